@@ -0,0 +1,132 @@
+package dearrow
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"regexp"
+	"strconv"
+
+	"github.com/cufee/feedlr-yt/internal/api/sponsorblock"
+)
+
+type Title struct {
+	Title    string `json:"title"`
+	Original bool   `json:"original"`
+	Votes    int    `json:"votes"`
+	Locked   bool   `json:"locked"`
+	UUID     string `json:"UUID"`
+}
+
+type Thumbnail struct {
+	Timestamp *float64 `json:"timestamp"`
+	Original  bool     `json:"original"`
+	Votes     int      `json:"votes"`
+	Locked    bool     `json:"locked"`
+	UUID      string   `json:"UUID"`
+}
+
+type Branding struct {
+	Titles        []Title     `json:"titles"`
+	Thumbnails    []Thumbnail `json:"thumbnails"`
+	RandomTime    float64     `json:"randomTime"`
+	VideoDuration *float64    `json:"videoDuration"`
+}
+
+// Words prefixed with > are marked by submitters to be left as is by formatting, the marker is never displayed
+var formattingMarker = regexp.MustCompile(`(^|\s)>(\S)`)
+
+/*
+BestTitle returns the top community title, titles with negative votes are only used when locked by a moderator.
+An empty string is returned when the original title should be kept.
+*/
+func (b Branding) BestTitle() string {
+	for _, title := range b.Titles {
+		if title.Original {
+			return ""
+		}
+		if title.Votes < 0 && !title.Locked {
+			continue
+		}
+		return formattingMarker.ReplaceAllString(title.Title, "$1$2")
+	}
+	return ""
+}
+
+/*
+BestThumbnail returns the timestamp of the top community thumbnail, false is returned when the original thumbnail should be kept
+*/
+func (b Branding) BestThumbnail() (float64, bool) {
+	for _, thumbnail := range b.Thumbnails {
+		if thumbnail.Original {
+			return 0, false
+		}
+		if (thumbnail.Votes < 0 && !thumbnail.Locked) || thumbnail.Timestamp == nil {
+			continue
+		}
+		return *thumbnail.Timestamp, true
+	}
+	return 0, false
+}
+
+/*
+LookupBranding returns community titles and thumbnails for a video using the hash prefix endpoint, nil is returned for videos without submissions.
+DeArrow runs on the SponsorBlock server and hashes video ids the same way.
+
+https://wiki.sponsor.ajay.app/w/API_Docs/DeArrow#GET_/api/branding/:sha256HashPrefix
+*/
+func (c *client) LookupBranding(ctx context.Context, videoID string) (*Branding, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, fmt.Sprintf("%s/branding/%s", c.apiUrl, sponsorblock.VideoIDHashPrefix(videoID)), nil)
+	if err != nil {
+		return nil, errors.Join(errors.New("LookupBranding.http.NewRequestWithContext"), err)
+	}
+
+	res, err := c.http.Do(req)
+	if err != nil {
+		if os.IsTimeout(err) || errors.Is(err, context.DeadlineExceeded) {
+			return nil, ErrRequestTimeout
+		}
+		return nil, errors.Join(errors.New("LookupBranding.http.Do"), err)
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode == http.StatusNotFound {
+		return nil, nil
+	}
+	if res.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("dearrow: unexpected status code %d", res.StatusCode)
+	}
+
+	var results map[string]Branding
+	err = json.NewDecoder(res.Body).Decode(&results)
+	if err != nil {
+		return nil, errors.Join(errors.New("LookupBranding.json.NewDecoder.Decode"), err)
+	}
+
+	// Branding is keyed by video id, the response also holds every other video sharing the prefix
+	if branding, ok := results[videoID]; ok {
+		return &branding, nil
+	}
+	return nil, nil
+}
+
+func (c *client) ThumbnailsEnabled() bool {
+	return c.thumbnailUrl != ""
+}
+
+/*
+ThumbnailURL returns a link to a rendered community thumbnail, false is returned when no thumbnail service is configured
+*/
+func (c *client) ThumbnailURL(videoID string, timestamp float64) (string, bool) {
+	if !c.ThumbnailsEnabled() {
+		return "", false
+	}
+	query := url.Values{}
+	query.Set("videoID", videoID)
+	query.Set("time", strconv.FormatFloat(timestamp, 'f', -1, 64))
+	return fmt.Sprintf("%s/v1/getThumbnail?%s", c.thumbnailUrl, query.Encode()), true
+}
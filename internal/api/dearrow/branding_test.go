@@ -0,0 +1,92 @@
+package dearrow
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/cufee/feedlr-yt/internal/api/sponsorblock"
+)
+
+func TestLookupBranding_HashPrefix(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if strings.Contains(r.URL.String(), "dQw4w9WgXcQ") {
+			t.Errorf("video id leaked to upstream: %s", r.URL.String())
+		}
+		if r.URL.Path != "/api/branding/"+sponsorblock.VideoIDHashPrefix("dQw4w9WgXcQ") {
+			t.Errorf("unexpected path %s", r.URL.Path)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{
+			"other-video": {"titles": [{"title": "Other", "original": false, "votes": 5, "locked": false, "UUID": "a"}], "thumbnails": []},
+			"dQw4w9WgXcQ": {
+				"titles": [
+					{"title": "Downvoted title", "original": false, "votes": -1, "locked": false, "UUID": "b"},
+					{"title": "Rick Astley sings >iPhone song", "original": false, "votes": 2, "locked": false, "UUID": "c"}
+				],
+				"thumbnails": [{"timestamp": 42.5, "original": false, "votes": 0, "locked": true, "UUID": "d"}],
+				"randomTime": 0.3
+			}
+		}`))
+	}))
+	defer server.Close()
+
+	branding, err := NewClient(server.URL+"/api/", "").LookupBranding(context.Background(), "dQw4w9WgXcQ")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if branding == nil {
+		t.Fatal("expected branding")
+	}
+	if title := branding.BestTitle(); title != "Rick Astley sings iPhone song" {
+		t.Fatalf("unexpected title %q", title)
+	}
+	if timestamp, ok := branding.BestThumbnail(); !ok || timestamp != 42.5 {
+		t.Fatalf("unexpected thumbnail %v, %v", timestamp, ok)
+	}
+}
+
+func TestLookupBranding_NotFound(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer server.Close()
+
+	branding, err := NewClient(server.URL, "").LookupBranding(context.Background(), "dQw4w9WgXcQ")
+	if err != nil || branding != nil {
+		t.Fatalf("expected no branding, got %+v, %v", branding, err)
+	}
+}
+
+func TestBestTitle_OriginalFirst(t *testing.T) {
+	branding := Branding{Titles: []Title{{Title: "Original", Original: true, Votes: 3}, {Title: "Community", Votes: 1}}}
+	if title := branding.BestTitle(); title != "" {
+		t.Fatalf("expected the original title to win, got %q", title)
+	}
+}
+
+func TestThumbnailURL(t *testing.T) {
+	if _, ok := NewClient("http://localhost", "").ThumbnailURL("abc", 1); ok {
+		t.Fatal("expected thumbnails to be disabled without a thumbnail service")
+	}
+	link, ok := NewClient("http://localhost", "http://thumbs/api/").ThumbnailURL("abc", 12.5)
+	if !ok || link != "http://thumbs/api/v1/getThumbnail?time=12.5&videoID=abc" {
+		t.Fatalf("unexpected link %q", link)
+	}
+}
+
+func TestFixTitleCasing(t *testing.T) {
+	tests := map[string]string{
+		"I Tried the NEW GPU and it was INSANE!!!": "I Tried the NEW GPU and it was Insane!",
+		"THIS CHANGES EVERYTHING":                  "This Changes Everything",
+		"Why the USA uses feet?!?":                 "Why the USA uses feet?",
+		"normal title":                             "normal title",
+	}
+	for input, want := range tests {
+		if got := FixTitleCasing(input); got != want {
+			t.Errorf("FixTitleCasing(%q) = %q, want %q", input, got, want)
+		}
+	}
+}
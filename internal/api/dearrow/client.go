@@ -0,0 +1,43 @@
+package dearrow
+
+import (
+	"errors"
+	"log"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+)
+
+type client struct {
+	apiUrl       string
+	thumbnailUrl string
+	http         http.Client
+}
+
+var DefaultClient *client
+var C *client
+
+var ErrRequestTimeout = errors.New("request timeout")
+
+func init() {
+	apiUrl := strings.TrimSpace(os.Getenv("DEARROW_API_URL"))
+	if apiUrl == "" {
+		log.Println("dearrow disabled: DEARROW_API_URL is not set")
+		return
+	}
+
+	DefaultClient = NewClient(apiUrl, strings.TrimSpace(os.Getenv("DEARROW_THUMBNAIL_API_URL")))
+	C = DefaultClient
+}
+
+/*
+NewClient creates a DeArrow client, thumbnailUrl points to the thumbnail cache service and can be left empty to only use titles
+*/
+func NewClient(apiUrl, thumbnailUrl string) *client {
+	return &client{
+		http:         http.Client{Timeout: time.Second * 5},
+		apiUrl:       strings.TrimSuffix(apiUrl, "/"),
+		thumbnailUrl: strings.TrimSuffix(thumbnailUrl, "/"),
+	}
+}
@@ -0,0 +1,81 @@
+package dearrow
+
+import (
+	"regexp"
+	"strings"
+	"unicode"
+)
+
+var repeatedPunctuation = regexp.MustCompile(`([!?])[!?]+`)
+
+/*
+FixTitleCasing tones down shouting in a title, similar to the DeArrow title formatter.
+Titles written mostly in capitals are converted to title case, otherwise only long capitalized words are changed since short ones are usually acronyms.
+Repeated exclamation and question marks are collapsed into one.
+*/
+func FixTitleCasing(title string) string {
+	words := strings.Fields(title)
+	if len(words) == 0 {
+		return title
+	}
+
+	var shouting, withLetters int
+	for _, word := range words {
+		if !hasLetters(word) {
+			continue
+		}
+		withLetters++
+		if isUpper(word) {
+			shouting++
+		}
+	}
+	mostlyUpper := withLetters > 1 && shouting*2 > withLetters
+
+	for i, word := range words {
+		if !isUpper(word) {
+			continue
+		}
+		if mostlyUpper || letterCount(word) >= 4 {
+			words[i] = capitalize(word)
+		}
+	}
+	return repeatedPunctuation.ReplaceAllString(strings.Join(words, " "), "$1")
+}
+
+func capitalize(word string) string {
+	runes := []rune(strings.ToLower(word))
+	for i, r := range runes {
+		if unicode.IsLetter(r) {
+			runes[i] = unicode.ToUpper(r)
+			break
+		}
+	}
+	return string(runes)
+}
+
+func hasLetters(word string) bool {
+	return letterCount(word) > 0
+}
+
+func letterCount(word string) int {
+	var count int
+	for _, r := range word {
+		if unicode.IsLetter(r) {
+			count++
+		}
+	}
+	return count
+}
+
+// isUpper reports whether a word has at least two letters and all of them are capitals
+func isUpper(word string) bool {
+	if letterCount(word) < 2 {
+		return false
+	}
+	for _, r := range word {
+		if unicode.IsLetter(r) && !unicode.IsUpper(r) {
+			return false
+		}
+	}
+	return true
+}
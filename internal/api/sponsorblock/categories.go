@@ -15,6 +15,11 @@ var (
 	Outro               = Category{"Endcards", "The credits or appearance of YouTube endcards at the end of the video.", "outro"}
 	Music               = Category{"Music", "Segments featuring off-topic music in the video.", "music_offtopic"}
 	Filler              = Category{"Filler", "Tangential and humorous scenes added to the video that are not essential for understanding the main content.", "filler"}
-	AvailableCategories = []Category{Sponsor, SelfPromo, Interaction, Preview, Intro, Outro, Music, Filler}
-	ValidCategoryValues = []string{"sponsor", "selfpromo", "interaction", "preview", "intro", "outro", "music_offtopic", "filler"}
+	Highlight           = Category{"Highlight", "The part of the video that most people are looking for, similar to a \"video starts at x\" comment.", "poi_highlight"}
+	ExclusiveAccess     = Category{"Exclusive Access", "The whole video showcases a product, service or location the creator received free or subsidized access to.", "exclusive_access"}
+	Chapter             = Category{"Chapter", "Community-submitted chapter labels, used when the video does not define its own chapters.", "chapter"}
+	AvailableCategories = []Category{Sponsor, SelfPromo, Interaction, Preview, Intro, Outro, Music, Filler, Highlight, ExclusiveAccess, Chapter}
+	// Highlights, full video labels and chapters need a different submission flow, only regular segments can be submitted
+	SubmittableCategories = []Category{Sponsor, SelfPromo, Interaction, Preview, Intro, Outro, Music, Filler}
+	ValidCategoryValues   = []string{"sponsor", "selfpromo", "interaction", "preview", "intro", "outro", "music_offtopic", "filler", "poi_highlight", "exclusive_access", "chapter"}
 )
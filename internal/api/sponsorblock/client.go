@@ -12,6 +12,9 @@ import (
 type client struct {
 	apiUrl string
 	http   http.Client
+
+	// lookupTimeout applies to lookups made without a deadline, those block player loads
+	lookupTimeout time.Duration
 }
 
 var DefaultClient *client
@@ -26,9 +29,14 @@ func init() {
 		return
 	}
 
-	DefaultClient = &client{
-		http:   http.Client{Timeout: time.Millisecond * 500},
-		apiUrl: apiUrl,
-	}
+	DefaultClient = NewClient(apiUrl)
 	C = DefaultClient
 }
+
+func NewClient(apiUrl string) *client {
+	return &client{
+		http:          http.Client{Timeout: time.Second * 5},
+		apiUrl:        strings.TrimSuffix(apiUrl, "/"),
+		lookupTimeout: time.Millisecond * 500,
+	}
+}
@@ -1,12 +1,29 @@
 package sponsorblock
 
 import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"encoding/json"
 	"errors"
 	"fmt"
 	"net/http"
 	"net/url"
 	"os"
+
+	"github.com/cufee/feedlr-yt/internal/tracing"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+const (
+	ActionTypeSkip    = "skip"
+	ActionTypeMute    = "mute"
+	ActionTypeFull    = "full"
+	ActionTypePOI     = "poi"
+	ActionTypeChapter = "chapter"
+
+	// 4 characters is what the SponsorBlock docs recommend, each prefix matches a few hundred videos
+	hashPrefixLength = 4
 )
 
 type Segment struct {
@@ -20,6 +37,20 @@ type Segment struct {
 	Description   string    `json:"description"`
 }
 
+type hashPrefixResult struct {
+	VideoID  string    `json:"videoID"`
+	Hash     string    `json:"hash"`
+	Segments []Segment `json:"segments"`
+}
+
+/*
+VideoIDHashPrefix returns the sha256 hash prefix used for privacy-preserving lookups
+*/
+func VideoIDHashPrefix(videoID string) string {
+	hash := sha256.Sum256([]byte(videoID))
+	return hex.EncodeToString(hash[:])[:hashPrefixLength]
+}
+
 /*
 GetVideoSegments returns a list of _sponsor_ segments for a given video ID.
 
@@ -29,37 +60,76 @@ func (c *client) GetVideoSegments(videoId string, categories ...Category) ([]Seg
 	if len(categories) == 0 {
 		categories = []Category{Sponsor, SelfPromo, Interaction}
 	}
+	ctx, cancel := context.WithTimeout(context.Background(), c.lookupTimeout)
+	defer cancel()
+	return c.LookupSegments(ctx, videoId, categories, nil)
+}
+
+/*
+GetVideoChapters returns a list of community-submitted chapter segments for a given video ID.
+The chapter name is stored in the Description field of each segment.
+*/
+func (c *client) GetVideoChapters(videoId string) ([]Segment, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), c.lookupTimeout)
+	defer cancel()
+	return c.LookupSegments(ctx, videoId, []Category{Chapter}, []string{ActionTypeChapter})
+}
+
+/*
+LookupSegments returns segments for a video using the hash prefix endpoint, the upstream only ever sees the first characters of the sha256 of the video ID.
+Missing action types default to skip.
 
-	link, err := url.Parse(fmt.Sprintf("%s/skipSegments/", c.apiUrl))
+https://wiki.sponsor.ajay.app/w/API_Docs#GET_/api/skipSegments/:sha256HashPrefix
+*/
+func (c *client) LookupSegments(ctx context.Context, videoId string, categories []Category, actionTypes []string) (_ []Segment, err error) {
+	ctx, span := tracing.StartClient(ctx, "sponsorblock.LookupSegments", attribute.String("video.id", videoId))
+	defer func() { tracing.End(span, err) }()
+
+	link, err := url.Parse(fmt.Sprintf("%s/skipSegments/%s", c.apiUrl, VideoIDHashPrefix(videoId)))
 	if err != nil {
-		return nil, errors.Join(errors.New("GetVideoSegments.url.Parse"), err)
+		return nil, errors.Join(errors.New("LookupSegments.url.Parse"), err)
 	}
 	query := link.Query()
-	query.Add("videoID", videoId)
 	for _, category := range categories {
 		query.Add("category", category.Value)
 	}
+	for _, actionType := range actionTypes {
+		query.Add("actionType", actionType)
+	}
 	link.RawQuery = query.Encode()
 
-	var segments []Segment
-	res, err := c.http.Get(link.String())
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, link.String(), nil)
 	if err != nil {
-		if os.IsTimeout(err) {
+		return nil, errors.Join(errors.New("LookupSegments.http.NewRequestWithContext"), err)
+	}
+
+	res, err := c.http.Do(req)
+	if err != nil {
+		if os.IsTimeout(err) || errors.Is(err, context.DeadlineExceeded) {
 			return nil, ErrRequestTimeout
 		}
-		return nil, errors.Join(errors.New("GetVideoSegments.http.DefaultClient.Get"), err)
+		return nil, errors.Join(errors.New("LookupSegments.http.Do"), err)
 	}
-	if res == nil || res.StatusCode == http.StatusNotFound {
-		return segments, nil
+	defer res.Body.Close()
+
+	if res.StatusCode == http.StatusNotFound {
+		return nil, nil
 	}
 	if res.StatusCode != http.StatusOK {
 		return nil, fmt.Errorf("sponsorblock: unexpected status code %d", res.StatusCode)
 	}
 
-	err = json.NewDecoder(res.Body).Decode(&segments)
+	var results []hashPrefixResult
+	err = json.NewDecoder(res.Body).Decode(&results)
 	if err != nil {
-		return nil, errors.Join(errors.New("GetVideoSegments.json.NewDecoder.Decode"), err)
+		return nil, errors.Join(errors.New("LookupSegments.json.NewDecoder.Decode"), err)
 	}
 
-	return segments, nil
+	// The prefix matches other videos as well, only keep the one we asked for
+	for _, result := range results {
+		if result.VideoID == videoId {
+			return result.Segments, nil
+		}
+	}
+	return nil, nil
 }
@@ -0,0 +1,74 @@
+package sponsorblock
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestVideoIDHashPrefix(t *testing.T) {
+	// sha256("dQw4w9WgXcQ") starts with 5f6b
+	if prefix := VideoIDHashPrefix("dQw4w9WgXcQ"); prefix != "5f6b" {
+		t.Fatalf("unexpected prefix %q", prefix)
+	}
+}
+
+func TestLookupSegments_HashPrefix(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if strings.Contains(r.URL.String(), "dQw4w9WgXcQ") {
+			t.Errorf("video id leaked to upstream: %s", r.URL.String())
+		}
+		if r.URL.Path != "/api/skipSegments/"+VideoIDHashPrefix("dQw4w9WgXcQ") {
+			t.Errorf("unexpected path %s", r.URL.Path)
+		}
+		if got := r.URL.Query()["category"]; len(got) != 2 || got[0] != "sponsor" || got[1] != "intro" {
+			t.Errorf("unexpected categories %v", got)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`[
+			{"videoID": "other-video", "hash": "5f6baaaa", "segments": [{"segment": [1, 2], "UUID": "other", "category": "sponsor", "actionType": "skip"}]},
+			{"videoID": "dQw4w9WgXcQ", "hash": "5f6b0b4e", "segments": [{"segment": [10.5, 30], "UUID": "wanted", "category": "sponsor", "actionType": "skip", "votes": 3}]}
+		]`))
+	}))
+	defer server.Close()
+
+	c := NewClient(server.URL + "/api/")
+	segments, err := c.LookupSegments(context.Background(), "dQw4w9WgXcQ", []Category{Sponsor, Intro}, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(segments) != 1 || segments[0].UUID != "wanted" || segments[0].Segment[0] != 10.5 {
+		t.Fatalf("unexpected segments %+v", segments)
+	}
+}
+
+func TestLookupSegments_NotFound(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer server.Close()
+
+	segments, err := NewClient(server.URL).LookupSegments(context.Background(), "dQw4w9WgXcQ", []Category{Sponsor}, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(segments) != 0 {
+		t.Fatalf("expected no segments, got %+v", segments)
+	}
+}
+
+func TestLookupSegments_Timeout(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		time.Sleep(100 * time.Millisecond)
+	}))
+	defer server.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	if _, err := NewClient(server.URL).LookupSegments(ctx, "dQw4w9WgXcQ", []Category{Sponsor}, nil); err != ErrRequestTimeout {
+		t.Fatalf("expected timeout error, got %v", err)
+	}
+}
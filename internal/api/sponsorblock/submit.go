@@ -0,0 +1,144 @@
+package sponsorblock
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+
+	"github.com/cufee/feedlr-yt/internal/tracing"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+const userAgent = "Feedlr/1.0"
+
+var (
+	// ErrSubmissionRejected is returned for submissions and votes the server will never accept, they should not be retried
+	ErrSubmissionRejected = errors.New("sponsorblock: submission rejected")
+	// ErrRateLimited is returned when the server asks us to slow down
+	ErrRateLimited = errors.New("sponsorblock: rate limited")
+)
+
+type SegmentSubmission struct {
+	Segment    []float64 `json:"segment"`
+	Category   string    `json:"category"`
+	ActionType string    `json:"actionType"`
+}
+
+type Submission struct {
+	VideoID       string              `json:"videoID"`
+	UserID        string              `json:"userID"`
+	UserAgent     string              `json:"userAgent"`
+	VideoDuration float64             `json:"videoDuration,omitempty"`
+	Segments      []SegmentSubmission `json:"segments"`
+}
+
+type SubmittedSegment struct {
+	UUID     string    `json:"UUID"`
+	Category string    `json:"category"`
+	Segment  []float64 `json:"segment"`
+}
+
+/*
+SubmitSegments submits new segments for a video, userID is the private user ID that is hashed by the server.
+Duplicate submissions are treated as a success since the segment already exists.
+
+https://wiki.sponsor.ajay.app/w/API_Docs#POST_/api/skipSegments
+*/
+func (c *client) SubmitSegments(ctx context.Context, submission Submission) (_ []SubmittedSegment, err error) {
+	ctx, span := tracing.StartClient(ctx, "sponsorblock.SubmitSegments", attribute.String("video.id", submission.VideoID))
+	defer func() { tracing.End(span, err) }()
+
+	if submission.UserAgent == "" {
+		submission.UserAgent = userAgent
+	}
+	body, err := json.Marshal(submission)
+	if err != nil {
+		return nil, errors.Join(errors.New("SubmitSegments.json.Marshal"), err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.apiUrl+"/skipSegments", bytes.NewReader(body))
+	if err != nil {
+		return nil, errors.Join(errors.New("SubmitSegments.http.NewRequestWithContext"), err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	res, err := c.do(req)
+	if err != nil {
+		if res != nil && res.StatusCode == http.StatusConflict {
+			return nil, nil
+		}
+		return nil, err
+	}
+	defer res.Body.Close()
+
+	var submitted []SubmittedSegment
+	if err := json.NewDecoder(res.Body).Decode(&submitted); err != nil {
+		return nil, errors.Join(errors.New("SubmitSegments.json.NewDecoder.Decode"), err)
+	}
+	return submitted, nil
+}
+
+/*
+VoteOnSegment up or down votes a segment by UUID
+
+https://wiki.sponsor.ajay.app/w/API_Docs#POST_/api/voteOnSponsorTime
+*/
+func (c *client) VoteOnSegment(ctx context.Context, userID, segmentUUID string, upvote bool) (err error) {
+	ctx, span := tracing.StartClient(ctx, "sponsorblock.VoteOnSegment", attribute.String("segment.uuid", segmentUUID))
+	defer func() { tracing.End(span, err) }()
+
+	voteType := "0"
+	if upvote {
+		voteType = "1"
+	}
+	query := url.Values{}
+	query.Set("UUID", segmentUUID)
+	query.Set("userID", userID)
+	query.Set("type", voteType)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.apiUrl+"/voteOnSponsorTime?"+query.Encode(), nil)
+	if err != nil {
+		return errors.Join(errors.New("VoteOnSegment.http.NewRequestWithContext"), err)
+	}
+
+	res, err := c.do(req)
+	if err != nil {
+		return err
+	}
+	res.Body.Close()
+	return nil
+}
+
+// do sends a write request and maps error responses, the response is returned with errors so callers can check the status code
+func (c *client) do(req *http.Request) (*http.Response, error) {
+	req.Header.Set("User-Agent", userAgent)
+
+	res, err := c.http.Do(req)
+	if err != nil {
+		if os.IsTimeout(err) || errors.Is(err, context.DeadlineExceeded) {
+			return nil, ErrRequestTimeout
+		}
+		return nil, errors.Join(errors.New("http.Do"), err)
+	}
+	if res.StatusCode == http.StatusOK {
+		return res, nil
+	}
+
+	message, _ := io.ReadAll(io.LimitReader(res.Body, 512))
+	res.Body.Close()
+
+	switch {
+	case res.StatusCode == http.StatusTooManyRequests:
+		return res, ErrRateLimited
+	case res.StatusCode >= 500:
+		return res, fmt.Errorf("sponsorblock: unexpected status code %d", res.StatusCode)
+	default:
+		return res, fmt.Errorf("%w: status %d: %s", ErrSubmissionRejected, res.StatusCode, bytes.TrimSpace(message))
+	}
+}
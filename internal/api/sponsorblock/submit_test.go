@@ -0,0 +1,94 @@
+package sponsorblock
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestSubmitSegments(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.URL.Path != "/api/skipSegments" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+		var body Submission
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Errorf("failed to decode body: %v", err)
+		}
+		if body.VideoID != "dQw4w9WgXcQ" || body.UserID != "private-id" || body.UserAgent == "" {
+			t.Errorf("unexpected submission %+v", body)
+		}
+		if len(body.Segments) != 1 || body.Segments[0].Category != "sponsor" || body.Segments[0].Segment[1] != 20 {
+			t.Errorf("unexpected segments %+v", body.Segments)
+		}
+		w.Write([]byte(`[{"UUID": "new-uuid", "category": "sponsor", "segment": [10, 20]}]`))
+	}))
+	defer server.Close()
+
+	submitted, err := NewClient(server.URL+"/api").SubmitSegments(context.Background(), Submission{
+		VideoID:  "dQw4w9WgXcQ",
+		UserID:   "private-id",
+		Segments: []SegmentSubmission{{Segment: []float64{10, 20}, Category: "sponsor", ActionType: ActionTypeSkip}},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(submitted) != 1 || submitted[0].UUID != "new-uuid" {
+		t.Fatalf("unexpected response %+v", submitted)
+	}
+}
+
+func TestSubmitSegments_Errors(t *testing.T) {
+	tests := []struct {
+		status    int
+		rejected  bool
+		succeeded bool
+	}{
+		{status: http.StatusConflict, succeeded: true},
+		{status: http.StatusBadRequest, rejected: true},
+		{status: http.StatusForbidden, rejected: true},
+		{status: http.StatusTooManyRequests},
+		{status: http.StatusBadGateway},
+	}
+	for _, tt := range tests {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(tt.status)
+		}))
+
+		_, err := NewClient(server.URL).SubmitSegments(context.Background(), Submission{VideoID: "dQw4w9WgXcQ"})
+		server.Close()
+
+		if tt.succeeded {
+			if err != nil {
+				t.Fatalf("status %d: unexpected error: %v", tt.status, err)
+			}
+			continue
+		}
+		if err == nil {
+			t.Fatalf("status %d: expected an error", tt.status)
+		}
+		if errors.Is(err, ErrSubmissionRejected) != tt.rejected {
+			t.Fatalf("status %d: unexpected error %v", tt.status, err)
+		}
+	}
+}
+
+func TestVoteOnSegment(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		query := r.URL.Query()
+		if r.Method != http.MethodPost || r.URL.Path != "/voteOnSponsorTime" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+		if query.Get("UUID") != "segment-uuid" || query.Get("userID") != "private-id" || query.Get("type") != "0" {
+			t.Errorf("unexpected query %v", query)
+		}
+	}))
+	defer server.Close()
+
+	if err := NewClient(server.URL).VoteOnSegment(context.Background(), "private-id", "segment-uuid", false); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
@@ -0,0 +1,316 @@
+/*
+Package dial finds YouTube screens on the local network using SSDP and the DIAL protocol.
+
+Discovery sends an SSDP M-SEARCH for DIAL servers, reads the device description behind each LOCATION header
+and asks the YouTube DIAL application for its screen ID, which can be exchanged for a lounge token.
+
+http://www.dial-multiscreen.org/dial-protocol-specification
+*/
+package dial
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	// DefaultSSDPAddress is the multicast group DIAL servers listen on
+	DefaultSSDPAddress = "239.255.255.250:1900"
+	// SearchTarget is the SSDP service type of DIAL servers
+	SearchTarget = "urn:dial-multiscreen-org:service:dial:1"
+
+	youtubeAppName       = "YouTube"
+	defaultSearchTimeout = 3 * time.Second
+	requestTimeout       = 5 * time.Second
+	launchPollInterval   = time.Second
+	launchPollAttempts   = 10
+	maxResponseSize      = 1024 * 1024
+)
+
+var ErrNoScreenID = errors.New("youtube app did not report a screen id")
+
+type Screen struct {
+	// ScreenID is the lounge screen ID of the YouTube app
+	ScreenID string
+	// Name is the friendly name of the device
+	Name string
+
+	Location       string
+	ApplicationURL string
+}
+
+type Options struct {
+	// Address SSDP requests are sent to, defaults to the multicast group
+	Address string
+	// Timeout is how long to wait for SSDP responses
+	Timeout time.Duration
+	// Launch starts the YouTube app on screens where it is not running, screens only report a screen ID while the app is running
+	Launch bool
+
+	HTTPClient *http.Client
+}
+
+/*
+Discover returns YouTube screens found on the local network.
+Devices that respond to SSDP but do not have the YouTube app, or do not report a screen ID, are skipped.
+*/
+func Discover(ctx context.Context, opts Options) ([]Screen, error) {
+	if opts.Address == "" {
+		opts.Address = DefaultSSDPAddress
+	}
+	if opts.Timeout <= 0 {
+		opts.Timeout = defaultSearchTimeout
+	}
+	if opts.HTTPClient == nil {
+		opts.HTTPClient = &http.Client{Timeout: requestTimeout}
+	}
+
+	locations, err := Search(ctx, opts.Address, opts.Timeout)
+	if err != nil {
+		return nil, err
+	}
+
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+	var screens []Screen
+	for _, location := range locations {
+		wg.Add(1)
+		go func(location string) {
+			defer wg.Done()
+
+			screen, err := lookupScreen(ctx, opts.HTTPClient, location, opts.Launch)
+			if err != nil {
+				return
+			}
+			mu.Lock()
+			screens = append(screens, screen)
+			mu.Unlock()
+		}(location)
+	}
+	wg.Wait()
+
+	// Keep the order stable, several devices can answer for the same screen
+	var unique []Screen
+	seen := make(map[string]bool)
+	for _, location := range locations {
+		for _, screen := range screens {
+			if screen.Location != location || seen[screen.ScreenID] {
+				continue
+			}
+			seen[screen.ScreenID] = true
+			unique = append(unique, screen)
+		}
+	}
+	return unique, nil
+}
+
+/*
+Search sends an SSDP M-SEARCH for DIAL servers and returns the unique LOCATION URLs received before the timeout
+*/
+func Search(ctx context.Context, address string, timeout time.Duration) ([]string, error) {
+	target, err := net.ResolveUDPAddr("udp4", address)
+	if err != nil {
+		return nil, fmt.Errorf("invalid ssdp address: %w", err)
+	}
+
+	conn, err := net.ListenUDP("udp4", nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open ssdp socket: %w", err)
+	}
+	defer conn.Close()
+
+	deadline := time.Now().Add(timeout)
+	if d, ok := ctx.Deadline(); ok && d.Before(deadline) {
+		deadline = d
+	}
+	if err := conn.SetDeadline(deadline); err != nil {
+		return nil, err
+	}
+
+	mx := max(1, int(timeout/time.Second))
+	request := fmt.Sprintf("M-SEARCH * HTTP/1.1\r\nHOST: %s\r\nMAN: \"ssdp:discover\"\r\nMX: %d\r\nST: %s\r\n\r\n", DefaultSSDPAddress, mx, SearchTarget)
+	// UDP is lossy, a second request a moment later catches devices that missed the first one
+	for range 2 {
+		if _, err := conn.WriteToUDP([]byte(request), target); err != nil {
+			return nil, fmt.Errorf("failed to send ssdp request: %w", err)
+		}
+	}
+
+	var locations []string
+	seen := make(map[string]bool)
+	buf := make([]byte, 64*1024)
+	for {
+		if ctx.Err() != nil {
+			return locations, nil
+		}
+		n, _, err := conn.ReadFromUDP(buf)
+		if err != nil {
+			var netErr net.Error
+			if errors.As(err, &netErr) && netErr.Timeout() {
+				return locations, nil
+			}
+			return nil, fmt.Errorf("failed to read ssdp response: %w", err)
+		}
+
+		location, ok := parseSearchResponse(buf[:n])
+		if !ok || seen[location] {
+			continue
+		}
+		seen[location] = true
+		locations = append(locations, location)
+	}
+}
+
+func parseSearchResponse(data []byte) (string, bool) {
+	res, err := http.ReadResponse(bufio.NewReader(bytes.NewReader(data)), nil)
+	if err != nil {
+		return "", false
+	}
+	res.Body.Close()
+
+	if res.StatusCode != http.StatusOK || !strings.EqualFold(strings.TrimSpace(res.Header.Get("ST")), SearchTarget) {
+		return "", false
+	}
+	location := strings.TrimSpace(res.Header.Get("Location"))
+	parsed, err := url.Parse(location)
+	if err != nil || (parsed.Scheme != "http" && parsed.Scheme != "https") || parsed.Host == "" {
+		return "", false
+	}
+	return location, true
+}
+
+type deviceDescription struct {
+	Device struct {
+		FriendlyName string `xml:"friendlyName"`
+	} `xml:"device"`
+}
+
+type appStatus struct {
+	Name           string `xml:"name"`
+	State          string `xml:"state"`
+	AdditionalData struct {
+		ScreenID string `xml:"screenId"`
+	} `xml:"additionalData"`
+}
+
+func lookupScreen(ctx context.Context, client *http.Client, location string, launch bool) (Screen, error) {
+	res, body, err := get(ctx, client, location)
+	if err != nil {
+		return Screen{}, err
+	}
+
+	applicationURL := strings.TrimSpace(res.Header.Get("Application-URL"))
+	if applicationURL == "" {
+		return Screen{}, errors.New("device description is missing the application url")
+	}
+	if !strings.HasSuffix(applicationURL, "/") {
+		applicationURL += "/"
+	}
+
+	var description deviceDescription
+	_ = xml.Unmarshal(body, &description)
+
+	screen := Screen{
+		Name:           strings.TrimSpace(description.Device.FriendlyName),
+		Location:       location,
+		ApplicationURL: applicationURL,
+	}
+
+	appURL := applicationURL + youtubeAppName
+	status, err := getAppStatus(ctx, client, appURL)
+	if err != nil {
+		return Screen{}, err
+	}
+	if status.AdditionalData.ScreenID == "" && launch && status.State != "running" {
+		status, err = launchApp(ctx, client, appURL)
+		if err != nil {
+			return Screen{}, err
+		}
+	}
+	if status.AdditionalData.ScreenID == "" {
+		return Screen{}, ErrNoScreenID
+	}
+
+	screen.ScreenID = strings.TrimSpace(status.AdditionalData.ScreenID)
+	return screen, nil
+}
+
+func getAppStatus(ctx context.Context, client *http.Client, appURL string) (appStatus, error) {
+	_, body, err := get(ctx, client, appURL)
+	if err != nil {
+		return appStatus{}, err
+	}
+
+	var status appStatus
+	if err := xml.Unmarshal(body, &status); err != nil {
+		return appStatus{}, fmt.Errorf("invalid app status: %w", err)
+	}
+	return status, nil
+}
+
+// launchApp starts the app and waits for it to report a screen ID
+func launchApp(ctx context.Context, client *http.Client, appURL string) (appStatus, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, appURL, nil)
+	if err != nil {
+		return appStatus{}, err
+	}
+	req.Header.Set("Content-Type", "text/plain; charset=utf-8")
+
+	res, err := client.Do(req)
+	if err != nil {
+		return appStatus{}, err
+	}
+	_, _ = io.Copy(io.Discard, io.LimitReader(res.Body, maxResponseSize))
+	res.Body.Close()
+	if res.StatusCode != http.StatusCreated && res.StatusCode != http.StatusOK {
+		return appStatus{}, fmt.Errorf("launching the app failed with status %d", res.StatusCode)
+	}
+
+	for range launchPollAttempts {
+		status, err := getAppStatus(ctx, client, appURL)
+		if err == nil && status.AdditionalData.ScreenID != "" {
+			return status, nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return appStatus{}, ctx.Err()
+		case <-time.After(launchPollInterval):
+		}
+	}
+	return appStatus{}, ErrNoScreenID
+}
+
+func get(ctx context.Context, client *http.Client, target string) (*http.Response, []byte, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, target, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	res, err := client.Do(req)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		return nil, nil, fmt.Errorf("request to %s failed with status %d", target, res.StatusCode)
+	}
+
+	body, err := io.ReadAll(io.LimitReader(res.Body, maxResponseSize))
+	if err != nil {
+		return nil, nil, err
+	}
+	return res, body, nil
+}
@@ -0,0 +1,150 @@
+package dial
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// startSSDPResponder answers M-SEARCH requests for DIAL servers with each of the locations, like devices on a LAN would
+func startSSDPResponder(t *testing.T, locations ...string) string {
+	t.Helper()
+
+	conn, err := net.ListenUDP("udp4", &net.UDPAddr{IP: net.IPv4(127, 0, 0, 1)})
+	if err != nil {
+		t.Fatalf("failed to start ssdp responder: %v", err)
+	}
+	t.Cleanup(func() { conn.Close() })
+
+	go func() {
+		buf := make([]byte, 4096)
+		for {
+			n, addr, err := conn.ReadFromUDP(buf)
+			if err != nil {
+				return
+			}
+			request := string(buf[:n])
+			if !strings.HasPrefix(request, "M-SEARCH * HTTP/1.1") || !strings.Contains(request, "ST: "+SearchTarget) {
+				continue
+			}
+
+			// Unrelated services answer searches too
+			_, _ = conn.WriteToUDP([]byte("HTTP/1.1 200 OK\r\nST: upnp:rootdevice\r\nLOCATION: http://127.0.0.1:1/other.xml\r\n\r\n"), addr)
+			for _, location := range locations {
+				response := fmt.Sprintf("HTTP/1.1 200 OK\r\nCACHE-CONTROL: max-age=1800\r\nST: %s\r\nLOCATION: %s\r\nUSN: uuid:test::%s\r\n\r\n", SearchTarget, location, SearchTarget)
+				_, _ = conn.WriteToUDP([]byte(response), addr)
+			}
+		}
+	}()
+
+	return conn.LocalAddr().String()
+}
+
+type fakeDevice struct {
+	name     string
+	screenID string
+	youtube  bool
+	running  atomic.Bool
+	launched atomic.Int32
+}
+
+func (d *fakeDevice) handler(appURL func() string) http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/dd.xml", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Application-URL", appURL())
+		w.Header().Set("Content-Type", "text/xml")
+		fmt.Fprintf(w, `<?xml version="1.0"?><root xmlns="urn:schemas-upnp-org:device-1-0"><device><friendlyName>%s</friendlyName></device></root>`, d.name)
+	})
+	mux.HandleFunc("/apps/YouTube", func(w http.ResponseWriter, r *http.Request) {
+		if !d.youtube {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		if r.Method == http.MethodPost {
+			d.launched.Add(1)
+			d.running.Store(true)
+			w.WriteHeader(http.StatusCreated)
+			return
+		}
+
+		w.Header().Set("Content-Type", "text/xml")
+		if !d.running.Load() {
+			fmt.Fprint(w, `<service xmlns="urn:dial-multiscreen-org:schemas:dial"><name>YouTube</name><state>stopped</state></service>`)
+			return
+		}
+		fmt.Fprintf(w, `<service xmlns="urn:dial-multiscreen-org:schemas:dial"><name>YouTube</name><state>running</state><additionalData><screenId>%s</screenId><theme>cl</theme></additionalData></service>`, d.screenID)
+	})
+	return mux
+}
+
+func startDevice(t *testing.T, device *fakeDevice) string {
+	t.Helper()
+
+	var server *httptest.Server
+	server = httptest.NewServer(device.handler(func() string { return server.URL + "/apps/" }))
+	t.Cleanup(server.Close)
+	return server.URL + "/dd.xml"
+}
+
+func TestDiscover(t *testing.T) {
+	living := &fakeDevice{name: "Living Room TV", screenID: "screen-living", youtube: true}
+	living.running.Store(true)
+	bedroom := &fakeDevice{name: "Bedroom TV", screenID: "screen-bedroom", youtube: true}
+	speaker := &fakeDevice{name: "Speaker"}
+
+	livingLocation := startDevice(t, living)
+	address := startSSDPResponder(t, livingLocation, livingLocation, startDevice(t, bedroom), startDevice(t, speaker))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	screens, err := Discover(ctx, Options{Address: address, Timeout: 500 * time.Millisecond})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(screens) != 1 {
+		t.Fatalf("expected only the screen with a running YouTube app, got %+v", screens)
+	}
+	if screens[0].ScreenID != "screen-living" || screens[0].Name != "Living Room TV" {
+		t.Fatalf("unexpected screen %+v", screens[0])
+	}
+	if bedroom.launched.Load() != 0 {
+		t.Fatal("expected the app not to be launched without the launch option")
+	}
+
+	screens, err = Discover(ctx, Options{Address: address, Timeout: 500 * time.Millisecond, Launch: true})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(screens) != 2 {
+		t.Fatalf("expected both YouTube screens, got %+v", screens)
+	}
+	if screens[0].ScreenID != "screen-living" || screens[1].ScreenID != "screen-bedroom" {
+		t.Fatalf("unexpected screens %+v", screens)
+	}
+	if bedroom.launched.Load() != 1 || living.launched.Load() != 0 {
+		t.Fatalf("expected only the stopped app to be launched, got bedroom=%d living=%d", bedroom.launched.Load(), living.launched.Load())
+	}
+}
+
+func TestParseSearchResponse(t *testing.T) {
+	location, ok := parseSearchResponse([]byte("HTTP/1.1 200 OK\r\nST: " + SearchTarget + "\r\nLOCATION: http://192.168.1.20:8008/ssdp/device-desc.xml\r\n\r\n"))
+	if !ok || location != "http://192.168.1.20:8008/ssdp/device-desc.xml" {
+		t.Fatalf("unexpected result %q %t", location, ok)
+	}
+	if _, ok := parseSearchResponse([]byte("HTTP/1.1 200 OK\r\nST: upnp:rootdevice\r\nLOCATION: http://192.168.1.20/desc.xml\r\n\r\n")); ok {
+		t.Fatal("expected responses for other services to be ignored")
+	}
+	if _, ok := parseSearchResponse([]byte("HTTP/1.1 200 OK\r\nST: " + SearchTarget + "\r\nLOCATION: file:///etc/passwd\r\n\r\n")); ok {
+		t.Fatal("expected non-http locations to be ignored")
+	}
+	if _, ok := parseSearchResponse([]byte("NOTIFY * HTTP/1.1\r\n\r\n")); ok {
+		t.Fatal("expected non-response packets to be ignored")
+	}
+}
@@ -16,6 +16,8 @@ import (
 	"time"
 
 	"github.com/cufee/feedlr-yt/internal/metrics"
+	"github.com/cufee/feedlr-yt/internal/tracing"
+	"go.opentelemetry.io/otel/attribute"
 )
 
 const defaultLoungeAPIBaseURL = "https://www.youtube.com/api/lounge"
@@ -94,7 +96,10 @@ func NewClientWithBaseURL(httpClient *http.Client, baseURL string) *Client {
 	return client
 }
 
-func (c *Client) PairWithCode(ctx context.Context, pairingCode string) (Screen, error) {
+func (c *Client) PairWithCode(ctx context.Context, pairingCode string) (_ Screen, err error) {
+	ctx, span := tracing.StartClient(ctx, "lounge.PairWithCode")
+	defer func() { tracing.End(span, err) }()
+
 	code := strings.TrimSpace(pairingCode)
 	if code == "" {
 		err := fmt.Errorf("pairing code is required")
@@ -155,7 +160,10 @@ func (c *Client) PairWithCode(ctx context.Context, pairingCode string) (Screen,
 	}, nil
 }
 
-func (c *Client) RefreshLoungeToken(ctx context.Context, screenID string) (Screen, error) {
+func (c *Client) RefreshLoungeToken(ctx context.Context, screenID string) (_ Screen, err error) {
+	ctx, span := tracing.StartClient(ctx, "lounge.RefreshLoungeToken", attribute.String("screen.id", screenID))
+	defer func() { tracing.End(span, err) }()
+
 	screenID = strings.TrimSpace(screenID)
 	if screenID == "" {
 		err := errors.New("screen id is required")
@@ -222,7 +230,10 @@ func (c *Client) RefreshLoungeToken(ctx context.Context, screenID string) (Scree
 	}, nil
 }
 
-func (c *Client) Connect(ctx context.Context, screenID, loungeToken, deviceName string) (*Session, error) {
+func (c *Client) Connect(ctx context.Context, screenID, loungeToken, deviceName string) (_ *Session, err error) {
+	ctx, span := tracing.StartClient(ctx, "lounge.Connect", attribute.String("screen.id", screenID))
+	defer func() { tracing.End(span, err) }()
+
 	screenID = strings.TrimSpace(screenID)
 	loungeToken = strings.TrimSpace(loungeToken)
 	if screenID == "" || loungeToken == "" {
@@ -362,11 +373,87 @@ func (c *Client) SeekTo(ctx context.Context, session *Session, seconds float64)
 	})
 }
 
+/*
+Mute mutes the screen without changing its volume level, the screen reports the change with an onVolumeChanged event
+*/
+func (c *Client) Mute(ctx context.Context, session *Session) error {
+	return c.command(ctx, session, "mute", nil)
+}
+
+func (c *Client) Unmute(ctx context.Context, session *Session) error {
+	return c.command(ctx, session, "unMute", nil)
+}
+
 func (c *Client) GetNowPlaying(ctx context.Context, session *Session) error {
 	return c.command(ctx, session, "getNowPlaying", nil)
 }
 
-func (c *Client) command(ctx context.Context, session *Session, command string, commandParameters map[string]string) error {
+/*
+SetPlaylist replaces the queue on the screen and starts playing the video at index from startSeconds
+*/
+func (c *Client) SetPlaylist(ctx context.Context, session *Session, videoIDs []string, index int, startSeconds float64) error {
+	if len(videoIDs) == 0 {
+		return errors.New("at least one video is required")
+	}
+	if index < 0 || index >= len(videoIDs) {
+		index = 0
+	}
+	if startSeconds < 0 {
+		startSeconds = 0
+	}
+	return c.command(ctx, session, "setPlaylist", map[string]string{
+		"videoId":      videoIDs[index],
+		"videoIds":     strings.Join(videoIDs, ","),
+		"currentIndex": strconv.Itoa(index),
+		"currentTime":  strconv.FormatFloat(startSeconds, 'f', 3, 64),
+	})
+}
+
+/*
+AddVideo appends a video to the end of the queue on the screen without interrupting playback
+*/
+func (c *Client) AddVideo(ctx context.Context, session *Session, videoID string) error {
+	videoID = strings.TrimSpace(videoID)
+	if videoID == "" {
+		return errors.New("video id is required")
+	}
+	return c.command(ctx, session, "addVideo", map[string]string{
+		"videoId": videoID,
+	})
+}
+
+func (c *Client) Play(ctx context.Context, session *Session) error {
+	return c.command(ctx, session, "play", nil)
+}
+
+func (c *Client) Pause(ctx context.Context, session *Session) error {
+	return c.command(ctx, session, "pause", nil)
+}
+
+func (c *Client) Next(ctx context.Context, session *Session) error {
+	return c.command(ctx, session, "next", nil)
+}
+
+/*
+StopVideo stops playback and closes the player, this also cancels the autoplay countdown after a video ends
+*/
+func (c *Client) StopVideo(ctx context.Context, session *Session) error {
+	return c.command(ctx, session, "stopVideo", nil)
+}
+
+/*
+SetVolume sets the screen volume, the level is clamped to 0-100
+*/
+func (c *Client) SetVolume(ctx context.Context, session *Session, volume int) error {
+	return c.command(ctx, session, "setVolume", map[string]string{
+		"volume": strconv.Itoa(min(max(volume, 0), 100)),
+	})
+}
+
+func (c *Client) command(ctx context.Context, session *Session, command string, commandParameters map[string]string) (err error) {
+	ctx, span := tracing.StartClient(ctx, "lounge."+command)
+	defer func() { tracing.End(span, err) }()
+
 	if session == nil || !session.connected() {
 		metrics.ObserveYouTubeTVCall("command_"+command, ErrNotConnected)
 		return ErrNotConnected
@@ -1,7 +1,11 @@
 package lounge
 
 import (
+	"context"
 	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
 	"strings"
 	"testing"
 )
@@ -57,3 +61,51 @@ func TestExtractPlaybackEvent(t *testing.T) {
 		t.Fatalf("unexpected state: %s", playback.State)
 	}
 }
+
+func TestRemoteCommands(t *testing.T) {
+	var commands []url.Values
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/lounge/bc/bind" || r.Method != http.MethodPost {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		_ = r.ParseForm()
+		commands = append(commands, r.PostForm)
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	client := NewClientWithBaseURL(server.Client(), server.URL+"/api/lounge")
+	session := &Session{SID: "sid-1", GSessionID: "gs-1", commandOffset: 1}
+
+	if err := client.SetPlaylist(context.Background(), session, []string{"video-a", "video-b"}, 1, 42); err != nil {
+		t.Fatalf("SetPlaylist returned error: %v", err)
+	}
+	if err := client.AddVideo(context.Background(), session, "video-c"); err != nil {
+		t.Fatalf("AddVideo returned error: %v", err)
+	}
+	if err := client.SetVolume(context.Background(), session, 150); err != nil {
+		t.Fatalf("SetVolume returned error: %v", err)
+	}
+	if err := client.SetPlaylist(context.Background(), session, nil, 0, 0); err == nil {
+		t.Fatal("expected an error for an empty playlist")
+	}
+
+	if len(commands) != 3 {
+		t.Fatalf("expected 3 commands, got %d", len(commands))
+	}
+	playlist := commands[0]
+	if playlist.Get("req0__sc") != "setPlaylist" || playlist.Get("req0_videoId") != "video-b" || playlist.Get("req0_videoIds") != "video-a,video-b" || playlist.Get("req0_currentIndex") != "1" || playlist.Get("req0_currentTime") != "42.000" {
+		t.Fatalf("unexpected setPlaylist command: %v", playlist)
+	}
+	if commands[1].Get("req0__sc") != "addVideo" || commands[1].Get("req0_videoId") != "video-c" {
+		t.Fatalf("unexpected addVideo command: %v", commands[1])
+	}
+	if commands[2].Get("req0__sc") != "setVolume" || commands[2].Get("req0_volume") != "100" {
+		t.Fatalf("unexpected setVolume command: %v", commands[2])
+	}
+	// Each command moves the offset forward so the server does not drop it as a duplicate
+	if commands[0].Get("ofs") != "1" || commands[2].Get("ofs") != "3" {
+		t.Fatalf("unexpected command offsets: %v, %v", commands[0].Get("ofs"), commands[2].Get("ofs"))
+	}
+}
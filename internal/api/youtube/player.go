@@ -31,8 +31,9 @@ const (
 
 type VideoDetails struct {
 	Video
-	ChannelID string `json:"channelId"`
-	Duration  int    `json:"duration"`
+	ChannelID     string         `json:"channelId"`
+	Duration      int            `json:"duration"`
+	CaptionTracks []CaptionTrack `json:"captionTracks,omitempty"`
 }
 
 type Microformat struct {
@@ -20,6 +20,7 @@ type DesktopPlayerResponse struct {
 	PlayabilityStatus  DesktopPlayabilityStatus  `json:"playabilityStatus"`
 	PlayerVideoDetails DesktopPlayerVideoDetails `json:"videoDetails"`
 	Microformat        Microformat               `json:"microformat"`
+	Captions           PlayerCaptions            `json:"captions"`
 }
 
 type DesktopPlayabilityStatus struct {
@@ -220,6 +221,7 @@ func (c *client) getDesktopPlayerDetails(videoId string) (*VideoDetails, error)
 			Thumbnail:   c.BuildVideoThumbnailURL(videoId),
 			URL:         c.BuildVideoEmbedURL(videoId),
 		},
+		CaptionTracks: details.Captions.tracks(),
 	}
 	if details.Microformat.PlayerMicroformatRenderer.PublishDate != "" {
 		fullDetails.Video.PublishedAt, _ = time.Parse(time.RFC3339, details.Microformat.PlayerMicroformatRenderer.PublishDate)
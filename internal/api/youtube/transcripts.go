@@ -0,0 +1,195 @@
+package youtube
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/cufee/feedlr-yt/internal/metrics"
+	"github.com/pkg/errors"
+)
+
+const captionKindAutoGenerated = "asr"
+
+var (
+	ErrNoCaptionTracks = errors.New("video has no caption tracks")
+
+	youtubeBaseURL, _ = url.Parse("https://www.youtube.com")
+)
+
+type CaptionTrack struct {
+	BaseURL       string `json:"baseUrl"`
+	LanguageCode  string `json:"languageCode"`
+	Name          string `json:"name"`
+	AutoGenerated bool   `json:"autoGenerated"`
+}
+
+type TranscriptCue struct {
+	StartMs    int    `json:"start"`
+	DurationMs int    `json:"duration"`
+	Text       string `json:"text"`
+}
+
+type PlayerCaptions struct {
+	PlayerCaptionsTracklistRenderer struct {
+		CaptionTracks []playerCaptionTrack `json:"captionTracks"`
+	} `json:"playerCaptionsTracklistRenderer"`
+}
+
+type playerCaptionTrack struct {
+	BaseURL      string `json:"baseUrl"`
+	LanguageCode string `json:"languageCode"`
+	Kind         string `json:"kind"`
+	Name         struct {
+		SimpleText string `json:"simpleText"`
+		Runs       []struct {
+			Text string `json:"text"`
+		} `json:"runs"`
+	} `json:"name"`
+}
+
+func (t playerCaptionTrack) name() string {
+	if t.Name.SimpleText != "" {
+		return t.Name.SimpleText
+	}
+	var parts []string
+	for _, run := range t.Name.Runs {
+		parts = append(parts, run.Text)
+	}
+	if name := strings.Join(parts, ""); name != "" {
+		return name
+	}
+	return t.LanguageCode
+}
+
+func (c PlayerCaptions) tracks() []CaptionTrack {
+	var tracks []CaptionTrack
+	for _, track := range c.PlayerCaptionsTracklistRenderer.CaptionTracks {
+		if track.BaseURL == "" || track.LanguageCode == "" {
+			continue
+		}
+		tracks = append(tracks, CaptionTrack{
+			BaseURL:       track.BaseURL,
+			LanguageCode:  track.LanguageCode,
+			Name:          track.name(),
+			AutoGenerated: track.Kind == captionKindAutoGenerated,
+		})
+	}
+	return tracks
+}
+
+/*
+GetVideoCaptionTracks returns caption tracks listed in the player response for a video
+*/
+func (c *client) GetVideoCaptionTracks(videoID string) ([]CaptionTrack, error) {
+	details, err := c.GetVideoPlayerDetails(videoID)
+	if err != nil {
+		return nil, err
+	}
+	if len(details.CaptionTracks) == 0 {
+		return nil, ErrNoCaptionTracks
+	}
+	return details.CaptionTracks, nil
+}
+
+/*
+GetTranscript downloads a caption track in the json3 timed text format and returns a list of cues
+*/
+func (c *client) GetTranscript(track CaptionTrack) ([]TranscriptCue, error) {
+	link, err := timedTextURL(track.BaseURL)
+	if err != nil {
+		metrics.ObserveYouTubeAPICall("transcript", "build_timedtext_url", err)
+		return nil, err
+	}
+
+	req, err := http.NewRequest(http.MethodGet, link, nil)
+	if err != nil {
+		metrics.ObserveYouTubeAPICall("transcript", "build_timedtext_request", err)
+		return nil, err
+	}
+	req.Header.Set("Referer", "https://www.youtube.com/")
+
+	res, err := c.httpClientWithTimeout(10 * time.Second).Do(req)
+	metrics.ObserveYouTubeAPICall("transcript", "timedtext_request", err)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+
+	body, err := io.ReadAll(res.Body)
+	metrics.ObserveYouTubeAPICall("transcript", "timedtext_response_body", err)
+	if err != nil {
+		return nil, err
+	}
+	if res.StatusCode != http.StatusOK {
+		metrics.ObserveYouTubeAPICall("transcript", "timedtext_status", errors.New("non_200_status"))
+		return nil, errors.Errorf("bad timedtext response status code %d", res.StatusCode)
+	}
+	metrics.ObserveYouTubeAPICall("transcript", "timedtext_status", nil)
+
+	cues, err := parseTimedTextJSON3(body)
+	metrics.ObserveYouTubeAPICall("transcript", "parse_timedtext", err)
+	if err != nil {
+		return nil, err
+	}
+	return cues, nil
+}
+
+func timedTextURL(baseURL string) (string, error) {
+	parsed, err := url.Parse(baseURL)
+	if err != nil {
+		return "", errors.Wrap(err, "invalid caption track url")
+	}
+	if !parsed.IsAbs() {
+		parsed = youtubeBaseURL.ResolveReference(parsed)
+	}
+
+	query := parsed.Query()
+	query.Set("fmt", "json3")
+	parsed.RawQuery = query.Encode()
+	return parsed.String(), nil
+}
+
+type timedTextJSON3 struct {
+	Events []struct {
+		StartMs    int `json:"tStartMs"`
+		DurationMs int `json:"dDurationMs"`
+		Segs       []struct {
+			UTF8 string `json:"utf8"`
+		} `json:"segs"`
+	} `json:"events"`
+}
+
+func parseTimedTextJSON3(body []byte) ([]TranscriptCue, error) {
+	var data timedTextJSON3
+	if err := json.Unmarshal(body, &data); err != nil {
+		return nil, errors.Wrap(err, "failed to parse timedtext response")
+	}
+
+	var cues []TranscriptCue
+	for _, event := range data.Events {
+		if len(event.Segs) == 0 {
+			continue
+		}
+
+		var text strings.Builder
+		for _, seg := range event.Segs {
+			text.WriteString(seg.UTF8)
+		}
+		// Auto-generated tracks split lines with newlines and pad words with spaces
+		cleaned := strings.Join(strings.Fields(text.String()), " ")
+		if cleaned == "" {
+			continue
+		}
+
+		cues = append(cues, TranscriptCue{
+			StartMs:    event.StartMs,
+			DurationMs: event.DurationMs,
+			Text:       cleaned,
+		})
+	}
+	return cues, nil
+}
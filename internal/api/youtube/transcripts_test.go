@@ -0,0 +1,102 @@
+package youtube
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+	"os"
+	"testing"
+)
+
+func recordedResponse(t *testing.T, name string) []byte {
+	t.Helper()
+	body, err := os.ReadFile("testdata/" + name)
+	if err != nil {
+		t.Fatalf("failed to read recorded response: %v", err)
+	}
+	return body
+}
+
+func TestPlayerCaptionsTracks(t *testing.T) {
+	var response DesktopPlayerResponse
+	if err := json.Unmarshal(recordedResponse(t, "player_captions.json"), &response); err != nil {
+		t.Fatalf("failed to parse recorded player response: %v", err)
+	}
+
+	tracks := response.Captions.tracks()
+	if len(tracks) != 3 {
+		t.Fatalf("expected 3 caption tracks, got %d", len(tracks))
+	}
+
+	want := []CaptionTrack{
+		{LanguageCode: "en", Name: "English", AutoGenerated: false},
+		{LanguageCode: "en", Name: "English (auto-generated)", AutoGenerated: true},
+		{LanguageCode: "de", Name: "German", AutoGenerated: false},
+	}
+	for i, track := range tracks {
+		if track.BaseURL == "" {
+			t.Fatalf("track %d: expected base url to be set", i)
+		}
+		if track.LanguageCode != want[i].LanguageCode || track.Name != want[i].Name || track.AutoGenerated != want[i].AutoGenerated {
+			t.Fatalf("track %d: expected %+v, got %+v", i, want[i], track)
+		}
+	}
+}
+
+func TestGetTranscript_RecordedResponse(t *testing.T) {
+	body := recordedResponse(t, "timedtext_json3.json")
+
+	var requested string
+	c := &client{
+		http: &http.Client{
+			Transport: roundTripFunc(func(req *http.Request) (*http.Response, error) {
+				requested = req.URL.String()
+				if req.URL.Query().Get("fmt") != "json3" {
+					t.Fatalf("expected fmt=json3, got %q", req.URL.Query().Get("fmt"))
+				}
+				return &http.Response{
+					StatusCode: http.StatusOK,
+					Body:       io.NopCloser(bytes.NewReader(body)),
+					Header:     make(http.Header),
+				}, nil
+			}),
+		},
+	}
+
+	cues, err := c.GetTranscript(CaptionTrack{BaseURL: "/api/timedtext?v=dQw4w9WgXcQ&lang=de", LanguageCode: "de"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if want := "https://www.youtube.com/api/timedtext?fmt=json3&lang=de&v=dQw4w9WgXcQ"; requested != want {
+		t.Fatalf("expected request to %q, got %q", want, requested)
+	}
+
+	want := []TranscriptCue{
+		{StartMs: 18800, DurationMs: 3120, Text: "We're no strangers to love"},
+		{StartMs: 22640, DurationMs: 4160, Text: "You know the rules and so do I"},
+		{StartMs: 27040, DurationMs: 4400, Text: "A full commitment's what I'm thinking of"},
+	}
+	if len(cues) != len(want) {
+		t.Fatalf("expected %d cues, got %d: %+v", len(want), len(cues), cues)
+	}
+	for i := range want {
+		if cues[i] != want[i] {
+			t.Fatalf("cue %d: expected %+v, got %+v", i, want[i], cues[i])
+		}
+	}
+}
+
+func TestGetTranscript_BadStatus(t *testing.T) {
+	c := &client{
+		http: &http.Client{
+			Transport: roundTripFunc(func(req *http.Request) (*http.Response, error) {
+				return mockResponse(http.StatusTooManyRequests), nil
+			}),
+		},
+	}
+
+	if _, err := c.GetTranscript(CaptionTrack{BaseURL: "https://www.youtube.com/api/timedtext?v=id&lang=en"}); err == nil {
+		t.Fatal("expected an error for a non-200 response")
+	}
+}
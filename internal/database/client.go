@@ -15,16 +15,30 @@ type Client interface {
 	ChannelsClient
 	VideosClient
 	ViewsClient
+	VideoChaptersClient
+	VideoTranscriptsClient
+	SponsorBlockSegmentsClient
+	SponsorBlockSubmissionsClient
+	VideoBrandingClient
 
 	UsersClient
 	SettingsClient
 	SubscriptionsClient
 
 	PlaylistsClient
+	SmartPlaylistsClient
+	PlaylistShareLinksClient
+	WatchHistoryImportsClient
 
 	ConfigurationClient
 	YouTubeSyncClient
+	YouTubeSyncTargetsClient
 	YouTubeTVSyncClient
+	YouTubeTVSyncEventsClient
+	YouTubeTVPairingTokensClient
+
+	LeasesClient
+	JobsClient
 
 	Close() error
 }
@@ -0,0 +1,999 @@
+// Code generated by tracegen. DO NOT EDIT.
+
+package database
+
+import (
+	"context"
+	"time"
+
+	"github.com/aarondl/null/v8"
+	"github.com/cufee/feedlr-yt/internal/database/models"
+	"github.com/cufee/feedlr-yt/internal/tracing"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+var _ Client = &tracedClient{}
+
+func (c *tracedClient) AcquireLease(ctx context.Context, name string, holder string, ttl time.Duration) (bool, error) {
+	ctx, span := tracing.Start(ctx, "database.AcquireLease", attribute.String("db.system", "sqlite"))
+	r0, r1 := c.next.AcquireLease(ctx, name, holder, ttl)
+	endSpan(span, r1)
+	return r0, r1
+}
+
+func (c *tracedClient) AddPlaylistItem(ctx context.Context, playlistID string, videoID string) error {
+	ctx, span := tracing.Start(ctx, "database.AddPlaylistItem", attribute.String("db.system", "sqlite"))
+	r0 := c.next.AddPlaylistItem(ctx, playlistID, videoID)
+	endSpan(span, r0)
+	return r0
+}
+
+func (c *tracedClient) AddPlaylistItemAtPosition(ctx context.Context, playlistID string, videoID string, position int) error {
+	ctx, span := tracing.Start(ctx, "database.AddPlaylistItemAtPosition", attribute.String("db.system", "sqlite"))
+	r0 := c.next.AddPlaylistItemAtPosition(ctx, playlistID, videoID, position)
+	endSpan(span, r0)
+	return r0
+}
+
+func (c *tracedClient) ClaimJob(ctx context.Context, holder string, kinds []string, lockTTL time.Duration) (*Job, error) {
+	ctx, span := tracing.Start(ctx, "database.ClaimJob", attribute.String("db.system", "sqlite"))
+	r0, r1 := c.next.ClaimJob(ctx, holder, kinds, lockTTL)
+	endSpan(span, r1)
+	return r0, r1
+}
+
+func (c *tracedClient) CleanupExpiredPlaylistItems(ctx context.Context) (int64, error) {
+	ctx, span := tracing.Start(ctx, "database.CleanupExpiredPlaylistItems", attribute.String("db.system", "sqlite"))
+	r0, r1 := c.next.CleanupExpiredPlaylistItems(ctx)
+	endSpan(span, r1)
+	return r0, r1
+}
+
+func (c *tracedClient) Close() error {
+	return c.next.Close()
+}
+
+func (c *tracedClient) CompleteJob(ctx context.Context, id string, holder string) error {
+	ctx, span := tracing.Start(ctx, "database.CompleteJob", attribute.String("db.system", "sqlite"))
+	r0 := c.next.CompleteJob(ctx, id, holder)
+	endSpan(span, r0)
+	return r0
+}
+
+func (c *tracedClient) CountJobs(ctx context.Context) (map[string]int, error) {
+	ctx, span := tracing.Start(ctx, "database.CountJobs", attribute.String("db.system", "sqlite"))
+	r0, r1 := c.next.CountJobs(ctx)
+	endSpan(span, r1)
+	return r0, r1
+}
+
+func (c *tracedClient) CreateConfiguration(ctx context.Context, key string, value []byte) (*models.AppConfiguration, error) {
+	ctx, span := tracing.Start(ctx, "database.CreateConfiguration", attribute.String("db.system", "sqlite"))
+	r0, r1 := c.next.CreateConfiguration(ctx, key, value)
+	endSpan(span, r1)
+	return r0, r1
+}
+
+func (c *tracedClient) CreatePlaylist(ctx context.Context, playlist *models.Playlist) error {
+	ctx, span := tracing.Start(ctx, "database.CreatePlaylist", attribute.String("db.system", "sqlite"))
+	r0 := c.next.CreatePlaylist(ctx, playlist)
+	endSpan(span, r0)
+	return r0
+}
+
+func (c *tracedClient) CreatePlaylistShareLink(ctx context.Context, link *models.PlaylistShareLink) error {
+	ctx, span := tracing.Start(ctx, "database.CreatePlaylistShareLink", attribute.String("db.system", "sqlite"))
+	r0 := c.next.CreatePlaylistShareLink(ctx, link)
+	endSpan(span, r0)
+	return r0
+}
+
+func (c *tracedClient) CreateSession(ctx context.Context, data *models.Session) (*models.Session, error) {
+	ctx, span := tracing.Start(ctx, "database.CreateSession", attribute.String("db.system", "sqlite"))
+	r0, r1 := c.next.CreateSession(ctx, data)
+	endSpan(span, r1)
+	return r0, r1
+}
+
+func (c *tracedClient) CreateSponsorBlockSubmission(ctx context.Context, submission *SponsorBlockSubmission) error {
+	ctx, span := tracing.Start(ctx, "database.CreateSponsorBlockSubmission", attribute.String("db.system", "sqlite"))
+	r0 := c.next.CreateSponsorBlockSubmission(ctx, submission)
+	endSpan(span, r0)
+	return r0
+}
+
+func (c *tracedClient) CreateUser(ctx context.Context, userID string, username string) (*models.User, error) {
+	ctx, span := tracing.Start(ctx, "database.CreateUser", attribute.String("db.system", "sqlite"))
+	r0, r1 := c.next.CreateUser(ctx, userID, username)
+	endSpan(span, r1)
+	return r0, r1
+}
+
+func (c *tracedClient) CreateWatchHistoryImport(ctx context.Context, record *models.WatchHistoryImport) error {
+	ctx, span := tracing.Start(ctx, "database.CreateWatchHistoryImport", attribute.String("db.system", "sqlite"))
+	r0 := c.next.CreateWatchHistoryImport(ctx, record)
+	endSpan(span, r0)
+	return r0
+}
+
+func (c *tracedClient) CreateYouTubeSyncTarget(ctx context.Context, target *models.YoutubeSyncTarget) error {
+	ctx, span := tracing.Start(ctx, "database.CreateYouTubeSyncTarget", attribute.String("db.system", "sqlite"))
+	r0 := c.next.CreateYouTubeSyncTarget(ctx, target)
+	endSpan(span, r0)
+	return r0
+}
+
+func (c *tracedClient) CreateYouTubeTVPairingToken(ctx context.Context, userID string, tokenHash string, expiresAt time.Time) error {
+	ctx, span := tracing.Start(ctx, "database.CreateYouTubeTVPairingToken", attribute.String("db.system", "sqlite"))
+	r0 := c.next.CreateYouTubeTVPairingToken(ctx, userID, tokenHash, expiresAt)
+	endSpan(span, r0)
+	return r0
+}
+
+func (c *tracedClient) CreateYouTubeTVSyncEvent(ctx context.Context, event *YouTubeTVSyncEvent) error {
+	ctx, span := tracing.Start(ctx, "database.CreateYouTubeTVSyncEvent", attribute.String("db.system", "sqlite"))
+	r0 := c.next.CreateYouTubeTVSyncEvent(ctx, event)
+	endSpan(span, r0)
+	return r0
+}
+
+func (c *tracedClient) DeleteExpiredSponsorBlockSegments(ctx context.Context, before time.Time) (int64, error) {
+	ctx, span := tracing.Start(ctx, "database.DeleteExpiredSponsorBlockSegments", attribute.String("db.system", "sqlite"))
+	r0, r1 := c.next.DeleteExpiredSponsorBlockSegments(ctx, before)
+	endSpan(span, r1)
+	return r0, r1
+}
+
+func (c *tracedClient) DeleteExpiredVideoBranding(ctx context.Context, before time.Time) (int64, error) {
+	ctx, span := tracing.Start(ctx, "database.DeleteExpiredVideoBranding", attribute.String("db.system", "sqlite"))
+	r0, r1 := c.next.DeleteExpiredVideoBranding(ctx, before)
+	endSpan(span, r1)
+	return r0, r1
+}
+
+func (c *tracedClient) DeletePlaylist(ctx context.Context, playlistID string) error {
+	ctx, span := tracing.Start(ctx, "database.DeletePlaylist", attribute.String("db.system", "sqlite"))
+	r0 := c.next.DeletePlaylist(ctx, playlistID)
+	endSpan(span, r0)
+	return r0
+}
+
+func (c *tracedClient) DeletePlaylistShareLink(ctx context.Context, playlistID string, linkID string) error {
+	ctx, span := tracing.Start(ctx, "database.DeletePlaylistShareLink", attribute.String("db.system", "sqlite"))
+	r0 := c.next.DeletePlaylistShareLink(ctx, playlistID, linkID)
+	endSpan(span, r0)
+	return r0
+}
+
+func (c *tracedClient) DeleteSession(ctx context.Context, id string) error {
+	ctx, span := tracing.Start(ctx, "database.DeleteSession", attribute.String("db.system", "sqlite"))
+	r0 := c.next.DeleteSession(ctx, id)
+	endSpan(span, r0)
+	return r0
+}
+
+func (c *tracedClient) DeleteSubscription(ctx context.Context, userID string, channelID string) error {
+	ctx, span := tracing.Start(ctx, "database.DeleteSubscription", attribute.String("db.system", "sqlite"))
+	r0 := c.next.DeleteSubscription(ctx, userID, channelID)
+	endSpan(span, r0)
+	return r0
+}
+
+func (c *tracedClient) DeleteUserPasskey(ctx context.Context, userID string, id string) error {
+	ctx, span := tracing.Start(ctx, "database.DeleteUserPasskey", attribute.String("db.system", "sqlite"))
+	r0 := c.next.DeleteUserPasskey(ctx, userID, id)
+	endSpan(span, r0)
+	return r0
+}
+
+func (c *tracedClient) DeleteWatchHistoryImport(ctx context.Context, id string) error {
+	ctx, span := tracing.Start(ctx, "database.DeleteWatchHistoryImport", attribute.String("db.system", "sqlite"))
+	r0 := c.next.DeleteWatchHistoryImport(ctx, id)
+	endSpan(span, r0)
+	return r0
+}
+
+func (c *tracedClient) DeleteYouTubeSyncAccount(ctx context.Context, userID string) error {
+	ctx, span := tracing.Start(ctx, "database.DeleteYouTubeSyncAccount", attribute.String("db.system", "sqlite"))
+	r0 := c.next.DeleteYouTubeSyncAccount(ctx, userID)
+	endSpan(span, r0)
+	return r0
+}
+
+func (c *tracedClient) DeleteYouTubeSyncTarget(ctx context.Context, id string) error {
+	ctx, span := tracing.Start(ctx, "database.DeleteYouTubeSyncTarget", attribute.String("db.system", "sqlite"))
+	r0 := c.next.DeleteYouTubeSyncTarget(ctx, id)
+	endSpan(span, r0)
+	return r0
+}
+
+func (c *tracedClient) DeleteYouTubeTVPairingTokens(ctx context.Context, userID string) error {
+	ctx, span := tracing.Start(ctx, "database.DeleteYouTubeTVPairingTokens", attribute.String("db.system", "sqlite"))
+	r0 := c.next.DeleteYouTubeTVPairingTokens(ctx, userID)
+	endSpan(span, r0)
+	return r0
+}
+
+func (c *tracedClient) DeleteYouTubeTVSyncAccount(ctx context.Context, id string) error {
+	ctx, span := tracing.Start(ctx, "database.DeleteYouTubeTVSyncAccount", attribute.String("db.system", "sqlite"))
+	r0 := c.next.DeleteYouTubeTVSyncAccount(ctx, id)
+	endSpan(span, r0)
+	return r0
+}
+
+func (c *tracedClient) EnqueueJob(ctx context.Context, job *Job) (bool, error) {
+	ctx, span := tracing.Start(ctx, "database.EnqueueJob", attribute.String("db.system", "sqlite"))
+	r0, r1 := c.next.EnqueueJob(ctx, job)
+	endSpan(span, r1)
+	return r0, r1
+}
+
+func (c *tracedClient) ExtendJobLock(ctx context.Context, id string, holder string, lockTTL time.Duration) (bool, error) {
+	ctx, span := tracing.Start(ctx, "database.ExtendJobLock", attribute.String("db.system", "sqlite"))
+	r0, r1 := c.next.ExtendJobLock(ctx, id, holder, lockTTL)
+	endSpan(span, r1)
+	return r0, r1
+}
+
+func (c *tracedClient) FailJob(ctx context.Context, id string, holder string, lastErr string) error {
+	ctx, span := tracing.Start(ctx, "database.FailJob", attribute.String("db.system", "sqlite"))
+	r0 := c.next.FailJob(ctx, id, holder, lastErr)
+	endSpan(span, r0)
+	return r0
+}
+
+func (c *tracedClient) FindSmartPlaylistVideos(ctx context.Context, query SmartPlaylistQuery) ([]*models.Video, error) {
+	ctx, span := tracing.Start(ctx, "database.FindSmartPlaylistVideos", attribute.String("db.system", "sqlite"))
+	r0, r1 := c.next.FindSmartPlaylistVideos(ctx, query)
+	endSpan(span, r1)
+	return r0, r1
+}
+
+func (c *tracedClient) FindSubscription(ctx context.Context, userID string, channelID string, opts ...SubscriptionQuery) (*models.Subscription, error) {
+	ctx, span := tracing.Start(ctx, "database.FindSubscription", attribute.String("db.system", "sqlite"))
+	r0, r1 := c.next.FindSubscription(ctx, userID, channelID, opts...)
+	endSpan(span, r1)
+	return r0, r1
+}
+
+func (c *tracedClient) FindUser(ctx context.Context, username string) (*models.User, error) {
+	ctx, span := tracing.Start(ctx, "database.FindUser", attribute.String("db.system", "sqlite"))
+	r0, r1 := c.next.FindUser(ctx, username)
+	endSpan(span, r1)
+	return r0, r1
+}
+
+func (c *tracedClient) FindVideos(ctx context.Context, o ...VideoQuery) ([]*models.Video, error) {
+	ctx, span := tracing.Start(ctx, "database.FindVideos", attribute.String("db.system", "sqlite"))
+	r0, r1 := c.next.FindVideos(ctx, o...)
+	endSpan(span, r1)
+	return r0, r1
+}
+
+func (c *tracedClient) FinishWatchHistoryImport(ctx context.Context, id string, status string, lastError string) error {
+	ctx, span := tracing.Start(ctx, "database.FinishWatchHistoryImport", attribute.String("db.system", "sqlite"))
+	r0 := c.next.FinishWatchHistoryImport(ctx, id, status, lastError)
+	endSpan(span, r0)
+	return r0
+}
+
+func (c *tracedClient) GetChannel(ctx context.Context, channelId string, opts ...ChannelQuery) (*models.Channel, error) {
+	ctx, span := tracing.Start(ctx, "database.GetChannel", attribute.String("db.system", "sqlite"))
+	r0, r1 := c.next.GetChannel(ctx, channelId, opts...)
+	endSpan(span, r1)
+	return r0, r1
+}
+
+func (c *tracedClient) GetChannels(ctx context.Context, opts ...ChannelQuery) ([]*models.Channel, error) {
+	ctx, span := tracing.Start(ctx, "database.GetChannels", attribute.String("db.system", "sqlite"))
+	r0, r1 := c.next.GetChannels(ctx, opts...)
+	endSpan(span, r1)
+	return r0, r1
+}
+
+func (c *tracedClient) GetChannelsForUpdate(ctx context.Context) ([]string, error) {
+	ctx, span := tracing.Start(ctx, "database.GetChannelsForUpdate", attribute.String("db.system", "sqlite"))
+	r0, r1 := c.next.GetChannelsForUpdate(ctx)
+	endSpan(span, r1)
+	return r0, r1
+}
+
+func (c *tracedClient) GetConfiguration(ctx context.Context, id string) (*models.AppConfiguration, error) {
+	ctx, span := tracing.Start(ctx, "database.GetConfiguration", attribute.String("db.system", "sqlite"))
+	r0, r1 := c.next.GetConfiguration(ctx, id)
+	endSpan(span, r1)
+	return r0, r1
+}
+
+func (c *tracedClient) GetDueSponsorBlockSubmissions(ctx context.Context, before time.Time, limit int) ([]*SponsorBlockSubmission, error) {
+	ctx, span := tracing.Start(ctx, "database.GetDueSponsorBlockSubmissions", attribute.String("db.system", "sqlite"))
+	r0, r1 := c.next.GetDueSponsorBlockSubmissions(ctx, before, limit)
+	endSpan(span, r1)
+	return r0, r1
+}
+
+func (c *tracedClient) GetJob(ctx context.Context, id string) (*Job, error) {
+	ctx, span := tracing.Start(ctx, "database.GetJob", attribute.String("db.system", "sqlite"))
+	r0, r1 := c.next.GetJob(ctx, id)
+	endSpan(span, r1)
+	return r0, r1
+}
+
+func (c *tracedClient) GetLatestWatchHistoryImport(ctx context.Context, userID string) (*models.WatchHistoryImport, error) {
+	ctx, span := tracing.Start(ctx, "database.GetLatestWatchHistoryImport", attribute.String("db.system", "sqlite"))
+	r0, r1 := c.next.GetLatestWatchHistoryImport(ctx, userID)
+	endSpan(span, r1)
+	return r0, r1
+}
+
+func (c *tracedClient) GetMaxPlaylistItemPosition(ctx context.Context, playlistID string) (int, error) {
+	ctx, span := tracing.Start(ctx, "database.GetMaxPlaylistItemPosition", attribute.String("db.system", "sqlite"))
+	r0, r1 := c.next.GetMaxPlaylistItemPosition(ctx, playlistID)
+	endSpan(span, r1)
+	return r0, r1
+}
+
+func (c *tracedClient) GetPlaylistByID(ctx context.Context, playlistID string) (*models.Playlist, error) {
+	ctx, span := tracing.Start(ctx, "database.GetPlaylistByID", attribute.String("db.system", "sqlite"))
+	r0, r1 := c.next.GetPlaylistByID(ctx, playlistID)
+	endSpan(span, r1)
+	return r0, r1
+}
+
+func (c *tracedClient) GetPlaylistBySlug(ctx context.Context, userID string, slug string) (*models.Playlist, error) {
+	ctx, span := tracing.Start(ctx, "database.GetPlaylistBySlug", attribute.String("db.system", "sqlite"))
+	r0, r1 := c.next.GetPlaylistBySlug(ctx, userID, slug)
+	endSpan(span, r1)
+	return r0, r1
+}
+
+func (c *tracedClient) GetPlaylistFirstVideoID(ctx context.Context, playlistID string) (string, error) {
+	ctx, span := tracing.Start(ctx, "database.GetPlaylistFirstVideoID", attribute.String("db.system", "sqlite"))
+	r0, r1 := c.next.GetPlaylistFirstVideoID(ctx, playlistID)
+	endSpan(span, r1)
+	return r0, r1
+}
+
+func (c *tracedClient) GetPlaylistItemByVideoID(ctx context.Context, playlistID string, videoID string) (*models.PlaylistItem, error) {
+	ctx, span := tracing.Start(ctx, "database.GetPlaylistItemByVideoID", attribute.String("db.system", "sqlite"))
+	r0, r1 := c.next.GetPlaylistItemByVideoID(ctx, playlistID, videoID)
+	endSpan(span, r1)
+	return r0, r1
+}
+
+func (c *tracedClient) GetPlaylistItemCount(ctx context.Context, playlistID string) (int64, error) {
+	ctx, span := tracing.Start(ctx, "database.GetPlaylistItemCount", attribute.String("db.system", "sqlite"))
+	r0, r1 := c.next.GetPlaylistItemCount(ctx, playlistID)
+	endSpan(span, r1)
+	return r0, r1
+}
+
+func (c *tracedClient) GetPlaylistItems(ctx context.Context, playlistID string, o ...PlaylistItemQuery) ([]*models.PlaylistItem, error) {
+	ctx, span := tracing.Start(ctx, "database.GetPlaylistItems", attribute.String("db.system", "sqlite"))
+	r0, r1 := c.next.GetPlaylistItems(ctx, playlistID, o...)
+	endSpan(span, r1)
+	return r0, r1
+}
+
+func (c *tracedClient) GetPlaylistShareLinkByTokenHash(ctx context.Context, tokenHash string) (*models.PlaylistShareLink, error) {
+	ctx, span := tracing.Start(ctx, "database.GetPlaylistShareLinkByTokenHash", attribute.String("db.system", "sqlite"))
+	r0, r1 := c.next.GetPlaylistShareLinkByTokenHash(ctx, tokenHash)
+	endSpan(span, r1)
+	return r0, r1
+}
+
+func (c *tracedClient) GetPlaylistShareLinks(ctx context.Context, playlistID string) ([]*models.PlaylistShareLink, error) {
+	ctx, span := tracing.Start(ctx, "database.GetPlaylistShareLinks", attribute.String("db.system", "sqlite"))
+	r0, r1 := c.next.GetPlaylistShareLinks(ctx, playlistID)
+	endSpan(span, r1)
+	return r0, r1
+}
+
+func (c *tracedClient) GetPlaylistsDueForSync(ctx context.Context, now time.Time, limit int) ([]*models.Playlist, error) {
+	ctx, span := tracing.Start(ctx, "database.GetPlaylistsDueForSync", attribute.String("db.system", "sqlite"))
+	r0, r1 := c.next.GetPlaylistsDueForSync(ctx, now, limit)
+	endSpan(span, r1)
+	return r0, r1
+}
+
+func (c *tracedClient) GetRecentUserViews(ctx context.Context, userID string, limit int) ([]*models.View, error) {
+	ctx, span := tracing.Start(ctx, "database.GetRecentUserViews", attribute.String("db.system", "sqlite"))
+	r0, r1 := c.next.GetRecentUserViews(ctx, userID, limit)
+	endSpan(span, r1)
+	return r0, r1
+}
+
+func (c *tracedClient) GetSession(ctx context.Context, id string) (*models.Session, error) {
+	ctx, span := tracing.Start(ctx, "database.GetSession", attribute.String("db.system", "sqlite"))
+	r0, r1 := c.next.GetSession(ctx, id)
+	endSpan(span, r1)
+	return r0, r1
+}
+
+func (c *tracedClient) GetSponsorBlockSegments(ctx context.Context, videoIDs ...string) (map[string]*SponsorBlockVideoSegments, error) {
+	ctx, span := tracing.Start(ctx, "database.GetSponsorBlockSegments", attribute.String("db.system", "sqlite"))
+	r0, r1 := c.next.GetSponsorBlockSegments(ctx, videoIDs...)
+	endSpan(span, r1)
+	return r0, r1
+}
+
+func (c *tracedClient) GetSubscription(ctx context.Context, id string, opts ...SubscriptionQuery) (*models.Subscription, error) {
+	ctx, span := tracing.Start(ctx, "database.GetSubscription", attribute.String("db.system", "sqlite"))
+	r0, r1 := c.next.GetSubscription(ctx, id, opts...)
+	endSpan(span, r1)
+	return r0, r1
+}
+
+func (c *tracedClient) GetUser(ctx context.Context, id string) (*models.User, error) {
+	ctx, span := tracing.Start(ctx, "database.GetUser", attribute.String("db.system", "sqlite"))
+	r0, r1 := c.next.GetUser(ctx, id)
+	endSpan(span, r1)
+	return r0, r1
+}
+
+func (c *tracedClient) GetUserLastSessionActivity(ctx context.Context, userID string) (null.Time, error) {
+	ctx, span := tracing.Start(ctx, "database.GetUserLastSessionActivity", attribute.String("db.system", "sqlite"))
+	r0, r1 := c.next.GetUserLastSessionActivity(ctx, userID)
+	endSpan(span, r1)
+	return r0, r1
+}
+
+func (c *tracedClient) GetUserPasskeys(ctx context.Context, userID string) ([]*models.Passkey, error) {
+	ctx, span := tracing.Start(ctx, "database.GetUserPasskeys", attribute.String("db.system", "sqlite"))
+	r0, r1 := c.next.GetUserPasskeys(ctx, userID)
+	endSpan(span, r1)
+	return r0, r1
+}
+
+func (c *tracedClient) GetUserPlaylists(ctx context.Context, userID string) ([]*models.Playlist, error) {
+	ctx, span := tracing.Start(ctx, "database.GetUserPlaylists", attribute.String("db.system", "sqlite"))
+	r0, r1 := c.next.GetUserPlaylists(ctx, userID)
+	endSpan(span, r1)
+	return r0, r1
+}
+
+func (c *tracedClient) GetUserSettings(ctx context.Context, userID string) (*models.Setting, error) {
+	ctx, span := tracing.Start(ctx, "database.GetUserSettings", attribute.String("db.system", "sqlite"))
+	r0, r1 := c.next.GetUserSettings(ctx, userID)
+	endSpan(span, r1)
+	return r0, r1
+}
+
+func (c *tracedClient) GetUserSponsorBlockSubmissions(ctx context.Context, userID string, videoID string) ([]*SponsorBlockSubmission, error) {
+	ctx, span := tracing.Start(ctx, "database.GetUserSponsorBlockSubmissions", attribute.String("db.system", "sqlite"))
+	r0, r1 := c.next.GetUserSponsorBlockSubmissions(ctx, userID, videoID)
+	endSpan(span, r1)
+	return r0, r1
+}
+
+func (c *tracedClient) GetUserViews(ctx context.Context, userID string, videoID ...string) ([]*models.View, error) {
+	ctx, span := tracing.Start(ctx, "database.GetUserViews", attribute.String("db.system", "sqlite"))
+	r0, r1 := c.next.GetUserViews(ctx, userID, videoID...)
+	endSpan(span, r1)
+	return r0, r1
+}
+
+func (c *tracedClient) GetVideoBranding(ctx context.Context, videoIDs ...string) (map[string]*DeArrowVideoBranding, error) {
+	ctx, span := tracing.Start(ctx, "database.GetVideoBranding", attribute.String("db.system", "sqlite"))
+	r0, r1 := c.next.GetVideoBranding(ctx, videoIDs...)
+	endSpan(span, r1)
+	return r0, r1
+}
+
+func (c *tracedClient) GetVideoByID(ctx context.Context, id string, o ...VideoQuery) (*models.Video, error) {
+	ctx, span := tracing.Start(ctx, "database.GetVideoByID", attribute.String("db.system", "sqlite"))
+	r0, r1 := c.next.GetVideoByID(ctx, id, o...)
+	endSpan(span, r1)
+	return r0, r1
+}
+
+func (c *tracedClient) GetVideoChapters(ctx context.Context, videoID string) ([]*VideoChapter, error) {
+	ctx, span := tracing.Start(ctx, "database.GetVideoChapters", attribute.String("db.system", "sqlite"))
+	r0, r1 := c.next.GetVideoChapters(ctx, videoID)
+	endSpan(span, r1)
+	return r0, r1
+}
+
+func (c *tracedClient) GetVideoTranscripts(ctx context.Context, videoID string) ([]*VideoTranscript, error) {
+	ctx, span := tracing.Start(ctx, "database.GetVideoTranscripts", attribute.String("db.system", "sqlite"))
+	r0, r1 := c.next.GetVideoTranscripts(ctx, videoID)
+	endSpan(span, r1)
+	return r0, r1
+}
+
+func (c *tracedClient) GetVideosMissingTranscripts(ctx context.Context, recheckSince time.Time, recheckBefore time.Time, limit int) ([]string, error) {
+	ctx, span := tracing.Start(ctx, "database.GetVideosMissingTranscripts", attribute.String("db.system", "sqlite"))
+	r0, r1 := c.next.GetVideosMissingTranscripts(ctx, recheckSince, recheckBefore, limit)
+	endSpan(span, r1)
+	return r0, r1
+}
+
+func (c *tracedClient) GetWatchHistoryImport(ctx context.Context, id string) (*models.WatchHistoryImport, error) {
+	ctx, span := tracing.Start(ctx, "database.GetWatchHistoryImport", attribute.String("db.system", "sqlite"))
+	r0, r1 := c.next.GetWatchHistoryImport(ctx, id)
+	endSpan(span, r1)
+	return r0, r1
+}
+
+func (c *tracedClient) GetYouTubeSyncAccountByUserID(ctx context.Context, userID string) (*models.YoutubeSyncAccount, error) {
+	ctx, span := tracing.Start(ctx, "database.GetYouTubeSyncAccountByUserID", attribute.String("db.system", "sqlite"))
+	r0, r1 := c.next.GetYouTubeSyncAccountByUserID(ctx, userID)
+	endSpan(span, r1)
+	return r0, r1
+}
+
+func (c *tracedClient) GetYouTubeSyncTarget(ctx context.Context, id string) (*models.YoutubeSyncTarget, error) {
+	ctx, span := tracing.Start(ctx, "database.GetYouTubeSyncTarget", attribute.String("db.system", "sqlite"))
+	r0, r1 := c.next.GetYouTubeSyncTarget(ctx, id)
+	endSpan(span, r1)
+	return r0, r1
+}
+
+func (c *tracedClient) GetYouTubeTVPairingTokenUser(ctx context.Context, tokenHash string) (string, error) {
+	ctx, span := tracing.Start(ctx, "database.GetYouTubeTVPairingTokenUser", attribute.String("db.system", "sqlite"))
+	r0, r1 := c.next.GetYouTubeTVPairingTokenUser(ctx, tokenHash)
+	endSpan(span, r1)
+	return r0, r1
+}
+
+func (c *tracedClient) GetYouTubeTVSyncAccount(ctx context.Context, id string) (*YouTubeTVSyncAccount, error) {
+	ctx, span := tracing.Start(ctx, "database.GetYouTubeTVSyncAccount", attribute.String("db.system", "sqlite"))
+	r0, r1 := c.next.GetYouTubeTVSyncAccount(ctx, id)
+	endSpan(span, r1)
+	return r0, r1
+}
+
+func (c *tracedClient) InsertViews(ctx context.Context, views ...*models.View) (int, error) {
+	ctx, span := tracing.Start(ctx, "database.InsertViews", attribute.String("db.system", "sqlite"))
+	r0, r1 := c.next.InsertViews(ctx, views...)
+	endSpan(span, r1)
+	return r0, r1
+}
+
+func (c *tracedClient) IsVideoInPlaylist(ctx context.Context, playlistID string, videoID string) (bool, error) {
+	ctx, span := tracing.Start(ctx, "database.IsVideoInPlaylist", attribute.String("db.system", "sqlite"))
+	r0, r1 := c.next.IsVideoInPlaylist(ctx, playlistID, videoID)
+	endSpan(span, r1)
+	return r0, r1
+}
+
+func (c *tracedClient) ListEnabledYouTubeSyncAccounts(ctx context.Context, limit int) ([]*models.YoutubeSyncAccount, error) {
+	ctx, span := tracing.Start(ctx, "database.ListEnabledYouTubeSyncAccounts", attribute.String("db.system", "sqlite"))
+	r0, r1 := c.next.ListEnabledYouTubeSyncAccounts(ctx, limit)
+	endSpan(span, r1)
+	return r0, r1
+}
+
+func (c *tracedClient) ListEnabledYouTubeTVSyncAccounts(ctx context.Context, limit int) ([]*YouTubeTVSyncAccount, error) {
+	ctx, span := tracing.Start(ctx, "database.ListEnabledYouTubeTVSyncAccounts", attribute.String("db.system", "sqlite"))
+	r0, r1 := c.next.ListEnabledYouTubeTVSyncAccounts(ctx, limit)
+	endSpan(span, r1)
+	return r0, r1
+}
+
+func (c *tracedClient) ListJobs(ctx context.Context, status string, limit int) ([]*Job, error) {
+	ctx, span := tracing.Start(ctx, "database.ListJobs", attribute.String("db.system", "sqlite"))
+	r0, r1 := c.next.ListJobs(ctx, status, limit)
+	endSpan(span, r1)
+	return r0, r1
+}
+
+func (c *tracedClient) ListLeases(ctx context.Context) ([]*Lease, error) {
+	ctx, span := tracing.Start(ctx, "database.ListLeases", attribute.String("db.system", "sqlite"))
+	r0, r1 := c.next.ListLeases(ctx)
+	endSpan(span, r1)
+	return r0, r1
+}
+
+func (c *tracedClient) ListRecentYouTubeTVSyncEvents(ctx context.Context, limit int) ([]*YouTubeTVSyncEvent, error) {
+	ctx, span := tracing.Start(ctx, "database.ListRecentYouTubeTVSyncEvents", attribute.String("db.system", "sqlite"))
+	r0, r1 := c.next.ListRecentYouTubeTVSyncEvents(ctx, limit)
+	endSpan(span, r1)
+	return r0, r1
+}
+
+func (c *tracedClient) ListUserYouTubeTVSyncAccounts(ctx context.Context, userID string) ([]*YouTubeTVSyncAccount, error) {
+	ctx, span := tracing.Start(ctx, "database.ListUserYouTubeTVSyncAccounts", attribute.String("db.system", "sqlite"))
+	r0, r1 := c.next.ListUserYouTubeTVSyncAccounts(ctx, userID)
+	endSpan(span, r1)
+	return r0, r1
+}
+
+func (c *tracedClient) ListYouTubeSyncAccountsBySecretHash(ctx context.Context, secretHash string) ([]*models.YoutubeSyncAccount, error) {
+	ctx, span := tracing.Start(ctx, "database.ListYouTubeSyncAccountsBySecretHash", attribute.String("db.system", "sqlite"))
+	r0, r1 := c.next.ListYouTubeSyncAccountsBySecretHash(ctx, secretHash)
+	endSpan(span, r1)
+	return r0, r1
+}
+
+func (c *tracedClient) ListYouTubeSyncTargets(ctx context.Context, userID string) ([]*models.YoutubeSyncTarget, error) {
+	ctx, span := tracing.Start(ctx, "database.ListYouTubeSyncTargets", attribute.String("db.system", "sqlite"))
+	r0, r1 := c.next.ListYouTubeSyncTargets(ctx, userID)
+	endSpan(span, r1)
+	return r0, r1
+}
+
+func (c *tracedClient) ListYouTubeTVSyncAccountsBySecretHash(ctx context.Context, secretHash string) ([]*YouTubeTVSyncAccount, error) {
+	ctx, span := tracing.Start(ctx, "database.ListYouTubeTVSyncAccountsBySecretHash", attribute.String("db.system", "sqlite"))
+	r0, r1 := c.next.ListYouTubeTVSyncAccountsBySecretHash(ctx, secretHash)
+	endSpan(span, r1)
+	return r0, r1
+}
+
+func (c *tracedClient) ListYouTubeTVSyncEvents(ctx context.Context, accountID string, limit int) ([]*YouTubeTVSyncEvent, error) {
+	ctx, span := tracing.Start(ctx, "database.ListYouTubeTVSyncEvents", attribute.String("db.system", "sqlite"))
+	r0, r1 := c.next.ListYouTubeTVSyncEvents(ctx, accountID, limit)
+	endSpan(span, r1)
+	return r0, r1
+}
+
+func (c *tracedClient) MovePlaylistItemToIndex(ctx context.Context, playlistID string, videoID string, index int) error {
+	ctx, span := tracing.Start(ctx, "database.MovePlaylistItemToIndex", attribute.String("db.system", "sqlite"))
+	r0 := c.next.MovePlaylistItemToIndex(ctx, playlistID, videoID, index)
+	endSpan(span, r0)
+	return r0
+}
+
+func (c *tracedClient) NewSubscription(ctx context.Context, userID string, channelID string) (*models.Subscription, error) {
+	ctx, span := tracing.Start(ctx, "database.NewSubscription", attribute.String("db.system", "sqlite"))
+	r0, r1 := c.next.NewSubscription(ctx, userID, channelID)
+	endSpan(span, r1)
+	return r0, r1
+}
+
+func (c *tracedClient) PruneJobs(ctx context.Context, status string, finishedBefore time.Time) (int64, error) {
+	ctx, span := tracing.Start(ctx, "database.PruneJobs", attribute.String("db.system", "sqlite"))
+	r0, r1 := c.next.PruneJobs(ctx, status, finishedBefore)
+	endSpan(span, r1)
+	return r0, r1
+}
+
+func (c *tracedClient) PruneYouTubeTVSyncEvents(ctx context.Context, before time.Time, keepPerAccount int) (int64, error) {
+	ctx, span := tracing.Start(ctx, "database.PruneYouTubeTVSyncEvents", attribute.String("db.system", "sqlite"))
+	r0, r1 := c.next.PruneYouTubeTVSyncEvents(ctx, before, keepPerAccount)
+	endSpan(span, r1)
+	return r0, r1
+}
+
+func (c *tracedClient) ReleaseJob(ctx context.Context, id string, holder string) error {
+	ctx, span := tracing.Start(ctx, "database.ReleaseJob", attribute.String("db.system", "sqlite"))
+	r0 := c.next.ReleaseJob(ctx, id, holder)
+	endSpan(span, r0)
+	return r0
+}
+
+func (c *tracedClient) ReleaseLease(ctx context.Context, name string, holder string) error {
+	ctx, span := tracing.Start(ctx, "database.ReleaseLease", attribute.String("db.system", "sqlite"))
+	r0 := c.next.ReleaseLease(ctx, name, holder)
+	endSpan(span, r0)
+	return r0
+}
+
+func (c *tracedClient) RemovePlaylistItem(ctx context.Context, playlistID string, videoID string) error {
+	ctx, span := tracing.Start(ctx, "database.RemovePlaylistItem", attribute.String("db.system", "sqlite"))
+	r0 := c.next.RemovePlaylistItem(ctx, playlistID, videoID)
+	endSpan(span, r0)
+	return r0
+}
+
+func (c *tracedClient) ReplaceVideoChapters(ctx context.Context, videoID string, source string, chapters []*VideoChapter) error {
+	ctx, span := tracing.Start(ctx, "database.ReplaceVideoChapters", attribute.String("db.system", "sqlite"))
+	r0 := c.next.ReplaceVideoChapters(ctx, videoID, source, chapters)
+	endSpan(span, r0)
+	return r0
+}
+
+func (c *tracedClient) ReplaceYouTubeSyncRefreshToken(ctx context.Context, userID string, previous []byte, encryptedRefreshToken []byte, secretHash string) (bool, error) {
+	ctx, span := tracing.Start(ctx, "database.ReplaceYouTubeSyncRefreshToken", attribute.String("db.system", "sqlite"))
+	r0, r1 := c.next.ReplaceYouTubeSyncRefreshToken(ctx, userID, previous, encryptedRefreshToken, secretHash)
+	endSpan(span, r1)
+	return r0, r1
+}
+
+func (c *tracedClient) ReplaceYouTubeTVSyncLoungeToken(ctx context.Context, id string, previous []byte, loungeTokenEnc []byte, secretHash string) (bool, error) {
+	ctx, span := tracing.Start(ctx, "database.ReplaceYouTubeTVSyncLoungeToken", attribute.String("db.system", "sqlite"))
+	r0, r1 := c.next.ReplaceYouTubeTVSyncLoungeToken(ctx, id, previous, loungeTokenEnc, secretHash)
+	endSpan(span, r1)
+	return r0, r1
+}
+
+func (c *tracedClient) RequeueJob(ctx context.Context, id string) error {
+	ctx, span := tracing.Start(ctx, "database.RequeueJob", attribute.String("db.system", "sqlite"))
+	r0 := c.next.RequeueJob(ctx, id)
+	endSpan(span, r0)
+	return r0
+}
+
+func (c *tracedClient) RetryJob(ctx context.Context, id string, holder string, runAt time.Time, lastErr string) error {
+	ctx, span := tracing.Start(ctx, "database.RetryJob", attribute.String("db.system", "sqlite"))
+	r0 := c.next.RetryJob(ctx, id, holder, runAt, lastErr)
+	endSpan(span, r0)
+	return r0
+}
+
+func (c *tracedClient) SaveUserPasskey(ctx context.Context, key *models.Passkey) error {
+	ctx, span := tracing.Start(ctx, "database.SaveUserPasskey", attribute.String("db.system", "sqlite"))
+	r0 := c.next.SaveUserPasskey(ctx, key)
+	endSpan(span, r0)
+	return r0
+}
+
+func (c *tracedClient) SearchVideoTranscripts(ctx context.Context, userID string, terms []string, limit int) ([]VideoTranscriptSearchResult, error) {
+	ctx, span := tracing.Start(ctx, "database.SearchVideoTranscripts", attribute.String("db.system", "sqlite"))
+	r0, r1 := c.next.SearchVideoTranscripts(ctx, userID, terms, limit)
+	endSpan(span, r1)
+	return r0, r1
+}
+
+func (c *tracedClient) SetChannelFeedUpdatedAt(ctx context.Context, channelID string, updatedAt time.Time) error {
+	ctx, span := tracing.Start(ctx, "database.SetChannelFeedUpdatedAt", attribute.String("db.system", "sqlite"))
+	r0 := c.next.SetChannelFeedUpdatedAt(ctx, channelID, updatedAt)
+	endSpan(span, r0)
+	return r0
+}
+
+func (c *tracedClient) SetPlaylistItemOrder(ctx context.Context, playlistID string, videoIDs []string) error {
+	ctx, span := tracing.Start(ctx, "database.SetPlaylistItemOrder", attribute.String("db.system", "sqlite"))
+	r0 := c.next.SetPlaylistItemOrder(ctx, playlistID, videoIDs)
+	endSpan(span, r0)
+	return r0
+}
+
+func (c *tracedClient) SetPlaylistNextSyncAt(ctx context.Context, playlistID string, next null.Time) error {
+	ctx, span := tracing.Start(ctx, "database.SetPlaylistNextSyncAt", attribute.String("db.system", "sqlite"))
+	r0 := c.next.SetPlaylistNextSyncAt(ctx, playlistID, next)
+	endSpan(span, r0)
+	return r0
+}
+
+func (c *tracedClient) SetSessionExpiration(ctx context.Context, id string, expiresAt time.Time) (*models.Session, error) {
+	ctx, span := tracing.Start(ctx, "database.SetSessionExpiration", attribute.String("db.system", "sqlite"))
+	r0, r1 := c.next.SetSessionExpiration(ctx, id, expiresAt)
+	endSpan(span, r1)
+	return r0, r1
+}
+
+func (c *tracedClient) SetVideoTranscriptCheck(ctx context.Context, videoID string, trackCount int) error {
+	ctx, span := tracing.Start(ctx, "database.SetVideoTranscriptCheck", attribute.String("db.system", "sqlite"))
+	r0 := c.next.SetVideoTranscriptCheck(ctx, videoID, trackCount)
+	endSpan(span, r0)
+	return r0
+}
+
+func (c *tracedClient) SetYouTubeSyncAccountEnabled(ctx context.Context, userID string, enabled bool) error {
+	ctx, span := tracing.Start(ctx, "database.SetYouTubeSyncAccountEnabled", attribute.String("db.system", "sqlite"))
+	r0 := c.next.SetYouTubeSyncAccountEnabled(ctx, userID, enabled)
+	endSpan(span, r0)
+	return r0
+}
+
+func (c *tracedClient) SetYouTubeTVSyncAccountEnabled(ctx context.Context, id string, enabled bool) error {
+	ctx, span := tracing.Start(ctx, "database.SetYouTubeTVSyncAccountEnabled", attribute.String("db.system", "sqlite"))
+	r0 := c.next.SetYouTubeTVSyncAccountEnabled(ctx, id, enabled)
+	endSpan(span, r0)
+	return r0
+}
+
+func (c *tracedClient) SwapPlaylistItemPositions(ctx context.Context, playlistID string, videoID string, direction string) error {
+	ctx, span := tracing.Start(ctx, "database.SwapPlaylistItemPositions", attribute.String("db.system", "sqlite"))
+	r0 := c.next.SwapPlaylistItemPositions(ctx, playlistID, videoID, direction)
+	endSpan(span, r0)
+	return r0
+}
+
+func (c *tracedClient) TouchVideoUpdatedAt(ctx context.Context, id string) error {
+	ctx, span := tracing.Start(ctx, "database.TouchVideoUpdatedAt", attribute.String("db.system", "sqlite"))
+	r0 := c.next.TouchVideoUpdatedAt(ctx, id)
+	endSpan(span, r0)
+	return r0
+}
+
+func (c *tracedClient) UpdateConfiguration(ctx context.Context, config *models.AppConfiguration) error {
+	ctx, span := tracing.Start(ctx, "database.UpdateConfiguration", attribute.String("db.system", "sqlite"))
+	r0 := c.next.UpdateConfiguration(ctx, config)
+	endSpan(span, r0)
+	return r0
+}
+
+func (c *tracedClient) UpdatePlaylist(ctx context.Context, playlist *models.Playlist) error {
+	ctx, span := tracing.Start(ctx, "database.UpdatePlaylist", attribute.String("db.system", "sqlite"))
+	r0 := c.next.UpdatePlaylist(ctx, playlist)
+	endSpan(span, r0)
+	return r0
+}
+
+func (c *tracedClient) UpdatePlaylistAutoSync(ctx context.Context, playlistID string, options PlaylistAutoSyncOptions) error {
+	ctx, span := tracing.Start(ctx, "database.UpdatePlaylistAutoSync", attribute.String("db.system", "sqlite"))
+	r0 := c.next.UpdatePlaylistAutoSync(ctx, playlistID, options)
+	endSpan(span, r0)
+	return r0
+}
+
+func (c *tracedClient) UpdatePlaylistLimits(ctx context.Context, playlistID string, limits PlaylistLimits) error {
+	ctx, span := tracing.Start(ctx, "database.UpdatePlaylistLimits", attribute.String("db.system", "sqlite"))
+	r0 := c.next.UpdatePlaylistLimits(ctx, playlistID, limits)
+	endSpan(span, r0)
+	return r0
+}
+
+func (c *tracedClient) UpdatePlaylistSyncResult(ctx context.Context, playlistID string, result PlaylistSyncResult) error {
+	ctx, span := tracing.Start(ctx, "database.UpdatePlaylistSyncResult", attribute.String("db.system", "sqlite"))
+	r0 := c.next.UpdatePlaylistSyncResult(ctx, playlistID, result)
+	endSpan(span, r0)
+	return r0
+}
+
+func (c *tracedClient) UpdateSessionMeta(ctx context.Context, id string, meta map[string]string) error {
+	ctx, span := tracing.Start(ctx, "database.UpdateSessionMeta", attribute.String("db.system", "sqlite"))
+	r0 := c.next.UpdateSessionMeta(ctx, id, meta)
+	endSpan(span, r0)
+	return r0
+}
+
+func (c *tracedClient) UpdateSessionUser(ctx context.Context, id string, userID null.String, connectionID null.String) error {
+	ctx, span := tracing.Start(ctx, "database.UpdateSessionUser", attribute.String("db.system", "sqlite"))
+	r0 := c.next.UpdateSessionUser(ctx, id, userID, connectionID)
+	endSpan(span, r0)
+	return r0
+}
+
+func (c *tracedClient) UpdateSponsorBlockSubmission(ctx context.Context, submission *SponsorBlockSubmission) error {
+	ctx, span := tracing.Start(ctx, "database.UpdateSponsorBlockSubmission", attribute.String("db.system", "sqlite"))
+	r0 := c.next.UpdateSponsorBlockSubmission(ctx, submission)
+	endSpan(span, r0)
+	return r0
+}
+
+func (c *tracedClient) UpdateSubscription(ctx context.Context, sub *models.Subscription) error {
+	ctx, span := tracing.Start(ctx, "database.UpdateSubscription", attribute.String("db.system", "sqlite"))
+	r0 := c.next.UpdateSubscription(ctx, sub)
+	endSpan(span, r0)
+	return r0
+}
+
+func (c *tracedClient) UpdateUser(ctx context.Context, user *models.User) error {
+	ctx, span := tracing.Start(ctx, "database.UpdateUser", attribute.String("db.system", "sqlite"))
+	r0 := c.next.UpdateUser(ctx, user)
+	endSpan(span, r0)
+	return r0
+}
+
+func (c *tracedClient) UpdateWatchHistoryImportProgress(ctx context.Context, id string, progress WatchHistoryImportProgress) error {
+	ctx, span := tracing.Start(ctx, "database.UpdateWatchHistoryImportProgress", attribute.String("db.system", "sqlite"))
+	r0 := c.next.UpdateWatchHistoryImportProgress(ctx, id, progress)
+	endSpan(span, r0)
+	return r0
+}
+
+func (c *tracedClient) UpdateYouTubeSyncRefreshToken(ctx context.Context, userID string, encryptedRefreshToken []byte, secretHash string) error {
+	ctx, span := tracing.Start(ctx, "database.UpdateYouTubeSyncRefreshToken", attribute.String("db.system", "sqlite"))
+	r0 := c.next.UpdateYouTubeSyncRefreshToken(ctx, userID, encryptedRefreshToken, secretHash)
+	endSpan(span, r0)
+	return r0
+}
+
+func (c *tracedClient) UpdateYouTubeSyncRunResult(ctx context.Context, userID string, result YouTubeSyncRunResult) error {
+	ctx, span := tracing.Start(ctx, "database.UpdateYouTubeSyncRunResult", attribute.String("db.system", "sqlite"))
+	r0 := c.next.UpdateYouTubeSyncRunResult(ctx, userID, result)
+	endSpan(span, r0)
+	return r0
+}
+
+func (c *tracedClient) UpdateYouTubeSyncTargetOptions(ctx context.Context, id string, options YouTubeSyncTargetOptions) error {
+	ctx, span := tracing.Start(ctx, "database.UpdateYouTubeSyncTargetOptions", attribute.String("db.system", "sqlite"))
+	r0 := c.next.UpdateYouTubeSyncTargetOptions(ctx, id, options)
+	endSpan(span, r0)
+	return r0
+}
+
+func (c *tracedClient) UpdateYouTubeSyncTargetPlaylistID(ctx context.Context, id string, playlistID string) error {
+	ctx, span := tracing.Start(ctx, "database.UpdateYouTubeSyncTargetPlaylistID", attribute.String("db.system", "sqlite"))
+	r0 := c.next.UpdateYouTubeSyncTargetPlaylistID(ctx, id, playlistID)
+	endSpan(span, r0)
+	return r0
+}
+
+func (c *tracedClient) UpdateYouTubeSyncTargetRunResult(ctx context.Context, id string, result YouTubeSyncTargetRunResult) error {
+	ctx, span := tracing.Start(ctx, "database.UpdateYouTubeSyncTargetRunResult", attribute.String("db.system", "sqlite"))
+	r0 := c.next.UpdateYouTubeSyncTargetRunResult(ctx, id, result)
+	endSpan(span, r0)
+	return r0
+}
+
+func (c *tracedClient) UpdateYouTubeTVSyncLoungeToken(ctx context.Context, id string, screenID string, screenName string, loungeTokenEnc []byte, secretHash string) error {
+	ctx, span := tracing.Start(ctx, "database.UpdateYouTubeTVSyncLoungeToken", attribute.String("db.system", "sqlite"))
+	r0 := c.next.UpdateYouTubeTVSyncLoungeToken(ctx, id, screenID, screenName, loungeTokenEnc, secretHash)
+	endSpan(span, r0)
+	return r0
+}
+
+func (c *tracedClient) UpdateYouTubeTVSyncPreferences(ctx context.Context, id string, displayName string, sponsorBlockMode string) error {
+	ctx, span := tracing.Start(ctx, "database.UpdateYouTubeTVSyncPreferences", attribute.String("db.system", "sqlite"))
+	r0 := c.next.UpdateYouTubeTVSyncPreferences(ctx, id, displayName, sponsorBlockMode)
+	endSpan(span, r0)
+	return r0
+}
+
+func (c *tracedClient) UpdateYouTubeTVSyncState(ctx context.Context, id string, update YouTubeTVSyncStateUpdate) error {
+	ctx, span := tracing.Start(ctx, "database.UpdateYouTubeTVSyncState", attribute.String("db.system", "sqlite"))
+	r0 := c.next.UpdateYouTubeTVSyncState(ctx, id, update)
+	endSpan(span, r0)
+	return r0
+}
+
+func (c *tracedClient) UpsertChannel(ctx context.Context, data *models.Channel) error {
+	ctx, span := tracing.Start(ctx, "database.UpsertChannel", attribute.String("db.system", "sqlite"))
+	r0 := c.next.UpsertChannel(ctx, data)
+	endSpan(span, r0)
+	return r0
+}
+
+func (c *tracedClient) UpsertConfiguration(ctx context.Context, config *models.AppConfiguration) (*models.AppConfiguration, error) {
+	ctx, span := tracing.Start(ctx, "database.UpsertConfiguration", attribute.String("db.system", "sqlite"))
+	r0, r1 := c.next.UpsertConfiguration(ctx, config)
+	endSpan(span, r1)
+	return r0, r1
+}
+
+func (c *tracedClient) UpsertSettings(ctx context.Context, settings *models.Setting) error {
+	ctx, span := tracing.Start(ctx, "database.UpsertSettings", attribute.String("db.system", "sqlite"))
+	r0 := c.next.UpsertSettings(ctx, settings)
+	endSpan(span, r0)
+	return r0
+}
+
+func (c *tracedClient) UpsertSponsorBlockSegments(ctx context.Context, record *SponsorBlockVideoSegments) error {
+	ctx, span := tracing.Start(ctx, "database.UpsertSponsorBlockSegments", attribute.String("db.system", "sqlite"))
+	r0 := c.next.UpsertSponsorBlockSegments(ctx, record)
+	endSpan(span, r0)
+	return r0
+}
+
+func (c *tracedClient) UpsertVideoBranding(ctx context.Context, record *DeArrowVideoBranding) error {
+	ctx, span := tracing.Start(ctx, "database.UpsertVideoBranding", attribute.String("db.system", "sqlite"))
+	r0 := c.next.UpsertVideoBranding(ctx, record)
+	endSpan(span, r0)
+	return r0
+}
+
+func (c *tracedClient) UpsertVideoTranscript(ctx context.Context, transcript *VideoTranscript, terms map[string]int) error {
+	ctx, span := tracing.Start(ctx, "database.UpsertVideoTranscript", attribute.String("db.system", "sqlite"))
+	r0 := c.next.UpsertVideoTranscript(ctx, transcript, terms)
+	endSpan(span, r0)
+	return r0
+}
+
+func (c *tracedClient) UpsertVideos(ctx context.Context, videos ...*models.Video) error {
+	ctx, span := tracing.Start(ctx, "database.UpsertVideos", attribute.String("db.system", "sqlite"))
+	r0 := c.next.UpsertVideos(ctx, videos...)
+	endSpan(span, r0)
+	return r0
+}
+
+func (c *tracedClient) UpsertView(ctx context.Context, view *models.View) error {
+	ctx, span := tracing.Start(ctx, "database.UpsertView", attribute.String("db.system", "sqlite"))
+	r0 := c.next.UpsertView(ctx, view)
+	endSpan(span, r0)
+	return r0
+}
+
+func (c *tracedClient) UpsertYouTubeSyncCredentials(ctx context.Context, userID string, encryptedRefreshToken []byte, secretHash string, grantedScopes string) error {
+	ctx, span := tracing.Start(ctx, "database.UpsertYouTubeSyncCredentials", attribute.String("db.system", "sqlite"))
+	r0 := c.next.UpsertYouTubeSyncCredentials(ctx, userID, encryptedRefreshToken, secretHash, grantedScopes)
+	endSpan(span, r0)
+	return r0
+}
+
+func (c *tracedClient) UpsertYouTubeTVSyncCredentials(ctx context.Context, userID string, screenID string, screenName string, loungeTokenEnc []byte, secretHash string) error {
+	ctx, span := tracing.Start(ctx, "database.UpsertYouTubeTVSyncCredentials", attribute.String("db.system", "sqlite"))
+	r0 := c.next.UpsertYouTubeTVSyncCredentials(ctx, userID, screenID, screenName, loungeTokenEnc, secretHash)
+	endSpan(span, r0)
+	return r0
+}
+
+func (c *tracedClient) UserSubscriptions(ctx context.Context, userID string, o ...SubscriptionQuery) ([]*models.Subscription, error) {
+	ctx, span := tracing.Start(ctx, "database.UserSubscriptions", attribute.String("db.system", "sqlite"))
+	r0, r1 := c.next.UserSubscriptions(ctx, userID, o...)
+	endSpan(span, r1)
+	return r0, r1
+}
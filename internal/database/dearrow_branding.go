@@ -0,0 +1,116 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"strings"
+	"time"
+
+	"github.com/aarondl/null/v8"
+)
+
+type DeArrowVideoBranding struct {
+	VideoID   string
+	CreatedAt time.Time
+	UpdatedAt time.Time
+	ExpiresAt time.Time
+	// Title is empty when the original title should be kept
+	Title         string
+	ThumbnailTime null.Float64
+}
+
+type VideoBrandingClient interface {
+	GetVideoBranding(ctx context.Context, videoIDs ...string) (map[string]*DeArrowVideoBranding, error)
+	UpsertVideoBranding(ctx context.Context, record *DeArrowVideoBranding) error
+	DeleteExpiredVideoBranding(ctx context.Context, before time.Time) (int64, error)
+}
+
+func scanDeArrowVideoBranding(row scanner) (*DeArrowVideoBranding, error) {
+	record := &DeArrowVideoBranding{}
+	var thumbnailTime sql.NullFloat64
+	err := row.Scan(
+		&record.VideoID,
+		&record.CreatedAt,
+		&record.UpdatedAt,
+		&record.ExpiresAt,
+		&record.Title,
+		&thumbnailTime,
+	)
+	if err != nil {
+		return nil, err
+	}
+	record.ThumbnailTime = null.NewFloat64(thumbnailTime.Float64, thumbnailTime.Valid)
+	return record, nil
+}
+
+/*
+GetVideoBranding returns cached DeArrow branding keyed by video ID, expired records are included so callers can fall back to them
+*/
+func (c *sqliteClient) GetVideoBranding(ctx context.Context, videoIDs ...string) (map[string]*DeArrowVideoBranding, error) {
+	records := make(map[string]*DeArrowVideoBranding, len(videoIDs))
+	if len(videoIDs) == 0 {
+		return records, nil
+	}
+
+	args := make([]any, 0, len(videoIDs))
+	for _, id := range videoIDs {
+		args = append(args, id)
+	}
+
+	rows, err := c.db.QueryContext(
+		ctx,
+		`SELECT video_id, created_at, updated_at, expires_at, title, thumbnail_time
+         FROM dearrow_video_branding
+         WHERE video_id IN (?`+strings.Repeat(", ?", len(videoIDs)-1)+`)`,
+		args...,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		record, err := scanDeArrowVideoBranding(rows)
+		if err != nil {
+			return nil, err
+		}
+		records[record.VideoID] = record
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return records, nil
+}
+
+func (c *sqliteClient) UpsertVideoBranding(ctx context.Context, record *DeArrowVideoBranding) error {
+	now := time.Now().UTC()
+	_, err := c.db.ExecContext(
+		ctx,
+		`INSERT INTO dearrow_video_branding (video_id, created_at, updated_at, expires_at, title, thumbnail_time)
+         VALUES (?, ?, ?, ?, ?, ?)
+         ON CONFLICT(video_id) DO UPDATE SET
+           updated_at = excluded.updated_at,
+           expires_at = excluded.expires_at,
+           title = excluded.title,
+           thumbnail_time = excluded.thumbnail_time`,
+		record.VideoID,
+		now,
+		now,
+		record.ExpiresAt.UTC(),
+		record.Title,
+		record.ThumbnailTime.Ptr(),
+	)
+	if err != nil {
+		return err
+	}
+	record.UpdatedAt = now
+	return nil
+}
+
+func (c *sqliteClient) DeleteExpiredVideoBranding(ctx context.Context, before time.Time) (int64, error) {
+	result, err := c.db.ExecContext(ctx, `DELETE FROM dearrow_video_branding WHERE expires_at < ?`, before.UTC())
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
@@ -0,0 +1,63 @@
+package database
+
+import (
+	"context"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/aarondl/null/v8"
+	"github.com/matryer/is"
+)
+
+func TestVideoBranding(t *testing.T) {
+	is := is.New(t)
+
+	dbPath := os.Getenv("DATABASE_PATH")
+	if dbPath == "" {
+		t.Skip("DATABASE_PATH not set")
+	}
+
+	c, err := NewSQLiteClient(dbPath)
+	is.NoErr(err)
+	defer c.Close()
+
+	ctx := context.Background()
+
+	err = c.UpsertVideoBranding(ctx, &DeArrowVideoBranding{
+		VideoID:       "test-video-branding-fresh",
+		ExpiresAt:     time.Now().Add(time.Hour),
+		Title:         "A calmer title",
+		ThumbnailTime: null.Float64From(12.5),
+	})
+	is.NoErr(err)
+	err = c.UpsertVideoBranding(ctx, &DeArrowVideoBranding{
+		VideoID:   "test-video-branding-expired",
+		ExpiresAt: time.Now().Add(-48 * time.Hour),
+	})
+	is.NoErr(err)
+
+	records, err := c.GetVideoBranding(ctx, "test-video-branding-fresh", "test-video-branding-expired", "test-video-branding-missing")
+	is.NoErr(err)
+	is.Equal(len(records), 2)
+	is.Equal(records["test-video-branding-fresh"].Title, "A calmer title")
+	is.Equal(records["test-video-branding-fresh"].ThumbnailTime.Float64, 12.5)
+	is.True(!records["test-video-branding-expired"].ThumbnailTime.Valid)
+
+	deleted, err := c.DeleteExpiredVideoBranding(ctx, time.Now().Add(-24*time.Hour))
+	is.NoErr(err)
+	is.Equal(deleted, int64(1))
+
+	// Upserts replace the cached branding
+	err = c.UpsertVideoBranding(ctx, &DeArrowVideoBranding{
+		VideoID:   "test-video-branding-fresh",
+		ExpiresAt: time.Now().Add(-48 * time.Hour),
+	})
+	is.NoErr(err)
+	records, err = c.GetVideoBranding(ctx, "test-video-branding-fresh")
+	is.NoErr(err)
+	is.Equal(records["test-video-branding-fresh"].Title, "")
+
+	_, err = c.DeleteExpiredVideoBranding(ctx, time.Now().Add(-24*time.Hour))
+	is.NoErr(err)
+}
@@ -105,6 +105,11 @@ func init() {
 		c.ID = ensureID(c.ID)
 		return nil
 	})
+	// Playlist Share Links
+	models.AddPlaylistShareLinkHook(boil.BeforeInsertHook, func(ctx context.Context, ce boil.ContextExecutor, c *models.PlaylistShareLink) error {
+		c.ID = ensureID(c.ID)
+		return nil
+	})
 	// YouTube Sync Accounts
 	models.AddYoutubeSyncAccountHook(boil.BeforeInsertHook, func(ctx context.Context, ce boil.ContextExecutor, c *models.YoutubeSyncAccount) error {
 		c.ID = ensureID(c.ID)
@@ -114,4 +119,14 @@ func init() {
 		c.ID = ensureID(c.ID)
 		return nil
 	})
+	// YouTube Sync Targets
+	models.AddYoutubeSyncTargetHook(boil.BeforeInsertHook, func(ctx context.Context, ce boil.ContextExecutor, c *models.YoutubeSyncTarget) error {
+		c.ID = ensureID(c.ID)
+		return nil
+	})
+	// Watch History Imports
+	models.AddWatchHistoryImportHook(boil.BeforeInsertHook, func(ctx context.Context, ce boil.ContextExecutor, c *models.WatchHistoryImport) error {
+		c.ID = ensureID(c.ID)
+		return nil
+	})
 }
@@ -0,0 +1,272 @@
+/*
+tracegen writes client_traced.go, a database.Client that starts a span for every method of the client it wraps.
+It reads the interfaces embedded in Client from the package sources, run it with go generate after changing them.
+*/
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+const (
+	rootInterface = "Client"
+	outputFile    = "client_traced.go"
+)
+
+type method struct {
+	name    string
+	params  []param
+	results []string
+	imports map[string]string
+}
+
+type param struct {
+	name     string
+	typ      string
+	variadic bool
+}
+
+type source struct {
+	iface   *ast.InterfaceType
+	imports map[string]string
+}
+
+func main() {
+	fset := token.NewFileSet()
+	files, err := filepath.Glob("*.go")
+	if err != nil {
+		fail(err)
+	}
+
+	interfaces := map[string]source{}
+	for _, path := range files {
+		if strings.HasSuffix(path, "_test.go") || path == outputFile {
+			continue
+		}
+		file, err := parser.ParseFile(fset, path, nil, 0)
+		if err != nil {
+			fail(err)
+		}
+
+		imports := map[string]string{}
+		for _, spec := range file.Imports {
+			importPath, _ := strconv.Unquote(spec.Path.Value)
+			name := packageName(importPath)
+			if spec.Name != nil {
+				name = spec.Name.Name
+			}
+			imports[name] = importPath
+		}
+
+		ast.Inspect(file, func(n ast.Node) bool {
+			spec, ok := n.(*ast.TypeSpec)
+			if !ok {
+				return true
+			}
+			if iface, ok := spec.Type.(*ast.InterfaceType); ok {
+				interfaces[spec.Name.Name] = source{iface: iface, imports: imports}
+			}
+			return false
+		})
+	}
+
+	methods := map[string]method{}
+	collect(fset, interfaces, rootInterface, methods)
+
+	names := make([]string, 0, len(methods))
+	for name := range methods {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	imports := map[string]string{"context": "context", "tracing": "github.com/cufee/feedlr-yt/internal/tracing", "attribute": "go.opentelemetry.io/otel/attribute"}
+	for _, name := range names {
+		for alias, path := range methods[name].imports {
+			imports[alias] = path
+		}
+	}
+
+	var out bytes.Buffer
+	out.WriteString("// Code generated by tracegen. DO NOT EDIT.\n\npackage database\n\nimport (\n")
+	aliases := make([]string, 0, len(imports))
+	for alias := range imports {
+		aliases = append(aliases, alias)
+	}
+	sort.Slice(aliases, func(i, j int) bool { return imports[aliases[i]] < imports[aliases[j]] })
+	// Standard library imports come first, in their own group
+	for _, std := range []bool{true, false} {
+		for _, alias := range aliases {
+			if isStd(imports[alias]) != std {
+				continue
+			}
+			if packageName(imports[alias]) == alias {
+				fmt.Fprintf(&out, "\t%q\n", imports[alias])
+			} else {
+				fmt.Fprintf(&out, "\t%s %q\n", alias, imports[alias])
+			}
+		}
+		if std {
+			out.WriteString("\n")
+		}
+	}
+	out.WriteString(")\n\n")
+	out.WriteString("var _ Client = &tracedClient{}\n\n")
+
+	for _, name := range names {
+		writeMethod(&out, methods[name])
+	}
+
+	formatted, err := format.Source(out.Bytes())
+	if err != nil {
+		fail(fmt.Errorf("%w\n%s", err, out.String()))
+	}
+	if err := os.WriteFile(outputFile, formatted, 0o644); err != nil {
+		fail(err)
+	}
+}
+
+func collect(fset *token.FileSet, interfaces map[string]source, name string, methods map[string]method) {
+	src, ok := interfaces[name]
+	if !ok {
+		fail(fmt.Errorf("interface %s not found", name))
+	}
+
+	for _, field := range src.iface.Methods.List {
+		switch typ := field.Type.(type) {
+		case *ast.Ident:
+			collect(fset, interfaces, typ.Name, methods)
+		case *ast.FuncType:
+			m := method{name: field.Names[0].Name, imports: map[string]string{}}
+			render := func(expr ast.Expr) string {
+				ast.Inspect(expr, func(n ast.Node) bool {
+					if sel, ok := n.(*ast.SelectorExpr); ok {
+						if pkg, ok := sel.X.(*ast.Ident); ok {
+							m.imports[pkg.Name] = src.imports[pkg.Name]
+						}
+					}
+					return true
+				})
+				var buf bytes.Buffer
+				if err := format.Node(&buf, fset, expr); err != nil {
+					fail(err)
+				}
+				return buf.String()
+			}
+
+			index := 0
+			for _, field := range typ.Params.List {
+				expr := field.Type
+				variadic := false
+				if ellipsis, ok := expr.(*ast.Ellipsis); ok {
+					expr = ellipsis.Elt
+					variadic = true
+				}
+				names := field.Names
+				if len(names) == 0 {
+					names = []*ast.Ident{nil}
+				}
+				for _, ident := range names {
+					name := fmt.Sprintf("p%d", index)
+					if ident != nil && ident.Name != "_" {
+						name = ident.Name
+					}
+					m.params = append(m.params, param{name: name, typ: render(expr), variadic: variadic})
+					index++
+				}
+			}
+			if typ.Results != nil {
+				for _, field := range typ.Results.List {
+					count := max(len(field.Names), 1)
+					for range count {
+						m.results = append(m.results, render(field.Type))
+					}
+				}
+			}
+			methods[m.name] = m
+		}
+	}
+}
+
+func writeMethod(out *bytes.Buffer, m method) {
+	var params, args []string
+	traced := len(m.params) > 0 && m.params[0].typ == "context.Context"
+	for i, p := range m.params {
+		name := p.name
+		// The span context replaces the context argument
+		if i == 0 && traced {
+			name = "ctx"
+		}
+		if p.variadic {
+			params = append(params, name+" ..."+p.typ)
+			args = append(args, name+"...")
+		} else {
+			params = append(params, name+" "+p.typ)
+			args = append(args, name)
+		}
+	}
+
+	results := strings.Join(m.results, ", ")
+	if len(m.results) > 1 {
+		results = "(" + results + ")"
+	}
+	fmt.Fprintf(out, "func (c *tracedClient) %s(%s) %s {\n", m.name, strings.Join(params, ", "), results)
+
+	call := fmt.Sprintf("c.next.%s(%s)", m.name, strings.Join(args, ", "))
+	if !traced {
+		if len(m.results) == 0 {
+			fmt.Fprintf(out, "\t%s\n}\n\n", call)
+		} else {
+			fmt.Fprintf(out, "\treturn %s\n}\n\n", call)
+		}
+		return
+	}
+
+	fmt.Fprintf(out, "\tctx, span := tracing.Start(ctx, %q, attribute.String(\"db.system\", \"sqlite\"))\n", "database."+m.name)
+	returnsError := len(m.results) > 0 && m.results[len(m.results)-1] == "error"
+	if len(m.results) == 0 {
+		fmt.Fprintf(out, "\t%s\n\tspan.End()\n}\n\n", call)
+		return
+	}
+
+	vars := make([]string, len(m.results))
+	for i := range m.results {
+		vars[i] = fmt.Sprintf("r%d", i)
+	}
+	fmt.Fprintf(out, "\t%s := %s\n", strings.Join(vars, ", "), call)
+	if returnsError {
+		fmt.Fprintf(out, "\tendSpan(span, %s)\n", vars[len(vars)-1])
+	} else {
+		out.WriteString("\tspan.End()\n")
+	}
+	fmt.Fprintf(out, "\treturn %s\n}\n\n", strings.Join(vars, ", "))
+}
+
+// packageName guesses the name of an imported package from its path, skipping a major version suffix like /v8
+func packageName(importPath string) string {
+	name := path.Base(importPath)
+	if len(name) > 1 && name[0] == 'v' && strings.Trim(name[1:], "0123456789") == "" {
+		return path.Base(path.Dir(importPath))
+	}
+	return name
+}
+
+func isStd(importPath string) bool {
+	first, _, _ := strings.Cut(importPath, "/")
+	return !strings.Contains(first, ".")
+}
+
+func fail(err error) {
+	fmt.Fprintln(os.Stderr, "tracegen:", err)
+	os.Exit(1)
+}
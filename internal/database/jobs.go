@@ -0,0 +1,355 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"strings"
+	"time"
+
+	"github.com/aarondl/null/v8"
+	"github.com/lucsky/cuid"
+)
+
+const (
+	JobStatusQueued    = "queued"
+	JobStatusRunning   = "running"
+	JobStatusSucceeded = "succeeded"
+	// Failed jobs ran out of attempts or failed permanently, they stay in the table until they are retried or pruned
+	JobStatusFailed = "failed"
+)
+
+type Job struct {
+	ID          string
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+	Kind        string
+	Payload     string
+	DedupKey    null.String
+	Priority    int
+	Status      string
+	Attempts    int
+	MaxAttempts int
+	RunAt       time.Time
+	LockedBy    null.String
+	LockedUntil null.Time
+	LastError   string
+	FinishedAt  null.Time
+}
+
+/*
+JobsClient stores the background job queue. Jobs are claimed by one worker at a time,
+a claim expires when the worker stops renewing it so jobs of a crashed process are picked up again.
+*/
+type JobsClient interface {
+	EnqueueJob(ctx context.Context, job *Job) (bool, error)
+	ClaimJob(ctx context.Context, holder string, kinds []string, lockTTL time.Duration) (*Job, error)
+	ExtendJobLock(ctx context.Context, id, holder string, lockTTL time.Duration) (bool, error)
+	CompleteJob(ctx context.Context, id, holder string) error
+	RetryJob(ctx context.Context, id, holder string, runAt time.Time, lastErr string) error
+	FailJob(ctx context.Context, id, holder string, lastErr string) error
+	ReleaseJob(ctx context.Context, id, holder string) error
+	RequeueJob(ctx context.Context, id string) error
+	GetJob(ctx context.Context, id string) (*Job, error)
+	ListJobs(ctx context.Context, status string, limit int) ([]*Job, error)
+	CountJobs(ctx context.Context) (map[string]int, error)
+	PruneJobs(ctx context.Context, status string, finishedBefore time.Time) (int64, error)
+}
+
+const jobColumns = `id, created_at, updated_at, kind, payload, dedup_key, priority, status, attempts, max_attempts, run_at, locked_by, locked_until, last_error, finished_at`
+
+func scanJob(row interface{ Scan(dest ...any) error }) (*Job, error) {
+	job := &Job{}
+	err := row.Scan(
+		&job.ID,
+		&job.CreatedAt,
+		&job.UpdatedAt,
+		&job.Kind,
+		&job.Payload,
+		&job.DedupKey,
+		&job.Priority,
+		&job.Status,
+		&job.Attempts,
+		&job.MaxAttempts,
+		&job.RunAt,
+		&job.LockedBy,
+		&job.LockedUntil,
+		&job.LastError,
+		&job.FinishedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return job, nil
+}
+
+/*
+EnqueueJob adds a queued job. It reports false without adding the job when a queued or running job has the same dedup key.
+The ID, timestamps and status of the job are set on success.
+*/
+func (c *sqliteClient) EnqueueJob(ctx context.Context, job *Job) (bool, error) {
+	now := time.Now().UTC()
+	if job.ID == "" {
+		job.ID = cuid.New()
+	}
+	if job.Payload == "" {
+		job.Payload = "{}"
+	}
+	if job.RunAt.IsZero() {
+		job.RunAt = now
+	}
+	job.CreatedAt = now
+	job.UpdatedAt = now
+	job.Status = JobStatusQueued
+
+	result, err := c.db.ExecContext(
+		ctx,
+		`INSERT INTO jobs (id, created_at, updated_at, kind, payload, dedup_key, priority, status, attempts, max_attempts, run_at, last_error)
+         VALUES (?, ?, ?, ?, ?, ?, ?, ?, 0, ?, ?, '')
+         ON CONFLICT DO NOTHING`,
+		job.ID,
+		job.CreatedAt,
+		job.UpdatedAt,
+		job.Kind,
+		job.Payload,
+		nullableString(job.DedupKey),
+		job.Priority,
+		job.Status,
+		job.MaxAttempts,
+		job.RunAt.UTC(),
+	)
+	if err != nil {
+		return false, err
+	}
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+	return affected > 0, nil
+}
+
+/*
+ClaimJob locks the next job that is due for the holder, highest priority first. Running jobs with an expired lock are claimed again.
+Each claim counts as an attempt. It returns sql.ErrNoRows when no job is due.
+*/
+func (c *sqliteClient) ClaimJob(ctx context.Context, holder string, kinds []string, lockTTL time.Duration) (*Job, error) {
+	if len(kinds) == 0 {
+		return nil, sql.ErrNoRows
+	}
+	now := time.Now().UTC()
+
+	args := []any{now, holder, now.Add(lockTTL), now, now}
+	for _, kind := range kinds {
+		args = append(args, kind)
+	}
+
+	row := c.db.QueryRowContext(
+		ctx,
+		`UPDATE jobs
+         SET updated_at = ?,
+             status = 'running',
+             locked_by = ?,
+             locked_until = ?,
+             attempts = attempts + 1
+         WHERE id = (
+            SELECT id FROM jobs
+            WHERE ((status = 'queued' AND run_at <= ?) OR (status = 'running' AND locked_until < ?))
+              AND kind IN (`+placeholders(len(kinds))+`)
+            ORDER BY priority DESC, run_at ASC
+            LIMIT 1
+         )
+         RETURNING `+jobColumns,
+		args...,
+	)
+	return scanJob(row)
+}
+
+/*
+ExtendJobLock renews the lock of a running job, it reports false when the job was claimed by someone else
+*/
+func (c *sqliteClient) ExtendJobLock(ctx context.Context, id, holder string, lockTTL time.Duration) (bool, error) {
+	now := time.Now().UTC()
+	result, err := c.db.ExecContext(
+		ctx,
+		`UPDATE jobs SET updated_at = ?, locked_until = ? WHERE id = ? AND locked_by = ? AND status = 'running'`,
+		now,
+		now.Add(lockTTL),
+		id,
+		holder,
+	)
+	if err != nil {
+		return false, err
+	}
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+	return affected > 0, nil
+}
+
+func (c *sqliteClient) finishJob(ctx context.Context, id, holder, query string, args ...any) error {
+	result, err := c.db.ExecContext(ctx, query+` WHERE id = ? AND locked_by = ? AND status = 'running'`, append(args, id, holder)...)
+	if err != nil {
+		return err
+	}
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if affected == 0 {
+		return sql.ErrNoRows
+	}
+	return nil
+}
+
+/*
+CompleteJob marks a job claimed by the holder as succeeded
+*/
+func (c *sqliteClient) CompleteJob(ctx context.Context, id, holder string) error {
+	now := time.Now().UTC()
+	return c.finishJob(ctx, id, holder,
+		`UPDATE jobs SET updated_at = ?, status = 'succeeded', locked_by = NULL, locked_until = NULL, last_error = '', finished_at = ?`,
+		now, now,
+	)
+}
+
+/*
+RetryJob puts a job claimed by the holder back in the queue to run again at runAt
+*/
+func (c *sqliteClient) RetryJob(ctx context.Context, id, holder string, runAt time.Time, lastErr string) error {
+	return c.finishJob(ctx, id, holder,
+		`UPDATE jobs SET updated_at = ?, status = 'queued', locked_by = NULL, locked_until = NULL, run_at = ?, last_error = ?`,
+		time.Now().UTC(), runAt.UTC(), lastErr,
+	)
+}
+
+/*
+FailJob moves a job claimed by the holder to the failed jobs, it is not run again unless it is requeued
+*/
+func (c *sqliteClient) FailJob(ctx context.Context, id, holder string, lastErr string) error {
+	now := time.Now().UTC()
+	return c.finishJob(ctx, id, holder,
+		`UPDATE jobs SET updated_at = ?, status = 'failed', locked_by = NULL, locked_until = NULL, last_error = ?, finished_at = ?`,
+		now, lastErr, now,
+	)
+}
+
+/*
+ReleaseJob puts a job claimed by the holder back in the queue without counting the attempt, used for jobs interrupted by a shutdown
+*/
+func (c *sqliteClient) ReleaseJob(ctx context.Context, id, holder string) error {
+	return c.finishJob(ctx, id, holder,
+		`UPDATE jobs SET updated_at = ?, status = 'queued', locked_by = NULL, locked_until = NULL, attempts = MAX(attempts - 1, 0)`,
+		time.Now().UTC(),
+	)
+}
+
+/*
+RequeueJob queues a failed job again with a fresh set of attempts.
+It returns sql.ErrNoRows when the job is not failed or a job with the same dedup key is already queued.
+*/
+func (c *sqliteClient) RequeueJob(ctx context.Context, id string) error {
+	now := time.Now().UTC()
+	result, err := c.db.ExecContext(
+		ctx,
+		`UPDATE OR IGNORE jobs
+         SET updated_at = ?, status = 'queued', attempts = 0, run_at = ?, finished_at = NULL
+         WHERE id = ? AND status = 'failed'`,
+		now,
+		now,
+		id,
+	)
+	if err != nil {
+		return err
+	}
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if affected == 0 {
+		return sql.ErrNoRows
+	}
+	return nil
+}
+
+func (c *sqliteClient) GetJob(ctx context.Context, id string) (*Job, error) {
+	return scanJob(c.db.QueryRowContext(ctx, `SELECT `+jobColumns+` FROM jobs WHERE id = ?`, id))
+}
+
+/*
+ListJobs returns jobs with a status. Queued jobs are ordered by the order they will run in, other jobs by the latest update first.
+*/
+func (c *sqliteClient) ListJobs(ctx context.Context, status string, limit int) ([]*Job, error) {
+	order := `updated_at DESC`
+	if status == JobStatusQueued {
+		order = `priority DESC, run_at ASC`
+	}
+
+	rows, err := c.db.QueryContext(
+		ctx,
+		`SELECT `+jobColumns+` FROM jobs WHERE status = ? ORDER BY `+order+` LIMIT ?`,
+		status,
+		limit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var jobs []*Job
+	for rows.Next() {
+		job, err := scanJob(rows)
+		if err != nil {
+			return nil, err
+		}
+		jobs = append(jobs, job)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return jobs, nil
+}
+
+/*
+CountJobs returns the number of jobs by status
+*/
+func (c *sqliteClient) CountJobs(ctx context.Context) (map[string]int, error) {
+	rows, err := c.db.QueryContext(ctx, `SELECT status, COUNT(*) FROM jobs GROUP BY status`)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	counts := map[string]int{}
+	for rows.Next() {
+		var status string
+		var count int
+		if err := rows.Scan(&status, &count); err != nil {
+			return nil, err
+		}
+		counts[status] = count
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return counts, nil
+}
+
+/*
+PruneJobs deletes finished jobs with a status that finished before the cutoff
+*/
+func (c *sqliteClient) PruneJobs(ctx context.Context, status string, finishedBefore time.Time) (int64, error) {
+	result, err := c.db.ExecContext(
+		ctx,
+		`DELETE FROM jobs WHERE status = ? AND finished_at < ?`,
+		status,
+		finishedBefore.UTC(),
+	)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+func placeholders(n int) string {
+	return strings.TrimSuffix(strings.Repeat("?, ", n), ", ")
+}
@@ -0,0 +1,147 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/aarondl/null/v8"
+	"github.com/matryer/is"
+)
+
+func TestJobs(t *testing.T) {
+	is := is.New(t)
+
+	dbPath := os.Getenv("DATABASE_PATH")
+	if dbPath == "" {
+		t.Skip("DATABASE_PATH not set")
+	}
+
+	c, err := NewSQLiteClient(dbPath)
+	is.NoErr(err)
+	defer c.Close()
+
+	ctx := context.Background()
+	db := c.(*sqliteClient).db
+	defer db.ExecContext(ctx, `DELETE FROM jobs WHERE kind LIKE 'test_%'`)
+
+	low := &Job{Kind: "test_low", DedupKey: null.StringFrom("test:low"), MaxAttempts: 3}
+	ok, err := c.EnqueueJob(ctx, low)
+	is.NoErr(err)
+	is.True(ok)
+
+	// A queued job with the same dedup key blocks duplicates
+	ok, err = c.EnqueueJob(ctx, &Job{Kind: "test_low", DedupKey: null.StringFrom("test:low"), MaxAttempts: 3})
+	is.NoErr(err)
+	is.True(!ok)
+
+	high := &Job{Kind: "test_high", Priority: 10, MaxAttempts: 3}
+	ok, err = c.EnqueueJob(ctx, high)
+	is.NoErr(err)
+	is.True(ok)
+
+	later := &Job{Kind: "test_high", Priority: 20, MaxAttempts: 3, RunAt: time.Now().Add(time.Hour)}
+	ok, err = c.EnqueueJob(ctx, later)
+	is.NoErr(err)
+	is.True(ok)
+
+	kinds := []string{"test_low", "test_high"}
+
+	// Due jobs are claimed by priority
+	claimed, err := c.ClaimJob(ctx, "worker-a", kinds, time.Minute)
+	is.NoErr(err)
+	is.Equal(claimed.ID, high.ID)
+	is.Equal(claimed.Status, JobStatusRunning)
+	is.Equal(claimed.Attempts, 1)
+
+	// Only kinds the worker handles are claimed
+	_, err = c.ClaimJob(ctx, "worker-b", []string{"test_other"}, time.Minute)
+	is.True(errors.Is(err, sql.ErrNoRows))
+
+	claimedLow, err := c.ClaimJob(ctx, "worker-b", kinds, time.Millisecond)
+	is.NoErr(err)
+	is.Equal(claimedLow.ID, low.ID)
+
+	_, err = c.ClaimJob(ctx, "worker-c", kinds, time.Minute)
+	is.True(errors.Is(err, sql.ErrNoRows))
+
+	// Finishing a job claimed by another worker fails
+	is.True(errors.Is(c.CompleteJob(ctx, high.ID, "worker-b"), sql.ErrNoRows))
+	is.NoErr(c.CompleteJob(ctx, high.ID, "worker-a"))
+
+	// An expired lock is claimed again, the original worker can no longer finish the job
+	time.Sleep(5 * time.Millisecond)
+	reclaimed, err := c.ClaimJob(ctx, "worker-c", kinds, time.Minute)
+	is.NoErr(err)
+	is.Equal(reclaimed.ID, low.ID)
+	is.Equal(reclaimed.Attempts, 2)
+	ok, err = c.ExtendJobLock(ctx, low.ID, "worker-b", time.Minute)
+	is.NoErr(err)
+	is.True(!ok)
+
+	// Running jobs still block duplicates
+	ok, err = c.EnqueueJob(ctx, &Job{Kind: "test_low", DedupKey: null.StringFrom("test:low"), MaxAttempts: 3})
+	is.NoErr(err)
+	is.True(!ok)
+
+	is.NoErr(c.RetryJob(ctx, low.ID, "worker-c", time.Now().Add(time.Hour), "temporary"))
+	job, err := c.GetJob(ctx, low.ID)
+	is.NoErr(err)
+	is.Equal(job.Status, JobStatusQueued)
+	is.Equal(job.LastError, "temporary")
+	is.True(!job.LockedBy.Valid)
+
+	// A released job does not count the interrupted attempt
+	_, err = db.ExecContext(ctx, `UPDATE jobs SET run_at = ? WHERE id = ?`, time.Now().UTC().Add(-time.Second), low.ID)
+	is.NoErr(err)
+	claimedLow, err = c.ClaimJob(ctx, "worker-a", kinds, time.Minute)
+	is.NoErr(err)
+	is.Equal(claimedLow.Attempts, 3)
+	is.NoErr(c.ReleaseJob(ctx, low.ID, "worker-a"))
+	job, err = c.GetJob(ctx, low.ID)
+	is.NoErr(err)
+	is.Equal(job.Attempts, 2)
+
+	claimedLow, err = c.ClaimJob(ctx, "worker-a", kinds, time.Minute)
+	is.NoErr(err)
+	is.NoErr(c.FailJob(ctx, claimedLow.ID, "worker-a", "gave up"))
+
+	// Failed jobs no longer block duplicates, a failed job is not requeued while a duplicate is queued
+	duplicate := &Job{Kind: "test_low", DedupKey: null.StringFrom("test:low"), MaxAttempts: 3, RunAt: time.Now().Add(time.Hour)}
+	ok, err = c.EnqueueJob(ctx, duplicate)
+	is.NoErr(err)
+	is.True(ok)
+	is.True(errors.Is(c.RequeueJob(ctx, low.ID), sql.ErrNoRows))
+	_, err = db.ExecContext(ctx, `DELETE FROM jobs WHERE id = ?`, duplicate.ID)
+	is.NoErr(err)
+
+	is.NoErr(c.RequeueJob(ctx, low.ID))
+	job, err = c.GetJob(ctx, low.ID)
+	is.NoErr(err)
+	is.Equal(job.Status, JobStatusQueued)
+	is.Equal(job.Attempts, 0)
+
+	counts, err := c.CountJobs(ctx)
+	is.NoErr(err)
+	is.True(counts[JobStatusQueued] >= 2)
+	is.True(counts[JobStatusSucceeded] >= 1)
+
+	queued, err := c.ListJobs(ctx, JobStatusQueued, 100)
+	is.NoErr(err)
+	var queuedIDs []string
+	for _, job := range queued {
+		if job.Kind == "test_low" || job.Kind == "test_high" {
+			queuedIDs = append(queuedIDs, job.ID)
+		}
+	}
+	is.Equal(queuedIDs, []string{later.ID, low.ID})
+
+	deleted, err := c.PruneJobs(ctx, JobStatusSucceeded, time.Now().Add(time.Second))
+	is.NoErr(err)
+	is.True(deleted >= 1)
+	_, err = c.GetJob(ctx, high.ID)
+	is.True(errors.Is(err, sql.ErrNoRows))
+}
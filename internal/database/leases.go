@@ -0,0 +1,90 @@
+package database
+
+import (
+	"context"
+	"time"
+)
+
+type Lease struct {
+	Name       string
+	Holder     string
+	AcquiredAt time.Time
+	ExpiresAt  time.Time
+}
+
+/*
+LeasesClient coordinates work between Feedlr processes sharing a database.
+A lease is held by one holder at a time until it expires or is released, holders renew it by acquiring it again.
+*/
+type LeasesClient interface {
+	AcquireLease(ctx context.Context, name, holder string, ttl time.Duration) (bool, error)
+	ReleaseLease(ctx context.Context, name, holder string) error
+	ListLeases(ctx context.Context) ([]*Lease, error)
+}
+
+/*
+AcquireLease takes a free or expired lease, or extends a lease the holder already has. It reports false when another holder has the lease.
+*/
+func (c *sqliteClient) AcquireLease(ctx context.Context, name, holder string, ttl time.Duration) (bool, error) {
+	now := time.Now().UTC()
+	result, err := c.db.ExecContext(
+		ctx,
+		`INSERT INTO leases (name, holder, acquired_at, expires_at)
+         VALUES (?, ?, ?, ?)
+         ON CONFLICT (name) DO UPDATE SET
+            acquired_at = CASE WHEN leases.holder = excluded.holder THEN leases.acquired_at ELSE excluded.acquired_at END,
+            holder = excluded.holder,
+            expires_at = excluded.expires_at
+         WHERE leases.holder = excluded.holder OR leases.expires_at < ?`,
+		name,
+		holder,
+		now,
+		now.Add(ttl),
+		now,
+	)
+	if err != nil {
+		return false, err
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+	return affected > 0, nil
+}
+
+/*
+ReleaseLease frees a lease so another holder can take it right away, leases held by someone else are not touched
+*/
+func (c *sqliteClient) ReleaseLease(ctx context.Context, name, holder string) error {
+	_, err := c.db.ExecContext(ctx, `DELETE FROM leases WHERE name = ? AND holder = ?`, name, holder)
+	return err
+}
+
+/*
+ListLeases returns all unexpired leases ordered by name
+*/
+func (c *sqliteClient) ListLeases(ctx context.Context) ([]*Lease, error) {
+	rows, err := c.db.QueryContext(
+		ctx,
+		`SELECT name, holder, acquired_at, expires_at FROM leases WHERE expires_at >= ? ORDER BY name`,
+		time.Now().UTC(),
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var leases []*Lease
+	for rows.Next() {
+		lease := &Lease{}
+		if err := rows.Scan(&lease.Name, &lease.Holder, &lease.AcquiredAt, &lease.ExpiresAt); err != nil {
+			return nil, err
+		}
+		leases = append(leases, lease)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return leases, nil
+}
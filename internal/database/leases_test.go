@@ -0,0 +1,76 @@
+package database
+
+import (
+	"context"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/matryer/is"
+)
+
+func TestLeases(t *testing.T) {
+	is := is.New(t)
+
+	dbPath := os.Getenv("DATABASE_PATH")
+	if dbPath == "" {
+		t.Skip("DATABASE_PATH not set")
+	}
+
+	c, err := NewSQLiteClient(dbPath)
+	is.NoErr(err)
+	defer c.Close()
+
+	ctx := context.Background()
+	name := "test:lease"
+	defer c.ReleaseLease(ctx, name, "holder-a")
+	defer c.ReleaseLease(ctx, name, "holder-b")
+
+	ok, err := c.AcquireLease(ctx, name, "holder-a", time.Minute)
+	is.NoErr(err)
+	is.True(ok)
+
+	// Another holder has to wait for the lease to expire or be released
+	ok, err = c.AcquireLease(ctx, name, "holder-b", time.Minute)
+	is.NoErr(err)
+	is.True(!ok)
+
+	// The holder renews its own lease
+	ok, err = c.AcquireLease(ctx, name, "holder-a", time.Millisecond)
+	is.NoErr(err)
+	is.True(ok)
+	time.Sleep(5 * time.Millisecond)
+
+	leases, err := c.ListLeases(ctx)
+	is.NoErr(err)
+	for _, lease := range leases {
+		is.True(lease.Name != name) // expired leases are not listed
+	}
+
+	// Expired leases are taken over
+	ok, err = c.AcquireLease(ctx, name, "holder-b", time.Minute)
+	is.NoErr(err)
+	is.True(ok)
+
+	// Releasing a lease held by someone else does nothing
+	is.NoErr(c.ReleaseLease(ctx, name, "holder-a"))
+	ok, err = c.AcquireLease(ctx, name, "holder-a", time.Minute)
+	is.NoErr(err)
+	is.True(!ok)
+
+	is.NoErr(c.ReleaseLease(ctx, name, "holder-b"))
+	ok, err = c.AcquireLease(ctx, name, "holder-a", time.Minute)
+	is.NoErr(err)
+	is.True(ok)
+
+	leases, err = c.ListLeases(ctx)
+	is.NoErr(err)
+	var found bool
+	for _, lease := range leases {
+		if lease.Name == name {
+			found = true
+			is.Equal(lease.Holder, "holder-a")
+		}
+	}
+	is.True(found)
+}
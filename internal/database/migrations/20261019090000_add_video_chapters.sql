@@ -0,0 +1,16 @@
+-- Create "video_chapters" table
+CREATE TABLE `video_chapters` (
+  `id` text NOT NULL,
+  `created_at` date NOT NULL,
+  `updated_at` date NOT NULL,
+  `video_id` text NOT NULL,
+  `source` text NOT NULL,
+  `position` integer NOT NULL,
+  `start_seconds` integer NOT NULL,
+  `end_seconds` integer NOT NULL,
+  `title` text NOT NULL,
+  PRIMARY KEY (`id`),
+  CONSTRAINT `video_chapters_video_id_fkey` FOREIGN KEY (`video_id`) REFERENCES `videos` (`id`) ON DELETE CASCADE
+);
+-- Create index "idx_video_chapters_video_id_source_position_unique" to table: "video_chapters"
+CREATE UNIQUE INDEX `idx_video_chapters_video_id_source_position_unique` ON `video_chapters` (`video_id`, `source`, `position`);
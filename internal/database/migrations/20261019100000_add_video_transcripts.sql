@@ -0,0 +1,36 @@
+-- Add "transcripts_enabled" column to table: "subscriptions"
+ALTER TABLE `subscriptions` ADD COLUMN `transcripts_enabled` boolean NOT NULL DEFAULT false;
+-- Create "video_transcripts" table
+CREATE TABLE `video_transcripts` (
+  `id` text NOT NULL,
+  `created_at` date NOT NULL,
+  `updated_at` date NOT NULL,
+  `video_id` text NOT NULL,
+  `language` text NOT NULL,
+  `name` text NOT NULL,
+  `auto_generated` boolean NOT NULL,
+  `cue_count` integer NOT NULL,
+  `content` blob NOT NULL,
+  PRIMARY KEY (`id`),
+  CONSTRAINT `video_transcripts_video_id_fkey` FOREIGN KEY (`video_id`) REFERENCES `videos` (`id`) ON DELETE CASCADE
+);
+-- Create index "idx_video_transcripts_video_id_language_unique" to table: "video_transcripts"
+CREATE UNIQUE INDEX `idx_video_transcripts_video_id_language_unique` ON `video_transcripts` (`video_id`, `language`);
+-- Create "video_transcript_terms" table
+CREATE TABLE `video_transcript_terms` (
+  `term` text NOT NULL,
+  `transcript_id` text NOT NULL,
+  `start_seconds` integer NOT NULL,
+  PRIMARY KEY (`term`, `transcript_id`),
+  CONSTRAINT `video_transcript_terms_transcript_id_fkey` FOREIGN KEY (`transcript_id`) REFERENCES `video_transcripts` (`id`) ON DELETE CASCADE
+);
+-- Create index "idx_video_transcript_terms_transcript_id" to table: "video_transcript_terms"
+CREATE INDEX `idx_video_transcript_terms_transcript_id` ON `video_transcript_terms` (`transcript_id`);
+-- Create "video_transcript_checks" table
+CREATE TABLE `video_transcript_checks` (
+  `video_id` text NOT NULL,
+  `checked_at` date NOT NULL,
+  `track_count` integer NOT NULL,
+  PRIMARY KEY (`video_id`),
+  CONSTRAINT `video_transcript_checks_video_id_fkey` FOREIGN KEY (`video_id`) REFERENCES `videos` (`id`) ON DELETE CASCADE
+);
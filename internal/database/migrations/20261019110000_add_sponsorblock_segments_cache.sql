@@ -0,0 +1,12 @@
+-- Create "sponsorblock_video_segments" table
+CREATE TABLE `sponsorblock_video_segments` (
+  `video_id` text NOT NULL,
+  `created_at` date NOT NULL,
+  `updated_at` date NOT NULL,
+  `expires_at` date NOT NULL,
+  `segment_count` integer NOT NULL,
+  `segments` text NOT NULL,
+  PRIMARY KEY (`video_id`)
+);
+-- Create index "idx_sponsorblock_video_segments_expires_at" to table: "sponsorblock_video_segments"
+CREATE INDEX `idx_sponsorblock_video_segments_expires_at` ON `sponsorblock_video_segments` (`expires_at`);
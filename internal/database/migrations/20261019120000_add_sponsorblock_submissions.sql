@@ -0,0 +1,21 @@
+-- Create "sponsorblock_submissions" table
+CREATE TABLE `sponsorblock_submissions` (
+  `id` text NOT NULL,
+  `created_at` date NOT NULL,
+  `updated_at` date NOT NULL,
+  `user_id` text NOT NULL,
+  `video_id` text NOT NULL,
+  `kind` text NOT NULL,
+  `payload` text NOT NULL,
+  `status` text NOT NULL,
+  `attempts` integer NOT NULL,
+  `next_attempt_at` date NOT NULL,
+  `last_error` text NOT NULL,
+  `result` text NOT NULL,
+  PRIMARY KEY (`id`),
+  CONSTRAINT `sponsorblock_submissions_user_id_fkey` FOREIGN KEY (`user_id`) REFERENCES `users` (`id`) ON DELETE CASCADE
+);
+-- Create index "idx_sponsorblock_submissions_status_next_attempt_at" to table: "sponsorblock_submissions"
+CREATE INDEX `idx_sponsorblock_submissions_status_next_attempt_at` ON `sponsorblock_submissions` (`status`, `next_attempt_at`);
+-- Create index "idx_sponsorblock_submissions_user_id_video_id" to table: "sponsorblock_submissions"
+CREATE INDEX `idx_sponsorblock_submissions_user_id_video_id` ON `sponsorblock_submissions` (`user_id`, `video_id`);
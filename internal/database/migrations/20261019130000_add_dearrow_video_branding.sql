@@ -0,0 +1,12 @@
+-- Create "dearrow_video_branding" table
+CREATE TABLE `dearrow_video_branding` (
+  `video_id` text NOT NULL,
+  `created_at` date NOT NULL,
+  `updated_at` date NOT NULL,
+  `expires_at` date NOT NULL,
+  `title` text NOT NULL,
+  `thumbnail_time` real NULL,
+  PRIMARY KEY (`video_id`)
+);
+-- Create index "idx_dearrow_video_branding_expires_at" to table: "dearrow_video_branding"
+CREATE INDEX `idx_dearrow_video_branding_expires_at` ON `dearrow_video_branding` (`expires_at`);
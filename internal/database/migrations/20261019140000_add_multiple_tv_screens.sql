@@ -0,0 +1,8 @@
+-- Drop index "idx_youtube_tv_sync_accounts_user_id_unique" from table: "youtube_tv_sync_accounts"
+DROP INDEX `idx_youtube_tv_sync_accounts_user_id_unique`;
+-- Add "display_name" column to table: "youtube_tv_sync_accounts"
+ALTER TABLE `youtube_tv_sync_accounts` ADD COLUMN `display_name` text NOT NULL DEFAULT '';
+-- Add "sponsorblock_mode" column to table: "youtube_tv_sync_accounts"
+ALTER TABLE `youtube_tv_sync_accounts` ADD COLUMN `sponsorblock_mode` text NOT NULL DEFAULT 'inherit';
+-- Create index "idx_youtube_tv_sync_accounts_user_id_screen_id_unique" to table: "youtube_tv_sync_accounts"
+CREATE UNIQUE INDEX `idx_youtube_tv_sync_accounts_user_id_screen_id_unique` ON `youtube_tv_sync_accounts` (`user_id`, `screen_id`);
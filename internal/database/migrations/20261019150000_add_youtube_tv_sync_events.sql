@@ -0,0 +1,17 @@
+-- Create "youtube_tv_sync_events" table
+CREATE TABLE `youtube_tv_sync_events` (
+  `id` text NOT NULL,
+  `created_at` date NOT NULL,
+  `account_id` text NOT NULL,
+  `user_id` text NOT NULL,
+  `kind` text NOT NULL,
+  `video_id` text NOT NULL DEFAULT '',
+  `message` text NOT NULL DEFAULT '',
+  PRIMARY KEY (`id`),
+  CONSTRAINT `youtube_tv_sync_events_account_id_fkey` FOREIGN KEY (`account_id`) REFERENCES `youtube_tv_sync_accounts` (`id`) ON DELETE CASCADE,
+  CONSTRAINT `youtube_tv_sync_events_user_id_fkey` FOREIGN KEY (`user_id`) REFERENCES `users` (`id`) ON DELETE CASCADE
+);
+-- Create index "idx_youtube_tv_sync_events_account_id_created_at" to table: "youtube_tv_sync_events"
+CREATE INDEX `idx_youtube_tv_sync_events_account_id_created_at` ON `youtube_tv_sync_events` (`account_id`, `created_at`);
+-- Create index "idx_youtube_tv_sync_events_created_at" to table: "youtube_tv_sync_events"
+CREATE INDEX `idx_youtube_tv_sync_events_created_at` ON `youtube_tv_sync_events` (`created_at`);
@@ -0,0 +1,11 @@
+-- Create "youtube_tv_pairing_tokens" table
+CREATE TABLE `youtube_tv_pairing_tokens` (
+  `token_hash` text NOT NULL,
+  `created_at` date NOT NULL,
+  `expires_at` date NOT NULL,
+  `user_id` text NOT NULL,
+  PRIMARY KEY (`token_hash`),
+  CONSTRAINT `youtube_tv_pairing_tokens_user_id_fkey` FOREIGN KEY (`user_id`) REFERENCES `users` (`id`) ON DELETE CASCADE
+);
+-- Create index "idx_youtube_tv_pairing_tokens_user_id" to table: "youtube_tv_pairing_tokens"
+CREATE INDEX `idx_youtube_tv_pairing_tokens_user_id` ON `youtube_tv_pairing_tokens` (`user_id`);
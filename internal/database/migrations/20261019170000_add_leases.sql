@@ -0,0 +1,10 @@
+-- Create "leases" table
+CREATE TABLE `leases` (
+  `name` text NOT NULL,
+  `holder` text NOT NULL,
+  `acquired_at` date NOT NULL,
+  `expires_at` date NOT NULL,
+  PRIMARY KEY (`name`)
+);
+-- Create index "idx_leases_holder" to table: "leases"
+CREATE INDEX `idx_leases_holder` ON `leases` (`holder`);
@@ -0,0 +1,25 @@
+-- Create "jobs" table
+CREATE TABLE `jobs` (
+  `id` text NOT NULL,
+  `created_at` date NOT NULL,
+  `updated_at` date NOT NULL,
+  `kind` text NOT NULL,
+  `payload` text NOT NULL DEFAULT '{}',
+  `dedup_key` text NULL,
+  `priority` integer NOT NULL DEFAULT 0,
+  `status` text NOT NULL,
+  `attempts` integer NOT NULL DEFAULT 0,
+  `max_attempts` integer NOT NULL,
+  `run_at` date NOT NULL,
+  `locked_by` text NULL,
+  `locked_until` date NULL,
+  `last_error` text NOT NULL DEFAULT '',
+  `finished_at` date NULL,
+  PRIMARY KEY (`id`)
+);
+-- Create index "idx_jobs_status_priority_run_at" to table: "jobs"
+CREATE INDEX `idx_jobs_status_priority_run_at` ON `jobs` (`status`, `priority`, `run_at`);
+-- Create index "idx_jobs_dedup_key_active" to table: "jobs"
+CREATE UNIQUE INDEX `idx_jobs_dedup_key_active` ON `jobs` (`dedup_key`) WHERE dedup_key IS NOT NULL AND status IN ('queued', 'running');
+-- Create index "idx_jobs_kind" to table: "jobs"
+CREATE INDEX `idx_jobs_kind` ON `jobs` (`kind`);
@@ -0,0 +1,24 @@
+-- Create "youtube_sync_targets" table
+CREATE TABLE `youtube_sync_targets` (
+  `id` text NOT NULL,
+  `created_at` date NOT NULL,
+  `updated_at` date NOT NULL,
+  `user_id` text NOT NULL,
+  `source` text NOT NULL,
+  `source_id` text NOT NULL DEFAULT '',
+  `title` text NOT NULL,
+  `playlist_id` text NULL,
+  `size` integer NOT NULL DEFAULT 36,
+  `ordering` text NOT NULL DEFAULT 'source',
+  `last_synced_at` date NULL,
+  `last_sync_attempt_at` date NULL,
+  `last_error` text NOT NULL DEFAULT '',
+  PRIMARY KEY (`id`),
+  CONSTRAINT `youtube_sync_targets_user_id_fkey` FOREIGN KEY (`user_id`) REFERENCES `youtube_sync_accounts` (`user_id`) ON DELETE CASCADE
+);
+-- Create index "idx_youtube_sync_targets_user_id_source_source_id_unique" to table: "youtube_sync_targets"
+CREATE UNIQUE INDEX `idx_youtube_sync_targets_user_id_source_source_id_unique` ON `youtube_sync_targets` (`user_id`, `source`, `source_id`);
+-- Move the playlist of every connected account to a feed target
+INSERT INTO `youtube_sync_targets` (`id`, `created_at`, `updated_at`, `user_id`, `source`, `source_id`, `title`, `playlist_id`, `size`, `ordering`, `last_synced_at`, `last_sync_attempt_at`, `last_error`)
+SELECT lower(hex(randomblob(12))), `created_at`, `updated_at`, `user_id`, 'feed', '', 'Feedlr Sync', `playlist_id`, 36, 'source', `last_synced_at`, `last_sync_attempt_at`, `last_error`
+FROM `youtube_sync_accounts`;
@@ -0,0 +1,2 @@
+-- Add "granted_scopes" column to table: "youtube_sync_accounts"
+ALTER TABLE `youtube_sync_accounts` ADD COLUMN `granted_scopes` text NOT NULL DEFAULT '';
@@ -0,0 +1,21 @@
+-- Create "watch_history_imports" table
+CREATE TABLE `watch_history_imports` (
+  `id` text NOT NULL,
+  `created_at` date NOT NULL,
+  `updated_at` date NOT NULL,
+  `user_id` text NOT NULL,
+  `hide` boolean NOT NULL DEFAULT false,
+  `entries` text NOT NULL DEFAULT '[]',
+  `status` text NOT NULL,
+  `total` integer NOT NULL DEFAULT 0,
+  `processed` integer NOT NULL DEFAULT 0,
+  `imported` integer NOT NULL DEFAULT 0,
+  `skipped` integer NOT NULL DEFAULT 0,
+  `failed` integer NOT NULL DEFAULT 0,
+  `last_error` text NOT NULL DEFAULT '',
+  `finished_at` date NULL,
+  PRIMARY KEY (`id`),
+  CONSTRAINT `watch_history_imports_user_id_fkey` FOREIGN KEY (`user_id`) REFERENCES `users` (`id`) ON DELETE CASCADE
+);
+-- Create index "idx_watch_history_imports_user_id_created_at" to table: "watch_history_imports"
+CREATE INDEX `idx_watch_history_imports_user_id_created_at` ON `watch_history_imports` (`user_id`, `created_at`);
@@ -0,0 +1,8 @@
+-- Add auto sync settings and the last sync status to "playlists" table
+ALTER TABLE `playlists` ADD COLUMN `auto_sync_interval_hours` integer NOT NULL DEFAULT 0;
+ALTER TABLE `playlists` ADD COLUMN `sync_mode` text NOT NULL DEFAULT 'append';
+ALTER TABLE `playlists` ADD COLUMN `last_synced_at` date NULL;
+ALTER TABLE `playlists` ADD COLUMN `last_sync_error` text NOT NULL DEFAULT '';
+ALTER TABLE `playlists` ADD COLUMN `next_sync_at` date NULL;
+-- Create index "idx_playlists_next_sync_at" to table: "playlists"
+CREATE INDEX `idx_playlists_next_sync_at` ON `playlists` (`next_sync_at`);
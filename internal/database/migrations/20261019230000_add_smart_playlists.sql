@@ -0,0 +1,2 @@
+-- Add "rules" column to "playlists" table, set on smart playlists
+ALTER TABLE `playlists` ADD COLUMN `rules` text NULL;
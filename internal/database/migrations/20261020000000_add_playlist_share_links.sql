@@ -0,0 +1,15 @@
+-- Create "playlist_share_links" table
+CREATE TABLE `playlist_share_links` (
+  `id` text NOT NULL,
+  `created_at` date NOT NULL,
+  `updated_at` date NOT NULL,
+  `playlist_id` text NOT NULL,
+  `token_hash` text NOT NULL,
+  `expires_at` date NULL,
+  PRIMARY KEY (`id`),
+  CONSTRAINT `playlist_share_links_playlist_id_fkey` FOREIGN KEY (`playlist_id`) REFERENCES `playlists` (`id`) ON DELETE CASCADE
+);
+-- Create index "idx_playlist_share_links_playlist_id" to table: "playlist_share_links"
+CREATE INDEX `idx_playlist_share_links_playlist_id` ON `playlist_share_links` (`playlist_id`);
+-- Create index "idx_playlist_share_links_token_hash_unique" to table: "playlist_share_links"
+CREATE UNIQUE INDEX `idx_playlist_share_links_token_hash_unique` ON `playlist_share_links` (`token_hash`);
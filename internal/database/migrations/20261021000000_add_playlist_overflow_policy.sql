@@ -0,0 +1,2 @@
+-- Add "overflow_policy" column to "playlists" table, picks what happens when an item is added to a full playlist
+ALTER TABLE `playlists` ADD COLUMN `overflow_policy` text NOT NULL DEFAULT 'drop_oldest';
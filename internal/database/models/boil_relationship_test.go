@@ -10,22 +10,33 @@ import "testing"
 func TestToOne(t *testing.T) {
 	t.Run("PlaylistItemToVideoUsingVideo", testPlaylistItemToOneVideoUsingVideo)
 	t.Run("PlaylistItemToPlaylistUsingPlaylist", testPlaylistItemToOnePlaylistUsingPlaylist)
+	t.Run("PlaylistShareLinkToPlaylistUsingPlaylist", testPlaylistShareLinkToOnePlaylistUsingPlaylist)
 	t.Run("PlaylistToUserUsingUser", testPlaylistToOneUserUsingUser)
 	t.Run("SettingToUserUsingUser", testSettingToOneUserUsingUser)
+	t.Run("SponsorblockSubmissionToUserUsingUser", testSponsorblockSubmissionToOneUserUsingUser)
 	t.Run("SubscriptionToUserUsingUser", testSubscriptionToOneUserUsingUser)
 	t.Run("SubscriptionToChannelUsingChannel", testSubscriptionToOneChannelUsingChannel)
+	t.Run("VideoChapterToVideoUsingVideo", testVideoChapterToOneVideoUsingVideo)
+	t.Run("VideoTranscriptCheckToVideoUsingVideo", testVideoTranscriptCheckToOneVideoUsingVideo)
+	t.Run("VideoTranscriptTermToVideoTranscriptUsingTranscript", testVideoTranscriptTermToOneVideoTranscriptUsingTranscript)
+	t.Run("VideoTranscriptToVideoUsingVideo", testVideoTranscriptToOneVideoUsingVideo)
 	t.Run("VideoToChannelUsingChannel", testVideoToOneChannelUsingChannel)
 	t.Run("ViewToVideoUsingVideo", testViewToOneVideoUsingVideo)
 	t.Run("ViewToUserUsingUser", testViewToOneUserUsingUser)
+	t.Run("WatchHistoryImportToUserUsingUser", testWatchHistoryImportToOneUserUsingUser)
 	t.Run("YoutubeSyncAccountToUserUsingUser", testYoutubeSyncAccountToOneUserUsingUser)
+	t.Run("YoutubeSyncTargetToYoutubeSyncAccountUsingUser", testYoutubeSyncTargetToOneYoutubeSyncAccountUsingUser)
+	t.Run("YoutubeTVPairingTokenToUserUsingUser", testYoutubeTVPairingTokenToOneUserUsingUser)
 	t.Run("YoutubeTVSyncAccountToUserUsingUser", testYoutubeTVSyncAccountToOneUserUsingUser)
+	t.Run("YoutubeTVSyncEventToUserUsingUser", testYoutubeTVSyncEventToOneUserUsingUser)
+	t.Run("YoutubeTVSyncEventToYoutubeTVSyncAccountUsingAccount", testYoutubeTVSyncEventToOneYoutubeTVSyncAccountUsingAccount)
 }
 
 // TestOneToOne tests cannot be run in parallel
 // or deadlocks can occur.
 func TestOneToOne(t *testing.T) {
 	t.Run("UserToYoutubeSyncAccountUsingYoutubeSyncAccount", testUserOneToOneYoutubeSyncAccountUsingYoutubeSyncAccount)
-	t.Run("UserToYoutubeTVSyncAccountUsingYoutubeTVSyncAccount", testUserOneToOneYoutubeTVSyncAccountUsingYoutubeTVSyncAccount)
+	t.Run("VideoToVideoTranscriptCheckUsingVideoTranscriptCheck", testVideoOneToOneVideoTranscriptCheckUsingVideoTranscriptCheck)
 }
 
 // TestToMany tests cannot be run in parallel
@@ -34,12 +45,23 @@ func TestToMany(t *testing.T) {
 	t.Run("ChannelToSubscriptions", testChannelToManySubscriptions)
 	t.Run("ChannelToVideos", testChannelToManyVideos)
 	t.Run("PlaylistToPlaylistItems", testPlaylistToManyPlaylistItems)
+	t.Run("PlaylistToPlaylistShareLinks", testPlaylistToManyPlaylistShareLinks)
 	t.Run("UserToPlaylists", testUserToManyPlaylists)
 	t.Run("UserToSettings", testUserToManySettings)
+	t.Run("UserToSponsorblockSubmissions", testUserToManySponsorblockSubmissions)
 	t.Run("UserToSubscriptions", testUserToManySubscriptions)
 	t.Run("UserToViews", testUserToManyViews)
+	t.Run("UserToWatchHistoryImports", testUserToManyWatchHistoryImports)
+	t.Run("UserToYoutubeTVPairingTokens", testUserToManyYoutubeTVPairingTokens)
+	t.Run("UserToYoutubeTVSyncAccounts", testUserToManyYoutubeTVSyncAccounts)
+	t.Run("UserToYoutubeTVSyncEvents", testUserToManyYoutubeTVSyncEvents)
+	t.Run("VideoTranscriptToTranscriptVideoTranscriptTerms", testVideoTranscriptToManyTranscriptVideoTranscriptTerms)
 	t.Run("VideoToPlaylistItems", testVideoToManyPlaylistItems)
+	t.Run("VideoToVideoChapters", testVideoToManyVideoChapters)
+	t.Run("VideoToVideoTranscripts", testVideoToManyVideoTranscripts)
 	t.Run("VideoToViews", testVideoToManyViews)
+	t.Run("YoutubeSyncAccountToUserYoutubeSyncTargets", testYoutubeSyncAccountToManyUserYoutubeSyncTargets)
+	t.Run("YoutubeTVSyncAccountToAccountYoutubeTVSyncEvents", testYoutubeTVSyncAccountToManyAccountYoutubeTVSyncEvents)
 }
 
 // TestToOneSet tests cannot be run in parallel
@@ -47,15 +69,26 @@ func TestToMany(t *testing.T) {
 func TestToOneSet(t *testing.T) {
 	t.Run("PlaylistItemToVideoUsingPlaylistItems", testPlaylistItemToOneSetOpVideoUsingVideo)
 	t.Run("PlaylistItemToPlaylistUsingPlaylistItems", testPlaylistItemToOneSetOpPlaylistUsingPlaylist)
+	t.Run("PlaylistShareLinkToPlaylistUsingPlaylistShareLinks", testPlaylistShareLinkToOneSetOpPlaylistUsingPlaylist)
 	t.Run("PlaylistToUserUsingPlaylists", testPlaylistToOneSetOpUserUsingUser)
 	t.Run("SettingToUserUsingSettings", testSettingToOneSetOpUserUsingUser)
+	t.Run("SponsorblockSubmissionToUserUsingSponsorblockSubmissions", testSponsorblockSubmissionToOneSetOpUserUsingUser)
 	t.Run("SubscriptionToUserUsingSubscriptions", testSubscriptionToOneSetOpUserUsingUser)
 	t.Run("SubscriptionToChannelUsingSubscriptions", testSubscriptionToOneSetOpChannelUsingChannel)
+	t.Run("VideoChapterToVideoUsingVideoChapters", testVideoChapterToOneSetOpVideoUsingVideo)
+	t.Run("VideoTranscriptCheckToVideoUsingVideoTranscriptCheck", testVideoTranscriptCheckToOneSetOpVideoUsingVideo)
+	t.Run("VideoTranscriptTermToVideoTranscriptUsingTranscriptVideoTranscriptTerms", testVideoTranscriptTermToOneSetOpVideoTranscriptUsingTranscript)
+	t.Run("VideoTranscriptToVideoUsingVideoTranscripts", testVideoTranscriptToOneSetOpVideoUsingVideo)
 	t.Run("VideoToChannelUsingVideos", testVideoToOneSetOpChannelUsingChannel)
 	t.Run("ViewToVideoUsingViews", testViewToOneSetOpVideoUsingVideo)
 	t.Run("ViewToUserUsingViews", testViewToOneSetOpUserUsingUser)
+	t.Run("WatchHistoryImportToUserUsingWatchHistoryImports", testWatchHistoryImportToOneSetOpUserUsingUser)
 	t.Run("YoutubeSyncAccountToUserUsingYoutubeSyncAccount", testYoutubeSyncAccountToOneSetOpUserUsingUser)
-	t.Run("YoutubeTVSyncAccountToUserUsingYoutubeTVSyncAccount", testYoutubeTVSyncAccountToOneSetOpUserUsingUser)
+	t.Run("YoutubeSyncTargetToYoutubeSyncAccountUsingUserYoutubeSyncTargets", testYoutubeSyncTargetToOneSetOpYoutubeSyncAccountUsingUser)
+	t.Run("YoutubeTVPairingTokenToUserUsingYoutubeTVPairingTokens", testYoutubeTVPairingTokenToOneSetOpUserUsingUser)
+	t.Run("YoutubeTVSyncAccountToUserUsingYoutubeTVSyncAccounts", testYoutubeTVSyncAccountToOneSetOpUserUsingUser)
+	t.Run("YoutubeTVSyncEventToUserUsingYoutubeTVSyncEvents", testYoutubeTVSyncEventToOneSetOpUserUsingUser)
+	t.Run("YoutubeTVSyncEventToYoutubeTVSyncAccountUsingAccountYoutubeTVSyncEvents", testYoutubeTVSyncEventToOneSetOpYoutubeTVSyncAccountUsingAccount)
 }
 
 // TestToOneRemove tests cannot be run in parallel
@@ -66,7 +99,7 @@ func TestToOneRemove(t *testing.T) {}
 // or deadlocks can occur.
 func TestOneToOneSet(t *testing.T) {
 	t.Run("UserToYoutubeSyncAccountUsingYoutubeSyncAccount", testUserOneToOneSetOpYoutubeSyncAccountUsingYoutubeSyncAccount)
-	t.Run("UserToYoutubeTVSyncAccountUsingYoutubeTVSyncAccount", testUserOneToOneSetOpYoutubeTVSyncAccountUsingYoutubeTVSyncAccount)
+	t.Run("VideoToVideoTranscriptCheckUsingVideoTranscriptCheck", testVideoOneToOneSetOpVideoTranscriptCheckUsingVideoTranscriptCheck)
 }
 
 // TestOneToOneRemove tests cannot be run in parallel
@@ -79,12 +112,23 @@ func TestToManyAdd(t *testing.T) {
 	t.Run("ChannelToSubscriptions", testChannelToManyAddOpSubscriptions)
 	t.Run("ChannelToVideos", testChannelToManyAddOpVideos)
 	t.Run("PlaylistToPlaylistItems", testPlaylistToManyAddOpPlaylistItems)
+	t.Run("PlaylistToPlaylistShareLinks", testPlaylistToManyAddOpPlaylistShareLinks)
 	t.Run("UserToPlaylists", testUserToManyAddOpPlaylists)
 	t.Run("UserToSettings", testUserToManyAddOpSettings)
+	t.Run("UserToSponsorblockSubmissions", testUserToManyAddOpSponsorblockSubmissions)
 	t.Run("UserToSubscriptions", testUserToManyAddOpSubscriptions)
 	t.Run("UserToViews", testUserToManyAddOpViews)
+	t.Run("UserToWatchHistoryImports", testUserToManyAddOpWatchHistoryImports)
+	t.Run("UserToYoutubeTVPairingTokens", testUserToManyAddOpYoutubeTVPairingTokens)
+	t.Run("UserToYoutubeTVSyncAccounts", testUserToManyAddOpYoutubeTVSyncAccounts)
+	t.Run("UserToYoutubeTVSyncEvents", testUserToManyAddOpYoutubeTVSyncEvents)
+	t.Run("VideoTranscriptToTranscriptVideoTranscriptTerms", testVideoTranscriptToManyAddOpTranscriptVideoTranscriptTerms)
 	t.Run("VideoToPlaylistItems", testVideoToManyAddOpPlaylistItems)
+	t.Run("VideoToVideoChapters", testVideoToManyAddOpVideoChapters)
+	t.Run("VideoToVideoTranscripts", testVideoToManyAddOpVideoTranscripts)
 	t.Run("VideoToViews", testVideoToManyAddOpViews)
+	t.Run("YoutubeSyncAccountToUserYoutubeSyncTargets", testYoutubeSyncAccountToManyAddOpUserYoutubeSyncTargets)
+	t.Run("YoutubeTVSyncAccountToAccountYoutubeTVSyncEvents", testYoutubeTVSyncAccountToManyAddOpAccountYoutubeTVSyncEvents)
 }
 
 // TestToManySet tests cannot be run in parallel
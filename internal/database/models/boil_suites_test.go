@@ -14,177 +14,331 @@ import "testing"
 func TestParent(t *testing.T) {
 	t.Run("AppConfigurations", testAppConfigurations)
 	t.Run("Channels", testChannels)
+	t.Run("DearrowVideoBrandings", testDearrowVideoBrandings)
+	t.Run("Jobs", testJobs)
+	t.Run("Leases", testLeases)
 	t.Run("Passkeys", testPasskeys)
 	t.Run("PlaylistItems", testPlaylistItems)
+	t.Run("PlaylistShareLinks", testPlaylistShareLinks)
 	t.Run("Playlists", testPlaylists)
 	t.Run("Sessions", testSessions)
 	t.Run("Settings", testSettings)
+	t.Run("SponsorblockSubmissions", testSponsorblockSubmissions)
+	t.Run("SponsorblockVideoSegments", testSponsorblockVideoSegments)
 	t.Run("Subscriptions", testSubscriptions)
 	t.Run("Users", testUsers)
+	t.Run("VideoChapters", testVideoChapters)
+	t.Run("VideoTranscriptChecks", testVideoTranscriptChecks)
+	t.Run("VideoTranscriptTerms", testVideoTranscriptTerms)
+	t.Run("VideoTranscripts", testVideoTranscripts)
 	t.Run("Videos", testVideos)
 	t.Run("Views", testViews)
+	t.Run("WatchHistoryImports", testWatchHistoryImports)
 	t.Run("YoutubeSyncAccounts", testYoutubeSyncAccounts)
+	t.Run("YoutubeSyncTargets", testYoutubeSyncTargets)
+	t.Run("YoutubeTVPairingTokens", testYoutubeTVPairingTokens)
 	t.Run("YoutubeTVSyncAccounts", testYoutubeTVSyncAccounts)
+	t.Run("YoutubeTVSyncEvents", testYoutubeTVSyncEvents)
 }
 
 func TestDelete(t *testing.T) {
 	t.Run("AppConfigurations", testAppConfigurationsDelete)
 	t.Run("Channels", testChannelsDelete)
+	t.Run("DearrowVideoBrandings", testDearrowVideoBrandingsDelete)
+	t.Run("Jobs", testJobsDelete)
+	t.Run("Leases", testLeasesDelete)
 	t.Run("Passkeys", testPasskeysDelete)
 	t.Run("PlaylistItems", testPlaylistItemsDelete)
+	t.Run("PlaylistShareLinks", testPlaylistShareLinksDelete)
 	t.Run("Playlists", testPlaylistsDelete)
 	t.Run("Sessions", testSessionsDelete)
 	t.Run("Settings", testSettingsDelete)
+	t.Run("SponsorblockSubmissions", testSponsorblockSubmissionsDelete)
+	t.Run("SponsorblockVideoSegments", testSponsorblockVideoSegmentsDelete)
 	t.Run("Subscriptions", testSubscriptionsDelete)
 	t.Run("Users", testUsersDelete)
+	t.Run("VideoChapters", testVideoChaptersDelete)
+	t.Run("VideoTranscriptChecks", testVideoTranscriptChecksDelete)
+	t.Run("VideoTranscriptTerms", testVideoTranscriptTermsDelete)
+	t.Run("VideoTranscripts", testVideoTranscriptsDelete)
 	t.Run("Videos", testVideosDelete)
 	t.Run("Views", testViewsDelete)
+	t.Run("WatchHistoryImports", testWatchHistoryImportsDelete)
 	t.Run("YoutubeSyncAccounts", testYoutubeSyncAccountsDelete)
+	t.Run("YoutubeSyncTargets", testYoutubeSyncTargetsDelete)
+	t.Run("YoutubeTVPairingTokens", testYoutubeTVPairingTokensDelete)
 	t.Run("YoutubeTVSyncAccounts", testYoutubeTVSyncAccountsDelete)
+	t.Run("YoutubeTVSyncEvents", testYoutubeTVSyncEventsDelete)
 }
 
 func TestQueryDeleteAll(t *testing.T) {
 	t.Run("AppConfigurations", testAppConfigurationsQueryDeleteAll)
 	t.Run("Channels", testChannelsQueryDeleteAll)
+	t.Run("DearrowVideoBrandings", testDearrowVideoBrandingsQueryDeleteAll)
+	t.Run("Jobs", testJobsQueryDeleteAll)
+	t.Run("Leases", testLeasesQueryDeleteAll)
 	t.Run("Passkeys", testPasskeysQueryDeleteAll)
 	t.Run("PlaylistItems", testPlaylistItemsQueryDeleteAll)
+	t.Run("PlaylistShareLinks", testPlaylistShareLinksQueryDeleteAll)
 	t.Run("Playlists", testPlaylistsQueryDeleteAll)
 	t.Run("Sessions", testSessionsQueryDeleteAll)
 	t.Run("Settings", testSettingsQueryDeleteAll)
+	t.Run("SponsorblockSubmissions", testSponsorblockSubmissionsQueryDeleteAll)
+	t.Run("SponsorblockVideoSegments", testSponsorblockVideoSegmentsQueryDeleteAll)
 	t.Run("Subscriptions", testSubscriptionsQueryDeleteAll)
 	t.Run("Users", testUsersQueryDeleteAll)
+	t.Run("VideoChapters", testVideoChaptersQueryDeleteAll)
+	t.Run("VideoTranscriptChecks", testVideoTranscriptChecksQueryDeleteAll)
+	t.Run("VideoTranscriptTerms", testVideoTranscriptTermsQueryDeleteAll)
+	t.Run("VideoTranscripts", testVideoTranscriptsQueryDeleteAll)
 	t.Run("Videos", testVideosQueryDeleteAll)
 	t.Run("Views", testViewsQueryDeleteAll)
+	t.Run("WatchHistoryImports", testWatchHistoryImportsQueryDeleteAll)
 	t.Run("YoutubeSyncAccounts", testYoutubeSyncAccountsQueryDeleteAll)
+	t.Run("YoutubeSyncTargets", testYoutubeSyncTargetsQueryDeleteAll)
+	t.Run("YoutubeTVPairingTokens", testYoutubeTVPairingTokensQueryDeleteAll)
 	t.Run("YoutubeTVSyncAccounts", testYoutubeTVSyncAccountsQueryDeleteAll)
+	t.Run("YoutubeTVSyncEvents", testYoutubeTVSyncEventsQueryDeleteAll)
 }
 
 func TestSliceDeleteAll(t *testing.T) {
 	t.Run("AppConfigurations", testAppConfigurationsSliceDeleteAll)
 	t.Run("Channels", testChannelsSliceDeleteAll)
+	t.Run("DearrowVideoBrandings", testDearrowVideoBrandingsSliceDeleteAll)
+	t.Run("Jobs", testJobsSliceDeleteAll)
+	t.Run("Leases", testLeasesSliceDeleteAll)
 	t.Run("Passkeys", testPasskeysSliceDeleteAll)
 	t.Run("PlaylistItems", testPlaylistItemsSliceDeleteAll)
+	t.Run("PlaylistShareLinks", testPlaylistShareLinksSliceDeleteAll)
 	t.Run("Playlists", testPlaylistsSliceDeleteAll)
 	t.Run("Sessions", testSessionsSliceDeleteAll)
 	t.Run("Settings", testSettingsSliceDeleteAll)
+	t.Run("SponsorblockSubmissions", testSponsorblockSubmissionsSliceDeleteAll)
+	t.Run("SponsorblockVideoSegments", testSponsorblockVideoSegmentsSliceDeleteAll)
 	t.Run("Subscriptions", testSubscriptionsSliceDeleteAll)
 	t.Run("Users", testUsersSliceDeleteAll)
+	t.Run("VideoChapters", testVideoChaptersSliceDeleteAll)
+	t.Run("VideoTranscriptChecks", testVideoTranscriptChecksSliceDeleteAll)
+	t.Run("VideoTranscriptTerms", testVideoTranscriptTermsSliceDeleteAll)
+	t.Run("VideoTranscripts", testVideoTranscriptsSliceDeleteAll)
 	t.Run("Videos", testVideosSliceDeleteAll)
 	t.Run("Views", testViewsSliceDeleteAll)
+	t.Run("WatchHistoryImports", testWatchHistoryImportsSliceDeleteAll)
 	t.Run("YoutubeSyncAccounts", testYoutubeSyncAccountsSliceDeleteAll)
+	t.Run("YoutubeSyncTargets", testYoutubeSyncTargetsSliceDeleteAll)
+	t.Run("YoutubeTVPairingTokens", testYoutubeTVPairingTokensSliceDeleteAll)
 	t.Run("YoutubeTVSyncAccounts", testYoutubeTVSyncAccountsSliceDeleteAll)
+	t.Run("YoutubeTVSyncEvents", testYoutubeTVSyncEventsSliceDeleteAll)
 }
 
 func TestExists(t *testing.T) {
 	t.Run("AppConfigurations", testAppConfigurationsExists)
 	t.Run("Channels", testChannelsExists)
+	t.Run("DearrowVideoBrandings", testDearrowVideoBrandingsExists)
+	t.Run("Jobs", testJobsExists)
+	t.Run("Leases", testLeasesExists)
 	t.Run("Passkeys", testPasskeysExists)
 	t.Run("PlaylistItems", testPlaylistItemsExists)
+	t.Run("PlaylistShareLinks", testPlaylistShareLinksExists)
 	t.Run("Playlists", testPlaylistsExists)
 	t.Run("Sessions", testSessionsExists)
 	t.Run("Settings", testSettingsExists)
+	t.Run("SponsorblockSubmissions", testSponsorblockSubmissionsExists)
+	t.Run("SponsorblockVideoSegments", testSponsorblockVideoSegmentsExists)
 	t.Run("Subscriptions", testSubscriptionsExists)
 	t.Run("Users", testUsersExists)
+	t.Run("VideoChapters", testVideoChaptersExists)
+	t.Run("VideoTranscriptChecks", testVideoTranscriptChecksExists)
+	t.Run("VideoTranscriptTerms", testVideoTranscriptTermsExists)
+	t.Run("VideoTranscripts", testVideoTranscriptsExists)
 	t.Run("Videos", testVideosExists)
 	t.Run("Views", testViewsExists)
+	t.Run("WatchHistoryImports", testWatchHistoryImportsExists)
 	t.Run("YoutubeSyncAccounts", testYoutubeSyncAccountsExists)
+	t.Run("YoutubeSyncTargets", testYoutubeSyncTargetsExists)
+	t.Run("YoutubeTVPairingTokens", testYoutubeTVPairingTokensExists)
 	t.Run("YoutubeTVSyncAccounts", testYoutubeTVSyncAccountsExists)
+	t.Run("YoutubeTVSyncEvents", testYoutubeTVSyncEventsExists)
 }
 
 func TestFind(t *testing.T) {
 	t.Run("AppConfigurations", testAppConfigurationsFind)
 	t.Run("Channels", testChannelsFind)
+	t.Run("DearrowVideoBrandings", testDearrowVideoBrandingsFind)
+	t.Run("Jobs", testJobsFind)
+	t.Run("Leases", testLeasesFind)
 	t.Run("Passkeys", testPasskeysFind)
 	t.Run("PlaylistItems", testPlaylistItemsFind)
+	t.Run("PlaylistShareLinks", testPlaylistShareLinksFind)
 	t.Run("Playlists", testPlaylistsFind)
 	t.Run("Sessions", testSessionsFind)
 	t.Run("Settings", testSettingsFind)
+	t.Run("SponsorblockSubmissions", testSponsorblockSubmissionsFind)
+	t.Run("SponsorblockVideoSegments", testSponsorblockVideoSegmentsFind)
 	t.Run("Subscriptions", testSubscriptionsFind)
 	t.Run("Users", testUsersFind)
+	t.Run("VideoChapters", testVideoChaptersFind)
+	t.Run("VideoTranscriptChecks", testVideoTranscriptChecksFind)
+	t.Run("VideoTranscriptTerms", testVideoTranscriptTermsFind)
+	t.Run("VideoTranscripts", testVideoTranscriptsFind)
 	t.Run("Videos", testVideosFind)
 	t.Run("Views", testViewsFind)
+	t.Run("WatchHistoryImports", testWatchHistoryImportsFind)
 	t.Run("YoutubeSyncAccounts", testYoutubeSyncAccountsFind)
+	t.Run("YoutubeSyncTargets", testYoutubeSyncTargetsFind)
+	t.Run("YoutubeTVPairingTokens", testYoutubeTVPairingTokensFind)
 	t.Run("YoutubeTVSyncAccounts", testYoutubeTVSyncAccountsFind)
+	t.Run("YoutubeTVSyncEvents", testYoutubeTVSyncEventsFind)
 }
 
 func TestBind(t *testing.T) {
 	t.Run("AppConfigurations", testAppConfigurationsBind)
 	t.Run("Channels", testChannelsBind)
+	t.Run("DearrowVideoBrandings", testDearrowVideoBrandingsBind)
+	t.Run("Jobs", testJobsBind)
+	t.Run("Leases", testLeasesBind)
 	t.Run("Passkeys", testPasskeysBind)
 	t.Run("PlaylistItems", testPlaylistItemsBind)
+	t.Run("PlaylistShareLinks", testPlaylistShareLinksBind)
 	t.Run("Playlists", testPlaylistsBind)
 	t.Run("Sessions", testSessionsBind)
 	t.Run("Settings", testSettingsBind)
+	t.Run("SponsorblockSubmissions", testSponsorblockSubmissionsBind)
+	t.Run("SponsorblockVideoSegments", testSponsorblockVideoSegmentsBind)
 	t.Run("Subscriptions", testSubscriptionsBind)
 	t.Run("Users", testUsersBind)
+	t.Run("VideoChapters", testVideoChaptersBind)
+	t.Run("VideoTranscriptChecks", testVideoTranscriptChecksBind)
+	t.Run("VideoTranscriptTerms", testVideoTranscriptTermsBind)
+	t.Run("VideoTranscripts", testVideoTranscriptsBind)
 	t.Run("Videos", testVideosBind)
 	t.Run("Views", testViewsBind)
+	t.Run("WatchHistoryImports", testWatchHistoryImportsBind)
 	t.Run("YoutubeSyncAccounts", testYoutubeSyncAccountsBind)
+	t.Run("YoutubeSyncTargets", testYoutubeSyncTargetsBind)
+	t.Run("YoutubeTVPairingTokens", testYoutubeTVPairingTokensBind)
 	t.Run("YoutubeTVSyncAccounts", testYoutubeTVSyncAccountsBind)
+	t.Run("YoutubeTVSyncEvents", testYoutubeTVSyncEventsBind)
 }
 
 func TestOne(t *testing.T) {
 	t.Run("AppConfigurations", testAppConfigurationsOne)
 	t.Run("Channels", testChannelsOne)
+	t.Run("DearrowVideoBrandings", testDearrowVideoBrandingsOne)
+	t.Run("Jobs", testJobsOne)
+	t.Run("Leases", testLeasesOne)
 	t.Run("Passkeys", testPasskeysOne)
 	t.Run("PlaylistItems", testPlaylistItemsOne)
+	t.Run("PlaylistShareLinks", testPlaylistShareLinksOne)
 	t.Run("Playlists", testPlaylistsOne)
 	t.Run("Sessions", testSessionsOne)
 	t.Run("Settings", testSettingsOne)
+	t.Run("SponsorblockSubmissions", testSponsorblockSubmissionsOne)
+	t.Run("SponsorblockVideoSegments", testSponsorblockVideoSegmentsOne)
 	t.Run("Subscriptions", testSubscriptionsOne)
 	t.Run("Users", testUsersOne)
+	t.Run("VideoChapters", testVideoChaptersOne)
+	t.Run("VideoTranscriptChecks", testVideoTranscriptChecksOne)
+	t.Run("VideoTranscriptTerms", testVideoTranscriptTermsOne)
+	t.Run("VideoTranscripts", testVideoTranscriptsOne)
 	t.Run("Videos", testVideosOne)
 	t.Run("Views", testViewsOne)
+	t.Run("WatchHistoryImports", testWatchHistoryImportsOne)
 	t.Run("YoutubeSyncAccounts", testYoutubeSyncAccountsOne)
+	t.Run("YoutubeSyncTargets", testYoutubeSyncTargetsOne)
+	t.Run("YoutubeTVPairingTokens", testYoutubeTVPairingTokensOne)
 	t.Run("YoutubeTVSyncAccounts", testYoutubeTVSyncAccountsOne)
+	t.Run("YoutubeTVSyncEvents", testYoutubeTVSyncEventsOne)
 }
 
 func TestAll(t *testing.T) {
 	t.Run("AppConfigurations", testAppConfigurationsAll)
 	t.Run("Channels", testChannelsAll)
+	t.Run("DearrowVideoBrandings", testDearrowVideoBrandingsAll)
+	t.Run("Jobs", testJobsAll)
+	t.Run("Leases", testLeasesAll)
 	t.Run("Passkeys", testPasskeysAll)
 	t.Run("PlaylistItems", testPlaylistItemsAll)
+	t.Run("PlaylistShareLinks", testPlaylistShareLinksAll)
 	t.Run("Playlists", testPlaylistsAll)
 	t.Run("Sessions", testSessionsAll)
 	t.Run("Settings", testSettingsAll)
+	t.Run("SponsorblockSubmissions", testSponsorblockSubmissionsAll)
+	t.Run("SponsorblockVideoSegments", testSponsorblockVideoSegmentsAll)
 	t.Run("Subscriptions", testSubscriptionsAll)
 	t.Run("Users", testUsersAll)
+	t.Run("VideoChapters", testVideoChaptersAll)
+	t.Run("VideoTranscriptChecks", testVideoTranscriptChecksAll)
+	t.Run("VideoTranscriptTerms", testVideoTranscriptTermsAll)
+	t.Run("VideoTranscripts", testVideoTranscriptsAll)
 	t.Run("Videos", testVideosAll)
 	t.Run("Views", testViewsAll)
+	t.Run("WatchHistoryImports", testWatchHistoryImportsAll)
 	t.Run("YoutubeSyncAccounts", testYoutubeSyncAccountsAll)
+	t.Run("YoutubeSyncTargets", testYoutubeSyncTargetsAll)
+	t.Run("YoutubeTVPairingTokens", testYoutubeTVPairingTokensAll)
 	t.Run("YoutubeTVSyncAccounts", testYoutubeTVSyncAccountsAll)
+	t.Run("YoutubeTVSyncEvents", testYoutubeTVSyncEventsAll)
 }
 
 func TestCount(t *testing.T) {
 	t.Run("AppConfigurations", testAppConfigurationsCount)
 	t.Run("Channels", testChannelsCount)
+	t.Run("DearrowVideoBrandings", testDearrowVideoBrandingsCount)
+	t.Run("Jobs", testJobsCount)
+	t.Run("Leases", testLeasesCount)
 	t.Run("Passkeys", testPasskeysCount)
 	t.Run("PlaylistItems", testPlaylistItemsCount)
+	t.Run("PlaylistShareLinks", testPlaylistShareLinksCount)
 	t.Run("Playlists", testPlaylistsCount)
 	t.Run("Sessions", testSessionsCount)
 	t.Run("Settings", testSettingsCount)
+	t.Run("SponsorblockSubmissions", testSponsorblockSubmissionsCount)
+	t.Run("SponsorblockVideoSegments", testSponsorblockVideoSegmentsCount)
 	t.Run("Subscriptions", testSubscriptionsCount)
 	t.Run("Users", testUsersCount)
+	t.Run("VideoChapters", testVideoChaptersCount)
+	t.Run("VideoTranscriptChecks", testVideoTranscriptChecksCount)
+	t.Run("VideoTranscriptTerms", testVideoTranscriptTermsCount)
+	t.Run("VideoTranscripts", testVideoTranscriptsCount)
 	t.Run("Videos", testVideosCount)
 	t.Run("Views", testViewsCount)
+	t.Run("WatchHistoryImports", testWatchHistoryImportsCount)
 	t.Run("YoutubeSyncAccounts", testYoutubeSyncAccountsCount)
+	t.Run("YoutubeSyncTargets", testYoutubeSyncTargetsCount)
+	t.Run("YoutubeTVPairingTokens", testYoutubeTVPairingTokensCount)
 	t.Run("YoutubeTVSyncAccounts", testYoutubeTVSyncAccountsCount)
+	t.Run("YoutubeTVSyncEvents", testYoutubeTVSyncEventsCount)
 }
 
 func TestHooks(t *testing.T) {
 	t.Run("AppConfigurations", testAppConfigurationsHooks)
 	t.Run("Channels", testChannelsHooks)
+	t.Run("DearrowVideoBrandings", testDearrowVideoBrandingsHooks)
+	t.Run("Jobs", testJobsHooks)
+	t.Run("Leases", testLeasesHooks)
 	t.Run("Passkeys", testPasskeysHooks)
 	t.Run("PlaylistItems", testPlaylistItemsHooks)
+	t.Run("PlaylistShareLinks", testPlaylistShareLinksHooks)
 	t.Run("Playlists", testPlaylistsHooks)
 	t.Run("Sessions", testSessionsHooks)
 	t.Run("Settings", testSettingsHooks)
+	t.Run("SponsorblockSubmissions", testSponsorblockSubmissionsHooks)
+	t.Run("SponsorblockVideoSegments", testSponsorblockVideoSegmentsHooks)
 	t.Run("Subscriptions", testSubscriptionsHooks)
 	t.Run("Users", testUsersHooks)
+	t.Run("VideoChapters", testVideoChaptersHooks)
+	t.Run("VideoTranscriptChecks", testVideoTranscriptChecksHooks)
+	t.Run("VideoTranscriptTerms", testVideoTranscriptTermsHooks)
+	t.Run("VideoTranscripts", testVideoTranscriptsHooks)
 	t.Run("Videos", testVideosHooks)
 	t.Run("Views", testViewsHooks)
+	t.Run("WatchHistoryImports", testWatchHistoryImportsHooks)
 	t.Run("YoutubeSyncAccounts", testYoutubeSyncAccountsHooks)
+	t.Run("YoutubeSyncTargets", testYoutubeSyncTargetsHooks)
+	t.Run("YoutubeTVPairingTokens", testYoutubeTVPairingTokensHooks)
 	t.Run("YoutubeTVSyncAccounts", testYoutubeTVSyncAccountsHooks)
+	t.Run("YoutubeTVSyncEvents", testYoutubeTVSyncEventsHooks)
 }
 
 func TestInsert(t *testing.T) {
@@ -192,106 +346,204 @@ func TestInsert(t *testing.T) {
 	t.Run("AppConfigurations", testAppConfigurationsInsertWhitelist)
 	t.Run("Channels", testChannelsInsert)
 	t.Run("Channels", testChannelsInsertWhitelist)
+	t.Run("DearrowVideoBrandings", testDearrowVideoBrandingsInsert)
+	t.Run("DearrowVideoBrandings", testDearrowVideoBrandingsInsertWhitelist)
+	t.Run("Jobs", testJobsInsert)
+	t.Run("Jobs", testJobsInsertWhitelist)
+	t.Run("Leases", testLeasesInsert)
+	t.Run("Leases", testLeasesInsertWhitelist)
 	t.Run("Passkeys", testPasskeysInsert)
 	t.Run("Passkeys", testPasskeysInsertWhitelist)
 	t.Run("PlaylistItems", testPlaylistItemsInsert)
 	t.Run("PlaylistItems", testPlaylistItemsInsertWhitelist)
+	t.Run("PlaylistShareLinks", testPlaylistShareLinksInsert)
+	t.Run("PlaylistShareLinks", testPlaylistShareLinksInsertWhitelist)
 	t.Run("Playlists", testPlaylistsInsert)
 	t.Run("Playlists", testPlaylistsInsertWhitelist)
 	t.Run("Sessions", testSessionsInsert)
 	t.Run("Sessions", testSessionsInsertWhitelist)
 	t.Run("Settings", testSettingsInsert)
 	t.Run("Settings", testSettingsInsertWhitelist)
+	t.Run("SponsorblockSubmissions", testSponsorblockSubmissionsInsert)
+	t.Run("SponsorblockSubmissions", testSponsorblockSubmissionsInsertWhitelist)
+	t.Run("SponsorblockVideoSegments", testSponsorblockVideoSegmentsInsert)
+	t.Run("SponsorblockVideoSegments", testSponsorblockVideoSegmentsInsertWhitelist)
 	t.Run("Subscriptions", testSubscriptionsInsert)
 	t.Run("Subscriptions", testSubscriptionsInsertWhitelist)
 	t.Run("Users", testUsersInsert)
 	t.Run("Users", testUsersInsertWhitelist)
+	t.Run("VideoChapters", testVideoChaptersInsert)
+	t.Run("VideoChapters", testVideoChaptersInsertWhitelist)
+	t.Run("VideoTranscriptChecks", testVideoTranscriptChecksInsert)
+	t.Run("VideoTranscriptChecks", testVideoTranscriptChecksInsertWhitelist)
+	t.Run("VideoTranscriptTerms", testVideoTranscriptTermsInsert)
+	t.Run("VideoTranscriptTerms", testVideoTranscriptTermsInsertWhitelist)
+	t.Run("VideoTranscripts", testVideoTranscriptsInsert)
+	t.Run("VideoTranscripts", testVideoTranscriptsInsertWhitelist)
 	t.Run("Videos", testVideosInsert)
 	t.Run("Videos", testVideosInsertWhitelist)
 	t.Run("Views", testViewsInsert)
 	t.Run("Views", testViewsInsertWhitelist)
+	t.Run("WatchHistoryImports", testWatchHistoryImportsInsert)
+	t.Run("WatchHistoryImports", testWatchHistoryImportsInsertWhitelist)
 	t.Run("YoutubeSyncAccounts", testYoutubeSyncAccountsInsert)
 	t.Run("YoutubeSyncAccounts", testYoutubeSyncAccountsInsertWhitelist)
+	t.Run("YoutubeSyncTargets", testYoutubeSyncTargetsInsert)
+	t.Run("YoutubeSyncTargets", testYoutubeSyncTargetsInsertWhitelist)
+	t.Run("YoutubeTVPairingTokens", testYoutubeTVPairingTokensInsert)
+	t.Run("YoutubeTVPairingTokens", testYoutubeTVPairingTokensInsertWhitelist)
 	t.Run("YoutubeTVSyncAccounts", testYoutubeTVSyncAccountsInsert)
 	t.Run("YoutubeTVSyncAccounts", testYoutubeTVSyncAccountsInsertWhitelist)
+	t.Run("YoutubeTVSyncEvents", testYoutubeTVSyncEventsInsert)
+	t.Run("YoutubeTVSyncEvents", testYoutubeTVSyncEventsInsertWhitelist)
 }
 
 func TestReload(t *testing.T) {
 	t.Run("AppConfigurations", testAppConfigurationsReload)
 	t.Run("Channels", testChannelsReload)
+	t.Run("DearrowVideoBrandings", testDearrowVideoBrandingsReload)
+	t.Run("Jobs", testJobsReload)
+	t.Run("Leases", testLeasesReload)
 	t.Run("Passkeys", testPasskeysReload)
 	t.Run("PlaylistItems", testPlaylistItemsReload)
+	t.Run("PlaylistShareLinks", testPlaylistShareLinksReload)
 	t.Run("Playlists", testPlaylistsReload)
 	t.Run("Sessions", testSessionsReload)
 	t.Run("Settings", testSettingsReload)
+	t.Run("SponsorblockSubmissions", testSponsorblockSubmissionsReload)
+	t.Run("SponsorblockVideoSegments", testSponsorblockVideoSegmentsReload)
 	t.Run("Subscriptions", testSubscriptionsReload)
 	t.Run("Users", testUsersReload)
+	t.Run("VideoChapters", testVideoChaptersReload)
+	t.Run("VideoTranscriptChecks", testVideoTranscriptChecksReload)
+	t.Run("VideoTranscriptTerms", testVideoTranscriptTermsReload)
+	t.Run("VideoTranscripts", testVideoTranscriptsReload)
 	t.Run("Videos", testVideosReload)
 	t.Run("Views", testViewsReload)
+	t.Run("WatchHistoryImports", testWatchHistoryImportsReload)
 	t.Run("YoutubeSyncAccounts", testYoutubeSyncAccountsReload)
+	t.Run("YoutubeSyncTargets", testYoutubeSyncTargetsReload)
+	t.Run("YoutubeTVPairingTokens", testYoutubeTVPairingTokensReload)
 	t.Run("YoutubeTVSyncAccounts", testYoutubeTVSyncAccountsReload)
+	t.Run("YoutubeTVSyncEvents", testYoutubeTVSyncEventsReload)
 }
 
 func TestReloadAll(t *testing.T) {
 	t.Run("AppConfigurations", testAppConfigurationsReloadAll)
 	t.Run("Channels", testChannelsReloadAll)
+	t.Run("DearrowVideoBrandings", testDearrowVideoBrandingsReloadAll)
+	t.Run("Jobs", testJobsReloadAll)
+	t.Run("Leases", testLeasesReloadAll)
 	t.Run("Passkeys", testPasskeysReloadAll)
 	t.Run("PlaylistItems", testPlaylistItemsReloadAll)
+	t.Run("PlaylistShareLinks", testPlaylistShareLinksReloadAll)
 	t.Run("Playlists", testPlaylistsReloadAll)
 	t.Run("Sessions", testSessionsReloadAll)
 	t.Run("Settings", testSettingsReloadAll)
+	t.Run("SponsorblockSubmissions", testSponsorblockSubmissionsReloadAll)
+	t.Run("SponsorblockVideoSegments", testSponsorblockVideoSegmentsReloadAll)
 	t.Run("Subscriptions", testSubscriptionsReloadAll)
 	t.Run("Users", testUsersReloadAll)
+	t.Run("VideoChapters", testVideoChaptersReloadAll)
+	t.Run("VideoTranscriptChecks", testVideoTranscriptChecksReloadAll)
+	t.Run("VideoTranscriptTerms", testVideoTranscriptTermsReloadAll)
+	t.Run("VideoTranscripts", testVideoTranscriptsReloadAll)
 	t.Run("Videos", testVideosReloadAll)
 	t.Run("Views", testViewsReloadAll)
+	t.Run("WatchHistoryImports", testWatchHistoryImportsReloadAll)
 	t.Run("YoutubeSyncAccounts", testYoutubeSyncAccountsReloadAll)
+	t.Run("YoutubeSyncTargets", testYoutubeSyncTargetsReloadAll)
+	t.Run("YoutubeTVPairingTokens", testYoutubeTVPairingTokensReloadAll)
 	t.Run("YoutubeTVSyncAccounts", testYoutubeTVSyncAccountsReloadAll)
+	t.Run("YoutubeTVSyncEvents", testYoutubeTVSyncEventsReloadAll)
 }
 
 func TestSelect(t *testing.T) {
 	t.Run("AppConfigurations", testAppConfigurationsSelect)
 	t.Run("Channels", testChannelsSelect)
+	t.Run("DearrowVideoBrandings", testDearrowVideoBrandingsSelect)
+	t.Run("Jobs", testJobsSelect)
+	t.Run("Leases", testLeasesSelect)
 	t.Run("Passkeys", testPasskeysSelect)
 	t.Run("PlaylistItems", testPlaylistItemsSelect)
+	t.Run("PlaylistShareLinks", testPlaylistShareLinksSelect)
 	t.Run("Playlists", testPlaylistsSelect)
 	t.Run("Sessions", testSessionsSelect)
 	t.Run("Settings", testSettingsSelect)
+	t.Run("SponsorblockSubmissions", testSponsorblockSubmissionsSelect)
+	t.Run("SponsorblockVideoSegments", testSponsorblockVideoSegmentsSelect)
 	t.Run("Subscriptions", testSubscriptionsSelect)
 	t.Run("Users", testUsersSelect)
+	t.Run("VideoChapters", testVideoChaptersSelect)
+	t.Run("VideoTranscriptChecks", testVideoTranscriptChecksSelect)
+	t.Run("VideoTranscriptTerms", testVideoTranscriptTermsSelect)
+	t.Run("VideoTranscripts", testVideoTranscriptsSelect)
 	t.Run("Videos", testVideosSelect)
 	t.Run("Views", testViewsSelect)
+	t.Run("WatchHistoryImports", testWatchHistoryImportsSelect)
 	t.Run("YoutubeSyncAccounts", testYoutubeSyncAccountsSelect)
+	t.Run("YoutubeSyncTargets", testYoutubeSyncTargetsSelect)
+	t.Run("YoutubeTVPairingTokens", testYoutubeTVPairingTokensSelect)
 	t.Run("YoutubeTVSyncAccounts", testYoutubeTVSyncAccountsSelect)
+	t.Run("YoutubeTVSyncEvents", testYoutubeTVSyncEventsSelect)
 }
 
 func TestUpdate(t *testing.T) {
 	t.Run("AppConfigurations", testAppConfigurationsUpdate)
 	t.Run("Channels", testChannelsUpdate)
+	t.Run("DearrowVideoBrandings", testDearrowVideoBrandingsUpdate)
+	t.Run("Jobs", testJobsUpdate)
+	t.Run("Leases", testLeasesUpdate)
 	t.Run("Passkeys", testPasskeysUpdate)
 	t.Run("PlaylistItems", testPlaylistItemsUpdate)
+	t.Run("PlaylistShareLinks", testPlaylistShareLinksUpdate)
 	t.Run("Playlists", testPlaylistsUpdate)
 	t.Run("Sessions", testSessionsUpdate)
 	t.Run("Settings", testSettingsUpdate)
+	t.Run("SponsorblockSubmissions", testSponsorblockSubmissionsUpdate)
+	t.Run("SponsorblockVideoSegments", testSponsorblockVideoSegmentsUpdate)
 	t.Run("Subscriptions", testSubscriptionsUpdate)
 	t.Run("Users", testUsersUpdate)
+	t.Run("VideoChapters", testVideoChaptersUpdate)
+	t.Run("VideoTranscriptChecks", testVideoTranscriptChecksUpdate)
+	t.Run("VideoTranscriptTerms", testVideoTranscriptTermsUpdate)
+	t.Run("VideoTranscripts", testVideoTranscriptsUpdate)
 	t.Run("Videos", testVideosUpdate)
 	t.Run("Views", testViewsUpdate)
+	t.Run("WatchHistoryImports", testWatchHistoryImportsUpdate)
 	t.Run("YoutubeSyncAccounts", testYoutubeSyncAccountsUpdate)
+	t.Run("YoutubeSyncTargets", testYoutubeSyncTargetsUpdate)
+	t.Run("YoutubeTVPairingTokens", testYoutubeTVPairingTokensUpdate)
 	t.Run("YoutubeTVSyncAccounts", testYoutubeTVSyncAccountsUpdate)
+	t.Run("YoutubeTVSyncEvents", testYoutubeTVSyncEventsUpdate)
 }
 
 func TestSliceUpdateAll(t *testing.T) {
 	t.Run("AppConfigurations", testAppConfigurationsSliceUpdateAll)
 	t.Run("Channels", testChannelsSliceUpdateAll)
+	t.Run("DearrowVideoBrandings", testDearrowVideoBrandingsSliceUpdateAll)
+	t.Run("Jobs", testJobsSliceUpdateAll)
+	t.Run("Leases", testLeasesSliceUpdateAll)
 	t.Run("Passkeys", testPasskeysSliceUpdateAll)
 	t.Run("PlaylistItems", testPlaylistItemsSliceUpdateAll)
+	t.Run("PlaylistShareLinks", testPlaylistShareLinksSliceUpdateAll)
 	t.Run("Playlists", testPlaylistsSliceUpdateAll)
 	t.Run("Sessions", testSessionsSliceUpdateAll)
 	t.Run("Settings", testSettingsSliceUpdateAll)
+	t.Run("SponsorblockSubmissions", testSponsorblockSubmissionsSliceUpdateAll)
+	t.Run("SponsorblockVideoSegments", testSponsorblockVideoSegmentsSliceUpdateAll)
 	t.Run("Subscriptions", testSubscriptionsSliceUpdateAll)
 	t.Run("Users", testUsersSliceUpdateAll)
+	t.Run("VideoChapters", testVideoChaptersSliceUpdateAll)
+	t.Run("VideoTranscriptChecks", testVideoTranscriptChecksSliceUpdateAll)
+	t.Run("VideoTranscriptTerms", testVideoTranscriptTermsSliceUpdateAll)
+	t.Run("VideoTranscripts", testVideoTranscriptsSliceUpdateAll)
 	t.Run("Videos", testVideosSliceUpdateAll)
 	t.Run("Views", testViewsSliceUpdateAll)
+	t.Run("WatchHistoryImports", testWatchHistoryImportsSliceUpdateAll)
 	t.Run("YoutubeSyncAccounts", testYoutubeSyncAccountsSliceUpdateAll)
+	t.Run("YoutubeSyncTargets", testYoutubeSyncTargetsSliceUpdateAll)
+	t.Run("YoutubeTVPairingTokens", testYoutubeTVPairingTokensSliceUpdateAll)
 	t.Run("YoutubeTVSyncAccounts", testYoutubeTVSyncAccountsSliceUpdateAll)
+	t.Run("YoutubeTVSyncEvents", testYoutubeTVSyncEventsSliceUpdateAll)
 }
@@ -4,31 +4,59 @@
 package models
 
 var TableNames = struct {
-	AppConfiguration      string
-	Channels              string
-	Passkeys              string
-	PlaylistItems         string
-	Playlists             string
-	Sessions              string
-	Settings              string
-	Subscriptions         string
-	Users                 string
-	Videos                string
-	Views                 string
-	YoutubeSyncAccounts   string
-	YoutubeTVSyncAccounts string
+	AppConfiguration          string
+	Channels                  string
+	DearrowVideoBranding      string
+	Jobs                      string
+	Leases                    string
+	Passkeys                  string
+	PlaylistItems             string
+	PlaylistShareLinks        string
+	Playlists                 string
+	Sessions                  string
+	Settings                  string
+	SponsorblockSubmissions   string
+	SponsorblockVideoSegments string
+	Subscriptions             string
+	Users                     string
+	VideoChapters             string
+	VideoTranscriptChecks     string
+	VideoTranscriptTerms      string
+	VideoTranscripts          string
+	Videos                    string
+	Views                     string
+	WatchHistoryImports       string
+	YoutubeSyncAccounts       string
+	YoutubeSyncTargets        string
+	YoutubeTVPairingTokens    string
+	YoutubeTVSyncAccounts     string
+	YoutubeTVSyncEvents       string
 }{
-	AppConfiguration:      "app_configuration",
-	Channels:              "channels",
-	Passkeys:              "passkeys",
-	PlaylistItems:         "playlist_items",
-	Playlists:             "playlists",
-	Sessions:              "sessions",
-	Settings:              "settings",
-	Subscriptions:         "subscriptions",
-	Users:                 "users",
-	Videos:                "videos",
-	Views:                 "views",
-	YoutubeSyncAccounts:   "youtube_sync_accounts",
-	YoutubeTVSyncAccounts: "youtube_tv_sync_accounts",
+	AppConfiguration:          "app_configuration",
+	Channels:                  "channels",
+	DearrowVideoBranding:      "dearrow_video_branding",
+	Jobs:                      "jobs",
+	Leases:                    "leases",
+	Passkeys:                  "passkeys",
+	PlaylistItems:             "playlist_items",
+	PlaylistShareLinks:        "playlist_share_links",
+	Playlists:                 "playlists",
+	Sessions:                  "sessions",
+	Settings:                  "settings",
+	SponsorblockSubmissions:   "sponsorblock_submissions",
+	SponsorblockVideoSegments: "sponsorblock_video_segments",
+	Subscriptions:             "subscriptions",
+	Users:                     "users",
+	VideoChapters:             "video_chapters",
+	VideoTranscriptChecks:     "video_transcript_checks",
+	VideoTranscriptTerms:      "video_transcript_terms",
+	VideoTranscripts:          "video_transcripts",
+	Videos:                    "videos",
+	Views:                     "views",
+	WatchHistoryImports:       "watch_history_imports",
+	YoutubeSyncAccounts:       "youtube_sync_accounts",
+	YoutubeSyncTargets:        "youtube_sync_targets",
+	YoutubeTVPairingTokens:    "youtube_tv_pairing_tokens",
+	YoutubeTVSyncAccounts:     "youtube_tv_sync_accounts",
+	YoutubeTVSyncEvents:       "youtube_tv_sync_events",
 }
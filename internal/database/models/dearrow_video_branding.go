@@ -0,0 +1,1014 @@
+// Code generated by SQLBoiler 4.19.7 (https://github.com/aarondl/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aarondl/null/v8"
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries"
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+	"github.com/aarondl/sqlboiler/v4/queries/qmhelper"
+	"github.com/aarondl/strmangle"
+	"github.com/friendsofgo/errors"
+)
+
+// DearrowVideoBranding is an object representing the database table.
+type DearrowVideoBranding struct {
+	VideoID       string       `boil:"video_id" json:"video_id" toml:"video_id" yaml:"video_id"`
+	CreatedAt     time.Time    `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+	UpdatedAt     time.Time    `boil:"updated_at" json:"updated_at" toml:"updated_at" yaml:"updated_at"`
+	ExpiresAt     time.Time    `boil:"expires_at" json:"expires_at" toml:"expires_at" yaml:"expires_at"`
+	Title         string       `boil:"title" json:"title" toml:"title" yaml:"title"`
+	ThumbnailTime null.Float64 `boil:"thumbnail_time" json:"thumbnail_time,omitempty" toml:"thumbnail_time" yaml:"thumbnail_time,omitempty"`
+
+	R *dearrowVideoBrandingR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L dearrowVideoBrandingL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var DearrowVideoBrandingColumns = struct {
+	VideoID       string
+	CreatedAt     string
+	UpdatedAt     string
+	ExpiresAt     string
+	Title         string
+	ThumbnailTime string
+}{
+	VideoID:       "video_id",
+	CreatedAt:     "created_at",
+	UpdatedAt:     "updated_at",
+	ExpiresAt:     "expires_at",
+	Title:         "title",
+	ThumbnailTime: "thumbnail_time",
+}
+
+var DearrowVideoBrandingTableColumns = struct {
+	VideoID       string
+	CreatedAt     string
+	UpdatedAt     string
+	ExpiresAt     string
+	Title         string
+	ThumbnailTime string
+}{
+	VideoID:       "dearrow_video_branding.video_id",
+	CreatedAt:     "dearrow_video_branding.created_at",
+	UpdatedAt:     "dearrow_video_branding.updated_at",
+	ExpiresAt:     "dearrow_video_branding.expires_at",
+	Title:         "dearrow_video_branding.title",
+	ThumbnailTime: "dearrow_video_branding.thumbnail_time",
+}
+
+// Generated where
+
+type whereHelpernull_Float64 struct{ field string }
+
+func (w whereHelpernull_Float64) EQ(x null.Float64) qm.QueryMod {
+	return qmhelper.WhereNullEQ(w.field, false, x)
+}
+func (w whereHelpernull_Float64) NEQ(x null.Float64) qm.QueryMod {
+	return qmhelper.WhereNullEQ(w.field, true, x)
+}
+func (w whereHelpernull_Float64) LT(x null.Float64) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LT, x)
+}
+func (w whereHelpernull_Float64) LTE(x null.Float64) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LTE, x)
+}
+func (w whereHelpernull_Float64) GT(x null.Float64) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GT, x)
+}
+func (w whereHelpernull_Float64) GTE(x null.Float64) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GTE, x)
+}
+func (w whereHelpernull_Float64) IN(slice []float64) qm.QueryMod {
+	values := make([]any, 0, len(slice))
+	for _, value := range slice {
+		values = append(values, value)
+	}
+	return qm.WhereIn(fmt.Sprintf("%s IN ?", w.field), values...)
+}
+func (w whereHelpernull_Float64) NIN(slice []float64) qm.QueryMod {
+	values := make([]any, 0, len(slice))
+	for _, value := range slice {
+		values = append(values, value)
+	}
+	return qm.WhereNotIn(fmt.Sprintf("%s NOT IN ?", w.field), values...)
+}
+
+func (w whereHelpernull_Float64) IsNull() qm.QueryMod    { return qmhelper.WhereIsNull(w.field) }
+func (w whereHelpernull_Float64) IsNotNull() qm.QueryMod { return qmhelper.WhereIsNotNull(w.field) }
+
+var DearrowVideoBrandingWhere = struct {
+	VideoID       whereHelperstring
+	CreatedAt     whereHelpertime_Time
+	UpdatedAt     whereHelpertime_Time
+	ExpiresAt     whereHelpertime_Time
+	Title         whereHelperstring
+	ThumbnailTime whereHelpernull_Float64
+}{
+	VideoID:       whereHelperstring{field: "\"dearrow_video_branding\".\"video_id\""},
+	CreatedAt:     whereHelpertime_Time{field: "\"dearrow_video_branding\".\"created_at\""},
+	UpdatedAt:     whereHelpertime_Time{field: "\"dearrow_video_branding\".\"updated_at\""},
+	ExpiresAt:     whereHelpertime_Time{field: "\"dearrow_video_branding\".\"expires_at\""},
+	Title:         whereHelperstring{field: "\"dearrow_video_branding\".\"title\""},
+	ThumbnailTime: whereHelpernull_Float64{field: "\"dearrow_video_branding\".\"thumbnail_time\""},
+}
+
+// DearrowVideoBrandingRels is where relationship names are stored.
+var DearrowVideoBrandingRels = struct {
+}{}
+
+// dearrowVideoBrandingR is where relationships are stored.
+type dearrowVideoBrandingR struct {
+}
+
+// NewStruct creates a new relationship struct
+func (*dearrowVideoBrandingR) NewStruct() *dearrowVideoBrandingR {
+	return &dearrowVideoBrandingR{}
+}
+
+// dearrowVideoBrandingL is where Load methods for each relationship are stored.
+type dearrowVideoBrandingL struct{}
+
+var (
+	dearrowVideoBrandingAllColumns            = []string{"video_id", "created_at", "updated_at", "expires_at", "title", "thumbnail_time"}
+	dearrowVideoBrandingColumnsWithoutDefault = []string{"video_id", "created_at", "updated_at", "expires_at", "title"}
+	dearrowVideoBrandingColumnsWithDefault    = []string{"thumbnail_time"}
+	dearrowVideoBrandingPrimaryKeyColumns     = []string{"video_id"}
+	dearrowVideoBrandingGeneratedColumns      = []string{}
+)
+
+type (
+	// DearrowVideoBrandingSlice is an alias for a slice of pointers to DearrowVideoBranding.
+	// This should almost always be used instead of []DearrowVideoBranding.
+	DearrowVideoBrandingSlice []*DearrowVideoBranding
+	// DearrowVideoBrandingHook is the signature for custom DearrowVideoBranding hook methods
+	DearrowVideoBrandingHook func(context.Context, boil.ContextExecutor, *DearrowVideoBranding) error
+
+	dearrowVideoBrandingQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	dearrowVideoBrandingType                 = reflect.TypeOf(&DearrowVideoBranding{})
+	dearrowVideoBrandingMapping              = queries.MakeStructMapping(dearrowVideoBrandingType)
+	dearrowVideoBrandingPrimaryKeyMapping, _ = queries.BindMapping(dearrowVideoBrandingType, dearrowVideoBrandingMapping, dearrowVideoBrandingPrimaryKeyColumns)
+	dearrowVideoBrandingInsertCacheMut       sync.RWMutex
+	dearrowVideoBrandingInsertCache          = make(map[string]insertCache)
+	dearrowVideoBrandingUpdateCacheMut       sync.RWMutex
+	dearrowVideoBrandingUpdateCache          = make(map[string]updateCache)
+	dearrowVideoBrandingUpsertCacheMut       sync.RWMutex
+	dearrowVideoBrandingUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var dearrowVideoBrandingAfterSelectMu sync.Mutex
+var dearrowVideoBrandingAfterSelectHooks []DearrowVideoBrandingHook
+
+var dearrowVideoBrandingBeforeInsertMu sync.Mutex
+var dearrowVideoBrandingBeforeInsertHooks []DearrowVideoBrandingHook
+var dearrowVideoBrandingAfterInsertMu sync.Mutex
+var dearrowVideoBrandingAfterInsertHooks []DearrowVideoBrandingHook
+
+var dearrowVideoBrandingBeforeUpdateMu sync.Mutex
+var dearrowVideoBrandingBeforeUpdateHooks []DearrowVideoBrandingHook
+var dearrowVideoBrandingAfterUpdateMu sync.Mutex
+var dearrowVideoBrandingAfterUpdateHooks []DearrowVideoBrandingHook
+
+var dearrowVideoBrandingBeforeDeleteMu sync.Mutex
+var dearrowVideoBrandingBeforeDeleteHooks []DearrowVideoBrandingHook
+var dearrowVideoBrandingAfterDeleteMu sync.Mutex
+var dearrowVideoBrandingAfterDeleteHooks []DearrowVideoBrandingHook
+
+var dearrowVideoBrandingBeforeUpsertMu sync.Mutex
+var dearrowVideoBrandingBeforeUpsertHooks []DearrowVideoBrandingHook
+var dearrowVideoBrandingAfterUpsertMu sync.Mutex
+var dearrowVideoBrandingAfterUpsertHooks []DearrowVideoBrandingHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *DearrowVideoBranding) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range dearrowVideoBrandingAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *DearrowVideoBranding) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range dearrowVideoBrandingBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *DearrowVideoBranding) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range dearrowVideoBrandingAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *DearrowVideoBranding) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range dearrowVideoBrandingBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *DearrowVideoBranding) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range dearrowVideoBrandingAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *DearrowVideoBranding) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range dearrowVideoBrandingBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *DearrowVideoBranding) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range dearrowVideoBrandingAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *DearrowVideoBranding) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range dearrowVideoBrandingBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *DearrowVideoBranding) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range dearrowVideoBrandingAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddDearrowVideoBrandingHook registers your hook function for all future operations.
+func AddDearrowVideoBrandingHook(hookPoint boil.HookPoint, dearrowVideoBrandingHook DearrowVideoBrandingHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		dearrowVideoBrandingAfterSelectMu.Lock()
+		dearrowVideoBrandingAfterSelectHooks = append(dearrowVideoBrandingAfterSelectHooks, dearrowVideoBrandingHook)
+		dearrowVideoBrandingAfterSelectMu.Unlock()
+	case boil.BeforeInsertHook:
+		dearrowVideoBrandingBeforeInsertMu.Lock()
+		dearrowVideoBrandingBeforeInsertHooks = append(dearrowVideoBrandingBeforeInsertHooks, dearrowVideoBrandingHook)
+		dearrowVideoBrandingBeforeInsertMu.Unlock()
+	case boil.AfterInsertHook:
+		dearrowVideoBrandingAfterInsertMu.Lock()
+		dearrowVideoBrandingAfterInsertHooks = append(dearrowVideoBrandingAfterInsertHooks, dearrowVideoBrandingHook)
+		dearrowVideoBrandingAfterInsertMu.Unlock()
+	case boil.BeforeUpdateHook:
+		dearrowVideoBrandingBeforeUpdateMu.Lock()
+		dearrowVideoBrandingBeforeUpdateHooks = append(dearrowVideoBrandingBeforeUpdateHooks, dearrowVideoBrandingHook)
+		dearrowVideoBrandingBeforeUpdateMu.Unlock()
+	case boil.AfterUpdateHook:
+		dearrowVideoBrandingAfterUpdateMu.Lock()
+		dearrowVideoBrandingAfterUpdateHooks = append(dearrowVideoBrandingAfterUpdateHooks, dearrowVideoBrandingHook)
+		dearrowVideoBrandingAfterUpdateMu.Unlock()
+	case boil.BeforeDeleteHook:
+		dearrowVideoBrandingBeforeDeleteMu.Lock()
+		dearrowVideoBrandingBeforeDeleteHooks = append(dearrowVideoBrandingBeforeDeleteHooks, dearrowVideoBrandingHook)
+		dearrowVideoBrandingBeforeDeleteMu.Unlock()
+	case boil.AfterDeleteHook:
+		dearrowVideoBrandingAfterDeleteMu.Lock()
+		dearrowVideoBrandingAfterDeleteHooks = append(dearrowVideoBrandingAfterDeleteHooks, dearrowVideoBrandingHook)
+		dearrowVideoBrandingAfterDeleteMu.Unlock()
+	case boil.BeforeUpsertHook:
+		dearrowVideoBrandingBeforeUpsertMu.Lock()
+		dearrowVideoBrandingBeforeUpsertHooks = append(dearrowVideoBrandingBeforeUpsertHooks, dearrowVideoBrandingHook)
+		dearrowVideoBrandingBeforeUpsertMu.Unlock()
+	case boil.AfterUpsertHook:
+		dearrowVideoBrandingAfterUpsertMu.Lock()
+		dearrowVideoBrandingAfterUpsertHooks = append(dearrowVideoBrandingAfterUpsertHooks, dearrowVideoBrandingHook)
+		dearrowVideoBrandingAfterUpsertMu.Unlock()
+	}
+}
+
+// One returns a single dearrowVideoBranding record from the query.
+func (q dearrowVideoBrandingQuery) One(ctx context.Context, exec boil.ContextExecutor) (*DearrowVideoBranding, error) {
+	o := &DearrowVideoBranding{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: failed to execute a one query for dearrow_video_branding")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all DearrowVideoBranding records from the query.
+func (q dearrowVideoBrandingQuery) All(ctx context.Context, exec boil.ContextExecutor) (DearrowVideoBrandingSlice, error) {
+	var o []*DearrowVideoBranding
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "models: failed to assign all query results to DearrowVideoBranding slice")
+	}
+
+	if len(dearrowVideoBrandingAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all DearrowVideoBranding records in the query.
+func (q dearrowVideoBrandingQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to count dearrow_video_branding rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q dearrowVideoBrandingQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "models: failed to check if dearrow_video_branding exists")
+	}
+
+	return count > 0, nil
+}
+
+// DearrowVideoBrandings retrieves all the records using an executor.
+func DearrowVideoBrandings(mods ...qm.QueryMod) dearrowVideoBrandingQuery {
+	mods = append(mods, qm.From("\"dearrow_video_branding\""))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"\"dearrow_video_branding\".*"})
+	}
+
+	return dearrowVideoBrandingQuery{q}
+}
+
+// FindDearrowVideoBranding retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindDearrowVideoBranding(ctx context.Context, exec boil.ContextExecutor, videoID string, selectCols ...string) (*DearrowVideoBranding, error) {
+	dearrowVideoBrandingObj := &DearrowVideoBranding{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"dearrow_video_branding\" where \"video_id\"=?", sel,
+	)
+
+	q := queries.Raw(query, videoID)
+
+	err := q.Bind(ctx, exec, dearrowVideoBrandingObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: unable to select from dearrow_video_branding")
+	}
+
+	if err = dearrowVideoBrandingObj.doAfterSelectHooks(ctx, exec); err != nil {
+		return dearrowVideoBrandingObj, err
+	}
+
+	return dearrowVideoBrandingObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *DearrowVideoBranding) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no dearrow_video_branding provided for insertion")
+	}
+
+	var err error
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+		if o.UpdatedAt.IsZero() {
+			o.UpdatedAt = currTime
+		}
+	}
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(dearrowVideoBrandingColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	dearrowVideoBrandingInsertCacheMut.RLock()
+	cache, cached := dearrowVideoBrandingInsertCache[key]
+	dearrowVideoBrandingInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			dearrowVideoBrandingAllColumns,
+			dearrowVideoBrandingColumnsWithDefault,
+			dearrowVideoBrandingColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(dearrowVideoBrandingType, dearrowVideoBrandingMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(dearrowVideoBrandingType, dearrowVideoBrandingMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"dearrow_video_branding\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"dearrow_video_branding\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "models: unable to insert into dearrow_video_branding")
+	}
+
+	if !cached {
+		dearrowVideoBrandingInsertCacheMut.Lock()
+		dearrowVideoBrandingInsertCache[key] = cache
+		dearrowVideoBrandingInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// Update uses an executor to update the DearrowVideoBranding.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *DearrowVideoBranding) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		o.UpdatedAt = currTime
+	}
+
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	dearrowVideoBrandingUpdateCacheMut.RLock()
+	cache, cached := dearrowVideoBrandingUpdateCache[key]
+	dearrowVideoBrandingUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			dearrowVideoBrandingAllColumns,
+			dearrowVideoBrandingPrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("models: unable to update dearrow_video_branding, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"dearrow_video_branding\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 0, wl),
+			strmangle.WhereClause("\"", "\"", 0, dearrowVideoBrandingPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(dearrowVideoBrandingType, dearrowVideoBrandingMapping, append(wl, dearrowVideoBrandingPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update dearrow_video_branding row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by update for dearrow_video_branding")
+	}
+
+	if !cached {
+		dearrowVideoBrandingUpdateCacheMut.Lock()
+		dearrowVideoBrandingUpdateCache[key] = cache
+		dearrowVideoBrandingUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q dearrowVideoBrandingQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all for dearrow_video_branding")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected for dearrow_video_branding")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o DearrowVideoBrandingSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("models: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]any, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), dearrowVideoBrandingPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"dearrow_video_branding\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 0, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, dearrowVideoBrandingPrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all in dearrowVideoBranding slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected all in update all dearrowVideoBranding")
+	}
+	return rowsAff, nil
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *DearrowVideoBranding) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no dearrow_video_branding provided for upsert")
+	}
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+		o.UpdatedAt = currTime
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(dearrowVideoBrandingColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	dearrowVideoBrandingUpsertCacheMut.RLock()
+	cache, cached := dearrowVideoBrandingUpsertCache[key]
+	dearrowVideoBrandingUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, _ := insertColumns.InsertColumnSet(
+			dearrowVideoBrandingAllColumns,
+			dearrowVideoBrandingColumnsWithDefault,
+			dearrowVideoBrandingColumnsWithoutDefault,
+			nzDefaults,
+		)
+		update := updateColumns.UpdateColumnSet(
+			dearrowVideoBrandingAllColumns,
+			dearrowVideoBrandingPrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("models: unable to upsert dearrow_video_branding, could not build update column list")
+		}
+
+		ret := strmangle.SetComplement(dearrowVideoBrandingAllColumns, strmangle.SetIntersect(insert, update))
+
+		conflict := conflictColumns
+		if len(conflict) == 0 {
+			conflict = make([]string, len(dearrowVideoBrandingPrimaryKeyColumns))
+			copy(conflict, dearrowVideoBrandingPrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQuerySQLite(dialect, "\"dearrow_video_branding\"", updateOnConflict, ret, update, conflict, insert)
+
+		cache.valueMapping, err = queries.BindMapping(dearrowVideoBrandingType, dearrowVideoBrandingMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(dearrowVideoBrandingType, dearrowVideoBrandingMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []any
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if errors.Is(err, sql.ErrNoRows) {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "models: unable to upsert dearrow_video_branding")
+	}
+
+	if !cached {
+		dearrowVideoBrandingUpsertCacheMut.Lock()
+		dearrowVideoBrandingUpsertCache[key] = cache
+		dearrowVideoBrandingUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// Delete deletes a single DearrowVideoBranding record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *DearrowVideoBranding) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("models: no DearrowVideoBranding provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), dearrowVideoBrandingPrimaryKeyMapping)
+	sql := "DELETE FROM \"dearrow_video_branding\" WHERE \"video_id\"=?"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete from dearrow_video_branding")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by delete for dearrow_video_branding")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q dearrowVideoBrandingQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("models: no dearrowVideoBrandingQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from dearrow_video_branding")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for dearrow_video_branding")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o DearrowVideoBrandingSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(dearrowVideoBrandingBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []any
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), dearrowVideoBrandingPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"dearrow_video_branding\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, dearrowVideoBrandingPrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from dearrowVideoBranding slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for dearrow_video_branding")
+	}
+
+	if len(dearrowVideoBrandingAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *DearrowVideoBranding) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindDearrowVideoBranding(ctx, exec, o.VideoID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *DearrowVideoBrandingSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := DearrowVideoBrandingSlice{}
+	var args []any
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), dearrowVideoBrandingPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"dearrow_video_branding\".* FROM \"dearrow_video_branding\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, dearrowVideoBrandingPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to reload all in DearrowVideoBrandingSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// DearrowVideoBrandingExists checks if the DearrowVideoBranding row exists.
+func DearrowVideoBrandingExists(ctx context.Context, exec boil.ContextExecutor, videoID string) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"dearrow_video_branding\" where \"video_id\"=? limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, videoID)
+	}
+	row := exec.QueryRowContext(ctx, sql, videoID)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "models: unable to check if dearrow_video_branding exists")
+	}
+
+	return exists, nil
+}
+
+// Exists checks if the DearrowVideoBranding row exists.
+func (o *DearrowVideoBranding) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	return DearrowVideoBrandingExists(ctx, exec, o.VideoID)
+}
@@ -0,0 +1,731 @@
+// Code generated by SQLBoiler 4.19.7 (https://github.com/aarondl/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"bytes"
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/aarondl/randomize"
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries"
+	"github.com/aarondl/strmangle"
+)
+
+var (
+	// Relationships sometimes use the reflection helper queries.Equal/queries.Assign
+	// so force a package dependency in case they don't.
+	_ = queries.Equal
+)
+
+func testDearrowVideoBrandings(t *testing.T) {
+	t.Parallel()
+
+	query := DearrowVideoBrandings()
+
+	if query.Query == nil {
+		t.Error("expected a query, got nothing")
+	}
+}
+
+func testDearrowVideoBrandingsDelete(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &DearrowVideoBranding{}
+	if err = randomize.Struct(seed, o, dearrowVideoBrandingDBTypes, true, dearrowVideoBrandingColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize DearrowVideoBranding struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := o.Delete(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := DearrowVideoBrandings().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testDearrowVideoBrandingsQueryDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &DearrowVideoBranding{}
+	if err = randomize.Struct(seed, o, dearrowVideoBrandingDBTypes, true, dearrowVideoBrandingColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize DearrowVideoBranding struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := DearrowVideoBrandings().DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := DearrowVideoBrandings().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testDearrowVideoBrandingsSliceDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &DearrowVideoBranding{}
+	if err = randomize.Struct(seed, o, dearrowVideoBrandingDBTypes, true, dearrowVideoBrandingColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize DearrowVideoBranding struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := DearrowVideoBrandingSlice{o}
+
+	if rowsAff, err := slice.DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := DearrowVideoBrandings().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testDearrowVideoBrandingsExists(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &DearrowVideoBranding{}
+	if err = randomize.Struct(seed, o, dearrowVideoBrandingDBTypes, true, dearrowVideoBrandingColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize DearrowVideoBranding struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	e, err := DearrowVideoBrandingExists(ctx, tx, o.VideoID)
+	if err != nil {
+		t.Errorf("Unable to check if DearrowVideoBranding exists: %s", err)
+	}
+	if !e {
+		t.Errorf("Expected DearrowVideoBrandingExists to return true, but got false.")
+	}
+}
+
+func testDearrowVideoBrandingsFind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &DearrowVideoBranding{}
+	if err = randomize.Struct(seed, o, dearrowVideoBrandingDBTypes, true, dearrowVideoBrandingColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize DearrowVideoBranding struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	dearrowVideoBrandingFound, err := FindDearrowVideoBranding(ctx, tx, o.VideoID)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if dearrowVideoBrandingFound == nil {
+		t.Error("want a record, got nil")
+	}
+}
+
+func testDearrowVideoBrandingsBind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &DearrowVideoBranding{}
+	if err = randomize.Struct(seed, o, dearrowVideoBrandingDBTypes, true, dearrowVideoBrandingColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize DearrowVideoBranding struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = DearrowVideoBrandings().Bind(ctx, tx, o); err != nil {
+		t.Error(err)
+	}
+}
+
+func testDearrowVideoBrandingsOne(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &DearrowVideoBranding{}
+	if err = randomize.Struct(seed, o, dearrowVideoBrandingDBTypes, true, dearrowVideoBrandingColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize DearrowVideoBranding struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if x, err := DearrowVideoBrandings().One(ctx, tx); err != nil {
+		t.Error(err)
+	} else if x == nil {
+		t.Error("expected to get a non nil record")
+	}
+}
+
+func testDearrowVideoBrandingsAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	dearrowVideoBrandingOne := &DearrowVideoBranding{}
+	dearrowVideoBrandingTwo := &DearrowVideoBranding{}
+	if err = randomize.Struct(seed, dearrowVideoBrandingOne, dearrowVideoBrandingDBTypes, false, dearrowVideoBrandingColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize DearrowVideoBranding struct: %s", err)
+	}
+	if err = randomize.Struct(seed, dearrowVideoBrandingTwo, dearrowVideoBrandingDBTypes, false, dearrowVideoBrandingColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize DearrowVideoBranding struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = dearrowVideoBrandingOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = dearrowVideoBrandingTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := DearrowVideoBrandings().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 2 {
+		t.Error("want 2 records, got:", len(slice))
+	}
+}
+
+func testDearrowVideoBrandingsCount(t *testing.T) {
+	t.Parallel()
+
+	var err error
+	seed := randomize.NewSeed()
+	dearrowVideoBrandingOne := &DearrowVideoBranding{}
+	dearrowVideoBrandingTwo := &DearrowVideoBranding{}
+	if err = randomize.Struct(seed, dearrowVideoBrandingOne, dearrowVideoBrandingDBTypes, false, dearrowVideoBrandingColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize DearrowVideoBranding struct: %s", err)
+	}
+	if err = randomize.Struct(seed, dearrowVideoBrandingTwo, dearrowVideoBrandingDBTypes, false, dearrowVideoBrandingColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize DearrowVideoBranding struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = dearrowVideoBrandingOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = dearrowVideoBrandingTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := DearrowVideoBrandings().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 2 {
+		t.Error("want 2 records, got:", count)
+	}
+}
+
+func dearrowVideoBrandingBeforeInsertHook(ctx context.Context, e boil.ContextExecutor, o *DearrowVideoBranding) error {
+	*o = DearrowVideoBranding{}
+	return nil
+}
+
+func dearrowVideoBrandingAfterInsertHook(ctx context.Context, e boil.ContextExecutor, o *DearrowVideoBranding) error {
+	*o = DearrowVideoBranding{}
+	return nil
+}
+
+func dearrowVideoBrandingAfterSelectHook(ctx context.Context, e boil.ContextExecutor, o *DearrowVideoBranding) error {
+	*o = DearrowVideoBranding{}
+	return nil
+}
+
+func dearrowVideoBrandingBeforeUpdateHook(ctx context.Context, e boil.ContextExecutor, o *DearrowVideoBranding) error {
+	*o = DearrowVideoBranding{}
+	return nil
+}
+
+func dearrowVideoBrandingAfterUpdateHook(ctx context.Context, e boil.ContextExecutor, o *DearrowVideoBranding) error {
+	*o = DearrowVideoBranding{}
+	return nil
+}
+
+func dearrowVideoBrandingBeforeDeleteHook(ctx context.Context, e boil.ContextExecutor, o *DearrowVideoBranding) error {
+	*o = DearrowVideoBranding{}
+	return nil
+}
+
+func dearrowVideoBrandingAfterDeleteHook(ctx context.Context, e boil.ContextExecutor, o *DearrowVideoBranding) error {
+	*o = DearrowVideoBranding{}
+	return nil
+}
+
+func dearrowVideoBrandingBeforeUpsertHook(ctx context.Context, e boil.ContextExecutor, o *DearrowVideoBranding) error {
+	*o = DearrowVideoBranding{}
+	return nil
+}
+
+func dearrowVideoBrandingAfterUpsertHook(ctx context.Context, e boil.ContextExecutor, o *DearrowVideoBranding) error {
+	*o = DearrowVideoBranding{}
+	return nil
+}
+
+func testDearrowVideoBrandingsHooks(t *testing.T) {
+	t.Parallel()
+
+	var err error
+
+	ctx := context.Background()
+	empty := &DearrowVideoBranding{}
+	o := &DearrowVideoBranding{}
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, o, dearrowVideoBrandingDBTypes, false); err != nil {
+		t.Errorf("Unable to randomize DearrowVideoBranding object: %s", err)
+	}
+
+	AddDearrowVideoBrandingHook(boil.BeforeInsertHook, dearrowVideoBrandingBeforeInsertHook)
+	if err = o.doBeforeInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeInsertHook function to empty object, but got: %#v", o)
+	}
+	dearrowVideoBrandingBeforeInsertHooks = []DearrowVideoBrandingHook{}
+
+	AddDearrowVideoBrandingHook(boil.AfterInsertHook, dearrowVideoBrandingAfterInsertHook)
+	if err = o.doAfterInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterInsertHook function to empty object, but got: %#v", o)
+	}
+	dearrowVideoBrandingAfterInsertHooks = []DearrowVideoBrandingHook{}
+
+	AddDearrowVideoBrandingHook(boil.AfterSelectHook, dearrowVideoBrandingAfterSelectHook)
+	if err = o.doAfterSelectHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterSelectHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterSelectHook function to empty object, but got: %#v", o)
+	}
+	dearrowVideoBrandingAfterSelectHooks = []DearrowVideoBrandingHook{}
+
+	AddDearrowVideoBrandingHook(boil.BeforeUpdateHook, dearrowVideoBrandingBeforeUpdateHook)
+	if err = o.doBeforeUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpdateHook function to empty object, but got: %#v", o)
+	}
+	dearrowVideoBrandingBeforeUpdateHooks = []DearrowVideoBrandingHook{}
+
+	AddDearrowVideoBrandingHook(boil.AfterUpdateHook, dearrowVideoBrandingAfterUpdateHook)
+	if err = o.doAfterUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpdateHook function to empty object, but got: %#v", o)
+	}
+	dearrowVideoBrandingAfterUpdateHooks = []DearrowVideoBrandingHook{}
+
+	AddDearrowVideoBrandingHook(boil.BeforeDeleteHook, dearrowVideoBrandingBeforeDeleteHook)
+	if err = o.doBeforeDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeDeleteHook function to empty object, but got: %#v", o)
+	}
+	dearrowVideoBrandingBeforeDeleteHooks = []DearrowVideoBrandingHook{}
+
+	AddDearrowVideoBrandingHook(boil.AfterDeleteHook, dearrowVideoBrandingAfterDeleteHook)
+	if err = o.doAfterDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterDeleteHook function to empty object, but got: %#v", o)
+	}
+	dearrowVideoBrandingAfterDeleteHooks = []DearrowVideoBrandingHook{}
+
+	AddDearrowVideoBrandingHook(boil.BeforeUpsertHook, dearrowVideoBrandingBeforeUpsertHook)
+	if err = o.doBeforeUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpsertHook function to empty object, but got: %#v", o)
+	}
+	dearrowVideoBrandingBeforeUpsertHooks = []DearrowVideoBrandingHook{}
+
+	AddDearrowVideoBrandingHook(boil.AfterUpsertHook, dearrowVideoBrandingAfterUpsertHook)
+	if err = o.doAfterUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpsertHook function to empty object, but got: %#v", o)
+	}
+	dearrowVideoBrandingAfterUpsertHooks = []DearrowVideoBrandingHook{}
+}
+
+func testDearrowVideoBrandingsInsert(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &DearrowVideoBranding{}
+	if err = randomize.Struct(seed, o, dearrowVideoBrandingDBTypes, true, dearrowVideoBrandingColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize DearrowVideoBranding struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := DearrowVideoBrandings().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testDearrowVideoBrandingsInsertWhitelist(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &DearrowVideoBranding{}
+	if err = randomize.Struct(seed, o, dearrowVideoBrandingDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize DearrowVideoBranding struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Whitelist(strmangle.SetMerge(dearrowVideoBrandingPrimaryKeyColumns, dearrowVideoBrandingColumnsWithoutDefault)...)); err != nil {
+		t.Error(err)
+	}
+
+	count, err := DearrowVideoBrandings().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testDearrowVideoBrandingsReload(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &DearrowVideoBranding{}
+	if err = randomize.Struct(seed, o, dearrowVideoBrandingDBTypes, true, dearrowVideoBrandingColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize DearrowVideoBranding struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = o.Reload(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testDearrowVideoBrandingsReloadAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &DearrowVideoBranding{}
+	if err = randomize.Struct(seed, o, dearrowVideoBrandingDBTypes, true, dearrowVideoBrandingColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize DearrowVideoBranding struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := DearrowVideoBrandingSlice{o}
+
+	if err = slice.ReloadAll(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testDearrowVideoBrandingsSelect(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &DearrowVideoBranding{}
+	if err = randomize.Struct(seed, o, dearrowVideoBrandingDBTypes, true, dearrowVideoBrandingColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize DearrowVideoBranding struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := DearrowVideoBrandings().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 1 {
+		t.Error("want one record, got:", len(slice))
+	}
+}
+
+var (
+	dearrowVideoBrandingDBTypes = map[string]string{`VideoID`: `TEXT`, `CreatedAt`: `DATE`, `UpdatedAt`: `DATE`, `ExpiresAt`: `DATE`, `Title`: `TEXT`, `ThumbnailTime`: `REAL`}
+	_                           = bytes.MinRead
+)
+
+func testDearrowVideoBrandingsUpdate(t *testing.T) {
+	t.Parallel()
+
+	if 0 == len(dearrowVideoBrandingPrimaryKeyColumns) {
+		t.Skip("Skipping table with no primary key columns")
+	}
+	if len(dearrowVideoBrandingAllColumns) == len(dearrowVideoBrandingPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &DearrowVideoBranding{}
+	if err = randomize.Struct(seed, o, dearrowVideoBrandingDBTypes, true, dearrowVideoBrandingColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize DearrowVideoBranding struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := DearrowVideoBrandings().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, dearrowVideoBrandingDBTypes, true, dearrowVideoBrandingPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize DearrowVideoBranding struct: %s", err)
+	}
+
+	if rowsAff, err := o.Update(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only affect one row but affected", rowsAff)
+	}
+}
+
+func testDearrowVideoBrandingsSliceUpdateAll(t *testing.T) {
+	t.Parallel()
+
+	if len(dearrowVideoBrandingAllColumns) == len(dearrowVideoBrandingPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &DearrowVideoBranding{}
+	if err = randomize.Struct(seed, o, dearrowVideoBrandingDBTypes, true, dearrowVideoBrandingColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize DearrowVideoBranding struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := DearrowVideoBrandings().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, dearrowVideoBrandingDBTypes, true, dearrowVideoBrandingPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize DearrowVideoBranding struct: %s", err)
+	}
+
+	// Remove Primary keys and unique columns from what we plan to update
+	var fields []string
+	if strmangle.StringSliceMatch(dearrowVideoBrandingAllColumns, dearrowVideoBrandingPrimaryKeyColumns) {
+		fields = dearrowVideoBrandingAllColumns
+	} else {
+		fields = strmangle.SetComplement(
+			dearrowVideoBrandingAllColumns,
+			dearrowVideoBrandingPrimaryKeyColumns,
+		)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	typ := reflect.TypeOf(o).Elem()
+	n := typ.NumField()
+
+	updateMap := M{}
+	for _, col := range fields {
+		for i := 0; i < n; i++ {
+			f := typ.Field(i)
+			if f.Tag.Get("boil") == col {
+				updateMap[col] = value.Field(i).Interface()
+			}
+		}
+	}
+
+	slice := DearrowVideoBrandingSlice{o}
+	if rowsAff, err := slice.UpdateAll(ctx, tx, updateMap); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("wanted one record updated but got", rowsAff)
+	}
+}
+
+func testDearrowVideoBrandingsUpsert(t *testing.T) {
+	t.Parallel()
+	if len(dearrowVideoBrandingAllColumns) == len(dearrowVideoBrandingPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	// Attempt the INSERT side of an UPSERT
+	o := DearrowVideoBranding{}
+	if err = randomize.Struct(seed, &o, dearrowVideoBrandingDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize DearrowVideoBranding struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Upsert(ctx, tx, false, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert DearrowVideoBranding: %s", err)
+	}
+
+	count, err := DearrowVideoBrandings().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	// Attempt the UPDATE side of an UPSERT
+	if err = randomize.Struct(seed, &o, dearrowVideoBrandingDBTypes, false, dearrowVideoBrandingPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize DearrowVideoBranding struct: %s", err)
+	}
+
+	if err = o.Upsert(ctx, tx, true, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert DearrowVideoBranding: %s", err)
+	}
+
+	count, err = DearrowVideoBrandings().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
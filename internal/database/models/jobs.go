@@ -0,0 +1,1107 @@
+// Code generated by SQLBoiler 4.19.7 (https://github.com/aarondl/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aarondl/null/v8"
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries"
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+	"github.com/aarondl/sqlboiler/v4/queries/qmhelper"
+	"github.com/aarondl/strmangle"
+	"github.com/friendsofgo/errors"
+)
+
+// Job is an object representing the database table.
+type Job struct {
+	ID          string      `boil:"id" json:"id" toml:"id" yaml:"id"`
+	CreatedAt   time.Time   `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+	UpdatedAt   time.Time   `boil:"updated_at" json:"updated_at" toml:"updated_at" yaml:"updated_at"`
+	Kind        string      `boil:"kind" json:"kind" toml:"kind" yaml:"kind"`
+	Payload     string      `boil:"payload" json:"payload" toml:"payload" yaml:"payload"`
+	DedupKey    null.String `boil:"dedup_key" json:"dedup_key,omitempty" toml:"dedup_key" yaml:"dedup_key,omitempty"`
+	Priority    int64       `boil:"priority" json:"priority" toml:"priority" yaml:"priority"`
+	Status      string      `boil:"status" json:"status" toml:"status" yaml:"status"`
+	Attempts    int64       `boil:"attempts" json:"attempts" toml:"attempts" yaml:"attempts"`
+	MaxAttempts int64       `boil:"max_attempts" json:"max_attempts" toml:"max_attempts" yaml:"max_attempts"`
+	RunAt       time.Time   `boil:"run_at" json:"run_at" toml:"run_at" yaml:"run_at"`
+	LockedBy    null.String `boil:"locked_by" json:"locked_by,omitempty" toml:"locked_by" yaml:"locked_by,omitempty"`
+	LockedUntil null.Time   `boil:"locked_until" json:"locked_until,omitempty" toml:"locked_until" yaml:"locked_until,omitempty"`
+	LastError   string      `boil:"last_error" json:"last_error" toml:"last_error" yaml:"last_error"`
+	FinishedAt  null.Time   `boil:"finished_at" json:"finished_at,omitempty" toml:"finished_at" yaml:"finished_at,omitempty"`
+
+	R *jobR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L jobL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var JobColumns = struct {
+	ID          string
+	CreatedAt   string
+	UpdatedAt   string
+	Kind        string
+	Payload     string
+	DedupKey    string
+	Priority    string
+	Status      string
+	Attempts    string
+	MaxAttempts string
+	RunAt       string
+	LockedBy    string
+	LockedUntil string
+	LastError   string
+	FinishedAt  string
+}{
+	ID:          "id",
+	CreatedAt:   "created_at",
+	UpdatedAt:   "updated_at",
+	Kind:        "kind",
+	Payload:     "payload",
+	DedupKey:    "dedup_key",
+	Priority:    "priority",
+	Status:      "status",
+	Attempts:    "attempts",
+	MaxAttempts: "max_attempts",
+	RunAt:       "run_at",
+	LockedBy:    "locked_by",
+	LockedUntil: "locked_until",
+	LastError:   "last_error",
+	FinishedAt:  "finished_at",
+}
+
+var JobTableColumns = struct {
+	ID          string
+	CreatedAt   string
+	UpdatedAt   string
+	Kind        string
+	Payload     string
+	DedupKey    string
+	Priority    string
+	Status      string
+	Attempts    string
+	MaxAttempts string
+	RunAt       string
+	LockedBy    string
+	LockedUntil string
+	LastError   string
+	FinishedAt  string
+}{
+	ID:          "jobs.id",
+	CreatedAt:   "jobs.created_at",
+	UpdatedAt:   "jobs.updated_at",
+	Kind:        "jobs.kind",
+	Payload:     "jobs.payload",
+	DedupKey:    "jobs.dedup_key",
+	Priority:    "jobs.priority",
+	Status:      "jobs.status",
+	Attempts:    "jobs.attempts",
+	MaxAttempts: "jobs.max_attempts",
+	RunAt:       "jobs.run_at",
+	LockedBy:    "jobs.locked_by",
+	LockedUntil: "jobs.locked_until",
+	LastError:   "jobs.last_error",
+	FinishedAt:  "jobs.finished_at",
+}
+
+// Generated where
+
+type whereHelpernull_String struct{ field string }
+
+func (w whereHelpernull_String) EQ(x null.String) qm.QueryMod {
+	return qmhelper.WhereNullEQ(w.field, false, x)
+}
+func (w whereHelpernull_String) NEQ(x null.String) qm.QueryMod {
+	return qmhelper.WhereNullEQ(w.field, true, x)
+}
+func (w whereHelpernull_String) LT(x null.String) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LT, x)
+}
+func (w whereHelpernull_String) LTE(x null.String) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LTE, x)
+}
+func (w whereHelpernull_String) GT(x null.String) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GT, x)
+}
+func (w whereHelpernull_String) GTE(x null.String) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GTE, x)
+}
+func (w whereHelpernull_String) LIKE(x null.String) qm.QueryMod {
+	return qm.Where(w.field+" LIKE ?", x)
+}
+func (w whereHelpernull_String) NLIKE(x null.String) qm.QueryMod {
+	return qm.Where(w.field+" NOT LIKE ?", x)
+}
+func (w whereHelpernull_String) IN(slice []string) qm.QueryMod {
+	values := make([]any, 0, len(slice))
+	for _, value := range slice {
+		values = append(values, value)
+	}
+	return qm.WhereIn(fmt.Sprintf("%s IN ?", w.field), values...)
+}
+func (w whereHelpernull_String) NIN(slice []string) qm.QueryMod {
+	values := make([]any, 0, len(slice))
+	for _, value := range slice {
+		values = append(values, value)
+	}
+	return qm.WhereNotIn(fmt.Sprintf("%s NOT IN ?", w.field), values...)
+}
+
+func (w whereHelpernull_String) IsNull() qm.QueryMod    { return qmhelper.WhereIsNull(w.field) }
+func (w whereHelpernull_String) IsNotNull() qm.QueryMod { return qmhelper.WhereIsNotNull(w.field) }
+
+type whereHelpernull_Time struct{ field string }
+
+func (w whereHelpernull_Time) EQ(x null.Time) qm.QueryMod {
+	return qmhelper.WhereNullEQ(w.field, false, x)
+}
+func (w whereHelpernull_Time) NEQ(x null.Time) qm.QueryMod {
+	return qmhelper.WhereNullEQ(w.field, true, x)
+}
+func (w whereHelpernull_Time) LT(x null.Time) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LT, x)
+}
+func (w whereHelpernull_Time) LTE(x null.Time) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LTE, x)
+}
+func (w whereHelpernull_Time) GT(x null.Time) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GT, x)
+}
+func (w whereHelpernull_Time) GTE(x null.Time) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GTE, x)
+}
+
+func (w whereHelpernull_Time) IsNull() qm.QueryMod    { return qmhelper.WhereIsNull(w.field) }
+func (w whereHelpernull_Time) IsNotNull() qm.QueryMod { return qmhelper.WhereIsNotNull(w.field) }
+
+var JobWhere = struct {
+	ID          whereHelperstring
+	CreatedAt   whereHelpertime_Time
+	UpdatedAt   whereHelpertime_Time
+	Kind        whereHelperstring
+	Payload     whereHelperstring
+	DedupKey    whereHelpernull_String
+	Priority    whereHelperint64
+	Status      whereHelperstring
+	Attempts    whereHelperint64
+	MaxAttempts whereHelperint64
+	RunAt       whereHelpertime_Time
+	LockedBy    whereHelpernull_String
+	LockedUntil whereHelpernull_Time
+	LastError   whereHelperstring
+	FinishedAt  whereHelpernull_Time
+}{
+	ID:          whereHelperstring{field: "\"jobs\".\"id\""},
+	CreatedAt:   whereHelpertime_Time{field: "\"jobs\".\"created_at\""},
+	UpdatedAt:   whereHelpertime_Time{field: "\"jobs\".\"updated_at\""},
+	Kind:        whereHelperstring{field: "\"jobs\".\"kind\""},
+	Payload:     whereHelperstring{field: "\"jobs\".\"payload\""},
+	DedupKey:    whereHelpernull_String{field: "\"jobs\".\"dedup_key\""},
+	Priority:    whereHelperint64{field: "\"jobs\".\"priority\""},
+	Status:      whereHelperstring{field: "\"jobs\".\"status\""},
+	Attempts:    whereHelperint64{field: "\"jobs\".\"attempts\""},
+	MaxAttempts: whereHelperint64{field: "\"jobs\".\"max_attempts\""},
+	RunAt:       whereHelpertime_Time{field: "\"jobs\".\"run_at\""},
+	LockedBy:    whereHelpernull_String{field: "\"jobs\".\"locked_by\""},
+	LockedUntil: whereHelpernull_Time{field: "\"jobs\".\"locked_until\""},
+	LastError:   whereHelperstring{field: "\"jobs\".\"last_error\""},
+	FinishedAt:  whereHelpernull_Time{field: "\"jobs\".\"finished_at\""},
+}
+
+// JobRels is where relationship names are stored.
+var JobRels = struct {
+}{}
+
+// jobR is where relationships are stored.
+type jobR struct {
+}
+
+// NewStruct creates a new relationship struct
+func (*jobR) NewStruct() *jobR {
+	return &jobR{}
+}
+
+// jobL is where Load methods for each relationship are stored.
+type jobL struct{}
+
+var (
+	jobAllColumns            = []string{"id", "created_at", "updated_at", "kind", "payload", "dedup_key", "priority", "status", "attempts", "max_attempts", "run_at", "locked_by", "locked_until", "last_error", "finished_at"}
+	jobColumnsWithoutDefault = []string{"id", "created_at", "updated_at", "kind", "status", "max_attempts", "run_at"}
+	jobColumnsWithDefault    = []string{"payload", "dedup_key", "priority", "attempts", "locked_by", "locked_until", "last_error", "finished_at"}
+	jobPrimaryKeyColumns     = []string{"id"}
+	jobGeneratedColumns      = []string{}
+)
+
+type (
+	// JobSlice is an alias for a slice of pointers to Job.
+	// This should almost always be used instead of []Job.
+	JobSlice []*Job
+	// JobHook is the signature for custom Job hook methods
+	JobHook func(context.Context, boil.ContextExecutor, *Job) error
+
+	jobQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	jobType                 = reflect.TypeOf(&Job{})
+	jobMapping              = queries.MakeStructMapping(jobType)
+	jobPrimaryKeyMapping, _ = queries.BindMapping(jobType, jobMapping, jobPrimaryKeyColumns)
+	jobInsertCacheMut       sync.RWMutex
+	jobInsertCache          = make(map[string]insertCache)
+	jobUpdateCacheMut       sync.RWMutex
+	jobUpdateCache          = make(map[string]updateCache)
+	jobUpsertCacheMut       sync.RWMutex
+	jobUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var jobAfterSelectMu sync.Mutex
+var jobAfterSelectHooks []JobHook
+
+var jobBeforeInsertMu sync.Mutex
+var jobBeforeInsertHooks []JobHook
+var jobAfterInsertMu sync.Mutex
+var jobAfterInsertHooks []JobHook
+
+var jobBeforeUpdateMu sync.Mutex
+var jobBeforeUpdateHooks []JobHook
+var jobAfterUpdateMu sync.Mutex
+var jobAfterUpdateHooks []JobHook
+
+var jobBeforeDeleteMu sync.Mutex
+var jobBeforeDeleteHooks []JobHook
+var jobAfterDeleteMu sync.Mutex
+var jobAfterDeleteHooks []JobHook
+
+var jobBeforeUpsertMu sync.Mutex
+var jobBeforeUpsertHooks []JobHook
+var jobAfterUpsertMu sync.Mutex
+var jobAfterUpsertHooks []JobHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *Job) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range jobAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *Job) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range jobBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *Job) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range jobAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *Job) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range jobBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *Job) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range jobAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *Job) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range jobBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *Job) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range jobAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *Job) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range jobBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *Job) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range jobAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddJobHook registers your hook function for all future operations.
+func AddJobHook(hookPoint boil.HookPoint, jobHook JobHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		jobAfterSelectMu.Lock()
+		jobAfterSelectHooks = append(jobAfterSelectHooks, jobHook)
+		jobAfterSelectMu.Unlock()
+	case boil.BeforeInsertHook:
+		jobBeforeInsertMu.Lock()
+		jobBeforeInsertHooks = append(jobBeforeInsertHooks, jobHook)
+		jobBeforeInsertMu.Unlock()
+	case boil.AfterInsertHook:
+		jobAfterInsertMu.Lock()
+		jobAfterInsertHooks = append(jobAfterInsertHooks, jobHook)
+		jobAfterInsertMu.Unlock()
+	case boil.BeforeUpdateHook:
+		jobBeforeUpdateMu.Lock()
+		jobBeforeUpdateHooks = append(jobBeforeUpdateHooks, jobHook)
+		jobBeforeUpdateMu.Unlock()
+	case boil.AfterUpdateHook:
+		jobAfterUpdateMu.Lock()
+		jobAfterUpdateHooks = append(jobAfterUpdateHooks, jobHook)
+		jobAfterUpdateMu.Unlock()
+	case boil.BeforeDeleteHook:
+		jobBeforeDeleteMu.Lock()
+		jobBeforeDeleteHooks = append(jobBeforeDeleteHooks, jobHook)
+		jobBeforeDeleteMu.Unlock()
+	case boil.AfterDeleteHook:
+		jobAfterDeleteMu.Lock()
+		jobAfterDeleteHooks = append(jobAfterDeleteHooks, jobHook)
+		jobAfterDeleteMu.Unlock()
+	case boil.BeforeUpsertHook:
+		jobBeforeUpsertMu.Lock()
+		jobBeforeUpsertHooks = append(jobBeforeUpsertHooks, jobHook)
+		jobBeforeUpsertMu.Unlock()
+	case boil.AfterUpsertHook:
+		jobAfterUpsertMu.Lock()
+		jobAfterUpsertHooks = append(jobAfterUpsertHooks, jobHook)
+		jobAfterUpsertMu.Unlock()
+	}
+}
+
+// One returns a single job record from the query.
+func (q jobQuery) One(ctx context.Context, exec boil.ContextExecutor) (*Job, error) {
+	o := &Job{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: failed to execute a one query for jobs")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all Job records from the query.
+func (q jobQuery) All(ctx context.Context, exec boil.ContextExecutor) (JobSlice, error) {
+	var o []*Job
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "models: failed to assign all query results to Job slice")
+	}
+
+	if len(jobAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all Job records in the query.
+func (q jobQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to count jobs rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q jobQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "models: failed to check if jobs exists")
+	}
+
+	return count > 0, nil
+}
+
+// Jobs retrieves all the records using an executor.
+func Jobs(mods ...qm.QueryMod) jobQuery {
+	mods = append(mods, qm.From("\"jobs\""))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"\"jobs\".*"})
+	}
+
+	return jobQuery{q}
+}
+
+// FindJob retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindJob(ctx context.Context, exec boil.ContextExecutor, iD string, selectCols ...string) (*Job, error) {
+	jobObj := &Job{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"jobs\" where \"id\"=?", sel,
+	)
+
+	q := queries.Raw(query, iD)
+
+	err := q.Bind(ctx, exec, jobObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: unable to select from jobs")
+	}
+
+	if err = jobObj.doAfterSelectHooks(ctx, exec); err != nil {
+		return jobObj, err
+	}
+
+	return jobObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *Job) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no jobs provided for insertion")
+	}
+
+	var err error
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+		if o.UpdatedAt.IsZero() {
+			o.UpdatedAt = currTime
+		}
+	}
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(jobColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	jobInsertCacheMut.RLock()
+	cache, cached := jobInsertCache[key]
+	jobInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			jobAllColumns,
+			jobColumnsWithDefault,
+			jobColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(jobType, jobMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(jobType, jobMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"jobs\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"jobs\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "models: unable to insert into jobs")
+	}
+
+	if !cached {
+		jobInsertCacheMut.Lock()
+		jobInsertCache[key] = cache
+		jobInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// Update uses an executor to update the Job.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *Job) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		o.UpdatedAt = currTime
+	}
+
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	jobUpdateCacheMut.RLock()
+	cache, cached := jobUpdateCache[key]
+	jobUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			jobAllColumns,
+			jobPrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("models: unable to update jobs, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"jobs\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 0, wl),
+			strmangle.WhereClause("\"", "\"", 0, jobPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(jobType, jobMapping, append(wl, jobPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update jobs row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by update for jobs")
+	}
+
+	if !cached {
+		jobUpdateCacheMut.Lock()
+		jobUpdateCache[key] = cache
+		jobUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q jobQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all for jobs")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected for jobs")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o JobSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("models: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]any, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), jobPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"jobs\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 0, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, jobPrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all in job slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected all in update all job")
+	}
+	return rowsAff, nil
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *Job) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no jobs provided for upsert")
+	}
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+		o.UpdatedAt = currTime
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(jobColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	jobUpsertCacheMut.RLock()
+	cache, cached := jobUpsertCache[key]
+	jobUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, _ := insertColumns.InsertColumnSet(
+			jobAllColumns,
+			jobColumnsWithDefault,
+			jobColumnsWithoutDefault,
+			nzDefaults,
+		)
+		update := updateColumns.UpdateColumnSet(
+			jobAllColumns,
+			jobPrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("models: unable to upsert jobs, could not build update column list")
+		}
+
+		ret := strmangle.SetComplement(jobAllColumns, strmangle.SetIntersect(insert, update))
+
+		conflict := conflictColumns
+		if len(conflict) == 0 {
+			conflict = make([]string, len(jobPrimaryKeyColumns))
+			copy(conflict, jobPrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQuerySQLite(dialect, "\"jobs\"", updateOnConflict, ret, update, conflict, insert)
+
+		cache.valueMapping, err = queries.BindMapping(jobType, jobMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(jobType, jobMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []any
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if errors.Is(err, sql.ErrNoRows) {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "models: unable to upsert jobs")
+	}
+
+	if !cached {
+		jobUpsertCacheMut.Lock()
+		jobUpsertCache[key] = cache
+		jobUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// Delete deletes a single Job record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *Job) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("models: no Job provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), jobPrimaryKeyMapping)
+	sql := "DELETE FROM \"jobs\" WHERE \"id\"=?"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete from jobs")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by delete for jobs")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q jobQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("models: no jobQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from jobs")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for jobs")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o JobSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(jobBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []any
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), jobPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"jobs\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, jobPrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from job slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for jobs")
+	}
+
+	if len(jobAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *Job) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindJob(ctx, exec, o.ID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *JobSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := JobSlice{}
+	var args []any
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), jobPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"jobs\".* FROM \"jobs\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, jobPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to reload all in JobSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// JobExists checks if the Job row exists.
+func JobExists(ctx context.Context, exec boil.ContextExecutor, iD string) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"jobs\" where \"id\"=? limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, iD)
+	}
+	row := exec.QueryRowContext(ctx, sql, iD)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "models: unable to check if jobs exists")
+	}
+
+	return exists, nil
+}
+
+// Exists checks if the Job row exists.
+func (o *Job) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	return JobExists(ctx, exec, o.ID)
+}
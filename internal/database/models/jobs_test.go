@@ -0,0 +1,731 @@
+// Code generated by SQLBoiler 4.19.7 (https://github.com/aarondl/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"bytes"
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/aarondl/randomize"
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries"
+	"github.com/aarondl/strmangle"
+)
+
+var (
+	// Relationships sometimes use the reflection helper queries.Equal/queries.Assign
+	// so force a package dependency in case they don't.
+	_ = queries.Equal
+)
+
+func testJobs(t *testing.T) {
+	t.Parallel()
+
+	query := Jobs()
+
+	if query.Query == nil {
+		t.Error("expected a query, got nothing")
+	}
+}
+
+func testJobsDelete(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Job{}
+	if err = randomize.Struct(seed, o, jobDBTypes, true, jobColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Job struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := o.Delete(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := Jobs().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testJobsQueryDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Job{}
+	if err = randomize.Struct(seed, o, jobDBTypes, true, jobColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Job struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := Jobs().DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := Jobs().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testJobsSliceDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Job{}
+	if err = randomize.Struct(seed, o, jobDBTypes, true, jobColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Job struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := JobSlice{o}
+
+	if rowsAff, err := slice.DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := Jobs().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testJobsExists(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Job{}
+	if err = randomize.Struct(seed, o, jobDBTypes, true, jobColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Job struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	e, err := JobExists(ctx, tx, o.ID)
+	if err != nil {
+		t.Errorf("Unable to check if Job exists: %s", err)
+	}
+	if !e {
+		t.Errorf("Expected JobExists to return true, but got false.")
+	}
+}
+
+func testJobsFind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Job{}
+	if err = randomize.Struct(seed, o, jobDBTypes, true, jobColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Job struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	jobFound, err := FindJob(ctx, tx, o.ID)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if jobFound == nil {
+		t.Error("want a record, got nil")
+	}
+}
+
+func testJobsBind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Job{}
+	if err = randomize.Struct(seed, o, jobDBTypes, true, jobColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Job struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = Jobs().Bind(ctx, tx, o); err != nil {
+		t.Error(err)
+	}
+}
+
+func testJobsOne(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Job{}
+	if err = randomize.Struct(seed, o, jobDBTypes, true, jobColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Job struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if x, err := Jobs().One(ctx, tx); err != nil {
+		t.Error(err)
+	} else if x == nil {
+		t.Error("expected to get a non nil record")
+	}
+}
+
+func testJobsAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	jobOne := &Job{}
+	jobTwo := &Job{}
+	if err = randomize.Struct(seed, jobOne, jobDBTypes, false, jobColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Job struct: %s", err)
+	}
+	if err = randomize.Struct(seed, jobTwo, jobDBTypes, false, jobColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Job struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = jobOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = jobTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := Jobs().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 2 {
+		t.Error("want 2 records, got:", len(slice))
+	}
+}
+
+func testJobsCount(t *testing.T) {
+	t.Parallel()
+
+	var err error
+	seed := randomize.NewSeed()
+	jobOne := &Job{}
+	jobTwo := &Job{}
+	if err = randomize.Struct(seed, jobOne, jobDBTypes, false, jobColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Job struct: %s", err)
+	}
+	if err = randomize.Struct(seed, jobTwo, jobDBTypes, false, jobColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Job struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = jobOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = jobTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := Jobs().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 2 {
+		t.Error("want 2 records, got:", count)
+	}
+}
+
+func jobBeforeInsertHook(ctx context.Context, e boil.ContextExecutor, o *Job) error {
+	*o = Job{}
+	return nil
+}
+
+func jobAfterInsertHook(ctx context.Context, e boil.ContextExecutor, o *Job) error {
+	*o = Job{}
+	return nil
+}
+
+func jobAfterSelectHook(ctx context.Context, e boil.ContextExecutor, o *Job) error {
+	*o = Job{}
+	return nil
+}
+
+func jobBeforeUpdateHook(ctx context.Context, e boil.ContextExecutor, o *Job) error {
+	*o = Job{}
+	return nil
+}
+
+func jobAfterUpdateHook(ctx context.Context, e boil.ContextExecutor, o *Job) error {
+	*o = Job{}
+	return nil
+}
+
+func jobBeforeDeleteHook(ctx context.Context, e boil.ContextExecutor, o *Job) error {
+	*o = Job{}
+	return nil
+}
+
+func jobAfterDeleteHook(ctx context.Context, e boil.ContextExecutor, o *Job) error {
+	*o = Job{}
+	return nil
+}
+
+func jobBeforeUpsertHook(ctx context.Context, e boil.ContextExecutor, o *Job) error {
+	*o = Job{}
+	return nil
+}
+
+func jobAfterUpsertHook(ctx context.Context, e boil.ContextExecutor, o *Job) error {
+	*o = Job{}
+	return nil
+}
+
+func testJobsHooks(t *testing.T) {
+	t.Parallel()
+
+	var err error
+
+	ctx := context.Background()
+	empty := &Job{}
+	o := &Job{}
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, o, jobDBTypes, false); err != nil {
+		t.Errorf("Unable to randomize Job object: %s", err)
+	}
+
+	AddJobHook(boil.BeforeInsertHook, jobBeforeInsertHook)
+	if err = o.doBeforeInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeInsertHook function to empty object, but got: %#v", o)
+	}
+	jobBeforeInsertHooks = []JobHook{}
+
+	AddJobHook(boil.AfterInsertHook, jobAfterInsertHook)
+	if err = o.doAfterInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterInsertHook function to empty object, but got: %#v", o)
+	}
+	jobAfterInsertHooks = []JobHook{}
+
+	AddJobHook(boil.AfterSelectHook, jobAfterSelectHook)
+	if err = o.doAfterSelectHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterSelectHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterSelectHook function to empty object, but got: %#v", o)
+	}
+	jobAfterSelectHooks = []JobHook{}
+
+	AddJobHook(boil.BeforeUpdateHook, jobBeforeUpdateHook)
+	if err = o.doBeforeUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpdateHook function to empty object, but got: %#v", o)
+	}
+	jobBeforeUpdateHooks = []JobHook{}
+
+	AddJobHook(boil.AfterUpdateHook, jobAfterUpdateHook)
+	if err = o.doAfterUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpdateHook function to empty object, but got: %#v", o)
+	}
+	jobAfterUpdateHooks = []JobHook{}
+
+	AddJobHook(boil.BeforeDeleteHook, jobBeforeDeleteHook)
+	if err = o.doBeforeDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeDeleteHook function to empty object, but got: %#v", o)
+	}
+	jobBeforeDeleteHooks = []JobHook{}
+
+	AddJobHook(boil.AfterDeleteHook, jobAfterDeleteHook)
+	if err = o.doAfterDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterDeleteHook function to empty object, but got: %#v", o)
+	}
+	jobAfterDeleteHooks = []JobHook{}
+
+	AddJobHook(boil.BeforeUpsertHook, jobBeforeUpsertHook)
+	if err = o.doBeforeUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpsertHook function to empty object, but got: %#v", o)
+	}
+	jobBeforeUpsertHooks = []JobHook{}
+
+	AddJobHook(boil.AfterUpsertHook, jobAfterUpsertHook)
+	if err = o.doAfterUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpsertHook function to empty object, but got: %#v", o)
+	}
+	jobAfterUpsertHooks = []JobHook{}
+}
+
+func testJobsInsert(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Job{}
+	if err = randomize.Struct(seed, o, jobDBTypes, true, jobColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Job struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := Jobs().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testJobsInsertWhitelist(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Job{}
+	if err = randomize.Struct(seed, o, jobDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize Job struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Whitelist(strmangle.SetMerge(jobPrimaryKeyColumns, jobColumnsWithoutDefault)...)); err != nil {
+		t.Error(err)
+	}
+
+	count, err := Jobs().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testJobsReload(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Job{}
+	if err = randomize.Struct(seed, o, jobDBTypes, true, jobColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Job struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = o.Reload(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testJobsReloadAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Job{}
+	if err = randomize.Struct(seed, o, jobDBTypes, true, jobColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Job struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := JobSlice{o}
+
+	if err = slice.ReloadAll(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testJobsSelect(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Job{}
+	if err = randomize.Struct(seed, o, jobDBTypes, true, jobColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Job struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := Jobs().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 1 {
+		t.Error("want one record, got:", len(slice))
+	}
+}
+
+var (
+	jobDBTypes = map[string]string{`ID`: `TEXT`, `CreatedAt`: `DATE`, `UpdatedAt`: `DATE`, `Kind`: `TEXT`, `Payload`: `TEXT`, `DedupKey`: `TEXT`, `Priority`: `INTEGER`, `Status`: `TEXT`, `Attempts`: `INTEGER`, `MaxAttempts`: `INTEGER`, `RunAt`: `DATE`, `LockedBy`: `TEXT`, `LockedUntil`: `DATE`, `LastError`: `TEXT`, `FinishedAt`: `DATE`}
+	_          = bytes.MinRead
+)
+
+func testJobsUpdate(t *testing.T) {
+	t.Parallel()
+
+	if 0 == len(jobPrimaryKeyColumns) {
+		t.Skip("Skipping table with no primary key columns")
+	}
+	if len(jobAllColumns) == len(jobPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Job{}
+	if err = randomize.Struct(seed, o, jobDBTypes, true, jobColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Job struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := Jobs().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, jobDBTypes, true, jobPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize Job struct: %s", err)
+	}
+
+	if rowsAff, err := o.Update(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only affect one row but affected", rowsAff)
+	}
+}
+
+func testJobsSliceUpdateAll(t *testing.T) {
+	t.Parallel()
+
+	if len(jobAllColumns) == len(jobPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Job{}
+	if err = randomize.Struct(seed, o, jobDBTypes, true, jobColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Job struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := Jobs().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, jobDBTypes, true, jobPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize Job struct: %s", err)
+	}
+
+	// Remove Primary keys and unique columns from what we plan to update
+	var fields []string
+	if strmangle.StringSliceMatch(jobAllColumns, jobPrimaryKeyColumns) {
+		fields = jobAllColumns
+	} else {
+		fields = strmangle.SetComplement(
+			jobAllColumns,
+			jobPrimaryKeyColumns,
+		)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	typ := reflect.TypeOf(o).Elem()
+	n := typ.NumField()
+
+	updateMap := M{}
+	for _, col := range fields {
+		for i := 0; i < n; i++ {
+			f := typ.Field(i)
+			if f.Tag.Get("boil") == col {
+				updateMap[col] = value.Field(i).Interface()
+			}
+		}
+	}
+
+	slice := JobSlice{o}
+	if rowsAff, err := slice.UpdateAll(ctx, tx, updateMap); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("wanted one record updated but got", rowsAff)
+	}
+}
+
+func testJobsUpsert(t *testing.T) {
+	t.Parallel()
+	if len(jobAllColumns) == len(jobPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	// Attempt the INSERT side of an UPSERT
+	o := Job{}
+	if err = randomize.Struct(seed, &o, jobDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize Job struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Upsert(ctx, tx, false, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert Job: %s", err)
+	}
+
+	count, err := Jobs().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	// Attempt the UPDATE side of an UPSERT
+	if err = randomize.Struct(seed, &o, jobDBTypes, false, jobPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize Job struct: %s", err)
+	}
+
+	if err = o.Upsert(ctx, tx, true, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert Job: %s", err)
+	}
+
+	count, err = Jobs().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
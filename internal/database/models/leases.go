@@ -0,0 +1,937 @@
+// Code generated by SQLBoiler 4.19.7 (https://github.com/aarondl/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries"
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+	"github.com/aarondl/sqlboiler/v4/queries/qmhelper"
+	"github.com/aarondl/strmangle"
+	"github.com/friendsofgo/errors"
+)
+
+// Lease is an object representing the database table.
+type Lease struct {
+	Name       string    `boil:"name" json:"name" toml:"name" yaml:"name"`
+	Holder     string    `boil:"holder" json:"holder" toml:"holder" yaml:"holder"`
+	AcquiredAt time.Time `boil:"acquired_at" json:"acquired_at" toml:"acquired_at" yaml:"acquired_at"`
+	ExpiresAt  time.Time `boil:"expires_at" json:"expires_at" toml:"expires_at" yaml:"expires_at"`
+
+	R *leaseR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L leaseL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var LeaseColumns = struct {
+	Name       string
+	Holder     string
+	AcquiredAt string
+	ExpiresAt  string
+}{
+	Name:       "name",
+	Holder:     "holder",
+	AcquiredAt: "acquired_at",
+	ExpiresAt:  "expires_at",
+}
+
+var LeaseTableColumns = struct {
+	Name       string
+	Holder     string
+	AcquiredAt string
+	ExpiresAt  string
+}{
+	Name:       "leases.name",
+	Holder:     "leases.holder",
+	AcquiredAt: "leases.acquired_at",
+	ExpiresAt:  "leases.expires_at",
+}
+
+// Generated where
+
+var LeaseWhere = struct {
+	Name       whereHelperstring
+	Holder     whereHelperstring
+	AcquiredAt whereHelpertime_Time
+	ExpiresAt  whereHelpertime_Time
+}{
+	Name:       whereHelperstring{field: "\"leases\".\"name\""},
+	Holder:     whereHelperstring{field: "\"leases\".\"holder\""},
+	AcquiredAt: whereHelpertime_Time{field: "\"leases\".\"acquired_at\""},
+	ExpiresAt:  whereHelpertime_Time{field: "\"leases\".\"expires_at\""},
+}
+
+// LeaseRels is where relationship names are stored.
+var LeaseRels = struct {
+}{}
+
+// leaseR is where relationships are stored.
+type leaseR struct {
+}
+
+// NewStruct creates a new relationship struct
+func (*leaseR) NewStruct() *leaseR {
+	return &leaseR{}
+}
+
+// leaseL is where Load methods for each relationship are stored.
+type leaseL struct{}
+
+var (
+	leaseAllColumns            = []string{"name", "holder", "acquired_at", "expires_at"}
+	leaseColumnsWithoutDefault = []string{"name", "holder", "acquired_at", "expires_at"}
+	leaseColumnsWithDefault    = []string{}
+	leasePrimaryKeyColumns     = []string{"name"}
+	leaseGeneratedColumns      = []string{}
+)
+
+type (
+	// LeaseSlice is an alias for a slice of pointers to Lease.
+	// This should almost always be used instead of []Lease.
+	LeaseSlice []*Lease
+	// LeaseHook is the signature for custom Lease hook methods
+	LeaseHook func(context.Context, boil.ContextExecutor, *Lease) error
+
+	leaseQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	leaseType                 = reflect.TypeOf(&Lease{})
+	leaseMapping              = queries.MakeStructMapping(leaseType)
+	leasePrimaryKeyMapping, _ = queries.BindMapping(leaseType, leaseMapping, leasePrimaryKeyColumns)
+	leaseInsertCacheMut       sync.RWMutex
+	leaseInsertCache          = make(map[string]insertCache)
+	leaseUpdateCacheMut       sync.RWMutex
+	leaseUpdateCache          = make(map[string]updateCache)
+	leaseUpsertCacheMut       sync.RWMutex
+	leaseUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var leaseAfterSelectMu sync.Mutex
+var leaseAfterSelectHooks []LeaseHook
+
+var leaseBeforeInsertMu sync.Mutex
+var leaseBeforeInsertHooks []LeaseHook
+var leaseAfterInsertMu sync.Mutex
+var leaseAfterInsertHooks []LeaseHook
+
+var leaseBeforeUpdateMu sync.Mutex
+var leaseBeforeUpdateHooks []LeaseHook
+var leaseAfterUpdateMu sync.Mutex
+var leaseAfterUpdateHooks []LeaseHook
+
+var leaseBeforeDeleteMu sync.Mutex
+var leaseBeforeDeleteHooks []LeaseHook
+var leaseAfterDeleteMu sync.Mutex
+var leaseAfterDeleteHooks []LeaseHook
+
+var leaseBeforeUpsertMu sync.Mutex
+var leaseBeforeUpsertHooks []LeaseHook
+var leaseAfterUpsertMu sync.Mutex
+var leaseAfterUpsertHooks []LeaseHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *Lease) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range leaseAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *Lease) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range leaseBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *Lease) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range leaseAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *Lease) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range leaseBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *Lease) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range leaseAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *Lease) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range leaseBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *Lease) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range leaseAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *Lease) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range leaseBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *Lease) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range leaseAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddLeaseHook registers your hook function for all future operations.
+func AddLeaseHook(hookPoint boil.HookPoint, leaseHook LeaseHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		leaseAfterSelectMu.Lock()
+		leaseAfterSelectHooks = append(leaseAfterSelectHooks, leaseHook)
+		leaseAfterSelectMu.Unlock()
+	case boil.BeforeInsertHook:
+		leaseBeforeInsertMu.Lock()
+		leaseBeforeInsertHooks = append(leaseBeforeInsertHooks, leaseHook)
+		leaseBeforeInsertMu.Unlock()
+	case boil.AfterInsertHook:
+		leaseAfterInsertMu.Lock()
+		leaseAfterInsertHooks = append(leaseAfterInsertHooks, leaseHook)
+		leaseAfterInsertMu.Unlock()
+	case boil.BeforeUpdateHook:
+		leaseBeforeUpdateMu.Lock()
+		leaseBeforeUpdateHooks = append(leaseBeforeUpdateHooks, leaseHook)
+		leaseBeforeUpdateMu.Unlock()
+	case boil.AfterUpdateHook:
+		leaseAfterUpdateMu.Lock()
+		leaseAfterUpdateHooks = append(leaseAfterUpdateHooks, leaseHook)
+		leaseAfterUpdateMu.Unlock()
+	case boil.BeforeDeleteHook:
+		leaseBeforeDeleteMu.Lock()
+		leaseBeforeDeleteHooks = append(leaseBeforeDeleteHooks, leaseHook)
+		leaseBeforeDeleteMu.Unlock()
+	case boil.AfterDeleteHook:
+		leaseAfterDeleteMu.Lock()
+		leaseAfterDeleteHooks = append(leaseAfterDeleteHooks, leaseHook)
+		leaseAfterDeleteMu.Unlock()
+	case boil.BeforeUpsertHook:
+		leaseBeforeUpsertMu.Lock()
+		leaseBeforeUpsertHooks = append(leaseBeforeUpsertHooks, leaseHook)
+		leaseBeforeUpsertMu.Unlock()
+	case boil.AfterUpsertHook:
+		leaseAfterUpsertMu.Lock()
+		leaseAfterUpsertHooks = append(leaseAfterUpsertHooks, leaseHook)
+		leaseAfterUpsertMu.Unlock()
+	}
+}
+
+// One returns a single lease record from the query.
+func (q leaseQuery) One(ctx context.Context, exec boil.ContextExecutor) (*Lease, error) {
+	o := &Lease{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: failed to execute a one query for leases")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all Lease records from the query.
+func (q leaseQuery) All(ctx context.Context, exec boil.ContextExecutor) (LeaseSlice, error) {
+	var o []*Lease
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "models: failed to assign all query results to Lease slice")
+	}
+
+	if len(leaseAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all Lease records in the query.
+func (q leaseQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to count leases rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q leaseQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "models: failed to check if leases exists")
+	}
+
+	return count > 0, nil
+}
+
+// Leases retrieves all the records using an executor.
+func Leases(mods ...qm.QueryMod) leaseQuery {
+	mods = append(mods, qm.From("\"leases\""))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"\"leases\".*"})
+	}
+
+	return leaseQuery{q}
+}
+
+// FindLease retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindLease(ctx context.Context, exec boil.ContextExecutor, name string, selectCols ...string) (*Lease, error) {
+	leaseObj := &Lease{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"leases\" where \"name\"=?", sel,
+	)
+
+	q := queries.Raw(query, name)
+
+	err := q.Bind(ctx, exec, leaseObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: unable to select from leases")
+	}
+
+	if err = leaseObj.doAfterSelectHooks(ctx, exec); err != nil {
+		return leaseObj, err
+	}
+
+	return leaseObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *Lease) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no leases provided for insertion")
+	}
+
+	var err error
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(leaseColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	leaseInsertCacheMut.RLock()
+	cache, cached := leaseInsertCache[key]
+	leaseInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			leaseAllColumns,
+			leaseColumnsWithDefault,
+			leaseColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(leaseType, leaseMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(leaseType, leaseMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"leases\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"leases\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "models: unable to insert into leases")
+	}
+
+	if !cached {
+		leaseInsertCacheMut.Lock()
+		leaseInsertCache[key] = cache
+		leaseInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// Update uses an executor to update the Lease.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *Lease) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	leaseUpdateCacheMut.RLock()
+	cache, cached := leaseUpdateCache[key]
+	leaseUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			leaseAllColumns,
+			leasePrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("models: unable to update leases, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"leases\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 0, wl),
+			strmangle.WhereClause("\"", "\"", 0, leasePrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(leaseType, leaseMapping, append(wl, leasePrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update leases row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by update for leases")
+	}
+
+	if !cached {
+		leaseUpdateCacheMut.Lock()
+		leaseUpdateCache[key] = cache
+		leaseUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q leaseQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all for leases")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected for leases")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o LeaseSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("models: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]any, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), leasePrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"leases\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 0, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, leasePrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all in lease slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected all in update all lease")
+	}
+	return rowsAff, nil
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *Lease) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no leases provided for upsert")
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(leaseColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	leaseUpsertCacheMut.RLock()
+	cache, cached := leaseUpsertCache[key]
+	leaseUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, _ := insertColumns.InsertColumnSet(
+			leaseAllColumns,
+			leaseColumnsWithDefault,
+			leaseColumnsWithoutDefault,
+			nzDefaults,
+		)
+		update := updateColumns.UpdateColumnSet(
+			leaseAllColumns,
+			leasePrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("models: unable to upsert leases, could not build update column list")
+		}
+
+		ret := strmangle.SetComplement(leaseAllColumns, strmangle.SetIntersect(insert, update))
+
+		conflict := conflictColumns
+		if len(conflict) == 0 {
+			conflict = make([]string, len(leasePrimaryKeyColumns))
+			copy(conflict, leasePrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQuerySQLite(dialect, "\"leases\"", updateOnConflict, ret, update, conflict, insert)
+
+		cache.valueMapping, err = queries.BindMapping(leaseType, leaseMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(leaseType, leaseMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []any
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if errors.Is(err, sql.ErrNoRows) {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "models: unable to upsert leases")
+	}
+
+	if !cached {
+		leaseUpsertCacheMut.Lock()
+		leaseUpsertCache[key] = cache
+		leaseUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// Delete deletes a single Lease record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *Lease) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("models: no Lease provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), leasePrimaryKeyMapping)
+	sql := "DELETE FROM \"leases\" WHERE \"name\"=?"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete from leases")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by delete for leases")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q leaseQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("models: no leaseQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from leases")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for leases")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o LeaseSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(leaseBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []any
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), leasePrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"leases\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, leasePrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from lease slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for leases")
+	}
+
+	if len(leaseAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *Lease) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindLease(ctx, exec, o.Name)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *LeaseSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := LeaseSlice{}
+	var args []any
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), leasePrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"leases\".* FROM \"leases\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, leasePrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to reload all in LeaseSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// LeaseExists checks if the Lease row exists.
+func LeaseExists(ctx context.Context, exec boil.ContextExecutor, name string) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"leases\" where \"name\"=? limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, name)
+	}
+	row := exec.QueryRowContext(ctx, sql, name)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "models: unable to check if leases exists")
+	}
+
+	return exists, nil
+}
+
+// Exists checks if the Lease row exists.
+func (o *Lease) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	return LeaseExists(ctx, exec, o.Name)
+}
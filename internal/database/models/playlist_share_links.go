@@ -0,0 +1,1174 @@
+// Code generated by SQLBoiler 4.19.7 (https://github.com/aarondl/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aarondl/null/v8"
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries"
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+	"github.com/aarondl/sqlboiler/v4/queries/qmhelper"
+	"github.com/aarondl/strmangle"
+	"github.com/friendsofgo/errors"
+)
+
+// PlaylistShareLink is an object representing the database table.
+type PlaylistShareLink struct {
+	ID         string    `boil:"id" json:"id" toml:"id" yaml:"id"`
+	CreatedAt  time.Time `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+	UpdatedAt  time.Time `boil:"updated_at" json:"updated_at" toml:"updated_at" yaml:"updated_at"`
+	PlaylistID string    `boil:"playlist_id" json:"playlist_id" toml:"playlist_id" yaml:"playlist_id"`
+	TokenHash  string    `boil:"token_hash" json:"token_hash" toml:"token_hash" yaml:"token_hash"`
+	ExpiresAt  null.Time `boil:"expires_at" json:"expires_at,omitempty" toml:"expires_at" yaml:"expires_at,omitempty"`
+
+	R *playlistShareLinkR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L playlistShareLinkL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var PlaylistShareLinkColumns = struct {
+	ID         string
+	CreatedAt  string
+	UpdatedAt  string
+	PlaylistID string
+	TokenHash  string
+	ExpiresAt  string
+}{
+	ID:         "id",
+	CreatedAt:  "created_at",
+	UpdatedAt:  "updated_at",
+	PlaylistID: "playlist_id",
+	TokenHash:  "token_hash",
+	ExpiresAt:  "expires_at",
+}
+
+var PlaylistShareLinkTableColumns = struct {
+	ID         string
+	CreatedAt  string
+	UpdatedAt  string
+	PlaylistID string
+	TokenHash  string
+	ExpiresAt  string
+}{
+	ID:         "playlist_share_links.id",
+	CreatedAt:  "playlist_share_links.created_at",
+	UpdatedAt:  "playlist_share_links.updated_at",
+	PlaylistID: "playlist_share_links.playlist_id",
+	TokenHash:  "playlist_share_links.token_hash",
+	ExpiresAt:  "playlist_share_links.expires_at",
+}
+
+// Generated where
+
+var PlaylistShareLinkWhere = struct {
+	ID         whereHelperstring
+	CreatedAt  whereHelpertime_Time
+	UpdatedAt  whereHelpertime_Time
+	PlaylistID whereHelperstring
+	TokenHash  whereHelperstring
+	ExpiresAt  whereHelpernull_Time
+}{
+	ID:         whereHelperstring{field: "\"playlist_share_links\".\"id\""},
+	CreatedAt:  whereHelpertime_Time{field: "\"playlist_share_links\".\"created_at\""},
+	UpdatedAt:  whereHelpertime_Time{field: "\"playlist_share_links\".\"updated_at\""},
+	PlaylistID: whereHelperstring{field: "\"playlist_share_links\".\"playlist_id\""},
+	TokenHash:  whereHelperstring{field: "\"playlist_share_links\".\"token_hash\""},
+	ExpiresAt:  whereHelpernull_Time{field: "\"playlist_share_links\".\"expires_at\""},
+}
+
+// PlaylistShareLinkRels is where relationship names are stored.
+var PlaylistShareLinkRels = struct {
+	Playlist string
+}{
+	Playlist: "Playlist",
+}
+
+// playlistShareLinkR is where relationships are stored.
+type playlistShareLinkR struct {
+	Playlist *Playlist `boil:"Playlist" json:"Playlist" toml:"Playlist" yaml:"Playlist"`
+}
+
+// NewStruct creates a new relationship struct
+func (*playlistShareLinkR) NewStruct() *playlistShareLinkR {
+	return &playlistShareLinkR{}
+}
+
+func (o *PlaylistShareLink) GetPlaylist() *Playlist {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetPlaylist()
+}
+
+func (r *playlistShareLinkR) GetPlaylist() *Playlist {
+	if r == nil {
+		return nil
+	}
+
+	return r.Playlist
+}
+
+// playlistShareLinkL is where Load methods for each relationship are stored.
+type playlistShareLinkL struct{}
+
+var (
+	playlistShareLinkAllColumns            = []string{"id", "created_at", "updated_at", "playlist_id", "token_hash", "expires_at"}
+	playlistShareLinkColumnsWithoutDefault = []string{"id", "created_at", "updated_at", "playlist_id", "token_hash"}
+	playlistShareLinkColumnsWithDefault    = []string{"expires_at"}
+	playlistShareLinkPrimaryKeyColumns     = []string{"id"}
+	playlistShareLinkGeneratedColumns      = []string{}
+)
+
+type (
+	// PlaylistShareLinkSlice is an alias for a slice of pointers to PlaylistShareLink.
+	// This should almost always be used instead of []PlaylistShareLink.
+	PlaylistShareLinkSlice []*PlaylistShareLink
+	// PlaylistShareLinkHook is the signature for custom PlaylistShareLink hook methods
+	PlaylistShareLinkHook func(context.Context, boil.ContextExecutor, *PlaylistShareLink) error
+
+	playlistShareLinkQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	playlistShareLinkType                 = reflect.TypeOf(&PlaylistShareLink{})
+	playlistShareLinkMapping              = queries.MakeStructMapping(playlistShareLinkType)
+	playlistShareLinkPrimaryKeyMapping, _ = queries.BindMapping(playlistShareLinkType, playlistShareLinkMapping, playlistShareLinkPrimaryKeyColumns)
+	playlistShareLinkInsertCacheMut       sync.RWMutex
+	playlistShareLinkInsertCache          = make(map[string]insertCache)
+	playlistShareLinkUpdateCacheMut       sync.RWMutex
+	playlistShareLinkUpdateCache          = make(map[string]updateCache)
+	playlistShareLinkUpsertCacheMut       sync.RWMutex
+	playlistShareLinkUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var playlistShareLinkAfterSelectMu sync.Mutex
+var playlistShareLinkAfterSelectHooks []PlaylistShareLinkHook
+
+var playlistShareLinkBeforeInsertMu sync.Mutex
+var playlistShareLinkBeforeInsertHooks []PlaylistShareLinkHook
+var playlistShareLinkAfterInsertMu sync.Mutex
+var playlistShareLinkAfterInsertHooks []PlaylistShareLinkHook
+
+var playlistShareLinkBeforeUpdateMu sync.Mutex
+var playlistShareLinkBeforeUpdateHooks []PlaylistShareLinkHook
+var playlistShareLinkAfterUpdateMu sync.Mutex
+var playlistShareLinkAfterUpdateHooks []PlaylistShareLinkHook
+
+var playlistShareLinkBeforeDeleteMu sync.Mutex
+var playlistShareLinkBeforeDeleteHooks []PlaylistShareLinkHook
+var playlistShareLinkAfterDeleteMu sync.Mutex
+var playlistShareLinkAfterDeleteHooks []PlaylistShareLinkHook
+
+var playlistShareLinkBeforeUpsertMu sync.Mutex
+var playlistShareLinkBeforeUpsertHooks []PlaylistShareLinkHook
+var playlistShareLinkAfterUpsertMu sync.Mutex
+var playlistShareLinkAfterUpsertHooks []PlaylistShareLinkHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *PlaylistShareLink) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range playlistShareLinkAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *PlaylistShareLink) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range playlistShareLinkBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *PlaylistShareLink) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range playlistShareLinkAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *PlaylistShareLink) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range playlistShareLinkBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *PlaylistShareLink) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range playlistShareLinkAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *PlaylistShareLink) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range playlistShareLinkBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *PlaylistShareLink) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range playlistShareLinkAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *PlaylistShareLink) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range playlistShareLinkBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *PlaylistShareLink) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range playlistShareLinkAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddPlaylistShareLinkHook registers your hook function for all future operations.
+func AddPlaylistShareLinkHook(hookPoint boil.HookPoint, playlistShareLinkHook PlaylistShareLinkHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		playlistShareLinkAfterSelectMu.Lock()
+		playlistShareLinkAfterSelectHooks = append(playlistShareLinkAfterSelectHooks, playlistShareLinkHook)
+		playlistShareLinkAfterSelectMu.Unlock()
+	case boil.BeforeInsertHook:
+		playlistShareLinkBeforeInsertMu.Lock()
+		playlistShareLinkBeforeInsertHooks = append(playlistShareLinkBeforeInsertHooks, playlistShareLinkHook)
+		playlistShareLinkBeforeInsertMu.Unlock()
+	case boil.AfterInsertHook:
+		playlistShareLinkAfterInsertMu.Lock()
+		playlistShareLinkAfterInsertHooks = append(playlistShareLinkAfterInsertHooks, playlistShareLinkHook)
+		playlistShareLinkAfterInsertMu.Unlock()
+	case boil.BeforeUpdateHook:
+		playlistShareLinkBeforeUpdateMu.Lock()
+		playlistShareLinkBeforeUpdateHooks = append(playlistShareLinkBeforeUpdateHooks, playlistShareLinkHook)
+		playlistShareLinkBeforeUpdateMu.Unlock()
+	case boil.AfterUpdateHook:
+		playlistShareLinkAfterUpdateMu.Lock()
+		playlistShareLinkAfterUpdateHooks = append(playlistShareLinkAfterUpdateHooks, playlistShareLinkHook)
+		playlistShareLinkAfterUpdateMu.Unlock()
+	case boil.BeforeDeleteHook:
+		playlistShareLinkBeforeDeleteMu.Lock()
+		playlistShareLinkBeforeDeleteHooks = append(playlistShareLinkBeforeDeleteHooks, playlistShareLinkHook)
+		playlistShareLinkBeforeDeleteMu.Unlock()
+	case boil.AfterDeleteHook:
+		playlistShareLinkAfterDeleteMu.Lock()
+		playlistShareLinkAfterDeleteHooks = append(playlistShareLinkAfterDeleteHooks, playlistShareLinkHook)
+		playlistShareLinkAfterDeleteMu.Unlock()
+	case boil.BeforeUpsertHook:
+		playlistShareLinkBeforeUpsertMu.Lock()
+		playlistShareLinkBeforeUpsertHooks = append(playlistShareLinkBeforeUpsertHooks, playlistShareLinkHook)
+		playlistShareLinkBeforeUpsertMu.Unlock()
+	case boil.AfterUpsertHook:
+		playlistShareLinkAfterUpsertMu.Lock()
+		playlistShareLinkAfterUpsertHooks = append(playlistShareLinkAfterUpsertHooks, playlistShareLinkHook)
+		playlistShareLinkAfterUpsertMu.Unlock()
+	}
+}
+
+// One returns a single playlistShareLink record from the query.
+func (q playlistShareLinkQuery) One(ctx context.Context, exec boil.ContextExecutor) (*PlaylistShareLink, error) {
+	o := &PlaylistShareLink{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: failed to execute a one query for playlist_share_links")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all PlaylistShareLink records from the query.
+func (q playlistShareLinkQuery) All(ctx context.Context, exec boil.ContextExecutor) (PlaylistShareLinkSlice, error) {
+	var o []*PlaylistShareLink
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "models: failed to assign all query results to PlaylistShareLink slice")
+	}
+
+	if len(playlistShareLinkAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all PlaylistShareLink records in the query.
+func (q playlistShareLinkQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to count playlist_share_links rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q playlistShareLinkQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "models: failed to check if playlist_share_links exists")
+	}
+
+	return count > 0, nil
+}
+
+// Playlist pointed to by the foreign key.
+func (o *PlaylistShareLink) Playlist(mods ...qm.QueryMod) playlistQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.PlaylistID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Playlists(queryMods...)
+}
+
+// LoadPlaylist allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (playlistShareLinkL) LoadPlaylist(ctx context.Context, e boil.ContextExecutor, singular bool, maybePlaylistShareLink any, mods queries.Applicator) error {
+	var slice []*PlaylistShareLink
+	var object *PlaylistShareLink
+
+	if singular {
+		var ok bool
+		object, ok = maybePlaylistShareLink.(*PlaylistShareLink)
+		if !ok {
+			object = new(PlaylistShareLink)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybePlaylistShareLink)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybePlaylistShareLink))
+			}
+		}
+	} else {
+		s, ok := maybePlaylistShareLink.(*[]*PlaylistShareLink)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybePlaylistShareLink)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybePlaylistShareLink))
+			}
+		}
+	}
+
+	args := make(map[any]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &playlistShareLinkR{}
+		}
+		args[object.PlaylistID] = struct{}{}
+
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &playlistShareLinkR{}
+			}
+
+			args[obj.PlaylistID] = struct{}{}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]any, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`playlists`),
+		qm.WhereIn(`playlists.id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load Playlist")
+	}
+
+	var resultSlice []*Playlist
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice Playlist")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for playlists")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for playlists")
+	}
+
+	if len(playlistAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Playlist = foreign
+		if foreign.R == nil {
+			foreign.R = &playlistR{}
+		}
+		foreign.R.PlaylistShareLinks = append(foreign.R.PlaylistShareLinks, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.PlaylistID == foreign.ID {
+				local.R.Playlist = foreign
+				if foreign.R == nil {
+					foreign.R = &playlistR{}
+				}
+				foreign.R.PlaylistShareLinks = append(foreign.R.PlaylistShareLinks, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetPlaylist of the playlistShareLink to the related item.
+// Sets o.R.Playlist to related.
+// Adds o to related.R.PlaylistShareLinks.
+func (o *PlaylistShareLink) SetPlaylist(ctx context.Context, exec boil.ContextExecutor, insert bool, related *Playlist) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"playlist_share_links\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 0, []string{"playlist_id"}),
+		strmangle.WhereClause("\"", "\"", 0, playlistShareLinkPrimaryKeyColumns),
+	)
+	values := []any{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.PlaylistID = related.ID
+	if o.R == nil {
+		o.R = &playlistShareLinkR{
+			Playlist: related,
+		}
+	} else {
+		o.R.Playlist = related
+	}
+
+	if related.R == nil {
+		related.R = &playlistR{
+			PlaylistShareLinks: PlaylistShareLinkSlice{o},
+		}
+	} else {
+		related.R.PlaylistShareLinks = append(related.R.PlaylistShareLinks, o)
+	}
+
+	return nil
+}
+
+// PlaylistShareLinks retrieves all the records using an executor.
+func PlaylistShareLinks(mods ...qm.QueryMod) playlistShareLinkQuery {
+	mods = append(mods, qm.From("\"playlist_share_links\""))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"\"playlist_share_links\".*"})
+	}
+
+	return playlistShareLinkQuery{q}
+}
+
+// FindPlaylistShareLink retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindPlaylistShareLink(ctx context.Context, exec boil.ContextExecutor, iD string, selectCols ...string) (*PlaylistShareLink, error) {
+	playlistShareLinkObj := &PlaylistShareLink{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"playlist_share_links\" where \"id\"=?", sel,
+	)
+
+	q := queries.Raw(query, iD)
+
+	err := q.Bind(ctx, exec, playlistShareLinkObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: unable to select from playlist_share_links")
+	}
+
+	if err = playlistShareLinkObj.doAfterSelectHooks(ctx, exec); err != nil {
+		return playlistShareLinkObj, err
+	}
+
+	return playlistShareLinkObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *PlaylistShareLink) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no playlist_share_links provided for insertion")
+	}
+
+	var err error
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+		if o.UpdatedAt.IsZero() {
+			o.UpdatedAt = currTime
+		}
+	}
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(playlistShareLinkColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	playlistShareLinkInsertCacheMut.RLock()
+	cache, cached := playlistShareLinkInsertCache[key]
+	playlistShareLinkInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			playlistShareLinkAllColumns,
+			playlistShareLinkColumnsWithDefault,
+			playlistShareLinkColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(playlistShareLinkType, playlistShareLinkMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(playlistShareLinkType, playlistShareLinkMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"playlist_share_links\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"playlist_share_links\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "models: unable to insert into playlist_share_links")
+	}
+
+	if !cached {
+		playlistShareLinkInsertCacheMut.Lock()
+		playlistShareLinkInsertCache[key] = cache
+		playlistShareLinkInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// Update uses an executor to update the PlaylistShareLink.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *PlaylistShareLink) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		o.UpdatedAt = currTime
+	}
+
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	playlistShareLinkUpdateCacheMut.RLock()
+	cache, cached := playlistShareLinkUpdateCache[key]
+	playlistShareLinkUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			playlistShareLinkAllColumns,
+			playlistShareLinkPrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("models: unable to update playlist_share_links, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"playlist_share_links\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 0, wl),
+			strmangle.WhereClause("\"", "\"", 0, playlistShareLinkPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(playlistShareLinkType, playlistShareLinkMapping, append(wl, playlistShareLinkPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update playlist_share_links row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by update for playlist_share_links")
+	}
+
+	if !cached {
+		playlistShareLinkUpdateCacheMut.Lock()
+		playlistShareLinkUpdateCache[key] = cache
+		playlistShareLinkUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q playlistShareLinkQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all for playlist_share_links")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected for playlist_share_links")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o PlaylistShareLinkSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("models: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]any, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), playlistShareLinkPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"playlist_share_links\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 0, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, playlistShareLinkPrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all in playlistShareLink slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected all in update all playlistShareLink")
+	}
+	return rowsAff, nil
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *PlaylistShareLink) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no playlist_share_links provided for upsert")
+	}
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+		o.UpdatedAt = currTime
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(playlistShareLinkColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	playlistShareLinkUpsertCacheMut.RLock()
+	cache, cached := playlistShareLinkUpsertCache[key]
+	playlistShareLinkUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, _ := insertColumns.InsertColumnSet(
+			playlistShareLinkAllColumns,
+			playlistShareLinkColumnsWithDefault,
+			playlistShareLinkColumnsWithoutDefault,
+			nzDefaults,
+		)
+		update := updateColumns.UpdateColumnSet(
+			playlistShareLinkAllColumns,
+			playlistShareLinkPrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("models: unable to upsert playlist_share_links, could not build update column list")
+		}
+
+		ret := strmangle.SetComplement(playlistShareLinkAllColumns, strmangle.SetIntersect(insert, update))
+
+		conflict := conflictColumns
+		if len(conflict) == 0 {
+			conflict = make([]string, len(playlistShareLinkPrimaryKeyColumns))
+			copy(conflict, playlistShareLinkPrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQuerySQLite(dialect, "\"playlist_share_links\"", updateOnConflict, ret, update, conflict, insert)
+
+		cache.valueMapping, err = queries.BindMapping(playlistShareLinkType, playlistShareLinkMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(playlistShareLinkType, playlistShareLinkMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []any
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if errors.Is(err, sql.ErrNoRows) {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "models: unable to upsert playlist_share_links")
+	}
+
+	if !cached {
+		playlistShareLinkUpsertCacheMut.Lock()
+		playlistShareLinkUpsertCache[key] = cache
+		playlistShareLinkUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// Delete deletes a single PlaylistShareLink record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *PlaylistShareLink) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("models: no PlaylistShareLink provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), playlistShareLinkPrimaryKeyMapping)
+	sql := "DELETE FROM \"playlist_share_links\" WHERE \"id\"=?"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete from playlist_share_links")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by delete for playlist_share_links")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q playlistShareLinkQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("models: no playlistShareLinkQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from playlist_share_links")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for playlist_share_links")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o PlaylistShareLinkSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(playlistShareLinkBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []any
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), playlistShareLinkPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"playlist_share_links\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, playlistShareLinkPrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from playlistShareLink slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for playlist_share_links")
+	}
+
+	if len(playlistShareLinkAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *PlaylistShareLink) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindPlaylistShareLink(ctx, exec, o.ID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *PlaylistShareLinkSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := PlaylistShareLinkSlice{}
+	var args []any
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), playlistShareLinkPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"playlist_share_links\".* FROM \"playlist_share_links\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, playlistShareLinkPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to reload all in PlaylistShareLinkSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// PlaylistShareLinkExists checks if the PlaylistShareLink row exists.
+func PlaylistShareLinkExists(ctx context.Context, exec boil.ContextExecutor, iD string) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"playlist_share_links\" where \"id\"=? limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, iD)
+	}
+	row := exec.QueryRowContext(ctx, sql, iD)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "models: unable to check if playlist_share_links exists")
+	}
+
+	return exists, nil
+}
+
+// Exists checks if the PlaylistShareLink row exists.
+func (o *PlaylistShareLink) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	return PlaylistShareLinkExists(ctx, exec, o.ID)
+}
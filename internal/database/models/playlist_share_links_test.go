@@ -0,0 +1,850 @@
+// Code generated by SQLBoiler 4.19.7 (https://github.com/aarondl/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"bytes"
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/aarondl/randomize"
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries"
+	"github.com/aarondl/strmangle"
+)
+
+var (
+	// Relationships sometimes use the reflection helper queries.Equal/queries.Assign
+	// so force a package dependency in case they don't.
+	_ = queries.Equal
+)
+
+func testPlaylistShareLinks(t *testing.T) {
+	t.Parallel()
+
+	query := PlaylistShareLinks()
+
+	if query.Query == nil {
+		t.Error("expected a query, got nothing")
+	}
+}
+
+func testPlaylistShareLinksDelete(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &PlaylistShareLink{}
+	if err = randomize.Struct(seed, o, playlistShareLinkDBTypes, true, playlistShareLinkColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PlaylistShareLink struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := o.Delete(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := PlaylistShareLinks().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testPlaylistShareLinksQueryDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &PlaylistShareLink{}
+	if err = randomize.Struct(seed, o, playlistShareLinkDBTypes, true, playlistShareLinkColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PlaylistShareLink struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := PlaylistShareLinks().DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := PlaylistShareLinks().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testPlaylistShareLinksSliceDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &PlaylistShareLink{}
+	if err = randomize.Struct(seed, o, playlistShareLinkDBTypes, true, playlistShareLinkColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PlaylistShareLink struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := PlaylistShareLinkSlice{o}
+
+	if rowsAff, err := slice.DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := PlaylistShareLinks().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testPlaylistShareLinksExists(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &PlaylistShareLink{}
+	if err = randomize.Struct(seed, o, playlistShareLinkDBTypes, true, playlistShareLinkColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PlaylistShareLink struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	e, err := PlaylistShareLinkExists(ctx, tx, o.ID)
+	if err != nil {
+		t.Errorf("Unable to check if PlaylistShareLink exists: %s", err)
+	}
+	if !e {
+		t.Errorf("Expected PlaylistShareLinkExists to return true, but got false.")
+	}
+}
+
+func testPlaylistShareLinksFind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &PlaylistShareLink{}
+	if err = randomize.Struct(seed, o, playlistShareLinkDBTypes, true, playlistShareLinkColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PlaylistShareLink struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	playlistShareLinkFound, err := FindPlaylistShareLink(ctx, tx, o.ID)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if playlistShareLinkFound == nil {
+		t.Error("want a record, got nil")
+	}
+}
+
+func testPlaylistShareLinksBind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &PlaylistShareLink{}
+	if err = randomize.Struct(seed, o, playlistShareLinkDBTypes, true, playlistShareLinkColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PlaylistShareLink struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = PlaylistShareLinks().Bind(ctx, tx, o); err != nil {
+		t.Error(err)
+	}
+}
+
+func testPlaylistShareLinksOne(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &PlaylistShareLink{}
+	if err = randomize.Struct(seed, o, playlistShareLinkDBTypes, true, playlistShareLinkColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PlaylistShareLink struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if x, err := PlaylistShareLinks().One(ctx, tx); err != nil {
+		t.Error(err)
+	} else if x == nil {
+		t.Error("expected to get a non nil record")
+	}
+}
+
+func testPlaylistShareLinksAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	playlistShareLinkOne := &PlaylistShareLink{}
+	playlistShareLinkTwo := &PlaylistShareLink{}
+	if err = randomize.Struct(seed, playlistShareLinkOne, playlistShareLinkDBTypes, false, playlistShareLinkColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PlaylistShareLink struct: %s", err)
+	}
+	if err = randomize.Struct(seed, playlistShareLinkTwo, playlistShareLinkDBTypes, false, playlistShareLinkColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PlaylistShareLink struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = playlistShareLinkOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = playlistShareLinkTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := PlaylistShareLinks().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 2 {
+		t.Error("want 2 records, got:", len(slice))
+	}
+}
+
+func testPlaylistShareLinksCount(t *testing.T) {
+	t.Parallel()
+
+	var err error
+	seed := randomize.NewSeed()
+	playlistShareLinkOne := &PlaylistShareLink{}
+	playlistShareLinkTwo := &PlaylistShareLink{}
+	if err = randomize.Struct(seed, playlistShareLinkOne, playlistShareLinkDBTypes, false, playlistShareLinkColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PlaylistShareLink struct: %s", err)
+	}
+	if err = randomize.Struct(seed, playlistShareLinkTwo, playlistShareLinkDBTypes, false, playlistShareLinkColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PlaylistShareLink struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = playlistShareLinkOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = playlistShareLinkTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := PlaylistShareLinks().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 2 {
+		t.Error("want 2 records, got:", count)
+	}
+}
+
+func playlistShareLinkBeforeInsertHook(ctx context.Context, e boil.ContextExecutor, o *PlaylistShareLink) error {
+	*o = PlaylistShareLink{}
+	return nil
+}
+
+func playlistShareLinkAfterInsertHook(ctx context.Context, e boil.ContextExecutor, o *PlaylistShareLink) error {
+	*o = PlaylistShareLink{}
+	return nil
+}
+
+func playlistShareLinkAfterSelectHook(ctx context.Context, e boil.ContextExecutor, o *PlaylistShareLink) error {
+	*o = PlaylistShareLink{}
+	return nil
+}
+
+func playlistShareLinkBeforeUpdateHook(ctx context.Context, e boil.ContextExecutor, o *PlaylistShareLink) error {
+	*o = PlaylistShareLink{}
+	return nil
+}
+
+func playlistShareLinkAfterUpdateHook(ctx context.Context, e boil.ContextExecutor, o *PlaylistShareLink) error {
+	*o = PlaylistShareLink{}
+	return nil
+}
+
+func playlistShareLinkBeforeDeleteHook(ctx context.Context, e boil.ContextExecutor, o *PlaylistShareLink) error {
+	*o = PlaylistShareLink{}
+	return nil
+}
+
+func playlistShareLinkAfterDeleteHook(ctx context.Context, e boil.ContextExecutor, o *PlaylistShareLink) error {
+	*o = PlaylistShareLink{}
+	return nil
+}
+
+func playlistShareLinkBeforeUpsertHook(ctx context.Context, e boil.ContextExecutor, o *PlaylistShareLink) error {
+	*o = PlaylistShareLink{}
+	return nil
+}
+
+func playlistShareLinkAfterUpsertHook(ctx context.Context, e boil.ContextExecutor, o *PlaylistShareLink) error {
+	*o = PlaylistShareLink{}
+	return nil
+}
+
+func testPlaylistShareLinksHooks(t *testing.T) {
+	t.Parallel()
+
+	var err error
+
+	ctx := context.Background()
+	empty := &PlaylistShareLink{}
+	o := &PlaylistShareLink{}
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, o, playlistShareLinkDBTypes, false); err != nil {
+		t.Errorf("Unable to randomize PlaylistShareLink object: %s", err)
+	}
+
+	AddPlaylistShareLinkHook(boil.BeforeInsertHook, playlistShareLinkBeforeInsertHook)
+	if err = o.doBeforeInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeInsertHook function to empty object, but got: %#v", o)
+	}
+	playlistShareLinkBeforeInsertHooks = []PlaylistShareLinkHook{}
+
+	AddPlaylistShareLinkHook(boil.AfterInsertHook, playlistShareLinkAfterInsertHook)
+	if err = o.doAfterInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterInsertHook function to empty object, but got: %#v", o)
+	}
+	playlistShareLinkAfterInsertHooks = []PlaylistShareLinkHook{}
+
+	AddPlaylistShareLinkHook(boil.AfterSelectHook, playlistShareLinkAfterSelectHook)
+	if err = o.doAfterSelectHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterSelectHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterSelectHook function to empty object, but got: %#v", o)
+	}
+	playlistShareLinkAfterSelectHooks = []PlaylistShareLinkHook{}
+
+	AddPlaylistShareLinkHook(boil.BeforeUpdateHook, playlistShareLinkBeforeUpdateHook)
+	if err = o.doBeforeUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpdateHook function to empty object, but got: %#v", o)
+	}
+	playlistShareLinkBeforeUpdateHooks = []PlaylistShareLinkHook{}
+
+	AddPlaylistShareLinkHook(boil.AfterUpdateHook, playlistShareLinkAfterUpdateHook)
+	if err = o.doAfterUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpdateHook function to empty object, but got: %#v", o)
+	}
+	playlistShareLinkAfterUpdateHooks = []PlaylistShareLinkHook{}
+
+	AddPlaylistShareLinkHook(boil.BeforeDeleteHook, playlistShareLinkBeforeDeleteHook)
+	if err = o.doBeforeDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeDeleteHook function to empty object, but got: %#v", o)
+	}
+	playlistShareLinkBeforeDeleteHooks = []PlaylistShareLinkHook{}
+
+	AddPlaylistShareLinkHook(boil.AfterDeleteHook, playlistShareLinkAfterDeleteHook)
+	if err = o.doAfterDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterDeleteHook function to empty object, but got: %#v", o)
+	}
+	playlistShareLinkAfterDeleteHooks = []PlaylistShareLinkHook{}
+
+	AddPlaylistShareLinkHook(boil.BeforeUpsertHook, playlistShareLinkBeforeUpsertHook)
+	if err = o.doBeforeUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpsertHook function to empty object, but got: %#v", o)
+	}
+	playlistShareLinkBeforeUpsertHooks = []PlaylistShareLinkHook{}
+
+	AddPlaylistShareLinkHook(boil.AfterUpsertHook, playlistShareLinkAfterUpsertHook)
+	if err = o.doAfterUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpsertHook function to empty object, but got: %#v", o)
+	}
+	playlistShareLinkAfterUpsertHooks = []PlaylistShareLinkHook{}
+}
+
+func testPlaylistShareLinksInsert(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &PlaylistShareLink{}
+	if err = randomize.Struct(seed, o, playlistShareLinkDBTypes, true, playlistShareLinkColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PlaylistShareLink struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := PlaylistShareLinks().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testPlaylistShareLinksInsertWhitelist(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &PlaylistShareLink{}
+	if err = randomize.Struct(seed, o, playlistShareLinkDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize PlaylistShareLink struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Whitelist(strmangle.SetMerge(playlistShareLinkPrimaryKeyColumns, playlistShareLinkColumnsWithoutDefault)...)); err != nil {
+		t.Error(err)
+	}
+
+	count, err := PlaylistShareLinks().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testPlaylistShareLinkToOnePlaylistUsingPlaylist(t *testing.T) {
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var local PlaylistShareLink
+	var foreign Playlist
+
+	seed := randomize.NewSeed()
+	if err := randomize.Struct(seed, &local, playlistShareLinkDBTypes, false, playlistShareLinkColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PlaylistShareLink struct: %s", err)
+	}
+	if err := randomize.Struct(seed, &foreign, playlistDBTypes, false, playlistColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Playlist struct: %s", err)
+	}
+
+	if err := foreign.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	local.PlaylistID = foreign.ID
+	if err := local.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := local.Playlist().One(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if check.ID != foreign.ID {
+		t.Errorf("want: %v, got %v", foreign.ID, check.ID)
+	}
+
+	ranAfterSelectHook := false
+	AddPlaylistHook(boil.AfterSelectHook, func(ctx context.Context, e boil.ContextExecutor, o *Playlist) error {
+		ranAfterSelectHook = true
+		return nil
+	})
+
+	slice := PlaylistShareLinkSlice{&local}
+	if err = local.L.LoadPlaylist(ctx, tx, false, (*[]*PlaylistShareLink)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.Playlist == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	local.R.Playlist = nil
+	if err = local.L.LoadPlaylist(ctx, tx, true, &local, nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.Playlist == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	if !ranAfterSelectHook {
+		t.Error("failed to run AfterSelect hook for relationship")
+	}
+}
+
+func testPlaylistShareLinkToOneSetOpPlaylistUsingPlaylist(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a PlaylistShareLink
+	var b, c Playlist
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, playlistShareLinkDBTypes, false, strmangle.SetComplement(playlistShareLinkPrimaryKeyColumns, playlistShareLinkColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, playlistDBTypes, false, strmangle.SetComplement(playlistPrimaryKeyColumns, playlistColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, playlistDBTypes, false, strmangle.SetComplement(playlistPrimaryKeyColumns, playlistColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	for i, x := range []*Playlist{&b, &c} {
+		err = a.SetPlaylist(ctx, tx, i != 0, x)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if a.R.Playlist != x {
+			t.Error("relationship struct not set to correct value")
+		}
+
+		if x.R.PlaylistShareLinks[0] != &a {
+			t.Error("failed to append to foreign relationship struct")
+		}
+		if a.PlaylistID != x.ID {
+			t.Error("foreign key was wrong value", a.PlaylistID)
+		}
+
+		zero := reflect.Zero(reflect.TypeOf(a.PlaylistID))
+		reflect.Indirect(reflect.ValueOf(&a.PlaylistID)).Set(zero)
+
+		if err = a.Reload(ctx, tx); err != nil {
+			t.Fatal("failed to reload", err)
+		}
+
+		if a.PlaylistID != x.ID {
+			t.Error("foreign key was wrong value", a.PlaylistID, x.ID)
+		}
+	}
+}
+
+func testPlaylistShareLinksReload(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &PlaylistShareLink{}
+	if err = randomize.Struct(seed, o, playlistShareLinkDBTypes, true, playlistShareLinkColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PlaylistShareLink struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = o.Reload(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testPlaylistShareLinksReloadAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &PlaylistShareLink{}
+	if err = randomize.Struct(seed, o, playlistShareLinkDBTypes, true, playlistShareLinkColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PlaylistShareLink struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := PlaylistShareLinkSlice{o}
+
+	if err = slice.ReloadAll(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testPlaylistShareLinksSelect(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &PlaylistShareLink{}
+	if err = randomize.Struct(seed, o, playlistShareLinkDBTypes, true, playlistShareLinkColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PlaylistShareLink struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := PlaylistShareLinks().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 1 {
+		t.Error("want one record, got:", len(slice))
+	}
+}
+
+var (
+	playlistShareLinkDBTypes = map[string]string{`ID`: `TEXT`, `CreatedAt`: `DATE`, `UpdatedAt`: `DATE`, `PlaylistID`: `TEXT`, `TokenHash`: `TEXT`, `ExpiresAt`: `DATE`}
+	_                        = bytes.MinRead
+)
+
+func testPlaylistShareLinksUpdate(t *testing.T) {
+	t.Parallel()
+
+	if 0 == len(playlistShareLinkPrimaryKeyColumns) {
+		t.Skip("Skipping table with no primary key columns")
+	}
+	if len(playlistShareLinkAllColumns) == len(playlistShareLinkPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &PlaylistShareLink{}
+	if err = randomize.Struct(seed, o, playlistShareLinkDBTypes, true, playlistShareLinkColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PlaylistShareLink struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := PlaylistShareLinks().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, playlistShareLinkDBTypes, true, playlistShareLinkPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize PlaylistShareLink struct: %s", err)
+	}
+
+	if rowsAff, err := o.Update(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only affect one row but affected", rowsAff)
+	}
+}
+
+func testPlaylistShareLinksSliceUpdateAll(t *testing.T) {
+	t.Parallel()
+
+	if len(playlistShareLinkAllColumns) == len(playlistShareLinkPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &PlaylistShareLink{}
+	if err = randomize.Struct(seed, o, playlistShareLinkDBTypes, true, playlistShareLinkColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PlaylistShareLink struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := PlaylistShareLinks().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, playlistShareLinkDBTypes, true, playlistShareLinkPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize PlaylistShareLink struct: %s", err)
+	}
+
+	// Remove Primary keys and unique columns from what we plan to update
+	var fields []string
+	if strmangle.StringSliceMatch(playlistShareLinkAllColumns, playlistShareLinkPrimaryKeyColumns) {
+		fields = playlistShareLinkAllColumns
+	} else {
+		fields = strmangle.SetComplement(
+			playlistShareLinkAllColumns,
+			playlistShareLinkPrimaryKeyColumns,
+		)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	typ := reflect.TypeOf(o).Elem()
+	n := typ.NumField()
+
+	updateMap := M{}
+	for _, col := range fields {
+		for i := 0; i < n; i++ {
+			f := typ.Field(i)
+			if f.Tag.Get("boil") == col {
+				updateMap[col] = value.Field(i).Interface()
+			}
+		}
+	}
+
+	slice := PlaylistShareLinkSlice{o}
+	if rowsAff, err := slice.UpdateAll(ctx, tx, updateMap); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("wanted one record updated but got", rowsAff)
+	}
+}
+
+func testPlaylistShareLinksUpsert(t *testing.T) {
+	t.Parallel()
+	if len(playlistShareLinkAllColumns) == len(playlistShareLinkPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	// Attempt the INSERT side of an UPSERT
+	o := PlaylistShareLink{}
+	if err = randomize.Struct(seed, &o, playlistShareLinkDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize PlaylistShareLink struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Upsert(ctx, tx, false, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert PlaylistShareLink: %s", err)
+	}
+
+	count, err := PlaylistShareLinks().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	// Attempt the UPDATE side of an UPSERT
+	if err = randomize.Struct(seed, &o, playlistShareLinkDBTypes, false, playlistShareLinkPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize PlaylistShareLink struct: %s", err)
+	}
+
+	if err = o.Upsert(ctx, tx, true, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert PlaylistShareLink: %s", err)
+	}
+
+	count, err = PlaylistShareLinks().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
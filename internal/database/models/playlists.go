@@ -24,72 +24,107 @@ import (
 
 // Playlist is an object representing the database table.
 type Playlist struct {
-	ID                string      `boil:"id" json:"id" toml:"id" yaml:"id"`
-	CreatedAt         time.Time   `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
-	UpdatedAt         time.Time   `boil:"updated_at" json:"updated_at" toml:"updated_at" yaml:"updated_at"`
-	UserID            string      `boil:"user_id" json:"user_id" toml:"user_id" yaml:"user_id"`
-	Slug              string      `boil:"slug" json:"slug" toml:"slug" yaml:"slug"`
-	Name              string      `boil:"name" json:"name" toml:"name" yaml:"name"`
-	System            bool        `boil:"system" json:"system" toml:"system" yaml:"system"`
-	TTLDays           null.Int64  `boil:"ttl_days" json:"ttl_days,omitempty" toml:"ttl_days" yaml:"ttl_days,omitempty"`
-	MaxSize           null.Int64  `boil:"max_size" json:"max_size,omitempty" toml:"max_size" yaml:"max_size,omitempty"`
-	Description       string      `boil:"description" json:"description" toml:"description" yaml:"description"`
-	YoutubePlaylistID null.String `boil:"youtube_playlist_id" json:"youtube_playlist_id,omitempty" toml:"youtube_playlist_id" yaml:"youtube_playlist_id,omitempty"`
+	ID                    string      `boil:"id" json:"id" toml:"id" yaml:"id"`
+	CreatedAt             time.Time   `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+	UpdatedAt             time.Time   `boil:"updated_at" json:"updated_at" toml:"updated_at" yaml:"updated_at"`
+	UserID                string      `boil:"user_id" json:"user_id" toml:"user_id" yaml:"user_id"`
+	Slug                  string      `boil:"slug" json:"slug" toml:"slug" yaml:"slug"`
+	Name                  string      `boil:"name" json:"name" toml:"name" yaml:"name"`
+	System                bool        `boil:"system" json:"system" toml:"system" yaml:"system"`
+	TTLDays               null.Int64  `boil:"ttl_days" json:"ttl_days,omitempty" toml:"ttl_days" yaml:"ttl_days,omitempty"`
+	MaxSize               null.Int64  `boil:"max_size" json:"max_size,omitempty" toml:"max_size" yaml:"max_size,omitempty"`
+	Description           string      `boil:"description" json:"description" toml:"description" yaml:"description"`
+	YoutubePlaylistID     null.String `boil:"youtube_playlist_id" json:"youtube_playlist_id,omitempty" toml:"youtube_playlist_id" yaml:"youtube_playlist_id,omitempty"`
+	AutoSyncIntervalHours int64       `boil:"auto_sync_interval_hours" json:"auto_sync_interval_hours" toml:"auto_sync_interval_hours" yaml:"auto_sync_interval_hours"`
+	SyncMode              string      `boil:"sync_mode" json:"sync_mode" toml:"sync_mode" yaml:"sync_mode"`
+	LastSyncedAt          null.Time   `boil:"last_synced_at" json:"last_synced_at,omitempty" toml:"last_synced_at" yaml:"last_synced_at,omitempty"`
+	LastSyncError         string      `boil:"last_sync_error" json:"last_sync_error" toml:"last_sync_error" yaml:"last_sync_error"`
+	NextSyncAt            null.Time   `boil:"next_sync_at" json:"next_sync_at,omitempty" toml:"next_sync_at" yaml:"next_sync_at,omitempty"`
+	Rules                 null.String `boil:"rules" json:"rules,omitempty" toml:"rules" yaml:"rules,omitempty"`
+	OverflowPolicy        string      `boil:"overflow_policy" json:"overflow_policy" toml:"overflow_policy" yaml:"overflow_policy"`
 
 	R *playlistR `boil:"-" json:"-" toml:"-" yaml:"-"`
 	L playlistL  `boil:"-" json:"-" toml:"-" yaml:"-"`
 }
 
 var PlaylistColumns = struct {
-	ID                string
-	CreatedAt         string
-	UpdatedAt         string
-	UserID            string
-	Slug              string
-	Name              string
-	System            string
-	TTLDays           string
-	MaxSize           string
-	Description       string
-	YoutubePlaylistID string
+	ID                    string
+	CreatedAt             string
+	UpdatedAt             string
+	UserID                string
+	Slug                  string
+	Name                  string
+	System                string
+	TTLDays               string
+	MaxSize               string
+	Description           string
+	YoutubePlaylistID     string
+	AutoSyncIntervalHours string
+	SyncMode              string
+	LastSyncedAt          string
+	LastSyncError         string
+	NextSyncAt            string
+	Rules                 string
+	OverflowPolicy        string
 }{
-	ID:                "id",
-	CreatedAt:         "created_at",
-	UpdatedAt:         "updated_at",
-	UserID:            "user_id",
-	Slug:              "slug",
-	Name:              "name",
-	System:            "system",
-	TTLDays:           "ttl_days",
-	MaxSize:           "max_size",
-	Description:       "description",
-	YoutubePlaylistID: "youtube_playlist_id",
+	ID:                    "id",
+	CreatedAt:             "created_at",
+	UpdatedAt:             "updated_at",
+	UserID:                "user_id",
+	Slug:                  "slug",
+	Name:                  "name",
+	System:                "system",
+	TTLDays:               "ttl_days",
+	MaxSize:               "max_size",
+	Description:           "description",
+	YoutubePlaylistID:     "youtube_playlist_id",
+	AutoSyncIntervalHours: "auto_sync_interval_hours",
+	SyncMode:              "sync_mode",
+	LastSyncedAt:          "last_synced_at",
+	LastSyncError:         "last_sync_error",
+	NextSyncAt:            "next_sync_at",
+	Rules:                 "rules",
+	OverflowPolicy:        "overflow_policy",
 }
 
 var PlaylistTableColumns = struct {
-	ID                string
-	CreatedAt         string
-	UpdatedAt         string
-	UserID            string
-	Slug              string
-	Name              string
-	System            string
-	TTLDays           string
-	MaxSize           string
-	Description       string
-	YoutubePlaylistID string
+	ID                    string
+	CreatedAt             string
+	UpdatedAt             string
+	UserID                string
+	Slug                  string
+	Name                  string
+	System                string
+	TTLDays               string
+	MaxSize               string
+	Description           string
+	YoutubePlaylistID     string
+	AutoSyncIntervalHours string
+	SyncMode              string
+	LastSyncedAt          string
+	LastSyncError         string
+	NextSyncAt            string
+	Rules                 string
+	OverflowPolicy        string
 }{
-	ID:                "playlists.id",
-	CreatedAt:         "playlists.created_at",
-	UpdatedAt:         "playlists.updated_at",
-	UserID:            "playlists.user_id",
-	Slug:              "playlists.slug",
-	Name:              "playlists.name",
-	System:            "playlists.system",
-	TTLDays:           "playlists.ttl_days",
-	MaxSize:           "playlists.max_size",
-	Description:       "playlists.description",
-	YoutubePlaylistID: "playlists.youtube_playlist_id",
+	ID:                    "playlists.id",
+	CreatedAt:             "playlists.created_at",
+	UpdatedAt:             "playlists.updated_at",
+	UserID:                "playlists.user_id",
+	Slug:                  "playlists.slug",
+	Name:                  "playlists.name",
+	System:                "playlists.system",
+	TTLDays:               "playlists.ttl_days",
+	MaxSize:               "playlists.max_size",
+	Description:           "playlists.description",
+	YoutubePlaylistID:     "playlists.youtube_playlist_id",
+	AutoSyncIntervalHours: "playlists.auto_sync_interval_hours",
+	SyncMode:              "playlists.sync_mode",
+	LastSyncedAt:          "playlists.last_synced_at",
+	LastSyncError:         "playlists.last_sync_error",
+	NextSyncAt:            "playlists.next_sync_at",
+	Rules:                 "playlists.rules",
+	OverflowPolicy:        "playlists.overflow_policy",
 }
 
 // Generated where
@@ -141,89 +176,62 @@ func (w whereHelpernull_Int64) NIN(slice []int64) qm.QueryMod {
 func (w whereHelpernull_Int64) IsNull() qm.QueryMod    { return qmhelper.WhereIsNull(w.field) }
 func (w whereHelpernull_Int64) IsNotNull() qm.QueryMod { return qmhelper.WhereIsNotNull(w.field) }
 
-type whereHelpernull_String struct{ field string }
-
-func (w whereHelpernull_String) EQ(x null.String) qm.QueryMod {
-	return qmhelper.WhereNullEQ(w.field, false, x)
-}
-func (w whereHelpernull_String) NEQ(x null.String) qm.QueryMod {
-	return qmhelper.WhereNullEQ(w.field, true, x)
-}
-func (w whereHelpernull_String) LT(x null.String) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.LT, x)
-}
-func (w whereHelpernull_String) LTE(x null.String) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.LTE, x)
-}
-func (w whereHelpernull_String) GT(x null.String) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.GT, x)
-}
-func (w whereHelpernull_String) GTE(x null.String) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.GTE, x)
-}
-func (w whereHelpernull_String) LIKE(x null.String) qm.QueryMod {
-	return qm.Where(w.field+" LIKE ?", x)
-}
-func (w whereHelpernull_String) NLIKE(x null.String) qm.QueryMod {
-	return qm.Where(w.field+" NOT LIKE ?", x)
-}
-func (w whereHelpernull_String) IN(slice []string) qm.QueryMod {
-	values := make([]any, 0, len(slice))
-	for _, value := range slice {
-		values = append(values, value)
-	}
-	return qm.WhereIn(fmt.Sprintf("%s IN ?", w.field), values...)
-}
-func (w whereHelpernull_String) NIN(slice []string) qm.QueryMod {
-	values := make([]any, 0, len(slice))
-	for _, value := range slice {
-		values = append(values, value)
-	}
-	return qm.WhereNotIn(fmt.Sprintf("%s NOT IN ?", w.field), values...)
-}
-
-func (w whereHelpernull_String) IsNull() qm.QueryMod    { return qmhelper.WhereIsNull(w.field) }
-func (w whereHelpernull_String) IsNotNull() qm.QueryMod { return qmhelper.WhereIsNotNull(w.field) }
-
 var PlaylistWhere = struct {
-	ID                whereHelperstring
-	CreatedAt         whereHelpertime_Time
-	UpdatedAt         whereHelpertime_Time
-	UserID            whereHelperstring
-	Slug              whereHelperstring
-	Name              whereHelperstring
-	System            whereHelperbool
-	TTLDays           whereHelpernull_Int64
-	MaxSize           whereHelpernull_Int64
-	Description       whereHelperstring
-	YoutubePlaylistID whereHelpernull_String
+	ID                    whereHelperstring
+	CreatedAt             whereHelpertime_Time
+	UpdatedAt             whereHelpertime_Time
+	UserID                whereHelperstring
+	Slug                  whereHelperstring
+	Name                  whereHelperstring
+	System                whereHelperbool
+	TTLDays               whereHelpernull_Int64
+	MaxSize               whereHelpernull_Int64
+	Description           whereHelperstring
+	YoutubePlaylistID     whereHelpernull_String
+	AutoSyncIntervalHours whereHelperint64
+	SyncMode              whereHelperstring
+	LastSyncedAt          whereHelpernull_Time
+	LastSyncError         whereHelperstring
+	NextSyncAt            whereHelpernull_Time
+	Rules                 whereHelpernull_String
+	OverflowPolicy        whereHelperstring
 }{
-	ID:                whereHelperstring{field: "\"playlists\".\"id\""},
-	CreatedAt:         whereHelpertime_Time{field: "\"playlists\".\"created_at\""},
-	UpdatedAt:         whereHelpertime_Time{field: "\"playlists\".\"updated_at\""},
-	UserID:            whereHelperstring{field: "\"playlists\".\"user_id\""},
-	Slug:              whereHelperstring{field: "\"playlists\".\"slug\""},
-	Name:              whereHelperstring{field: "\"playlists\".\"name\""},
-	System:            whereHelperbool{field: "\"playlists\".\"system\""},
-	TTLDays:           whereHelpernull_Int64{field: "\"playlists\".\"ttl_days\""},
-	MaxSize:           whereHelpernull_Int64{field: "\"playlists\".\"max_size\""},
-	Description:       whereHelperstring{field: "\"playlists\".\"description\""},
-	YoutubePlaylistID: whereHelpernull_String{field: "\"playlists\".\"youtube_playlist_id\""},
+	ID:                    whereHelperstring{field: "\"playlists\".\"id\""},
+	CreatedAt:             whereHelpertime_Time{field: "\"playlists\".\"created_at\""},
+	UpdatedAt:             whereHelpertime_Time{field: "\"playlists\".\"updated_at\""},
+	UserID:                whereHelperstring{field: "\"playlists\".\"user_id\""},
+	Slug:                  whereHelperstring{field: "\"playlists\".\"slug\""},
+	Name:                  whereHelperstring{field: "\"playlists\".\"name\""},
+	System:                whereHelperbool{field: "\"playlists\".\"system\""},
+	TTLDays:               whereHelpernull_Int64{field: "\"playlists\".\"ttl_days\""},
+	MaxSize:               whereHelpernull_Int64{field: "\"playlists\".\"max_size\""},
+	Description:           whereHelperstring{field: "\"playlists\".\"description\""},
+	YoutubePlaylistID:     whereHelpernull_String{field: "\"playlists\".\"youtube_playlist_id\""},
+	AutoSyncIntervalHours: whereHelperint64{field: "\"playlists\".\"auto_sync_interval_hours\""},
+	SyncMode:              whereHelperstring{field: "\"playlists\".\"sync_mode\""},
+	LastSyncedAt:          whereHelpernull_Time{field: "\"playlists\".\"last_synced_at\""},
+	LastSyncError:         whereHelperstring{field: "\"playlists\".\"last_sync_error\""},
+	NextSyncAt:            whereHelpernull_Time{field: "\"playlists\".\"next_sync_at\""},
+	Rules:                 whereHelpernull_String{field: "\"playlists\".\"rules\""},
+	OverflowPolicy:        whereHelperstring{field: "\"playlists\".\"overflow_policy\""},
 }
 
 // PlaylistRels is where relationship names are stored.
 var PlaylistRels = struct {
-	User          string
-	PlaylistItems string
+	User               string
+	PlaylistItems      string
+	PlaylistShareLinks string
 }{
-	User:          "User",
-	PlaylistItems: "PlaylistItems",
+	User:               "User",
+	PlaylistItems:      "PlaylistItems",
+	PlaylistShareLinks: "PlaylistShareLinks",
 }
 
 // playlistR is where relationships are stored.
 type playlistR struct {
-	User          *User             `boil:"User" json:"User" toml:"User" yaml:"User"`
-	PlaylistItems PlaylistItemSlice `boil:"PlaylistItems" json:"PlaylistItems" toml:"PlaylistItems" yaml:"PlaylistItems"`
+	User               *User                  `boil:"User" json:"User" toml:"User" yaml:"User"`
+	PlaylistItems      PlaylistItemSlice      `boil:"PlaylistItems" json:"PlaylistItems" toml:"PlaylistItems" yaml:"PlaylistItems"`
+	PlaylistShareLinks PlaylistShareLinkSlice `boil:"PlaylistShareLinks" json:"PlaylistShareLinks" toml:"PlaylistShareLinks" yaml:"PlaylistShareLinks"`
 }
 
 // NewStruct creates a new relationship struct
@@ -263,13 +271,29 @@ func (r *playlistR) GetPlaylistItems() PlaylistItemSlice {
 	return r.PlaylistItems
 }
 
+func (o *Playlist) GetPlaylistShareLinks() PlaylistShareLinkSlice {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetPlaylistShareLinks()
+}
+
+func (r *playlistR) GetPlaylistShareLinks() PlaylistShareLinkSlice {
+	if r == nil {
+		return nil
+	}
+
+	return r.PlaylistShareLinks
+}
+
 // playlistL is where Load methods for each relationship are stored.
 type playlistL struct{}
 
 var (
-	playlistAllColumns            = []string{"id", "created_at", "updated_at", "user_id", "slug", "name", "system", "ttl_days", "max_size", "description", "youtube_playlist_id"}
+	playlistAllColumns            = []string{"id", "created_at", "updated_at", "user_id", "slug", "name", "system", "ttl_days", "max_size", "description", "youtube_playlist_id", "auto_sync_interval_hours", "sync_mode", "last_synced_at", "last_sync_error", "next_sync_at", "rules", "overflow_policy"}
 	playlistColumnsWithoutDefault = []string{"id", "created_at", "updated_at", "user_id", "slug", "name"}
-	playlistColumnsWithDefault    = []string{"system", "ttl_days", "max_size", "description", "youtube_playlist_id"}
+	playlistColumnsWithDefault    = []string{"system", "ttl_days", "max_size", "description", "youtube_playlist_id", "auto_sync_interval_hours", "sync_mode", "last_synced_at", "last_sync_error", "next_sync_at", "rules", "overflow_policy"}
 	playlistPrimaryKeyColumns     = []string{"id"}
 	playlistGeneratedColumns      = []string{}
 )
@@ -604,6 +628,20 @@ func (o *Playlist) PlaylistItems(mods ...qm.QueryMod) playlistItemQuery {
 	return PlaylistItems(queryMods...)
 }
 
+// PlaylistShareLinks retrieves all the playlist_share_link's PlaylistShareLinks with an executor.
+func (o *Playlist) PlaylistShareLinks(mods ...qm.QueryMod) playlistShareLinkQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"playlist_share_links\".\"playlist_id\"=?", o.ID),
+	)
+
+	return PlaylistShareLinks(queryMods...)
+}
+
 // LoadUser allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for an N-1 relationship.
 func (playlistL) LoadUser(ctx context.Context, e boil.ContextExecutor, singular bool, maybePlaylist any, mods queries.Applicator) error {
@@ -837,6 +875,119 @@ func (playlistL) LoadPlaylistItems(ctx context.Context, e boil.ContextExecutor,
 	return nil
 }
 
+// LoadPlaylistShareLinks allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (playlistL) LoadPlaylistShareLinks(ctx context.Context, e boil.ContextExecutor, singular bool, maybePlaylist any, mods queries.Applicator) error {
+	var slice []*Playlist
+	var object *Playlist
+
+	if singular {
+		var ok bool
+		object, ok = maybePlaylist.(*Playlist)
+		if !ok {
+			object = new(Playlist)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybePlaylist)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybePlaylist))
+			}
+		}
+	} else {
+		s, ok := maybePlaylist.(*[]*Playlist)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybePlaylist)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybePlaylist))
+			}
+		}
+	}
+
+	args := make(map[any]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &playlistR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &playlistR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]any, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`playlist_share_links`),
+		qm.WhereIn(`playlist_share_links.playlist_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load playlist_share_links")
+	}
+
+	var resultSlice []*PlaylistShareLink
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice playlist_share_links")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on playlist_share_links")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for playlist_share_links")
+	}
+
+	if len(playlistShareLinkAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.PlaylistShareLinks = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &playlistShareLinkR{}
+			}
+			foreign.R.Playlist = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.PlaylistID {
+				local.R.PlaylistShareLinks = append(local.R.PlaylistShareLinks, foreign)
+				if foreign.R == nil {
+					foreign.R = &playlistShareLinkR{}
+				}
+				foreign.R.Playlist = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
 // SetUser of the playlist to the related item.
 // Sets o.R.User to related.
 // Adds o to related.R.Playlists.
@@ -937,6 +1088,59 @@ func (o *Playlist) AddPlaylistItems(ctx context.Context, exec boil.ContextExecut
 	return nil
 }
 
+// AddPlaylistShareLinks adds the given related objects to the existing relationships
+// of the playlist, optionally inserting them as new records.
+// Appends related to o.R.PlaylistShareLinks.
+// Sets related.R.Playlist appropriately.
+func (o *Playlist) AddPlaylistShareLinks(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*PlaylistShareLink) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.PlaylistID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"playlist_share_links\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 0, []string{"playlist_id"}),
+				strmangle.WhereClause("\"", "\"", 0, playlistShareLinkPrimaryKeyColumns),
+			)
+			values := []any{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.PlaylistID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &playlistR{
+			PlaylistShareLinks: related,
+		}
+	} else {
+		o.R.PlaylistShareLinks = append(o.R.PlaylistShareLinks, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &playlistShareLinkR{
+				Playlist: o,
+			}
+		} else {
+			rel.R.Playlist = o
+		}
+	}
+	return nil
+}
+
 // Playlists retrieves all the records using an executor.
 func Playlists(mods ...qm.QueryMod) playlistQuery {
 	mods = append(mods, qm.From("\"playlists\""))
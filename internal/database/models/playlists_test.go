@@ -572,6 +572,84 @@ func testPlaylistToManyPlaylistItems(t *testing.T) {
 	}
 }
 
+func testPlaylistToManyPlaylistShareLinks(t *testing.T) {
+	var err error
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Playlist
+	var b, c PlaylistShareLink
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, playlistDBTypes, true, playlistColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Playlist struct: %s", err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = randomize.Struct(seed, &b, playlistShareLinkDBTypes, false, playlistShareLinkColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, playlistShareLinkDBTypes, false, playlistShareLinkColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+
+	b.PlaylistID = a.ID
+	c.PlaylistID = a.ID
+
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := a.PlaylistShareLinks().All(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	bFound, cFound := false, false
+	for _, v := range check {
+		if v.PlaylistID == b.PlaylistID {
+			bFound = true
+		}
+		if v.PlaylistID == c.PlaylistID {
+			cFound = true
+		}
+	}
+
+	if !bFound {
+		t.Error("expected to find b")
+	}
+	if !cFound {
+		t.Error("expected to find c")
+	}
+
+	slice := PlaylistSlice{&a}
+	if err = a.L.LoadPlaylistShareLinks(ctx, tx, false, (*[]*Playlist)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.PlaylistShareLinks); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	a.R.PlaylistShareLinks = nil
+	if err = a.L.LoadPlaylistShareLinks(ctx, tx, true, &a, nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.PlaylistShareLinks); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	if t.Failed() {
+		t.Logf("%#v", check)
+	}
+}
+
 func testPlaylistToManyAddOpPlaylistItems(t *testing.T) {
 	var err error
 
@@ -647,6 +725,81 @@ func testPlaylistToManyAddOpPlaylistItems(t *testing.T) {
 		}
 	}
 }
+func testPlaylistToManyAddOpPlaylistShareLinks(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Playlist
+	var b, c, d, e PlaylistShareLink
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, playlistDBTypes, false, strmangle.SetComplement(playlistPrimaryKeyColumns, playlistColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*PlaylistShareLink{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, playlistShareLinkDBTypes, false, strmangle.SetComplement(playlistShareLinkPrimaryKeyColumns, playlistShareLinkColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	foreignersSplitByInsertion := [][]*PlaylistShareLink{
+		{&b, &c},
+		{&d, &e},
+	}
+
+	for i, x := range foreignersSplitByInsertion {
+		err = a.AddPlaylistShareLinks(ctx, tx, i != 0, x...)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		first := x[0]
+		second := x[1]
+
+		if a.ID != first.PlaylistID {
+			t.Error("foreign key was wrong value", a.ID, first.PlaylistID)
+		}
+		if a.ID != second.PlaylistID {
+			t.Error("foreign key was wrong value", a.ID, second.PlaylistID)
+		}
+
+		if first.R.Playlist != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+		if second.R.Playlist != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+
+		if a.R.PlaylistShareLinks[i*2] != first {
+			t.Error("relationship struct slice not set to correct value")
+		}
+		if a.R.PlaylistShareLinks[i*2+1] != second {
+			t.Error("relationship struct slice not set to correct value")
+		}
+
+		count, err := a.PlaylistShareLinks().Count(ctx, tx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if want := int64((i + 1) * 2); count != want {
+			t.Error("want", want, "got", count)
+		}
+	}
+}
 func testPlaylistToOneUserUsingUser(t *testing.T) {
 	ctx := context.Background()
 	tx := MustTx(boil.BeginTx(ctx, nil))
@@ -840,7 +993,7 @@ func testPlaylistsSelect(t *testing.T) {
 }
 
 var (
-	playlistDBTypes = map[string]string{`ID`: `TEXT`, `CreatedAt`: `DATE`, `UpdatedAt`: `DATE`, `UserID`: `TEXT`, `Slug`: `TEXT`, `Name`: `TEXT`, `System`: `BOOLEAN`, `TTLDays`: `INTEGER`, `MaxSize`: `INTEGER`, `Description`: `TEXT`, `YoutubePlaylistID`: `TEXT`}
+	playlistDBTypes = map[string]string{`ID`: `TEXT`, `CreatedAt`: `DATE`, `UpdatedAt`: `DATE`, `UserID`: `TEXT`, `Slug`: `TEXT`, `Name`: `TEXT`, `System`: `BOOLEAN`, `TTLDays`: `INTEGER`, `MaxSize`: `INTEGER`, `Description`: `TEXT`, `YoutubePlaylistID`: `TEXT`, `AutoSyncIntervalHours`: `INTEGER`, `SyncMode`: `TEXT`, `LastSyncedAt`: `DATE`, `LastSyncError`: `TEXT`, `NextSyncAt`: `DATE`, `Rules`: `TEXT`, `OverflowPolicy`: `TEXT`}
 	_               = bytes.MinRead
 )
 
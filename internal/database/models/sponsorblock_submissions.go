@@ -0,0 +1,1215 @@
+// Code generated by SQLBoiler 4.19.7 (https://github.com/aarondl/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries"
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+	"github.com/aarondl/sqlboiler/v4/queries/qmhelper"
+	"github.com/aarondl/strmangle"
+	"github.com/friendsofgo/errors"
+)
+
+// SponsorblockSubmission is an object representing the database table.
+type SponsorblockSubmission struct {
+	ID            string    `boil:"id" json:"id" toml:"id" yaml:"id"`
+	CreatedAt     time.Time `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+	UpdatedAt     time.Time `boil:"updated_at" json:"updated_at" toml:"updated_at" yaml:"updated_at"`
+	UserID        string    `boil:"user_id" json:"user_id" toml:"user_id" yaml:"user_id"`
+	VideoID       string    `boil:"video_id" json:"video_id" toml:"video_id" yaml:"video_id"`
+	Kind          string    `boil:"kind" json:"kind" toml:"kind" yaml:"kind"`
+	Payload       string    `boil:"payload" json:"payload" toml:"payload" yaml:"payload"`
+	Status        string    `boil:"status" json:"status" toml:"status" yaml:"status"`
+	Attempts      int64     `boil:"attempts" json:"attempts" toml:"attempts" yaml:"attempts"`
+	NextAttemptAt time.Time `boil:"next_attempt_at" json:"next_attempt_at" toml:"next_attempt_at" yaml:"next_attempt_at"`
+	LastError     string    `boil:"last_error" json:"last_error" toml:"last_error" yaml:"last_error"`
+	Result        string    `boil:"result" json:"result" toml:"result" yaml:"result"`
+
+	R *sponsorblockSubmissionR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L sponsorblockSubmissionL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var SponsorblockSubmissionColumns = struct {
+	ID            string
+	CreatedAt     string
+	UpdatedAt     string
+	UserID        string
+	VideoID       string
+	Kind          string
+	Payload       string
+	Status        string
+	Attempts      string
+	NextAttemptAt string
+	LastError     string
+	Result        string
+}{
+	ID:            "id",
+	CreatedAt:     "created_at",
+	UpdatedAt:     "updated_at",
+	UserID:        "user_id",
+	VideoID:       "video_id",
+	Kind:          "kind",
+	Payload:       "payload",
+	Status:        "status",
+	Attempts:      "attempts",
+	NextAttemptAt: "next_attempt_at",
+	LastError:     "last_error",
+	Result:        "result",
+}
+
+var SponsorblockSubmissionTableColumns = struct {
+	ID            string
+	CreatedAt     string
+	UpdatedAt     string
+	UserID        string
+	VideoID       string
+	Kind          string
+	Payload       string
+	Status        string
+	Attempts      string
+	NextAttemptAt string
+	LastError     string
+	Result        string
+}{
+	ID:            "sponsorblock_submissions.id",
+	CreatedAt:     "sponsorblock_submissions.created_at",
+	UpdatedAt:     "sponsorblock_submissions.updated_at",
+	UserID:        "sponsorblock_submissions.user_id",
+	VideoID:       "sponsorblock_submissions.video_id",
+	Kind:          "sponsorblock_submissions.kind",
+	Payload:       "sponsorblock_submissions.payload",
+	Status:        "sponsorblock_submissions.status",
+	Attempts:      "sponsorblock_submissions.attempts",
+	NextAttemptAt: "sponsorblock_submissions.next_attempt_at",
+	LastError:     "sponsorblock_submissions.last_error",
+	Result:        "sponsorblock_submissions.result",
+}
+
+// Generated where
+
+var SponsorblockSubmissionWhere = struct {
+	ID            whereHelperstring
+	CreatedAt     whereHelpertime_Time
+	UpdatedAt     whereHelpertime_Time
+	UserID        whereHelperstring
+	VideoID       whereHelperstring
+	Kind          whereHelperstring
+	Payload       whereHelperstring
+	Status        whereHelperstring
+	Attempts      whereHelperint64
+	NextAttemptAt whereHelpertime_Time
+	LastError     whereHelperstring
+	Result        whereHelperstring
+}{
+	ID:            whereHelperstring{field: "\"sponsorblock_submissions\".\"id\""},
+	CreatedAt:     whereHelpertime_Time{field: "\"sponsorblock_submissions\".\"created_at\""},
+	UpdatedAt:     whereHelpertime_Time{field: "\"sponsorblock_submissions\".\"updated_at\""},
+	UserID:        whereHelperstring{field: "\"sponsorblock_submissions\".\"user_id\""},
+	VideoID:       whereHelperstring{field: "\"sponsorblock_submissions\".\"video_id\""},
+	Kind:          whereHelperstring{field: "\"sponsorblock_submissions\".\"kind\""},
+	Payload:       whereHelperstring{field: "\"sponsorblock_submissions\".\"payload\""},
+	Status:        whereHelperstring{field: "\"sponsorblock_submissions\".\"status\""},
+	Attempts:      whereHelperint64{field: "\"sponsorblock_submissions\".\"attempts\""},
+	NextAttemptAt: whereHelpertime_Time{field: "\"sponsorblock_submissions\".\"next_attempt_at\""},
+	LastError:     whereHelperstring{field: "\"sponsorblock_submissions\".\"last_error\""},
+	Result:        whereHelperstring{field: "\"sponsorblock_submissions\".\"result\""},
+}
+
+// SponsorblockSubmissionRels is where relationship names are stored.
+var SponsorblockSubmissionRels = struct {
+	User string
+}{
+	User: "User",
+}
+
+// sponsorblockSubmissionR is where relationships are stored.
+type sponsorblockSubmissionR struct {
+	User *User `boil:"User" json:"User" toml:"User" yaml:"User"`
+}
+
+// NewStruct creates a new relationship struct
+func (*sponsorblockSubmissionR) NewStruct() *sponsorblockSubmissionR {
+	return &sponsorblockSubmissionR{}
+}
+
+func (o *SponsorblockSubmission) GetUser() *User {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetUser()
+}
+
+func (r *sponsorblockSubmissionR) GetUser() *User {
+	if r == nil {
+		return nil
+	}
+
+	return r.User
+}
+
+// sponsorblockSubmissionL is where Load methods for each relationship are stored.
+type sponsorblockSubmissionL struct{}
+
+var (
+	sponsorblockSubmissionAllColumns            = []string{"id", "created_at", "updated_at", "user_id", "video_id", "kind", "payload", "status", "attempts", "next_attempt_at", "last_error", "result"}
+	sponsorblockSubmissionColumnsWithoutDefault = []string{"id", "created_at", "updated_at", "user_id", "video_id", "kind", "payload", "status", "attempts", "next_attempt_at", "last_error", "result"}
+	sponsorblockSubmissionColumnsWithDefault    = []string{}
+	sponsorblockSubmissionPrimaryKeyColumns     = []string{"id"}
+	sponsorblockSubmissionGeneratedColumns      = []string{}
+)
+
+type (
+	// SponsorblockSubmissionSlice is an alias for a slice of pointers to SponsorblockSubmission.
+	// This should almost always be used instead of []SponsorblockSubmission.
+	SponsorblockSubmissionSlice []*SponsorblockSubmission
+	// SponsorblockSubmissionHook is the signature for custom SponsorblockSubmission hook methods
+	SponsorblockSubmissionHook func(context.Context, boil.ContextExecutor, *SponsorblockSubmission) error
+
+	sponsorblockSubmissionQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	sponsorblockSubmissionType                 = reflect.TypeOf(&SponsorblockSubmission{})
+	sponsorblockSubmissionMapping              = queries.MakeStructMapping(sponsorblockSubmissionType)
+	sponsorblockSubmissionPrimaryKeyMapping, _ = queries.BindMapping(sponsorblockSubmissionType, sponsorblockSubmissionMapping, sponsorblockSubmissionPrimaryKeyColumns)
+	sponsorblockSubmissionInsertCacheMut       sync.RWMutex
+	sponsorblockSubmissionInsertCache          = make(map[string]insertCache)
+	sponsorblockSubmissionUpdateCacheMut       sync.RWMutex
+	sponsorblockSubmissionUpdateCache          = make(map[string]updateCache)
+	sponsorblockSubmissionUpsertCacheMut       sync.RWMutex
+	sponsorblockSubmissionUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var sponsorblockSubmissionAfterSelectMu sync.Mutex
+var sponsorblockSubmissionAfterSelectHooks []SponsorblockSubmissionHook
+
+var sponsorblockSubmissionBeforeInsertMu sync.Mutex
+var sponsorblockSubmissionBeforeInsertHooks []SponsorblockSubmissionHook
+var sponsorblockSubmissionAfterInsertMu sync.Mutex
+var sponsorblockSubmissionAfterInsertHooks []SponsorblockSubmissionHook
+
+var sponsorblockSubmissionBeforeUpdateMu sync.Mutex
+var sponsorblockSubmissionBeforeUpdateHooks []SponsorblockSubmissionHook
+var sponsorblockSubmissionAfterUpdateMu sync.Mutex
+var sponsorblockSubmissionAfterUpdateHooks []SponsorblockSubmissionHook
+
+var sponsorblockSubmissionBeforeDeleteMu sync.Mutex
+var sponsorblockSubmissionBeforeDeleteHooks []SponsorblockSubmissionHook
+var sponsorblockSubmissionAfterDeleteMu sync.Mutex
+var sponsorblockSubmissionAfterDeleteHooks []SponsorblockSubmissionHook
+
+var sponsorblockSubmissionBeforeUpsertMu sync.Mutex
+var sponsorblockSubmissionBeforeUpsertHooks []SponsorblockSubmissionHook
+var sponsorblockSubmissionAfterUpsertMu sync.Mutex
+var sponsorblockSubmissionAfterUpsertHooks []SponsorblockSubmissionHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *SponsorblockSubmission) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range sponsorblockSubmissionAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *SponsorblockSubmission) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range sponsorblockSubmissionBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *SponsorblockSubmission) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range sponsorblockSubmissionAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *SponsorblockSubmission) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range sponsorblockSubmissionBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *SponsorblockSubmission) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range sponsorblockSubmissionAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *SponsorblockSubmission) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range sponsorblockSubmissionBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *SponsorblockSubmission) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range sponsorblockSubmissionAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *SponsorblockSubmission) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range sponsorblockSubmissionBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *SponsorblockSubmission) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range sponsorblockSubmissionAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddSponsorblockSubmissionHook registers your hook function for all future operations.
+func AddSponsorblockSubmissionHook(hookPoint boil.HookPoint, sponsorblockSubmissionHook SponsorblockSubmissionHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		sponsorblockSubmissionAfterSelectMu.Lock()
+		sponsorblockSubmissionAfterSelectHooks = append(sponsorblockSubmissionAfterSelectHooks, sponsorblockSubmissionHook)
+		sponsorblockSubmissionAfterSelectMu.Unlock()
+	case boil.BeforeInsertHook:
+		sponsorblockSubmissionBeforeInsertMu.Lock()
+		sponsorblockSubmissionBeforeInsertHooks = append(sponsorblockSubmissionBeforeInsertHooks, sponsorblockSubmissionHook)
+		sponsorblockSubmissionBeforeInsertMu.Unlock()
+	case boil.AfterInsertHook:
+		sponsorblockSubmissionAfterInsertMu.Lock()
+		sponsorblockSubmissionAfterInsertHooks = append(sponsorblockSubmissionAfterInsertHooks, sponsorblockSubmissionHook)
+		sponsorblockSubmissionAfterInsertMu.Unlock()
+	case boil.BeforeUpdateHook:
+		sponsorblockSubmissionBeforeUpdateMu.Lock()
+		sponsorblockSubmissionBeforeUpdateHooks = append(sponsorblockSubmissionBeforeUpdateHooks, sponsorblockSubmissionHook)
+		sponsorblockSubmissionBeforeUpdateMu.Unlock()
+	case boil.AfterUpdateHook:
+		sponsorblockSubmissionAfterUpdateMu.Lock()
+		sponsorblockSubmissionAfterUpdateHooks = append(sponsorblockSubmissionAfterUpdateHooks, sponsorblockSubmissionHook)
+		sponsorblockSubmissionAfterUpdateMu.Unlock()
+	case boil.BeforeDeleteHook:
+		sponsorblockSubmissionBeforeDeleteMu.Lock()
+		sponsorblockSubmissionBeforeDeleteHooks = append(sponsorblockSubmissionBeforeDeleteHooks, sponsorblockSubmissionHook)
+		sponsorblockSubmissionBeforeDeleteMu.Unlock()
+	case boil.AfterDeleteHook:
+		sponsorblockSubmissionAfterDeleteMu.Lock()
+		sponsorblockSubmissionAfterDeleteHooks = append(sponsorblockSubmissionAfterDeleteHooks, sponsorblockSubmissionHook)
+		sponsorblockSubmissionAfterDeleteMu.Unlock()
+	case boil.BeforeUpsertHook:
+		sponsorblockSubmissionBeforeUpsertMu.Lock()
+		sponsorblockSubmissionBeforeUpsertHooks = append(sponsorblockSubmissionBeforeUpsertHooks, sponsorblockSubmissionHook)
+		sponsorblockSubmissionBeforeUpsertMu.Unlock()
+	case boil.AfterUpsertHook:
+		sponsorblockSubmissionAfterUpsertMu.Lock()
+		sponsorblockSubmissionAfterUpsertHooks = append(sponsorblockSubmissionAfterUpsertHooks, sponsorblockSubmissionHook)
+		sponsorblockSubmissionAfterUpsertMu.Unlock()
+	}
+}
+
+// One returns a single sponsorblockSubmission record from the query.
+func (q sponsorblockSubmissionQuery) One(ctx context.Context, exec boil.ContextExecutor) (*SponsorblockSubmission, error) {
+	o := &SponsorblockSubmission{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: failed to execute a one query for sponsorblock_submissions")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all SponsorblockSubmission records from the query.
+func (q sponsorblockSubmissionQuery) All(ctx context.Context, exec boil.ContextExecutor) (SponsorblockSubmissionSlice, error) {
+	var o []*SponsorblockSubmission
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "models: failed to assign all query results to SponsorblockSubmission slice")
+	}
+
+	if len(sponsorblockSubmissionAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all SponsorblockSubmission records in the query.
+func (q sponsorblockSubmissionQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to count sponsorblock_submissions rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q sponsorblockSubmissionQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "models: failed to check if sponsorblock_submissions exists")
+	}
+
+	return count > 0, nil
+}
+
+// User pointed to by the foreign key.
+func (o *SponsorblockSubmission) User(mods ...qm.QueryMod) userQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.UserID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Users(queryMods...)
+}
+
+// LoadUser allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (sponsorblockSubmissionL) LoadUser(ctx context.Context, e boil.ContextExecutor, singular bool, maybeSponsorblockSubmission any, mods queries.Applicator) error {
+	var slice []*SponsorblockSubmission
+	var object *SponsorblockSubmission
+
+	if singular {
+		var ok bool
+		object, ok = maybeSponsorblockSubmission.(*SponsorblockSubmission)
+		if !ok {
+			object = new(SponsorblockSubmission)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeSponsorblockSubmission)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeSponsorblockSubmission))
+			}
+		}
+	} else {
+		s, ok := maybeSponsorblockSubmission.(*[]*SponsorblockSubmission)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeSponsorblockSubmission)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeSponsorblockSubmission))
+			}
+		}
+	}
+
+	args := make(map[any]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &sponsorblockSubmissionR{}
+		}
+		args[object.UserID] = struct{}{}
+
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &sponsorblockSubmissionR{}
+			}
+
+			args[obj.UserID] = struct{}{}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]any, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`users`),
+		qm.WhereIn(`users.id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load User")
+	}
+
+	var resultSlice []*User
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice User")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for users")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for users")
+	}
+
+	if len(userAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.User = foreign
+		if foreign.R == nil {
+			foreign.R = &userR{}
+		}
+		foreign.R.SponsorblockSubmissions = append(foreign.R.SponsorblockSubmissions, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.UserID == foreign.ID {
+				local.R.User = foreign
+				if foreign.R == nil {
+					foreign.R = &userR{}
+				}
+				foreign.R.SponsorblockSubmissions = append(foreign.R.SponsorblockSubmissions, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetUser of the sponsorblockSubmission to the related item.
+// Sets o.R.User to related.
+// Adds o to related.R.SponsorblockSubmissions.
+func (o *SponsorblockSubmission) SetUser(ctx context.Context, exec boil.ContextExecutor, insert bool, related *User) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"sponsorblock_submissions\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 0, []string{"user_id"}),
+		strmangle.WhereClause("\"", "\"", 0, sponsorblockSubmissionPrimaryKeyColumns),
+	)
+	values := []any{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.UserID = related.ID
+	if o.R == nil {
+		o.R = &sponsorblockSubmissionR{
+			User: related,
+		}
+	} else {
+		o.R.User = related
+	}
+
+	if related.R == nil {
+		related.R = &userR{
+			SponsorblockSubmissions: SponsorblockSubmissionSlice{o},
+		}
+	} else {
+		related.R.SponsorblockSubmissions = append(related.R.SponsorblockSubmissions, o)
+	}
+
+	return nil
+}
+
+// SponsorblockSubmissions retrieves all the records using an executor.
+func SponsorblockSubmissions(mods ...qm.QueryMod) sponsorblockSubmissionQuery {
+	mods = append(mods, qm.From("\"sponsorblock_submissions\""))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"\"sponsorblock_submissions\".*"})
+	}
+
+	return sponsorblockSubmissionQuery{q}
+}
+
+// FindSponsorblockSubmission retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindSponsorblockSubmission(ctx context.Context, exec boil.ContextExecutor, iD string, selectCols ...string) (*SponsorblockSubmission, error) {
+	sponsorblockSubmissionObj := &SponsorblockSubmission{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"sponsorblock_submissions\" where \"id\"=?", sel,
+	)
+
+	q := queries.Raw(query, iD)
+
+	err := q.Bind(ctx, exec, sponsorblockSubmissionObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: unable to select from sponsorblock_submissions")
+	}
+
+	if err = sponsorblockSubmissionObj.doAfterSelectHooks(ctx, exec); err != nil {
+		return sponsorblockSubmissionObj, err
+	}
+
+	return sponsorblockSubmissionObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *SponsorblockSubmission) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no sponsorblock_submissions provided for insertion")
+	}
+
+	var err error
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+		if o.UpdatedAt.IsZero() {
+			o.UpdatedAt = currTime
+		}
+	}
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(sponsorblockSubmissionColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	sponsorblockSubmissionInsertCacheMut.RLock()
+	cache, cached := sponsorblockSubmissionInsertCache[key]
+	sponsorblockSubmissionInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			sponsorblockSubmissionAllColumns,
+			sponsorblockSubmissionColumnsWithDefault,
+			sponsorblockSubmissionColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(sponsorblockSubmissionType, sponsorblockSubmissionMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(sponsorblockSubmissionType, sponsorblockSubmissionMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"sponsorblock_submissions\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"sponsorblock_submissions\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "models: unable to insert into sponsorblock_submissions")
+	}
+
+	if !cached {
+		sponsorblockSubmissionInsertCacheMut.Lock()
+		sponsorblockSubmissionInsertCache[key] = cache
+		sponsorblockSubmissionInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// Update uses an executor to update the SponsorblockSubmission.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *SponsorblockSubmission) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		o.UpdatedAt = currTime
+	}
+
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	sponsorblockSubmissionUpdateCacheMut.RLock()
+	cache, cached := sponsorblockSubmissionUpdateCache[key]
+	sponsorblockSubmissionUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			sponsorblockSubmissionAllColumns,
+			sponsorblockSubmissionPrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("models: unable to update sponsorblock_submissions, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"sponsorblock_submissions\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 0, wl),
+			strmangle.WhereClause("\"", "\"", 0, sponsorblockSubmissionPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(sponsorblockSubmissionType, sponsorblockSubmissionMapping, append(wl, sponsorblockSubmissionPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update sponsorblock_submissions row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by update for sponsorblock_submissions")
+	}
+
+	if !cached {
+		sponsorblockSubmissionUpdateCacheMut.Lock()
+		sponsorblockSubmissionUpdateCache[key] = cache
+		sponsorblockSubmissionUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q sponsorblockSubmissionQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all for sponsorblock_submissions")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected for sponsorblock_submissions")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o SponsorblockSubmissionSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("models: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]any, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), sponsorblockSubmissionPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"sponsorblock_submissions\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 0, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, sponsorblockSubmissionPrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all in sponsorblockSubmission slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected all in update all sponsorblockSubmission")
+	}
+	return rowsAff, nil
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *SponsorblockSubmission) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no sponsorblock_submissions provided for upsert")
+	}
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+		o.UpdatedAt = currTime
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(sponsorblockSubmissionColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	sponsorblockSubmissionUpsertCacheMut.RLock()
+	cache, cached := sponsorblockSubmissionUpsertCache[key]
+	sponsorblockSubmissionUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, _ := insertColumns.InsertColumnSet(
+			sponsorblockSubmissionAllColumns,
+			sponsorblockSubmissionColumnsWithDefault,
+			sponsorblockSubmissionColumnsWithoutDefault,
+			nzDefaults,
+		)
+		update := updateColumns.UpdateColumnSet(
+			sponsorblockSubmissionAllColumns,
+			sponsorblockSubmissionPrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("models: unable to upsert sponsorblock_submissions, could not build update column list")
+		}
+
+		ret := strmangle.SetComplement(sponsorblockSubmissionAllColumns, strmangle.SetIntersect(insert, update))
+
+		conflict := conflictColumns
+		if len(conflict) == 0 {
+			conflict = make([]string, len(sponsorblockSubmissionPrimaryKeyColumns))
+			copy(conflict, sponsorblockSubmissionPrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQuerySQLite(dialect, "\"sponsorblock_submissions\"", updateOnConflict, ret, update, conflict, insert)
+
+		cache.valueMapping, err = queries.BindMapping(sponsorblockSubmissionType, sponsorblockSubmissionMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(sponsorblockSubmissionType, sponsorblockSubmissionMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []any
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if errors.Is(err, sql.ErrNoRows) {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "models: unable to upsert sponsorblock_submissions")
+	}
+
+	if !cached {
+		sponsorblockSubmissionUpsertCacheMut.Lock()
+		sponsorblockSubmissionUpsertCache[key] = cache
+		sponsorblockSubmissionUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// Delete deletes a single SponsorblockSubmission record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *SponsorblockSubmission) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("models: no SponsorblockSubmission provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), sponsorblockSubmissionPrimaryKeyMapping)
+	sql := "DELETE FROM \"sponsorblock_submissions\" WHERE \"id\"=?"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete from sponsorblock_submissions")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by delete for sponsorblock_submissions")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q sponsorblockSubmissionQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("models: no sponsorblockSubmissionQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from sponsorblock_submissions")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for sponsorblock_submissions")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o SponsorblockSubmissionSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(sponsorblockSubmissionBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []any
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), sponsorblockSubmissionPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"sponsorblock_submissions\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, sponsorblockSubmissionPrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from sponsorblockSubmission slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for sponsorblock_submissions")
+	}
+
+	if len(sponsorblockSubmissionAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *SponsorblockSubmission) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindSponsorblockSubmission(ctx, exec, o.ID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *SponsorblockSubmissionSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := SponsorblockSubmissionSlice{}
+	var args []any
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), sponsorblockSubmissionPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"sponsorblock_submissions\".* FROM \"sponsorblock_submissions\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, sponsorblockSubmissionPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to reload all in SponsorblockSubmissionSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// SponsorblockSubmissionExists checks if the SponsorblockSubmission row exists.
+func SponsorblockSubmissionExists(ctx context.Context, exec boil.ContextExecutor, iD string) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"sponsorblock_submissions\" where \"id\"=? limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, iD)
+	}
+	row := exec.QueryRowContext(ctx, sql, iD)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "models: unable to check if sponsorblock_submissions exists")
+	}
+
+	return exists, nil
+}
+
+// Exists checks if the SponsorblockSubmission row exists.
+func (o *SponsorblockSubmission) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	return SponsorblockSubmissionExists(ctx, exec, o.ID)
+}
@@ -0,0 +1,850 @@
+// Code generated by SQLBoiler 4.19.7 (https://github.com/aarondl/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"bytes"
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/aarondl/randomize"
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries"
+	"github.com/aarondl/strmangle"
+)
+
+var (
+	// Relationships sometimes use the reflection helper queries.Equal/queries.Assign
+	// so force a package dependency in case they don't.
+	_ = queries.Equal
+)
+
+func testSponsorblockSubmissions(t *testing.T) {
+	t.Parallel()
+
+	query := SponsorblockSubmissions()
+
+	if query.Query == nil {
+		t.Error("expected a query, got nothing")
+	}
+}
+
+func testSponsorblockSubmissionsDelete(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &SponsorblockSubmission{}
+	if err = randomize.Struct(seed, o, sponsorblockSubmissionDBTypes, true, sponsorblockSubmissionColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize SponsorblockSubmission struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := o.Delete(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := SponsorblockSubmissions().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testSponsorblockSubmissionsQueryDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &SponsorblockSubmission{}
+	if err = randomize.Struct(seed, o, sponsorblockSubmissionDBTypes, true, sponsorblockSubmissionColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize SponsorblockSubmission struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := SponsorblockSubmissions().DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := SponsorblockSubmissions().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testSponsorblockSubmissionsSliceDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &SponsorblockSubmission{}
+	if err = randomize.Struct(seed, o, sponsorblockSubmissionDBTypes, true, sponsorblockSubmissionColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize SponsorblockSubmission struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := SponsorblockSubmissionSlice{o}
+
+	if rowsAff, err := slice.DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := SponsorblockSubmissions().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testSponsorblockSubmissionsExists(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &SponsorblockSubmission{}
+	if err = randomize.Struct(seed, o, sponsorblockSubmissionDBTypes, true, sponsorblockSubmissionColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize SponsorblockSubmission struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	e, err := SponsorblockSubmissionExists(ctx, tx, o.ID)
+	if err != nil {
+		t.Errorf("Unable to check if SponsorblockSubmission exists: %s", err)
+	}
+	if !e {
+		t.Errorf("Expected SponsorblockSubmissionExists to return true, but got false.")
+	}
+}
+
+func testSponsorblockSubmissionsFind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &SponsorblockSubmission{}
+	if err = randomize.Struct(seed, o, sponsorblockSubmissionDBTypes, true, sponsorblockSubmissionColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize SponsorblockSubmission struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	sponsorblockSubmissionFound, err := FindSponsorblockSubmission(ctx, tx, o.ID)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if sponsorblockSubmissionFound == nil {
+		t.Error("want a record, got nil")
+	}
+}
+
+func testSponsorblockSubmissionsBind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &SponsorblockSubmission{}
+	if err = randomize.Struct(seed, o, sponsorblockSubmissionDBTypes, true, sponsorblockSubmissionColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize SponsorblockSubmission struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = SponsorblockSubmissions().Bind(ctx, tx, o); err != nil {
+		t.Error(err)
+	}
+}
+
+func testSponsorblockSubmissionsOne(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &SponsorblockSubmission{}
+	if err = randomize.Struct(seed, o, sponsorblockSubmissionDBTypes, true, sponsorblockSubmissionColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize SponsorblockSubmission struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if x, err := SponsorblockSubmissions().One(ctx, tx); err != nil {
+		t.Error(err)
+	} else if x == nil {
+		t.Error("expected to get a non nil record")
+	}
+}
+
+func testSponsorblockSubmissionsAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	sponsorblockSubmissionOne := &SponsorblockSubmission{}
+	sponsorblockSubmissionTwo := &SponsorblockSubmission{}
+	if err = randomize.Struct(seed, sponsorblockSubmissionOne, sponsorblockSubmissionDBTypes, false, sponsorblockSubmissionColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize SponsorblockSubmission struct: %s", err)
+	}
+	if err = randomize.Struct(seed, sponsorblockSubmissionTwo, sponsorblockSubmissionDBTypes, false, sponsorblockSubmissionColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize SponsorblockSubmission struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = sponsorblockSubmissionOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = sponsorblockSubmissionTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := SponsorblockSubmissions().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 2 {
+		t.Error("want 2 records, got:", len(slice))
+	}
+}
+
+func testSponsorblockSubmissionsCount(t *testing.T) {
+	t.Parallel()
+
+	var err error
+	seed := randomize.NewSeed()
+	sponsorblockSubmissionOne := &SponsorblockSubmission{}
+	sponsorblockSubmissionTwo := &SponsorblockSubmission{}
+	if err = randomize.Struct(seed, sponsorblockSubmissionOne, sponsorblockSubmissionDBTypes, false, sponsorblockSubmissionColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize SponsorblockSubmission struct: %s", err)
+	}
+	if err = randomize.Struct(seed, sponsorblockSubmissionTwo, sponsorblockSubmissionDBTypes, false, sponsorblockSubmissionColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize SponsorblockSubmission struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = sponsorblockSubmissionOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = sponsorblockSubmissionTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := SponsorblockSubmissions().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 2 {
+		t.Error("want 2 records, got:", count)
+	}
+}
+
+func sponsorblockSubmissionBeforeInsertHook(ctx context.Context, e boil.ContextExecutor, o *SponsorblockSubmission) error {
+	*o = SponsorblockSubmission{}
+	return nil
+}
+
+func sponsorblockSubmissionAfterInsertHook(ctx context.Context, e boil.ContextExecutor, o *SponsorblockSubmission) error {
+	*o = SponsorblockSubmission{}
+	return nil
+}
+
+func sponsorblockSubmissionAfterSelectHook(ctx context.Context, e boil.ContextExecutor, o *SponsorblockSubmission) error {
+	*o = SponsorblockSubmission{}
+	return nil
+}
+
+func sponsorblockSubmissionBeforeUpdateHook(ctx context.Context, e boil.ContextExecutor, o *SponsorblockSubmission) error {
+	*o = SponsorblockSubmission{}
+	return nil
+}
+
+func sponsorblockSubmissionAfterUpdateHook(ctx context.Context, e boil.ContextExecutor, o *SponsorblockSubmission) error {
+	*o = SponsorblockSubmission{}
+	return nil
+}
+
+func sponsorblockSubmissionBeforeDeleteHook(ctx context.Context, e boil.ContextExecutor, o *SponsorblockSubmission) error {
+	*o = SponsorblockSubmission{}
+	return nil
+}
+
+func sponsorblockSubmissionAfterDeleteHook(ctx context.Context, e boil.ContextExecutor, o *SponsorblockSubmission) error {
+	*o = SponsorblockSubmission{}
+	return nil
+}
+
+func sponsorblockSubmissionBeforeUpsertHook(ctx context.Context, e boil.ContextExecutor, o *SponsorblockSubmission) error {
+	*o = SponsorblockSubmission{}
+	return nil
+}
+
+func sponsorblockSubmissionAfterUpsertHook(ctx context.Context, e boil.ContextExecutor, o *SponsorblockSubmission) error {
+	*o = SponsorblockSubmission{}
+	return nil
+}
+
+func testSponsorblockSubmissionsHooks(t *testing.T) {
+	t.Parallel()
+
+	var err error
+
+	ctx := context.Background()
+	empty := &SponsorblockSubmission{}
+	o := &SponsorblockSubmission{}
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, o, sponsorblockSubmissionDBTypes, false); err != nil {
+		t.Errorf("Unable to randomize SponsorblockSubmission object: %s", err)
+	}
+
+	AddSponsorblockSubmissionHook(boil.BeforeInsertHook, sponsorblockSubmissionBeforeInsertHook)
+	if err = o.doBeforeInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeInsertHook function to empty object, but got: %#v", o)
+	}
+	sponsorblockSubmissionBeforeInsertHooks = []SponsorblockSubmissionHook{}
+
+	AddSponsorblockSubmissionHook(boil.AfterInsertHook, sponsorblockSubmissionAfterInsertHook)
+	if err = o.doAfterInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterInsertHook function to empty object, but got: %#v", o)
+	}
+	sponsorblockSubmissionAfterInsertHooks = []SponsorblockSubmissionHook{}
+
+	AddSponsorblockSubmissionHook(boil.AfterSelectHook, sponsorblockSubmissionAfterSelectHook)
+	if err = o.doAfterSelectHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterSelectHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterSelectHook function to empty object, but got: %#v", o)
+	}
+	sponsorblockSubmissionAfterSelectHooks = []SponsorblockSubmissionHook{}
+
+	AddSponsorblockSubmissionHook(boil.BeforeUpdateHook, sponsorblockSubmissionBeforeUpdateHook)
+	if err = o.doBeforeUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpdateHook function to empty object, but got: %#v", o)
+	}
+	sponsorblockSubmissionBeforeUpdateHooks = []SponsorblockSubmissionHook{}
+
+	AddSponsorblockSubmissionHook(boil.AfterUpdateHook, sponsorblockSubmissionAfterUpdateHook)
+	if err = o.doAfterUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpdateHook function to empty object, but got: %#v", o)
+	}
+	sponsorblockSubmissionAfterUpdateHooks = []SponsorblockSubmissionHook{}
+
+	AddSponsorblockSubmissionHook(boil.BeforeDeleteHook, sponsorblockSubmissionBeforeDeleteHook)
+	if err = o.doBeforeDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeDeleteHook function to empty object, but got: %#v", o)
+	}
+	sponsorblockSubmissionBeforeDeleteHooks = []SponsorblockSubmissionHook{}
+
+	AddSponsorblockSubmissionHook(boil.AfterDeleteHook, sponsorblockSubmissionAfterDeleteHook)
+	if err = o.doAfterDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterDeleteHook function to empty object, but got: %#v", o)
+	}
+	sponsorblockSubmissionAfterDeleteHooks = []SponsorblockSubmissionHook{}
+
+	AddSponsorblockSubmissionHook(boil.BeforeUpsertHook, sponsorblockSubmissionBeforeUpsertHook)
+	if err = o.doBeforeUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpsertHook function to empty object, but got: %#v", o)
+	}
+	sponsorblockSubmissionBeforeUpsertHooks = []SponsorblockSubmissionHook{}
+
+	AddSponsorblockSubmissionHook(boil.AfterUpsertHook, sponsorblockSubmissionAfterUpsertHook)
+	if err = o.doAfterUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpsertHook function to empty object, but got: %#v", o)
+	}
+	sponsorblockSubmissionAfterUpsertHooks = []SponsorblockSubmissionHook{}
+}
+
+func testSponsorblockSubmissionsInsert(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &SponsorblockSubmission{}
+	if err = randomize.Struct(seed, o, sponsorblockSubmissionDBTypes, true, sponsorblockSubmissionColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize SponsorblockSubmission struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := SponsorblockSubmissions().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testSponsorblockSubmissionsInsertWhitelist(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &SponsorblockSubmission{}
+	if err = randomize.Struct(seed, o, sponsorblockSubmissionDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize SponsorblockSubmission struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Whitelist(strmangle.SetMerge(sponsorblockSubmissionPrimaryKeyColumns, sponsorblockSubmissionColumnsWithoutDefault)...)); err != nil {
+		t.Error(err)
+	}
+
+	count, err := SponsorblockSubmissions().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testSponsorblockSubmissionToOneUserUsingUser(t *testing.T) {
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var local SponsorblockSubmission
+	var foreign User
+
+	seed := randomize.NewSeed()
+	if err := randomize.Struct(seed, &local, sponsorblockSubmissionDBTypes, false, sponsorblockSubmissionColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize SponsorblockSubmission struct: %s", err)
+	}
+	if err := randomize.Struct(seed, &foreign, userDBTypes, false, userColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize User struct: %s", err)
+	}
+
+	if err := foreign.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	local.UserID = foreign.ID
+	if err := local.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := local.User().One(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if check.ID != foreign.ID {
+		t.Errorf("want: %v, got %v", foreign.ID, check.ID)
+	}
+
+	ranAfterSelectHook := false
+	AddUserHook(boil.AfterSelectHook, func(ctx context.Context, e boil.ContextExecutor, o *User) error {
+		ranAfterSelectHook = true
+		return nil
+	})
+
+	slice := SponsorblockSubmissionSlice{&local}
+	if err = local.L.LoadUser(ctx, tx, false, (*[]*SponsorblockSubmission)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.User == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	local.R.User = nil
+	if err = local.L.LoadUser(ctx, tx, true, &local, nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.User == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	if !ranAfterSelectHook {
+		t.Error("failed to run AfterSelect hook for relationship")
+	}
+}
+
+func testSponsorblockSubmissionToOneSetOpUserUsingUser(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a SponsorblockSubmission
+	var b, c User
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, sponsorblockSubmissionDBTypes, false, strmangle.SetComplement(sponsorblockSubmissionPrimaryKeyColumns, sponsorblockSubmissionColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, userDBTypes, false, strmangle.SetComplement(userPrimaryKeyColumns, userColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, userDBTypes, false, strmangle.SetComplement(userPrimaryKeyColumns, userColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	for i, x := range []*User{&b, &c} {
+		err = a.SetUser(ctx, tx, i != 0, x)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if a.R.User != x {
+			t.Error("relationship struct not set to correct value")
+		}
+
+		if x.R.SponsorblockSubmissions[0] != &a {
+			t.Error("failed to append to foreign relationship struct")
+		}
+		if a.UserID != x.ID {
+			t.Error("foreign key was wrong value", a.UserID)
+		}
+
+		zero := reflect.Zero(reflect.TypeOf(a.UserID))
+		reflect.Indirect(reflect.ValueOf(&a.UserID)).Set(zero)
+
+		if err = a.Reload(ctx, tx); err != nil {
+			t.Fatal("failed to reload", err)
+		}
+
+		if a.UserID != x.ID {
+			t.Error("foreign key was wrong value", a.UserID, x.ID)
+		}
+	}
+}
+
+func testSponsorblockSubmissionsReload(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &SponsorblockSubmission{}
+	if err = randomize.Struct(seed, o, sponsorblockSubmissionDBTypes, true, sponsorblockSubmissionColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize SponsorblockSubmission struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = o.Reload(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testSponsorblockSubmissionsReloadAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &SponsorblockSubmission{}
+	if err = randomize.Struct(seed, o, sponsorblockSubmissionDBTypes, true, sponsorblockSubmissionColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize SponsorblockSubmission struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := SponsorblockSubmissionSlice{o}
+
+	if err = slice.ReloadAll(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testSponsorblockSubmissionsSelect(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &SponsorblockSubmission{}
+	if err = randomize.Struct(seed, o, sponsorblockSubmissionDBTypes, true, sponsorblockSubmissionColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize SponsorblockSubmission struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := SponsorblockSubmissions().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 1 {
+		t.Error("want one record, got:", len(slice))
+	}
+}
+
+var (
+	sponsorblockSubmissionDBTypes = map[string]string{`ID`: `TEXT`, `CreatedAt`: `DATE`, `UpdatedAt`: `DATE`, `UserID`: `TEXT`, `VideoID`: `TEXT`, `Kind`: `TEXT`, `Payload`: `TEXT`, `Status`: `TEXT`, `Attempts`: `INTEGER`, `NextAttemptAt`: `DATE`, `LastError`: `TEXT`, `Result`: `TEXT`}
+	_                             = bytes.MinRead
+)
+
+func testSponsorblockSubmissionsUpdate(t *testing.T) {
+	t.Parallel()
+
+	if 0 == len(sponsorblockSubmissionPrimaryKeyColumns) {
+		t.Skip("Skipping table with no primary key columns")
+	}
+	if len(sponsorblockSubmissionAllColumns) == len(sponsorblockSubmissionPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &SponsorblockSubmission{}
+	if err = randomize.Struct(seed, o, sponsorblockSubmissionDBTypes, true, sponsorblockSubmissionColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize SponsorblockSubmission struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := SponsorblockSubmissions().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, sponsorblockSubmissionDBTypes, true, sponsorblockSubmissionPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize SponsorblockSubmission struct: %s", err)
+	}
+
+	if rowsAff, err := o.Update(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only affect one row but affected", rowsAff)
+	}
+}
+
+func testSponsorblockSubmissionsSliceUpdateAll(t *testing.T) {
+	t.Parallel()
+
+	if len(sponsorblockSubmissionAllColumns) == len(sponsorblockSubmissionPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &SponsorblockSubmission{}
+	if err = randomize.Struct(seed, o, sponsorblockSubmissionDBTypes, true, sponsorblockSubmissionColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize SponsorblockSubmission struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := SponsorblockSubmissions().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, sponsorblockSubmissionDBTypes, true, sponsorblockSubmissionPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize SponsorblockSubmission struct: %s", err)
+	}
+
+	// Remove Primary keys and unique columns from what we plan to update
+	var fields []string
+	if strmangle.StringSliceMatch(sponsorblockSubmissionAllColumns, sponsorblockSubmissionPrimaryKeyColumns) {
+		fields = sponsorblockSubmissionAllColumns
+	} else {
+		fields = strmangle.SetComplement(
+			sponsorblockSubmissionAllColumns,
+			sponsorblockSubmissionPrimaryKeyColumns,
+		)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	typ := reflect.TypeOf(o).Elem()
+	n := typ.NumField()
+
+	updateMap := M{}
+	for _, col := range fields {
+		for i := 0; i < n; i++ {
+			f := typ.Field(i)
+			if f.Tag.Get("boil") == col {
+				updateMap[col] = value.Field(i).Interface()
+			}
+		}
+	}
+
+	slice := SponsorblockSubmissionSlice{o}
+	if rowsAff, err := slice.UpdateAll(ctx, tx, updateMap); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("wanted one record updated but got", rowsAff)
+	}
+}
+
+func testSponsorblockSubmissionsUpsert(t *testing.T) {
+	t.Parallel()
+	if len(sponsorblockSubmissionAllColumns) == len(sponsorblockSubmissionPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	// Attempt the INSERT side of an UPSERT
+	o := SponsorblockSubmission{}
+	if err = randomize.Struct(seed, &o, sponsorblockSubmissionDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize SponsorblockSubmission struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Upsert(ctx, tx, false, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert SponsorblockSubmission: %s", err)
+	}
+
+	count, err := SponsorblockSubmissions().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	// Attempt the UPDATE side of an UPSERT
+	if err = randomize.Struct(seed, &o, sponsorblockSubmissionDBTypes, false, sponsorblockSubmissionPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize SponsorblockSubmission struct: %s", err)
+	}
+
+	if err = o.Upsert(ctx, tx, true, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert SponsorblockSubmission: %s", err)
+	}
+
+	count, err = SponsorblockSubmissions().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
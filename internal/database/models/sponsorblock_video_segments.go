@@ -0,0 +1,975 @@
+// Code generated by SQLBoiler 4.19.7 (https://github.com/aarondl/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries"
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+	"github.com/aarondl/sqlboiler/v4/queries/qmhelper"
+	"github.com/aarondl/strmangle"
+	"github.com/friendsofgo/errors"
+)
+
+// SponsorblockVideoSegment is an object representing the database table.
+type SponsorblockVideoSegment struct {
+	VideoID      string    `boil:"video_id" json:"video_id" toml:"video_id" yaml:"video_id"`
+	CreatedAt    time.Time `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+	UpdatedAt    time.Time `boil:"updated_at" json:"updated_at" toml:"updated_at" yaml:"updated_at"`
+	ExpiresAt    time.Time `boil:"expires_at" json:"expires_at" toml:"expires_at" yaml:"expires_at"`
+	SegmentCount int64     `boil:"segment_count" json:"segment_count" toml:"segment_count" yaml:"segment_count"`
+	Segments     string    `boil:"segments" json:"segments" toml:"segments" yaml:"segments"`
+
+	R *sponsorblockVideoSegmentR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L sponsorblockVideoSegmentL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var SponsorblockVideoSegmentColumns = struct {
+	VideoID      string
+	CreatedAt    string
+	UpdatedAt    string
+	ExpiresAt    string
+	SegmentCount string
+	Segments     string
+}{
+	VideoID:      "video_id",
+	CreatedAt:    "created_at",
+	UpdatedAt:    "updated_at",
+	ExpiresAt:    "expires_at",
+	SegmentCount: "segment_count",
+	Segments:     "segments",
+}
+
+var SponsorblockVideoSegmentTableColumns = struct {
+	VideoID      string
+	CreatedAt    string
+	UpdatedAt    string
+	ExpiresAt    string
+	SegmentCount string
+	Segments     string
+}{
+	VideoID:      "sponsorblock_video_segments.video_id",
+	CreatedAt:    "sponsorblock_video_segments.created_at",
+	UpdatedAt:    "sponsorblock_video_segments.updated_at",
+	ExpiresAt:    "sponsorblock_video_segments.expires_at",
+	SegmentCount: "sponsorblock_video_segments.segment_count",
+	Segments:     "sponsorblock_video_segments.segments",
+}
+
+// Generated where
+
+var SponsorblockVideoSegmentWhere = struct {
+	VideoID      whereHelperstring
+	CreatedAt    whereHelpertime_Time
+	UpdatedAt    whereHelpertime_Time
+	ExpiresAt    whereHelpertime_Time
+	SegmentCount whereHelperint64
+	Segments     whereHelperstring
+}{
+	VideoID:      whereHelperstring{field: "\"sponsorblock_video_segments\".\"video_id\""},
+	CreatedAt:    whereHelpertime_Time{field: "\"sponsorblock_video_segments\".\"created_at\""},
+	UpdatedAt:    whereHelpertime_Time{field: "\"sponsorblock_video_segments\".\"updated_at\""},
+	ExpiresAt:    whereHelpertime_Time{field: "\"sponsorblock_video_segments\".\"expires_at\""},
+	SegmentCount: whereHelperint64{field: "\"sponsorblock_video_segments\".\"segment_count\""},
+	Segments:     whereHelperstring{field: "\"sponsorblock_video_segments\".\"segments\""},
+}
+
+// SponsorblockVideoSegmentRels is where relationship names are stored.
+var SponsorblockVideoSegmentRels = struct {
+}{}
+
+// sponsorblockVideoSegmentR is where relationships are stored.
+type sponsorblockVideoSegmentR struct {
+}
+
+// NewStruct creates a new relationship struct
+func (*sponsorblockVideoSegmentR) NewStruct() *sponsorblockVideoSegmentR {
+	return &sponsorblockVideoSegmentR{}
+}
+
+// sponsorblockVideoSegmentL is where Load methods for each relationship are stored.
+type sponsorblockVideoSegmentL struct{}
+
+var (
+	sponsorblockVideoSegmentAllColumns            = []string{"video_id", "created_at", "updated_at", "expires_at", "segment_count", "segments"}
+	sponsorblockVideoSegmentColumnsWithoutDefault = []string{"video_id", "created_at", "updated_at", "expires_at", "segment_count", "segments"}
+	sponsorblockVideoSegmentColumnsWithDefault    = []string{}
+	sponsorblockVideoSegmentPrimaryKeyColumns     = []string{"video_id"}
+	sponsorblockVideoSegmentGeneratedColumns      = []string{}
+)
+
+type (
+	// SponsorblockVideoSegmentSlice is an alias for a slice of pointers to SponsorblockVideoSegment.
+	// This should almost always be used instead of []SponsorblockVideoSegment.
+	SponsorblockVideoSegmentSlice []*SponsorblockVideoSegment
+	// SponsorblockVideoSegmentHook is the signature for custom SponsorblockVideoSegment hook methods
+	SponsorblockVideoSegmentHook func(context.Context, boil.ContextExecutor, *SponsorblockVideoSegment) error
+
+	sponsorblockVideoSegmentQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	sponsorblockVideoSegmentType                 = reflect.TypeOf(&SponsorblockVideoSegment{})
+	sponsorblockVideoSegmentMapping              = queries.MakeStructMapping(sponsorblockVideoSegmentType)
+	sponsorblockVideoSegmentPrimaryKeyMapping, _ = queries.BindMapping(sponsorblockVideoSegmentType, sponsorblockVideoSegmentMapping, sponsorblockVideoSegmentPrimaryKeyColumns)
+	sponsorblockVideoSegmentInsertCacheMut       sync.RWMutex
+	sponsorblockVideoSegmentInsertCache          = make(map[string]insertCache)
+	sponsorblockVideoSegmentUpdateCacheMut       sync.RWMutex
+	sponsorblockVideoSegmentUpdateCache          = make(map[string]updateCache)
+	sponsorblockVideoSegmentUpsertCacheMut       sync.RWMutex
+	sponsorblockVideoSegmentUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var sponsorblockVideoSegmentAfterSelectMu sync.Mutex
+var sponsorblockVideoSegmentAfterSelectHooks []SponsorblockVideoSegmentHook
+
+var sponsorblockVideoSegmentBeforeInsertMu sync.Mutex
+var sponsorblockVideoSegmentBeforeInsertHooks []SponsorblockVideoSegmentHook
+var sponsorblockVideoSegmentAfterInsertMu sync.Mutex
+var sponsorblockVideoSegmentAfterInsertHooks []SponsorblockVideoSegmentHook
+
+var sponsorblockVideoSegmentBeforeUpdateMu sync.Mutex
+var sponsorblockVideoSegmentBeforeUpdateHooks []SponsorblockVideoSegmentHook
+var sponsorblockVideoSegmentAfterUpdateMu sync.Mutex
+var sponsorblockVideoSegmentAfterUpdateHooks []SponsorblockVideoSegmentHook
+
+var sponsorblockVideoSegmentBeforeDeleteMu sync.Mutex
+var sponsorblockVideoSegmentBeforeDeleteHooks []SponsorblockVideoSegmentHook
+var sponsorblockVideoSegmentAfterDeleteMu sync.Mutex
+var sponsorblockVideoSegmentAfterDeleteHooks []SponsorblockVideoSegmentHook
+
+var sponsorblockVideoSegmentBeforeUpsertMu sync.Mutex
+var sponsorblockVideoSegmentBeforeUpsertHooks []SponsorblockVideoSegmentHook
+var sponsorblockVideoSegmentAfterUpsertMu sync.Mutex
+var sponsorblockVideoSegmentAfterUpsertHooks []SponsorblockVideoSegmentHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *SponsorblockVideoSegment) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range sponsorblockVideoSegmentAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *SponsorblockVideoSegment) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range sponsorblockVideoSegmentBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *SponsorblockVideoSegment) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range sponsorblockVideoSegmentAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *SponsorblockVideoSegment) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range sponsorblockVideoSegmentBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *SponsorblockVideoSegment) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range sponsorblockVideoSegmentAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *SponsorblockVideoSegment) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range sponsorblockVideoSegmentBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *SponsorblockVideoSegment) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range sponsorblockVideoSegmentAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *SponsorblockVideoSegment) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range sponsorblockVideoSegmentBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *SponsorblockVideoSegment) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range sponsorblockVideoSegmentAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddSponsorblockVideoSegmentHook registers your hook function for all future operations.
+func AddSponsorblockVideoSegmentHook(hookPoint boil.HookPoint, sponsorblockVideoSegmentHook SponsorblockVideoSegmentHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		sponsorblockVideoSegmentAfterSelectMu.Lock()
+		sponsorblockVideoSegmentAfterSelectHooks = append(sponsorblockVideoSegmentAfterSelectHooks, sponsorblockVideoSegmentHook)
+		sponsorblockVideoSegmentAfterSelectMu.Unlock()
+	case boil.BeforeInsertHook:
+		sponsorblockVideoSegmentBeforeInsertMu.Lock()
+		sponsorblockVideoSegmentBeforeInsertHooks = append(sponsorblockVideoSegmentBeforeInsertHooks, sponsorblockVideoSegmentHook)
+		sponsorblockVideoSegmentBeforeInsertMu.Unlock()
+	case boil.AfterInsertHook:
+		sponsorblockVideoSegmentAfterInsertMu.Lock()
+		sponsorblockVideoSegmentAfterInsertHooks = append(sponsorblockVideoSegmentAfterInsertHooks, sponsorblockVideoSegmentHook)
+		sponsorblockVideoSegmentAfterInsertMu.Unlock()
+	case boil.BeforeUpdateHook:
+		sponsorblockVideoSegmentBeforeUpdateMu.Lock()
+		sponsorblockVideoSegmentBeforeUpdateHooks = append(sponsorblockVideoSegmentBeforeUpdateHooks, sponsorblockVideoSegmentHook)
+		sponsorblockVideoSegmentBeforeUpdateMu.Unlock()
+	case boil.AfterUpdateHook:
+		sponsorblockVideoSegmentAfterUpdateMu.Lock()
+		sponsorblockVideoSegmentAfterUpdateHooks = append(sponsorblockVideoSegmentAfterUpdateHooks, sponsorblockVideoSegmentHook)
+		sponsorblockVideoSegmentAfterUpdateMu.Unlock()
+	case boil.BeforeDeleteHook:
+		sponsorblockVideoSegmentBeforeDeleteMu.Lock()
+		sponsorblockVideoSegmentBeforeDeleteHooks = append(sponsorblockVideoSegmentBeforeDeleteHooks, sponsorblockVideoSegmentHook)
+		sponsorblockVideoSegmentBeforeDeleteMu.Unlock()
+	case boil.AfterDeleteHook:
+		sponsorblockVideoSegmentAfterDeleteMu.Lock()
+		sponsorblockVideoSegmentAfterDeleteHooks = append(sponsorblockVideoSegmentAfterDeleteHooks, sponsorblockVideoSegmentHook)
+		sponsorblockVideoSegmentAfterDeleteMu.Unlock()
+	case boil.BeforeUpsertHook:
+		sponsorblockVideoSegmentBeforeUpsertMu.Lock()
+		sponsorblockVideoSegmentBeforeUpsertHooks = append(sponsorblockVideoSegmentBeforeUpsertHooks, sponsorblockVideoSegmentHook)
+		sponsorblockVideoSegmentBeforeUpsertMu.Unlock()
+	case boil.AfterUpsertHook:
+		sponsorblockVideoSegmentAfterUpsertMu.Lock()
+		sponsorblockVideoSegmentAfterUpsertHooks = append(sponsorblockVideoSegmentAfterUpsertHooks, sponsorblockVideoSegmentHook)
+		sponsorblockVideoSegmentAfterUpsertMu.Unlock()
+	}
+}
+
+// One returns a single sponsorblockVideoSegment record from the query.
+func (q sponsorblockVideoSegmentQuery) One(ctx context.Context, exec boil.ContextExecutor) (*SponsorblockVideoSegment, error) {
+	o := &SponsorblockVideoSegment{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: failed to execute a one query for sponsorblock_video_segments")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all SponsorblockVideoSegment records from the query.
+func (q sponsorblockVideoSegmentQuery) All(ctx context.Context, exec boil.ContextExecutor) (SponsorblockVideoSegmentSlice, error) {
+	var o []*SponsorblockVideoSegment
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "models: failed to assign all query results to SponsorblockVideoSegment slice")
+	}
+
+	if len(sponsorblockVideoSegmentAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all SponsorblockVideoSegment records in the query.
+func (q sponsorblockVideoSegmentQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to count sponsorblock_video_segments rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q sponsorblockVideoSegmentQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "models: failed to check if sponsorblock_video_segments exists")
+	}
+
+	return count > 0, nil
+}
+
+// SponsorblockVideoSegments retrieves all the records using an executor.
+func SponsorblockVideoSegments(mods ...qm.QueryMod) sponsorblockVideoSegmentQuery {
+	mods = append(mods, qm.From("\"sponsorblock_video_segments\""))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"\"sponsorblock_video_segments\".*"})
+	}
+
+	return sponsorblockVideoSegmentQuery{q}
+}
+
+// FindSponsorblockVideoSegment retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindSponsorblockVideoSegment(ctx context.Context, exec boil.ContextExecutor, videoID string, selectCols ...string) (*SponsorblockVideoSegment, error) {
+	sponsorblockVideoSegmentObj := &SponsorblockVideoSegment{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"sponsorblock_video_segments\" where \"video_id\"=?", sel,
+	)
+
+	q := queries.Raw(query, videoID)
+
+	err := q.Bind(ctx, exec, sponsorblockVideoSegmentObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: unable to select from sponsorblock_video_segments")
+	}
+
+	if err = sponsorblockVideoSegmentObj.doAfterSelectHooks(ctx, exec); err != nil {
+		return sponsorblockVideoSegmentObj, err
+	}
+
+	return sponsorblockVideoSegmentObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *SponsorblockVideoSegment) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no sponsorblock_video_segments provided for insertion")
+	}
+
+	var err error
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+		if o.UpdatedAt.IsZero() {
+			o.UpdatedAt = currTime
+		}
+	}
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(sponsorblockVideoSegmentColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	sponsorblockVideoSegmentInsertCacheMut.RLock()
+	cache, cached := sponsorblockVideoSegmentInsertCache[key]
+	sponsorblockVideoSegmentInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			sponsorblockVideoSegmentAllColumns,
+			sponsorblockVideoSegmentColumnsWithDefault,
+			sponsorblockVideoSegmentColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(sponsorblockVideoSegmentType, sponsorblockVideoSegmentMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(sponsorblockVideoSegmentType, sponsorblockVideoSegmentMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"sponsorblock_video_segments\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"sponsorblock_video_segments\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "models: unable to insert into sponsorblock_video_segments")
+	}
+
+	if !cached {
+		sponsorblockVideoSegmentInsertCacheMut.Lock()
+		sponsorblockVideoSegmentInsertCache[key] = cache
+		sponsorblockVideoSegmentInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// Update uses an executor to update the SponsorblockVideoSegment.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *SponsorblockVideoSegment) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		o.UpdatedAt = currTime
+	}
+
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	sponsorblockVideoSegmentUpdateCacheMut.RLock()
+	cache, cached := sponsorblockVideoSegmentUpdateCache[key]
+	sponsorblockVideoSegmentUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			sponsorblockVideoSegmentAllColumns,
+			sponsorblockVideoSegmentPrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("models: unable to update sponsorblock_video_segments, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"sponsorblock_video_segments\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 0, wl),
+			strmangle.WhereClause("\"", "\"", 0, sponsorblockVideoSegmentPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(sponsorblockVideoSegmentType, sponsorblockVideoSegmentMapping, append(wl, sponsorblockVideoSegmentPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update sponsorblock_video_segments row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by update for sponsorblock_video_segments")
+	}
+
+	if !cached {
+		sponsorblockVideoSegmentUpdateCacheMut.Lock()
+		sponsorblockVideoSegmentUpdateCache[key] = cache
+		sponsorblockVideoSegmentUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q sponsorblockVideoSegmentQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all for sponsorblock_video_segments")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected for sponsorblock_video_segments")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o SponsorblockVideoSegmentSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("models: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]any, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), sponsorblockVideoSegmentPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"sponsorblock_video_segments\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 0, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, sponsorblockVideoSegmentPrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all in sponsorblockVideoSegment slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected all in update all sponsorblockVideoSegment")
+	}
+	return rowsAff, nil
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *SponsorblockVideoSegment) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no sponsorblock_video_segments provided for upsert")
+	}
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+		o.UpdatedAt = currTime
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(sponsorblockVideoSegmentColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	sponsorblockVideoSegmentUpsertCacheMut.RLock()
+	cache, cached := sponsorblockVideoSegmentUpsertCache[key]
+	sponsorblockVideoSegmentUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, _ := insertColumns.InsertColumnSet(
+			sponsorblockVideoSegmentAllColumns,
+			sponsorblockVideoSegmentColumnsWithDefault,
+			sponsorblockVideoSegmentColumnsWithoutDefault,
+			nzDefaults,
+		)
+		update := updateColumns.UpdateColumnSet(
+			sponsorblockVideoSegmentAllColumns,
+			sponsorblockVideoSegmentPrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("models: unable to upsert sponsorblock_video_segments, could not build update column list")
+		}
+
+		ret := strmangle.SetComplement(sponsorblockVideoSegmentAllColumns, strmangle.SetIntersect(insert, update))
+
+		conflict := conflictColumns
+		if len(conflict) == 0 {
+			conflict = make([]string, len(sponsorblockVideoSegmentPrimaryKeyColumns))
+			copy(conflict, sponsorblockVideoSegmentPrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQuerySQLite(dialect, "\"sponsorblock_video_segments\"", updateOnConflict, ret, update, conflict, insert)
+
+		cache.valueMapping, err = queries.BindMapping(sponsorblockVideoSegmentType, sponsorblockVideoSegmentMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(sponsorblockVideoSegmentType, sponsorblockVideoSegmentMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []any
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if errors.Is(err, sql.ErrNoRows) {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "models: unable to upsert sponsorblock_video_segments")
+	}
+
+	if !cached {
+		sponsorblockVideoSegmentUpsertCacheMut.Lock()
+		sponsorblockVideoSegmentUpsertCache[key] = cache
+		sponsorblockVideoSegmentUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// Delete deletes a single SponsorblockVideoSegment record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *SponsorblockVideoSegment) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("models: no SponsorblockVideoSegment provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), sponsorblockVideoSegmentPrimaryKeyMapping)
+	sql := "DELETE FROM \"sponsorblock_video_segments\" WHERE \"video_id\"=?"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete from sponsorblock_video_segments")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by delete for sponsorblock_video_segments")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q sponsorblockVideoSegmentQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("models: no sponsorblockVideoSegmentQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from sponsorblock_video_segments")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for sponsorblock_video_segments")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o SponsorblockVideoSegmentSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(sponsorblockVideoSegmentBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []any
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), sponsorblockVideoSegmentPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"sponsorblock_video_segments\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, sponsorblockVideoSegmentPrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from sponsorblockVideoSegment slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for sponsorblock_video_segments")
+	}
+
+	if len(sponsorblockVideoSegmentAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *SponsorblockVideoSegment) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindSponsorblockVideoSegment(ctx, exec, o.VideoID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *SponsorblockVideoSegmentSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := SponsorblockVideoSegmentSlice{}
+	var args []any
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), sponsorblockVideoSegmentPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"sponsorblock_video_segments\".* FROM \"sponsorblock_video_segments\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, sponsorblockVideoSegmentPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to reload all in SponsorblockVideoSegmentSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// SponsorblockVideoSegmentExists checks if the SponsorblockVideoSegment row exists.
+func SponsorblockVideoSegmentExists(ctx context.Context, exec boil.ContextExecutor, videoID string) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"sponsorblock_video_segments\" where \"video_id\"=? limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, videoID)
+	}
+	row := exec.QueryRowContext(ctx, sql, videoID)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "models: unable to check if sponsorblock_video_segments exists")
+	}
+
+	return exists, nil
+}
+
+// Exists checks if the SponsorblockVideoSegment row exists.
+func (o *SponsorblockVideoSegment) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	return SponsorblockVideoSegmentExists(ctx, exec, o.VideoID)
+}
@@ -0,0 +1,731 @@
+// Code generated by SQLBoiler 4.19.7 (https://github.com/aarondl/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"bytes"
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/aarondl/randomize"
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries"
+	"github.com/aarondl/strmangle"
+)
+
+var (
+	// Relationships sometimes use the reflection helper queries.Equal/queries.Assign
+	// so force a package dependency in case they don't.
+	_ = queries.Equal
+)
+
+func testSponsorblockVideoSegments(t *testing.T) {
+	t.Parallel()
+
+	query := SponsorblockVideoSegments()
+
+	if query.Query == nil {
+		t.Error("expected a query, got nothing")
+	}
+}
+
+func testSponsorblockVideoSegmentsDelete(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &SponsorblockVideoSegment{}
+	if err = randomize.Struct(seed, o, sponsorblockVideoSegmentDBTypes, true, sponsorblockVideoSegmentColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize SponsorblockVideoSegment struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := o.Delete(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := SponsorblockVideoSegments().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testSponsorblockVideoSegmentsQueryDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &SponsorblockVideoSegment{}
+	if err = randomize.Struct(seed, o, sponsorblockVideoSegmentDBTypes, true, sponsorblockVideoSegmentColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize SponsorblockVideoSegment struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := SponsorblockVideoSegments().DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := SponsorblockVideoSegments().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testSponsorblockVideoSegmentsSliceDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &SponsorblockVideoSegment{}
+	if err = randomize.Struct(seed, o, sponsorblockVideoSegmentDBTypes, true, sponsorblockVideoSegmentColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize SponsorblockVideoSegment struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := SponsorblockVideoSegmentSlice{o}
+
+	if rowsAff, err := slice.DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := SponsorblockVideoSegments().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testSponsorblockVideoSegmentsExists(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &SponsorblockVideoSegment{}
+	if err = randomize.Struct(seed, o, sponsorblockVideoSegmentDBTypes, true, sponsorblockVideoSegmentColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize SponsorblockVideoSegment struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	e, err := SponsorblockVideoSegmentExists(ctx, tx, o.VideoID)
+	if err != nil {
+		t.Errorf("Unable to check if SponsorblockVideoSegment exists: %s", err)
+	}
+	if !e {
+		t.Errorf("Expected SponsorblockVideoSegmentExists to return true, but got false.")
+	}
+}
+
+func testSponsorblockVideoSegmentsFind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &SponsorblockVideoSegment{}
+	if err = randomize.Struct(seed, o, sponsorblockVideoSegmentDBTypes, true, sponsorblockVideoSegmentColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize SponsorblockVideoSegment struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	sponsorblockVideoSegmentFound, err := FindSponsorblockVideoSegment(ctx, tx, o.VideoID)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if sponsorblockVideoSegmentFound == nil {
+		t.Error("want a record, got nil")
+	}
+}
+
+func testSponsorblockVideoSegmentsBind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &SponsorblockVideoSegment{}
+	if err = randomize.Struct(seed, o, sponsorblockVideoSegmentDBTypes, true, sponsorblockVideoSegmentColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize SponsorblockVideoSegment struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = SponsorblockVideoSegments().Bind(ctx, tx, o); err != nil {
+		t.Error(err)
+	}
+}
+
+func testSponsorblockVideoSegmentsOne(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &SponsorblockVideoSegment{}
+	if err = randomize.Struct(seed, o, sponsorblockVideoSegmentDBTypes, true, sponsorblockVideoSegmentColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize SponsorblockVideoSegment struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if x, err := SponsorblockVideoSegments().One(ctx, tx); err != nil {
+		t.Error(err)
+	} else if x == nil {
+		t.Error("expected to get a non nil record")
+	}
+}
+
+func testSponsorblockVideoSegmentsAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	sponsorblockVideoSegmentOne := &SponsorblockVideoSegment{}
+	sponsorblockVideoSegmentTwo := &SponsorblockVideoSegment{}
+	if err = randomize.Struct(seed, sponsorblockVideoSegmentOne, sponsorblockVideoSegmentDBTypes, false, sponsorblockVideoSegmentColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize SponsorblockVideoSegment struct: %s", err)
+	}
+	if err = randomize.Struct(seed, sponsorblockVideoSegmentTwo, sponsorblockVideoSegmentDBTypes, false, sponsorblockVideoSegmentColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize SponsorblockVideoSegment struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = sponsorblockVideoSegmentOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = sponsorblockVideoSegmentTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := SponsorblockVideoSegments().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 2 {
+		t.Error("want 2 records, got:", len(slice))
+	}
+}
+
+func testSponsorblockVideoSegmentsCount(t *testing.T) {
+	t.Parallel()
+
+	var err error
+	seed := randomize.NewSeed()
+	sponsorblockVideoSegmentOne := &SponsorblockVideoSegment{}
+	sponsorblockVideoSegmentTwo := &SponsorblockVideoSegment{}
+	if err = randomize.Struct(seed, sponsorblockVideoSegmentOne, sponsorblockVideoSegmentDBTypes, false, sponsorblockVideoSegmentColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize SponsorblockVideoSegment struct: %s", err)
+	}
+	if err = randomize.Struct(seed, sponsorblockVideoSegmentTwo, sponsorblockVideoSegmentDBTypes, false, sponsorblockVideoSegmentColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize SponsorblockVideoSegment struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = sponsorblockVideoSegmentOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = sponsorblockVideoSegmentTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := SponsorblockVideoSegments().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 2 {
+		t.Error("want 2 records, got:", count)
+	}
+}
+
+func sponsorblockVideoSegmentBeforeInsertHook(ctx context.Context, e boil.ContextExecutor, o *SponsorblockVideoSegment) error {
+	*o = SponsorblockVideoSegment{}
+	return nil
+}
+
+func sponsorblockVideoSegmentAfterInsertHook(ctx context.Context, e boil.ContextExecutor, o *SponsorblockVideoSegment) error {
+	*o = SponsorblockVideoSegment{}
+	return nil
+}
+
+func sponsorblockVideoSegmentAfterSelectHook(ctx context.Context, e boil.ContextExecutor, o *SponsorblockVideoSegment) error {
+	*o = SponsorblockVideoSegment{}
+	return nil
+}
+
+func sponsorblockVideoSegmentBeforeUpdateHook(ctx context.Context, e boil.ContextExecutor, o *SponsorblockVideoSegment) error {
+	*o = SponsorblockVideoSegment{}
+	return nil
+}
+
+func sponsorblockVideoSegmentAfterUpdateHook(ctx context.Context, e boil.ContextExecutor, o *SponsorblockVideoSegment) error {
+	*o = SponsorblockVideoSegment{}
+	return nil
+}
+
+func sponsorblockVideoSegmentBeforeDeleteHook(ctx context.Context, e boil.ContextExecutor, o *SponsorblockVideoSegment) error {
+	*o = SponsorblockVideoSegment{}
+	return nil
+}
+
+func sponsorblockVideoSegmentAfterDeleteHook(ctx context.Context, e boil.ContextExecutor, o *SponsorblockVideoSegment) error {
+	*o = SponsorblockVideoSegment{}
+	return nil
+}
+
+func sponsorblockVideoSegmentBeforeUpsertHook(ctx context.Context, e boil.ContextExecutor, o *SponsorblockVideoSegment) error {
+	*o = SponsorblockVideoSegment{}
+	return nil
+}
+
+func sponsorblockVideoSegmentAfterUpsertHook(ctx context.Context, e boil.ContextExecutor, o *SponsorblockVideoSegment) error {
+	*o = SponsorblockVideoSegment{}
+	return nil
+}
+
+func testSponsorblockVideoSegmentsHooks(t *testing.T) {
+	t.Parallel()
+
+	var err error
+
+	ctx := context.Background()
+	empty := &SponsorblockVideoSegment{}
+	o := &SponsorblockVideoSegment{}
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, o, sponsorblockVideoSegmentDBTypes, false); err != nil {
+		t.Errorf("Unable to randomize SponsorblockVideoSegment object: %s", err)
+	}
+
+	AddSponsorblockVideoSegmentHook(boil.BeforeInsertHook, sponsorblockVideoSegmentBeforeInsertHook)
+	if err = o.doBeforeInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeInsertHook function to empty object, but got: %#v", o)
+	}
+	sponsorblockVideoSegmentBeforeInsertHooks = []SponsorblockVideoSegmentHook{}
+
+	AddSponsorblockVideoSegmentHook(boil.AfterInsertHook, sponsorblockVideoSegmentAfterInsertHook)
+	if err = o.doAfterInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterInsertHook function to empty object, but got: %#v", o)
+	}
+	sponsorblockVideoSegmentAfterInsertHooks = []SponsorblockVideoSegmentHook{}
+
+	AddSponsorblockVideoSegmentHook(boil.AfterSelectHook, sponsorblockVideoSegmentAfterSelectHook)
+	if err = o.doAfterSelectHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterSelectHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterSelectHook function to empty object, but got: %#v", o)
+	}
+	sponsorblockVideoSegmentAfterSelectHooks = []SponsorblockVideoSegmentHook{}
+
+	AddSponsorblockVideoSegmentHook(boil.BeforeUpdateHook, sponsorblockVideoSegmentBeforeUpdateHook)
+	if err = o.doBeforeUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpdateHook function to empty object, but got: %#v", o)
+	}
+	sponsorblockVideoSegmentBeforeUpdateHooks = []SponsorblockVideoSegmentHook{}
+
+	AddSponsorblockVideoSegmentHook(boil.AfterUpdateHook, sponsorblockVideoSegmentAfterUpdateHook)
+	if err = o.doAfterUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpdateHook function to empty object, but got: %#v", o)
+	}
+	sponsorblockVideoSegmentAfterUpdateHooks = []SponsorblockVideoSegmentHook{}
+
+	AddSponsorblockVideoSegmentHook(boil.BeforeDeleteHook, sponsorblockVideoSegmentBeforeDeleteHook)
+	if err = o.doBeforeDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeDeleteHook function to empty object, but got: %#v", o)
+	}
+	sponsorblockVideoSegmentBeforeDeleteHooks = []SponsorblockVideoSegmentHook{}
+
+	AddSponsorblockVideoSegmentHook(boil.AfterDeleteHook, sponsorblockVideoSegmentAfterDeleteHook)
+	if err = o.doAfterDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterDeleteHook function to empty object, but got: %#v", o)
+	}
+	sponsorblockVideoSegmentAfterDeleteHooks = []SponsorblockVideoSegmentHook{}
+
+	AddSponsorblockVideoSegmentHook(boil.BeforeUpsertHook, sponsorblockVideoSegmentBeforeUpsertHook)
+	if err = o.doBeforeUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpsertHook function to empty object, but got: %#v", o)
+	}
+	sponsorblockVideoSegmentBeforeUpsertHooks = []SponsorblockVideoSegmentHook{}
+
+	AddSponsorblockVideoSegmentHook(boil.AfterUpsertHook, sponsorblockVideoSegmentAfterUpsertHook)
+	if err = o.doAfterUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpsertHook function to empty object, but got: %#v", o)
+	}
+	sponsorblockVideoSegmentAfterUpsertHooks = []SponsorblockVideoSegmentHook{}
+}
+
+func testSponsorblockVideoSegmentsInsert(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &SponsorblockVideoSegment{}
+	if err = randomize.Struct(seed, o, sponsorblockVideoSegmentDBTypes, true, sponsorblockVideoSegmentColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize SponsorblockVideoSegment struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := SponsorblockVideoSegments().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testSponsorblockVideoSegmentsInsertWhitelist(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &SponsorblockVideoSegment{}
+	if err = randomize.Struct(seed, o, sponsorblockVideoSegmentDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize SponsorblockVideoSegment struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Whitelist(strmangle.SetMerge(sponsorblockVideoSegmentPrimaryKeyColumns, sponsorblockVideoSegmentColumnsWithoutDefault)...)); err != nil {
+		t.Error(err)
+	}
+
+	count, err := SponsorblockVideoSegments().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testSponsorblockVideoSegmentsReload(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &SponsorblockVideoSegment{}
+	if err = randomize.Struct(seed, o, sponsorblockVideoSegmentDBTypes, true, sponsorblockVideoSegmentColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize SponsorblockVideoSegment struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = o.Reload(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testSponsorblockVideoSegmentsReloadAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &SponsorblockVideoSegment{}
+	if err = randomize.Struct(seed, o, sponsorblockVideoSegmentDBTypes, true, sponsorblockVideoSegmentColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize SponsorblockVideoSegment struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := SponsorblockVideoSegmentSlice{o}
+
+	if err = slice.ReloadAll(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testSponsorblockVideoSegmentsSelect(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &SponsorblockVideoSegment{}
+	if err = randomize.Struct(seed, o, sponsorblockVideoSegmentDBTypes, true, sponsorblockVideoSegmentColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize SponsorblockVideoSegment struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := SponsorblockVideoSegments().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 1 {
+		t.Error("want one record, got:", len(slice))
+	}
+}
+
+var (
+	sponsorblockVideoSegmentDBTypes = map[string]string{`VideoID`: `TEXT`, `CreatedAt`: `DATE`, `UpdatedAt`: `DATE`, `ExpiresAt`: `DATE`, `SegmentCount`: `INTEGER`, `Segments`: `TEXT`}
+	_                               = bytes.MinRead
+)
+
+func testSponsorblockVideoSegmentsUpdate(t *testing.T) {
+	t.Parallel()
+
+	if 0 == len(sponsorblockVideoSegmentPrimaryKeyColumns) {
+		t.Skip("Skipping table with no primary key columns")
+	}
+	if len(sponsorblockVideoSegmentAllColumns) == len(sponsorblockVideoSegmentPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &SponsorblockVideoSegment{}
+	if err = randomize.Struct(seed, o, sponsorblockVideoSegmentDBTypes, true, sponsorblockVideoSegmentColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize SponsorblockVideoSegment struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := SponsorblockVideoSegments().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, sponsorblockVideoSegmentDBTypes, true, sponsorblockVideoSegmentPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize SponsorblockVideoSegment struct: %s", err)
+	}
+
+	if rowsAff, err := o.Update(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only affect one row but affected", rowsAff)
+	}
+}
+
+func testSponsorblockVideoSegmentsSliceUpdateAll(t *testing.T) {
+	t.Parallel()
+
+	if len(sponsorblockVideoSegmentAllColumns) == len(sponsorblockVideoSegmentPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &SponsorblockVideoSegment{}
+	if err = randomize.Struct(seed, o, sponsorblockVideoSegmentDBTypes, true, sponsorblockVideoSegmentColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize SponsorblockVideoSegment struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := SponsorblockVideoSegments().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, sponsorblockVideoSegmentDBTypes, true, sponsorblockVideoSegmentPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize SponsorblockVideoSegment struct: %s", err)
+	}
+
+	// Remove Primary keys and unique columns from what we plan to update
+	var fields []string
+	if strmangle.StringSliceMatch(sponsorblockVideoSegmentAllColumns, sponsorblockVideoSegmentPrimaryKeyColumns) {
+		fields = sponsorblockVideoSegmentAllColumns
+	} else {
+		fields = strmangle.SetComplement(
+			sponsorblockVideoSegmentAllColumns,
+			sponsorblockVideoSegmentPrimaryKeyColumns,
+		)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	typ := reflect.TypeOf(o).Elem()
+	n := typ.NumField()
+
+	updateMap := M{}
+	for _, col := range fields {
+		for i := 0; i < n; i++ {
+			f := typ.Field(i)
+			if f.Tag.Get("boil") == col {
+				updateMap[col] = value.Field(i).Interface()
+			}
+		}
+	}
+
+	slice := SponsorblockVideoSegmentSlice{o}
+	if rowsAff, err := slice.UpdateAll(ctx, tx, updateMap); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("wanted one record updated but got", rowsAff)
+	}
+}
+
+func testSponsorblockVideoSegmentsUpsert(t *testing.T) {
+	t.Parallel()
+	if len(sponsorblockVideoSegmentAllColumns) == len(sponsorblockVideoSegmentPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	// Attempt the INSERT side of an UPSERT
+	o := SponsorblockVideoSegment{}
+	if err = randomize.Struct(seed, &o, sponsorblockVideoSegmentDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize SponsorblockVideoSegment struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Upsert(ctx, tx, false, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert SponsorblockVideoSegment: %s", err)
+	}
+
+	count, err := SponsorblockVideoSegments().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	// Attempt the UPDATE side of an UPSERT
+	if err = randomize.Struct(seed, &o, sponsorblockVideoSegmentDBTypes, false, sponsorblockVideoSegmentPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize SponsorblockVideoSegment struct: %s", err)
+	}
+
+	if err = o.Upsert(ctx, tx, true, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert SponsorblockVideoSegment: %s", err)
+	}
+
+	count, err = SponsorblockVideoSegments().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
@@ -10,25 +10,53 @@ func TestUpsert(t *testing.T) {
 
 	t.Run("Channels", testChannelsUpsert)
 
+	t.Run("DearrowVideoBrandings", testDearrowVideoBrandingsUpsert)
+
+	t.Run("Jobs", testJobsUpsert)
+
+	t.Run("Leases", testLeasesUpsert)
+
 	t.Run("Passkeys", testPasskeysUpsert)
 
 	t.Run("PlaylistItems", testPlaylistItemsUpsert)
 
+	t.Run("PlaylistShareLinks", testPlaylistShareLinksUpsert)
+
 	t.Run("Playlists", testPlaylistsUpsert)
 
 	t.Run("Sessions", testSessionsUpsert)
 
 	t.Run("Settings", testSettingsUpsert)
 
+	t.Run("SponsorblockSubmissions", testSponsorblockSubmissionsUpsert)
+
+	t.Run("SponsorblockVideoSegments", testSponsorblockVideoSegmentsUpsert)
+
 	t.Run("Subscriptions", testSubscriptionsUpsert)
 
 	t.Run("Users", testUsersUpsert)
 
+	t.Run("VideoChapters", testVideoChaptersUpsert)
+
+	t.Run("VideoTranscriptChecks", testVideoTranscriptChecksUpsert)
+
+	t.Run("VideoTranscriptTerms", testVideoTranscriptTermsUpsert)
+
+	t.Run("VideoTranscripts", testVideoTranscriptsUpsert)
+
 	t.Run("Videos", testVideosUpsert)
 
 	t.Run("Views", testViewsUpsert)
 
+	t.Run("WatchHistoryImports", testWatchHistoryImportsUpsert)
+
 	t.Run("YoutubeSyncAccounts", testYoutubeSyncAccountsUpsert)
 
+	t.Run("YoutubeSyncTargets", testYoutubeSyncTargetsUpsert)
+
+	t.Run("YoutubeTVPairingTokens", testYoutubeTVPairingTokensUpsert)
+
 	t.Run("YoutubeTVSyncAccounts", testYoutubeTVSyncAccountsUpsert)
+
+	t.Run("YoutubeTVSyncEvents", testYoutubeTVSyncEventsUpsert)
 }
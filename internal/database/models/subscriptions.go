@@ -23,72 +23,79 @@ import (
 
 // Subscription is an object representing the database table.
 type Subscription struct {
-	ID          string    `boil:"id" json:"id" toml:"id" yaml:"id"`
-	CreatedAt   time.Time `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
-	UpdatedAt   time.Time `boil:"updated_at" json:"updated_at" toml:"updated_at" yaml:"updated_at"`
-	Favorite    bool      `boil:"favorite" json:"favorite" toml:"favorite" yaml:"favorite"`
-	ChannelID   string    `boil:"channel_id" json:"channel_id" toml:"channel_id" yaml:"channel_id"`
-	UserID      string    `boil:"user_id" json:"user_id" toml:"user_id" yaml:"user_id"`
-	VideoFilter string    `boil:"video_filter" json:"video_filter" toml:"video_filter" yaml:"video_filter"`
+	ID                 string    `boil:"id" json:"id" toml:"id" yaml:"id"`
+	CreatedAt          time.Time `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+	UpdatedAt          time.Time `boil:"updated_at" json:"updated_at" toml:"updated_at" yaml:"updated_at"`
+	Favorite           bool      `boil:"favorite" json:"favorite" toml:"favorite" yaml:"favorite"`
+	ChannelID          string    `boil:"channel_id" json:"channel_id" toml:"channel_id" yaml:"channel_id"`
+	UserID             string    `boil:"user_id" json:"user_id" toml:"user_id" yaml:"user_id"`
+	VideoFilter        string    `boil:"video_filter" json:"video_filter" toml:"video_filter" yaml:"video_filter"`
+	TranscriptsEnabled bool      `boil:"transcripts_enabled" json:"transcripts_enabled" toml:"transcripts_enabled" yaml:"transcripts_enabled"`
 
 	R *subscriptionR `boil:"-" json:"-" toml:"-" yaml:"-"`
 	L subscriptionL  `boil:"-" json:"-" toml:"-" yaml:"-"`
 }
 
 var SubscriptionColumns = struct {
-	ID          string
-	CreatedAt   string
-	UpdatedAt   string
-	Favorite    string
-	ChannelID   string
-	UserID      string
-	VideoFilter string
+	ID                 string
+	CreatedAt          string
+	UpdatedAt          string
+	Favorite           string
+	ChannelID          string
+	UserID             string
+	VideoFilter        string
+	TranscriptsEnabled string
 }{
-	ID:          "id",
-	CreatedAt:   "created_at",
-	UpdatedAt:   "updated_at",
-	Favorite:    "favorite",
-	ChannelID:   "channel_id",
-	UserID:      "user_id",
-	VideoFilter: "video_filter",
+	ID:                 "id",
+	CreatedAt:          "created_at",
+	UpdatedAt:          "updated_at",
+	Favorite:           "favorite",
+	ChannelID:          "channel_id",
+	UserID:             "user_id",
+	VideoFilter:        "video_filter",
+	TranscriptsEnabled: "transcripts_enabled",
 }
 
 var SubscriptionTableColumns = struct {
-	ID          string
-	CreatedAt   string
-	UpdatedAt   string
-	Favorite    string
-	ChannelID   string
-	UserID      string
-	VideoFilter string
+	ID                 string
+	CreatedAt          string
+	UpdatedAt          string
+	Favorite           string
+	ChannelID          string
+	UserID             string
+	VideoFilter        string
+	TranscriptsEnabled string
 }{
-	ID:          "subscriptions.id",
-	CreatedAt:   "subscriptions.created_at",
-	UpdatedAt:   "subscriptions.updated_at",
-	Favorite:    "subscriptions.favorite",
-	ChannelID:   "subscriptions.channel_id",
-	UserID:      "subscriptions.user_id",
-	VideoFilter: "subscriptions.video_filter",
+	ID:                 "subscriptions.id",
+	CreatedAt:          "subscriptions.created_at",
+	UpdatedAt:          "subscriptions.updated_at",
+	Favorite:           "subscriptions.favorite",
+	ChannelID:          "subscriptions.channel_id",
+	UserID:             "subscriptions.user_id",
+	VideoFilter:        "subscriptions.video_filter",
+	TranscriptsEnabled: "subscriptions.transcripts_enabled",
 }
 
 // Generated where
 
 var SubscriptionWhere = struct {
-	ID          whereHelperstring
-	CreatedAt   whereHelpertime_Time
-	UpdatedAt   whereHelpertime_Time
-	Favorite    whereHelperbool
-	ChannelID   whereHelperstring
-	UserID      whereHelperstring
-	VideoFilter whereHelperstring
+	ID                 whereHelperstring
+	CreatedAt          whereHelpertime_Time
+	UpdatedAt          whereHelpertime_Time
+	Favorite           whereHelperbool
+	ChannelID          whereHelperstring
+	UserID             whereHelperstring
+	VideoFilter        whereHelperstring
+	TranscriptsEnabled whereHelperbool
 }{
-	ID:          whereHelperstring{field: "\"subscriptions\".\"id\""},
-	CreatedAt:   whereHelpertime_Time{field: "\"subscriptions\".\"created_at\""},
-	UpdatedAt:   whereHelpertime_Time{field: "\"subscriptions\".\"updated_at\""},
-	Favorite:    whereHelperbool{field: "\"subscriptions\".\"favorite\""},
-	ChannelID:   whereHelperstring{field: "\"subscriptions\".\"channel_id\""},
-	UserID:      whereHelperstring{field: "\"subscriptions\".\"user_id\""},
-	VideoFilter: whereHelperstring{field: "\"subscriptions\".\"video_filter\""},
+	ID:                 whereHelperstring{field: "\"subscriptions\".\"id\""},
+	CreatedAt:          whereHelpertime_Time{field: "\"subscriptions\".\"created_at\""},
+	UpdatedAt:          whereHelpertime_Time{field: "\"subscriptions\".\"updated_at\""},
+	Favorite:           whereHelperbool{field: "\"subscriptions\".\"favorite\""},
+	ChannelID:          whereHelperstring{field: "\"subscriptions\".\"channel_id\""},
+	UserID:             whereHelperstring{field: "\"subscriptions\".\"user_id\""},
+	VideoFilter:        whereHelperstring{field: "\"subscriptions\".\"video_filter\""},
+	TranscriptsEnabled: whereHelperbool{field: "\"subscriptions\".\"transcripts_enabled\""},
 }
 
 // SubscriptionRels is where relationship names are stored.
@@ -147,9 +154,9 @@ func (r *subscriptionR) GetChannel() *Channel {
 type subscriptionL struct{}
 
 var (
-	subscriptionAllColumns            = []string{"id", "created_at", "updated_at", "favorite", "channel_id", "user_id", "video_filter"}
+	subscriptionAllColumns            = []string{"id", "created_at", "updated_at", "favorite", "channel_id", "user_id", "video_filter", "transcripts_enabled"}
 	subscriptionColumnsWithoutDefault = []string{"id", "created_at", "updated_at", "favorite", "channel_id", "user_id"}
-	subscriptionColumnsWithDefault    = []string{"video_filter"}
+	subscriptionColumnsWithDefault    = []string{"video_filter", "transcripts_enabled"}
 	subscriptionPrimaryKeyColumns     = []string{"id"}
 	subscriptionGeneratedColumns      = []string{}
 )
@@ -805,7 +805,7 @@ func testSubscriptionsSelect(t *testing.T) {
 }
 
 var (
-	subscriptionDBTypes = map[string]string{`ID`: `TEXT`, `CreatedAt`: `DATE`, `UpdatedAt`: `DATE`, `Favorite`: `BOOLEAN`, `ChannelID`: `TEXT`, `UserID`: `TEXT`, `VideoFilter`: `TEXT`}
+	subscriptionDBTypes = map[string]string{`ID`: `TEXT`, `CreatedAt`: `DATE`, `UpdatedAt`: `DATE`, `Favorite`: `BOOLEAN`, `ChannelID`: `TEXT`, `UserID`: `TEXT`, `VideoFilter`: `TEXT`, `TranscriptsEnabled`: `BOOLEAN`}
 	_                   = bytes.MinRead
 )
 
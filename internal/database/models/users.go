@@ -79,29 +79,41 @@ var UserWhere = struct {
 
 // UserRels is where relationship names are stored.
 var UserRels = struct {
-	YoutubeSyncAccount   string
-	YoutubeTVSyncAccount string
-	Playlists            string
-	Settings             string
-	Subscriptions        string
-	Views                string
+	YoutubeSyncAccount      string
+	Playlists               string
+	Settings                string
+	SponsorblockSubmissions string
+	Subscriptions           string
+	Views                   string
+	WatchHistoryImports     string
+	YoutubeTVPairingTokens  string
+	YoutubeTVSyncAccounts   string
+	YoutubeTVSyncEvents     string
 }{
-	YoutubeSyncAccount:   "YoutubeSyncAccount",
-	YoutubeTVSyncAccount: "YoutubeTVSyncAccount",
-	Playlists:            "Playlists",
-	Settings:             "Settings",
-	Subscriptions:        "Subscriptions",
-	Views:                "Views",
+	YoutubeSyncAccount:      "YoutubeSyncAccount",
+	Playlists:               "Playlists",
+	Settings:                "Settings",
+	SponsorblockSubmissions: "SponsorblockSubmissions",
+	Subscriptions:           "Subscriptions",
+	Views:                   "Views",
+	WatchHistoryImports:     "WatchHistoryImports",
+	YoutubeTVPairingTokens:  "YoutubeTVPairingTokens",
+	YoutubeTVSyncAccounts:   "YoutubeTVSyncAccounts",
+	YoutubeTVSyncEvents:     "YoutubeTVSyncEvents",
 }
 
 // userR is where relationships are stored.
 type userR struct {
-	YoutubeSyncAccount   *YoutubeSyncAccount   `boil:"YoutubeSyncAccount" json:"YoutubeSyncAccount" toml:"YoutubeSyncAccount" yaml:"YoutubeSyncAccount"`
-	YoutubeTVSyncAccount *YoutubeTVSyncAccount `boil:"YoutubeTVSyncAccount" json:"YoutubeTVSyncAccount" toml:"YoutubeTVSyncAccount" yaml:"YoutubeTVSyncAccount"`
-	Playlists            PlaylistSlice         `boil:"Playlists" json:"Playlists" toml:"Playlists" yaml:"Playlists"`
-	Settings             SettingSlice          `boil:"Settings" json:"Settings" toml:"Settings" yaml:"Settings"`
-	Subscriptions        SubscriptionSlice     `boil:"Subscriptions" json:"Subscriptions" toml:"Subscriptions" yaml:"Subscriptions"`
-	Views                ViewSlice             `boil:"Views" json:"Views" toml:"Views" yaml:"Views"`
+	YoutubeSyncAccount      *YoutubeSyncAccount         `boil:"YoutubeSyncAccount" json:"YoutubeSyncAccount" toml:"YoutubeSyncAccount" yaml:"YoutubeSyncAccount"`
+	Playlists               PlaylistSlice               `boil:"Playlists" json:"Playlists" toml:"Playlists" yaml:"Playlists"`
+	Settings                SettingSlice                `boil:"Settings" json:"Settings" toml:"Settings" yaml:"Settings"`
+	SponsorblockSubmissions SponsorblockSubmissionSlice `boil:"SponsorblockSubmissions" json:"SponsorblockSubmissions" toml:"SponsorblockSubmissions" yaml:"SponsorblockSubmissions"`
+	Subscriptions           SubscriptionSlice           `boil:"Subscriptions" json:"Subscriptions" toml:"Subscriptions" yaml:"Subscriptions"`
+	Views                   ViewSlice                   `boil:"Views" json:"Views" toml:"Views" yaml:"Views"`
+	WatchHistoryImports     WatchHistoryImportSlice     `boil:"WatchHistoryImports" json:"WatchHistoryImports" toml:"WatchHistoryImports" yaml:"WatchHistoryImports"`
+	YoutubeTVPairingTokens  YoutubeTVPairingTokenSlice  `boil:"YoutubeTVPairingTokens" json:"YoutubeTVPairingTokens" toml:"YoutubeTVPairingTokens" yaml:"YoutubeTVPairingTokens"`
+	YoutubeTVSyncAccounts   YoutubeTVSyncAccountSlice   `boil:"YoutubeTVSyncAccounts" json:"YoutubeTVSyncAccounts" toml:"YoutubeTVSyncAccounts" yaml:"YoutubeTVSyncAccounts"`
+	YoutubeTVSyncEvents     YoutubeTVSyncEventSlice     `boil:"YoutubeTVSyncEvents" json:"YoutubeTVSyncEvents" toml:"YoutubeTVSyncEvents" yaml:"YoutubeTVSyncEvents"`
 }
 
 // NewStruct creates a new relationship struct
@@ -125,52 +137,52 @@ func (r *userR) GetYoutubeSyncAccount() *YoutubeSyncAccount {
 	return r.YoutubeSyncAccount
 }
 
-func (o *User) GetYoutubeTVSyncAccount() *YoutubeTVSyncAccount {
+func (o *User) GetPlaylists() PlaylistSlice {
 	if o == nil {
 		return nil
 	}
 
-	return o.R.GetYoutubeTVSyncAccount()
+	return o.R.GetPlaylists()
 }
 
-func (r *userR) GetYoutubeTVSyncAccount() *YoutubeTVSyncAccount {
+func (r *userR) GetPlaylists() PlaylistSlice {
 	if r == nil {
 		return nil
 	}
 
-	return r.YoutubeTVSyncAccount
+	return r.Playlists
 }
 
-func (o *User) GetPlaylists() PlaylistSlice {
+func (o *User) GetSettings() SettingSlice {
 	if o == nil {
 		return nil
 	}
 
-	return o.R.GetPlaylists()
+	return o.R.GetSettings()
 }
 
-func (r *userR) GetPlaylists() PlaylistSlice {
+func (r *userR) GetSettings() SettingSlice {
 	if r == nil {
 		return nil
 	}
 
-	return r.Playlists
+	return r.Settings
 }
 
-func (o *User) GetSettings() SettingSlice {
+func (o *User) GetSponsorblockSubmissions() SponsorblockSubmissionSlice {
 	if o == nil {
 		return nil
 	}
 
-	return o.R.GetSettings()
+	return o.R.GetSponsorblockSubmissions()
 }
 
-func (r *userR) GetSettings() SettingSlice {
+func (r *userR) GetSponsorblockSubmissions() SponsorblockSubmissionSlice {
 	if r == nil {
 		return nil
 	}
 
-	return r.Settings
+	return r.SponsorblockSubmissions
 }
 
 func (o *User) GetSubscriptions() SubscriptionSlice {
@@ -205,6 +217,70 @@ func (r *userR) GetViews() ViewSlice {
 	return r.Views
 }
 
+func (o *User) GetWatchHistoryImports() WatchHistoryImportSlice {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetWatchHistoryImports()
+}
+
+func (r *userR) GetWatchHistoryImports() WatchHistoryImportSlice {
+	if r == nil {
+		return nil
+	}
+
+	return r.WatchHistoryImports
+}
+
+func (o *User) GetYoutubeTVPairingTokens() YoutubeTVPairingTokenSlice {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetYoutubeTVPairingTokens()
+}
+
+func (r *userR) GetYoutubeTVPairingTokens() YoutubeTVPairingTokenSlice {
+	if r == nil {
+		return nil
+	}
+
+	return r.YoutubeTVPairingTokens
+}
+
+func (o *User) GetYoutubeTVSyncAccounts() YoutubeTVSyncAccountSlice {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetYoutubeTVSyncAccounts()
+}
+
+func (r *userR) GetYoutubeTVSyncAccounts() YoutubeTVSyncAccountSlice {
+	if r == nil {
+		return nil
+	}
+
+	return r.YoutubeTVSyncAccounts
+}
+
+func (o *User) GetYoutubeTVSyncEvents() YoutubeTVSyncEventSlice {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetYoutubeTVSyncEvents()
+}
+
+func (r *userR) GetYoutubeTVSyncEvents() YoutubeTVSyncEventSlice {
+	if r == nil {
+		return nil
+	}
+
+	return r.YoutubeTVSyncEvents
+}
+
 // userL is where Load methods for each relationship are stored.
 type userL struct{}
 
@@ -532,17 +608,6 @@ func (o *User) YoutubeSyncAccount(mods ...qm.QueryMod) youtubeSyncAccountQuery {
 	return YoutubeSyncAccounts(queryMods...)
 }
 
-// YoutubeTVSyncAccount pointed to by the foreign key.
-func (o *User) YoutubeTVSyncAccount(mods ...qm.QueryMod) youtubeTVSyncAccountQuery {
-	queryMods := []qm.QueryMod{
-		qm.Where("\"user_id\" = ?", o.ID),
-	}
-
-	queryMods = append(queryMods, mods...)
-
-	return YoutubeTVSyncAccounts(queryMods...)
-}
-
 // Playlists retrieves all the playlist's Playlists with an executor.
 func (o *User) Playlists(mods ...qm.QueryMod) playlistQuery {
 	var queryMods []qm.QueryMod
@@ -571,6 +636,20 @@ func (o *User) Settings(mods ...qm.QueryMod) settingQuery {
 	return Settings(queryMods...)
 }
 
+// SponsorblockSubmissions retrieves all the sponsorblock_submission's SponsorblockSubmissions with an executor.
+func (o *User) SponsorblockSubmissions(mods ...qm.QueryMod) sponsorblockSubmissionQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"sponsorblock_submissions\".\"user_id\"=?", o.ID),
+	)
+
+	return SponsorblockSubmissions(queryMods...)
+}
+
 // Subscriptions retrieves all the subscription's Subscriptions with an executor.
 func (o *User) Subscriptions(mods ...qm.QueryMod) subscriptionQuery {
 	var queryMods []qm.QueryMod
@@ -599,6 +678,62 @@ func (o *User) Views(mods ...qm.QueryMod) viewQuery {
 	return Views(queryMods...)
 }
 
+// WatchHistoryImports retrieves all the watch_history_import's WatchHistoryImports with an executor.
+func (o *User) WatchHistoryImports(mods ...qm.QueryMod) watchHistoryImportQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"watch_history_imports\".\"user_id\"=?", o.ID),
+	)
+
+	return WatchHistoryImports(queryMods...)
+}
+
+// YoutubeTVPairingTokens retrieves all the youtube_tv_pairing_token's YoutubeTVPairingTokens with an executor.
+func (o *User) YoutubeTVPairingTokens(mods ...qm.QueryMod) youtubeTVPairingTokenQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"youtube_tv_pairing_tokens\".\"user_id\"=?", o.ID),
+	)
+
+	return YoutubeTVPairingTokens(queryMods...)
+}
+
+// YoutubeTVSyncAccounts retrieves all the youtube_tv_sync_account's YoutubeTVSyncAccounts with an executor.
+func (o *User) YoutubeTVSyncAccounts(mods ...qm.QueryMod) youtubeTVSyncAccountQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"youtube_tv_sync_accounts\".\"user_id\"=?", o.ID),
+	)
+
+	return YoutubeTVSyncAccounts(queryMods...)
+}
+
+// YoutubeTVSyncEvents retrieves all the youtube_tv_sync_event's YoutubeTVSyncEvents with an executor.
+func (o *User) YoutubeTVSyncEvents(mods ...qm.QueryMod) youtubeTVSyncEventQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"youtube_tv_sync_events\".\"user_id\"=?", o.ID),
+	)
+
+	return YoutubeTVSyncEvents(queryMods...)
+}
+
 // LoadYoutubeSyncAccount allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for a 1-1 relationship.
 func (userL) LoadYoutubeSyncAccount(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser any, mods queries.Applicator) error {
@@ -716,9 +851,9 @@ func (userL) LoadYoutubeSyncAccount(ctx context.Context, e boil.ContextExecutor,
 	return nil
 }
 
-// LoadYoutubeTVSyncAccount allows an eager lookup of values, cached into the
-// loaded structs of the objects. This is for a 1-1 relationship.
-func (userL) LoadYoutubeTVSyncAccount(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser any, mods queries.Applicator) error {
+// LoadPlaylists allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadPlaylists(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser any, mods queries.Applicator) error {
 	var slice []*User
 	var object *User
 
@@ -755,7 +890,6 @@ func (userL) LoadYoutubeTVSyncAccount(ctx context.Context, e boil.ContextExecuto
 			if obj.R == nil {
 				obj.R = &userR{}
 			}
-
 			args[obj.ID] = struct{}{}
 		}
 	}
@@ -772,8 +906,8 @@ func (userL) LoadYoutubeTVSyncAccount(ctx context.Context, e boil.ContextExecuto
 	}
 
 	query := NewQuery(
-		qm.From(`youtube_tv_sync_accounts`),
-		qm.WhereIn(`youtube_tv_sync_accounts.user_id in ?`, argsSlice...),
+		qm.From(`playlists`),
+		qm.WhereIn(`playlists.user_id in ?`, argsSlice...),
 	)
 	if mods != nil {
 		mods.Apply(query)
@@ -781,48 +915,45 @@ func (userL) LoadYoutubeTVSyncAccount(ctx context.Context, e boil.ContextExecuto
 
 	results, err := query.QueryContext(ctx, e)
 	if err != nil {
-		return errors.Wrap(err, "failed to eager load YoutubeTVSyncAccount")
+		return errors.Wrap(err, "failed to eager load playlists")
 	}
 
-	var resultSlice []*YoutubeTVSyncAccount
+	var resultSlice []*Playlist
 	if err = queries.Bind(results, &resultSlice); err != nil {
-		return errors.Wrap(err, "failed to bind eager loaded slice YoutubeTVSyncAccount")
+		return errors.Wrap(err, "failed to bind eager loaded slice playlists")
 	}
 
 	if err = results.Close(); err != nil {
-		return errors.Wrap(err, "failed to close results of eager load for youtube_tv_sync_accounts")
+		return errors.Wrap(err, "failed to close results in eager load on playlists")
 	}
 	if err = results.Err(); err != nil {
-		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for youtube_tv_sync_accounts")
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for playlists")
 	}
 
-	if len(youtubeTVSyncAccountAfterSelectHooks) != 0 {
+	if len(playlistAfterSelectHooks) != 0 {
 		for _, obj := range resultSlice {
 			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
 				return err
 			}
 		}
 	}
-
-	if len(resultSlice) == 0 {
-		return nil
-	}
-
 	if singular {
-		foreign := resultSlice[0]
-		object.R.YoutubeTVSyncAccount = foreign
-		if foreign.R == nil {
-			foreign.R = &youtubeTVSyncAccountR{}
+		object.R.Playlists = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &playlistR{}
+			}
+			foreign.R.User = object
 		}
-		foreign.R.User = object
+		return nil
 	}
 
-	for _, local := range slice {
-		for _, foreign := range resultSlice {
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
 			if local.ID == foreign.UserID {
-				local.R.YoutubeTVSyncAccount = foreign
+				local.R.Playlists = append(local.R.Playlists, foreign)
 				if foreign.R == nil {
-					foreign.R = &youtubeTVSyncAccountR{}
+					foreign.R = &playlistR{}
 				}
 				foreign.R.User = local
 				break
@@ -833,9 +964,9 @@ func (userL) LoadYoutubeTVSyncAccount(ctx context.Context, e boil.ContextExecuto
 	return nil
 }
 
-// LoadPlaylists allows an eager lookup of values, cached into the
+// LoadSettings allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for a 1-M or N-M relationship.
-func (userL) LoadPlaylists(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser any, mods queries.Applicator) error {
+func (userL) LoadSettings(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser any, mods queries.Applicator) error {
 	var slice []*User
 	var object *User
 
@@ -888,8 +1019,8 @@ func (userL) LoadPlaylists(ctx context.Context, e boil.ContextExecutor, singular
 	}
 
 	query := NewQuery(
-		qm.From(`playlists`),
-		qm.WhereIn(`playlists.user_id in ?`, argsSlice...),
+		qm.From(`settings`),
+		qm.WhereIn(`settings.user_id in ?`, argsSlice...),
 	)
 	if mods != nil {
 		mods.Apply(query)
@@ -897,22 +1028,22 @@ func (userL) LoadPlaylists(ctx context.Context, e boil.ContextExecutor, singular
 
 	results, err := query.QueryContext(ctx, e)
 	if err != nil {
-		return errors.Wrap(err, "failed to eager load playlists")
+		return errors.Wrap(err, "failed to eager load settings")
 	}
 
-	var resultSlice []*Playlist
+	var resultSlice []*Setting
 	if err = queries.Bind(results, &resultSlice); err != nil {
-		return errors.Wrap(err, "failed to bind eager loaded slice playlists")
+		return errors.Wrap(err, "failed to bind eager loaded slice settings")
 	}
 
 	if err = results.Close(); err != nil {
-		return errors.Wrap(err, "failed to close results in eager load on playlists")
+		return errors.Wrap(err, "failed to close results in eager load on settings")
 	}
 	if err = results.Err(); err != nil {
-		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for playlists")
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for settings")
 	}
 
-	if len(playlistAfterSelectHooks) != 0 {
+	if len(settingAfterSelectHooks) != 0 {
 		for _, obj := range resultSlice {
 			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
 				return err
@@ -920,10 +1051,10 @@ func (userL) LoadPlaylists(ctx context.Context, e boil.ContextExecutor, singular
 		}
 	}
 	if singular {
-		object.R.Playlists = resultSlice
+		object.R.Settings = resultSlice
 		for _, foreign := range resultSlice {
 			if foreign.R == nil {
-				foreign.R = &playlistR{}
+				foreign.R = &settingR{}
 			}
 			foreign.R.User = object
 		}
@@ -933,9 +1064,9 @@ func (userL) LoadPlaylists(ctx context.Context, e boil.ContextExecutor, singular
 	for _, foreign := range resultSlice {
 		for _, local := range slice {
 			if local.ID == foreign.UserID {
-				local.R.Playlists = append(local.R.Playlists, foreign)
+				local.R.Settings = append(local.R.Settings, foreign)
 				if foreign.R == nil {
-					foreign.R = &playlistR{}
+					foreign.R = &settingR{}
 				}
 				foreign.R.User = local
 				break
@@ -946,9 +1077,9 @@ func (userL) LoadPlaylists(ctx context.Context, e boil.ContextExecutor, singular
 	return nil
 }
 
-// LoadSettings allows an eager lookup of values, cached into the
+// LoadSponsorblockSubmissions allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for a 1-M or N-M relationship.
-func (userL) LoadSettings(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser any, mods queries.Applicator) error {
+func (userL) LoadSponsorblockSubmissions(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser any, mods queries.Applicator) error {
 	var slice []*User
 	var object *User
 
@@ -1001,8 +1132,8 @@ func (userL) LoadSettings(ctx context.Context, e boil.ContextExecutor, singular
 	}
 
 	query := NewQuery(
-		qm.From(`settings`),
-		qm.WhereIn(`settings.user_id in ?`, argsSlice...),
+		qm.From(`sponsorblock_submissions`),
+		qm.WhereIn(`sponsorblock_submissions.user_id in ?`, argsSlice...),
 	)
 	if mods != nil {
 		mods.Apply(query)
@@ -1010,22 +1141,22 @@ func (userL) LoadSettings(ctx context.Context, e boil.ContextExecutor, singular
 
 	results, err := query.QueryContext(ctx, e)
 	if err != nil {
-		return errors.Wrap(err, "failed to eager load settings")
+		return errors.Wrap(err, "failed to eager load sponsorblock_submissions")
 	}
 
-	var resultSlice []*Setting
+	var resultSlice []*SponsorblockSubmission
 	if err = queries.Bind(results, &resultSlice); err != nil {
-		return errors.Wrap(err, "failed to bind eager loaded slice settings")
+		return errors.Wrap(err, "failed to bind eager loaded slice sponsorblock_submissions")
 	}
 
 	if err = results.Close(); err != nil {
-		return errors.Wrap(err, "failed to close results in eager load on settings")
+		return errors.Wrap(err, "failed to close results in eager load on sponsorblock_submissions")
 	}
 	if err = results.Err(); err != nil {
-		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for settings")
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for sponsorblock_submissions")
 	}
 
-	if len(settingAfterSelectHooks) != 0 {
+	if len(sponsorblockSubmissionAfterSelectHooks) != 0 {
 		for _, obj := range resultSlice {
 			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
 				return err
@@ -1033,10 +1164,10 @@ func (userL) LoadSettings(ctx context.Context, e boil.ContextExecutor, singular
 		}
 	}
 	if singular {
-		object.R.Settings = resultSlice
+		object.R.SponsorblockSubmissions = resultSlice
 		for _, foreign := range resultSlice {
 			if foreign.R == nil {
-				foreign.R = &settingR{}
+				foreign.R = &sponsorblockSubmissionR{}
 			}
 			foreign.R.User = object
 		}
@@ -1046,9 +1177,9 @@ func (userL) LoadSettings(ctx context.Context, e boil.ContextExecutor, singular
 	for _, foreign := range resultSlice {
 		for _, local := range slice {
 			if local.ID == foreign.UserID {
-				local.R.Settings = append(local.R.Settings, foreign)
+				local.R.SponsorblockSubmissions = append(local.R.SponsorblockSubmissions, foreign)
 				if foreign.R == nil {
-					foreign.R = &settingR{}
+					foreign.R = &sponsorblockSubmissionR{}
 				}
 				foreign.R.User = local
 				break
@@ -1285,60 +1416,462 @@ func (userL) LoadViews(ctx context.Context, e boil.ContextExecutor, singular boo
 	return nil
 }
 
-// SetYoutubeSyncAccount of the user to the related item.
-// Sets o.R.YoutubeSyncAccount to related.
-// Adds o to related.R.User.
-func (o *User) SetYoutubeSyncAccount(ctx context.Context, exec boil.ContextExecutor, insert bool, related *YoutubeSyncAccount) error {
-	var err error
-
-	if insert {
-		related.UserID = o.ID
+// LoadWatchHistoryImports allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadWatchHistoryImports(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser any, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
 
-		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
-			return errors.Wrap(err, "failed to insert into foreign table")
+	if singular {
+		var ok bool
+		object, ok = maybeUser.(*User)
+		if !ok {
+			object = new(User)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeUser))
+			}
 		}
 	} else {
-		updateQuery := fmt.Sprintf(
-			"UPDATE \"youtube_sync_accounts\" SET %s WHERE %s",
-			strmangle.SetParamNames("\"", "\"", 0, []string{"user_id"}),
-			strmangle.WhereClause("\"", "\"", 0, youtubeSyncAccountPrimaryKeyColumns),
-		)
-		values := []any{o.ID, related.ID}
-
-		if boil.IsDebug(ctx) {
-			writer := boil.DebugWriterFrom(ctx)
-			fmt.Fprintln(writer, updateQuery)
-			fmt.Fprintln(writer, values)
-		}
-		if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
-			return errors.Wrap(err, "failed to update foreign table")
+		s, ok := maybeUser.(*[]*User)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeUser))
+			}
 		}
-
-		related.UserID = o.ID
 	}
 
-	if o.R == nil {
-		o.R = &userR{
-			YoutubeSyncAccount: related,
-		}
+	args := make(map[any]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args[object.ID] = struct{}{}
 	} else {
-		o.R.YoutubeSyncAccount = related
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]any, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`watch_history_imports`),
+		qm.WhereIn(`watch_history_imports.user_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load watch_history_imports")
+	}
+
+	var resultSlice []*WatchHistoryImport
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice watch_history_imports")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on watch_history_imports")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for watch_history_imports")
+	}
+
+	if len(watchHistoryImportAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.WatchHistoryImports = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &watchHistoryImportR{}
+			}
+			foreign.R.User = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.UserID {
+				local.R.WatchHistoryImports = append(local.R.WatchHistoryImports, foreign)
+				if foreign.R == nil {
+					foreign.R = &watchHistoryImportR{}
+				}
+				foreign.R.User = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadYoutubeTVPairingTokens allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadYoutubeTVPairingTokens(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser any, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		var ok bool
+		object, ok = maybeUser.(*User)
+		if !ok {
+			object = new(User)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeUser))
+			}
+		}
+	} else {
+		s, ok := maybeUser.(*[]*User)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeUser))
+			}
+		}
+	}
+
+	args := make(map[any]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]any, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`youtube_tv_pairing_tokens`),
+		qm.WhereIn(`youtube_tv_pairing_tokens.user_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load youtube_tv_pairing_tokens")
+	}
+
+	var resultSlice []*YoutubeTVPairingToken
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice youtube_tv_pairing_tokens")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on youtube_tv_pairing_tokens")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for youtube_tv_pairing_tokens")
+	}
+
+	if len(youtubeTVPairingTokenAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.YoutubeTVPairingTokens = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &youtubeTVPairingTokenR{}
+			}
+			foreign.R.User = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.UserID {
+				local.R.YoutubeTVPairingTokens = append(local.R.YoutubeTVPairingTokens, foreign)
+				if foreign.R == nil {
+					foreign.R = &youtubeTVPairingTokenR{}
+				}
+				foreign.R.User = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadYoutubeTVSyncAccounts allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadYoutubeTVSyncAccounts(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser any, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		var ok bool
+		object, ok = maybeUser.(*User)
+		if !ok {
+			object = new(User)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeUser))
+			}
+		}
+	} else {
+		s, ok := maybeUser.(*[]*User)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeUser))
+			}
+		}
+	}
+
+	args := make(map[any]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]any, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`youtube_tv_sync_accounts`),
+		qm.WhereIn(`youtube_tv_sync_accounts.user_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load youtube_tv_sync_accounts")
+	}
+
+	var resultSlice []*YoutubeTVSyncAccount
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice youtube_tv_sync_accounts")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on youtube_tv_sync_accounts")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for youtube_tv_sync_accounts")
+	}
+
+	if len(youtubeTVSyncAccountAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.YoutubeTVSyncAccounts = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &youtubeTVSyncAccountR{}
+			}
+			foreign.R.User = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.UserID {
+				local.R.YoutubeTVSyncAccounts = append(local.R.YoutubeTVSyncAccounts, foreign)
+				if foreign.R == nil {
+					foreign.R = &youtubeTVSyncAccountR{}
+				}
+				foreign.R.User = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadYoutubeTVSyncEvents allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadYoutubeTVSyncEvents(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser any, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		var ok bool
+		object, ok = maybeUser.(*User)
+		if !ok {
+			object = new(User)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeUser))
+			}
+		}
+	} else {
+		s, ok := maybeUser.(*[]*User)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeUser))
+			}
+		}
+	}
+
+	args := make(map[any]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]any, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`youtube_tv_sync_events`),
+		qm.WhereIn(`youtube_tv_sync_events.user_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load youtube_tv_sync_events")
+	}
+
+	var resultSlice []*YoutubeTVSyncEvent
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice youtube_tv_sync_events")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on youtube_tv_sync_events")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for youtube_tv_sync_events")
+	}
+
+	if len(youtubeTVSyncEventAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.YoutubeTVSyncEvents = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &youtubeTVSyncEventR{}
+			}
+			foreign.R.User = object
+		}
+		return nil
 	}
 
-	if related.R == nil {
-		related.R = &youtubeSyncAccountR{
-			User: o,
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.UserID {
+				local.R.YoutubeTVSyncEvents = append(local.R.YoutubeTVSyncEvents, foreign)
+				if foreign.R == nil {
+					foreign.R = &youtubeTVSyncEventR{}
+				}
+				foreign.R.User = local
+				break
+			}
 		}
-	} else {
-		related.R.User = o
 	}
+
 	return nil
 }
 
-// SetYoutubeTVSyncAccount of the user to the related item.
-// Sets o.R.YoutubeTVSyncAccount to related.
+// SetYoutubeSyncAccount of the user to the related item.
+// Sets o.R.YoutubeSyncAccount to related.
 // Adds o to related.R.User.
-func (o *User) SetYoutubeTVSyncAccount(ctx context.Context, exec boil.ContextExecutor, insert bool, related *YoutubeTVSyncAccount) error {
+func (o *User) SetYoutubeSyncAccount(ctx context.Context, exec boil.ContextExecutor, insert bool, related *YoutubeSyncAccount) error {
 	var err error
 
 	if insert {
@@ -1349,9 +1882,9 @@ func (o *User) SetYoutubeTVSyncAccount(ctx context.Context, exec boil.ContextExe
 		}
 	} else {
 		updateQuery := fmt.Sprintf(
-			"UPDATE \"youtube_tv_sync_accounts\" SET %s WHERE %s",
+			"UPDATE \"youtube_sync_accounts\" SET %s WHERE %s",
 			strmangle.SetParamNames("\"", "\"", 0, []string{"user_id"}),
-			strmangle.WhereClause("\"", "\"", 0, youtubeTVSyncAccountPrimaryKeyColumns),
+			strmangle.WhereClause("\"", "\"", 0, youtubeSyncAccountPrimaryKeyColumns),
 		)
 		values := []any{o.ID, related.ID}
 
@@ -1369,14 +1902,14 @@ func (o *User) SetYoutubeTVSyncAccount(ctx context.Context, exec boil.ContextExe
 
 	if o.R == nil {
 		o.R = &userR{
-			YoutubeTVSyncAccount: related,
+			YoutubeSyncAccount: related,
 		}
 	} else {
-		o.R.YoutubeTVSyncAccount = related
+		o.R.YoutubeSyncAccount = related
 	}
 
 	if related.R == nil {
-		related.R = &youtubeTVSyncAccountR{
+		related.R = &youtubeSyncAccountR{
 			User: o,
 		}
 	} else {
@@ -1491,6 +2024,59 @@ func (o *User) AddSettings(ctx context.Context, exec boil.ContextExecutor, inser
 	return nil
 }
 
+// AddSponsorblockSubmissions adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.SponsorblockSubmissions.
+// Sets related.R.User appropriately.
+func (o *User) AddSponsorblockSubmissions(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*SponsorblockSubmission) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.UserID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"sponsorblock_submissions\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 0, []string{"user_id"}),
+				strmangle.WhereClause("\"", "\"", 0, sponsorblockSubmissionPrimaryKeyColumns),
+			)
+			values := []any{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.UserID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &userR{
+			SponsorblockSubmissions: related,
+		}
+	} else {
+		o.R.SponsorblockSubmissions = append(o.R.SponsorblockSubmissions, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &sponsorblockSubmissionR{
+				User: o,
+			}
+		} else {
+			rel.R.User = o
+		}
+	}
+	return nil
+}
+
 // AddSubscriptions adds the given related objects to the existing relationships
 // of the user, optionally inserting them as new records.
 // Appends related to o.R.Subscriptions.
@@ -1597,6 +2183,218 @@ func (o *User) AddViews(ctx context.Context, exec boil.ContextExecutor, insert b
 	return nil
 }
 
+// AddWatchHistoryImports adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.WatchHistoryImports.
+// Sets related.R.User appropriately.
+func (o *User) AddWatchHistoryImports(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*WatchHistoryImport) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.UserID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"watch_history_imports\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 0, []string{"user_id"}),
+				strmangle.WhereClause("\"", "\"", 0, watchHistoryImportPrimaryKeyColumns),
+			)
+			values := []any{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.UserID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &userR{
+			WatchHistoryImports: related,
+		}
+	} else {
+		o.R.WatchHistoryImports = append(o.R.WatchHistoryImports, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &watchHistoryImportR{
+				User: o,
+			}
+		} else {
+			rel.R.User = o
+		}
+	}
+	return nil
+}
+
+// AddYoutubeTVPairingTokens adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.YoutubeTVPairingTokens.
+// Sets related.R.User appropriately.
+func (o *User) AddYoutubeTVPairingTokens(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*YoutubeTVPairingToken) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.UserID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"youtube_tv_pairing_tokens\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 0, []string{"user_id"}),
+				strmangle.WhereClause("\"", "\"", 0, youtubeTVPairingTokenPrimaryKeyColumns),
+			)
+			values := []any{o.ID, rel.TokenHash}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.UserID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &userR{
+			YoutubeTVPairingTokens: related,
+		}
+	} else {
+		o.R.YoutubeTVPairingTokens = append(o.R.YoutubeTVPairingTokens, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &youtubeTVPairingTokenR{
+				User: o,
+			}
+		} else {
+			rel.R.User = o
+		}
+	}
+	return nil
+}
+
+// AddYoutubeTVSyncAccounts adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.YoutubeTVSyncAccounts.
+// Sets related.R.User appropriately.
+func (o *User) AddYoutubeTVSyncAccounts(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*YoutubeTVSyncAccount) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.UserID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"youtube_tv_sync_accounts\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 0, []string{"user_id"}),
+				strmangle.WhereClause("\"", "\"", 0, youtubeTVSyncAccountPrimaryKeyColumns),
+			)
+			values := []any{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.UserID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &userR{
+			YoutubeTVSyncAccounts: related,
+		}
+	} else {
+		o.R.YoutubeTVSyncAccounts = append(o.R.YoutubeTVSyncAccounts, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &youtubeTVSyncAccountR{
+				User: o,
+			}
+		} else {
+			rel.R.User = o
+		}
+	}
+	return nil
+}
+
+// AddYoutubeTVSyncEvents adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.YoutubeTVSyncEvents.
+// Sets related.R.User appropriately.
+func (o *User) AddYoutubeTVSyncEvents(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*YoutubeTVSyncEvent) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.UserID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"youtube_tv_sync_events\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 0, []string{"user_id"}),
+				strmangle.WhereClause("\"", "\"", 0, youtubeTVSyncEventPrimaryKeyColumns),
+			)
+			values := []any{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.UserID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &userR{
+			YoutubeTVSyncEvents: related,
+		}
+	} else {
+		o.R.YoutubeTVSyncEvents = append(o.R.YoutubeTVSyncEvents, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &youtubeTVSyncEventR{
+				User: o,
+			}
+		} else {
+			rel.R.User = o
+		}
+	}
+	return nil
+}
+
 // Users retrieves all the records using an executor.
 func Users(mods ...qm.QueryMod) userQuery {
 	mods = append(mods, qm.From("\"users\""))
@@ -555,67 +555,6 @@ func testUserOneToOneYoutubeSyncAccountUsingYoutubeSyncAccount(t *testing.T) {
 	}
 }
 
-func testUserOneToOneYoutubeTVSyncAccountUsingYoutubeTVSyncAccount(t *testing.T) {
-	ctx := context.Background()
-	tx := MustTx(boil.BeginTx(ctx, nil))
-	defer func() { _ = tx.Rollback() }()
-
-	var foreign YoutubeTVSyncAccount
-	var local User
-
-	seed := randomize.NewSeed()
-	if err := randomize.Struct(seed, &foreign, youtubeTVSyncAccountDBTypes, true, youtubeTVSyncAccountColumnsWithDefault...); err != nil {
-		t.Errorf("Unable to randomize YoutubeTVSyncAccount struct: %s", err)
-	}
-	if err := randomize.Struct(seed, &local, userDBTypes, true, userColumnsWithDefault...); err != nil {
-		t.Errorf("Unable to randomize User struct: %s", err)
-	}
-
-	if err := local.Insert(ctx, tx, boil.Infer()); err != nil {
-		t.Fatal(err)
-	}
-
-	foreign.UserID = local.ID
-	if err := foreign.Insert(ctx, tx, boil.Infer()); err != nil {
-		t.Fatal(err)
-	}
-
-	check, err := local.YoutubeTVSyncAccount().One(ctx, tx)
-	if err != nil {
-		t.Fatal(err)
-	}
-
-	if check.UserID != foreign.UserID {
-		t.Errorf("want: %v, got %v", foreign.UserID, check.UserID)
-	}
-
-	ranAfterSelectHook := false
-	AddYoutubeTVSyncAccountHook(boil.AfterSelectHook, func(ctx context.Context, e boil.ContextExecutor, o *YoutubeTVSyncAccount) error {
-		ranAfterSelectHook = true
-		return nil
-	})
-
-	slice := UserSlice{&local}
-	if err = local.L.LoadYoutubeTVSyncAccount(ctx, tx, false, (*[]*User)(&slice), nil); err != nil {
-		t.Fatal(err)
-	}
-	if local.R.YoutubeTVSyncAccount == nil {
-		t.Error("struct should have been eager loaded")
-	}
-
-	local.R.YoutubeTVSyncAccount = nil
-	if err = local.L.LoadYoutubeTVSyncAccount(ctx, tx, true, &local, nil); err != nil {
-		t.Fatal(err)
-	}
-	if local.R.YoutubeTVSyncAccount == nil {
-		t.Error("struct should have been eager loaded")
-	}
-
-	if !ranAfterSelectHook {
-		t.Error("failed to run AfterSelect hook for relationship")
-	}
-}
-
 func testUserOneToOneSetOpYoutubeSyncAccountUsingYoutubeSyncAccount(t *testing.T) {
 	var err error
 
@@ -677,76 +616,93 @@ func testUserOneToOneSetOpYoutubeSyncAccountUsingYoutubeSyncAccount(t *testing.T
 		}
 	}
 }
-func testUserOneToOneSetOpYoutubeTVSyncAccountUsingYoutubeTVSyncAccount(t *testing.T) {
-	var err error
 
+func testUserToManyPlaylists(t *testing.T) {
+	var err error
 	ctx := context.Background()
 	tx := MustTx(boil.BeginTx(ctx, nil))
 	defer func() { _ = tx.Rollback() }()
 
 	var a User
-	var b, c YoutubeTVSyncAccount
+	var b, c Playlist
 
 	seed := randomize.NewSeed()
-	if err = randomize.Struct(seed, &a, userDBTypes, false, strmangle.SetComplement(userPrimaryKeyColumns, userColumnsWithoutDefault)...); err != nil {
+	if err = randomize.Struct(seed, &a, userDBTypes, true, userColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize User struct: %s", err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
 		t.Fatal(err)
 	}
-	if err = randomize.Struct(seed, &b, youtubeTVSyncAccountDBTypes, false, strmangle.SetComplement(youtubeTVSyncAccountPrimaryKeyColumns, youtubeTVSyncAccountColumnsWithoutDefault)...); err != nil {
+
+	if err = randomize.Struct(seed, &b, playlistDBTypes, false, playlistColumnsWithDefault...); err != nil {
 		t.Fatal(err)
 	}
-	if err = randomize.Struct(seed, &c, youtubeTVSyncAccountDBTypes, false, strmangle.SetComplement(youtubeTVSyncAccountPrimaryKeyColumns, youtubeTVSyncAccountColumnsWithoutDefault)...); err != nil {
+	if err = randomize.Struct(seed, &c, playlistDBTypes, false, playlistColumnsWithDefault...); err != nil {
 		t.Fatal(err)
 	}
 
-	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+	b.UserID = a.ID
+	c.UserID = a.ID
+
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
 		t.Fatal(err)
 	}
-	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
 		t.Fatal(err)
 	}
 
-	for i, x := range []*YoutubeTVSyncAccount{&b, &c} {
-		err = a.SetYoutubeTVSyncAccount(ctx, tx, i != 0, x)
-		if err != nil {
-			t.Fatal(err)
-		}
+	check, err := a.Playlists().All(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
 
-		if a.R.YoutubeTVSyncAccount != x {
-			t.Error("relationship struct not set to correct value")
-		}
-		if x.R.User != &a {
-			t.Error("failed to append to foreign relationship struct")
+	bFound, cFound := false, false
+	for _, v := range check {
+		if v.UserID == b.UserID {
+			bFound = true
 		}
-
-		if a.ID != x.UserID {
-			t.Error("foreign key was wrong value", a.ID)
+		if v.UserID == c.UserID {
+			cFound = true
 		}
+	}
 
-		zero := reflect.Zero(reflect.TypeOf(x.UserID))
-		reflect.Indirect(reflect.ValueOf(&x.UserID)).Set(zero)
+	if !bFound {
+		t.Error("expected to find b")
+	}
+	if !cFound {
+		t.Error("expected to find c")
+	}
 
-		if err = x.Reload(ctx, tx); err != nil {
-			t.Fatal("failed to reload", err)
-		}
+	slice := UserSlice{&a}
+	if err = a.L.LoadPlaylists(ctx, tx, false, (*[]*User)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.Playlists); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
 
-		if a.ID != x.UserID {
-			t.Error("foreign key was wrong value", a.ID, x.UserID)
-		}
+	a.R.Playlists = nil
+	if err = a.L.LoadPlaylists(ctx, tx, true, &a, nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.Playlists); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
 
-		if _, err = x.Delete(ctx, tx); err != nil {
-			t.Fatal("failed to delete x", err)
-		}
+	if t.Failed() {
+		t.Logf("%#v", check)
 	}
 }
 
-func testUserToManyPlaylists(t *testing.T) {
+func testUserToManySettings(t *testing.T) {
 	var err error
 	ctx := context.Background()
 	tx := MustTx(boil.BeginTx(ctx, nil))
 	defer func() { _ = tx.Rollback() }()
 
 	var a User
-	var b, c Playlist
+	var b, c Setting
 
 	seed := randomize.NewSeed()
 	if err = randomize.Struct(seed, &a, userDBTypes, true, userColumnsWithDefault...); err != nil {
@@ -757,10 +713,10 @@ func testUserToManyPlaylists(t *testing.T) {
 		t.Fatal(err)
 	}
 
-	if err = randomize.Struct(seed, &b, playlistDBTypes, false, playlistColumnsWithDefault...); err != nil {
+	if err = randomize.Struct(seed, &b, settingDBTypes, false, settingColumnsWithDefault...); err != nil {
 		t.Fatal(err)
 	}
-	if err = randomize.Struct(seed, &c, playlistDBTypes, false, playlistColumnsWithDefault...); err != nil {
+	if err = randomize.Struct(seed, &c, settingDBTypes, false, settingColumnsWithDefault...); err != nil {
 		t.Fatal(err)
 	}
 
@@ -774,7 +730,7 @@ func testUserToManyPlaylists(t *testing.T) {
 		t.Fatal(err)
 	}
 
-	check, err := a.Playlists().All(ctx, tx)
+	check, err := a.Settings().All(ctx, tx)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -797,18 +753,18 @@ func testUserToManyPlaylists(t *testing.T) {
 	}
 
 	slice := UserSlice{&a}
-	if err = a.L.LoadPlaylists(ctx, tx, false, (*[]*User)(&slice), nil); err != nil {
+	if err = a.L.LoadSettings(ctx, tx, false, (*[]*User)(&slice), nil); err != nil {
 		t.Fatal(err)
 	}
-	if got := len(a.R.Playlists); got != 2 {
+	if got := len(a.R.Settings); got != 2 {
 		t.Error("number of eager loaded records wrong, got:", got)
 	}
 
-	a.R.Playlists = nil
-	if err = a.L.LoadPlaylists(ctx, tx, true, &a, nil); err != nil {
+	a.R.Settings = nil
+	if err = a.L.LoadSettings(ctx, tx, true, &a, nil); err != nil {
 		t.Fatal(err)
 	}
-	if got := len(a.R.Playlists); got != 2 {
+	if got := len(a.R.Settings); got != 2 {
 		t.Error("number of eager loaded records wrong, got:", got)
 	}
 
@@ -817,14 +773,14 @@ func testUserToManyPlaylists(t *testing.T) {
 	}
 }
 
-func testUserToManySettings(t *testing.T) {
+func testUserToManySponsorblockSubmissions(t *testing.T) {
 	var err error
 	ctx := context.Background()
 	tx := MustTx(boil.BeginTx(ctx, nil))
 	defer func() { _ = tx.Rollback() }()
 
 	var a User
-	var b, c Setting
+	var b, c SponsorblockSubmission
 
 	seed := randomize.NewSeed()
 	if err = randomize.Struct(seed, &a, userDBTypes, true, userColumnsWithDefault...); err != nil {
@@ -835,10 +791,10 @@ func testUserToManySettings(t *testing.T) {
 		t.Fatal(err)
 	}
 
-	if err = randomize.Struct(seed, &b, settingDBTypes, false, settingColumnsWithDefault...); err != nil {
+	if err = randomize.Struct(seed, &b, sponsorblockSubmissionDBTypes, false, sponsorblockSubmissionColumnsWithDefault...); err != nil {
 		t.Fatal(err)
 	}
-	if err = randomize.Struct(seed, &c, settingDBTypes, false, settingColumnsWithDefault...); err != nil {
+	if err = randomize.Struct(seed, &c, sponsorblockSubmissionDBTypes, false, sponsorblockSubmissionColumnsWithDefault...); err != nil {
 		t.Fatal(err)
 	}
 
@@ -852,7 +808,7 @@ func testUserToManySettings(t *testing.T) {
 		t.Fatal(err)
 	}
 
-	check, err := a.Settings().All(ctx, tx)
+	check, err := a.SponsorblockSubmissions().All(ctx, tx)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -875,18 +831,18 @@ func testUserToManySettings(t *testing.T) {
 	}
 
 	slice := UserSlice{&a}
-	if err = a.L.LoadSettings(ctx, tx, false, (*[]*User)(&slice), nil); err != nil {
+	if err = a.L.LoadSponsorblockSubmissions(ctx, tx, false, (*[]*User)(&slice), nil); err != nil {
 		t.Fatal(err)
 	}
-	if got := len(a.R.Settings); got != 2 {
+	if got := len(a.R.SponsorblockSubmissions); got != 2 {
 		t.Error("number of eager loaded records wrong, got:", got)
 	}
 
-	a.R.Settings = nil
-	if err = a.L.LoadSettings(ctx, tx, true, &a, nil); err != nil {
+	a.R.SponsorblockSubmissions = nil
+	if err = a.L.LoadSponsorblockSubmissions(ctx, tx, true, &a, nil); err != nil {
 		t.Fatal(err)
 	}
-	if got := len(a.R.Settings); got != 2 {
+	if got := len(a.R.SponsorblockSubmissions); got != 2 {
 		t.Error("number of eager loaded records wrong, got:", got)
 	}
 
@@ -1051,30 +1007,34 @@ func testUserToManyViews(t *testing.T) {
 	}
 }
 
-func testUserToManyAddOpPlaylists(t *testing.T) {
+func testUserToManyWatchHistoryImports(t *testing.T) {
 	var err error
-
 	ctx := context.Background()
 	tx := MustTx(boil.BeginTx(ctx, nil))
 	defer func() { _ = tx.Rollback() }()
 
 	var a User
-	var b, c, d, e Playlist
+	var b, c WatchHistoryImport
 
 	seed := randomize.NewSeed()
-	if err = randomize.Struct(seed, &a, userDBTypes, false, strmangle.SetComplement(userPrimaryKeyColumns, userColumnsWithoutDefault)...); err != nil {
-		t.Fatal(err)
-	}
-	foreigners := []*Playlist{&b, &c, &d, &e}
-	for _, x := range foreigners {
-		if err = randomize.Struct(seed, x, playlistDBTypes, false, strmangle.SetComplement(playlistPrimaryKeyColumns, playlistColumnsWithoutDefault)...); err != nil {
-			t.Fatal(err)
-		}
+	if err = randomize.Struct(seed, &a, userDBTypes, true, userColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize User struct: %s", err)
 	}
 
 	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
 		t.Fatal(err)
 	}
+
+	if err = randomize.Struct(seed, &b, watchHistoryImportDBTypes, false, watchHistoryImportColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, watchHistoryImportDBTypes, false, watchHistoryImportColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+
+	b.UserID = a.ID
+	c.UserID = a.ID
+
 	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
 		t.Fatal(err)
 	}
@@ -1082,78 +1042,386 @@ func testUserToManyAddOpPlaylists(t *testing.T) {
 		t.Fatal(err)
 	}
 
-	foreignersSplitByInsertion := [][]*Playlist{
-		{&b, &c},
-		{&d, &e},
+	check, err := a.WatchHistoryImports().All(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
 	}
 
-	for i, x := range foreignersSplitByInsertion {
-		err = a.AddPlaylists(ctx, tx, i != 0, x...)
-		if err != nil {
-			t.Fatal(err)
-		}
-
-		first := x[0]
-		second := x[1]
-
-		if a.ID != first.UserID {
-			t.Error("foreign key was wrong value", a.ID, first.UserID)
+	bFound, cFound := false, false
+	for _, v := range check {
+		if v.UserID == b.UserID {
+			bFound = true
 		}
-		if a.ID != second.UserID {
-			t.Error("foreign key was wrong value", a.ID, second.UserID)
+		if v.UserID == c.UserID {
+			cFound = true
 		}
+	}
 
-		if first.R.User != &a {
-			t.Error("relationship was not added properly to the foreign slice")
-		}
-		if second.R.User != &a {
-			t.Error("relationship was not added properly to the foreign slice")
-		}
+	if !bFound {
+		t.Error("expected to find b")
+	}
+	if !cFound {
+		t.Error("expected to find c")
+	}
 
-		if a.R.Playlists[i*2] != first {
-			t.Error("relationship struct slice not set to correct value")
-		}
-		if a.R.Playlists[i*2+1] != second {
-			t.Error("relationship struct slice not set to correct value")
-		}
+	slice := UserSlice{&a}
+	if err = a.L.LoadWatchHistoryImports(ctx, tx, false, (*[]*User)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.WatchHistoryImports); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
 
-		count, err := a.Playlists().Count(ctx, tx)
-		if err != nil {
-			t.Fatal(err)
-		}
-		if want := int64((i + 1) * 2); count != want {
-			t.Error("want", want, "got", count)
-		}
+	a.R.WatchHistoryImports = nil
+	if err = a.L.LoadWatchHistoryImports(ctx, tx, true, &a, nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.WatchHistoryImports); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	if t.Failed() {
+		t.Logf("%#v", check)
 	}
 }
-func testUserToManyAddOpSettings(t *testing.T) {
-	var err error
 
+func testUserToManyYoutubeTVPairingTokens(t *testing.T) {
+	var err error
 	ctx := context.Background()
 	tx := MustTx(boil.BeginTx(ctx, nil))
 	defer func() { _ = tx.Rollback() }()
 
 	var a User
-	var b, c, d, e Setting
+	var b, c YoutubeTVPairingToken
 
 	seed := randomize.NewSeed()
-	if err = randomize.Struct(seed, &a, userDBTypes, false, strmangle.SetComplement(userPrimaryKeyColumns, userColumnsWithoutDefault)...); err != nil {
-		t.Fatal(err)
-	}
-	foreigners := []*Setting{&b, &c, &d, &e}
-	for _, x := range foreigners {
-		if err = randomize.Struct(seed, x, settingDBTypes, false, strmangle.SetComplement(settingPrimaryKeyColumns, settingColumnsWithoutDefault)...); err != nil {
-			t.Fatal(err)
-		}
+	if err = randomize.Struct(seed, &a, userDBTypes, true, userColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize User struct: %s", err)
 	}
 
 	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
 		t.Fatal(err)
 	}
-	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+
+	if err = randomize.Struct(seed, &b, youtubeTVPairingTokenDBTypes, false, youtubeTVPairingTokenColumnsWithDefault...); err != nil {
 		t.Fatal(err)
 	}
-	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+	if err = randomize.Struct(seed, &c, youtubeTVPairingTokenDBTypes, false, youtubeTVPairingTokenColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+
+	b.UserID = a.ID
+	c.UserID = a.ID
+
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := a.YoutubeTVPairingTokens().All(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	bFound, cFound := false, false
+	for _, v := range check {
+		if v.UserID == b.UserID {
+			bFound = true
+		}
+		if v.UserID == c.UserID {
+			cFound = true
+		}
+	}
+
+	if !bFound {
+		t.Error("expected to find b")
+	}
+	if !cFound {
+		t.Error("expected to find c")
+	}
+
+	slice := UserSlice{&a}
+	if err = a.L.LoadYoutubeTVPairingTokens(ctx, tx, false, (*[]*User)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.YoutubeTVPairingTokens); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	a.R.YoutubeTVPairingTokens = nil
+	if err = a.L.LoadYoutubeTVPairingTokens(ctx, tx, true, &a, nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.YoutubeTVPairingTokens); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	if t.Failed() {
+		t.Logf("%#v", check)
+	}
+}
+
+func testUserToManyYoutubeTVSyncAccounts(t *testing.T) {
+	var err error
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a User
+	var b, c YoutubeTVSyncAccount
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, userDBTypes, true, userColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize User struct: %s", err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = randomize.Struct(seed, &b, youtubeTVSyncAccountDBTypes, false, youtubeTVSyncAccountColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, youtubeTVSyncAccountDBTypes, false, youtubeTVSyncAccountColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+
+	b.UserID = a.ID
+	c.UserID = a.ID
+
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := a.YoutubeTVSyncAccounts().All(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	bFound, cFound := false, false
+	for _, v := range check {
+		if v.UserID == b.UserID {
+			bFound = true
+		}
+		if v.UserID == c.UserID {
+			cFound = true
+		}
+	}
+
+	if !bFound {
+		t.Error("expected to find b")
+	}
+	if !cFound {
+		t.Error("expected to find c")
+	}
+
+	slice := UserSlice{&a}
+	if err = a.L.LoadYoutubeTVSyncAccounts(ctx, tx, false, (*[]*User)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.YoutubeTVSyncAccounts); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	a.R.YoutubeTVSyncAccounts = nil
+	if err = a.L.LoadYoutubeTVSyncAccounts(ctx, tx, true, &a, nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.YoutubeTVSyncAccounts); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	if t.Failed() {
+		t.Logf("%#v", check)
+	}
+}
+
+func testUserToManyYoutubeTVSyncEvents(t *testing.T) {
+	var err error
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a User
+	var b, c YoutubeTVSyncEvent
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, userDBTypes, true, userColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize User struct: %s", err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = randomize.Struct(seed, &b, youtubeTVSyncEventDBTypes, false, youtubeTVSyncEventColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, youtubeTVSyncEventDBTypes, false, youtubeTVSyncEventColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+
+	b.UserID = a.ID
+	c.UserID = a.ID
+
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := a.YoutubeTVSyncEvents().All(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	bFound, cFound := false, false
+	for _, v := range check {
+		if v.UserID == b.UserID {
+			bFound = true
+		}
+		if v.UserID == c.UserID {
+			cFound = true
+		}
+	}
+
+	if !bFound {
+		t.Error("expected to find b")
+	}
+	if !cFound {
+		t.Error("expected to find c")
+	}
+
+	slice := UserSlice{&a}
+	if err = a.L.LoadYoutubeTVSyncEvents(ctx, tx, false, (*[]*User)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.YoutubeTVSyncEvents); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	a.R.YoutubeTVSyncEvents = nil
+	if err = a.L.LoadYoutubeTVSyncEvents(ctx, tx, true, &a, nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.YoutubeTVSyncEvents); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	if t.Failed() {
+		t.Logf("%#v", check)
+	}
+}
+
+func testUserToManyAddOpPlaylists(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a User
+	var b, c, d, e Playlist
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, userDBTypes, false, strmangle.SetComplement(userPrimaryKeyColumns, userColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*Playlist{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, playlistDBTypes, false, strmangle.SetComplement(playlistPrimaryKeyColumns, playlistColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	foreignersSplitByInsertion := [][]*Playlist{
+		{&b, &c},
+		{&d, &e},
+	}
+
+	for i, x := range foreignersSplitByInsertion {
+		err = a.AddPlaylists(ctx, tx, i != 0, x...)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		first := x[0]
+		second := x[1]
+
+		if a.ID != first.UserID {
+			t.Error("foreign key was wrong value", a.ID, first.UserID)
+		}
+		if a.ID != second.UserID {
+			t.Error("foreign key was wrong value", a.ID, second.UserID)
+		}
+
+		if first.R.User != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+		if second.R.User != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+
+		if a.R.Playlists[i*2] != first {
+			t.Error("relationship struct slice not set to correct value")
+		}
+		if a.R.Playlists[i*2+1] != second {
+			t.Error("relationship struct slice not set to correct value")
+		}
+
+		count, err := a.Playlists().Count(ctx, tx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if want := int64((i + 1) * 2); count != want {
+			t.Error("want", want, "got", count)
+		}
+	}
+}
+func testUserToManyAddOpSettings(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a User
+	var b, c, d, e Setting
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, userDBTypes, false, strmangle.SetComplement(userPrimaryKeyColumns, userColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*Setting{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, settingDBTypes, false, strmangle.SetComplement(settingPrimaryKeyColumns, settingColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
 		t.Fatal(err)
 	}
 
@@ -1163,7 +1431,157 @@ func testUserToManyAddOpSettings(t *testing.T) {
 	}
 
 	for i, x := range foreignersSplitByInsertion {
-		err = a.AddSettings(ctx, tx, i != 0, x...)
+		err = a.AddSettings(ctx, tx, i != 0, x...)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		first := x[0]
+		second := x[1]
+
+		if a.ID != first.UserID {
+			t.Error("foreign key was wrong value", a.ID, first.UserID)
+		}
+		if a.ID != second.UserID {
+			t.Error("foreign key was wrong value", a.ID, second.UserID)
+		}
+
+		if first.R.User != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+		if second.R.User != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+
+		if a.R.Settings[i*2] != first {
+			t.Error("relationship struct slice not set to correct value")
+		}
+		if a.R.Settings[i*2+1] != second {
+			t.Error("relationship struct slice not set to correct value")
+		}
+
+		count, err := a.Settings().Count(ctx, tx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if want := int64((i + 1) * 2); count != want {
+			t.Error("want", want, "got", count)
+		}
+	}
+}
+func testUserToManyAddOpSponsorblockSubmissions(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a User
+	var b, c, d, e SponsorblockSubmission
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, userDBTypes, false, strmangle.SetComplement(userPrimaryKeyColumns, userColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*SponsorblockSubmission{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, sponsorblockSubmissionDBTypes, false, strmangle.SetComplement(sponsorblockSubmissionPrimaryKeyColumns, sponsorblockSubmissionColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	foreignersSplitByInsertion := [][]*SponsorblockSubmission{
+		{&b, &c},
+		{&d, &e},
+	}
+
+	for i, x := range foreignersSplitByInsertion {
+		err = a.AddSponsorblockSubmissions(ctx, tx, i != 0, x...)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		first := x[0]
+		second := x[1]
+
+		if a.ID != first.UserID {
+			t.Error("foreign key was wrong value", a.ID, first.UserID)
+		}
+		if a.ID != second.UserID {
+			t.Error("foreign key was wrong value", a.ID, second.UserID)
+		}
+
+		if first.R.User != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+		if second.R.User != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+
+		if a.R.SponsorblockSubmissions[i*2] != first {
+			t.Error("relationship struct slice not set to correct value")
+		}
+		if a.R.SponsorblockSubmissions[i*2+1] != second {
+			t.Error("relationship struct slice not set to correct value")
+		}
+
+		count, err := a.SponsorblockSubmissions().Count(ctx, tx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if want := int64((i + 1) * 2); count != want {
+			t.Error("want", want, "got", count)
+		}
+	}
+}
+func testUserToManyAddOpSubscriptions(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a User
+	var b, c, d, e Subscription
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, userDBTypes, false, strmangle.SetComplement(userPrimaryKeyColumns, userColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*Subscription{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, subscriptionDBTypes, false, strmangle.SetComplement(subscriptionPrimaryKeyColumns, subscriptionColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	foreignersSplitByInsertion := [][]*Subscription{
+		{&b, &c},
+		{&d, &e},
+	}
+
+	for i, x := range foreignersSplitByInsertion {
+		err = a.AddSubscriptions(ctx, tx, i != 0, x...)
 		if err != nil {
 			t.Fatal(err)
 		}
@@ -1185,14 +1603,14 @@ func testUserToManyAddOpSettings(t *testing.T) {
 			t.Error("relationship was not added properly to the foreign slice")
 		}
 
-		if a.R.Settings[i*2] != first {
+		if a.R.Subscriptions[i*2] != first {
 			t.Error("relationship struct slice not set to correct value")
 		}
-		if a.R.Settings[i*2+1] != second {
+		if a.R.Subscriptions[i*2+1] != second {
 			t.Error("relationship struct slice not set to correct value")
 		}
 
-		count, err := a.Settings().Count(ctx, tx)
+		count, err := a.Subscriptions().Count(ctx, tx)
 		if err != nil {
 			t.Fatal(err)
 		}
@@ -1201,7 +1619,7 @@ func testUserToManyAddOpSettings(t *testing.T) {
 		}
 	}
 }
-func testUserToManyAddOpSubscriptions(t *testing.T) {
+func testUserToManyAddOpViews(t *testing.T) {
 	var err error
 
 	ctx := context.Background()
@@ -1209,15 +1627,15 @@ func testUserToManyAddOpSubscriptions(t *testing.T) {
 	defer func() { _ = tx.Rollback() }()
 
 	var a User
-	var b, c, d, e Subscription
+	var b, c, d, e View
 
 	seed := randomize.NewSeed()
 	if err = randomize.Struct(seed, &a, userDBTypes, false, strmangle.SetComplement(userPrimaryKeyColumns, userColumnsWithoutDefault)...); err != nil {
 		t.Fatal(err)
 	}
-	foreigners := []*Subscription{&b, &c, &d, &e}
+	foreigners := []*View{&b, &c, &d, &e}
 	for _, x := range foreigners {
-		if err = randomize.Struct(seed, x, subscriptionDBTypes, false, strmangle.SetComplement(subscriptionPrimaryKeyColumns, subscriptionColumnsWithoutDefault)...); err != nil {
+		if err = randomize.Struct(seed, x, viewDBTypes, false, strmangle.SetComplement(viewPrimaryKeyColumns, viewColumnsWithoutDefault)...); err != nil {
 			t.Fatal(err)
 		}
 	}
@@ -1232,13 +1650,13 @@ func testUserToManyAddOpSubscriptions(t *testing.T) {
 		t.Fatal(err)
 	}
 
-	foreignersSplitByInsertion := [][]*Subscription{
+	foreignersSplitByInsertion := [][]*View{
 		{&b, &c},
 		{&d, &e},
 	}
 
 	for i, x := range foreignersSplitByInsertion {
-		err = a.AddSubscriptions(ctx, tx, i != 0, x...)
+		err = a.AddViews(ctx, tx, i != 0, x...)
 		if err != nil {
 			t.Fatal(err)
 		}
@@ -1260,14 +1678,14 @@ func testUserToManyAddOpSubscriptions(t *testing.T) {
 			t.Error("relationship was not added properly to the foreign slice")
 		}
 
-		if a.R.Subscriptions[i*2] != first {
+		if a.R.Views[i*2] != first {
 			t.Error("relationship struct slice not set to correct value")
 		}
-		if a.R.Subscriptions[i*2+1] != second {
+		if a.R.Views[i*2+1] != second {
 			t.Error("relationship struct slice not set to correct value")
 		}
 
-		count, err := a.Subscriptions().Count(ctx, tx)
+		count, err := a.Views().Count(ctx, tx)
 		if err != nil {
 			t.Fatal(err)
 		}
@@ -1276,7 +1694,7 @@ func testUserToManyAddOpSubscriptions(t *testing.T) {
 		}
 	}
 }
-func testUserToManyAddOpViews(t *testing.T) {
+func testUserToManyAddOpWatchHistoryImports(t *testing.T) {
 	var err error
 
 	ctx := context.Background()
@@ -1284,15 +1702,15 @@ func testUserToManyAddOpViews(t *testing.T) {
 	defer func() { _ = tx.Rollback() }()
 
 	var a User
-	var b, c, d, e View
+	var b, c, d, e WatchHistoryImport
 
 	seed := randomize.NewSeed()
 	if err = randomize.Struct(seed, &a, userDBTypes, false, strmangle.SetComplement(userPrimaryKeyColumns, userColumnsWithoutDefault)...); err != nil {
 		t.Fatal(err)
 	}
-	foreigners := []*View{&b, &c, &d, &e}
+	foreigners := []*WatchHistoryImport{&b, &c, &d, &e}
 	for _, x := range foreigners {
-		if err = randomize.Struct(seed, x, viewDBTypes, false, strmangle.SetComplement(viewPrimaryKeyColumns, viewColumnsWithoutDefault)...); err != nil {
+		if err = randomize.Struct(seed, x, watchHistoryImportDBTypes, false, strmangle.SetComplement(watchHistoryImportPrimaryKeyColumns, watchHistoryImportColumnsWithoutDefault)...); err != nil {
 			t.Fatal(err)
 		}
 	}
@@ -1307,13 +1725,13 @@ func testUserToManyAddOpViews(t *testing.T) {
 		t.Fatal(err)
 	}
 
-	foreignersSplitByInsertion := [][]*View{
+	foreignersSplitByInsertion := [][]*WatchHistoryImport{
 		{&b, &c},
 		{&d, &e},
 	}
 
 	for i, x := range foreignersSplitByInsertion {
-		err = a.AddViews(ctx, tx, i != 0, x...)
+		err = a.AddWatchHistoryImports(ctx, tx, i != 0, x...)
 		if err != nil {
 			t.Fatal(err)
 		}
@@ -1335,14 +1753,239 @@ func testUserToManyAddOpViews(t *testing.T) {
 			t.Error("relationship was not added properly to the foreign slice")
 		}
 
-		if a.R.Views[i*2] != first {
+		if a.R.WatchHistoryImports[i*2] != first {
 			t.Error("relationship struct slice not set to correct value")
 		}
-		if a.R.Views[i*2+1] != second {
+		if a.R.WatchHistoryImports[i*2+1] != second {
 			t.Error("relationship struct slice not set to correct value")
 		}
 
-		count, err := a.Views().Count(ctx, tx)
+		count, err := a.WatchHistoryImports().Count(ctx, tx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if want := int64((i + 1) * 2); count != want {
+			t.Error("want", want, "got", count)
+		}
+	}
+}
+func testUserToManyAddOpYoutubeTVPairingTokens(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a User
+	var b, c, d, e YoutubeTVPairingToken
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, userDBTypes, false, strmangle.SetComplement(userPrimaryKeyColumns, userColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*YoutubeTVPairingToken{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, youtubeTVPairingTokenDBTypes, false, strmangle.SetComplement(youtubeTVPairingTokenPrimaryKeyColumns, youtubeTVPairingTokenColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	foreignersSplitByInsertion := [][]*YoutubeTVPairingToken{
+		{&b, &c},
+		{&d, &e},
+	}
+
+	for i, x := range foreignersSplitByInsertion {
+		err = a.AddYoutubeTVPairingTokens(ctx, tx, i != 0, x...)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		first := x[0]
+		second := x[1]
+
+		if a.ID != first.UserID {
+			t.Error("foreign key was wrong value", a.ID, first.UserID)
+		}
+		if a.ID != second.UserID {
+			t.Error("foreign key was wrong value", a.ID, second.UserID)
+		}
+
+		if first.R.User != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+		if second.R.User != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+
+		if a.R.YoutubeTVPairingTokens[i*2] != first {
+			t.Error("relationship struct slice not set to correct value")
+		}
+		if a.R.YoutubeTVPairingTokens[i*2+1] != second {
+			t.Error("relationship struct slice not set to correct value")
+		}
+
+		count, err := a.YoutubeTVPairingTokens().Count(ctx, tx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if want := int64((i + 1) * 2); count != want {
+			t.Error("want", want, "got", count)
+		}
+	}
+}
+func testUserToManyAddOpYoutubeTVSyncAccounts(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a User
+	var b, c, d, e YoutubeTVSyncAccount
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, userDBTypes, false, strmangle.SetComplement(userPrimaryKeyColumns, userColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*YoutubeTVSyncAccount{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, youtubeTVSyncAccountDBTypes, false, strmangle.SetComplement(youtubeTVSyncAccountPrimaryKeyColumns, youtubeTVSyncAccountColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	foreignersSplitByInsertion := [][]*YoutubeTVSyncAccount{
+		{&b, &c},
+		{&d, &e},
+	}
+
+	for i, x := range foreignersSplitByInsertion {
+		err = a.AddYoutubeTVSyncAccounts(ctx, tx, i != 0, x...)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		first := x[0]
+		second := x[1]
+
+		if a.ID != first.UserID {
+			t.Error("foreign key was wrong value", a.ID, first.UserID)
+		}
+		if a.ID != second.UserID {
+			t.Error("foreign key was wrong value", a.ID, second.UserID)
+		}
+
+		if first.R.User != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+		if second.R.User != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+
+		if a.R.YoutubeTVSyncAccounts[i*2] != first {
+			t.Error("relationship struct slice not set to correct value")
+		}
+		if a.R.YoutubeTVSyncAccounts[i*2+1] != second {
+			t.Error("relationship struct slice not set to correct value")
+		}
+
+		count, err := a.YoutubeTVSyncAccounts().Count(ctx, tx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if want := int64((i + 1) * 2); count != want {
+			t.Error("want", want, "got", count)
+		}
+	}
+}
+func testUserToManyAddOpYoutubeTVSyncEvents(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a User
+	var b, c, d, e YoutubeTVSyncEvent
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, userDBTypes, false, strmangle.SetComplement(userPrimaryKeyColumns, userColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*YoutubeTVSyncEvent{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, youtubeTVSyncEventDBTypes, false, strmangle.SetComplement(youtubeTVSyncEventPrimaryKeyColumns, youtubeTVSyncEventColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	foreignersSplitByInsertion := [][]*YoutubeTVSyncEvent{
+		{&b, &c},
+		{&d, &e},
+	}
+
+	for i, x := range foreignersSplitByInsertion {
+		err = a.AddYoutubeTVSyncEvents(ctx, tx, i != 0, x...)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		first := x[0]
+		second := x[1]
+
+		if a.ID != first.UserID {
+			t.Error("foreign key was wrong value", a.ID, first.UserID)
+		}
+		if a.ID != second.UserID {
+			t.Error("foreign key was wrong value", a.ID, second.UserID)
+		}
+
+		if first.R.User != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+		if second.R.User != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+
+		if a.R.YoutubeTVSyncEvents[i*2] != first {
+			t.Error("relationship struct slice not set to correct value")
+		}
+		if a.R.YoutubeTVSyncEvents[i*2+1] != second {
+			t.Error("relationship struct slice not set to correct value")
+		}
+
+		count, err := a.YoutubeTVSyncEvents().Count(ctx, tx)
 		if err != nil {
 			t.Fatal(err)
 		}
@@ -0,0 +1,1194 @@
+// Code generated by SQLBoiler 4.19.7 (https://github.com/aarondl/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries"
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+	"github.com/aarondl/sqlboiler/v4/queries/qmhelper"
+	"github.com/aarondl/strmangle"
+	"github.com/friendsofgo/errors"
+)
+
+// VideoChapter is an object representing the database table.
+type VideoChapter struct {
+	ID           string    `boil:"id" json:"id" toml:"id" yaml:"id"`
+	CreatedAt    time.Time `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+	UpdatedAt    time.Time `boil:"updated_at" json:"updated_at" toml:"updated_at" yaml:"updated_at"`
+	VideoID      string    `boil:"video_id" json:"video_id" toml:"video_id" yaml:"video_id"`
+	Source       string    `boil:"source" json:"source" toml:"source" yaml:"source"`
+	Position     int64     `boil:"position" json:"position" toml:"position" yaml:"position"`
+	StartSeconds int64     `boil:"start_seconds" json:"start_seconds" toml:"start_seconds" yaml:"start_seconds"`
+	EndSeconds   int64     `boil:"end_seconds" json:"end_seconds" toml:"end_seconds" yaml:"end_seconds"`
+	Title        string    `boil:"title" json:"title" toml:"title" yaml:"title"`
+
+	R *videoChapterR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L videoChapterL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var VideoChapterColumns = struct {
+	ID           string
+	CreatedAt    string
+	UpdatedAt    string
+	VideoID      string
+	Source       string
+	Position     string
+	StartSeconds string
+	EndSeconds   string
+	Title        string
+}{
+	ID:           "id",
+	CreatedAt:    "created_at",
+	UpdatedAt:    "updated_at",
+	VideoID:      "video_id",
+	Source:       "source",
+	Position:     "position",
+	StartSeconds: "start_seconds",
+	EndSeconds:   "end_seconds",
+	Title:        "title",
+}
+
+var VideoChapterTableColumns = struct {
+	ID           string
+	CreatedAt    string
+	UpdatedAt    string
+	VideoID      string
+	Source       string
+	Position     string
+	StartSeconds string
+	EndSeconds   string
+	Title        string
+}{
+	ID:           "video_chapters.id",
+	CreatedAt:    "video_chapters.created_at",
+	UpdatedAt:    "video_chapters.updated_at",
+	VideoID:      "video_chapters.video_id",
+	Source:       "video_chapters.source",
+	Position:     "video_chapters.position",
+	StartSeconds: "video_chapters.start_seconds",
+	EndSeconds:   "video_chapters.end_seconds",
+	Title:        "video_chapters.title",
+}
+
+// Generated where
+
+var VideoChapterWhere = struct {
+	ID           whereHelperstring
+	CreatedAt    whereHelpertime_Time
+	UpdatedAt    whereHelpertime_Time
+	VideoID      whereHelperstring
+	Source       whereHelperstring
+	Position     whereHelperint64
+	StartSeconds whereHelperint64
+	EndSeconds   whereHelperint64
+	Title        whereHelperstring
+}{
+	ID:           whereHelperstring{field: "\"video_chapters\".\"id\""},
+	CreatedAt:    whereHelpertime_Time{field: "\"video_chapters\".\"created_at\""},
+	UpdatedAt:    whereHelpertime_Time{field: "\"video_chapters\".\"updated_at\""},
+	VideoID:      whereHelperstring{field: "\"video_chapters\".\"video_id\""},
+	Source:       whereHelperstring{field: "\"video_chapters\".\"source\""},
+	Position:     whereHelperint64{field: "\"video_chapters\".\"position\""},
+	StartSeconds: whereHelperint64{field: "\"video_chapters\".\"start_seconds\""},
+	EndSeconds:   whereHelperint64{field: "\"video_chapters\".\"end_seconds\""},
+	Title:        whereHelperstring{field: "\"video_chapters\".\"title\""},
+}
+
+// VideoChapterRels is where relationship names are stored.
+var VideoChapterRels = struct {
+	Video string
+}{
+	Video: "Video",
+}
+
+// videoChapterR is where relationships are stored.
+type videoChapterR struct {
+	Video *Video `boil:"Video" json:"Video" toml:"Video" yaml:"Video"`
+}
+
+// NewStruct creates a new relationship struct
+func (*videoChapterR) NewStruct() *videoChapterR {
+	return &videoChapterR{}
+}
+
+func (o *VideoChapter) GetVideo() *Video {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetVideo()
+}
+
+func (r *videoChapterR) GetVideo() *Video {
+	if r == nil {
+		return nil
+	}
+
+	return r.Video
+}
+
+// videoChapterL is where Load methods for each relationship are stored.
+type videoChapterL struct{}
+
+var (
+	videoChapterAllColumns            = []string{"id", "created_at", "updated_at", "video_id", "source", "position", "start_seconds", "end_seconds", "title"}
+	videoChapterColumnsWithoutDefault = []string{"id", "created_at", "updated_at", "video_id", "source", "position", "start_seconds", "end_seconds", "title"}
+	videoChapterColumnsWithDefault    = []string{}
+	videoChapterPrimaryKeyColumns     = []string{"id"}
+	videoChapterGeneratedColumns      = []string{}
+)
+
+type (
+	// VideoChapterSlice is an alias for a slice of pointers to VideoChapter.
+	// This should almost always be used instead of []VideoChapter.
+	VideoChapterSlice []*VideoChapter
+	// VideoChapterHook is the signature for custom VideoChapter hook methods
+	VideoChapterHook func(context.Context, boil.ContextExecutor, *VideoChapter) error
+
+	videoChapterQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	videoChapterType                 = reflect.TypeOf(&VideoChapter{})
+	videoChapterMapping              = queries.MakeStructMapping(videoChapterType)
+	videoChapterPrimaryKeyMapping, _ = queries.BindMapping(videoChapterType, videoChapterMapping, videoChapterPrimaryKeyColumns)
+	videoChapterInsertCacheMut       sync.RWMutex
+	videoChapterInsertCache          = make(map[string]insertCache)
+	videoChapterUpdateCacheMut       sync.RWMutex
+	videoChapterUpdateCache          = make(map[string]updateCache)
+	videoChapterUpsertCacheMut       sync.RWMutex
+	videoChapterUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var videoChapterAfterSelectMu sync.Mutex
+var videoChapterAfterSelectHooks []VideoChapterHook
+
+var videoChapterBeforeInsertMu sync.Mutex
+var videoChapterBeforeInsertHooks []VideoChapterHook
+var videoChapterAfterInsertMu sync.Mutex
+var videoChapterAfterInsertHooks []VideoChapterHook
+
+var videoChapterBeforeUpdateMu sync.Mutex
+var videoChapterBeforeUpdateHooks []VideoChapterHook
+var videoChapterAfterUpdateMu sync.Mutex
+var videoChapterAfterUpdateHooks []VideoChapterHook
+
+var videoChapterBeforeDeleteMu sync.Mutex
+var videoChapterBeforeDeleteHooks []VideoChapterHook
+var videoChapterAfterDeleteMu sync.Mutex
+var videoChapterAfterDeleteHooks []VideoChapterHook
+
+var videoChapterBeforeUpsertMu sync.Mutex
+var videoChapterBeforeUpsertHooks []VideoChapterHook
+var videoChapterAfterUpsertMu sync.Mutex
+var videoChapterAfterUpsertHooks []VideoChapterHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *VideoChapter) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range videoChapterAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *VideoChapter) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range videoChapterBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *VideoChapter) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range videoChapterAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *VideoChapter) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range videoChapterBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *VideoChapter) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range videoChapterAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *VideoChapter) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range videoChapterBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *VideoChapter) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range videoChapterAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *VideoChapter) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range videoChapterBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *VideoChapter) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range videoChapterAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddVideoChapterHook registers your hook function for all future operations.
+func AddVideoChapterHook(hookPoint boil.HookPoint, videoChapterHook VideoChapterHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		videoChapterAfterSelectMu.Lock()
+		videoChapterAfterSelectHooks = append(videoChapterAfterSelectHooks, videoChapterHook)
+		videoChapterAfterSelectMu.Unlock()
+	case boil.BeforeInsertHook:
+		videoChapterBeforeInsertMu.Lock()
+		videoChapterBeforeInsertHooks = append(videoChapterBeforeInsertHooks, videoChapterHook)
+		videoChapterBeforeInsertMu.Unlock()
+	case boil.AfterInsertHook:
+		videoChapterAfterInsertMu.Lock()
+		videoChapterAfterInsertHooks = append(videoChapterAfterInsertHooks, videoChapterHook)
+		videoChapterAfterInsertMu.Unlock()
+	case boil.BeforeUpdateHook:
+		videoChapterBeforeUpdateMu.Lock()
+		videoChapterBeforeUpdateHooks = append(videoChapterBeforeUpdateHooks, videoChapterHook)
+		videoChapterBeforeUpdateMu.Unlock()
+	case boil.AfterUpdateHook:
+		videoChapterAfterUpdateMu.Lock()
+		videoChapterAfterUpdateHooks = append(videoChapterAfterUpdateHooks, videoChapterHook)
+		videoChapterAfterUpdateMu.Unlock()
+	case boil.BeforeDeleteHook:
+		videoChapterBeforeDeleteMu.Lock()
+		videoChapterBeforeDeleteHooks = append(videoChapterBeforeDeleteHooks, videoChapterHook)
+		videoChapterBeforeDeleteMu.Unlock()
+	case boil.AfterDeleteHook:
+		videoChapterAfterDeleteMu.Lock()
+		videoChapterAfterDeleteHooks = append(videoChapterAfterDeleteHooks, videoChapterHook)
+		videoChapterAfterDeleteMu.Unlock()
+	case boil.BeforeUpsertHook:
+		videoChapterBeforeUpsertMu.Lock()
+		videoChapterBeforeUpsertHooks = append(videoChapterBeforeUpsertHooks, videoChapterHook)
+		videoChapterBeforeUpsertMu.Unlock()
+	case boil.AfterUpsertHook:
+		videoChapterAfterUpsertMu.Lock()
+		videoChapterAfterUpsertHooks = append(videoChapterAfterUpsertHooks, videoChapterHook)
+		videoChapterAfterUpsertMu.Unlock()
+	}
+}
+
+// One returns a single videoChapter record from the query.
+func (q videoChapterQuery) One(ctx context.Context, exec boil.ContextExecutor) (*VideoChapter, error) {
+	o := &VideoChapter{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: failed to execute a one query for video_chapters")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all VideoChapter records from the query.
+func (q videoChapterQuery) All(ctx context.Context, exec boil.ContextExecutor) (VideoChapterSlice, error) {
+	var o []*VideoChapter
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "models: failed to assign all query results to VideoChapter slice")
+	}
+
+	if len(videoChapterAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all VideoChapter records in the query.
+func (q videoChapterQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to count video_chapters rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q videoChapterQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "models: failed to check if video_chapters exists")
+	}
+
+	return count > 0, nil
+}
+
+// Video pointed to by the foreign key.
+func (o *VideoChapter) Video(mods ...qm.QueryMod) videoQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.VideoID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Videos(queryMods...)
+}
+
+// LoadVideo allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (videoChapterL) LoadVideo(ctx context.Context, e boil.ContextExecutor, singular bool, maybeVideoChapter any, mods queries.Applicator) error {
+	var slice []*VideoChapter
+	var object *VideoChapter
+
+	if singular {
+		var ok bool
+		object, ok = maybeVideoChapter.(*VideoChapter)
+		if !ok {
+			object = new(VideoChapter)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeVideoChapter)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeVideoChapter))
+			}
+		}
+	} else {
+		s, ok := maybeVideoChapter.(*[]*VideoChapter)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeVideoChapter)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeVideoChapter))
+			}
+		}
+	}
+
+	args := make(map[any]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &videoChapterR{}
+		}
+		args[object.VideoID] = struct{}{}
+
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &videoChapterR{}
+			}
+
+			args[obj.VideoID] = struct{}{}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]any, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`videos`),
+		qm.WhereIn(`videos.id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load Video")
+	}
+
+	var resultSlice []*Video
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice Video")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for videos")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for videos")
+	}
+
+	if len(videoAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Video = foreign
+		if foreign.R == nil {
+			foreign.R = &videoR{}
+		}
+		foreign.R.VideoChapters = append(foreign.R.VideoChapters, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.VideoID == foreign.ID {
+				local.R.Video = foreign
+				if foreign.R == nil {
+					foreign.R = &videoR{}
+				}
+				foreign.R.VideoChapters = append(foreign.R.VideoChapters, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetVideo of the videoChapter to the related item.
+// Sets o.R.Video to related.
+// Adds o to related.R.VideoChapters.
+func (o *VideoChapter) SetVideo(ctx context.Context, exec boil.ContextExecutor, insert bool, related *Video) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"video_chapters\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 0, []string{"video_id"}),
+		strmangle.WhereClause("\"", "\"", 0, videoChapterPrimaryKeyColumns),
+	)
+	values := []any{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.VideoID = related.ID
+	if o.R == nil {
+		o.R = &videoChapterR{
+			Video: related,
+		}
+	} else {
+		o.R.Video = related
+	}
+
+	if related.R == nil {
+		related.R = &videoR{
+			VideoChapters: VideoChapterSlice{o},
+		}
+	} else {
+		related.R.VideoChapters = append(related.R.VideoChapters, o)
+	}
+
+	return nil
+}
+
+// VideoChapters retrieves all the records using an executor.
+func VideoChapters(mods ...qm.QueryMod) videoChapterQuery {
+	mods = append(mods, qm.From("\"video_chapters\""))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"\"video_chapters\".*"})
+	}
+
+	return videoChapterQuery{q}
+}
+
+// FindVideoChapter retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindVideoChapter(ctx context.Context, exec boil.ContextExecutor, iD string, selectCols ...string) (*VideoChapter, error) {
+	videoChapterObj := &VideoChapter{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"video_chapters\" where \"id\"=?", sel,
+	)
+
+	q := queries.Raw(query, iD)
+
+	err := q.Bind(ctx, exec, videoChapterObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: unable to select from video_chapters")
+	}
+
+	if err = videoChapterObj.doAfterSelectHooks(ctx, exec); err != nil {
+		return videoChapterObj, err
+	}
+
+	return videoChapterObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *VideoChapter) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no video_chapters provided for insertion")
+	}
+
+	var err error
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+		if o.UpdatedAt.IsZero() {
+			o.UpdatedAt = currTime
+		}
+	}
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(videoChapterColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	videoChapterInsertCacheMut.RLock()
+	cache, cached := videoChapterInsertCache[key]
+	videoChapterInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			videoChapterAllColumns,
+			videoChapterColumnsWithDefault,
+			videoChapterColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(videoChapterType, videoChapterMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(videoChapterType, videoChapterMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"video_chapters\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"video_chapters\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "models: unable to insert into video_chapters")
+	}
+
+	if !cached {
+		videoChapterInsertCacheMut.Lock()
+		videoChapterInsertCache[key] = cache
+		videoChapterInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// Update uses an executor to update the VideoChapter.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *VideoChapter) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		o.UpdatedAt = currTime
+	}
+
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	videoChapterUpdateCacheMut.RLock()
+	cache, cached := videoChapterUpdateCache[key]
+	videoChapterUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			videoChapterAllColumns,
+			videoChapterPrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("models: unable to update video_chapters, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"video_chapters\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 0, wl),
+			strmangle.WhereClause("\"", "\"", 0, videoChapterPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(videoChapterType, videoChapterMapping, append(wl, videoChapterPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update video_chapters row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by update for video_chapters")
+	}
+
+	if !cached {
+		videoChapterUpdateCacheMut.Lock()
+		videoChapterUpdateCache[key] = cache
+		videoChapterUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q videoChapterQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all for video_chapters")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected for video_chapters")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o VideoChapterSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("models: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]any, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), videoChapterPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"video_chapters\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 0, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, videoChapterPrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all in videoChapter slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected all in update all videoChapter")
+	}
+	return rowsAff, nil
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *VideoChapter) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no video_chapters provided for upsert")
+	}
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+		o.UpdatedAt = currTime
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(videoChapterColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	videoChapterUpsertCacheMut.RLock()
+	cache, cached := videoChapterUpsertCache[key]
+	videoChapterUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, _ := insertColumns.InsertColumnSet(
+			videoChapterAllColumns,
+			videoChapterColumnsWithDefault,
+			videoChapterColumnsWithoutDefault,
+			nzDefaults,
+		)
+		update := updateColumns.UpdateColumnSet(
+			videoChapterAllColumns,
+			videoChapterPrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("models: unable to upsert video_chapters, could not build update column list")
+		}
+
+		ret := strmangle.SetComplement(videoChapterAllColumns, strmangle.SetIntersect(insert, update))
+
+		conflict := conflictColumns
+		if len(conflict) == 0 {
+			conflict = make([]string, len(videoChapterPrimaryKeyColumns))
+			copy(conflict, videoChapterPrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQuerySQLite(dialect, "\"video_chapters\"", updateOnConflict, ret, update, conflict, insert)
+
+		cache.valueMapping, err = queries.BindMapping(videoChapterType, videoChapterMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(videoChapterType, videoChapterMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []any
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if errors.Is(err, sql.ErrNoRows) {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "models: unable to upsert video_chapters")
+	}
+
+	if !cached {
+		videoChapterUpsertCacheMut.Lock()
+		videoChapterUpsertCache[key] = cache
+		videoChapterUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// Delete deletes a single VideoChapter record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *VideoChapter) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("models: no VideoChapter provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), videoChapterPrimaryKeyMapping)
+	sql := "DELETE FROM \"video_chapters\" WHERE \"id\"=?"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete from video_chapters")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by delete for video_chapters")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q videoChapterQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("models: no videoChapterQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from video_chapters")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for video_chapters")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o VideoChapterSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(videoChapterBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []any
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), videoChapterPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"video_chapters\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, videoChapterPrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from videoChapter slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for video_chapters")
+	}
+
+	if len(videoChapterAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *VideoChapter) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindVideoChapter(ctx, exec, o.ID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *VideoChapterSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := VideoChapterSlice{}
+	var args []any
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), videoChapterPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"video_chapters\".* FROM \"video_chapters\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, videoChapterPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to reload all in VideoChapterSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// VideoChapterExists checks if the VideoChapter row exists.
+func VideoChapterExists(ctx context.Context, exec boil.ContextExecutor, iD string) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"video_chapters\" where \"id\"=? limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, iD)
+	}
+	row := exec.QueryRowContext(ctx, sql, iD)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "models: unable to check if video_chapters exists")
+	}
+
+	return exists, nil
+}
+
+// Exists checks if the VideoChapter row exists.
+func (o *VideoChapter) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	return VideoChapterExists(ctx, exec, o.ID)
+}
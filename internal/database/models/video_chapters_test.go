@@ -0,0 +1,850 @@
+// Code generated by SQLBoiler 4.19.7 (https://github.com/aarondl/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"bytes"
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/aarondl/randomize"
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries"
+	"github.com/aarondl/strmangle"
+)
+
+var (
+	// Relationships sometimes use the reflection helper queries.Equal/queries.Assign
+	// so force a package dependency in case they don't.
+	_ = queries.Equal
+)
+
+func testVideoChapters(t *testing.T) {
+	t.Parallel()
+
+	query := VideoChapters()
+
+	if query.Query == nil {
+		t.Error("expected a query, got nothing")
+	}
+}
+
+func testVideoChaptersDelete(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &VideoChapter{}
+	if err = randomize.Struct(seed, o, videoChapterDBTypes, true, videoChapterColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize VideoChapter struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := o.Delete(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := VideoChapters().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testVideoChaptersQueryDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &VideoChapter{}
+	if err = randomize.Struct(seed, o, videoChapterDBTypes, true, videoChapterColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize VideoChapter struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := VideoChapters().DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := VideoChapters().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testVideoChaptersSliceDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &VideoChapter{}
+	if err = randomize.Struct(seed, o, videoChapterDBTypes, true, videoChapterColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize VideoChapter struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := VideoChapterSlice{o}
+
+	if rowsAff, err := slice.DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := VideoChapters().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testVideoChaptersExists(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &VideoChapter{}
+	if err = randomize.Struct(seed, o, videoChapterDBTypes, true, videoChapterColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize VideoChapter struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	e, err := VideoChapterExists(ctx, tx, o.ID)
+	if err != nil {
+		t.Errorf("Unable to check if VideoChapter exists: %s", err)
+	}
+	if !e {
+		t.Errorf("Expected VideoChapterExists to return true, but got false.")
+	}
+}
+
+func testVideoChaptersFind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &VideoChapter{}
+	if err = randomize.Struct(seed, o, videoChapterDBTypes, true, videoChapterColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize VideoChapter struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	videoChapterFound, err := FindVideoChapter(ctx, tx, o.ID)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if videoChapterFound == nil {
+		t.Error("want a record, got nil")
+	}
+}
+
+func testVideoChaptersBind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &VideoChapter{}
+	if err = randomize.Struct(seed, o, videoChapterDBTypes, true, videoChapterColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize VideoChapter struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = VideoChapters().Bind(ctx, tx, o); err != nil {
+		t.Error(err)
+	}
+}
+
+func testVideoChaptersOne(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &VideoChapter{}
+	if err = randomize.Struct(seed, o, videoChapterDBTypes, true, videoChapterColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize VideoChapter struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if x, err := VideoChapters().One(ctx, tx); err != nil {
+		t.Error(err)
+	} else if x == nil {
+		t.Error("expected to get a non nil record")
+	}
+}
+
+func testVideoChaptersAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	videoChapterOne := &VideoChapter{}
+	videoChapterTwo := &VideoChapter{}
+	if err = randomize.Struct(seed, videoChapterOne, videoChapterDBTypes, false, videoChapterColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize VideoChapter struct: %s", err)
+	}
+	if err = randomize.Struct(seed, videoChapterTwo, videoChapterDBTypes, false, videoChapterColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize VideoChapter struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = videoChapterOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = videoChapterTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := VideoChapters().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 2 {
+		t.Error("want 2 records, got:", len(slice))
+	}
+}
+
+func testVideoChaptersCount(t *testing.T) {
+	t.Parallel()
+
+	var err error
+	seed := randomize.NewSeed()
+	videoChapterOne := &VideoChapter{}
+	videoChapterTwo := &VideoChapter{}
+	if err = randomize.Struct(seed, videoChapterOne, videoChapterDBTypes, false, videoChapterColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize VideoChapter struct: %s", err)
+	}
+	if err = randomize.Struct(seed, videoChapterTwo, videoChapterDBTypes, false, videoChapterColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize VideoChapter struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = videoChapterOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = videoChapterTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := VideoChapters().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 2 {
+		t.Error("want 2 records, got:", count)
+	}
+}
+
+func videoChapterBeforeInsertHook(ctx context.Context, e boil.ContextExecutor, o *VideoChapter) error {
+	*o = VideoChapter{}
+	return nil
+}
+
+func videoChapterAfterInsertHook(ctx context.Context, e boil.ContextExecutor, o *VideoChapter) error {
+	*o = VideoChapter{}
+	return nil
+}
+
+func videoChapterAfterSelectHook(ctx context.Context, e boil.ContextExecutor, o *VideoChapter) error {
+	*o = VideoChapter{}
+	return nil
+}
+
+func videoChapterBeforeUpdateHook(ctx context.Context, e boil.ContextExecutor, o *VideoChapter) error {
+	*o = VideoChapter{}
+	return nil
+}
+
+func videoChapterAfterUpdateHook(ctx context.Context, e boil.ContextExecutor, o *VideoChapter) error {
+	*o = VideoChapter{}
+	return nil
+}
+
+func videoChapterBeforeDeleteHook(ctx context.Context, e boil.ContextExecutor, o *VideoChapter) error {
+	*o = VideoChapter{}
+	return nil
+}
+
+func videoChapterAfterDeleteHook(ctx context.Context, e boil.ContextExecutor, o *VideoChapter) error {
+	*o = VideoChapter{}
+	return nil
+}
+
+func videoChapterBeforeUpsertHook(ctx context.Context, e boil.ContextExecutor, o *VideoChapter) error {
+	*o = VideoChapter{}
+	return nil
+}
+
+func videoChapterAfterUpsertHook(ctx context.Context, e boil.ContextExecutor, o *VideoChapter) error {
+	*o = VideoChapter{}
+	return nil
+}
+
+func testVideoChaptersHooks(t *testing.T) {
+	t.Parallel()
+
+	var err error
+
+	ctx := context.Background()
+	empty := &VideoChapter{}
+	o := &VideoChapter{}
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, o, videoChapterDBTypes, false); err != nil {
+		t.Errorf("Unable to randomize VideoChapter object: %s", err)
+	}
+
+	AddVideoChapterHook(boil.BeforeInsertHook, videoChapterBeforeInsertHook)
+	if err = o.doBeforeInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeInsertHook function to empty object, but got: %#v", o)
+	}
+	videoChapterBeforeInsertHooks = []VideoChapterHook{}
+
+	AddVideoChapterHook(boil.AfterInsertHook, videoChapterAfterInsertHook)
+	if err = o.doAfterInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterInsertHook function to empty object, but got: %#v", o)
+	}
+	videoChapterAfterInsertHooks = []VideoChapterHook{}
+
+	AddVideoChapterHook(boil.AfterSelectHook, videoChapterAfterSelectHook)
+	if err = o.doAfterSelectHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterSelectHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterSelectHook function to empty object, but got: %#v", o)
+	}
+	videoChapterAfterSelectHooks = []VideoChapterHook{}
+
+	AddVideoChapterHook(boil.BeforeUpdateHook, videoChapterBeforeUpdateHook)
+	if err = o.doBeforeUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpdateHook function to empty object, but got: %#v", o)
+	}
+	videoChapterBeforeUpdateHooks = []VideoChapterHook{}
+
+	AddVideoChapterHook(boil.AfterUpdateHook, videoChapterAfterUpdateHook)
+	if err = o.doAfterUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpdateHook function to empty object, but got: %#v", o)
+	}
+	videoChapterAfterUpdateHooks = []VideoChapterHook{}
+
+	AddVideoChapterHook(boil.BeforeDeleteHook, videoChapterBeforeDeleteHook)
+	if err = o.doBeforeDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeDeleteHook function to empty object, but got: %#v", o)
+	}
+	videoChapterBeforeDeleteHooks = []VideoChapterHook{}
+
+	AddVideoChapterHook(boil.AfterDeleteHook, videoChapterAfterDeleteHook)
+	if err = o.doAfterDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterDeleteHook function to empty object, but got: %#v", o)
+	}
+	videoChapterAfterDeleteHooks = []VideoChapterHook{}
+
+	AddVideoChapterHook(boil.BeforeUpsertHook, videoChapterBeforeUpsertHook)
+	if err = o.doBeforeUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpsertHook function to empty object, but got: %#v", o)
+	}
+	videoChapterBeforeUpsertHooks = []VideoChapterHook{}
+
+	AddVideoChapterHook(boil.AfterUpsertHook, videoChapterAfterUpsertHook)
+	if err = o.doAfterUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpsertHook function to empty object, but got: %#v", o)
+	}
+	videoChapterAfterUpsertHooks = []VideoChapterHook{}
+}
+
+func testVideoChaptersInsert(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &VideoChapter{}
+	if err = randomize.Struct(seed, o, videoChapterDBTypes, true, videoChapterColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize VideoChapter struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := VideoChapters().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testVideoChaptersInsertWhitelist(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &VideoChapter{}
+	if err = randomize.Struct(seed, o, videoChapterDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize VideoChapter struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Whitelist(strmangle.SetMerge(videoChapterPrimaryKeyColumns, videoChapterColumnsWithoutDefault)...)); err != nil {
+		t.Error(err)
+	}
+
+	count, err := VideoChapters().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testVideoChapterToOneVideoUsingVideo(t *testing.T) {
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var local VideoChapter
+	var foreign Video
+
+	seed := randomize.NewSeed()
+	if err := randomize.Struct(seed, &local, videoChapterDBTypes, false, videoChapterColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize VideoChapter struct: %s", err)
+	}
+	if err := randomize.Struct(seed, &foreign, videoDBTypes, false, videoColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Video struct: %s", err)
+	}
+
+	if err := foreign.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	local.VideoID = foreign.ID
+	if err := local.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := local.Video().One(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if check.ID != foreign.ID {
+		t.Errorf("want: %v, got %v", foreign.ID, check.ID)
+	}
+
+	ranAfterSelectHook := false
+	AddVideoHook(boil.AfterSelectHook, func(ctx context.Context, e boil.ContextExecutor, o *Video) error {
+		ranAfterSelectHook = true
+		return nil
+	})
+
+	slice := VideoChapterSlice{&local}
+	if err = local.L.LoadVideo(ctx, tx, false, (*[]*VideoChapter)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.Video == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	local.R.Video = nil
+	if err = local.L.LoadVideo(ctx, tx, true, &local, nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.Video == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	if !ranAfterSelectHook {
+		t.Error("failed to run AfterSelect hook for relationship")
+	}
+}
+
+func testVideoChapterToOneSetOpVideoUsingVideo(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a VideoChapter
+	var b, c Video
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, videoChapterDBTypes, false, strmangle.SetComplement(videoChapterPrimaryKeyColumns, videoChapterColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, videoDBTypes, false, strmangle.SetComplement(videoPrimaryKeyColumns, videoColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, videoDBTypes, false, strmangle.SetComplement(videoPrimaryKeyColumns, videoColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	for i, x := range []*Video{&b, &c} {
+		err = a.SetVideo(ctx, tx, i != 0, x)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if a.R.Video != x {
+			t.Error("relationship struct not set to correct value")
+		}
+
+		if x.R.VideoChapters[0] != &a {
+			t.Error("failed to append to foreign relationship struct")
+		}
+		if a.VideoID != x.ID {
+			t.Error("foreign key was wrong value", a.VideoID)
+		}
+
+		zero := reflect.Zero(reflect.TypeOf(a.VideoID))
+		reflect.Indirect(reflect.ValueOf(&a.VideoID)).Set(zero)
+
+		if err = a.Reload(ctx, tx); err != nil {
+			t.Fatal("failed to reload", err)
+		}
+
+		if a.VideoID != x.ID {
+			t.Error("foreign key was wrong value", a.VideoID, x.ID)
+		}
+	}
+}
+
+func testVideoChaptersReload(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &VideoChapter{}
+	if err = randomize.Struct(seed, o, videoChapterDBTypes, true, videoChapterColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize VideoChapter struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = o.Reload(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testVideoChaptersReloadAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &VideoChapter{}
+	if err = randomize.Struct(seed, o, videoChapterDBTypes, true, videoChapterColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize VideoChapter struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := VideoChapterSlice{o}
+
+	if err = slice.ReloadAll(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testVideoChaptersSelect(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &VideoChapter{}
+	if err = randomize.Struct(seed, o, videoChapterDBTypes, true, videoChapterColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize VideoChapter struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := VideoChapters().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 1 {
+		t.Error("want one record, got:", len(slice))
+	}
+}
+
+var (
+	videoChapterDBTypes = map[string]string{`ID`: `TEXT`, `CreatedAt`: `DATE`, `UpdatedAt`: `DATE`, `VideoID`: `TEXT`, `Source`: `TEXT`, `Position`: `INTEGER`, `StartSeconds`: `INTEGER`, `EndSeconds`: `INTEGER`, `Title`: `TEXT`}
+	_                   = bytes.MinRead
+)
+
+func testVideoChaptersUpdate(t *testing.T) {
+	t.Parallel()
+
+	if 0 == len(videoChapterPrimaryKeyColumns) {
+		t.Skip("Skipping table with no primary key columns")
+	}
+	if len(videoChapterAllColumns) == len(videoChapterPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &VideoChapter{}
+	if err = randomize.Struct(seed, o, videoChapterDBTypes, true, videoChapterColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize VideoChapter struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := VideoChapters().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, videoChapterDBTypes, true, videoChapterPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize VideoChapter struct: %s", err)
+	}
+
+	if rowsAff, err := o.Update(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only affect one row but affected", rowsAff)
+	}
+}
+
+func testVideoChaptersSliceUpdateAll(t *testing.T) {
+	t.Parallel()
+
+	if len(videoChapterAllColumns) == len(videoChapterPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &VideoChapter{}
+	if err = randomize.Struct(seed, o, videoChapterDBTypes, true, videoChapterColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize VideoChapter struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := VideoChapters().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, videoChapterDBTypes, true, videoChapterPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize VideoChapter struct: %s", err)
+	}
+
+	// Remove Primary keys and unique columns from what we plan to update
+	var fields []string
+	if strmangle.StringSliceMatch(videoChapterAllColumns, videoChapterPrimaryKeyColumns) {
+		fields = videoChapterAllColumns
+	} else {
+		fields = strmangle.SetComplement(
+			videoChapterAllColumns,
+			videoChapterPrimaryKeyColumns,
+		)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	typ := reflect.TypeOf(o).Elem()
+	n := typ.NumField()
+
+	updateMap := M{}
+	for _, col := range fields {
+		for i := 0; i < n; i++ {
+			f := typ.Field(i)
+			if f.Tag.Get("boil") == col {
+				updateMap[col] = value.Field(i).Interface()
+			}
+		}
+	}
+
+	slice := VideoChapterSlice{o}
+	if rowsAff, err := slice.UpdateAll(ctx, tx, updateMap); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("wanted one record updated but got", rowsAff)
+	}
+}
+
+func testVideoChaptersUpsert(t *testing.T) {
+	t.Parallel()
+	if len(videoChapterAllColumns) == len(videoChapterPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	// Attempt the INSERT side of an UPSERT
+	o := VideoChapter{}
+	if err = randomize.Struct(seed, &o, videoChapterDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize VideoChapter struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Upsert(ctx, tx, false, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert VideoChapter: %s", err)
+	}
+
+	count, err := VideoChapters().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	// Attempt the UPDATE side of an UPSERT
+	if err = randomize.Struct(seed, &o, videoChapterDBTypes, false, videoChapterPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize VideoChapter struct: %s", err)
+	}
+
+	if err = o.Upsert(ctx, tx, true, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert VideoChapter: %s", err)
+	}
+
+	count, err = VideoChapters().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
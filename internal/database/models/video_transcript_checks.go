@@ -0,0 +1,1128 @@
+// Code generated by SQLBoiler 4.19.7 (https://github.com/aarondl/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries"
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+	"github.com/aarondl/sqlboiler/v4/queries/qmhelper"
+	"github.com/aarondl/strmangle"
+	"github.com/friendsofgo/errors"
+)
+
+// VideoTranscriptCheck is an object representing the database table.
+type VideoTranscriptCheck struct {
+	VideoID    string    `boil:"video_id" json:"video_id" toml:"video_id" yaml:"video_id"`
+	CheckedAt  time.Time `boil:"checked_at" json:"checked_at" toml:"checked_at" yaml:"checked_at"`
+	TrackCount int64     `boil:"track_count" json:"track_count" toml:"track_count" yaml:"track_count"`
+
+	R *videoTranscriptCheckR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L videoTranscriptCheckL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var VideoTranscriptCheckColumns = struct {
+	VideoID    string
+	CheckedAt  string
+	TrackCount string
+}{
+	VideoID:    "video_id",
+	CheckedAt:  "checked_at",
+	TrackCount: "track_count",
+}
+
+var VideoTranscriptCheckTableColumns = struct {
+	VideoID    string
+	CheckedAt  string
+	TrackCount string
+}{
+	VideoID:    "video_transcript_checks.video_id",
+	CheckedAt:  "video_transcript_checks.checked_at",
+	TrackCount: "video_transcript_checks.track_count",
+}
+
+// Generated where
+
+var VideoTranscriptCheckWhere = struct {
+	VideoID    whereHelperstring
+	CheckedAt  whereHelpertime_Time
+	TrackCount whereHelperint64
+}{
+	VideoID:    whereHelperstring{field: "\"video_transcript_checks\".\"video_id\""},
+	CheckedAt:  whereHelpertime_Time{field: "\"video_transcript_checks\".\"checked_at\""},
+	TrackCount: whereHelperint64{field: "\"video_transcript_checks\".\"track_count\""},
+}
+
+// VideoTranscriptCheckRels is where relationship names are stored.
+var VideoTranscriptCheckRels = struct {
+	Video string
+}{
+	Video: "Video",
+}
+
+// videoTranscriptCheckR is where relationships are stored.
+type videoTranscriptCheckR struct {
+	Video *Video `boil:"Video" json:"Video" toml:"Video" yaml:"Video"`
+}
+
+// NewStruct creates a new relationship struct
+func (*videoTranscriptCheckR) NewStruct() *videoTranscriptCheckR {
+	return &videoTranscriptCheckR{}
+}
+
+func (o *VideoTranscriptCheck) GetVideo() *Video {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetVideo()
+}
+
+func (r *videoTranscriptCheckR) GetVideo() *Video {
+	if r == nil {
+		return nil
+	}
+
+	return r.Video
+}
+
+// videoTranscriptCheckL is where Load methods for each relationship are stored.
+type videoTranscriptCheckL struct{}
+
+var (
+	videoTranscriptCheckAllColumns            = []string{"video_id", "checked_at", "track_count"}
+	videoTranscriptCheckColumnsWithoutDefault = []string{"video_id", "checked_at", "track_count"}
+	videoTranscriptCheckColumnsWithDefault    = []string{}
+	videoTranscriptCheckPrimaryKeyColumns     = []string{"video_id"}
+	videoTranscriptCheckGeneratedColumns      = []string{}
+)
+
+type (
+	// VideoTranscriptCheckSlice is an alias for a slice of pointers to VideoTranscriptCheck.
+	// This should almost always be used instead of []VideoTranscriptCheck.
+	VideoTranscriptCheckSlice []*VideoTranscriptCheck
+	// VideoTranscriptCheckHook is the signature for custom VideoTranscriptCheck hook methods
+	VideoTranscriptCheckHook func(context.Context, boil.ContextExecutor, *VideoTranscriptCheck) error
+
+	videoTranscriptCheckQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	videoTranscriptCheckType                 = reflect.TypeOf(&VideoTranscriptCheck{})
+	videoTranscriptCheckMapping              = queries.MakeStructMapping(videoTranscriptCheckType)
+	videoTranscriptCheckPrimaryKeyMapping, _ = queries.BindMapping(videoTranscriptCheckType, videoTranscriptCheckMapping, videoTranscriptCheckPrimaryKeyColumns)
+	videoTranscriptCheckInsertCacheMut       sync.RWMutex
+	videoTranscriptCheckInsertCache          = make(map[string]insertCache)
+	videoTranscriptCheckUpdateCacheMut       sync.RWMutex
+	videoTranscriptCheckUpdateCache          = make(map[string]updateCache)
+	videoTranscriptCheckUpsertCacheMut       sync.RWMutex
+	videoTranscriptCheckUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var videoTranscriptCheckAfterSelectMu sync.Mutex
+var videoTranscriptCheckAfterSelectHooks []VideoTranscriptCheckHook
+
+var videoTranscriptCheckBeforeInsertMu sync.Mutex
+var videoTranscriptCheckBeforeInsertHooks []VideoTranscriptCheckHook
+var videoTranscriptCheckAfterInsertMu sync.Mutex
+var videoTranscriptCheckAfterInsertHooks []VideoTranscriptCheckHook
+
+var videoTranscriptCheckBeforeUpdateMu sync.Mutex
+var videoTranscriptCheckBeforeUpdateHooks []VideoTranscriptCheckHook
+var videoTranscriptCheckAfterUpdateMu sync.Mutex
+var videoTranscriptCheckAfterUpdateHooks []VideoTranscriptCheckHook
+
+var videoTranscriptCheckBeforeDeleteMu sync.Mutex
+var videoTranscriptCheckBeforeDeleteHooks []VideoTranscriptCheckHook
+var videoTranscriptCheckAfterDeleteMu sync.Mutex
+var videoTranscriptCheckAfterDeleteHooks []VideoTranscriptCheckHook
+
+var videoTranscriptCheckBeforeUpsertMu sync.Mutex
+var videoTranscriptCheckBeforeUpsertHooks []VideoTranscriptCheckHook
+var videoTranscriptCheckAfterUpsertMu sync.Mutex
+var videoTranscriptCheckAfterUpsertHooks []VideoTranscriptCheckHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *VideoTranscriptCheck) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range videoTranscriptCheckAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *VideoTranscriptCheck) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range videoTranscriptCheckBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *VideoTranscriptCheck) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range videoTranscriptCheckAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *VideoTranscriptCheck) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range videoTranscriptCheckBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *VideoTranscriptCheck) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range videoTranscriptCheckAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *VideoTranscriptCheck) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range videoTranscriptCheckBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *VideoTranscriptCheck) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range videoTranscriptCheckAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *VideoTranscriptCheck) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range videoTranscriptCheckBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *VideoTranscriptCheck) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range videoTranscriptCheckAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddVideoTranscriptCheckHook registers your hook function for all future operations.
+func AddVideoTranscriptCheckHook(hookPoint boil.HookPoint, videoTranscriptCheckHook VideoTranscriptCheckHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		videoTranscriptCheckAfterSelectMu.Lock()
+		videoTranscriptCheckAfterSelectHooks = append(videoTranscriptCheckAfterSelectHooks, videoTranscriptCheckHook)
+		videoTranscriptCheckAfterSelectMu.Unlock()
+	case boil.BeforeInsertHook:
+		videoTranscriptCheckBeforeInsertMu.Lock()
+		videoTranscriptCheckBeforeInsertHooks = append(videoTranscriptCheckBeforeInsertHooks, videoTranscriptCheckHook)
+		videoTranscriptCheckBeforeInsertMu.Unlock()
+	case boil.AfterInsertHook:
+		videoTranscriptCheckAfterInsertMu.Lock()
+		videoTranscriptCheckAfterInsertHooks = append(videoTranscriptCheckAfterInsertHooks, videoTranscriptCheckHook)
+		videoTranscriptCheckAfterInsertMu.Unlock()
+	case boil.BeforeUpdateHook:
+		videoTranscriptCheckBeforeUpdateMu.Lock()
+		videoTranscriptCheckBeforeUpdateHooks = append(videoTranscriptCheckBeforeUpdateHooks, videoTranscriptCheckHook)
+		videoTranscriptCheckBeforeUpdateMu.Unlock()
+	case boil.AfterUpdateHook:
+		videoTranscriptCheckAfterUpdateMu.Lock()
+		videoTranscriptCheckAfterUpdateHooks = append(videoTranscriptCheckAfterUpdateHooks, videoTranscriptCheckHook)
+		videoTranscriptCheckAfterUpdateMu.Unlock()
+	case boil.BeforeDeleteHook:
+		videoTranscriptCheckBeforeDeleteMu.Lock()
+		videoTranscriptCheckBeforeDeleteHooks = append(videoTranscriptCheckBeforeDeleteHooks, videoTranscriptCheckHook)
+		videoTranscriptCheckBeforeDeleteMu.Unlock()
+	case boil.AfterDeleteHook:
+		videoTranscriptCheckAfterDeleteMu.Lock()
+		videoTranscriptCheckAfterDeleteHooks = append(videoTranscriptCheckAfterDeleteHooks, videoTranscriptCheckHook)
+		videoTranscriptCheckAfterDeleteMu.Unlock()
+	case boil.BeforeUpsertHook:
+		videoTranscriptCheckBeforeUpsertMu.Lock()
+		videoTranscriptCheckBeforeUpsertHooks = append(videoTranscriptCheckBeforeUpsertHooks, videoTranscriptCheckHook)
+		videoTranscriptCheckBeforeUpsertMu.Unlock()
+	case boil.AfterUpsertHook:
+		videoTranscriptCheckAfterUpsertMu.Lock()
+		videoTranscriptCheckAfterUpsertHooks = append(videoTranscriptCheckAfterUpsertHooks, videoTranscriptCheckHook)
+		videoTranscriptCheckAfterUpsertMu.Unlock()
+	}
+}
+
+// One returns a single videoTranscriptCheck record from the query.
+func (q videoTranscriptCheckQuery) One(ctx context.Context, exec boil.ContextExecutor) (*VideoTranscriptCheck, error) {
+	o := &VideoTranscriptCheck{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: failed to execute a one query for video_transcript_checks")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all VideoTranscriptCheck records from the query.
+func (q videoTranscriptCheckQuery) All(ctx context.Context, exec boil.ContextExecutor) (VideoTranscriptCheckSlice, error) {
+	var o []*VideoTranscriptCheck
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "models: failed to assign all query results to VideoTranscriptCheck slice")
+	}
+
+	if len(videoTranscriptCheckAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all VideoTranscriptCheck records in the query.
+func (q videoTranscriptCheckQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to count video_transcript_checks rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q videoTranscriptCheckQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "models: failed to check if video_transcript_checks exists")
+	}
+
+	return count > 0, nil
+}
+
+// Video pointed to by the foreign key.
+func (o *VideoTranscriptCheck) Video(mods ...qm.QueryMod) videoQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.VideoID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Videos(queryMods...)
+}
+
+// LoadVideo allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (videoTranscriptCheckL) LoadVideo(ctx context.Context, e boil.ContextExecutor, singular bool, maybeVideoTranscriptCheck any, mods queries.Applicator) error {
+	var slice []*VideoTranscriptCheck
+	var object *VideoTranscriptCheck
+
+	if singular {
+		var ok bool
+		object, ok = maybeVideoTranscriptCheck.(*VideoTranscriptCheck)
+		if !ok {
+			object = new(VideoTranscriptCheck)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeVideoTranscriptCheck)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeVideoTranscriptCheck))
+			}
+		}
+	} else {
+		s, ok := maybeVideoTranscriptCheck.(*[]*VideoTranscriptCheck)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeVideoTranscriptCheck)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeVideoTranscriptCheck))
+			}
+		}
+	}
+
+	args := make(map[any]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &videoTranscriptCheckR{}
+		}
+		args[object.VideoID] = struct{}{}
+
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &videoTranscriptCheckR{}
+			}
+
+			args[obj.VideoID] = struct{}{}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]any, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`videos`),
+		qm.WhereIn(`videos.id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load Video")
+	}
+
+	var resultSlice []*Video
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice Video")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for videos")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for videos")
+	}
+
+	if len(videoAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Video = foreign
+		if foreign.R == nil {
+			foreign.R = &videoR{}
+		}
+		foreign.R.VideoTranscriptCheck = object
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.VideoID == foreign.ID {
+				local.R.Video = foreign
+				if foreign.R == nil {
+					foreign.R = &videoR{}
+				}
+				foreign.R.VideoTranscriptCheck = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetVideo of the videoTranscriptCheck to the related item.
+// Sets o.R.Video to related.
+// Adds o to related.R.VideoTranscriptCheck.
+func (o *VideoTranscriptCheck) SetVideo(ctx context.Context, exec boil.ContextExecutor, insert bool, related *Video) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"video_transcript_checks\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 0, []string{"video_id"}),
+		strmangle.WhereClause("\"", "\"", 0, videoTranscriptCheckPrimaryKeyColumns),
+	)
+	values := []any{related.ID, o.VideoID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.VideoID = related.ID
+	if o.R == nil {
+		o.R = &videoTranscriptCheckR{
+			Video: related,
+		}
+	} else {
+		o.R.Video = related
+	}
+
+	if related.R == nil {
+		related.R = &videoR{
+			VideoTranscriptCheck: o,
+		}
+	} else {
+		related.R.VideoTranscriptCheck = o
+	}
+
+	return nil
+}
+
+// VideoTranscriptChecks retrieves all the records using an executor.
+func VideoTranscriptChecks(mods ...qm.QueryMod) videoTranscriptCheckQuery {
+	mods = append(mods, qm.From("\"video_transcript_checks\""))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"\"video_transcript_checks\".*"})
+	}
+
+	return videoTranscriptCheckQuery{q}
+}
+
+// FindVideoTranscriptCheck retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindVideoTranscriptCheck(ctx context.Context, exec boil.ContextExecutor, videoID string, selectCols ...string) (*VideoTranscriptCheck, error) {
+	videoTranscriptCheckObj := &VideoTranscriptCheck{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"video_transcript_checks\" where \"video_id\"=?", sel,
+	)
+
+	q := queries.Raw(query, videoID)
+
+	err := q.Bind(ctx, exec, videoTranscriptCheckObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: unable to select from video_transcript_checks")
+	}
+
+	if err = videoTranscriptCheckObj.doAfterSelectHooks(ctx, exec); err != nil {
+		return videoTranscriptCheckObj, err
+	}
+
+	return videoTranscriptCheckObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *VideoTranscriptCheck) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no video_transcript_checks provided for insertion")
+	}
+
+	var err error
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(videoTranscriptCheckColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	videoTranscriptCheckInsertCacheMut.RLock()
+	cache, cached := videoTranscriptCheckInsertCache[key]
+	videoTranscriptCheckInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			videoTranscriptCheckAllColumns,
+			videoTranscriptCheckColumnsWithDefault,
+			videoTranscriptCheckColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(videoTranscriptCheckType, videoTranscriptCheckMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(videoTranscriptCheckType, videoTranscriptCheckMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"video_transcript_checks\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"video_transcript_checks\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "models: unable to insert into video_transcript_checks")
+	}
+
+	if !cached {
+		videoTranscriptCheckInsertCacheMut.Lock()
+		videoTranscriptCheckInsertCache[key] = cache
+		videoTranscriptCheckInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// Update uses an executor to update the VideoTranscriptCheck.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *VideoTranscriptCheck) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	videoTranscriptCheckUpdateCacheMut.RLock()
+	cache, cached := videoTranscriptCheckUpdateCache[key]
+	videoTranscriptCheckUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			videoTranscriptCheckAllColumns,
+			videoTranscriptCheckPrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("models: unable to update video_transcript_checks, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"video_transcript_checks\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 0, wl),
+			strmangle.WhereClause("\"", "\"", 0, videoTranscriptCheckPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(videoTranscriptCheckType, videoTranscriptCheckMapping, append(wl, videoTranscriptCheckPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update video_transcript_checks row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by update for video_transcript_checks")
+	}
+
+	if !cached {
+		videoTranscriptCheckUpdateCacheMut.Lock()
+		videoTranscriptCheckUpdateCache[key] = cache
+		videoTranscriptCheckUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q videoTranscriptCheckQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all for video_transcript_checks")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected for video_transcript_checks")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o VideoTranscriptCheckSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("models: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]any, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), videoTranscriptCheckPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"video_transcript_checks\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 0, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, videoTranscriptCheckPrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all in videoTranscriptCheck slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected all in update all videoTranscriptCheck")
+	}
+	return rowsAff, nil
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *VideoTranscriptCheck) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no video_transcript_checks provided for upsert")
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(videoTranscriptCheckColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	videoTranscriptCheckUpsertCacheMut.RLock()
+	cache, cached := videoTranscriptCheckUpsertCache[key]
+	videoTranscriptCheckUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, _ := insertColumns.InsertColumnSet(
+			videoTranscriptCheckAllColumns,
+			videoTranscriptCheckColumnsWithDefault,
+			videoTranscriptCheckColumnsWithoutDefault,
+			nzDefaults,
+		)
+		update := updateColumns.UpdateColumnSet(
+			videoTranscriptCheckAllColumns,
+			videoTranscriptCheckPrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("models: unable to upsert video_transcript_checks, could not build update column list")
+		}
+
+		ret := strmangle.SetComplement(videoTranscriptCheckAllColumns, strmangle.SetIntersect(insert, update))
+
+		conflict := conflictColumns
+		if len(conflict) == 0 {
+			conflict = make([]string, len(videoTranscriptCheckPrimaryKeyColumns))
+			copy(conflict, videoTranscriptCheckPrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQuerySQLite(dialect, "\"video_transcript_checks\"", updateOnConflict, ret, update, conflict, insert)
+
+		cache.valueMapping, err = queries.BindMapping(videoTranscriptCheckType, videoTranscriptCheckMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(videoTranscriptCheckType, videoTranscriptCheckMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []any
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if errors.Is(err, sql.ErrNoRows) {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "models: unable to upsert video_transcript_checks")
+	}
+
+	if !cached {
+		videoTranscriptCheckUpsertCacheMut.Lock()
+		videoTranscriptCheckUpsertCache[key] = cache
+		videoTranscriptCheckUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// Delete deletes a single VideoTranscriptCheck record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *VideoTranscriptCheck) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("models: no VideoTranscriptCheck provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), videoTranscriptCheckPrimaryKeyMapping)
+	sql := "DELETE FROM \"video_transcript_checks\" WHERE \"video_id\"=?"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete from video_transcript_checks")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by delete for video_transcript_checks")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q videoTranscriptCheckQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("models: no videoTranscriptCheckQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from video_transcript_checks")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for video_transcript_checks")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o VideoTranscriptCheckSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(videoTranscriptCheckBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []any
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), videoTranscriptCheckPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"video_transcript_checks\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, videoTranscriptCheckPrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from videoTranscriptCheck slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for video_transcript_checks")
+	}
+
+	if len(videoTranscriptCheckAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *VideoTranscriptCheck) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindVideoTranscriptCheck(ctx, exec, o.VideoID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *VideoTranscriptCheckSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := VideoTranscriptCheckSlice{}
+	var args []any
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), videoTranscriptCheckPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"video_transcript_checks\".* FROM \"video_transcript_checks\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, videoTranscriptCheckPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to reload all in VideoTranscriptCheckSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// VideoTranscriptCheckExists checks if the VideoTranscriptCheck row exists.
+func VideoTranscriptCheckExists(ctx context.Context, exec boil.ContextExecutor, videoID string) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"video_transcript_checks\" where \"video_id\"=? limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, videoID)
+	}
+	row := exec.QueryRowContext(ctx, sql, videoID)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "models: unable to check if video_transcript_checks exists")
+	}
+
+	return exists, nil
+}
+
+// Exists checks if the VideoTranscriptCheck row exists.
+func (o *VideoTranscriptCheck) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	return VideoTranscriptCheckExists(ctx, exec, o.VideoID)
+}
@@ -0,0 +1,846 @@
+// Code generated by SQLBoiler 4.19.7 (https://github.com/aarondl/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"bytes"
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/aarondl/randomize"
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries"
+	"github.com/aarondl/strmangle"
+)
+
+var (
+	// Relationships sometimes use the reflection helper queries.Equal/queries.Assign
+	// so force a package dependency in case they don't.
+	_ = queries.Equal
+)
+
+func testVideoTranscriptChecks(t *testing.T) {
+	t.Parallel()
+
+	query := VideoTranscriptChecks()
+
+	if query.Query == nil {
+		t.Error("expected a query, got nothing")
+	}
+}
+
+func testVideoTranscriptChecksDelete(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &VideoTranscriptCheck{}
+	if err = randomize.Struct(seed, o, videoTranscriptCheckDBTypes, true, videoTranscriptCheckColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize VideoTranscriptCheck struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := o.Delete(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := VideoTranscriptChecks().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testVideoTranscriptChecksQueryDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &VideoTranscriptCheck{}
+	if err = randomize.Struct(seed, o, videoTranscriptCheckDBTypes, true, videoTranscriptCheckColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize VideoTranscriptCheck struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := VideoTranscriptChecks().DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := VideoTranscriptChecks().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testVideoTranscriptChecksSliceDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &VideoTranscriptCheck{}
+	if err = randomize.Struct(seed, o, videoTranscriptCheckDBTypes, true, videoTranscriptCheckColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize VideoTranscriptCheck struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := VideoTranscriptCheckSlice{o}
+
+	if rowsAff, err := slice.DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := VideoTranscriptChecks().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testVideoTranscriptChecksExists(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &VideoTranscriptCheck{}
+	if err = randomize.Struct(seed, o, videoTranscriptCheckDBTypes, true, videoTranscriptCheckColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize VideoTranscriptCheck struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	e, err := VideoTranscriptCheckExists(ctx, tx, o.VideoID)
+	if err != nil {
+		t.Errorf("Unable to check if VideoTranscriptCheck exists: %s", err)
+	}
+	if !e {
+		t.Errorf("Expected VideoTranscriptCheckExists to return true, but got false.")
+	}
+}
+
+func testVideoTranscriptChecksFind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &VideoTranscriptCheck{}
+	if err = randomize.Struct(seed, o, videoTranscriptCheckDBTypes, true, videoTranscriptCheckColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize VideoTranscriptCheck struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	videoTranscriptCheckFound, err := FindVideoTranscriptCheck(ctx, tx, o.VideoID)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if videoTranscriptCheckFound == nil {
+		t.Error("want a record, got nil")
+	}
+}
+
+func testVideoTranscriptChecksBind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &VideoTranscriptCheck{}
+	if err = randomize.Struct(seed, o, videoTranscriptCheckDBTypes, true, videoTranscriptCheckColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize VideoTranscriptCheck struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = VideoTranscriptChecks().Bind(ctx, tx, o); err != nil {
+		t.Error(err)
+	}
+}
+
+func testVideoTranscriptChecksOne(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &VideoTranscriptCheck{}
+	if err = randomize.Struct(seed, o, videoTranscriptCheckDBTypes, true, videoTranscriptCheckColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize VideoTranscriptCheck struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if x, err := VideoTranscriptChecks().One(ctx, tx); err != nil {
+		t.Error(err)
+	} else if x == nil {
+		t.Error("expected to get a non nil record")
+	}
+}
+
+func testVideoTranscriptChecksAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	videoTranscriptCheckOne := &VideoTranscriptCheck{}
+	videoTranscriptCheckTwo := &VideoTranscriptCheck{}
+	if err = randomize.Struct(seed, videoTranscriptCheckOne, videoTranscriptCheckDBTypes, false, videoTranscriptCheckColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize VideoTranscriptCheck struct: %s", err)
+	}
+	if err = randomize.Struct(seed, videoTranscriptCheckTwo, videoTranscriptCheckDBTypes, false, videoTranscriptCheckColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize VideoTranscriptCheck struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = videoTranscriptCheckOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = videoTranscriptCheckTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := VideoTranscriptChecks().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 2 {
+		t.Error("want 2 records, got:", len(slice))
+	}
+}
+
+func testVideoTranscriptChecksCount(t *testing.T) {
+	t.Parallel()
+
+	var err error
+	seed := randomize.NewSeed()
+	videoTranscriptCheckOne := &VideoTranscriptCheck{}
+	videoTranscriptCheckTwo := &VideoTranscriptCheck{}
+	if err = randomize.Struct(seed, videoTranscriptCheckOne, videoTranscriptCheckDBTypes, false, videoTranscriptCheckColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize VideoTranscriptCheck struct: %s", err)
+	}
+	if err = randomize.Struct(seed, videoTranscriptCheckTwo, videoTranscriptCheckDBTypes, false, videoTranscriptCheckColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize VideoTranscriptCheck struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = videoTranscriptCheckOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = videoTranscriptCheckTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := VideoTranscriptChecks().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 2 {
+		t.Error("want 2 records, got:", count)
+	}
+}
+
+func videoTranscriptCheckBeforeInsertHook(ctx context.Context, e boil.ContextExecutor, o *VideoTranscriptCheck) error {
+	*o = VideoTranscriptCheck{}
+	return nil
+}
+
+func videoTranscriptCheckAfterInsertHook(ctx context.Context, e boil.ContextExecutor, o *VideoTranscriptCheck) error {
+	*o = VideoTranscriptCheck{}
+	return nil
+}
+
+func videoTranscriptCheckAfterSelectHook(ctx context.Context, e boil.ContextExecutor, o *VideoTranscriptCheck) error {
+	*o = VideoTranscriptCheck{}
+	return nil
+}
+
+func videoTranscriptCheckBeforeUpdateHook(ctx context.Context, e boil.ContextExecutor, o *VideoTranscriptCheck) error {
+	*o = VideoTranscriptCheck{}
+	return nil
+}
+
+func videoTranscriptCheckAfterUpdateHook(ctx context.Context, e boil.ContextExecutor, o *VideoTranscriptCheck) error {
+	*o = VideoTranscriptCheck{}
+	return nil
+}
+
+func videoTranscriptCheckBeforeDeleteHook(ctx context.Context, e boil.ContextExecutor, o *VideoTranscriptCheck) error {
+	*o = VideoTranscriptCheck{}
+	return nil
+}
+
+func videoTranscriptCheckAfterDeleteHook(ctx context.Context, e boil.ContextExecutor, o *VideoTranscriptCheck) error {
+	*o = VideoTranscriptCheck{}
+	return nil
+}
+
+func videoTranscriptCheckBeforeUpsertHook(ctx context.Context, e boil.ContextExecutor, o *VideoTranscriptCheck) error {
+	*o = VideoTranscriptCheck{}
+	return nil
+}
+
+func videoTranscriptCheckAfterUpsertHook(ctx context.Context, e boil.ContextExecutor, o *VideoTranscriptCheck) error {
+	*o = VideoTranscriptCheck{}
+	return nil
+}
+
+func testVideoTranscriptChecksHooks(t *testing.T) {
+	t.Parallel()
+
+	var err error
+
+	ctx := context.Background()
+	empty := &VideoTranscriptCheck{}
+	o := &VideoTranscriptCheck{}
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, o, videoTranscriptCheckDBTypes, false); err != nil {
+		t.Errorf("Unable to randomize VideoTranscriptCheck object: %s", err)
+	}
+
+	AddVideoTranscriptCheckHook(boil.BeforeInsertHook, videoTranscriptCheckBeforeInsertHook)
+	if err = o.doBeforeInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeInsertHook function to empty object, but got: %#v", o)
+	}
+	videoTranscriptCheckBeforeInsertHooks = []VideoTranscriptCheckHook{}
+
+	AddVideoTranscriptCheckHook(boil.AfterInsertHook, videoTranscriptCheckAfterInsertHook)
+	if err = o.doAfterInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterInsertHook function to empty object, but got: %#v", o)
+	}
+	videoTranscriptCheckAfterInsertHooks = []VideoTranscriptCheckHook{}
+
+	AddVideoTranscriptCheckHook(boil.AfterSelectHook, videoTranscriptCheckAfterSelectHook)
+	if err = o.doAfterSelectHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterSelectHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterSelectHook function to empty object, but got: %#v", o)
+	}
+	videoTranscriptCheckAfterSelectHooks = []VideoTranscriptCheckHook{}
+
+	AddVideoTranscriptCheckHook(boil.BeforeUpdateHook, videoTranscriptCheckBeforeUpdateHook)
+	if err = o.doBeforeUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpdateHook function to empty object, but got: %#v", o)
+	}
+	videoTranscriptCheckBeforeUpdateHooks = []VideoTranscriptCheckHook{}
+
+	AddVideoTranscriptCheckHook(boil.AfterUpdateHook, videoTranscriptCheckAfterUpdateHook)
+	if err = o.doAfterUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpdateHook function to empty object, but got: %#v", o)
+	}
+	videoTranscriptCheckAfterUpdateHooks = []VideoTranscriptCheckHook{}
+
+	AddVideoTranscriptCheckHook(boil.BeforeDeleteHook, videoTranscriptCheckBeforeDeleteHook)
+	if err = o.doBeforeDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeDeleteHook function to empty object, but got: %#v", o)
+	}
+	videoTranscriptCheckBeforeDeleteHooks = []VideoTranscriptCheckHook{}
+
+	AddVideoTranscriptCheckHook(boil.AfterDeleteHook, videoTranscriptCheckAfterDeleteHook)
+	if err = o.doAfterDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterDeleteHook function to empty object, but got: %#v", o)
+	}
+	videoTranscriptCheckAfterDeleteHooks = []VideoTranscriptCheckHook{}
+
+	AddVideoTranscriptCheckHook(boil.BeforeUpsertHook, videoTranscriptCheckBeforeUpsertHook)
+	if err = o.doBeforeUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpsertHook function to empty object, but got: %#v", o)
+	}
+	videoTranscriptCheckBeforeUpsertHooks = []VideoTranscriptCheckHook{}
+
+	AddVideoTranscriptCheckHook(boil.AfterUpsertHook, videoTranscriptCheckAfterUpsertHook)
+	if err = o.doAfterUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpsertHook function to empty object, but got: %#v", o)
+	}
+	videoTranscriptCheckAfterUpsertHooks = []VideoTranscriptCheckHook{}
+}
+
+func testVideoTranscriptChecksInsert(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &VideoTranscriptCheck{}
+	if err = randomize.Struct(seed, o, videoTranscriptCheckDBTypes, true, videoTranscriptCheckColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize VideoTranscriptCheck struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := VideoTranscriptChecks().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testVideoTranscriptChecksInsertWhitelist(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &VideoTranscriptCheck{}
+	if err = randomize.Struct(seed, o, videoTranscriptCheckDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize VideoTranscriptCheck struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Whitelist(strmangle.SetMerge(videoTranscriptCheckPrimaryKeyColumns, videoTranscriptCheckColumnsWithoutDefault)...)); err != nil {
+		t.Error(err)
+	}
+
+	count, err := VideoTranscriptChecks().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testVideoTranscriptCheckToOneVideoUsingVideo(t *testing.T) {
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var local VideoTranscriptCheck
+	var foreign Video
+
+	seed := randomize.NewSeed()
+	if err := randomize.Struct(seed, &local, videoTranscriptCheckDBTypes, false, videoTranscriptCheckColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize VideoTranscriptCheck struct: %s", err)
+	}
+	if err := randomize.Struct(seed, &foreign, videoDBTypes, false, videoColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Video struct: %s", err)
+	}
+
+	if err := foreign.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	local.VideoID = foreign.ID
+	if err := local.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := local.Video().One(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if check.ID != foreign.ID {
+		t.Errorf("want: %v, got %v", foreign.ID, check.ID)
+	}
+
+	ranAfterSelectHook := false
+	AddVideoHook(boil.AfterSelectHook, func(ctx context.Context, e boil.ContextExecutor, o *Video) error {
+		ranAfterSelectHook = true
+		return nil
+	})
+
+	slice := VideoTranscriptCheckSlice{&local}
+	if err = local.L.LoadVideo(ctx, tx, false, (*[]*VideoTranscriptCheck)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.Video == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	local.R.Video = nil
+	if err = local.L.LoadVideo(ctx, tx, true, &local, nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.Video == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	if !ranAfterSelectHook {
+		t.Error("failed to run AfterSelect hook for relationship")
+	}
+}
+
+func testVideoTranscriptCheckToOneSetOpVideoUsingVideo(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a VideoTranscriptCheck
+	var b, c Video
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, videoTranscriptCheckDBTypes, false, strmangle.SetComplement(videoTranscriptCheckPrimaryKeyColumns, videoTranscriptCheckColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, videoDBTypes, false, strmangle.SetComplement(videoPrimaryKeyColumns, videoColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, videoDBTypes, false, strmangle.SetComplement(videoPrimaryKeyColumns, videoColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	for i, x := range []*Video{&b, &c} {
+		err = a.SetVideo(ctx, tx, i != 0, x)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if a.R.Video != x {
+			t.Error("relationship struct not set to correct value")
+		}
+
+		if x.R.VideoTranscriptCheck != &a {
+			t.Error("failed to append to foreign relationship struct")
+		}
+		if a.VideoID != x.ID {
+			t.Error("foreign key was wrong value", a.VideoID)
+		}
+
+		if exists, err := VideoTranscriptCheckExists(ctx, tx, a.VideoID); err != nil {
+			t.Fatal(err)
+		} else if !exists {
+			t.Error("want 'a' to exist")
+		}
+
+	}
+}
+
+func testVideoTranscriptChecksReload(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &VideoTranscriptCheck{}
+	if err = randomize.Struct(seed, o, videoTranscriptCheckDBTypes, true, videoTranscriptCheckColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize VideoTranscriptCheck struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = o.Reload(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testVideoTranscriptChecksReloadAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &VideoTranscriptCheck{}
+	if err = randomize.Struct(seed, o, videoTranscriptCheckDBTypes, true, videoTranscriptCheckColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize VideoTranscriptCheck struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := VideoTranscriptCheckSlice{o}
+
+	if err = slice.ReloadAll(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testVideoTranscriptChecksSelect(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &VideoTranscriptCheck{}
+	if err = randomize.Struct(seed, o, videoTranscriptCheckDBTypes, true, videoTranscriptCheckColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize VideoTranscriptCheck struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := VideoTranscriptChecks().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 1 {
+		t.Error("want one record, got:", len(slice))
+	}
+}
+
+var (
+	videoTranscriptCheckDBTypes = map[string]string{`VideoID`: `TEXT`, `CheckedAt`: `DATE`, `TrackCount`: `INTEGER`}
+	_                           = bytes.MinRead
+)
+
+func testVideoTranscriptChecksUpdate(t *testing.T) {
+	t.Parallel()
+
+	if 0 == len(videoTranscriptCheckPrimaryKeyColumns) {
+		t.Skip("Skipping table with no primary key columns")
+	}
+	if len(videoTranscriptCheckAllColumns) == len(videoTranscriptCheckPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &VideoTranscriptCheck{}
+	if err = randomize.Struct(seed, o, videoTranscriptCheckDBTypes, true, videoTranscriptCheckColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize VideoTranscriptCheck struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := VideoTranscriptChecks().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, videoTranscriptCheckDBTypes, true, videoTranscriptCheckPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize VideoTranscriptCheck struct: %s", err)
+	}
+
+	if rowsAff, err := o.Update(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only affect one row but affected", rowsAff)
+	}
+}
+
+func testVideoTranscriptChecksSliceUpdateAll(t *testing.T) {
+	t.Parallel()
+
+	if len(videoTranscriptCheckAllColumns) == len(videoTranscriptCheckPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &VideoTranscriptCheck{}
+	if err = randomize.Struct(seed, o, videoTranscriptCheckDBTypes, true, videoTranscriptCheckColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize VideoTranscriptCheck struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := VideoTranscriptChecks().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, videoTranscriptCheckDBTypes, true, videoTranscriptCheckPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize VideoTranscriptCheck struct: %s", err)
+	}
+
+	// Remove Primary keys and unique columns from what we plan to update
+	var fields []string
+	if strmangle.StringSliceMatch(videoTranscriptCheckAllColumns, videoTranscriptCheckPrimaryKeyColumns) {
+		fields = videoTranscriptCheckAllColumns
+	} else {
+		fields = strmangle.SetComplement(
+			videoTranscriptCheckAllColumns,
+			videoTranscriptCheckPrimaryKeyColumns,
+		)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	typ := reflect.TypeOf(o).Elem()
+	n := typ.NumField()
+
+	updateMap := M{}
+	for _, col := range fields {
+		for i := 0; i < n; i++ {
+			f := typ.Field(i)
+			if f.Tag.Get("boil") == col {
+				updateMap[col] = value.Field(i).Interface()
+			}
+		}
+	}
+
+	slice := VideoTranscriptCheckSlice{o}
+	if rowsAff, err := slice.UpdateAll(ctx, tx, updateMap); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("wanted one record updated but got", rowsAff)
+	}
+}
+
+func testVideoTranscriptChecksUpsert(t *testing.T) {
+	t.Parallel()
+	if len(videoTranscriptCheckAllColumns) == len(videoTranscriptCheckPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	// Attempt the INSERT side of an UPSERT
+	o := VideoTranscriptCheck{}
+	if err = randomize.Struct(seed, &o, videoTranscriptCheckDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize VideoTranscriptCheck struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Upsert(ctx, tx, false, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert VideoTranscriptCheck: %s", err)
+	}
+
+	count, err := VideoTranscriptChecks().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	// Attempt the UPDATE side of an UPSERT
+	if err = randomize.Struct(seed, &o, videoTranscriptCheckDBTypes, false, videoTranscriptCheckPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize VideoTranscriptCheck struct: %s", err)
+	}
+
+	if err = o.Upsert(ctx, tx, true, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert VideoTranscriptCheck: %s", err)
+	}
+
+	count, err = VideoTranscriptChecks().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
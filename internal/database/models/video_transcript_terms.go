@@ -0,0 +1,1128 @@
+// Code generated by SQLBoiler 4.19.7 (https://github.com/aarondl/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries"
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+	"github.com/aarondl/sqlboiler/v4/queries/qmhelper"
+	"github.com/aarondl/strmangle"
+	"github.com/friendsofgo/errors"
+)
+
+// VideoTranscriptTerm is an object representing the database table.
+type VideoTranscriptTerm struct {
+	Term         string `boil:"term" json:"term" toml:"term" yaml:"term"`
+	TranscriptID string `boil:"transcript_id" json:"transcript_id" toml:"transcript_id" yaml:"transcript_id"`
+	StartSeconds int64  `boil:"start_seconds" json:"start_seconds" toml:"start_seconds" yaml:"start_seconds"`
+
+	R *videoTranscriptTermR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L videoTranscriptTermL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var VideoTranscriptTermColumns = struct {
+	Term         string
+	TranscriptID string
+	StartSeconds string
+}{
+	Term:         "term",
+	TranscriptID: "transcript_id",
+	StartSeconds: "start_seconds",
+}
+
+var VideoTranscriptTermTableColumns = struct {
+	Term         string
+	TranscriptID string
+	StartSeconds string
+}{
+	Term:         "video_transcript_terms.term",
+	TranscriptID: "video_transcript_terms.transcript_id",
+	StartSeconds: "video_transcript_terms.start_seconds",
+}
+
+// Generated where
+
+var VideoTranscriptTermWhere = struct {
+	Term         whereHelperstring
+	TranscriptID whereHelperstring
+	StartSeconds whereHelperint64
+}{
+	Term:         whereHelperstring{field: "\"video_transcript_terms\".\"term\""},
+	TranscriptID: whereHelperstring{field: "\"video_transcript_terms\".\"transcript_id\""},
+	StartSeconds: whereHelperint64{field: "\"video_transcript_terms\".\"start_seconds\""},
+}
+
+// VideoTranscriptTermRels is where relationship names are stored.
+var VideoTranscriptTermRels = struct {
+	Transcript string
+}{
+	Transcript: "Transcript",
+}
+
+// videoTranscriptTermR is where relationships are stored.
+type videoTranscriptTermR struct {
+	Transcript *VideoTranscript `boil:"Transcript" json:"Transcript" toml:"Transcript" yaml:"Transcript"`
+}
+
+// NewStruct creates a new relationship struct
+func (*videoTranscriptTermR) NewStruct() *videoTranscriptTermR {
+	return &videoTranscriptTermR{}
+}
+
+func (o *VideoTranscriptTerm) GetTranscript() *VideoTranscript {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetTranscript()
+}
+
+func (r *videoTranscriptTermR) GetTranscript() *VideoTranscript {
+	if r == nil {
+		return nil
+	}
+
+	return r.Transcript
+}
+
+// videoTranscriptTermL is where Load methods for each relationship are stored.
+type videoTranscriptTermL struct{}
+
+var (
+	videoTranscriptTermAllColumns            = []string{"term", "transcript_id", "start_seconds"}
+	videoTranscriptTermColumnsWithoutDefault = []string{"term", "transcript_id", "start_seconds"}
+	videoTranscriptTermColumnsWithDefault    = []string{}
+	videoTranscriptTermPrimaryKeyColumns     = []string{"term", "transcript_id"}
+	videoTranscriptTermGeneratedColumns      = []string{}
+)
+
+type (
+	// VideoTranscriptTermSlice is an alias for a slice of pointers to VideoTranscriptTerm.
+	// This should almost always be used instead of []VideoTranscriptTerm.
+	VideoTranscriptTermSlice []*VideoTranscriptTerm
+	// VideoTranscriptTermHook is the signature for custom VideoTranscriptTerm hook methods
+	VideoTranscriptTermHook func(context.Context, boil.ContextExecutor, *VideoTranscriptTerm) error
+
+	videoTranscriptTermQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	videoTranscriptTermType                 = reflect.TypeOf(&VideoTranscriptTerm{})
+	videoTranscriptTermMapping              = queries.MakeStructMapping(videoTranscriptTermType)
+	videoTranscriptTermPrimaryKeyMapping, _ = queries.BindMapping(videoTranscriptTermType, videoTranscriptTermMapping, videoTranscriptTermPrimaryKeyColumns)
+	videoTranscriptTermInsertCacheMut       sync.RWMutex
+	videoTranscriptTermInsertCache          = make(map[string]insertCache)
+	videoTranscriptTermUpdateCacheMut       sync.RWMutex
+	videoTranscriptTermUpdateCache          = make(map[string]updateCache)
+	videoTranscriptTermUpsertCacheMut       sync.RWMutex
+	videoTranscriptTermUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var videoTranscriptTermAfterSelectMu sync.Mutex
+var videoTranscriptTermAfterSelectHooks []VideoTranscriptTermHook
+
+var videoTranscriptTermBeforeInsertMu sync.Mutex
+var videoTranscriptTermBeforeInsertHooks []VideoTranscriptTermHook
+var videoTranscriptTermAfterInsertMu sync.Mutex
+var videoTranscriptTermAfterInsertHooks []VideoTranscriptTermHook
+
+var videoTranscriptTermBeforeUpdateMu sync.Mutex
+var videoTranscriptTermBeforeUpdateHooks []VideoTranscriptTermHook
+var videoTranscriptTermAfterUpdateMu sync.Mutex
+var videoTranscriptTermAfterUpdateHooks []VideoTranscriptTermHook
+
+var videoTranscriptTermBeforeDeleteMu sync.Mutex
+var videoTranscriptTermBeforeDeleteHooks []VideoTranscriptTermHook
+var videoTranscriptTermAfterDeleteMu sync.Mutex
+var videoTranscriptTermAfterDeleteHooks []VideoTranscriptTermHook
+
+var videoTranscriptTermBeforeUpsertMu sync.Mutex
+var videoTranscriptTermBeforeUpsertHooks []VideoTranscriptTermHook
+var videoTranscriptTermAfterUpsertMu sync.Mutex
+var videoTranscriptTermAfterUpsertHooks []VideoTranscriptTermHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *VideoTranscriptTerm) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range videoTranscriptTermAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *VideoTranscriptTerm) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range videoTranscriptTermBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *VideoTranscriptTerm) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range videoTranscriptTermAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *VideoTranscriptTerm) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range videoTranscriptTermBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *VideoTranscriptTerm) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range videoTranscriptTermAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *VideoTranscriptTerm) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range videoTranscriptTermBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *VideoTranscriptTerm) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range videoTranscriptTermAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *VideoTranscriptTerm) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range videoTranscriptTermBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *VideoTranscriptTerm) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range videoTranscriptTermAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddVideoTranscriptTermHook registers your hook function for all future operations.
+func AddVideoTranscriptTermHook(hookPoint boil.HookPoint, videoTranscriptTermHook VideoTranscriptTermHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		videoTranscriptTermAfterSelectMu.Lock()
+		videoTranscriptTermAfterSelectHooks = append(videoTranscriptTermAfterSelectHooks, videoTranscriptTermHook)
+		videoTranscriptTermAfterSelectMu.Unlock()
+	case boil.BeforeInsertHook:
+		videoTranscriptTermBeforeInsertMu.Lock()
+		videoTranscriptTermBeforeInsertHooks = append(videoTranscriptTermBeforeInsertHooks, videoTranscriptTermHook)
+		videoTranscriptTermBeforeInsertMu.Unlock()
+	case boil.AfterInsertHook:
+		videoTranscriptTermAfterInsertMu.Lock()
+		videoTranscriptTermAfterInsertHooks = append(videoTranscriptTermAfterInsertHooks, videoTranscriptTermHook)
+		videoTranscriptTermAfterInsertMu.Unlock()
+	case boil.BeforeUpdateHook:
+		videoTranscriptTermBeforeUpdateMu.Lock()
+		videoTranscriptTermBeforeUpdateHooks = append(videoTranscriptTermBeforeUpdateHooks, videoTranscriptTermHook)
+		videoTranscriptTermBeforeUpdateMu.Unlock()
+	case boil.AfterUpdateHook:
+		videoTranscriptTermAfterUpdateMu.Lock()
+		videoTranscriptTermAfterUpdateHooks = append(videoTranscriptTermAfterUpdateHooks, videoTranscriptTermHook)
+		videoTranscriptTermAfterUpdateMu.Unlock()
+	case boil.BeforeDeleteHook:
+		videoTranscriptTermBeforeDeleteMu.Lock()
+		videoTranscriptTermBeforeDeleteHooks = append(videoTranscriptTermBeforeDeleteHooks, videoTranscriptTermHook)
+		videoTranscriptTermBeforeDeleteMu.Unlock()
+	case boil.AfterDeleteHook:
+		videoTranscriptTermAfterDeleteMu.Lock()
+		videoTranscriptTermAfterDeleteHooks = append(videoTranscriptTermAfterDeleteHooks, videoTranscriptTermHook)
+		videoTranscriptTermAfterDeleteMu.Unlock()
+	case boil.BeforeUpsertHook:
+		videoTranscriptTermBeforeUpsertMu.Lock()
+		videoTranscriptTermBeforeUpsertHooks = append(videoTranscriptTermBeforeUpsertHooks, videoTranscriptTermHook)
+		videoTranscriptTermBeforeUpsertMu.Unlock()
+	case boil.AfterUpsertHook:
+		videoTranscriptTermAfterUpsertMu.Lock()
+		videoTranscriptTermAfterUpsertHooks = append(videoTranscriptTermAfterUpsertHooks, videoTranscriptTermHook)
+		videoTranscriptTermAfterUpsertMu.Unlock()
+	}
+}
+
+// One returns a single videoTranscriptTerm record from the query.
+func (q videoTranscriptTermQuery) One(ctx context.Context, exec boil.ContextExecutor) (*VideoTranscriptTerm, error) {
+	o := &VideoTranscriptTerm{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: failed to execute a one query for video_transcript_terms")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all VideoTranscriptTerm records from the query.
+func (q videoTranscriptTermQuery) All(ctx context.Context, exec boil.ContextExecutor) (VideoTranscriptTermSlice, error) {
+	var o []*VideoTranscriptTerm
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "models: failed to assign all query results to VideoTranscriptTerm slice")
+	}
+
+	if len(videoTranscriptTermAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all VideoTranscriptTerm records in the query.
+func (q videoTranscriptTermQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to count video_transcript_terms rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q videoTranscriptTermQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "models: failed to check if video_transcript_terms exists")
+	}
+
+	return count > 0, nil
+}
+
+// Transcript pointed to by the foreign key.
+func (o *VideoTranscriptTerm) Transcript(mods ...qm.QueryMod) videoTranscriptQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.TranscriptID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return VideoTranscripts(queryMods...)
+}
+
+// LoadTranscript allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (videoTranscriptTermL) LoadTranscript(ctx context.Context, e boil.ContextExecutor, singular bool, maybeVideoTranscriptTerm any, mods queries.Applicator) error {
+	var slice []*VideoTranscriptTerm
+	var object *VideoTranscriptTerm
+
+	if singular {
+		var ok bool
+		object, ok = maybeVideoTranscriptTerm.(*VideoTranscriptTerm)
+		if !ok {
+			object = new(VideoTranscriptTerm)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeVideoTranscriptTerm)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeVideoTranscriptTerm))
+			}
+		}
+	} else {
+		s, ok := maybeVideoTranscriptTerm.(*[]*VideoTranscriptTerm)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeVideoTranscriptTerm)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeVideoTranscriptTerm))
+			}
+		}
+	}
+
+	args := make(map[any]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &videoTranscriptTermR{}
+		}
+		args[object.TranscriptID] = struct{}{}
+
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &videoTranscriptTermR{}
+			}
+
+			args[obj.TranscriptID] = struct{}{}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]any, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`video_transcripts`),
+		qm.WhereIn(`video_transcripts.id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load VideoTranscript")
+	}
+
+	var resultSlice []*VideoTranscript
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice VideoTranscript")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for video_transcripts")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for video_transcripts")
+	}
+
+	if len(videoTranscriptAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Transcript = foreign
+		if foreign.R == nil {
+			foreign.R = &videoTranscriptR{}
+		}
+		foreign.R.TranscriptVideoTranscriptTerms = append(foreign.R.TranscriptVideoTranscriptTerms, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.TranscriptID == foreign.ID {
+				local.R.Transcript = foreign
+				if foreign.R == nil {
+					foreign.R = &videoTranscriptR{}
+				}
+				foreign.R.TranscriptVideoTranscriptTerms = append(foreign.R.TranscriptVideoTranscriptTerms, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetTranscript of the videoTranscriptTerm to the related item.
+// Sets o.R.Transcript to related.
+// Adds o to related.R.TranscriptVideoTranscriptTerms.
+func (o *VideoTranscriptTerm) SetTranscript(ctx context.Context, exec boil.ContextExecutor, insert bool, related *VideoTranscript) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"video_transcript_terms\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 0, []string{"transcript_id"}),
+		strmangle.WhereClause("\"", "\"", 0, videoTranscriptTermPrimaryKeyColumns),
+	)
+	values := []any{related.ID, o.Term, o.TranscriptID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.TranscriptID = related.ID
+	if o.R == nil {
+		o.R = &videoTranscriptTermR{
+			Transcript: related,
+		}
+	} else {
+		o.R.Transcript = related
+	}
+
+	if related.R == nil {
+		related.R = &videoTranscriptR{
+			TranscriptVideoTranscriptTerms: VideoTranscriptTermSlice{o},
+		}
+	} else {
+		related.R.TranscriptVideoTranscriptTerms = append(related.R.TranscriptVideoTranscriptTerms, o)
+	}
+
+	return nil
+}
+
+// VideoTranscriptTerms retrieves all the records using an executor.
+func VideoTranscriptTerms(mods ...qm.QueryMod) videoTranscriptTermQuery {
+	mods = append(mods, qm.From("\"video_transcript_terms\""))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"\"video_transcript_terms\".*"})
+	}
+
+	return videoTranscriptTermQuery{q}
+}
+
+// FindVideoTranscriptTerm retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindVideoTranscriptTerm(ctx context.Context, exec boil.ContextExecutor, term string, transcriptID string, selectCols ...string) (*VideoTranscriptTerm, error) {
+	videoTranscriptTermObj := &VideoTranscriptTerm{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"video_transcript_terms\" where \"term\"=? AND \"transcript_id\"=?", sel,
+	)
+
+	q := queries.Raw(query, term, transcriptID)
+
+	err := q.Bind(ctx, exec, videoTranscriptTermObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: unable to select from video_transcript_terms")
+	}
+
+	if err = videoTranscriptTermObj.doAfterSelectHooks(ctx, exec); err != nil {
+		return videoTranscriptTermObj, err
+	}
+
+	return videoTranscriptTermObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *VideoTranscriptTerm) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no video_transcript_terms provided for insertion")
+	}
+
+	var err error
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(videoTranscriptTermColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	videoTranscriptTermInsertCacheMut.RLock()
+	cache, cached := videoTranscriptTermInsertCache[key]
+	videoTranscriptTermInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			videoTranscriptTermAllColumns,
+			videoTranscriptTermColumnsWithDefault,
+			videoTranscriptTermColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(videoTranscriptTermType, videoTranscriptTermMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(videoTranscriptTermType, videoTranscriptTermMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"video_transcript_terms\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"video_transcript_terms\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "models: unable to insert into video_transcript_terms")
+	}
+
+	if !cached {
+		videoTranscriptTermInsertCacheMut.Lock()
+		videoTranscriptTermInsertCache[key] = cache
+		videoTranscriptTermInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// Update uses an executor to update the VideoTranscriptTerm.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *VideoTranscriptTerm) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	videoTranscriptTermUpdateCacheMut.RLock()
+	cache, cached := videoTranscriptTermUpdateCache[key]
+	videoTranscriptTermUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			videoTranscriptTermAllColumns,
+			videoTranscriptTermPrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("models: unable to update video_transcript_terms, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"video_transcript_terms\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 0, wl),
+			strmangle.WhereClause("\"", "\"", 0, videoTranscriptTermPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(videoTranscriptTermType, videoTranscriptTermMapping, append(wl, videoTranscriptTermPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update video_transcript_terms row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by update for video_transcript_terms")
+	}
+
+	if !cached {
+		videoTranscriptTermUpdateCacheMut.Lock()
+		videoTranscriptTermUpdateCache[key] = cache
+		videoTranscriptTermUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q videoTranscriptTermQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all for video_transcript_terms")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected for video_transcript_terms")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o VideoTranscriptTermSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("models: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]any, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), videoTranscriptTermPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"video_transcript_terms\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 0, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, videoTranscriptTermPrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all in videoTranscriptTerm slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected all in update all videoTranscriptTerm")
+	}
+	return rowsAff, nil
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *VideoTranscriptTerm) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no video_transcript_terms provided for upsert")
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(videoTranscriptTermColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	videoTranscriptTermUpsertCacheMut.RLock()
+	cache, cached := videoTranscriptTermUpsertCache[key]
+	videoTranscriptTermUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, _ := insertColumns.InsertColumnSet(
+			videoTranscriptTermAllColumns,
+			videoTranscriptTermColumnsWithDefault,
+			videoTranscriptTermColumnsWithoutDefault,
+			nzDefaults,
+		)
+		update := updateColumns.UpdateColumnSet(
+			videoTranscriptTermAllColumns,
+			videoTranscriptTermPrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("models: unable to upsert video_transcript_terms, could not build update column list")
+		}
+
+		ret := strmangle.SetComplement(videoTranscriptTermAllColumns, strmangle.SetIntersect(insert, update))
+
+		conflict := conflictColumns
+		if len(conflict) == 0 {
+			conflict = make([]string, len(videoTranscriptTermPrimaryKeyColumns))
+			copy(conflict, videoTranscriptTermPrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQuerySQLite(dialect, "\"video_transcript_terms\"", updateOnConflict, ret, update, conflict, insert)
+
+		cache.valueMapping, err = queries.BindMapping(videoTranscriptTermType, videoTranscriptTermMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(videoTranscriptTermType, videoTranscriptTermMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []any
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if errors.Is(err, sql.ErrNoRows) {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "models: unable to upsert video_transcript_terms")
+	}
+
+	if !cached {
+		videoTranscriptTermUpsertCacheMut.Lock()
+		videoTranscriptTermUpsertCache[key] = cache
+		videoTranscriptTermUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// Delete deletes a single VideoTranscriptTerm record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *VideoTranscriptTerm) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("models: no VideoTranscriptTerm provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), videoTranscriptTermPrimaryKeyMapping)
+	sql := "DELETE FROM \"video_transcript_terms\" WHERE \"term\"=? AND \"transcript_id\"=?"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete from video_transcript_terms")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by delete for video_transcript_terms")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q videoTranscriptTermQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("models: no videoTranscriptTermQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from video_transcript_terms")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for video_transcript_terms")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o VideoTranscriptTermSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(videoTranscriptTermBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []any
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), videoTranscriptTermPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"video_transcript_terms\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, videoTranscriptTermPrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from videoTranscriptTerm slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for video_transcript_terms")
+	}
+
+	if len(videoTranscriptTermAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *VideoTranscriptTerm) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindVideoTranscriptTerm(ctx, exec, o.Term, o.TranscriptID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *VideoTranscriptTermSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := VideoTranscriptTermSlice{}
+	var args []any
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), videoTranscriptTermPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"video_transcript_terms\".* FROM \"video_transcript_terms\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, videoTranscriptTermPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to reload all in VideoTranscriptTermSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// VideoTranscriptTermExists checks if the VideoTranscriptTerm row exists.
+func VideoTranscriptTermExists(ctx context.Context, exec boil.ContextExecutor, term string, transcriptID string) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"video_transcript_terms\" where \"term\"=? AND \"transcript_id\"=? limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, term, transcriptID)
+	}
+	row := exec.QueryRowContext(ctx, sql, term, transcriptID)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "models: unable to check if video_transcript_terms exists")
+	}
+
+	return exists, nil
+}
+
+// Exists checks if the VideoTranscriptTerm row exists.
+func (o *VideoTranscriptTerm) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	return VideoTranscriptTermExists(ctx, exec, o.Term, o.TranscriptID)
+}
@@ -0,0 +1,846 @@
+// Code generated by SQLBoiler 4.19.7 (https://github.com/aarondl/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"bytes"
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/aarondl/randomize"
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries"
+	"github.com/aarondl/strmangle"
+)
+
+var (
+	// Relationships sometimes use the reflection helper queries.Equal/queries.Assign
+	// so force a package dependency in case they don't.
+	_ = queries.Equal
+)
+
+func testVideoTranscriptTerms(t *testing.T) {
+	t.Parallel()
+
+	query := VideoTranscriptTerms()
+
+	if query.Query == nil {
+		t.Error("expected a query, got nothing")
+	}
+}
+
+func testVideoTranscriptTermsDelete(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &VideoTranscriptTerm{}
+	if err = randomize.Struct(seed, o, videoTranscriptTermDBTypes, true, videoTranscriptTermColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize VideoTranscriptTerm struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := o.Delete(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := VideoTranscriptTerms().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testVideoTranscriptTermsQueryDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &VideoTranscriptTerm{}
+	if err = randomize.Struct(seed, o, videoTranscriptTermDBTypes, true, videoTranscriptTermColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize VideoTranscriptTerm struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := VideoTranscriptTerms().DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := VideoTranscriptTerms().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testVideoTranscriptTermsSliceDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &VideoTranscriptTerm{}
+	if err = randomize.Struct(seed, o, videoTranscriptTermDBTypes, true, videoTranscriptTermColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize VideoTranscriptTerm struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := VideoTranscriptTermSlice{o}
+
+	if rowsAff, err := slice.DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := VideoTranscriptTerms().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testVideoTranscriptTermsExists(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &VideoTranscriptTerm{}
+	if err = randomize.Struct(seed, o, videoTranscriptTermDBTypes, true, videoTranscriptTermColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize VideoTranscriptTerm struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	e, err := VideoTranscriptTermExists(ctx, tx, o.Term, o.TranscriptID)
+	if err != nil {
+		t.Errorf("Unable to check if VideoTranscriptTerm exists: %s", err)
+	}
+	if !e {
+		t.Errorf("Expected VideoTranscriptTermExists to return true, but got false.")
+	}
+}
+
+func testVideoTranscriptTermsFind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &VideoTranscriptTerm{}
+	if err = randomize.Struct(seed, o, videoTranscriptTermDBTypes, true, videoTranscriptTermColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize VideoTranscriptTerm struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	videoTranscriptTermFound, err := FindVideoTranscriptTerm(ctx, tx, o.Term, o.TranscriptID)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if videoTranscriptTermFound == nil {
+		t.Error("want a record, got nil")
+	}
+}
+
+func testVideoTranscriptTermsBind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &VideoTranscriptTerm{}
+	if err = randomize.Struct(seed, o, videoTranscriptTermDBTypes, true, videoTranscriptTermColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize VideoTranscriptTerm struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = VideoTranscriptTerms().Bind(ctx, tx, o); err != nil {
+		t.Error(err)
+	}
+}
+
+func testVideoTranscriptTermsOne(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &VideoTranscriptTerm{}
+	if err = randomize.Struct(seed, o, videoTranscriptTermDBTypes, true, videoTranscriptTermColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize VideoTranscriptTerm struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if x, err := VideoTranscriptTerms().One(ctx, tx); err != nil {
+		t.Error(err)
+	} else if x == nil {
+		t.Error("expected to get a non nil record")
+	}
+}
+
+func testVideoTranscriptTermsAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	videoTranscriptTermOne := &VideoTranscriptTerm{}
+	videoTranscriptTermTwo := &VideoTranscriptTerm{}
+	if err = randomize.Struct(seed, videoTranscriptTermOne, videoTranscriptTermDBTypes, false, videoTranscriptTermColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize VideoTranscriptTerm struct: %s", err)
+	}
+	if err = randomize.Struct(seed, videoTranscriptTermTwo, videoTranscriptTermDBTypes, false, videoTranscriptTermColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize VideoTranscriptTerm struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = videoTranscriptTermOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = videoTranscriptTermTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := VideoTranscriptTerms().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 2 {
+		t.Error("want 2 records, got:", len(slice))
+	}
+}
+
+func testVideoTranscriptTermsCount(t *testing.T) {
+	t.Parallel()
+
+	var err error
+	seed := randomize.NewSeed()
+	videoTranscriptTermOne := &VideoTranscriptTerm{}
+	videoTranscriptTermTwo := &VideoTranscriptTerm{}
+	if err = randomize.Struct(seed, videoTranscriptTermOne, videoTranscriptTermDBTypes, false, videoTranscriptTermColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize VideoTranscriptTerm struct: %s", err)
+	}
+	if err = randomize.Struct(seed, videoTranscriptTermTwo, videoTranscriptTermDBTypes, false, videoTranscriptTermColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize VideoTranscriptTerm struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = videoTranscriptTermOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = videoTranscriptTermTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := VideoTranscriptTerms().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 2 {
+		t.Error("want 2 records, got:", count)
+	}
+}
+
+func videoTranscriptTermBeforeInsertHook(ctx context.Context, e boil.ContextExecutor, o *VideoTranscriptTerm) error {
+	*o = VideoTranscriptTerm{}
+	return nil
+}
+
+func videoTranscriptTermAfterInsertHook(ctx context.Context, e boil.ContextExecutor, o *VideoTranscriptTerm) error {
+	*o = VideoTranscriptTerm{}
+	return nil
+}
+
+func videoTranscriptTermAfterSelectHook(ctx context.Context, e boil.ContextExecutor, o *VideoTranscriptTerm) error {
+	*o = VideoTranscriptTerm{}
+	return nil
+}
+
+func videoTranscriptTermBeforeUpdateHook(ctx context.Context, e boil.ContextExecutor, o *VideoTranscriptTerm) error {
+	*o = VideoTranscriptTerm{}
+	return nil
+}
+
+func videoTranscriptTermAfterUpdateHook(ctx context.Context, e boil.ContextExecutor, o *VideoTranscriptTerm) error {
+	*o = VideoTranscriptTerm{}
+	return nil
+}
+
+func videoTranscriptTermBeforeDeleteHook(ctx context.Context, e boil.ContextExecutor, o *VideoTranscriptTerm) error {
+	*o = VideoTranscriptTerm{}
+	return nil
+}
+
+func videoTranscriptTermAfterDeleteHook(ctx context.Context, e boil.ContextExecutor, o *VideoTranscriptTerm) error {
+	*o = VideoTranscriptTerm{}
+	return nil
+}
+
+func videoTranscriptTermBeforeUpsertHook(ctx context.Context, e boil.ContextExecutor, o *VideoTranscriptTerm) error {
+	*o = VideoTranscriptTerm{}
+	return nil
+}
+
+func videoTranscriptTermAfterUpsertHook(ctx context.Context, e boil.ContextExecutor, o *VideoTranscriptTerm) error {
+	*o = VideoTranscriptTerm{}
+	return nil
+}
+
+func testVideoTranscriptTermsHooks(t *testing.T) {
+	t.Parallel()
+
+	var err error
+
+	ctx := context.Background()
+	empty := &VideoTranscriptTerm{}
+	o := &VideoTranscriptTerm{}
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, o, videoTranscriptTermDBTypes, false); err != nil {
+		t.Errorf("Unable to randomize VideoTranscriptTerm object: %s", err)
+	}
+
+	AddVideoTranscriptTermHook(boil.BeforeInsertHook, videoTranscriptTermBeforeInsertHook)
+	if err = o.doBeforeInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeInsertHook function to empty object, but got: %#v", o)
+	}
+	videoTranscriptTermBeforeInsertHooks = []VideoTranscriptTermHook{}
+
+	AddVideoTranscriptTermHook(boil.AfterInsertHook, videoTranscriptTermAfterInsertHook)
+	if err = o.doAfterInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterInsertHook function to empty object, but got: %#v", o)
+	}
+	videoTranscriptTermAfterInsertHooks = []VideoTranscriptTermHook{}
+
+	AddVideoTranscriptTermHook(boil.AfterSelectHook, videoTranscriptTermAfterSelectHook)
+	if err = o.doAfterSelectHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterSelectHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterSelectHook function to empty object, but got: %#v", o)
+	}
+	videoTranscriptTermAfterSelectHooks = []VideoTranscriptTermHook{}
+
+	AddVideoTranscriptTermHook(boil.BeforeUpdateHook, videoTranscriptTermBeforeUpdateHook)
+	if err = o.doBeforeUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpdateHook function to empty object, but got: %#v", o)
+	}
+	videoTranscriptTermBeforeUpdateHooks = []VideoTranscriptTermHook{}
+
+	AddVideoTranscriptTermHook(boil.AfterUpdateHook, videoTranscriptTermAfterUpdateHook)
+	if err = o.doAfterUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpdateHook function to empty object, but got: %#v", o)
+	}
+	videoTranscriptTermAfterUpdateHooks = []VideoTranscriptTermHook{}
+
+	AddVideoTranscriptTermHook(boil.BeforeDeleteHook, videoTranscriptTermBeforeDeleteHook)
+	if err = o.doBeforeDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeDeleteHook function to empty object, but got: %#v", o)
+	}
+	videoTranscriptTermBeforeDeleteHooks = []VideoTranscriptTermHook{}
+
+	AddVideoTranscriptTermHook(boil.AfterDeleteHook, videoTranscriptTermAfterDeleteHook)
+	if err = o.doAfterDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterDeleteHook function to empty object, but got: %#v", o)
+	}
+	videoTranscriptTermAfterDeleteHooks = []VideoTranscriptTermHook{}
+
+	AddVideoTranscriptTermHook(boil.BeforeUpsertHook, videoTranscriptTermBeforeUpsertHook)
+	if err = o.doBeforeUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpsertHook function to empty object, but got: %#v", o)
+	}
+	videoTranscriptTermBeforeUpsertHooks = []VideoTranscriptTermHook{}
+
+	AddVideoTranscriptTermHook(boil.AfterUpsertHook, videoTranscriptTermAfterUpsertHook)
+	if err = o.doAfterUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpsertHook function to empty object, but got: %#v", o)
+	}
+	videoTranscriptTermAfterUpsertHooks = []VideoTranscriptTermHook{}
+}
+
+func testVideoTranscriptTermsInsert(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &VideoTranscriptTerm{}
+	if err = randomize.Struct(seed, o, videoTranscriptTermDBTypes, true, videoTranscriptTermColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize VideoTranscriptTerm struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := VideoTranscriptTerms().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testVideoTranscriptTermsInsertWhitelist(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &VideoTranscriptTerm{}
+	if err = randomize.Struct(seed, o, videoTranscriptTermDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize VideoTranscriptTerm struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Whitelist(strmangle.SetMerge(videoTranscriptTermPrimaryKeyColumns, videoTranscriptTermColumnsWithoutDefault)...)); err != nil {
+		t.Error(err)
+	}
+
+	count, err := VideoTranscriptTerms().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testVideoTranscriptTermToOneVideoTranscriptUsingTranscript(t *testing.T) {
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var local VideoTranscriptTerm
+	var foreign VideoTranscript
+
+	seed := randomize.NewSeed()
+	if err := randomize.Struct(seed, &local, videoTranscriptTermDBTypes, false, videoTranscriptTermColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize VideoTranscriptTerm struct: %s", err)
+	}
+	if err := randomize.Struct(seed, &foreign, videoTranscriptDBTypes, false, videoTranscriptColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize VideoTranscript struct: %s", err)
+	}
+
+	if err := foreign.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	local.TranscriptID = foreign.ID
+	if err := local.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := local.Transcript().One(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if check.ID != foreign.ID {
+		t.Errorf("want: %v, got %v", foreign.ID, check.ID)
+	}
+
+	ranAfterSelectHook := false
+	AddVideoTranscriptHook(boil.AfterSelectHook, func(ctx context.Context, e boil.ContextExecutor, o *VideoTranscript) error {
+		ranAfterSelectHook = true
+		return nil
+	})
+
+	slice := VideoTranscriptTermSlice{&local}
+	if err = local.L.LoadTranscript(ctx, tx, false, (*[]*VideoTranscriptTerm)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.Transcript == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	local.R.Transcript = nil
+	if err = local.L.LoadTranscript(ctx, tx, true, &local, nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.Transcript == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	if !ranAfterSelectHook {
+		t.Error("failed to run AfterSelect hook for relationship")
+	}
+}
+
+func testVideoTranscriptTermToOneSetOpVideoTranscriptUsingTranscript(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a VideoTranscriptTerm
+	var b, c VideoTranscript
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, videoTranscriptTermDBTypes, false, strmangle.SetComplement(videoTranscriptTermPrimaryKeyColumns, videoTranscriptTermColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, videoTranscriptDBTypes, false, strmangle.SetComplement(videoTranscriptPrimaryKeyColumns, videoTranscriptColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, videoTranscriptDBTypes, false, strmangle.SetComplement(videoTranscriptPrimaryKeyColumns, videoTranscriptColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	for i, x := range []*VideoTranscript{&b, &c} {
+		err = a.SetTranscript(ctx, tx, i != 0, x)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if a.R.Transcript != x {
+			t.Error("relationship struct not set to correct value")
+		}
+
+		if x.R.TranscriptVideoTranscriptTerms[0] != &a {
+			t.Error("failed to append to foreign relationship struct")
+		}
+		if a.TranscriptID != x.ID {
+			t.Error("foreign key was wrong value", a.TranscriptID)
+		}
+
+		if exists, err := VideoTranscriptTermExists(ctx, tx, a.Term, a.TranscriptID); err != nil {
+			t.Fatal(err)
+		} else if !exists {
+			t.Error("want 'a' to exist")
+		}
+
+	}
+}
+
+func testVideoTranscriptTermsReload(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &VideoTranscriptTerm{}
+	if err = randomize.Struct(seed, o, videoTranscriptTermDBTypes, true, videoTranscriptTermColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize VideoTranscriptTerm struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = o.Reload(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testVideoTranscriptTermsReloadAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &VideoTranscriptTerm{}
+	if err = randomize.Struct(seed, o, videoTranscriptTermDBTypes, true, videoTranscriptTermColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize VideoTranscriptTerm struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := VideoTranscriptTermSlice{o}
+
+	if err = slice.ReloadAll(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testVideoTranscriptTermsSelect(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &VideoTranscriptTerm{}
+	if err = randomize.Struct(seed, o, videoTranscriptTermDBTypes, true, videoTranscriptTermColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize VideoTranscriptTerm struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := VideoTranscriptTerms().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 1 {
+		t.Error("want one record, got:", len(slice))
+	}
+}
+
+var (
+	videoTranscriptTermDBTypes = map[string]string{`Term`: `TEXT`, `TranscriptID`: `TEXT`, `StartSeconds`: `INTEGER`}
+	_                          = bytes.MinRead
+)
+
+func testVideoTranscriptTermsUpdate(t *testing.T) {
+	t.Parallel()
+
+	if 0 == len(videoTranscriptTermPrimaryKeyColumns) {
+		t.Skip("Skipping table with no primary key columns")
+	}
+	if len(videoTranscriptTermAllColumns) == len(videoTranscriptTermPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &VideoTranscriptTerm{}
+	if err = randomize.Struct(seed, o, videoTranscriptTermDBTypes, true, videoTranscriptTermColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize VideoTranscriptTerm struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := VideoTranscriptTerms().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, videoTranscriptTermDBTypes, true, videoTranscriptTermPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize VideoTranscriptTerm struct: %s", err)
+	}
+
+	if rowsAff, err := o.Update(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only affect one row but affected", rowsAff)
+	}
+}
+
+func testVideoTranscriptTermsSliceUpdateAll(t *testing.T) {
+	t.Parallel()
+
+	if len(videoTranscriptTermAllColumns) == len(videoTranscriptTermPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &VideoTranscriptTerm{}
+	if err = randomize.Struct(seed, o, videoTranscriptTermDBTypes, true, videoTranscriptTermColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize VideoTranscriptTerm struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := VideoTranscriptTerms().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, videoTranscriptTermDBTypes, true, videoTranscriptTermPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize VideoTranscriptTerm struct: %s", err)
+	}
+
+	// Remove Primary keys and unique columns from what we plan to update
+	var fields []string
+	if strmangle.StringSliceMatch(videoTranscriptTermAllColumns, videoTranscriptTermPrimaryKeyColumns) {
+		fields = videoTranscriptTermAllColumns
+	} else {
+		fields = strmangle.SetComplement(
+			videoTranscriptTermAllColumns,
+			videoTranscriptTermPrimaryKeyColumns,
+		)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	typ := reflect.TypeOf(o).Elem()
+	n := typ.NumField()
+
+	updateMap := M{}
+	for _, col := range fields {
+		for i := 0; i < n; i++ {
+			f := typ.Field(i)
+			if f.Tag.Get("boil") == col {
+				updateMap[col] = value.Field(i).Interface()
+			}
+		}
+	}
+
+	slice := VideoTranscriptTermSlice{o}
+	if rowsAff, err := slice.UpdateAll(ctx, tx, updateMap); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("wanted one record updated but got", rowsAff)
+	}
+}
+
+func testVideoTranscriptTermsUpsert(t *testing.T) {
+	t.Parallel()
+	if len(videoTranscriptTermAllColumns) == len(videoTranscriptTermPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	// Attempt the INSERT side of an UPSERT
+	o := VideoTranscriptTerm{}
+	if err = randomize.Struct(seed, &o, videoTranscriptTermDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize VideoTranscriptTerm struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Upsert(ctx, tx, false, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert VideoTranscriptTerm: %s", err)
+	}
+
+	count, err := VideoTranscriptTerms().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	// Attempt the UPDATE side of an UPSERT
+	if err = randomize.Struct(seed, &o, videoTranscriptTermDBTypes, false, videoTranscriptTermPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize VideoTranscriptTerm struct: %s", err)
+	}
+
+	if err = o.Upsert(ctx, tx, true, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert VideoTranscriptTerm: %s", err)
+	}
+
+	count, err = VideoTranscriptTerms().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
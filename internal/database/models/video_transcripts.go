@@ -0,0 +1,1393 @@
+// Code generated by SQLBoiler 4.19.7 (https://github.com/aarondl/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries"
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+	"github.com/aarondl/sqlboiler/v4/queries/qmhelper"
+	"github.com/aarondl/strmangle"
+	"github.com/friendsofgo/errors"
+)
+
+// VideoTranscript is an object representing the database table.
+type VideoTranscript struct {
+	ID            string    `boil:"id" json:"id" toml:"id" yaml:"id"`
+	CreatedAt     time.Time `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+	UpdatedAt     time.Time `boil:"updated_at" json:"updated_at" toml:"updated_at" yaml:"updated_at"`
+	VideoID       string    `boil:"video_id" json:"video_id" toml:"video_id" yaml:"video_id"`
+	Language      string    `boil:"language" json:"language" toml:"language" yaml:"language"`
+	Name          string    `boil:"name" json:"name" toml:"name" yaml:"name"`
+	AutoGenerated bool      `boil:"auto_generated" json:"auto_generated" toml:"auto_generated" yaml:"auto_generated"`
+	CueCount      int64     `boil:"cue_count" json:"cue_count" toml:"cue_count" yaml:"cue_count"`
+	Content       []byte    `boil:"content" json:"content" toml:"content" yaml:"content"`
+
+	R *videoTranscriptR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L videoTranscriptL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var VideoTranscriptColumns = struct {
+	ID            string
+	CreatedAt     string
+	UpdatedAt     string
+	VideoID       string
+	Language      string
+	Name          string
+	AutoGenerated string
+	CueCount      string
+	Content       string
+}{
+	ID:            "id",
+	CreatedAt:     "created_at",
+	UpdatedAt:     "updated_at",
+	VideoID:       "video_id",
+	Language:      "language",
+	Name:          "name",
+	AutoGenerated: "auto_generated",
+	CueCount:      "cue_count",
+	Content:       "content",
+}
+
+var VideoTranscriptTableColumns = struct {
+	ID            string
+	CreatedAt     string
+	UpdatedAt     string
+	VideoID       string
+	Language      string
+	Name          string
+	AutoGenerated string
+	CueCount      string
+	Content       string
+}{
+	ID:            "video_transcripts.id",
+	CreatedAt:     "video_transcripts.created_at",
+	UpdatedAt:     "video_transcripts.updated_at",
+	VideoID:       "video_transcripts.video_id",
+	Language:      "video_transcripts.language",
+	Name:          "video_transcripts.name",
+	AutoGenerated: "video_transcripts.auto_generated",
+	CueCount:      "video_transcripts.cue_count",
+	Content:       "video_transcripts.content",
+}
+
+// Generated where
+
+var VideoTranscriptWhere = struct {
+	ID            whereHelperstring
+	CreatedAt     whereHelpertime_Time
+	UpdatedAt     whereHelpertime_Time
+	VideoID       whereHelperstring
+	Language      whereHelperstring
+	Name          whereHelperstring
+	AutoGenerated whereHelperbool
+	CueCount      whereHelperint64
+	Content       whereHelper__byte
+}{
+	ID:            whereHelperstring{field: "\"video_transcripts\".\"id\""},
+	CreatedAt:     whereHelpertime_Time{field: "\"video_transcripts\".\"created_at\""},
+	UpdatedAt:     whereHelpertime_Time{field: "\"video_transcripts\".\"updated_at\""},
+	VideoID:       whereHelperstring{field: "\"video_transcripts\".\"video_id\""},
+	Language:      whereHelperstring{field: "\"video_transcripts\".\"language\""},
+	Name:          whereHelperstring{field: "\"video_transcripts\".\"name\""},
+	AutoGenerated: whereHelperbool{field: "\"video_transcripts\".\"auto_generated\""},
+	CueCount:      whereHelperint64{field: "\"video_transcripts\".\"cue_count\""},
+	Content:       whereHelper__byte{field: "\"video_transcripts\".\"content\""},
+}
+
+// VideoTranscriptRels is where relationship names are stored.
+var VideoTranscriptRels = struct {
+	Video                          string
+	TranscriptVideoTranscriptTerms string
+}{
+	Video:                          "Video",
+	TranscriptVideoTranscriptTerms: "TranscriptVideoTranscriptTerms",
+}
+
+// videoTranscriptR is where relationships are stored.
+type videoTranscriptR struct {
+	Video                          *Video                   `boil:"Video" json:"Video" toml:"Video" yaml:"Video"`
+	TranscriptVideoTranscriptTerms VideoTranscriptTermSlice `boil:"TranscriptVideoTranscriptTerms" json:"TranscriptVideoTranscriptTerms" toml:"TranscriptVideoTranscriptTerms" yaml:"TranscriptVideoTranscriptTerms"`
+}
+
+// NewStruct creates a new relationship struct
+func (*videoTranscriptR) NewStruct() *videoTranscriptR {
+	return &videoTranscriptR{}
+}
+
+func (o *VideoTranscript) GetVideo() *Video {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetVideo()
+}
+
+func (r *videoTranscriptR) GetVideo() *Video {
+	if r == nil {
+		return nil
+	}
+
+	return r.Video
+}
+
+func (o *VideoTranscript) GetTranscriptVideoTranscriptTerms() VideoTranscriptTermSlice {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetTranscriptVideoTranscriptTerms()
+}
+
+func (r *videoTranscriptR) GetTranscriptVideoTranscriptTerms() VideoTranscriptTermSlice {
+	if r == nil {
+		return nil
+	}
+
+	return r.TranscriptVideoTranscriptTerms
+}
+
+// videoTranscriptL is where Load methods for each relationship are stored.
+type videoTranscriptL struct{}
+
+var (
+	videoTranscriptAllColumns            = []string{"id", "created_at", "updated_at", "video_id", "language", "name", "auto_generated", "cue_count", "content"}
+	videoTranscriptColumnsWithoutDefault = []string{"id", "created_at", "updated_at", "video_id", "language", "name", "auto_generated", "cue_count", "content"}
+	videoTranscriptColumnsWithDefault    = []string{}
+	videoTranscriptPrimaryKeyColumns     = []string{"id"}
+	videoTranscriptGeneratedColumns      = []string{}
+)
+
+type (
+	// VideoTranscriptSlice is an alias for a slice of pointers to VideoTranscript.
+	// This should almost always be used instead of []VideoTranscript.
+	VideoTranscriptSlice []*VideoTranscript
+	// VideoTranscriptHook is the signature for custom VideoTranscript hook methods
+	VideoTranscriptHook func(context.Context, boil.ContextExecutor, *VideoTranscript) error
+
+	videoTranscriptQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	videoTranscriptType                 = reflect.TypeOf(&VideoTranscript{})
+	videoTranscriptMapping              = queries.MakeStructMapping(videoTranscriptType)
+	videoTranscriptPrimaryKeyMapping, _ = queries.BindMapping(videoTranscriptType, videoTranscriptMapping, videoTranscriptPrimaryKeyColumns)
+	videoTranscriptInsertCacheMut       sync.RWMutex
+	videoTranscriptInsertCache          = make(map[string]insertCache)
+	videoTranscriptUpdateCacheMut       sync.RWMutex
+	videoTranscriptUpdateCache          = make(map[string]updateCache)
+	videoTranscriptUpsertCacheMut       sync.RWMutex
+	videoTranscriptUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var videoTranscriptAfterSelectMu sync.Mutex
+var videoTranscriptAfterSelectHooks []VideoTranscriptHook
+
+var videoTranscriptBeforeInsertMu sync.Mutex
+var videoTranscriptBeforeInsertHooks []VideoTranscriptHook
+var videoTranscriptAfterInsertMu sync.Mutex
+var videoTranscriptAfterInsertHooks []VideoTranscriptHook
+
+var videoTranscriptBeforeUpdateMu sync.Mutex
+var videoTranscriptBeforeUpdateHooks []VideoTranscriptHook
+var videoTranscriptAfterUpdateMu sync.Mutex
+var videoTranscriptAfterUpdateHooks []VideoTranscriptHook
+
+var videoTranscriptBeforeDeleteMu sync.Mutex
+var videoTranscriptBeforeDeleteHooks []VideoTranscriptHook
+var videoTranscriptAfterDeleteMu sync.Mutex
+var videoTranscriptAfterDeleteHooks []VideoTranscriptHook
+
+var videoTranscriptBeforeUpsertMu sync.Mutex
+var videoTranscriptBeforeUpsertHooks []VideoTranscriptHook
+var videoTranscriptAfterUpsertMu sync.Mutex
+var videoTranscriptAfterUpsertHooks []VideoTranscriptHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *VideoTranscript) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range videoTranscriptAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *VideoTranscript) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range videoTranscriptBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *VideoTranscript) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range videoTranscriptAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *VideoTranscript) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range videoTranscriptBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *VideoTranscript) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range videoTranscriptAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *VideoTranscript) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range videoTranscriptBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *VideoTranscript) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range videoTranscriptAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *VideoTranscript) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range videoTranscriptBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *VideoTranscript) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range videoTranscriptAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddVideoTranscriptHook registers your hook function for all future operations.
+func AddVideoTranscriptHook(hookPoint boil.HookPoint, videoTranscriptHook VideoTranscriptHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		videoTranscriptAfterSelectMu.Lock()
+		videoTranscriptAfterSelectHooks = append(videoTranscriptAfterSelectHooks, videoTranscriptHook)
+		videoTranscriptAfterSelectMu.Unlock()
+	case boil.BeforeInsertHook:
+		videoTranscriptBeforeInsertMu.Lock()
+		videoTranscriptBeforeInsertHooks = append(videoTranscriptBeforeInsertHooks, videoTranscriptHook)
+		videoTranscriptBeforeInsertMu.Unlock()
+	case boil.AfterInsertHook:
+		videoTranscriptAfterInsertMu.Lock()
+		videoTranscriptAfterInsertHooks = append(videoTranscriptAfterInsertHooks, videoTranscriptHook)
+		videoTranscriptAfterInsertMu.Unlock()
+	case boil.BeforeUpdateHook:
+		videoTranscriptBeforeUpdateMu.Lock()
+		videoTranscriptBeforeUpdateHooks = append(videoTranscriptBeforeUpdateHooks, videoTranscriptHook)
+		videoTranscriptBeforeUpdateMu.Unlock()
+	case boil.AfterUpdateHook:
+		videoTranscriptAfterUpdateMu.Lock()
+		videoTranscriptAfterUpdateHooks = append(videoTranscriptAfterUpdateHooks, videoTranscriptHook)
+		videoTranscriptAfterUpdateMu.Unlock()
+	case boil.BeforeDeleteHook:
+		videoTranscriptBeforeDeleteMu.Lock()
+		videoTranscriptBeforeDeleteHooks = append(videoTranscriptBeforeDeleteHooks, videoTranscriptHook)
+		videoTranscriptBeforeDeleteMu.Unlock()
+	case boil.AfterDeleteHook:
+		videoTranscriptAfterDeleteMu.Lock()
+		videoTranscriptAfterDeleteHooks = append(videoTranscriptAfterDeleteHooks, videoTranscriptHook)
+		videoTranscriptAfterDeleteMu.Unlock()
+	case boil.BeforeUpsertHook:
+		videoTranscriptBeforeUpsertMu.Lock()
+		videoTranscriptBeforeUpsertHooks = append(videoTranscriptBeforeUpsertHooks, videoTranscriptHook)
+		videoTranscriptBeforeUpsertMu.Unlock()
+	case boil.AfterUpsertHook:
+		videoTranscriptAfterUpsertMu.Lock()
+		videoTranscriptAfterUpsertHooks = append(videoTranscriptAfterUpsertHooks, videoTranscriptHook)
+		videoTranscriptAfterUpsertMu.Unlock()
+	}
+}
+
+// One returns a single videoTranscript record from the query.
+func (q videoTranscriptQuery) One(ctx context.Context, exec boil.ContextExecutor) (*VideoTranscript, error) {
+	o := &VideoTranscript{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: failed to execute a one query for video_transcripts")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all VideoTranscript records from the query.
+func (q videoTranscriptQuery) All(ctx context.Context, exec boil.ContextExecutor) (VideoTranscriptSlice, error) {
+	var o []*VideoTranscript
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "models: failed to assign all query results to VideoTranscript slice")
+	}
+
+	if len(videoTranscriptAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all VideoTranscript records in the query.
+func (q videoTranscriptQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to count video_transcripts rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q videoTranscriptQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "models: failed to check if video_transcripts exists")
+	}
+
+	return count > 0, nil
+}
+
+// Video pointed to by the foreign key.
+func (o *VideoTranscript) Video(mods ...qm.QueryMod) videoQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.VideoID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Videos(queryMods...)
+}
+
+// TranscriptVideoTranscriptTerms retrieves all the video_transcript_term's VideoTranscriptTerms with an executor via transcript_id column.
+func (o *VideoTranscript) TranscriptVideoTranscriptTerms(mods ...qm.QueryMod) videoTranscriptTermQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"video_transcript_terms\".\"transcript_id\"=?", o.ID),
+	)
+
+	return VideoTranscriptTerms(queryMods...)
+}
+
+// LoadVideo allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (videoTranscriptL) LoadVideo(ctx context.Context, e boil.ContextExecutor, singular bool, maybeVideoTranscript any, mods queries.Applicator) error {
+	var slice []*VideoTranscript
+	var object *VideoTranscript
+
+	if singular {
+		var ok bool
+		object, ok = maybeVideoTranscript.(*VideoTranscript)
+		if !ok {
+			object = new(VideoTranscript)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeVideoTranscript)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeVideoTranscript))
+			}
+		}
+	} else {
+		s, ok := maybeVideoTranscript.(*[]*VideoTranscript)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeVideoTranscript)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeVideoTranscript))
+			}
+		}
+	}
+
+	args := make(map[any]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &videoTranscriptR{}
+		}
+		args[object.VideoID] = struct{}{}
+
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &videoTranscriptR{}
+			}
+
+			args[obj.VideoID] = struct{}{}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]any, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`videos`),
+		qm.WhereIn(`videos.id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load Video")
+	}
+
+	var resultSlice []*Video
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice Video")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for videos")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for videos")
+	}
+
+	if len(videoAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Video = foreign
+		if foreign.R == nil {
+			foreign.R = &videoR{}
+		}
+		foreign.R.VideoTranscripts = append(foreign.R.VideoTranscripts, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.VideoID == foreign.ID {
+				local.R.Video = foreign
+				if foreign.R == nil {
+					foreign.R = &videoR{}
+				}
+				foreign.R.VideoTranscripts = append(foreign.R.VideoTranscripts, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadTranscriptVideoTranscriptTerms allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (videoTranscriptL) LoadTranscriptVideoTranscriptTerms(ctx context.Context, e boil.ContextExecutor, singular bool, maybeVideoTranscript any, mods queries.Applicator) error {
+	var slice []*VideoTranscript
+	var object *VideoTranscript
+
+	if singular {
+		var ok bool
+		object, ok = maybeVideoTranscript.(*VideoTranscript)
+		if !ok {
+			object = new(VideoTranscript)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeVideoTranscript)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeVideoTranscript))
+			}
+		}
+	} else {
+		s, ok := maybeVideoTranscript.(*[]*VideoTranscript)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeVideoTranscript)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeVideoTranscript))
+			}
+		}
+	}
+
+	args := make(map[any]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &videoTranscriptR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &videoTranscriptR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]any, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`video_transcript_terms`),
+		qm.WhereIn(`video_transcript_terms.transcript_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load video_transcript_terms")
+	}
+
+	var resultSlice []*VideoTranscriptTerm
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice video_transcript_terms")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on video_transcript_terms")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for video_transcript_terms")
+	}
+
+	if len(videoTranscriptTermAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.TranscriptVideoTranscriptTerms = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &videoTranscriptTermR{}
+			}
+			foreign.R.Transcript = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.TranscriptID {
+				local.R.TranscriptVideoTranscriptTerms = append(local.R.TranscriptVideoTranscriptTerms, foreign)
+				if foreign.R == nil {
+					foreign.R = &videoTranscriptTermR{}
+				}
+				foreign.R.Transcript = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetVideo of the videoTranscript to the related item.
+// Sets o.R.Video to related.
+// Adds o to related.R.VideoTranscripts.
+func (o *VideoTranscript) SetVideo(ctx context.Context, exec boil.ContextExecutor, insert bool, related *Video) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"video_transcripts\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 0, []string{"video_id"}),
+		strmangle.WhereClause("\"", "\"", 0, videoTranscriptPrimaryKeyColumns),
+	)
+	values := []any{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.VideoID = related.ID
+	if o.R == nil {
+		o.R = &videoTranscriptR{
+			Video: related,
+		}
+	} else {
+		o.R.Video = related
+	}
+
+	if related.R == nil {
+		related.R = &videoR{
+			VideoTranscripts: VideoTranscriptSlice{o},
+		}
+	} else {
+		related.R.VideoTranscripts = append(related.R.VideoTranscripts, o)
+	}
+
+	return nil
+}
+
+// AddTranscriptVideoTranscriptTerms adds the given related objects to the existing relationships
+// of the video_transcript, optionally inserting them as new records.
+// Appends related to o.R.TranscriptVideoTranscriptTerms.
+// Sets related.R.Transcript appropriately.
+func (o *VideoTranscript) AddTranscriptVideoTranscriptTerms(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*VideoTranscriptTerm) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.TranscriptID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"video_transcript_terms\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 0, []string{"transcript_id"}),
+				strmangle.WhereClause("\"", "\"", 0, videoTranscriptTermPrimaryKeyColumns),
+			)
+			values := []any{o.ID, rel.Term, rel.TranscriptID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.TranscriptID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &videoTranscriptR{
+			TranscriptVideoTranscriptTerms: related,
+		}
+	} else {
+		o.R.TranscriptVideoTranscriptTerms = append(o.R.TranscriptVideoTranscriptTerms, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &videoTranscriptTermR{
+				Transcript: o,
+			}
+		} else {
+			rel.R.Transcript = o
+		}
+	}
+	return nil
+}
+
+// VideoTranscripts retrieves all the records using an executor.
+func VideoTranscripts(mods ...qm.QueryMod) videoTranscriptQuery {
+	mods = append(mods, qm.From("\"video_transcripts\""))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"\"video_transcripts\".*"})
+	}
+
+	return videoTranscriptQuery{q}
+}
+
+// FindVideoTranscript retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindVideoTranscript(ctx context.Context, exec boil.ContextExecutor, iD string, selectCols ...string) (*VideoTranscript, error) {
+	videoTranscriptObj := &VideoTranscript{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"video_transcripts\" where \"id\"=?", sel,
+	)
+
+	q := queries.Raw(query, iD)
+
+	err := q.Bind(ctx, exec, videoTranscriptObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: unable to select from video_transcripts")
+	}
+
+	if err = videoTranscriptObj.doAfterSelectHooks(ctx, exec); err != nil {
+		return videoTranscriptObj, err
+	}
+
+	return videoTranscriptObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *VideoTranscript) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no video_transcripts provided for insertion")
+	}
+
+	var err error
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+		if o.UpdatedAt.IsZero() {
+			o.UpdatedAt = currTime
+		}
+	}
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(videoTranscriptColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	videoTranscriptInsertCacheMut.RLock()
+	cache, cached := videoTranscriptInsertCache[key]
+	videoTranscriptInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			videoTranscriptAllColumns,
+			videoTranscriptColumnsWithDefault,
+			videoTranscriptColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(videoTranscriptType, videoTranscriptMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(videoTranscriptType, videoTranscriptMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"video_transcripts\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"video_transcripts\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "models: unable to insert into video_transcripts")
+	}
+
+	if !cached {
+		videoTranscriptInsertCacheMut.Lock()
+		videoTranscriptInsertCache[key] = cache
+		videoTranscriptInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// Update uses an executor to update the VideoTranscript.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *VideoTranscript) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		o.UpdatedAt = currTime
+	}
+
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	videoTranscriptUpdateCacheMut.RLock()
+	cache, cached := videoTranscriptUpdateCache[key]
+	videoTranscriptUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			videoTranscriptAllColumns,
+			videoTranscriptPrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("models: unable to update video_transcripts, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"video_transcripts\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 0, wl),
+			strmangle.WhereClause("\"", "\"", 0, videoTranscriptPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(videoTranscriptType, videoTranscriptMapping, append(wl, videoTranscriptPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update video_transcripts row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by update for video_transcripts")
+	}
+
+	if !cached {
+		videoTranscriptUpdateCacheMut.Lock()
+		videoTranscriptUpdateCache[key] = cache
+		videoTranscriptUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q videoTranscriptQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all for video_transcripts")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected for video_transcripts")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o VideoTranscriptSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("models: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]any, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), videoTranscriptPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"video_transcripts\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 0, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, videoTranscriptPrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all in videoTranscript slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected all in update all videoTranscript")
+	}
+	return rowsAff, nil
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *VideoTranscript) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no video_transcripts provided for upsert")
+	}
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+		o.UpdatedAt = currTime
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(videoTranscriptColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	videoTranscriptUpsertCacheMut.RLock()
+	cache, cached := videoTranscriptUpsertCache[key]
+	videoTranscriptUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, _ := insertColumns.InsertColumnSet(
+			videoTranscriptAllColumns,
+			videoTranscriptColumnsWithDefault,
+			videoTranscriptColumnsWithoutDefault,
+			nzDefaults,
+		)
+		update := updateColumns.UpdateColumnSet(
+			videoTranscriptAllColumns,
+			videoTranscriptPrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("models: unable to upsert video_transcripts, could not build update column list")
+		}
+
+		ret := strmangle.SetComplement(videoTranscriptAllColumns, strmangle.SetIntersect(insert, update))
+
+		conflict := conflictColumns
+		if len(conflict) == 0 {
+			conflict = make([]string, len(videoTranscriptPrimaryKeyColumns))
+			copy(conflict, videoTranscriptPrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQuerySQLite(dialect, "\"video_transcripts\"", updateOnConflict, ret, update, conflict, insert)
+
+		cache.valueMapping, err = queries.BindMapping(videoTranscriptType, videoTranscriptMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(videoTranscriptType, videoTranscriptMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []any
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if errors.Is(err, sql.ErrNoRows) {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "models: unable to upsert video_transcripts")
+	}
+
+	if !cached {
+		videoTranscriptUpsertCacheMut.Lock()
+		videoTranscriptUpsertCache[key] = cache
+		videoTranscriptUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// Delete deletes a single VideoTranscript record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *VideoTranscript) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("models: no VideoTranscript provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), videoTranscriptPrimaryKeyMapping)
+	sql := "DELETE FROM \"video_transcripts\" WHERE \"id\"=?"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete from video_transcripts")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by delete for video_transcripts")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q videoTranscriptQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("models: no videoTranscriptQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from video_transcripts")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for video_transcripts")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o VideoTranscriptSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(videoTranscriptBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []any
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), videoTranscriptPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"video_transcripts\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, videoTranscriptPrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from videoTranscript slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for video_transcripts")
+	}
+
+	if len(videoTranscriptAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *VideoTranscript) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindVideoTranscript(ctx, exec, o.ID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *VideoTranscriptSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := VideoTranscriptSlice{}
+	var args []any
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), videoTranscriptPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"video_transcripts\".* FROM \"video_transcripts\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, videoTranscriptPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to reload all in VideoTranscriptSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// VideoTranscriptExists checks if the VideoTranscript row exists.
+func VideoTranscriptExists(ctx context.Context, exec boil.ContextExecutor, iD string) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"video_transcripts\" where \"id\"=? limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, iD)
+	}
+	row := exec.QueryRowContext(ctx, sql, iD)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "models: unable to check if video_transcripts exists")
+	}
+
+	return exists, nil
+}
+
+// Exists checks if the VideoTranscript row exists.
+func (o *VideoTranscript) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	return VideoTranscriptExists(ctx, exec, o.ID)
+}
@@ -0,0 +1,1003 @@
+// Code generated by SQLBoiler 4.19.7 (https://github.com/aarondl/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"bytes"
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/aarondl/randomize"
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries"
+	"github.com/aarondl/strmangle"
+)
+
+var (
+	// Relationships sometimes use the reflection helper queries.Equal/queries.Assign
+	// so force a package dependency in case they don't.
+	_ = queries.Equal
+)
+
+func testVideoTranscripts(t *testing.T) {
+	t.Parallel()
+
+	query := VideoTranscripts()
+
+	if query.Query == nil {
+		t.Error("expected a query, got nothing")
+	}
+}
+
+func testVideoTranscriptsDelete(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &VideoTranscript{}
+	if err = randomize.Struct(seed, o, videoTranscriptDBTypes, true, videoTranscriptColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize VideoTranscript struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := o.Delete(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := VideoTranscripts().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testVideoTranscriptsQueryDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &VideoTranscript{}
+	if err = randomize.Struct(seed, o, videoTranscriptDBTypes, true, videoTranscriptColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize VideoTranscript struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := VideoTranscripts().DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := VideoTranscripts().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testVideoTranscriptsSliceDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &VideoTranscript{}
+	if err = randomize.Struct(seed, o, videoTranscriptDBTypes, true, videoTranscriptColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize VideoTranscript struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := VideoTranscriptSlice{o}
+
+	if rowsAff, err := slice.DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := VideoTranscripts().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testVideoTranscriptsExists(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &VideoTranscript{}
+	if err = randomize.Struct(seed, o, videoTranscriptDBTypes, true, videoTranscriptColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize VideoTranscript struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	e, err := VideoTranscriptExists(ctx, tx, o.ID)
+	if err != nil {
+		t.Errorf("Unable to check if VideoTranscript exists: %s", err)
+	}
+	if !e {
+		t.Errorf("Expected VideoTranscriptExists to return true, but got false.")
+	}
+}
+
+func testVideoTranscriptsFind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &VideoTranscript{}
+	if err = randomize.Struct(seed, o, videoTranscriptDBTypes, true, videoTranscriptColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize VideoTranscript struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	videoTranscriptFound, err := FindVideoTranscript(ctx, tx, o.ID)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if videoTranscriptFound == nil {
+		t.Error("want a record, got nil")
+	}
+}
+
+func testVideoTranscriptsBind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &VideoTranscript{}
+	if err = randomize.Struct(seed, o, videoTranscriptDBTypes, true, videoTranscriptColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize VideoTranscript struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = VideoTranscripts().Bind(ctx, tx, o); err != nil {
+		t.Error(err)
+	}
+}
+
+func testVideoTranscriptsOne(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &VideoTranscript{}
+	if err = randomize.Struct(seed, o, videoTranscriptDBTypes, true, videoTranscriptColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize VideoTranscript struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if x, err := VideoTranscripts().One(ctx, tx); err != nil {
+		t.Error(err)
+	} else if x == nil {
+		t.Error("expected to get a non nil record")
+	}
+}
+
+func testVideoTranscriptsAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	videoTranscriptOne := &VideoTranscript{}
+	videoTranscriptTwo := &VideoTranscript{}
+	if err = randomize.Struct(seed, videoTranscriptOne, videoTranscriptDBTypes, false, videoTranscriptColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize VideoTranscript struct: %s", err)
+	}
+	if err = randomize.Struct(seed, videoTranscriptTwo, videoTranscriptDBTypes, false, videoTranscriptColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize VideoTranscript struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = videoTranscriptOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = videoTranscriptTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := VideoTranscripts().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 2 {
+		t.Error("want 2 records, got:", len(slice))
+	}
+}
+
+func testVideoTranscriptsCount(t *testing.T) {
+	t.Parallel()
+
+	var err error
+	seed := randomize.NewSeed()
+	videoTranscriptOne := &VideoTranscript{}
+	videoTranscriptTwo := &VideoTranscript{}
+	if err = randomize.Struct(seed, videoTranscriptOne, videoTranscriptDBTypes, false, videoTranscriptColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize VideoTranscript struct: %s", err)
+	}
+	if err = randomize.Struct(seed, videoTranscriptTwo, videoTranscriptDBTypes, false, videoTranscriptColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize VideoTranscript struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = videoTranscriptOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = videoTranscriptTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := VideoTranscripts().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 2 {
+		t.Error("want 2 records, got:", count)
+	}
+}
+
+func videoTranscriptBeforeInsertHook(ctx context.Context, e boil.ContextExecutor, o *VideoTranscript) error {
+	*o = VideoTranscript{}
+	return nil
+}
+
+func videoTranscriptAfterInsertHook(ctx context.Context, e boil.ContextExecutor, o *VideoTranscript) error {
+	*o = VideoTranscript{}
+	return nil
+}
+
+func videoTranscriptAfterSelectHook(ctx context.Context, e boil.ContextExecutor, o *VideoTranscript) error {
+	*o = VideoTranscript{}
+	return nil
+}
+
+func videoTranscriptBeforeUpdateHook(ctx context.Context, e boil.ContextExecutor, o *VideoTranscript) error {
+	*o = VideoTranscript{}
+	return nil
+}
+
+func videoTranscriptAfterUpdateHook(ctx context.Context, e boil.ContextExecutor, o *VideoTranscript) error {
+	*o = VideoTranscript{}
+	return nil
+}
+
+func videoTranscriptBeforeDeleteHook(ctx context.Context, e boil.ContextExecutor, o *VideoTranscript) error {
+	*o = VideoTranscript{}
+	return nil
+}
+
+func videoTranscriptAfterDeleteHook(ctx context.Context, e boil.ContextExecutor, o *VideoTranscript) error {
+	*o = VideoTranscript{}
+	return nil
+}
+
+func videoTranscriptBeforeUpsertHook(ctx context.Context, e boil.ContextExecutor, o *VideoTranscript) error {
+	*o = VideoTranscript{}
+	return nil
+}
+
+func videoTranscriptAfterUpsertHook(ctx context.Context, e boil.ContextExecutor, o *VideoTranscript) error {
+	*o = VideoTranscript{}
+	return nil
+}
+
+func testVideoTranscriptsHooks(t *testing.T) {
+	t.Parallel()
+
+	var err error
+
+	ctx := context.Background()
+	empty := &VideoTranscript{}
+	o := &VideoTranscript{}
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, o, videoTranscriptDBTypes, false); err != nil {
+		t.Errorf("Unable to randomize VideoTranscript object: %s", err)
+	}
+
+	AddVideoTranscriptHook(boil.BeforeInsertHook, videoTranscriptBeforeInsertHook)
+	if err = o.doBeforeInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeInsertHook function to empty object, but got: %#v", o)
+	}
+	videoTranscriptBeforeInsertHooks = []VideoTranscriptHook{}
+
+	AddVideoTranscriptHook(boil.AfterInsertHook, videoTranscriptAfterInsertHook)
+	if err = o.doAfterInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterInsertHook function to empty object, but got: %#v", o)
+	}
+	videoTranscriptAfterInsertHooks = []VideoTranscriptHook{}
+
+	AddVideoTranscriptHook(boil.AfterSelectHook, videoTranscriptAfterSelectHook)
+	if err = o.doAfterSelectHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterSelectHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterSelectHook function to empty object, but got: %#v", o)
+	}
+	videoTranscriptAfterSelectHooks = []VideoTranscriptHook{}
+
+	AddVideoTranscriptHook(boil.BeforeUpdateHook, videoTranscriptBeforeUpdateHook)
+	if err = o.doBeforeUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpdateHook function to empty object, but got: %#v", o)
+	}
+	videoTranscriptBeforeUpdateHooks = []VideoTranscriptHook{}
+
+	AddVideoTranscriptHook(boil.AfterUpdateHook, videoTranscriptAfterUpdateHook)
+	if err = o.doAfterUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpdateHook function to empty object, but got: %#v", o)
+	}
+	videoTranscriptAfterUpdateHooks = []VideoTranscriptHook{}
+
+	AddVideoTranscriptHook(boil.BeforeDeleteHook, videoTranscriptBeforeDeleteHook)
+	if err = o.doBeforeDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeDeleteHook function to empty object, but got: %#v", o)
+	}
+	videoTranscriptBeforeDeleteHooks = []VideoTranscriptHook{}
+
+	AddVideoTranscriptHook(boil.AfterDeleteHook, videoTranscriptAfterDeleteHook)
+	if err = o.doAfterDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterDeleteHook function to empty object, but got: %#v", o)
+	}
+	videoTranscriptAfterDeleteHooks = []VideoTranscriptHook{}
+
+	AddVideoTranscriptHook(boil.BeforeUpsertHook, videoTranscriptBeforeUpsertHook)
+	if err = o.doBeforeUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpsertHook function to empty object, but got: %#v", o)
+	}
+	videoTranscriptBeforeUpsertHooks = []VideoTranscriptHook{}
+
+	AddVideoTranscriptHook(boil.AfterUpsertHook, videoTranscriptAfterUpsertHook)
+	if err = o.doAfterUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpsertHook function to empty object, but got: %#v", o)
+	}
+	videoTranscriptAfterUpsertHooks = []VideoTranscriptHook{}
+}
+
+func testVideoTranscriptsInsert(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &VideoTranscript{}
+	if err = randomize.Struct(seed, o, videoTranscriptDBTypes, true, videoTranscriptColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize VideoTranscript struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := VideoTranscripts().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testVideoTranscriptsInsertWhitelist(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &VideoTranscript{}
+	if err = randomize.Struct(seed, o, videoTranscriptDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize VideoTranscript struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Whitelist(strmangle.SetMerge(videoTranscriptPrimaryKeyColumns, videoTranscriptColumnsWithoutDefault)...)); err != nil {
+		t.Error(err)
+	}
+
+	count, err := VideoTranscripts().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testVideoTranscriptToManyTranscriptVideoTranscriptTerms(t *testing.T) {
+	var err error
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a VideoTranscript
+	var b, c VideoTranscriptTerm
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, videoTranscriptDBTypes, true, videoTranscriptColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize VideoTranscript struct: %s", err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = randomize.Struct(seed, &b, videoTranscriptTermDBTypes, false, videoTranscriptTermColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, videoTranscriptTermDBTypes, false, videoTranscriptTermColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+
+	b.TranscriptID = a.ID
+	c.TranscriptID = a.ID
+
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := a.TranscriptVideoTranscriptTerms().All(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	bFound, cFound := false, false
+	for _, v := range check {
+		if v.TranscriptID == b.TranscriptID {
+			bFound = true
+		}
+		if v.TranscriptID == c.TranscriptID {
+			cFound = true
+		}
+	}
+
+	if !bFound {
+		t.Error("expected to find b")
+	}
+	if !cFound {
+		t.Error("expected to find c")
+	}
+
+	slice := VideoTranscriptSlice{&a}
+	if err = a.L.LoadTranscriptVideoTranscriptTerms(ctx, tx, false, (*[]*VideoTranscript)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.TranscriptVideoTranscriptTerms); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	a.R.TranscriptVideoTranscriptTerms = nil
+	if err = a.L.LoadTranscriptVideoTranscriptTerms(ctx, tx, true, &a, nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.TranscriptVideoTranscriptTerms); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	if t.Failed() {
+		t.Logf("%#v", check)
+	}
+}
+
+func testVideoTranscriptToManyAddOpTranscriptVideoTranscriptTerms(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a VideoTranscript
+	var b, c, d, e VideoTranscriptTerm
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, videoTranscriptDBTypes, false, strmangle.SetComplement(videoTranscriptPrimaryKeyColumns, videoTranscriptColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*VideoTranscriptTerm{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, videoTranscriptTermDBTypes, false, strmangle.SetComplement(videoTranscriptTermPrimaryKeyColumns, videoTranscriptTermColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	foreignersSplitByInsertion := [][]*VideoTranscriptTerm{
+		{&b, &c},
+		{&d, &e},
+	}
+
+	for i, x := range foreignersSplitByInsertion {
+		err = a.AddTranscriptVideoTranscriptTerms(ctx, tx, i != 0, x...)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		first := x[0]
+		second := x[1]
+
+		if a.ID != first.TranscriptID {
+			t.Error("foreign key was wrong value", a.ID, first.TranscriptID)
+		}
+		if a.ID != second.TranscriptID {
+			t.Error("foreign key was wrong value", a.ID, second.TranscriptID)
+		}
+
+		if first.R.Transcript != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+		if second.R.Transcript != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+
+		if a.R.TranscriptVideoTranscriptTerms[i*2] != first {
+			t.Error("relationship struct slice not set to correct value")
+		}
+		if a.R.TranscriptVideoTranscriptTerms[i*2+1] != second {
+			t.Error("relationship struct slice not set to correct value")
+		}
+
+		count, err := a.TranscriptVideoTranscriptTerms().Count(ctx, tx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if want := int64((i + 1) * 2); count != want {
+			t.Error("want", want, "got", count)
+		}
+	}
+}
+func testVideoTranscriptToOneVideoUsingVideo(t *testing.T) {
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var local VideoTranscript
+	var foreign Video
+
+	seed := randomize.NewSeed()
+	if err := randomize.Struct(seed, &local, videoTranscriptDBTypes, false, videoTranscriptColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize VideoTranscript struct: %s", err)
+	}
+	if err := randomize.Struct(seed, &foreign, videoDBTypes, false, videoColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Video struct: %s", err)
+	}
+
+	if err := foreign.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	local.VideoID = foreign.ID
+	if err := local.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := local.Video().One(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if check.ID != foreign.ID {
+		t.Errorf("want: %v, got %v", foreign.ID, check.ID)
+	}
+
+	ranAfterSelectHook := false
+	AddVideoHook(boil.AfterSelectHook, func(ctx context.Context, e boil.ContextExecutor, o *Video) error {
+		ranAfterSelectHook = true
+		return nil
+	})
+
+	slice := VideoTranscriptSlice{&local}
+	if err = local.L.LoadVideo(ctx, tx, false, (*[]*VideoTranscript)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.Video == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	local.R.Video = nil
+	if err = local.L.LoadVideo(ctx, tx, true, &local, nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.Video == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	if !ranAfterSelectHook {
+		t.Error("failed to run AfterSelect hook for relationship")
+	}
+}
+
+func testVideoTranscriptToOneSetOpVideoUsingVideo(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a VideoTranscript
+	var b, c Video
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, videoTranscriptDBTypes, false, strmangle.SetComplement(videoTranscriptPrimaryKeyColumns, videoTranscriptColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, videoDBTypes, false, strmangle.SetComplement(videoPrimaryKeyColumns, videoColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, videoDBTypes, false, strmangle.SetComplement(videoPrimaryKeyColumns, videoColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	for i, x := range []*Video{&b, &c} {
+		err = a.SetVideo(ctx, tx, i != 0, x)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if a.R.Video != x {
+			t.Error("relationship struct not set to correct value")
+		}
+
+		if x.R.VideoTranscripts[0] != &a {
+			t.Error("failed to append to foreign relationship struct")
+		}
+		if a.VideoID != x.ID {
+			t.Error("foreign key was wrong value", a.VideoID)
+		}
+
+		zero := reflect.Zero(reflect.TypeOf(a.VideoID))
+		reflect.Indirect(reflect.ValueOf(&a.VideoID)).Set(zero)
+
+		if err = a.Reload(ctx, tx); err != nil {
+			t.Fatal("failed to reload", err)
+		}
+
+		if a.VideoID != x.ID {
+			t.Error("foreign key was wrong value", a.VideoID, x.ID)
+		}
+	}
+}
+
+func testVideoTranscriptsReload(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &VideoTranscript{}
+	if err = randomize.Struct(seed, o, videoTranscriptDBTypes, true, videoTranscriptColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize VideoTranscript struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = o.Reload(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testVideoTranscriptsReloadAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &VideoTranscript{}
+	if err = randomize.Struct(seed, o, videoTranscriptDBTypes, true, videoTranscriptColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize VideoTranscript struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := VideoTranscriptSlice{o}
+
+	if err = slice.ReloadAll(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testVideoTranscriptsSelect(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &VideoTranscript{}
+	if err = randomize.Struct(seed, o, videoTranscriptDBTypes, true, videoTranscriptColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize VideoTranscript struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := VideoTranscripts().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 1 {
+		t.Error("want one record, got:", len(slice))
+	}
+}
+
+var (
+	videoTranscriptDBTypes = map[string]string{`ID`: `TEXT`, `CreatedAt`: `DATE`, `UpdatedAt`: `DATE`, `VideoID`: `TEXT`, `Language`: `TEXT`, `Name`: `TEXT`, `AutoGenerated`: `BOOLEAN`, `CueCount`: `INTEGER`, `Content`: `BLOB`}
+	_                      = bytes.MinRead
+)
+
+func testVideoTranscriptsUpdate(t *testing.T) {
+	t.Parallel()
+
+	if 0 == len(videoTranscriptPrimaryKeyColumns) {
+		t.Skip("Skipping table with no primary key columns")
+	}
+	if len(videoTranscriptAllColumns) == len(videoTranscriptPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &VideoTranscript{}
+	if err = randomize.Struct(seed, o, videoTranscriptDBTypes, true, videoTranscriptColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize VideoTranscript struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := VideoTranscripts().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, videoTranscriptDBTypes, true, videoTranscriptPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize VideoTranscript struct: %s", err)
+	}
+
+	if rowsAff, err := o.Update(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only affect one row but affected", rowsAff)
+	}
+}
+
+func testVideoTranscriptsSliceUpdateAll(t *testing.T) {
+	t.Parallel()
+
+	if len(videoTranscriptAllColumns) == len(videoTranscriptPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &VideoTranscript{}
+	if err = randomize.Struct(seed, o, videoTranscriptDBTypes, true, videoTranscriptColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize VideoTranscript struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := VideoTranscripts().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, videoTranscriptDBTypes, true, videoTranscriptPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize VideoTranscript struct: %s", err)
+	}
+
+	// Remove Primary keys and unique columns from what we plan to update
+	var fields []string
+	if strmangle.StringSliceMatch(videoTranscriptAllColumns, videoTranscriptPrimaryKeyColumns) {
+		fields = videoTranscriptAllColumns
+	} else {
+		fields = strmangle.SetComplement(
+			videoTranscriptAllColumns,
+			videoTranscriptPrimaryKeyColumns,
+		)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	typ := reflect.TypeOf(o).Elem()
+	n := typ.NumField()
+
+	updateMap := M{}
+	for _, col := range fields {
+		for i := 0; i < n; i++ {
+			f := typ.Field(i)
+			if f.Tag.Get("boil") == col {
+				updateMap[col] = value.Field(i).Interface()
+			}
+		}
+	}
+
+	slice := VideoTranscriptSlice{o}
+	if rowsAff, err := slice.UpdateAll(ctx, tx, updateMap); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("wanted one record updated but got", rowsAff)
+	}
+}
+
+func testVideoTranscriptsUpsert(t *testing.T) {
+	t.Parallel()
+	if len(videoTranscriptAllColumns) == len(videoTranscriptPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	// Attempt the INSERT side of an UPSERT
+	o := VideoTranscript{}
+	if err = randomize.Struct(seed, &o, videoTranscriptDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize VideoTranscript struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Upsert(ctx, tx, false, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert VideoTranscript: %s", err)
+	}
+
+	count, err := VideoTranscripts().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	// Attempt the UPDATE side of an UPSERT
+	if err = randomize.Struct(seed, &o, videoTranscriptDBTypes, false, videoTranscriptPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize VideoTranscript struct: %s", err)
+	}
+
+	if err = o.Upsert(ctx, tx, true, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert VideoTranscript: %s", err)
+	}
+
+	count, err = VideoTranscripts().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
@@ -114,20 +114,29 @@ var VideoWhere = struct {
 
 // VideoRels is where relationship names are stored.
 var VideoRels = struct {
-	Channel       string
-	PlaylistItems string
-	Views         string
+	Channel              string
+	VideoTranscriptCheck string
+	PlaylistItems        string
+	VideoChapters        string
+	VideoTranscripts     string
+	Views                string
 }{
-	Channel:       "Channel",
-	PlaylistItems: "PlaylistItems",
-	Views:         "Views",
+	Channel:              "Channel",
+	VideoTranscriptCheck: "VideoTranscriptCheck",
+	PlaylistItems:        "PlaylistItems",
+	VideoChapters:        "VideoChapters",
+	VideoTranscripts:     "VideoTranscripts",
+	Views:                "Views",
 }
 
 // videoR is where relationships are stored.
 type videoR struct {
-	Channel       *Channel          `boil:"Channel" json:"Channel" toml:"Channel" yaml:"Channel"`
-	PlaylistItems PlaylistItemSlice `boil:"PlaylistItems" json:"PlaylistItems" toml:"PlaylistItems" yaml:"PlaylistItems"`
-	Views         ViewSlice         `boil:"Views" json:"Views" toml:"Views" yaml:"Views"`
+	Channel              *Channel              `boil:"Channel" json:"Channel" toml:"Channel" yaml:"Channel"`
+	VideoTranscriptCheck *VideoTranscriptCheck `boil:"VideoTranscriptCheck" json:"VideoTranscriptCheck" toml:"VideoTranscriptCheck" yaml:"VideoTranscriptCheck"`
+	PlaylistItems        PlaylistItemSlice     `boil:"PlaylistItems" json:"PlaylistItems" toml:"PlaylistItems" yaml:"PlaylistItems"`
+	VideoChapters        VideoChapterSlice     `boil:"VideoChapters" json:"VideoChapters" toml:"VideoChapters" yaml:"VideoChapters"`
+	VideoTranscripts     VideoTranscriptSlice  `boil:"VideoTranscripts" json:"VideoTranscripts" toml:"VideoTranscripts" yaml:"VideoTranscripts"`
+	Views                ViewSlice             `boil:"Views" json:"Views" toml:"Views" yaml:"Views"`
 }
 
 // NewStruct creates a new relationship struct
@@ -151,6 +160,22 @@ func (r *videoR) GetChannel() *Channel {
 	return r.Channel
 }
 
+func (o *Video) GetVideoTranscriptCheck() *VideoTranscriptCheck {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetVideoTranscriptCheck()
+}
+
+func (r *videoR) GetVideoTranscriptCheck() *VideoTranscriptCheck {
+	if r == nil {
+		return nil
+	}
+
+	return r.VideoTranscriptCheck
+}
+
 func (o *Video) GetPlaylistItems() PlaylistItemSlice {
 	if o == nil {
 		return nil
@@ -167,6 +192,38 @@ func (r *videoR) GetPlaylistItems() PlaylistItemSlice {
 	return r.PlaylistItems
 }
 
+func (o *Video) GetVideoChapters() VideoChapterSlice {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetVideoChapters()
+}
+
+func (r *videoR) GetVideoChapters() VideoChapterSlice {
+	if r == nil {
+		return nil
+	}
+
+	return r.VideoChapters
+}
+
+func (o *Video) GetVideoTranscripts() VideoTranscriptSlice {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetVideoTranscripts()
+}
+
+func (r *videoR) GetVideoTranscripts() VideoTranscriptSlice {
+	if r == nil {
+		return nil
+	}
+
+	return r.VideoTranscripts
+}
+
 func (o *Video) GetViews() ViewSlice {
 	if o == nil {
 		return nil
@@ -510,6 +567,17 @@ func (o *Video) Channel(mods ...qm.QueryMod) channelQuery {
 	return Channels(queryMods...)
 }
 
+// VideoTranscriptCheck pointed to by the foreign key.
+func (o *Video) VideoTranscriptCheck(mods ...qm.QueryMod) videoTranscriptCheckQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"video_id\" = ?", o.ID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return VideoTranscriptChecks(queryMods...)
+}
+
 // PlaylistItems retrieves all the playlist_item's PlaylistItems with an executor.
 func (o *Video) PlaylistItems(mods ...qm.QueryMod) playlistItemQuery {
 	var queryMods []qm.QueryMod
@@ -524,6 +592,34 @@ func (o *Video) PlaylistItems(mods ...qm.QueryMod) playlistItemQuery {
 	return PlaylistItems(queryMods...)
 }
 
+// VideoChapters retrieves all the video_chapter's VideoChapters with an executor.
+func (o *Video) VideoChapters(mods ...qm.QueryMod) videoChapterQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"video_chapters\".\"video_id\"=?", o.ID),
+	)
+
+	return VideoChapters(queryMods...)
+}
+
+// VideoTranscripts retrieves all the video_transcript's VideoTranscripts with an executor.
+func (o *Video) VideoTranscripts(mods ...qm.QueryMod) videoTranscriptQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"video_transcripts\".\"video_id\"=?", o.ID),
+	)
+
+	return VideoTranscripts(queryMods...)
+}
+
 // Views retrieves all the view's Views with an executor.
 func (o *Video) Views(mods ...qm.QueryMod) viewQuery {
 	var queryMods []qm.QueryMod
@@ -658,6 +754,123 @@ func (videoL) LoadChannel(ctx context.Context, e boil.ContextExecutor, singular
 	return nil
 }
 
+// LoadVideoTranscriptCheck allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-1 relationship.
+func (videoL) LoadVideoTranscriptCheck(ctx context.Context, e boil.ContextExecutor, singular bool, maybeVideo any, mods queries.Applicator) error {
+	var slice []*Video
+	var object *Video
+
+	if singular {
+		var ok bool
+		object, ok = maybeVideo.(*Video)
+		if !ok {
+			object = new(Video)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeVideo)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeVideo))
+			}
+		}
+	} else {
+		s, ok := maybeVideo.(*[]*Video)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeVideo)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeVideo))
+			}
+		}
+	}
+
+	args := make(map[any]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &videoR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &videoR{}
+			}
+
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]any, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`video_transcript_checks`),
+		qm.WhereIn(`video_transcript_checks.video_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load VideoTranscriptCheck")
+	}
+
+	var resultSlice []*VideoTranscriptCheck
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice VideoTranscriptCheck")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for video_transcript_checks")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for video_transcript_checks")
+	}
+
+	if len(videoTranscriptCheckAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.VideoTranscriptCheck = foreign
+		if foreign.R == nil {
+			foreign.R = &videoTranscriptCheckR{}
+		}
+		foreign.R.Video = object
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.ID == foreign.VideoID {
+				local.R.VideoTranscriptCheck = foreign
+				if foreign.R == nil {
+					foreign.R = &videoTranscriptCheckR{}
+				}
+				foreign.R.Video = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
 // LoadPlaylistItems allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for a 1-M or N-M relationship.
 func (videoL) LoadPlaylistItems(ctx context.Context, e boil.ContextExecutor, singular bool, maybeVideo any, mods queries.Applicator) error {
@@ -771,6 +984,232 @@ func (videoL) LoadPlaylistItems(ctx context.Context, e boil.ContextExecutor, sin
 	return nil
 }
 
+// LoadVideoChapters allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (videoL) LoadVideoChapters(ctx context.Context, e boil.ContextExecutor, singular bool, maybeVideo any, mods queries.Applicator) error {
+	var slice []*Video
+	var object *Video
+
+	if singular {
+		var ok bool
+		object, ok = maybeVideo.(*Video)
+		if !ok {
+			object = new(Video)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeVideo)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeVideo))
+			}
+		}
+	} else {
+		s, ok := maybeVideo.(*[]*Video)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeVideo)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeVideo))
+			}
+		}
+	}
+
+	args := make(map[any]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &videoR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &videoR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]any, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`video_chapters`),
+		qm.WhereIn(`video_chapters.video_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load video_chapters")
+	}
+
+	var resultSlice []*VideoChapter
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice video_chapters")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on video_chapters")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for video_chapters")
+	}
+
+	if len(videoChapterAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.VideoChapters = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &videoChapterR{}
+			}
+			foreign.R.Video = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.VideoID {
+				local.R.VideoChapters = append(local.R.VideoChapters, foreign)
+				if foreign.R == nil {
+					foreign.R = &videoChapterR{}
+				}
+				foreign.R.Video = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadVideoTranscripts allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (videoL) LoadVideoTranscripts(ctx context.Context, e boil.ContextExecutor, singular bool, maybeVideo any, mods queries.Applicator) error {
+	var slice []*Video
+	var object *Video
+
+	if singular {
+		var ok bool
+		object, ok = maybeVideo.(*Video)
+		if !ok {
+			object = new(Video)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeVideo)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeVideo))
+			}
+		}
+	} else {
+		s, ok := maybeVideo.(*[]*Video)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeVideo)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeVideo))
+			}
+		}
+	}
+
+	args := make(map[any]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &videoR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &videoR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]any, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`video_transcripts`),
+		qm.WhereIn(`video_transcripts.video_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load video_transcripts")
+	}
+
+	var resultSlice []*VideoTranscript
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice video_transcripts")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on video_transcripts")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for video_transcripts")
+	}
+
+	if len(videoTranscriptAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.VideoTranscripts = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &videoTranscriptR{}
+			}
+			foreign.R.Video = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.VideoID {
+				local.R.VideoTranscripts = append(local.R.VideoTranscripts, foreign)
+				if foreign.R == nil {
+					foreign.R = &videoTranscriptR{}
+				}
+				foreign.R.Video = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
 // LoadViews allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for a 1-M or N-M relationship.
 func (videoL) LoadViews(ctx context.Context, e boil.ContextExecutor, singular bool, maybeVideo any, mods queries.Applicator) error {
@@ -931,6 +1370,56 @@ func (o *Video) SetChannel(ctx context.Context, exec boil.ContextExecutor, inser
 	return nil
 }
 
+// SetVideoTranscriptCheck of the video to the related item.
+// Sets o.R.VideoTranscriptCheck to related.
+// Adds o to related.R.Video.
+func (o *Video) SetVideoTranscriptCheck(ctx context.Context, exec boil.ContextExecutor, insert bool, related *VideoTranscriptCheck) error {
+	var err error
+
+	if insert {
+		related.VideoID = o.ID
+
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	} else {
+		updateQuery := fmt.Sprintf(
+			"UPDATE \"video_transcript_checks\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 0, []string{"video_id"}),
+			strmangle.WhereClause("\"", "\"", 0, videoTranscriptCheckPrimaryKeyColumns),
+		)
+		values := []any{o.ID, related.VideoID}
+
+		if boil.IsDebug(ctx) {
+			writer := boil.DebugWriterFrom(ctx)
+			fmt.Fprintln(writer, updateQuery)
+			fmt.Fprintln(writer, values)
+		}
+		if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+			return errors.Wrap(err, "failed to update foreign table")
+		}
+
+		related.VideoID = o.ID
+	}
+
+	if o.R == nil {
+		o.R = &videoR{
+			VideoTranscriptCheck: related,
+		}
+	} else {
+		o.R.VideoTranscriptCheck = related
+	}
+
+	if related.R == nil {
+		related.R = &videoTranscriptCheckR{
+			Video: o,
+		}
+	} else {
+		related.R.Video = o
+	}
+	return nil
+}
+
 // AddPlaylistItems adds the given related objects to the existing relationships
 // of the video, optionally inserting them as new records.
 // Appends related to o.R.PlaylistItems.
@@ -984,6 +1473,112 @@ func (o *Video) AddPlaylistItems(ctx context.Context, exec boil.ContextExecutor,
 	return nil
 }
 
+// AddVideoChapters adds the given related objects to the existing relationships
+// of the video, optionally inserting them as new records.
+// Appends related to o.R.VideoChapters.
+// Sets related.R.Video appropriately.
+func (o *Video) AddVideoChapters(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*VideoChapter) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.VideoID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"video_chapters\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 0, []string{"video_id"}),
+				strmangle.WhereClause("\"", "\"", 0, videoChapterPrimaryKeyColumns),
+			)
+			values := []any{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.VideoID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &videoR{
+			VideoChapters: related,
+		}
+	} else {
+		o.R.VideoChapters = append(o.R.VideoChapters, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &videoChapterR{
+				Video: o,
+			}
+		} else {
+			rel.R.Video = o
+		}
+	}
+	return nil
+}
+
+// AddVideoTranscripts adds the given related objects to the existing relationships
+// of the video, optionally inserting them as new records.
+// Appends related to o.R.VideoTranscripts.
+// Sets related.R.Video appropriately.
+func (o *Video) AddVideoTranscripts(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*VideoTranscript) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.VideoID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"video_transcripts\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 0, []string{"video_id"}),
+				strmangle.WhereClause("\"", "\"", 0, videoTranscriptPrimaryKeyColumns),
+			)
+			values := []any{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.VideoID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &videoR{
+			VideoTranscripts: related,
+		}
+	} else {
+		o.R.VideoTranscripts = append(o.R.VideoTranscripts, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &videoTranscriptR{
+				Video: o,
+			}
+		} else {
+			rel.R.Video = o
+		}
+	}
+	return nil
+}
+
 // AddViews adds the given related objects to the existing relationships
 // of the video, optionally inserting them as new records.
 // Appends related to o.R.Views.
@@ -494,6 +494,128 @@ func testVideosInsertWhitelist(t *testing.T) {
 	}
 }
 
+func testVideoOneToOneVideoTranscriptCheckUsingVideoTranscriptCheck(t *testing.T) {
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var foreign VideoTranscriptCheck
+	var local Video
+
+	seed := randomize.NewSeed()
+	if err := randomize.Struct(seed, &foreign, videoTranscriptCheckDBTypes, true, videoTranscriptCheckColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize VideoTranscriptCheck struct: %s", err)
+	}
+	if err := randomize.Struct(seed, &local, videoDBTypes, true, videoColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Video struct: %s", err)
+	}
+
+	if err := local.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	foreign.VideoID = local.ID
+	if err := foreign.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := local.VideoTranscriptCheck().One(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if check.VideoID != foreign.VideoID {
+		t.Errorf("want: %v, got %v", foreign.VideoID, check.VideoID)
+	}
+
+	ranAfterSelectHook := false
+	AddVideoTranscriptCheckHook(boil.AfterSelectHook, func(ctx context.Context, e boil.ContextExecutor, o *VideoTranscriptCheck) error {
+		ranAfterSelectHook = true
+		return nil
+	})
+
+	slice := VideoSlice{&local}
+	if err = local.L.LoadVideoTranscriptCheck(ctx, tx, false, (*[]*Video)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.VideoTranscriptCheck == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	local.R.VideoTranscriptCheck = nil
+	if err = local.L.LoadVideoTranscriptCheck(ctx, tx, true, &local, nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.VideoTranscriptCheck == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	if !ranAfterSelectHook {
+		t.Error("failed to run AfterSelect hook for relationship")
+	}
+}
+
+func testVideoOneToOneSetOpVideoTranscriptCheckUsingVideoTranscriptCheck(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Video
+	var b, c VideoTranscriptCheck
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, videoDBTypes, false, strmangle.SetComplement(videoPrimaryKeyColumns, videoColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, videoTranscriptCheckDBTypes, false, strmangle.SetComplement(videoTranscriptCheckPrimaryKeyColumns, videoTranscriptCheckColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, videoTranscriptCheckDBTypes, false, strmangle.SetComplement(videoTranscriptCheckPrimaryKeyColumns, videoTranscriptCheckColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	for i, x := range []*VideoTranscriptCheck{&b, &c} {
+		err = a.SetVideoTranscriptCheck(ctx, tx, i != 0, x)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if a.R.VideoTranscriptCheck != x {
+			t.Error("relationship struct not set to correct value")
+		}
+		if x.R.Video != &a {
+			t.Error("failed to append to foreign relationship struct")
+		}
+
+		if a.ID != x.VideoID {
+			t.Error("foreign key was wrong value", a.ID)
+		}
+
+		if exists, err := VideoTranscriptCheckExists(ctx, tx, x.VideoID); err != nil {
+			t.Fatal(err)
+		} else if !exists {
+			t.Error("want 'x' to exist")
+		}
+
+		if a.ID != x.VideoID {
+			t.Error("foreign key was wrong value", a.ID, x.VideoID)
+		}
+
+		if _, err = x.Delete(ctx, tx); err != nil {
+			t.Fatal("failed to delete x", err)
+		}
+	}
+}
+
 func testVideoToManyPlaylistItems(t *testing.T) {
 	var err error
 	ctx := context.Background()
@@ -572,6 +694,162 @@ func testVideoToManyPlaylistItems(t *testing.T) {
 	}
 }
 
+func testVideoToManyVideoChapters(t *testing.T) {
+	var err error
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Video
+	var b, c VideoChapter
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, videoDBTypes, true, videoColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Video struct: %s", err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = randomize.Struct(seed, &b, videoChapterDBTypes, false, videoChapterColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, videoChapterDBTypes, false, videoChapterColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+
+	b.VideoID = a.ID
+	c.VideoID = a.ID
+
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := a.VideoChapters().All(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	bFound, cFound := false, false
+	for _, v := range check {
+		if v.VideoID == b.VideoID {
+			bFound = true
+		}
+		if v.VideoID == c.VideoID {
+			cFound = true
+		}
+	}
+
+	if !bFound {
+		t.Error("expected to find b")
+	}
+	if !cFound {
+		t.Error("expected to find c")
+	}
+
+	slice := VideoSlice{&a}
+	if err = a.L.LoadVideoChapters(ctx, tx, false, (*[]*Video)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.VideoChapters); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	a.R.VideoChapters = nil
+	if err = a.L.LoadVideoChapters(ctx, tx, true, &a, nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.VideoChapters); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	if t.Failed() {
+		t.Logf("%#v", check)
+	}
+}
+
+func testVideoToManyVideoTranscripts(t *testing.T) {
+	var err error
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Video
+	var b, c VideoTranscript
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, videoDBTypes, true, videoColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Video struct: %s", err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = randomize.Struct(seed, &b, videoTranscriptDBTypes, false, videoTranscriptColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, videoTranscriptDBTypes, false, videoTranscriptColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+
+	b.VideoID = a.ID
+	c.VideoID = a.ID
+
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := a.VideoTranscripts().All(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	bFound, cFound := false, false
+	for _, v := range check {
+		if v.VideoID == b.VideoID {
+			bFound = true
+		}
+		if v.VideoID == c.VideoID {
+			cFound = true
+		}
+	}
+
+	if !bFound {
+		t.Error("expected to find b")
+	}
+	if !cFound {
+		t.Error("expected to find c")
+	}
+
+	slice := VideoSlice{&a}
+	if err = a.L.LoadVideoTranscripts(ctx, tx, false, (*[]*Video)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.VideoTranscripts); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	a.R.VideoTranscripts = nil
+	if err = a.L.LoadVideoTranscripts(ctx, tx, true, &a, nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.VideoTranscripts); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	if t.Failed() {
+		t.Logf("%#v", check)
+	}
+}
+
 func testVideoToManyViews(t *testing.T) {
 	var err error
 	ctx := context.Background()
@@ -725,6 +1003,156 @@ func testVideoToManyAddOpPlaylistItems(t *testing.T) {
 		}
 	}
 }
+func testVideoToManyAddOpVideoChapters(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Video
+	var b, c, d, e VideoChapter
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, videoDBTypes, false, strmangle.SetComplement(videoPrimaryKeyColumns, videoColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*VideoChapter{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, videoChapterDBTypes, false, strmangle.SetComplement(videoChapterPrimaryKeyColumns, videoChapterColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	foreignersSplitByInsertion := [][]*VideoChapter{
+		{&b, &c},
+		{&d, &e},
+	}
+
+	for i, x := range foreignersSplitByInsertion {
+		err = a.AddVideoChapters(ctx, tx, i != 0, x...)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		first := x[0]
+		second := x[1]
+
+		if a.ID != first.VideoID {
+			t.Error("foreign key was wrong value", a.ID, first.VideoID)
+		}
+		if a.ID != second.VideoID {
+			t.Error("foreign key was wrong value", a.ID, second.VideoID)
+		}
+
+		if first.R.Video != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+		if second.R.Video != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+
+		if a.R.VideoChapters[i*2] != first {
+			t.Error("relationship struct slice not set to correct value")
+		}
+		if a.R.VideoChapters[i*2+1] != second {
+			t.Error("relationship struct slice not set to correct value")
+		}
+
+		count, err := a.VideoChapters().Count(ctx, tx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if want := int64((i + 1) * 2); count != want {
+			t.Error("want", want, "got", count)
+		}
+	}
+}
+func testVideoToManyAddOpVideoTranscripts(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Video
+	var b, c, d, e VideoTranscript
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, videoDBTypes, false, strmangle.SetComplement(videoPrimaryKeyColumns, videoColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*VideoTranscript{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, videoTranscriptDBTypes, false, strmangle.SetComplement(videoTranscriptPrimaryKeyColumns, videoTranscriptColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	foreignersSplitByInsertion := [][]*VideoTranscript{
+		{&b, &c},
+		{&d, &e},
+	}
+
+	for i, x := range foreignersSplitByInsertion {
+		err = a.AddVideoTranscripts(ctx, tx, i != 0, x...)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		first := x[0]
+		second := x[1]
+
+		if a.ID != first.VideoID {
+			t.Error("foreign key was wrong value", a.ID, first.VideoID)
+		}
+		if a.ID != second.VideoID {
+			t.Error("foreign key was wrong value", a.ID, second.VideoID)
+		}
+
+		if first.R.Video != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+		if second.R.Video != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+
+		if a.R.VideoTranscripts[i*2] != first {
+			t.Error("relationship struct slice not set to correct value")
+		}
+		if a.R.VideoTranscripts[i*2+1] != second {
+			t.Error("relationship struct slice not set to correct value")
+		}
+
+		count, err := a.VideoTranscripts().Count(ctx, tx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if want := int64((i + 1) * 2); count != want {
+			t.Error("want", want, "got", count)
+		}
+	}
+}
 func testVideoToManyAddOpViews(t *testing.T) {
 	var err error
 
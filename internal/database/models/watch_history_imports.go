@@ -0,0 +1,1230 @@
+// Code generated by SQLBoiler 4.19.7 (https://github.com/aarondl/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aarondl/null/v8"
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries"
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+	"github.com/aarondl/sqlboiler/v4/queries/qmhelper"
+	"github.com/aarondl/strmangle"
+	"github.com/friendsofgo/errors"
+)
+
+// WatchHistoryImport is an object representing the database table.
+type WatchHistoryImport struct {
+	ID         string    `boil:"id" json:"id" toml:"id" yaml:"id"`
+	CreatedAt  time.Time `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+	UpdatedAt  time.Time `boil:"updated_at" json:"updated_at" toml:"updated_at" yaml:"updated_at"`
+	UserID     string    `boil:"user_id" json:"user_id" toml:"user_id" yaml:"user_id"`
+	Hide       bool      `boil:"hide" json:"hide" toml:"hide" yaml:"hide"`
+	Entries    string    `boil:"entries" json:"entries" toml:"entries" yaml:"entries"`
+	Status     string    `boil:"status" json:"status" toml:"status" yaml:"status"`
+	Total      int64     `boil:"total" json:"total" toml:"total" yaml:"total"`
+	Processed  int64     `boil:"processed" json:"processed" toml:"processed" yaml:"processed"`
+	Imported   int64     `boil:"imported" json:"imported" toml:"imported" yaml:"imported"`
+	Skipped    int64     `boil:"skipped" json:"skipped" toml:"skipped" yaml:"skipped"`
+	Failed     int64     `boil:"failed" json:"failed" toml:"failed" yaml:"failed"`
+	LastError  string    `boil:"last_error" json:"last_error" toml:"last_error" yaml:"last_error"`
+	FinishedAt null.Time `boil:"finished_at" json:"finished_at,omitempty" toml:"finished_at" yaml:"finished_at,omitempty"`
+
+	R *watchHistoryImportR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L watchHistoryImportL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var WatchHistoryImportColumns = struct {
+	ID         string
+	CreatedAt  string
+	UpdatedAt  string
+	UserID     string
+	Hide       string
+	Entries    string
+	Status     string
+	Total      string
+	Processed  string
+	Imported   string
+	Skipped    string
+	Failed     string
+	LastError  string
+	FinishedAt string
+}{
+	ID:         "id",
+	CreatedAt:  "created_at",
+	UpdatedAt:  "updated_at",
+	UserID:     "user_id",
+	Hide:       "hide",
+	Entries:    "entries",
+	Status:     "status",
+	Total:      "total",
+	Processed:  "processed",
+	Imported:   "imported",
+	Skipped:    "skipped",
+	Failed:     "failed",
+	LastError:  "last_error",
+	FinishedAt: "finished_at",
+}
+
+var WatchHistoryImportTableColumns = struct {
+	ID         string
+	CreatedAt  string
+	UpdatedAt  string
+	UserID     string
+	Hide       string
+	Entries    string
+	Status     string
+	Total      string
+	Processed  string
+	Imported   string
+	Skipped    string
+	Failed     string
+	LastError  string
+	FinishedAt string
+}{
+	ID:         "watch_history_imports.id",
+	CreatedAt:  "watch_history_imports.created_at",
+	UpdatedAt:  "watch_history_imports.updated_at",
+	UserID:     "watch_history_imports.user_id",
+	Hide:       "watch_history_imports.hide",
+	Entries:    "watch_history_imports.entries",
+	Status:     "watch_history_imports.status",
+	Total:      "watch_history_imports.total",
+	Processed:  "watch_history_imports.processed",
+	Imported:   "watch_history_imports.imported",
+	Skipped:    "watch_history_imports.skipped",
+	Failed:     "watch_history_imports.failed",
+	LastError:  "watch_history_imports.last_error",
+	FinishedAt: "watch_history_imports.finished_at",
+}
+
+// Generated where
+
+var WatchHistoryImportWhere = struct {
+	ID         whereHelperstring
+	CreatedAt  whereHelpertime_Time
+	UpdatedAt  whereHelpertime_Time
+	UserID     whereHelperstring
+	Hide       whereHelperbool
+	Entries    whereHelperstring
+	Status     whereHelperstring
+	Total      whereHelperint64
+	Processed  whereHelperint64
+	Imported   whereHelperint64
+	Skipped    whereHelperint64
+	Failed     whereHelperint64
+	LastError  whereHelperstring
+	FinishedAt whereHelpernull_Time
+}{
+	ID:         whereHelperstring{field: "\"watch_history_imports\".\"id\""},
+	CreatedAt:  whereHelpertime_Time{field: "\"watch_history_imports\".\"created_at\""},
+	UpdatedAt:  whereHelpertime_Time{field: "\"watch_history_imports\".\"updated_at\""},
+	UserID:     whereHelperstring{field: "\"watch_history_imports\".\"user_id\""},
+	Hide:       whereHelperbool{field: "\"watch_history_imports\".\"hide\""},
+	Entries:    whereHelperstring{field: "\"watch_history_imports\".\"entries\""},
+	Status:     whereHelperstring{field: "\"watch_history_imports\".\"status\""},
+	Total:      whereHelperint64{field: "\"watch_history_imports\".\"total\""},
+	Processed:  whereHelperint64{field: "\"watch_history_imports\".\"processed\""},
+	Imported:   whereHelperint64{field: "\"watch_history_imports\".\"imported\""},
+	Skipped:    whereHelperint64{field: "\"watch_history_imports\".\"skipped\""},
+	Failed:     whereHelperint64{field: "\"watch_history_imports\".\"failed\""},
+	LastError:  whereHelperstring{field: "\"watch_history_imports\".\"last_error\""},
+	FinishedAt: whereHelpernull_Time{field: "\"watch_history_imports\".\"finished_at\""},
+}
+
+// WatchHistoryImportRels is where relationship names are stored.
+var WatchHistoryImportRels = struct {
+	User string
+}{
+	User: "User",
+}
+
+// watchHistoryImportR is where relationships are stored.
+type watchHistoryImportR struct {
+	User *User `boil:"User" json:"User" toml:"User" yaml:"User"`
+}
+
+// NewStruct creates a new relationship struct
+func (*watchHistoryImportR) NewStruct() *watchHistoryImportR {
+	return &watchHistoryImportR{}
+}
+
+func (o *WatchHistoryImport) GetUser() *User {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetUser()
+}
+
+func (r *watchHistoryImportR) GetUser() *User {
+	if r == nil {
+		return nil
+	}
+
+	return r.User
+}
+
+// watchHistoryImportL is where Load methods for each relationship are stored.
+type watchHistoryImportL struct{}
+
+var (
+	watchHistoryImportAllColumns            = []string{"id", "created_at", "updated_at", "user_id", "hide", "entries", "status", "total", "processed", "imported", "skipped", "failed", "last_error", "finished_at"}
+	watchHistoryImportColumnsWithoutDefault = []string{"id", "created_at", "updated_at", "user_id", "status"}
+	watchHistoryImportColumnsWithDefault    = []string{"hide", "entries", "total", "processed", "imported", "skipped", "failed", "last_error", "finished_at"}
+	watchHistoryImportPrimaryKeyColumns     = []string{"id"}
+	watchHistoryImportGeneratedColumns      = []string{}
+)
+
+type (
+	// WatchHistoryImportSlice is an alias for a slice of pointers to WatchHistoryImport.
+	// This should almost always be used instead of []WatchHistoryImport.
+	WatchHistoryImportSlice []*WatchHistoryImport
+	// WatchHistoryImportHook is the signature for custom WatchHistoryImport hook methods
+	WatchHistoryImportHook func(context.Context, boil.ContextExecutor, *WatchHistoryImport) error
+
+	watchHistoryImportQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	watchHistoryImportType                 = reflect.TypeOf(&WatchHistoryImport{})
+	watchHistoryImportMapping              = queries.MakeStructMapping(watchHistoryImportType)
+	watchHistoryImportPrimaryKeyMapping, _ = queries.BindMapping(watchHistoryImportType, watchHistoryImportMapping, watchHistoryImportPrimaryKeyColumns)
+	watchHistoryImportInsertCacheMut       sync.RWMutex
+	watchHistoryImportInsertCache          = make(map[string]insertCache)
+	watchHistoryImportUpdateCacheMut       sync.RWMutex
+	watchHistoryImportUpdateCache          = make(map[string]updateCache)
+	watchHistoryImportUpsertCacheMut       sync.RWMutex
+	watchHistoryImportUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var watchHistoryImportAfterSelectMu sync.Mutex
+var watchHistoryImportAfterSelectHooks []WatchHistoryImportHook
+
+var watchHistoryImportBeforeInsertMu sync.Mutex
+var watchHistoryImportBeforeInsertHooks []WatchHistoryImportHook
+var watchHistoryImportAfterInsertMu sync.Mutex
+var watchHistoryImportAfterInsertHooks []WatchHistoryImportHook
+
+var watchHistoryImportBeforeUpdateMu sync.Mutex
+var watchHistoryImportBeforeUpdateHooks []WatchHistoryImportHook
+var watchHistoryImportAfterUpdateMu sync.Mutex
+var watchHistoryImportAfterUpdateHooks []WatchHistoryImportHook
+
+var watchHistoryImportBeforeDeleteMu sync.Mutex
+var watchHistoryImportBeforeDeleteHooks []WatchHistoryImportHook
+var watchHistoryImportAfterDeleteMu sync.Mutex
+var watchHistoryImportAfterDeleteHooks []WatchHistoryImportHook
+
+var watchHistoryImportBeforeUpsertMu sync.Mutex
+var watchHistoryImportBeforeUpsertHooks []WatchHistoryImportHook
+var watchHistoryImportAfterUpsertMu sync.Mutex
+var watchHistoryImportAfterUpsertHooks []WatchHistoryImportHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *WatchHistoryImport) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range watchHistoryImportAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *WatchHistoryImport) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range watchHistoryImportBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *WatchHistoryImport) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range watchHistoryImportAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *WatchHistoryImport) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range watchHistoryImportBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *WatchHistoryImport) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range watchHistoryImportAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *WatchHistoryImport) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range watchHistoryImportBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *WatchHistoryImport) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range watchHistoryImportAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *WatchHistoryImport) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range watchHistoryImportBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *WatchHistoryImport) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range watchHistoryImportAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddWatchHistoryImportHook registers your hook function for all future operations.
+func AddWatchHistoryImportHook(hookPoint boil.HookPoint, watchHistoryImportHook WatchHistoryImportHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		watchHistoryImportAfterSelectMu.Lock()
+		watchHistoryImportAfterSelectHooks = append(watchHistoryImportAfterSelectHooks, watchHistoryImportHook)
+		watchHistoryImportAfterSelectMu.Unlock()
+	case boil.BeforeInsertHook:
+		watchHistoryImportBeforeInsertMu.Lock()
+		watchHistoryImportBeforeInsertHooks = append(watchHistoryImportBeforeInsertHooks, watchHistoryImportHook)
+		watchHistoryImportBeforeInsertMu.Unlock()
+	case boil.AfterInsertHook:
+		watchHistoryImportAfterInsertMu.Lock()
+		watchHistoryImportAfterInsertHooks = append(watchHistoryImportAfterInsertHooks, watchHistoryImportHook)
+		watchHistoryImportAfterInsertMu.Unlock()
+	case boil.BeforeUpdateHook:
+		watchHistoryImportBeforeUpdateMu.Lock()
+		watchHistoryImportBeforeUpdateHooks = append(watchHistoryImportBeforeUpdateHooks, watchHistoryImportHook)
+		watchHistoryImportBeforeUpdateMu.Unlock()
+	case boil.AfterUpdateHook:
+		watchHistoryImportAfterUpdateMu.Lock()
+		watchHistoryImportAfterUpdateHooks = append(watchHistoryImportAfterUpdateHooks, watchHistoryImportHook)
+		watchHistoryImportAfterUpdateMu.Unlock()
+	case boil.BeforeDeleteHook:
+		watchHistoryImportBeforeDeleteMu.Lock()
+		watchHistoryImportBeforeDeleteHooks = append(watchHistoryImportBeforeDeleteHooks, watchHistoryImportHook)
+		watchHistoryImportBeforeDeleteMu.Unlock()
+	case boil.AfterDeleteHook:
+		watchHistoryImportAfterDeleteMu.Lock()
+		watchHistoryImportAfterDeleteHooks = append(watchHistoryImportAfterDeleteHooks, watchHistoryImportHook)
+		watchHistoryImportAfterDeleteMu.Unlock()
+	case boil.BeforeUpsertHook:
+		watchHistoryImportBeforeUpsertMu.Lock()
+		watchHistoryImportBeforeUpsertHooks = append(watchHistoryImportBeforeUpsertHooks, watchHistoryImportHook)
+		watchHistoryImportBeforeUpsertMu.Unlock()
+	case boil.AfterUpsertHook:
+		watchHistoryImportAfterUpsertMu.Lock()
+		watchHistoryImportAfterUpsertHooks = append(watchHistoryImportAfterUpsertHooks, watchHistoryImportHook)
+		watchHistoryImportAfterUpsertMu.Unlock()
+	}
+}
+
+// One returns a single watchHistoryImport record from the query.
+func (q watchHistoryImportQuery) One(ctx context.Context, exec boil.ContextExecutor) (*WatchHistoryImport, error) {
+	o := &WatchHistoryImport{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: failed to execute a one query for watch_history_imports")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all WatchHistoryImport records from the query.
+func (q watchHistoryImportQuery) All(ctx context.Context, exec boil.ContextExecutor) (WatchHistoryImportSlice, error) {
+	var o []*WatchHistoryImport
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "models: failed to assign all query results to WatchHistoryImport slice")
+	}
+
+	if len(watchHistoryImportAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all WatchHistoryImport records in the query.
+func (q watchHistoryImportQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to count watch_history_imports rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q watchHistoryImportQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "models: failed to check if watch_history_imports exists")
+	}
+
+	return count > 0, nil
+}
+
+// User pointed to by the foreign key.
+func (o *WatchHistoryImport) User(mods ...qm.QueryMod) userQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.UserID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Users(queryMods...)
+}
+
+// LoadUser allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (watchHistoryImportL) LoadUser(ctx context.Context, e boil.ContextExecutor, singular bool, maybeWatchHistoryImport any, mods queries.Applicator) error {
+	var slice []*WatchHistoryImport
+	var object *WatchHistoryImport
+
+	if singular {
+		var ok bool
+		object, ok = maybeWatchHistoryImport.(*WatchHistoryImport)
+		if !ok {
+			object = new(WatchHistoryImport)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeWatchHistoryImport)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeWatchHistoryImport))
+			}
+		}
+	} else {
+		s, ok := maybeWatchHistoryImport.(*[]*WatchHistoryImport)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeWatchHistoryImport)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeWatchHistoryImport))
+			}
+		}
+	}
+
+	args := make(map[any]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &watchHistoryImportR{}
+		}
+		args[object.UserID] = struct{}{}
+
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &watchHistoryImportR{}
+			}
+
+			args[obj.UserID] = struct{}{}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]any, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`users`),
+		qm.WhereIn(`users.id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load User")
+	}
+
+	var resultSlice []*User
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice User")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for users")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for users")
+	}
+
+	if len(userAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.User = foreign
+		if foreign.R == nil {
+			foreign.R = &userR{}
+		}
+		foreign.R.WatchHistoryImports = append(foreign.R.WatchHistoryImports, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.UserID == foreign.ID {
+				local.R.User = foreign
+				if foreign.R == nil {
+					foreign.R = &userR{}
+				}
+				foreign.R.WatchHistoryImports = append(foreign.R.WatchHistoryImports, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetUser of the watchHistoryImport to the related item.
+// Sets o.R.User to related.
+// Adds o to related.R.WatchHistoryImports.
+func (o *WatchHistoryImport) SetUser(ctx context.Context, exec boil.ContextExecutor, insert bool, related *User) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"watch_history_imports\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 0, []string{"user_id"}),
+		strmangle.WhereClause("\"", "\"", 0, watchHistoryImportPrimaryKeyColumns),
+	)
+	values := []any{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.UserID = related.ID
+	if o.R == nil {
+		o.R = &watchHistoryImportR{
+			User: related,
+		}
+	} else {
+		o.R.User = related
+	}
+
+	if related.R == nil {
+		related.R = &userR{
+			WatchHistoryImports: WatchHistoryImportSlice{o},
+		}
+	} else {
+		related.R.WatchHistoryImports = append(related.R.WatchHistoryImports, o)
+	}
+
+	return nil
+}
+
+// WatchHistoryImports retrieves all the records using an executor.
+func WatchHistoryImports(mods ...qm.QueryMod) watchHistoryImportQuery {
+	mods = append(mods, qm.From("\"watch_history_imports\""))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"\"watch_history_imports\".*"})
+	}
+
+	return watchHistoryImportQuery{q}
+}
+
+// FindWatchHistoryImport retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindWatchHistoryImport(ctx context.Context, exec boil.ContextExecutor, iD string, selectCols ...string) (*WatchHistoryImport, error) {
+	watchHistoryImportObj := &WatchHistoryImport{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"watch_history_imports\" where \"id\"=?", sel,
+	)
+
+	q := queries.Raw(query, iD)
+
+	err := q.Bind(ctx, exec, watchHistoryImportObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: unable to select from watch_history_imports")
+	}
+
+	if err = watchHistoryImportObj.doAfterSelectHooks(ctx, exec); err != nil {
+		return watchHistoryImportObj, err
+	}
+
+	return watchHistoryImportObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *WatchHistoryImport) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no watch_history_imports provided for insertion")
+	}
+
+	var err error
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+		if o.UpdatedAt.IsZero() {
+			o.UpdatedAt = currTime
+		}
+	}
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(watchHistoryImportColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	watchHistoryImportInsertCacheMut.RLock()
+	cache, cached := watchHistoryImportInsertCache[key]
+	watchHistoryImportInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			watchHistoryImportAllColumns,
+			watchHistoryImportColumnsWithDefault,
+			watchHistoryImportColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(watchHistoryImportType, watchHistoryImportMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(watchHistoryImportType, watchHistoryImportMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"watch_history_imports\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"watch_history_imports\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "models: unable to insert into watch_history_imports")
+	}
+
+	if !cached {
+		watchHistoryImportInsertCacheMut.Lock()
+		watchHistoryImportInsertCache[key] = cache
+		watchHistoryImportInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// Update uses an executor to update the WatchHistoryImport.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *WatchHistoryImport) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		o.UpdatedAt = currTime
+	}
+
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	watchHistoryImportUpdateCacheMut.RLock()
+	cache, cached := watchHistoryImportUpdateCache[key]
+	watchHistoryImportUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			watchHistoryImportAllColumns,
+			watchHistoryImportPrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("models: unable to update watch_history_imports, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"watch_history_imports\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 0, wl),
+			strmangle.WhereClause("\"", "\"", 0, watchHistoryImportPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(watchHistoryImportType, watchHistoryImportMapping, append(wl, watchHistoryImportPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update watch_history_imports row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by update for watch_history_imports")
+	}
+
+	if !cached {
+		watchHistoryImportUpdateCacheMut.Lock()
+		watchHistoryImportUpdateCache[key] = cache
+		watchHistoryImportUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q watchHistoryImportQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all for watch_history_imports")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected for watch_history_imports")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o WatchHistoryImportSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("models: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]any, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), watchHistoryImportPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"watch_history_imports\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 0, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, watchHistoryImportPrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all in watchHistoryImport slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected all in update all watchHistoryImport")
+	}
+	return rowsAff, nil
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *WatchHistoryImport) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no watch_history_imports provided for upsert")
+	}
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+		o.UpdatedAt = currTime
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(watchHistoryImportColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	watchHistoryImportUpsertCacheMut.RLock()
+	cache, cached := watchHistoryImportUpsertCache[key]
+	watchHistoryImportUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, _ := insertColumns.InsertColumnSet(
+			watchHistoryImportAllColumns,
+			watchHistoryImportColumnsWithDefault,
+			watchHistoryImportColumnsWithoutDefault,
+			nzDefaults,
+		)
+		update := updateColumns.UpdateColumnSet(
+			watchHistoryImportAllColumns,
+			watchHistoryImportPrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("models: unable to upsert watch_history_imports, could not build update column list")
+		}
+
+		ret := strmangle.SetComplement(watchHistoryImportAllColumns, strmangle.SetIntersect(insert, update))
+
+		conflict := conflictColumns
+		if len(conflict) == 0 {
+			conflict = make([]string, len(watchHistoryImportPrimaryKeyColumns))
+			copy(conflict, watchHistoryImportPrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQuerySQLite(dialect, "\"watch_history_imports\"", updateOnConflict, ret, update, conflict, insert)
+
+		cache.valueMapping, err = queries.BindMapping(watchHistoryImportType, watchHistoryImportMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(watchHistoryImportType, watchHistoryImportMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []any
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if errors.Is(err, sql.ErrNoRows) {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "models: unable to upsert watch_history_imports")
+	}
+
+	if !cached {
+		watchHistoryImportUpsertCacheMut.Lock()
+		watchHistoryImportUpsertCache[key] = cache
+		watchHistoryImportUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// Delete deletes a single WatchHistoryImport record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *WatchHistoryImport) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("models: no WatchHistoryImport provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), watchHistoryImportPrimaryKeyMapping)
+	sql := "DELETE FROM \"watch_history_imports\" WHERE \"id\"=?"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete from watch_history_imports")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by delete for watch_history_imports")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q watchHistoryImportQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("models: no watchHistoryImportQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from watch_history_imports")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for watch_history_imports")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o WatchHistoryImportSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(watchHistoryImportBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []any
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), watchHistoryImportPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"watch_history_imports\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, watchHistoryImportPrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from watchHistoryImport slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for watch_history_imports")
+	}
+
+	if len(watchHistoryImportAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *WatchHistoryImport) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindWatchHistoryImport(ctx, exec, o.ID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *WatchHistoryImportSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := WatchHistoryImportSlice{}
+	var args []any
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), watchHistoryImportPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"watch_history_imports\".* FROM \"watch_history_imports\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, watchHistoryImportPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to reload all in WatchHistoryImportSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// WatchHistoryImportExists checks if the WatchHistoryImport row exists.
+func WatchHistoryImportExists(ctx context.Context, exec boil.ContextExecutor, iD string) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"watch_history_imports\" where \"id\"=? limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, iD)
+	}
+	row := exec.QueryRowContext(ctx, sql, iD)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "models: unable to check if watch_history_imports exists")
+	}
+
+	return exists, nil
+}
+
+// Exists checks if the WatchHistoryImport row exists.
+func (o *WatchHistoryImport) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	return WatchHistoryImportExists(ctx, exec, o.ID)
+}
@@ -0,0 +1,850 @@
+// Code generated by SQLBoiler 4.19.7 (https://github.com/aarondl/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"bytes"
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/aarondl/randomize"
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries"
+	"github.com/aarondl/strmangle"
+)
+
+var (
+	// Relationships sometimes use the reflection helper queries.Equal/queries.Assign
+	// so force a package dependency in case they don't.
+	_ = queries.Equal
+)
+
+func testWatchHistoryImports(t *testing.T) {
+	t.Parallel()
+
+	query := WatchHistoryImports()
+
+	if query.Query == nil {
+		t.Error("expected a query, got nothing")
+	}
+}
+
+func testWatchHistoryImportsDelete(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &WatchHistoryImport{}
+	if err = randomize.Struct(seed, o, watchHistoryImportDBTypes, true, watchHistoryImportColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize WatchHistoryImport struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := o.Delete(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := WatchHistoryImports().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testWatchHistoryImportsQueryDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &WatchHistoryImport{}
+	if err = randomize.Struct(seed, o, watchHistoryImportDBTypes, true, watchHistoryImportColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize WatchHistoryImport struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := WatchHistoryImports().DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := WatchHistoryImports().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testWatchHistoryImportsSliceDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &WatchHistoryImport{}
+	if err = randomize.Struct(seed, o, watchHistoryImportDBTypes, true, watchHistoryImportColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize WatchHistoryImport struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := WatchHistoryImportSlice{o}
+
+	if rowsAff, err := slice.DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := WatchHistoryImports().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testWatchHistoryImportsExists(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &WatchHistoryImport{}
+	if err = randomize.Struct(seed, o, watchHistoryImportDBTypes, true, watchHistoryImportColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize WatchHistoryImport struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	e, err := WatchHistoryImportExists(ctx, tx, o.ID)
+	if err != nil {
+		t.Errorf("Unable to check if WatchHistoryImport exists: %s", err)
+	}
+	if !e {
+		t.Errorf("Expected WatchHistoryImportExists to return true, but got false.")
+	}
+}
+
+func testWatchHistoryImportsFind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &WatchHistoryImport{}
+	if err = randomize.Struct(seed, o, watchHistoryImportDBTypes, true, watchHistoryImportColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize WatchHistoryImport struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	watchHistoryImportFound, err := FindWatchHistoryImport(ctx, tx, o.ID)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if watchHistoryImportFound == nil {
+		t.Error("want a record, got nil")
+	}
+}
+
+func testWatchHistoryImportsBind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &WatchHistoryImport{}
+	if err = randomize.Struct(seed, o, watchHistoryImportDBTypes, true, watchHistoryImportColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize WatchHistoryImport struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = WatchHistoryImports().Bind(ctx, tx, o); err != nil {
+		t.Error(err)
+	}
+}
+
+func testWatchHistoryImportsOne(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &WatchHistoryImport{}
+	if err = randomize.Struct(seed, o, watchHistoryImportDBTypes, true, watchHistoryImportColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize WatchHistoryImport struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if x, err := WatchHistoryImports().One(ctx, tx); err != nil {
+		t.Error(err)
+	} else if x == nil {
+		t.Error("expected to get a non nil record")
+	}
+}
+
+func testWatchHistoryImportsAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	watchHistoryImportOne := &WatchHistoryImport{}
+	watchHistoryImportTwo := &WatchHistoryImport{}
+	if err = randomize.Struct(seed, watchHistoryImportOne, watchHistoryImportDBTypes, false, watchHistoryImportColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize WatchHistoryImport struct: %s", err)
+	}
+	if err = randomize.Struct(seed, watchHistoryImportTwo, watchHistoryImportDBTypes, false, watchHistoryImportColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize WatchHistoryImport struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = watchHistoryImportOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = watchHistoryImportTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := WatchHistoryImports().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 2 {
+		t.Error("want 2 records, got:", len(slice))
+	}
+}
+
+func testWatchHistoryImportsCount(t *testing.T) {
+	t.Parallel()
+
+	var err error
+	seed := randomize.NewSeed()
+	watchHistoryImportOne := &WatchHistoryImport{}
+	watchHistoryImportTwo := &WatchHistoryImport{}
+	if err = randomize.Struct(seed, watchHistoryImportOne, watchHistoryImportDBTypes, false, watchHistoryImportColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize WatchHistoryImport struct: %s", err)
+	}
+	if err = randomize.Struct(seed, watchHistoryImportTwo, watchHistoryImportDBTypes, false, watchHistoryImportColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize WatchHistoryImport struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = watchHistoryImportOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = watchHistoryImportTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := WatchHistoryImports().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 2 {
+		t.Error("want 2 records, got:", count)
+	}
+}
+
+func watchHistoryImportBeforeInsertHook(ctx context.Context, e boil.ContextExecutor, o *WatchHistoryImport) error {
+	*o = WatchHistoryImport{}
+	return nil
+}
+
+func watchHistoryImportAfterInsertHook(ctx context.Context, e boil.ContextExecutor, o *WatchHistoryImport) error {
+	*o = WatchHistoryImport{}
+	return nil
+}
+
+func watchHistoryImportAfterSelectHook(ctx context.Context, e boil.ContextExecutor, o *WatchHistoryImport) error {
+	*o = WatchHistoryImport{}
+	return nil
+}
+
+func watchHistoryImportBeforeUpdateHook(ctx context.Context, e boil.ContextExecutor, o *WatchHistoryImport) error {
+	*o = WatchHistoryImport{}
+	return nil
+}
+
+func watchHistoryImportAfterUpdateHook(ctx context.Context, e boil.ContextExecutor, o *WatchHistoryImport) error {
+	*o = WatchHistoryImport{}
+	return nil
+}
+
+func watchHistoryImportBeforeDeleteHook(ctx context.Context, e boil.ContextExecutor, o *WatchHistoryImport) error {
+	*o = WatchHistoryImport{}
+	return nil
+}
+
+func watchHistoryImportAfterDeleteHook(ctx context.Context, e boil.ContextExecutor, o *WatchHistoryImport) error {
+	*o = WatchHistoryImport{}
+	return nil
+}
+
+func watchHistoryImportBeforeUpsertHook(ctx context.Context, e boil.ContextExecutor, o *WatchHistoryImport) error {
+	*o = WatchHistoryImport{}
+	return nil
+}
+
+func watchHistoryImportAfterUpsertHook(ctx context.Context, e boil.ContextExecutor, o *WatchHistoryImport) error {
+	*o = WatchHistoryImport{}
+	return nil
+}
+
+func testWatchHistoryImportsHooks(t *testing.T) {
+	t.Parallel()
+
+	var err error
+
+	ctx := context.Background()
+	empty := &WatchHistoryImport{}
+	o := &WatchHistoryImport{}
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, o, watchHistoryImportDBTypes, false); err != nil {
+		t.Errorf("Unable to randomize WatchHistoryImport object: %s", err)
+	}
+
+	AddWatchHistoryImportHook(boil.BeforeInsertHook, watchHistoryImportBeforeInsertHook)
+	if err = o.doBeforeInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeInsertHook function to empty object, but got: %#v", o)
+	}
+	watchHistoryImportBeforeInsertHooks = []WatchHistoryImportHook{}
+
+	AddWatchHistoryImportHook(boil.AfterInsertHook, watchHistoryImportAfterInsertHook)
+	if err = o.doAfterInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterInsertHook function to empty object, but got: %#v", o)
+	}
+	watchHistoryImportAfterInsertHooks = []WatchHistoryImportHook{}
+
+	AddWatchHistoryImportHook(boil.AfterSelectHook, watchHistoryImportAfterSelectHook)
+	if err = o.doAfterSelectHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterSelectHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterSelectHook function to empty object, but got: %#v", o)
+	}
+	watchHistoryImportAfterSelectHooks = []WatchHistoryImportHook{}
+
+	AddWatchHistoryImportHook(boil.BeforeUpdateHook, watchHistoryImportBeforeUpdateHook)
+	if err = o.doBeforeUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpdateHook function to empty object, but got: %#v", o)
+	}
+	watchHistoryImportBeforeUpdateHooks = []WatchHistoryImportHook{}
+
+	AddWatchHistoryImportHook(boil.AfterUpdateHook, watchHistoryImportAfterUpdateHook)
+	if err = o.doAfterUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpdateHook function to empty object, but got: %#v", o)
+	}
+	watchHistoryImportAfterUpdateHooks = []WatchHistoryImportHook{}
+
+	AddWatchHistoryImportHook(boil.BeforeDeleteHook, watchHistoryImportBeforeDeleteHook)
+	if err = o.doBeforeDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeDeleteHook function to empty object, but got: %#v", o)
+	}
+	watchHistoryImportBeforeDeleteHooks = []WatchHistoryImportHook{}
+
+	AddWatchHistoryImportHook(boil.AfterDeleteHook, watchHistoryImportAfterDeleteHook)
+	if err = o.doAfterDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterDeleteHook function to empty object, but got: %#v", o)
+	}
+	watchHistoryImportAfterDeleteHooks = []WatchHistoryImportHook{}
+
+	AddWatchHistoryImportHook(boil.BeforeUpsertHook, watchHistoryImportBeforeUpsertHook)
+	if err = o.doBeforeUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpsertHook function to empty object, but got: %#v", o)
+	}
+	watchHistoryImportBeforeUpsertHooks = []WatchHistoryImportHook{}
+
+	AddWatchHistoryImportHook(boil.AfterUpsertHook, watchHistoryImportAfterUpsertHook)
+	if err = o.doAfterUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpsertHook function to empty object, but got: %#v", o)
+	}
+	watchHistoryImportAfterUpsertHooks = []WatchHistoryImportHook{}
+}
+
+func testWatchHistoryImportsInsert(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &WatchHistoryImport{}
+	if err = randomize.Struct(seed, o, watchHistoryImportDBTypes, true, watchHistoryImportColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize WatchHistoryImport struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := WatchHistoryImports().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testWatchHistoryImportsInsertWhitelist(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &WatchHistoryImport{}
+	if err = randomize.Struct(seed, o, watchHistoryImportDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize WatchHistoryImport struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Whitelist(strmangle.SetMerge(watchHistoryImportPrimaryKeyColumns, watchHistoryImportColumnsWithoutDefault)...)); err != nil {
+		t.Error(err)
+	}
+
+	count, err := WatchHistoryImports().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testWatchHistoryImportToOneUserUsingUser(t *testing.T) {
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var local WatchHistoryImport
+	var foreign User
+
+	seed := randomize.NewSeed()
+	if err := randomize.Struct(seed, &local, watchHistoryImportDBTypes, false, watchHistoryImportColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize WatchHistoryImport struct: %s", err)
+	}
+	if err := randomize.Struct(seed, &foreign, userDBTypes, false, userColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize User struct: %s", err)
+	}
+
+	if err := foreign.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	local.UserID = foreign.ID
+	if err := local.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := local.User().One(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if check.ID != foreign.ID {
+		t.Errorf("want: %v, got %v", foreign.ID, check.ID)
+	}
+
+	ranAfterSelectHook := false
+	AddUserHook(boil.AfterSelectHook, func(ctx context.Context, e boil.ContextExecutor, o *User) error {
+		ranAfterSelectHook = true
+		return nil
+	})
+
+	slice := WatchHistoryImportSlice{&local}
+	if err = local.L.LoadUser(ctx, tx, false, (*[]*WatchHistoryImport)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.User == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	local.R.User = nil
+	if err = local.L.LoadUser(ctx, tx, true, &local, nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.User == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	if !ranAfterSelectHook {
+		t.Error("failed to run AfterSelect hook for relationship")
+	}
+}
+
+func testWatchHistoryImportToOneSetOpUserUsingUser(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a WatchHistoryImport
+	var b, c User
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, watchHistoryImportDBTypes, false, strmangle.SetComplement(watchHistoryImportPrimaryKeyColumns, watchHistoryImportColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, userDBTypes, false, strmangle.SetComplement(userPrimaryKeyColumns, userColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, userDBTypes, false, strmangle.SetComplement(userPrimaryKeyColumns, userColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	for i, x := range []*User{&b, &c} {
+		err = a.SetUser(ctx, tx, i != 0, x)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if a.R.User != x {
+			t.Error("relationship struct not set to correct value")
+		}
+
+		if x.R.WatchHistoryImports[0] != &a {
+			t.Error("failed to append to foreign relationship struct")
+		}
+		if a.UserID != x.ID {
+			t.Error("foreign key was wrong value", a.UserID)
+		}
+
+		zero := reflect.Zero(reflect.TypeOf(a.UserID))
+		reflect.Indirect(reflect.ValueOf(&a.UserID)).Set(zero)
+
+		if err = a.Reload(ctx, tx); err != nil {
+			t.Fatal("failed to reload", err)
+		}
+
+		if a.UserID != x.ID {
+			t.Error("foreign key was wrong value", a.UserID, x.ID)
+		}
+	}
+}
+
+func testWatchHistoryImportsReload(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &WatchHistoryImport{}
+	if err = randomize.Struct(seed, o, watchHistoryImportDBTypes, true, watchHistoryImportColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize WatchHistoryImport struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = o.Reload(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testWatchHistoryImportsReloadAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &WatchHistoryImport{}
+	if err = randomize.Struct(seed, o, watchHistoryImportDBTypes, true, watchHistoryImportColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize WatchHistoryImport struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := WatchHistoryImportSlice{o}
+
+	if err = slice.ReloadAll(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testWatchHistoryImportsSelect(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &WatchHistoryImport{}
+	if err = randomize.Struct(seed, o, watchHistoryImportDBTypes, true, watchHistoryImportColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize WatchHistoryImport struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := WatchHistoryImports().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 1 {
+		t.Error("want one record, got:", len(slice))
+	}
+}
+
+var (
+	watchHistoryImportDBTypes = map[string]string{`ID`: `TEXT`, `CreatedAt`: `DATE`, `UpdatedAt`: `DATE`, `UserID`: `TEXT`, `Hide`: `BOOLEAN`, `Entries`: `TEXT`, `Status`: `TEXT`, `Total`: `INTEGER`, `Processed`: `INTEGER`, `Imported`: `INTEGER`, `Skipped`: `INTEGER`, `Failed`: `INTEGER`, `LastError`: `TEXT`, `FinishedAt`: `DATE`}
+	_                         = bytes.MinRead
+)
+
+func testWatchHistoryImportsUpdate(t *testing.T) {
+	t.Parallel()
+
+	if 0 == len(watchHistoryImportPrimaryKeyColumns) {
+		t.Skip("Skipping table with no primary key columns")
+	}
+	if len(watchHistoryImportAllColumns) == len(watchHistoryImportPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &WatchHistoryImport{}
+	if err = randomize.Struct(seed, o, watchHistoryImportDBTypes, true, watchHistoryImportColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize WatchHistoryImport struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := WatchHistoryImports().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, watchHistoryImportDBTypes, true, watchHistoryImportPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize WatchHistoryImport struct: %s", err)
+	}
+
+	if rowsAff, err := o.Update(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only affect one row but affected", rowsAff)
+	}
+}
+
+func testWatchHistoryImportsSliceUpdateAll(t *testing.T) {
+	t.Parallel()
+
+	if len(watchHistoryImportAllColumns) == len(watchHistoryImportPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &WatchHistoryImport{}
+	if err = randomize.Struct(seed, o, watchHistoryImportDBTypes, true, watchHistoryImportColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize WatchHistoryImport struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := WatchHistoryImports().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, watchHistoryImportDBTypes, true, watchHistoryImportPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize WatchHistoryImport struct: %s", err)
+	}
+
+	// Remove Primary keys and unique columns from what we plan to update
+	var fields []string
+	if strmangle.StringSliceMatch(watchHistoryImportAllColumns, watchHistoryImportPrimaryKeyColumns) {
+		fields = watchHistoryImportAllColumns
+	} else {
+		fields = strmangle.SetComplement(
+			watchHistoryImportAllColumns,
+			watchHistoryImportPrimaryKeyColumns,
+		)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	typ := reflect.TypeOf(o).Elem()
+	n := typ.NumField()
+
+	updateMap := M{}
+	for _, col := range fields {
+		for i := 0; i < n; i++ {
+			f := typ.Field(i)
+			if f.Tag.Get("boil") == col {
+				updateMap[col] = value.Field(i).Interface()
+			}
+		}
+	}
+
+	slice := WatchHistoryImportSlice{o}
+	if rowsAff, err := slice.UpdateAll(ctx, tx, updateMap); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("wanted one record updated but got", rowsAff)
+	}
+}
+
+func testWatchHistoryImportsUpsert(t *testing.T) {
+	t.Parallel()
+	if len(watchHistoryImportAllColumns) == len(watchHistoryImportPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	// Attempt the INSERT side of an UPSERT
+	o := WatchHistoryImport{}
+	if err = randomize.Struct(seed, &o, watchHistoryImportDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize WatchHistoryImport struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Upsert(ctx, tx, false, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert WatchHistoryImport: %s", err)
+	}
+
+	count, err := WatchHistoryImports().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	// Attempt the UPDATE side of an UPSERT
+	if err = randomize.Struct(seed, &o, watchHistoryImportDBTypes, false, watchHistoryImportPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize WatchHistoryImport struct: %s", err)
+	}
+
+	if err = o.Upsert(ctx, tx, true, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert WatchHistoryImport: %s", err)
+	}
+
+	count, err = WatchHistoryImports().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
@@ -36,6 +36,7 @@ type YoutubeSyncAccount struct {
 	LastSyncedAt             null.Time   `boil:"last_synced_at" json:"last_synced_at,omitempty" toml:"last_synced_at" yaml:"last_synced_at,omitempty"`
 	LastSyncAttemptAt        null.Time   `boil:"last_sync_attempt_at" json:"last_sync_attempt_at,omitempty" toml:"last_sync_attempt_at" yaml:"last_sync_attempt_at,omitempty"`
 	LastError                string      `boil:"last_error" json:"last_error" toml:"last_error" yaml:"last_error"`
+	GrantedScopes            string      `boil:"granted_scopes" json:"granted_scopes" toml:"granted_scopes" yaml:"granted_scopes"`
 
 	R *youtubeSyncAccountR `boil:"-" json:"-" toml:"-" yaml:"-"`
 	L youtubeSyncAccountL  `boil:"-" json:"-" toml:"-" yaml:"-"`
@@ -54,6 +55,7 @@ var YoutubeSyncAccountColumns = struct {
 	LastSyncedAt             string
 	LastSyncAttemptAt        string
 	LastError                string
+	GrantedScopes            string
 }{
 	ID:                       "id",
 	CreatedAt:                "created_at",
@@ -67,6 +69,7 @@ var YoutubeSyncAccountColumns = struct {
 	LastSyncedAt:             "last_synced_at",
 	LastSyncAttemptAt:        "last_sync_attempt_at",
 	LastError:                "last_error",
+	GrantedScopes:            "granted_scopes",
 }
 
 var YoutubeSyncAccountTableColumns = struct {
@@ -82,6 +85,7 @@ var YoutubeSyncAccountTableColumns = struct {
 	LastSyncedAt             string
 	LastSyncAttemptAt        string
 	LastError                string
+	GrantedScopes            string
 }{
 	ID:                       "youtube_sync_accounts.id",
 	CreatedAt:                "youtube_sync_accounts.created_at",
@@ -95,34 +99,11 @@ var YoutubeSyncAccountTableColumns = struct {
 	LastSyncedAt:             "youtube_sync_accounts.last_synced_at",
 	LastSyncAttemptAt:        "youtube_sync_accounts.last_sync_attempt_at",
 	LastError:                "youtube_sync_accounts.last_error",
+	GrantedScopes:            "youtube_sync_accounts.granted_scopes",
 }
 
 // Generated where
 
-type whereHelpernull_Time struct{ field string }
-
-func (w whereHelpernull_Time) EQ(x null.Time) qm.QueryMod {
-	return qmhelper.WhereNullEQ(w.field, false, x)
-}
-func (w whereHelpernull_Time) NEQ(x null.Time) qm.QueryMod {
-	return qmhelper.WhereNullEQ(w.field, true, x)
-}
-func (w whereHelpernull_Time) LT(x null.Time) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.LT, x)
-}
-func (w whereHelpernull_Time) LTE(x null.Time) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.LTE, x)
-}
-func (w whereHelpernull_Time) GT(x null.Time) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.GT, x)
-}
-func (w whereHelpernull_Time) GTE(x null.Time) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.GTE, x)
-}
-
-func (w whereHelpernull_Time) IsNull() qm.QueryMod    { return qmhelper.WhereIsNull(w.field) }
-func (w whereHelpernull_Time) IsNotNull() qm.QueryMod { return qmhelper.WhereIsNotNull(w.field) }
-
 var YoutubeSyncAccountWhere = struct {
 	ID                       whereHelperstring
 	CreatedAt                whereHelpertime_Time
@@ -136,6 +117,7 @@ var YoutubeSyncAccountWhere = struct {
 	LastSyncedAt             whereHelpernull_Time
 	LastSyncAttemptAt        whereHelpernull_Time
 	LastError                whereHelperstring
+	GrantedScopes            whereHelperstring
 }{
 	ID:                       whereHelperstring{field: "\"youtube_sync_accounts\".\"id\""},
 	CreatedAt:                whereHelpertime_Time{field: "\"youtube_sync_accounts\".\"created_at\""},
@@ -149,18 +131,22 @@ var YoutubeSyncAccountWhere = struct {
 	LastSyncedAt:             whereHelpernull_Time{field: "\"youtube_sync_accounts\".\"last_synced_at\""},
 	LastSyncAttemptAt:        whereHelpernull_Time{field: "\"youtube_sync_accounts\".\"last_sync_attempt_at\""},
 	LastError:                whereHelperstring{field: "\"youtube_sync_accounts\".\"last_error\""},
+	GrantedScopes:            whereHelperstring{field: "\"youtube_sync_accounts\".\"granted_scopes\""},
 }
 
 // YoutubeSyncAccountRels is where relationship names are stored.
 var YoutubeSyncAccountRels = struct {
-	User string
+	User                   string
+	UserYoutubeSyncTargets string
 }{
-	User: "User",
+	User:                   "User",
+	UserYoutubeSyncTargets: "UserYoutubeSyncTargets",
 }
 
 // youtubeSyncAccountR is where relationships are stored.
 type youtubeSyncAccountR struct {
-	User *User `boil:"User" json:"User" toml:"User" yaml:"User"`
+	User                   *User                  `boil:"User" json:"User" toml:"User" yaml:"User"`
+	UserYoutubeSyncTargets YoutubeSyncTargetSlice `boil:"UserYoutubeSyncTargets" json:"UserYoutubeSyncTargets" toml:"UserYoutubeSyncTargets" yaml:"UserYoutubeSyncTargets"`
 }
 
 // NewStruct creates a new relationship struct
@@ -184,13 +170,29 @@ func (r *youtubeSyncAccountR) GetUser() *User {
 	return r.User
 }
 
+func (o *YoutubeSyncAccount) GetUserYoutubeSyncTargets() YoutubeSyncTargetSlice {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetUserYoutubeSyncTargets()
+}
+
+func (r *youtubeSyncAccountR) GetUserYoutubeSyncTargets() YoutubeSyncTargetSlice {
+	if r == nil {
+		return nil
+	}
+
+	return r.UserYoutubeSyncTargets
+}
+
 // youtubeSyncAccountL is where Load methods for each relationship are stored.
 type youtubeSyncAccountL struct{}
 
 var (
-	youtubeSyncAccountAllColumns            = []string{"id", "created_at", "updated_at", "user_id", "refresh_token_enc", "enc_secret_hash", "playlist_id", "sync_enabled", "last_feed_video_published_at", "last_synced_at", "last_sync_attempt_at", "last_error"}
+	youtubeSyncAccountAllColumns            = []string{"id", "created_at", "updated_at", "user_id", "refresh_token_enc", "enc_secret_hash", "playlist_id", "sync_enabled", "last_feed_video_published_at", "last_synced_at", "last_sync_attempt_at", "last_error", "granted_scopes"}
 	youtubeSyncAccountColumnsWithoutDefault = []string{"id", "created_at", "updated_at", "user_id", "refresh_token_enc", "enc_secret_hash"}
-	youtubeSyncAccountColumnsWithDefault    = []string{"playlist_id", "sync_enabled", "last_feed_video_published_at", "last_synced_at", "last_sync_attempt_at", "last_error"}
+	youtubeSyncAccountColumnsWithDefault    = []string{"playlist_id", "sync_enabled", "last_feed_video_published_at", "last_synced_at", "last_sync_attempt_at", "last_error", "granted_scopes"}
 	youtubeSyncAccountPrimaryKeyColumns     = []string{"id"}
 	youtubeSyncAccountGeneratedColumns      = []string{}
 )
@@ -511,6 +513,20 @@ func (o *YoutubeSyncAccount) User(mods ...qm.QueryMod) userQuery {
 	return Users(queryMods...)
 }
 
+// UserYoutubeSyncTargets retrieves all the youtube_sync_target's YoutubeSyncTargets with an executor via user_id column.
+func (o *YoutubeSyncAccount) UserYoutubeSyncTargets(mods ...qm.QueryMod) youtubeSyncTargetQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"youtube_sync_targets\".\"user_id\"=?", o.UserID),
+	)
+
+	return YoutubeSyncTargets(queryMods...)
+}
+
 // LoadUser allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for an N-1 relationship.
 func (youtubeSyncAccountL) LoadUser(ctx context.Context, e boil.ContextExecutor, singular bool, maybeYoutubeSyncAccount any, mods queries.Applicator) error {
@@ -631,6 +647,119 @@ func (youtubeSyncAccountL) LoadUser(ctx context.Context, e boil.ContextExecutor,
 	return nil
 }
 
+// LoadUserYoutubeSyncTargets allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (youtubeSyncAccountL) LoadUserYoutubeSyncTargets(ctx context.Context, e boil.ContextExecutor, singular bool, maybeYoutubeSyncAccount any, mods queries.Applicator) error {
+	var slice []*YoutubeSyncAccount
+	var object *YoutubeSyncAccount
+
+	if singular {
+		var ok bool
+		object, ok = maybeYoutubeSyncAccount.(*YoutubeSyncAccount)
+		if !ok {
+			object = new(YoutubeSyncAccount)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeYoutubeSyncAccount)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeYoutubeSyncAccount))
+			}
+		}
+	} else {
+		s, ok := maybeYoutubeSyncAccount.(*[]*YoutubeSyncAccount)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeYoutubeSyncAccount)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeYoutubeSyncAccount))
+			}
+		}
+	}
+
+	args := make(map[any]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &youtubeSyncAccountR{}
+		}
+		args[object.UserID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &youtubeSyncAccountR{}
+			}
+			args[obj.UserID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]any, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`youtube_sync_targets`),
+		qm.WhereIn(`youtube_sync_targets.user_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load youtube_sync_targets")
+	}
+
+	var resultSlice []*YoutubeSyncTarget
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice youtube_sync_targets")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on youtube_sync_targets")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for youtube_sync_targets")
+	}
+
+	if len(youtubeSyncTargetAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.UserYoutubeSyncTargets = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &youtubeSyncTargetR{}
+			}
+			foreign.R.User = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.UserID == foreign.UserID {
+				local.R.UserYoutubeSyncTargets = append(local.R.UserYoutubeSyncTargets, foreign)
+				if foreign.R == nil {
+					foreign.R = &youtubeSyncTargetR{}
+				}
+				foreign.R.User = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
 // SetUser of the youtubeSyncAccount to the related item.
 // Sets o.R.User to related.
 // Adds o to related.R.YoutubeSyncAccount.
@@ -678,6 +807,59 @@ func (o *YoutubeSyncAccount) SetUser(ctx context.Context, exec boil.ContextExecu
 	return nil
 }
 
+// AddUserYoutubeSyncTargets adds the given related objects to the existing relationships
+// of the youtube_sync_account, optionally inserting them as new records.
+// Appends related to o.R.UserYoutubeSyncTargets.
+// Sets related.R.User appropriately.
+func (o *YoutubeSyncAccount) AddUserYoutubeSyncTargets(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*YoutubeSyncTarget) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.UserID = o.UserID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"youtube_sync_targets\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 0, []string{"user_id"}),
+				strmangle.WhereClause("\"", "\"", 0, youtubeSyncTargetPrimaryKeyColumns),
+			)
+			values := []any{o.UserID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.UserID = o.UserID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &youtubeSyncAccountR{
+			UserYoutubeSyncTargets: related,
+		}
+	} else {
+		o.R.UserYoutubeSyncTargets = append(o.R.UserYoutubeSyncTargets, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &youtubeSyncTargetR{
+				User: o,
+			}
+		} else {
+			rel.R.User = o
+		}
+	}
+	return nil
+}
+
 // YoutubeSyncAccounts retrieves all the records using an executor.
 func YoutubeSyncAccounts(mods ...qm.QueryMod) youtubeSyncAccountQuery {
 	mods = append(mods, qm.From("\"youtube_sync_accounts\""))
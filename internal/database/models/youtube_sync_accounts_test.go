@@ -494,6 +494,159 @@ func testYoutubeSyncAccountsInsertWhitelist(t *testing.T) {
 	}
 }
 
+func testYoutubeSyncAccountToManyUserYoutubeSyncTargets(t *testing.T) {
+	var err error
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a YoutubeSyncAccount
+	var b, c YoutubeSyncTarget
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, youtubeSyncAccountDBTypes, true, youtubeSyncAccountColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize YoutubeSyncAccount struct: %s", err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = randomize.Struct(seed, &b, youtubeSyncTargetDBTypes, false, youtubeSyncTargetColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, youtubeSyncTargetDBTypes, false, youtubeSyncTargetColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+
+	b.UserID = a.UserID
+	c.UserID = a.UserID
+
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := a.UserYoutubeSyncTargets().All(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	bFound, cFound := false, false
+	for _, v := range check {
+		if v.UserID == b.UserID {
+			bFound = true
+		}
+		if v.UserID == c.UserID {
+			cFound = true
+		}
+	}
+
+	if !bFound {
+		t.Error("expected to find b")
+	}
+	if !cFound {
+		t.Error("expected to find c")
+	}
+
+	slice := YoutubeSyncAccountSlice{&a}
+	if err = a.L.LoadUserYoutubeSyncTargets(ctx, tx, false, (*[]*YoutubeSyncAccount)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.UserYoutubeSyncTargets); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	a.R.UserYoutubeSyncTargets = nil
+	if err = a.L.LoadUserYoutubeSyncTargets(ctx, tx, true, &a, nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.UserYoutubeSyncTargets); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	if t.Failed() {
+		t.Logf("%#v", check)
+	}
+}
+
+func testYoutubeSyncAccountToManyAddOpUserYoutubeSyncTargets(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a YoutubeSyncAccount
+	var b, c, d, e YoutubeSyncTarget
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, youtubeSyncAccountDBTypes, false, strmangle.SetComplement(youtubeSyncAccountPrimaryKeyColumns, youtubeSyncAccountColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*YoutubeSyncTarget{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, youtubeSyncTargetDBTypes, false, strmangle.SetComplement(youtubeSyncTargetPrimaryKeyColumns, youtubeSyncTargetColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	foreignersSplitByInsertion := [][]*YoutubeSyncTarget{
+		{&b, &c},
+		{&d, &e},
+	}
+
+	for i, x := range foreignersSplitByInsertion {
+		err = a.AddUserYoutubeSyncTargets(ctx, tx, i != 0, x...)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		first := x[0]
+		second := x[1]
+
+		if a.UserID != first.UserID {
+			t.Error("foreign key was wrong value", a.UserID, first.UserID)
+		}
+		if a.UserID != second.UserID {
+			t.Error("foreign key was wrong value", a.UserID, second.UserID)
+		}
+
+		if first.R.User != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+		if second.R.User != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+
+		if a.R.UserYoutubeSyncTargets[i*2] != first {
+			t.Error("relationship struct slice not set to correct value")
+		}
+		if a.R.UserYoutubeSyncTargets[i*2+1] != second {
+			t.Error("relationship struct slice not set to correct value")
+		}
+
+		count, err := a.UserYoutubeSyncTargets().Count(ctx, tx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if want := int64((i + 1) * 2); count != want {
+			t.Error("want", want, "got", count)
+		}
+	}
+}
 func testYoutubeSyncAccountToOneUserUsingUser(t *testing.T) {
 	ctx := context.Background()
 	tx := MustTx(boil.BeginTx(ctx, nil))
@@ -687,7 +840,7 @@ func testYoutubeSyncAccountsSelect(t *testing.T) {
 }
 
 var (
-	youtubeSyncAccountDBTypes = map[string]string{`ID`: `TEXT`, `CreatedAt`: `DATE`, `UpdatedAt`: `DATE`, `UserID`: `TEXT`, `RefreshTokenEnc`: `BLOB`, `EncSecretHash`: `TEXT`, `PlaylistID`: `TEXT`, `SyncEnabled`: `BOOLEAN`, `LastFeedVideoPublishedAt`: `DATE`, `LastSyncedAt`: `DATE`, `LastSyncAttemptAt`: `DATE`, `LastError`: `TEXT`}
+	youtubeSyncAccountDBTypes = map[string]string{`ID`: `TEXT`, `CreatedAt`: `DATE`, `UpdatedAt`: `DATE`, `UserID`: `TEXT`, `RefreshTokenEnc`: `BLOB`, `EncSecretHash`: `TEXT`, `PlaylistID`: `TEXT`, `SyncEnabled`: `BOOLEAN`, `LastFeedVideoPublishedAt`: `DATE`, `LastSyncedAt`: `DATE`, `LastSyncAttemptAt`: `DATE`, `LastError`: `TEXT`, `GrantedScopes`: `TEXT`}
 	_                         = bytes.MinRead
 )
 
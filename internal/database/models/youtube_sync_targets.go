@@ -0,0 +1,1223 @@
+// Code generated by SQLBoiler 4.19.7 (https://github.com/aarondl/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aarondl/null/v8"
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries"
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+	"github.com/aarondl/sqlboiler/v4/queries/qmhelper"
+	"github.com/aarondl/strmangle"
+	"github.com/friendsofgo/errors"
+)
+
+// YoutubeSyncTarget is an object representing the database table.
+type YoutubeSyncTarget struct {
+	ID                string      `boil:"id" json:"id" toml:"id" yaml:"id"`
+	CreatedAt         time.Time   `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+	UpdatedAt         time.Time   `boil:"updated_at" json:"updated_at" toml:"updated_at" yaml:"updated_at"`
+	UserID            string      `boil:"user_id" json:"user_id" toml:"user_id" yaml:"user_id"`
+	Source            string      `boil:"source" json:"source" toml:"source" yaml:"source"`
+	SourceID          string      `boil:"source_id" json:"source_id" toml:"source_id" yaml:"source_id"`
+	Title             string      `boil:"title" json:"title" toml:"title" yaml:"title"`
+	PlaylistID        null.String `boil:"playlist_id" json:"playlist_id,omitempty" toml:"playlist_id" yaml:"playlist_id,omitempty"`
+	Size              int64       `boil:"size" json:"size" toml:"size" yaml:"size"`
+	Ordering          string      `boil:"ordering" json:"ordering" toml:"ordering" yaml:"ordering"`
+	LastSyncedAt      null.Time   `boil:"last_synced_at" json:"last_synced_at,omitempty" toml:"last_synced_at" yaml:"last_synced_at,omitempty"`
+	LastSyncAttemptAt null.Time   `boil:"last_sync_attempt_at" json:"last_sync_attempt_at,omitempty" toml:"last_sync_attempt_at" yaml:"last_sync_attempt_at,omitempty"`
+	LastError         string      `boil:"last_error" json:"last_error" toml:"last_error" yaml:"last_error"`
+
+	R *youtubeSyncTargetR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L youtubeSyncTargetL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var YoutubeSyncTargetColumns = struct {
+	ID                string
+	CreatedAt         string
+	UpdatedAt         string
+	UserID            string
+	Source            string
+	SourceID          string
+	Title             string
+	PlaylistID        string
+	Size              string
+	Ordering          string
+	LastSyncedAt      string
+	LastSyncAttemptAt string
+	LastError         string
+}{
+	ID:                "id",
+	CreatedAt:         "created_at",
+	UpdatedAt:         "updated_at",
+	UserID:            "user_id",
+	Source:            "source",
+	SourceID:          "source_id",
+	Title:             "title",
+	PlaylistID:        "playlist_id",
+	Size:              "size",
+	Ordering:          "ordering",
+	LastSyncedAt:      "last_synced_at",
+	LastSyncAttemptAt: "last_sync_attempt_at",
+	LastError:         "last_error",
+}
+
+var YoutubeSyncTargetTableColumns = struct {
+	ID                string
+	CreatedAt         string
+	UpdatedAt         string
+	UserID            string
+	Source            string
+	SourceID          string
+	Title             string
+	PlaylistID        string
+	Size              string
+	Ordering          string
+	LastSyncedAt      string
+	LastSyncAttemptAt string
+	LastError         string
+}{
+	ID:                "youtube_sync_targets.id",
+	CreatedAt:         "youtube_sync_targets.created_at",
+	UpdatedAt:         "youtube_sync_targets.updated_at",
+	UserID:            "youtube_sync_targets.user_id",
+	Source:            "youtube_sync_targets.source",
+	SourceID:          "youtube_sync_targets.source_id",
+	Title:             "youtube_sync_targets.title",
+	PlaylistID:        "youtube_sync_targets.playlist_id",
+	Size:              "youtube_sync_targets.size",
+	Ordering:          "youtube_sync_targets.ordering",
+	LastSyncedAt:      "youtube_sync_targets.last_synced_at",
+	LastSyncAttemptAt: "youtube_sync_targets.last_sync_attempt_at",
+	LastError:         "youtube_sync_targets.last_error",
+}
+
+// Generated where
+
+var YoutubeSyncTargetWhere = struct {
+	ID                whereHelperstring
+	CreatedAt         whereHelpertime_Time
+	UpdatedAt         whereHelpertime_Time
+	UserID            whereHelperstring
+	Source            whereHelperstring
+	SourceID          whereHelperstring
+	Title             whereHelperstring
+	PlaylistID        whereHelpernull_String
+	Size              whereHelperint64
+	Ordering          whereHelperstring
+	LastSyncedAt      whereHelpernull_Time
+	LastSyncAttemptAt whereHelpernull_Time
+	LastError         whereHelperstring
+}{
+	ID:                whereHelperstring{field: "\"youtube_sync_targets\".\"id\""},
+	CreatedAt:         whereHelpertime_Time{field: "\"youtube_sync_targets\".\"created_at\""},
+	UpdatedAt:         whereHelpertime_Time{field: "\"youtube_sync_targets\".\"updated_at\""},
+	UserID:            whereHelperstring{field: "\"youtube_sync_targets\".\"user_id\""},
+	Source:            whereHelperstring{field: "\"youtube_sync_targets\".\"source\""},
+	SourceID:          whereHelperstring{field: "\"youtube_sync_targets\".\"source_id\""},
+	Title:             whereHelperstring{field: "\"youtube_sync_targets\".\"title\""},
+	PlaylistID:        whereHelpernull_String{field: "\"youtube_sync_targets\".\"playlist_id\""},
+	Size:              whereHelperint64{field: "\"youtube_sync_targets\".\"size\""},
+	Ordering:          whereHelperstring{field: "\"youtube_sync_targets\".\"ordering\""},
+	LastSyncedAt:      whereHelpernull_Time{field: "\"youtube_sync_targets\".\"last_synced_at\""},
+	LastSyncAttemptAt: whereHelpernull_Time{field: "\"youtube_sync_targets\".\"last_sync_attempt_at\""},
+	LastError:         whereHelperstring{field: "\"youtube_sync_targets\".\"last_error\""},
+}
+
+// YoutubeSyncTargetRels is where relationship names are stored.
+var YoutubeSyncTargetRels = struct {
+	User string
+}{
+	User: "User",
+}
+
+// youtubeSyncTargetR is where relationships are stored.
+type youtubeSyncTargetR struct {
+	User *YoutubeSyncAccount `boil:"User" json:"User" toml:"User" yaml:"User"`
+}
+
+// NewStruct creates a new relationship struct
+func (*youtubeSyncTargetR) NewStruct() *youtubeSyncTargetR {
+	return &youtubeSyncTargetR{}
+}
+
+func (o *YoutubeSyncTarget) GetUser() *YoutubeSyncAccount {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetUser()
+}
+
+func (r *youtubeSyncTargetR) GetUser() *YoutubeSyncAccount {
+	if r == nil {
+		return nil
+	}
+
+	return r.User
+}
+
+// youtubeSyncTargetL is where Load methods for each relationship are stored.
+type youtubeSyncTargetL struct{}
+
+var (
+	youtubeSyncTargetAllColumns            = []string{"id", "created_at", "updated_at", "user_id", "source", "source_id", "title", "playlist_id", "size", "ordering", "last_synced_at", "last_sync_attempt_at", "last_error"}
+	youtubeSyncTargetColumnsWithoutDefault = []string{"id", "created_at", "updated_at", "user_id", "source", "title"}
+	youtubeSyncTargetColumnsWithDefault    = []string{"source_id", "playlist_id", "size", "ordering", "last_synced_at", "last_sync_attempt_at", "last_error"}
+	youtubeSyncTargetPrimaryKeyColumns     = []string{"id"}
+	youtubeSyncTargetGeneratedColumns      = []string{}
+)
+
+type (
+	// YoutubeSyncTargetSlice is an alias for a slice of pointers to YoutubeSyncTarget.
+	// This should almost always be used instead of []YoutubeSyncTarget.
+	YoutubeSyncTargetSlice []*YoutubeSyncTarget
+	// YoutubeSyncTargetHook is the signature for custom YoutubeSyncTarget hook methods
+	YoutubeSyncTargetHook func(context.Context, boil.ContextExecutor, *YoutubeSyncTarget) error
+
+	youtubeSyncTargetQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	youtubeSyncTargetType                 = reflect.TypeOf(&YoutubeSyncTarget{})
+	youtubeSyncTargetMapping              = queries.MakeStructMapping(youtubeSyncTargetType)
+	youtubeSyncTargetPrimaryKeyMapping, _ = queries.BindMapping(youtubeSyncTargetType, youtubeSyncTargetMapping, youtubeSyncTargetPrimaryKeyColumns)
+	youtubeSyncTargetInsertCacheMut       sync.RWMutex
+	youtubeSyncTargetInsertCache          = make(map[string]insertCache)
+	youtubeSyncTargetUpdateCacheMut       sync.RWMutex
+	youtubeSyncTargetUpdateCache          = make(map[string]updateCache)
+	youtubeSyncTargetUpsertCacheMut       sync.RWMutex
+	youtubeSyncTargetUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var youtubeSyncTargetAfterSelectMu sync.Mutex
+var youtubeSyncTargetAfterSelectHooks []YoutubeSyncTargetHook
+
+var youtubeSyncTargetBeforeInsertMu sync.Mutex
+var youtubeSyncTargetBeforeInsertHooks []YoutubeSyncTargetHook
+var youtubeSyncTargetAfterInsertMu sync.Mutex
+var youtubeSyncTargetAfterInsertHooks []YoutubeSyncTargetHook
+
+var youtubeSyncTargetBeforeUpdateMu sync.Mutex
+var youtubeSyncTargetBeforeUpdateHooks []YoutubeSyncTargetHook
+var youtubeSyncTargetAfterUpdateMu sync.Mutex
+var youtubeSyncTargetAfterUpdateHooks []YoutubeSyncTargetHook
+
+var youtubeSyncTargetBeforeDeleteMu sync.Mutex
+var youtubeSyncTargetBeforeDeleteHooks []YoutubeSyncTargetHook
+var youtubeSyncTargetAfterDeleteMu sync.Mutex
+var youtubeSyncTargetAfterDeleteHooks []YoutubeSyncTargetHook
+
+var youtubeSyncTargetBeforeUpsertMu sync.Mutex
+var youtubeSyncTargetBeforeUpsertHooks []YoutubeSyncTargetHook
+var youtubeSyncTargetAfterUpsertMu sync.Mutex
+var youtubeSyncTargetAfterUpsertHooks []YoutubeSyncTargetHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *YoutubeSyncTarget) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range youtubeSyncTargetAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *YoutubeSyncTarget) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range youtubeSyncTargetBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *YoutubeSyncTarget) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range youtubeSyncTargetAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *YoutubeSyncTarget) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range youtubeSyncTargetBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *YoutubeSyncTarget) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range youtubeSyncTargetAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *YoutubeSyncTarget) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range youtubeSyncTargetBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *YoutubeSyncTarget) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range youtubeSyncTargetAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *YoutubeSyncTarget) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range youtubeSyncTargetBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *YoutubeSyncTarget) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range youtubeSyncTargetAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddYoutubeSyncTargetHook registers your hook function for all future operations.
+func AddYoutubeSyncTargetHook(hookPoint boil.HookPoint, youtubeSyncTargetHook YoutubeSyncTargetHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		youtubeSyncTargetAfterSelectMu.Lock()
+		youtubeSyncTargetAfterSelectHooks = append(youtubeSyncTargetAfterSelectHooks, youtubeSyncTargetHook)
+		youtubeSyncTargetAfterSelectMu.Unlock()
+	case boil.BeforeInsertHook:
+		youtubeSyncTargetBeforeInsertMu.Lock()
+		youtubeSyncTargetBeforeInsertHooks = append(youtubeSyncTargetBeforeInsertHooks, youtubeSyncTargetHook)
+		youtubeSyncTargetBeforeInsertMu.Unlock()
+	case boil.AfterInsertHook:
+		youtubeSyncTargetAfterInsertMu.Lock()
+		youtubeSyncTargetAfterInsertHooks = append(youtubeSyncTargetAfterInsertHooks, youtubeSyncTargetHook)
+		youtubeSyncTargetAfterInsertMu.Unlock()
+	case boil.BeforeUpdateHook:
+		youtubeSyncTargetBeforeUpdateMu.Lock()
+		youtubeSyncTargetBeforeUpdateHooks = append(youtubeSyncTargetBeforeUpdateHooks, youtubeSyncTargetHook)
+		youtubeSyncTargetBeforeUpdateMu.Unlock()
+	case boil.AfterUpdateHook:
+		youtubeSyncTargetAfterUpdateMu.Lock()
+		youtubeSyncTargetAfterUpdateHooks = append(youtubeSyncTargetAfterUpdateHooks, youtubeSyncTargetHook)
+		youtubeSyncTargetAfterUpdateMu.Unlock()
+	case boil.BeforeDeleteHook:
+		youtubeSyncTargetBeforeDeleteMu.Lock()
+		youtubeSyncTargetBeforeDeleteHooks = append(youtubeSyncTargetBeforeDeleteHooks, youtubeSyncTargetHook)
+		youtubeSyncTargetBeforeDeleteMu.Unlock()
+	case boil.AfterDeleteHook:
+		youtubeSyncTargetAfterDeleteMu.Lock()
+		youtubeSyncTargetAfterDeleteHooks = append(youtubeSyncTargetAfterDeleteHooks, youtubeSyncTargetHook)
+		youtubeSyncTargetAfterDeleteMu.Unlock()
+	case boil.BeforeUpsertHook:
+		youtubeSyncTargetBeforeUpsertMu.Lock()
+		youtubeSyncTargetBeforeUpsertHooks = append(youtubeSyncTargetBeforeUpsertHooks, youtubeSyncTargetHook)
+		youtubeSyncTargetBeforeUpsertMu.Unlock()
+	case boil.AfterUpsertHook:
+		youtubeSyncTargetAfterUpsertMu.Lock()
+		youtubeSyncTargetAfterUpsertHooks = append(youtubeSyncTargetAfterUpsertHooks, youtubeSyncTargetHook)
+		youtubeSyncTargetAfterUpsertMu.Unlock()
+	}
+}
+
+// One returns a single youtubeSyncTarget record from the query.
+func (q youtubeSyncTargetQuery) One(ctx context.Context, exec boil.ContextExecutor) (*YoutubeSyncTarget, error) {
+	o := &YoutubeSyncTarget{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: failed to execute a one query for youtube_sync_targets")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all YoutubeSyncTarget records from the query.
+func (q youtubeSyncTargetQuery) All(ctx context.Context, exec boil.ContextExecutor) (YoutubeSyncTargetSlice, error) {
+	var o []*YoutubeSyncTarget
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "models: failed to assign all query results to YoutubeSyncTarget slice")
+	}
+
+	if len(youtubeSyncTargetAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all YoutubeSyncTarget records in the query.
+func (q youtubeSyncTargetQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to count youtube_sync_targets rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q youtubeSyncTargetQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "models: failed to check if youtube_sync_targets exists")
+	}
+
+	return count > 0, nil
+}
+
+// User pointed to by the foreign key.
+func (o *YoutubeSyncTarget) User(mods ...qm.QueryMod) youtubeSyncAccountQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"user_id\" = ?", o.UserID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return YoutubeSyncAccounts(queryMods...)
+}
+
+// LoadUser allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (youtubeSyncTargetL) LoadUser(ctx context.Context, e boil.ContextExecutor, singular bool, maybeYoutubeSyncTarget any, mods queries.Applicator) error {
+	var slice []*YoutubeSyncTarget
+	var object *YoutubeSyncTarget
+
+	if singular {
+		var ok bool
+		object, ok = maybeYoutubeSyncTarget.(*YoutubeSyncTarget)
+		if !ok {
+			object = new(YoutubeSyncTarget)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeYoutubeSyncTarget)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeYoutubeSyncTarget))
+			}
+		}
+	} else {
+		s, ok := maybeYoutubeSyncTarget.(*[]*YoutubeSyncTarget)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeYoutubeSyncTarget)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeYoutubeSyncTarget))
+			}
+		}
+	}
+
+	args := make(map[any]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &youtubeSyncTargetR{}
+		}
+		args[object.UserID] = struct{}{}
+
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &youtubeSyncTargetR{}
+			}
+
+			args[obj.UserID] = struct{}{}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]any, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`youtube_sync_accounts`),
+		qm.WhereIn(`youtube_sync_accounts.user_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load YoutubeSyncAccount")
+	}
+
+	var resultSlice []*YoutubeSyncAccount
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice YoutubeSyncAccount")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for youtube_sync_accounts")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for youtube_sync_accounts")
+	}
+
+	if len(youtubeSyncAccountAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.User = foreign
+		if foreign.R == nil {
+			foreign.R = &youtubeSyncAccountR{}
+		}
+		foreign.R.UserYoutubeSyncTargets = append(foreign.R.UserYoutubeSyncTargets, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.UserID == foreign.UserID {
+				local.R.User = foreign
+				if foreign.R == nil {
+					foreign.R = &youtubeSyncAccountR{}
+				}
+				foreign.R.UserYoutubeSyncTargets = append(foreign.R.UserYoutubeSyncTargets, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetUser of the youtubeSyncTarget to the related item.
+// Sets o.R.User to related.
+// Adds o to related.R.UserYoutubeSyncTargets.
+func (o *YoutubeSyncTarget) SetUser(ctx context.Context, exec boil.ContextExecutor, insert bool, related *YoutubeSyncAccount) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"youtube_sync_targets\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 0, []string{"user_id"}),
+		strmangle.WhereClause("\"", "\"", 0, youtubeSyncTargetPrimaryKeyColumns),
+	)
+	values := []any{related.UserID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.UserID = related.UserID
+	if o.R == nil {
+		o.R = &youtubeSyncTargetR{
+			User: related,
+		}
+	} else {
+		o.R.User = related
+	}
+
+	if related.R == nil {
+		related.R = &youtubeSyncAccountR{
+			UserYoutubeSyncTargets: YoutubeSyncTargetSlice{o},
+		}
+	} else {
+		related.R.UserYoutubeSyncTargets = append(related.R.UserYoutubeSyncTargets, o)
+	}
+
+	return nil
+}
+
+// YoutubeSyncTargets retrieves all the records using an executor.
+func YoutubeSyncTargets(mods ...qm.QueryMod) youtubeSyncTargetQuery {
+	mods = append(mods, qm.From("\"youtube_sync_targets\""))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"\"youtube_sync_targets\".*"})
+	}
+
+	return youtubeSyncTargetQuery{q}
+}
+
+// FindYoutubeSyncTarget retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindYoutubeSyncTarget(ctx context.Context, exec boil.ContextExecutor, iD string, selectCols ...string) (*YoutubeSyncTarget, error) {
+	youtubeSyncTargetObj := &YoutubeSyncTarget{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"youtube_sync_targets\" where \"id\"=?", sel,
+	)
+
+	q := queries.Raw(query, iD)
+
+	err := q.Bind(ctx, exec, youtubeSyncTargetObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: unable to select from youtube_sync_targets")
+	}
+
+	if err = youtubeSyncTargetObj.doAfterSelectHooks(ctx, exec); err != nil {
+		return youtubeSyncTargetObj, err
+	}
+
+	return youtubeSyncTargetObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *YoutubeSyncTarget) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no youtube_sync_targets provided for insertion")
+	}
+
+	var err error
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+		if o.UpdatedAt.IsZero() {
+			o.UpdatedAt = currTime
+		}
+	}
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(youtubeSyncTargetColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	youtubeSyncTargetInsertCacheMut.RLock()
+	cache, cached := youtubeSyncTargetInsertCache[key]
+	youtubeSyncTargetInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			youtubeSyncTargetAllColumns,
+			youtubeSyncTargetColumnsWithDefault,
+			youtubeSyncTargetColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(youtubeSyncTargetType, youtubeSyncTargetMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(youtubeSyncTargetType, youtubeSyncTargetMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"youtube_sync_targets\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"youtube_sync_targets\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "models: unable to insert into youtube_sync_targets")
+	}
+
+	if !cached {
+		youtubeSyncTargetInsertCacheMut.Lock()
+		youtubeSyncTargetInsertCache[key] = cache
+		youtubeSyncTargetInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// Update uses an executor to update the YoutubeSyncTarget.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *YoutubeSyncTarget) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		o.UpdatedAt = currTime
+	}
+
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	youtubeSyncTargetUpdateCacheMut.RLock()
+	cache, cached := youtubeSyncTargetUpdateCache[key]
+	youtubeSyncTargetUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			youtubeSyncTargetAllColumns,
+			youtubeSyncTargetPrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("models: unable to update youtube_sync_targets, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"youtube_sync_targets\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 0, wl),
+			strmangle.WhereClause("\"", "\"", 0, youtubeSyncTargetPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(youtubeSyncTargetType, youtubeSyncTargetMapping, append(wl, youtubeSyncTargetPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update youtube_sync_targets row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by update for youtube_sync_targets")
+	}
+
+	if !cached {
+		youtubeSyncTargetUpdateCacheMut.Lock()
+		youtubeSyncTargetUpdateCache[key] = cache
+		youtubeSyncTargetUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q youtubeSyncTargetQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all for youtube_sync_targets")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected for youtube_sync_targets")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o YoutubeSyncTargetSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("models: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]any, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), youtubeSyncTargetPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"youtube_sync_targets\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 0, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, youtubeSyncTargetPrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all in youtubeSyncTarget slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected all in update all youtubeSyncTarget")
+	}
+	return rowsAff, nil
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *YoutubeSyncTarget) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no youtube_sync_targets provided for upsert")
+	}
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+		o.UpdatedAt = currTime
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(youtubeSyncTargetColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	youtubeSyncTargetUpsertCacheMut.RLock()
+	cache, cached := youtubeSyncTargetUpsertCache[key]
+	youtubeSyncTargetUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, _ := insertColumns.InsertColumnSet(
+			youtubeSyncTargetAllColumns,
+			youtubeSyncTargetColumnsWithDefault,
+			youtubeSyncTargetColumnsWithoutDefault,
+			nzDefaults,
+		)
+		update := updateColumns.UpdateColumnSet(
+			youtubeSyncTargetAllColumns,
+			youtubeSyncTargetPrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("models: unable to upsert youtube_sync_targets, could not build update column list")
+		}
+
+		ret := strmangle.SetComplement(youtubeSyncTargetAllColumns, strmangle.SetIntersect(insert, update))
+
+		conflict := conflictColumns
+		if len(conflict) == 0 {
+			conflict = make([]string, len(youtubeSyncTargetPrimaryKeyColumns))
+			copy(conflict, youtubeSyncTargetPrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQuerySQLite(dialect, "\"youtube_sync_targets\"", updateOnConflict, ret, update, conflict, insert)
+
+		cache.valueMapping, err = queries.BindMapping(youtubeSyncTargetType, youtubeSyncTargetMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(youtubeSyncTargetType, youtubeSyncTargetMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []any
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if errors.Is(err, sql.ErrNoRows) {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "models: unable to upsert youtube_sync_targets")
+	}
+
+	if !cached {
+		youtubeSyncTargetUpsertCacheMut.Lock()
+		youtubeSyncTargetUpsertCache[key] = cache
+		youtubeSyncTargetUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// Delete deletes a single YoutubeSyncTarget record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *YoutubeSyncTarget) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("models: no YoutubeSyncTarget provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), youtubeSyncTargetPrimaryKeyMapping)
+	sql := "DELETE FROM \"youtube_sync_targets\" WHERE \"id\"=?"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete from youtube_sync_targets")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by delete for youtube_sync_targets")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q youtubeSyncTargetQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("models: no youtubeSyncTargetQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from youtube_sync_targets")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for youtube_sync_targets")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o YoutubeSyncTargetSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(youtubeSyncTargetBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []any
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), youtubeSyncTargetPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"youtube_sync_targets\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, youtubeSyncTargetPrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from youtubeSyncTarget slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for youtube_sync_targets")
+	}
+
+	if len(youtubeSyncTargetAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *YoutubeSyncTarget) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindYoutubeSyncTarget(ctx, exec, o.ID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *YoutubeSyncTargetSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := YoutubeSyncTargetSlice{}
+	var args []any
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), youtubeSyncTargetPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"youtube_sync_targets\".* FROM \"youtube_sync_targets\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, youtubeSyncTargetPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to reload all in YoutubeSyncTargetSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// YoutubeSyncTargetExists checks if the YoutubeSyncTarget row exists.
+func YoutubeSyncTargetExists(ctx context.Context, exec boil.ContextExecutor, iD string) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"youtube_sync_targets\" where \"id\"=? limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, iD)
+	}
+	row := exec.QueryRowContext(ctx, sql, iD)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "models: unable to check if youtube_sync_targets exists")
+	}
+
+	return exists, nil
+}
+
+// Exists checks if the YoutubeSyncTarget row exists.
+func (o *YoutubeSyncTarget) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	return YoutubeSyncTargetExists(ctx, exec, o.ID)
+}
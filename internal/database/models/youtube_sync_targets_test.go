@@ -0,0 +1,850 @@
+// Code generated by SQLBoiler 4.19.7 (https://github.com/aarondl/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"bytes"
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/aarondl/randomize"
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries"
+	"github.com/aarondl/strmangle"
+)
+
+var (
+	// Relationships sometimes use the reflection helper queries.Equal/queries.Assign
+	// so force a package dependency in case they don't.
+	_ = queries.Equal
+)
+
+func testYoutubeSyncTargets(t *testing.T) {
+	t.Parallel()
+
+	query := YoutubeSyncTargets()
+
+	if query.Query == nil {
+		t.Error("expected a query, got nothing")
+	}
+}
+
+func testYoutubeSyncTargetsDelete(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &YoutubeSyncTarget{}
+	if err = randomize.Struct(seed, o, youtubeSyncTargetDBTypes, true, youtubeSyncTargetColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize YoutubeSyncTarget struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := o.Delete(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := YoutubeSyncTargets().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testYoutubeSyncTargetsQueryDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &YoutubeSyncTarget{}
+	if err = randomize.Struct(seed, o, youtubeSyncTargetDBTypes, true, youtubeSyncTargetColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize YoutubeSyncTarget struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := YoutubeSyncTargets().DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := YoutubeSyncTargets().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testYoutubeSyncTargetsSliceDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &YoutubeSyncTarget{}
+	if err = randomize.Struct(seed, o, youtubeSyncTargetDBTypes, true, youtubeSyncTargetColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize YoutubeSyncTarget struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := YoutubeSyncTargetSlice{o}
+
+	if rowsAff, err := slice.DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := YoutubeSyncTargets().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testYoutubeSyncTargetsExists(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &YoutubeSyncTarget{}
+	if err = randomize.Struct(seed, o, youtubeSyncTargetDBTypes, true, youtubeSyncTargetColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize YoutubeSyncTarget struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	e, err := YoutubeSyncTargetExists(ctx, tx, o.ID)
+	if err != nil {
+		t.Errorf("Unable to check if YoutubeSyncTarget exists: %s", err)
+	}
+	if !e {
+		t.Errorf("Expected YoutubeSyncTargetExists to return true, but got false.")
+	}
+}
+
+func testYoutubeSyncTargetsFind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &YoutubeSyncTarget{}
+	if err = randomize.Struct(seed, o, youtubeSyncTargetDBTypes, true, youtubeSyncTargetColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize YoutubeSyncTarget struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	youtubeSyncTargetFound, err := FindYoutubeSyncTarget(ctx, tx, o.ID)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if youtubeSyncTargetFound == nil {
+		t.Error("want a record, got nil")
+	}
+}
+
+func testYoutubeSyncTargetsBind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &YoutubeSyncTarget{}
+	if err = randomize.Struct(seed, o, youtubeSyncTargetDBTypes, true, youtubeSyncTargetColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize YoutubeSyncTarget struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = YoutubeSyncTargets().Bind(ctx, tx, o); err != nil {
+		t.Error(err)
+	}
+}
+
+func testYoutubeSyncTargetsOne(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &YoutubeSyncTarget{}
+	if err = randomize.Struct(seed, o, youtubeSyncTargetDBTypes, true, youtubeSyncTargetColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize YoutubeSyncTarget struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if x, err := YoutubeSyncTargets().One(ctx, tx); err != nil {
+		t.Error(err)
+	} else if x == nil {
+		t.Error("expected to get a non nil record")
+	}
+}
+
+func testYoutubeSyncTargetsAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	youtubeSyncTargetOne := &YoutubeSyncTarget{}
+	youtubeSyncTargetTwo := &YoutubeSyncTarget{}
+	if err = randomize.Struct(seed, youtubeSyncTargetOne, youtubeSyncTargetDBTypes, false, youtubeSyncTargetColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize YoutubeSyncTarget struct: %s", err)
+	}
+	if err = randomize.Struct(seed, youtubeSyncTargetTwo, youtubeSyncTargetDBTypes, false, youtubeSyncTargetColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize YoutubeSyncTarget struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = youtubeSyncTargetOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = youtubeSyncTargetTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := YoutubeSyncTargets().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 2 {
+		t.Error("want 2 records, got:", len(slice))
+	}
+}
+
+func testYoutubeSyncTargetsCount(t *testing.T) {
+	t.Parallel()
+
+	var err error
+	seed := randomize.NewSeed()
+	youtubeSyncTargetOne := &YoutubeSyncTarget{}
+	youtubeSyncTargetTwo := &YoutubeSyncTarget{}
+	if err = randomize.Struct(seed, youtubeSyncTargetOne, youtubeSyncTargetDBTypes, false, youtubeSyncTargetColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize YoutubeSyncTarget struct: %s", err)
+	}
+	if err = randomize.Struct(seed, youtubeSyncTargetTwo, youtubeSyncTargetDBTypes, false, youtubeSyncTargetColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize YoutubeSyncTarget struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = youtubeSyncTargetOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = youtubeSyncTargetTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := YoutubeSyncTargets().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 2 {
+		t.Error("want 2 records, got:", count)
+	}
+}
+
+func youtubeSyncTargetBeforeInsertHook(ctx context.Context, e boil.ContextExecutor, o *YoutubeSyncTarget) error {
+	*o = YoutubeSyncTarget{}
+	return nil
+}
+
+func youtubeSyncTargetAfterInsertHook(ctx context.Context, e boil.ContextExecutor, o *YoutubeSyncTarget) error {
+	*o = YoutubeSyncTarget{}
+	return nil
+}
+
+func youtubeSyncTargetAfterSelectHook(ctx context.Context, e boil.ContextExecutor, o *YoutubeSyncTarget) error {
+	*o = YoutubeSyncTarget{}
+	return nil
+}
+
+func youtubeSyncTargetBeforeUpdateHook(ctx context.Context, e boil.ContextExecutor, o *YoutubeSyncTarget) error {
+	*o = YoutubeSyncTarget{}
+	return nil
+}
+
+func youtubeSyncTargetAfterUpdateHook(ctx context.Context, e boil.ContextExecutor, o *YoutubeSyncTarget) error {
+	*o = YoutubeSyncTarget{}
+	return nil
+}
+
+func youtubeSyncTargetBeforeDeleteHook(ctx context.Context, e boil.ContextExecutor, o *YoutubeSyncTarget) error {
+	*o = YoutubeSyncTarget{}
+	return nil
+}
+
+func youtubeSyncTargetAfterDeleteHook(ctx context.Context, e boil.ContextExecutor, o *YoutubeSyncTarget) error {
+	*o = YoutubeSyncTarget{}
+	return nil
+}
+
+func youtubeSyncTargetBeforeUpsertHook(ctx context.Context, e boil.ContextExecutor, o *YoutubeSyncTarget) error {
+	*o = YoutubeSyncTarget{}
+	return nil
+}
+
+func youtubeSyncTargetAfterUpsertHook(ctx context.Context, e boil.ContextExecutor, o *YoutubeSyncTarget) error {
+	*o = YoutubeSyncTarget{}
+	return nil
+}
+
+func testYoutubeSyncTargetsHooks(t *testing.T) {
+	t.Parallel()
+
+	var err error
+
+	ctx := context.Background()
+	empty := &YoutubeSyncTarget{}
+	o := &YoutubeSyncTarget{}
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, o, youtubeSyncTargetDBTypes, false); err != nil {
+		t.Errorf("Unable to randomize YoutubeSyncTarget object: %s", err)
+	}
+
+	AddYoutubeSyncTargetHook(boil.BeforeInsertHook, youtubeSyncTargetBeforeInsertHook)
+	if err = o.doBeforeInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeInsertHook function to empty object, but got: %#v", o)
+	}
+	youtubeSyncTargetBeforeInsertHooks = []YoutubeSyncTargetHook{}
+
+	AddYoutubeSyncTargetHook(boil.AfterInsertHook, youtubeSyncTargetAfterInsertHook)
+	if err = o.doAfterInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterInsertHook function to empty object, but got: %#v", o)
+	}
+	youtubeSyncTargetAfterInsertHooks = []YoutubeSyncTargetHook{}
+
+	AddYoutubeSyncTargetHook(boil.AfterSelectHook, youtubeSyncTargetAfterSelectHook)
+	if err = o.doAfterSelectHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterSelectHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterSelectHook function to empty object, but got: %#v", o)
+	}
+	youtubeSyncTargetAfterSelectHooks = []YoutubeSyncTargetHook{}
+
+	AddYoutubeSyncTargetHook(boil.BeforeUpdateHook, youtubeSyncTargetBeforeUpdateHook)
+	if err = o.doBeforeUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpdateHook function to empty object, but got: %#v", o)
+	}
+	youtubeSyncTargetBeforeUpdateHooks = []YoutubeSyncTargetHook{}
+
+	AddYoutubeSyncTargetHook(boil.AfterUpdateHook, youtubeSyncTargetAfterUpdateHook)
+	if err = o.doAfterUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpdateHook function to empty object, but got: %#v", o)
+	}
+	youtubeSyncTargetAfterUpdateHooks = []YoutubeSyncTargetHook{}
+
+	AddYoutubeSyncTargetHook(boil.BeforeDeleteHook, youtubeSyncTargetBeforeDeleteHook)
+	if err = o.doBeforeDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeDeleteHook function to empty object, but got: %#v", o)
+	}
+	youtubeSyncTargetBeforeDeleteHooks = []YoutubeSyncTargetHook{}
+
+	AddYoutubeSyncTargetHook(boil.AfterDeleteHook, youtubeSyncTargetAfterDeleteHook)
+	if err = o.doAfterDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterDeleteHook function to empty object, but got: %#v", o)
+	}
+	youtubeSyncTargetAfterDeleteHooks = []YoutubeSyncTargetHook{}
+
+	AddYoutubeSyncTargetHook(boil.BeforeUpsertHook, youtubeSyncTargetBeforeUpsertHook)
+	if err = o.doBeforeUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpsertHook function to empty object, but got: %#v", o)
+	}
+	youtubeSyncTargetBeforeUpsertHooks = []YoutubeSyncTargetHook{}
+
+	AddYoutubeSyncTargetHook(boil.AfterUpsertHook, youtubeSyncTargetAfterUpsertHook)
+	if err = o.doAfterUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpsertHook function to empty object, but got: %#v", o)
+	}
+	youtubeSyncTargetAfterUpsertHooks = []YoutubeSyncTargetHook{}
+}
+
+func testYoutubeSyncTargetsInsert(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &YoutubeSyncTarget{}
+	if err = randomize.Struct(seed, o, youtubeSyncTargetDBTypes, true, youtubeSyncTargetColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize YoutubeSyncTarget struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := YoutubeSyncTargets().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testYoutubeSyncTargetsInsertWhitelist(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &YoutubeSyncTarget{}
+	if err = randomize.Struct(seed, o, youtubeSyncTargetDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize YoutubeSyncTarget struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Whitelist(strmangle.SetMerge(youtubeSyncTargetPrimaryKeyColumns, youtubeSyncTargetColumnsWithoutDefault)...)); err != nil {
+		t.Error(err)
+	}
+
+	count, err := YoutubeSyncTargets().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testYoutubeSyncTargetToOneYoutubeSyncAccountUsingUser(t *testing.T) {
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var local YoutubeSyncTarget
+	var foreign YoutubeSyncAccount
+
+	seed := randomize.NewSeed()
+	if err := randomize.Struct(seed, &local, youtubeSyncTargetDBTypes, false, youtubeSyncTargetColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize YoutubeSyncTarget struct: %s", err)
+	}
+	if err := randomize.Struct(seed, &foreign, youtubeSyncAccountDBTypes, false, youtubeSyncAccountColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize YoutubeSyncAccount struct: %s", err)
+	}
+
+	if err := foreign.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	local.UserID = foreign.UserID
+	if err := local.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := local.User().One(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if check.UserID != foreign.UserID {
+		t.Errorf("want: %v, got %v", foreign.UserID, check.UserID)
+	}
+
+	ranAfterSelectHook := false
+	AddYoutubeSyncAccountHook(boil.AfterSelectHook, func(ctx context.Context, e boil.ContextExecutor, o *YoutubeSyncAccount) error {
+		ranAfterSelectHook = true
+		return nil
+	})
+
+	slice := YoutubeSyncTargetSlice{&local}
+	if err = local.L.LoadUser(ctx, tx, false, (*[]*YoutubeSyncTarget)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.User == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	local.R.User = nil
+	if err = local.L.LoadUser(ctx, tx, true, &local, nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.User == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	if !ranAfterSelectHook {
+		t.Error("failed to run AfterSelect hook for relationship")
+	}
+}
+
+func testYoutubeSyncTargetToOneSetOpYoutubeSyncAccountUsingUser(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a YoutubeSyncTarget
+	var b, c YoutubeSyncAccount
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, youtubeSyncTargetDBTypes, false, strmangle.SetComplement(youtubeSyncTargetPrimaryKeyColumns, youtubeSyncTargetColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, youtubeSyncAccountDBTypes, false, strmangle.SetComplement(youtubeSyncAccountPrimaryKeyColumns, youtubeSyncAccountColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, youtubeSyncAccountDBTypes, false, strmangle.SetComplement(youtubeSyncAccountPrimaryKeyColumns, youtubeSyncAccountColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	for i, x := range []*YoutubeSyncAccount{&b, &c} {
+		err = a.SetUser(ctx, tx, i != 0, x)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if a.R.User != x {
+			t.Error("relationship struct not set to correct value")
+		}
+
+		if x.R.UserYoutubeSyncTargets[0] != &a {
+			t.Error("failed to append to foreign relationship struct")
+		}
+		if a.UserID != x.UserID {
+			t.Error("foreign key was wrong value", a.UserID)
+		}
+
+		zero := reflect.Zero(reflect.TypeOf(a.UserID))
+		reflect.Indirect(reflect.ValueOf(&a.UserID)).Set(zero)
+
+		if err = a.Reload(ctx, tx); err != nil {
+			t.Fatal("failed to reload", err)
+		}
+
+		if a.UserID != x.UserID {
+			t.Error("foreign key was wrong value", a.UserID, x.UserID)
+		}
+	}
+}
+
+func testYoutubeSyncTargetsReload(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &YoutubeSyncTarget{}
+	if err = randomize.Struct(seed, o, youtubeSyncTargetDBTypes, true, youtubeSyncTargetColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize YoutubeSyncTarget struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = o.Reload(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testYoutubeSyncTargetsReloadAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &YoutubeSyncTarget{}
+	if err = randomize.Struct(seed, o, youtubeSyncTargetDBTypes, true, youtubeSyncTargetColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize YoutubeSyncTarget struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := YoutubeSyncTargetSlice{o}
+
+	if err = slice.ReloadAll(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testYoutubeSyncTargetsSelect(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &YoutubeSyncTarget{}
+	if err = randomize.Struct(seed, o, youtubeSyncTargetDBTypes, true, youtubeSyncTargetColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize YoutubeSyncTarget struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := YoutubeSyncTargets().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 1 {
+		t.Error("want one record, got:", len(slice))
+	}
+}
+
+var (
+	youtubeSyncTargetDBTypes = map[string]string{`ID`: `TEXT`, `CreatedAt`: `DATE`, `UpdatedAt`: `DATE`, `UserID`: `TEXT`, `Source`: `TEXT`, `SourceID`: `TEXT`, `Title`: `TEXT`, `PlaylistID`: `TEXT`, `Size`: `INTEGER`, `Ordering`: `TEXT`, `LastSyncedAt`: `DATE`, `LastSyncAttemptAt`: `DATE`, `LastError`: `TEXT`}
+	_                        = bytes.MinRead
+)
+
+func testYoutubeSyncTargetsUpdate(t *testing.T) {
+	t.Parallel()
+
+	if 0 == len(youtubeSyncTargetPrimaryKeyColumns) {
+		t.Skip("Skipping table with no primary key columns")
+	}
+	if len(youtubeSyncTargetAllColumns) == len(youtubeSyncTargetPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &YoutubeSyncTarget{}
+	if err = randomize.Struct(seed, o, youtubeSyncTargetDBTypes, true, youtubeSyncTargetColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize YoutubeSyncTarget struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := YoutubeSyncTargets().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, youtubeSyncTargetDBTypes, true, youtubeSyncTargetPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize YoutubeSyncTarget struct: %s", err)
+	}
+
+	if rowsAff, err := o.Update(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only affect one row but affected", rowsAff)
+	}
+}
+
+func testYoutubeSyncTargetsSliceUpdateAll(t *testing.T) {
+	t.Parallel()
+
+	if len(youtubeSyncTargetAllColumns) == len(youtubeSyncTargetPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &YoutubeSyncTarget{}
+	if err = randomize.Struct(seed, o, youtubeSyncTargetDBTypes, true, youtubeSyncTargetColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize YoutubeSyncTarget struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := YoutubeSyncTargets().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, youtubeSyncTargetDBTypes, true, youtubeSyncTargetPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize YoutubeSyncTarget struct: %s", err)
+	}
+
+	// Remove Primary keys and unique columns from what we plan to update
+	var fields []string
+	if strmangle.StringSliceMatch(youtubeSyncTargetAllColumns, youtubeSyncTargetPrimaryKeyColumns) {
+		fields = youtubeSyncTargetAllColumns
+	} else {
+		fields = strmangle.SetComplement(
+			youtubeSyncTargetAllColumns,
+			youtubeSyncTargetPrimaryKeyColumns,
+		)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	typ := reflect.TypeOf(o).Elem()
+	n := typ.NumField()
+
+	updateMap := M{}
+	for _, col := range fields {
+		for i := 0; i < n; i++ {
+			f := typ.Field(i)
+			if f.Tag.Get("boil") == col {
+				updateMap[col] = value.Field(i).Interface()
+			}
+		}
+	}
+
+	slice := YoutubeSyncTargetSlice{o}
+	if rowsAff, err := slice.UpdateAll(ctx, tx, updateMap); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("wanted one record updated but got", rowsAff)
+	}
+}
+
+func testYoutubeSyncTargetsUpsert(t *testing.T) {
+	t.Parallel()
+	if len(youtubeSyncTargetAllColumns) == len(youtubeSyncTargetPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	// Attempt the INSERT side of an UPSERT
+	o := YoutubeSyncTarget{}
+	if err = randomize.Struct(seed, &o, youtubeSyncTargetDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize YoutubeSyncTarget struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Upsert(ctx, tx, false, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert YoutubeSyncTarget: %s", err)
+	}
+
+	count, err := YoutubeSyncTargets().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	// Attempt the UPDATE side of an UPSERT
+	if err = randomize.Struct(seed, &o, youtubeSyncTargetDBTypes, false, youtubeSyncTargetPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize YoutubeSyncTarget struct: %s", err)
+	}
+
+	if err = o.Upsert(ctx, tx, true, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert YoutubeSyncTarget: %s", err)
+	}
+
+	count, err = YoutubeSyncTargets().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
@@ -0,0 +1,1149 @@
+// Code generated by SQLBoiler 4.19.7 (https://github.com/aarondl/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries"
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+	"github.com/aarondl/sqlboiler/v4/queries/qmhelper"
+	"github.com/aarondl/strmangle"
+	"github.com/friendsofgo/errors"
+)
+
+// YoutubeTVPairingToken is an object representing the database table.
+type YoutubeTVPairingToken struct {
+	TokenHash string    `boil:"token_hash" json:"token_hash" toml:"token_hash" yaml:"token_hash"`
+	CreatedAt time.Time `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+	ExpiresAt time.Time `boil:"expires_at" json:"expires_at" toml:"expires_at" yaml:"expires_at"`
+	UserID    string    `boil:"user_id" json:"user_id" toml:"user_id" yaml:"user_id"`
+
+	R *youtubeTVPairingTokenR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L youtubeTVPairingTokenL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var YoutubeTVPairingTokenColumns = struct {
+	TokenHash string
+	CreatedAt string
+	ExpiresAt string
+	UserID    string
+}{
+	TokenHash: "token_hash",
+	CreatedAt: "created_at",
+	ExpiresAt: "expires_at",
+	UserID:    "user_id",
+}
+
+var YoutubeTVPairingTokenTableColumns = struct {
+	TokenHash string
+	CreatedAt string
+	ExpiresAt string
+	UserID    string
+}{
+	TokenHash: "youtube_tv_pairing_tokens.token_hash",
+	CreatedAt: "youtube_tv_pairing_tokens.created_at",
+	ExpiresAt: "youtube_tv_pairing_tokens.expires_at",
+	UserID:    "youtube_tv_pairing_tokens.user_id",
+}
+
+// Generated where
+
+var YoutubeTVPairingTokenWhere = struct {
+	TokenHash whereHelperstring
+	CreatedAt whereHelpertime_Time
+	ExpiresAt whereHelpertime_Time
+	UserID    whereHelperstring
+}{
+	TokenHash: whereHelperstring{field: "\"youtube_tv_pairing_tokens\".\"token_hash\""},
+	CreatedAt: whereHelpertime_Time{field: "\"youtube_tv_pairing_tokens\".\"created_at\""},
+	ExpiresAt: whereHelpertime_Time{field: "\"youtube_tv_pairing_tokens\".\"expires_at\""},
+	UserID:    whereHelperstring{field: "\"youtube_tv_pairing_tokens\".\"user_id\""},
+}
+
+// YoutubeTVPairingTokenRels is where relationship names are stored.
+var YoutubeTVPairingTokenRels = struct {
+	User string
+}{
+	User: "User",
+}
+
+// youtubeTVPairingTokenR is where relationships are stored.
+type youtubeTVPairingTokenR struct {
+	User *User `boil:"User" json:"User" toml:"User" yaml:"User"`
+}
+
+// NewStruct creates a new relationship struct
+func (*youtubeTVPairingTokenR) NewStruct() *youtubeTVPairingTokenR {
+	return &youtubeTVPairingTokenR{}
+}
+
+func (o *YoutubeTVPairingToken) GetUser() *User {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetUser()
+}
+
+func (r *youtubeTVPairingTokenR) GetUser() *User {
+	if r == nil {
+		return nil
+	}
+
+	return r.User
+}
+
+// youtubeTVPairingTokenL is where Load methods for each relationship are stored.
+type youtubeTVPairingTokenL struct{}
+
+var (
+	youtubeTVPairingTokenAllColumns            = []string{"token_hash", "created_at", "expires_at", "user_id"}
+	youtubeTVPairingTokenColumnsWithoutDefault = []string{"token_hash", "created_at", "expires_at", "user_id"}
+	youtubeTVPairingTokenColumnsWithDefault    = []string{}
+	youtubeTVPairingTokenPrimaryKeyColumns     = []string{"token_hash"}
+	youtubeTVPairingTokenGeneratedColumns      = []string{}
+)
+
+type (
+	// YoutubeTVPairingTokenSlice is an alias for a slice of pointers to YoutubeTVPairingToken.
+	// This should almost always be used instead of []YoutubeTVPairingToken.
+	YoutubeTVPairingTokenSlice []*YoutubeTVPairingToken
+	// YoutubeTVPairingTokenHook is the signature for custom YoutubeTVPairingToken hook methods
+	YoutubeTVPairingTokenHook func(context.Context, boil.ContextExecutor, *YoutubeTVPairingToken) error
+
+	youtubeTVPairingTokenQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	youtubeTVPairingTokenType                 = reflect.TypeOf(&YoutubeTVPairingToken{})
+	youtubeTVPairingTokenMapping              = queries.MakeStructMapping(youtubeTVPairingTokenType)
+	youtubeTVPairingTokenPrimaryKeyMapping, _ = queries.BindMapping(youtubeTVPairingTokenType, youtubeTVPairingTokenMapping, youtubeTVPairingTokenPrimaryKeyColumns)
+	youtubeTVPairingTokenInsertCacheMut       sync.RWMutex
+	youtubeTVPairingTokenInsertCache          = make(map[string]insertCache)
+	youtubeTVPairingTokenUpdateCacheMut       sync.RWMutex
+	youtubeTVPairingTokenUpdateCache          = make(map[string]updateCache)
+	youtubeTVPairingTokenUpsertCacheMut       sync.RWMutex
+	youtubeTVPairingTokenUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var youtubeTVPairingTokenAfterSelectMu sync.Mutex
+var youtubeTVPairingTokenAfterSelectHooks []YoutubeTVPairingTokenHook
+
+var youtubeTVPairingTokenBeforeInsertMu sync.Mutex
+var youtubeTVPairingTokenBeforeInsertHooks []YoutubeTVPairingTokenHook
+var youtubeTVPairingTokenAfterInsertMu sync.Mutex
+var youtubeTVPairingTokenAfterInsertHooks []YoutubeTVPairingTokenHook
+
+var youtubeTVPairingTokenBeforeUpdateMu sync.Mutex
+var youtubeTVPairingTokenBeforeUpdateHooks []YoutubeTVPairingTokenHook
+var youtubeTVPairingTokenAfterUpdateMu sync.Mutex
+var youtubeTVPairingTokenAfterUpdateHooks []YoutubeTVPairingTokenHook
+
+var youtubeTVPairingTokenBeforeDeleteMu sync.Mutex
+var youtubeTVPairingTokenBeforeDeleteHooks []YoutubeTVPairingTokenHook
+var youtubeTVPairingTokenAfterDeleteMu sync.Mutex
+var youtubeTVPairingTokenAfterDeleteHooks []YoutubeTVPairingTokenHook
+
+var youtubeTVPairingTokenBeforeUpsertMu sync.Mutex
+var youtubeTVPairingTokenBeforeUpsertHooks []YoutubeTVPairingTokenHook
+var youtubeTVPairingTokenAfterUpsertMu sync.Mutex
+var youtubeTVPairingTokenAfterUpsertHooks []YoutubeTVPairingTokenHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *YoutubeTVPairingToken) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range youtubeTVPairingTokenAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *YoutubeTVPairingToken) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range youtubeTVPairingTokenBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *YoutubeTVPairingToken) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range youtubeTVPairingTokenAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *YoutubeTVPairingToken) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range youtubeTVPairingTokenBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *YoutubeTVPairingToken) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range youtubeTVPairingTokenAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *YoutubeTVPairingToken) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range youtubeTVPairingTokenBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *YoutubeTVPairingToken) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range youtubeTVPairingTokenAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *YoutubeTVPairingToken) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range youtubeTVPairingTokenBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *YoutubeTVPairingToken) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range youtubeTVPairingTokenAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddYoutubeTVPairingTokenHook registers your hook function for all future operations.
+func AddYoutubeTVPairingTokenHook(hookPoint boil.HookPoint, youtubeTVPairingTokenHook YoutubeTVPairingTokenHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		youtubeTVPairingTokenAfterSelectMu.Lock()
+		youtubeTVPairingTokenAfterSelectHooks = append(youtubeTVPairingTokenAfterSelectHooks, youtubeTVPairingTokenHook)
+		youtubeTVPairingTokenAfterSelectMu.Unlock()
+	case boil.BeforeInsertHook:
+		youtubeTVPairingTokenBeforeInsertMu.Lock()
+		youtubeTVPairingTokenBeforeInsertHooks = append(youtubeTVPairingTokenBeforeInsertHooks, youtubeTVPairingTokenHook)
+		youtubeTVPairingTokenBeforeInsertMu.Unlock()
+	case boil.AfterInsertHook:
+		youtubeTVPairingTokenAfterInsertMu.Lock()
+		youtubeTVPairingTokenAfterInsertHooks = append(youtubeTVPairingTokenAfterInsertHooks, youtubeTVPairingTokenHook)
+		youtubeTVPairingTokenAfterInsertMu.Unlock()
+	case boil.BeforeUpdateHook:
+		youtubeTVPairingTokenBeforeUpdateMu.Lock()
+		youtubeTVPairingTokenBeforeUpdateHooks = append(youtubeTVPairingTokenBeforeUpdateHooks, youtubeTVPairingTokenHook)
+		youtubeTVPairingTokenBeforeUpdateMu.Unlock()
+	case boil.AfterUpdateHook:
+		youtubeTVPairingTokenAfterUpdateMu.Lock()
+		youtubeTVPairingTokenAfterUpdateHooks = append(youtubeTVPairingTokenAfterUpdateHooks, youtubeTVPairingTokenHook)
+		youtubeTVPairingTokenAfterUpdateMu.Unlock()
+	case boil.BeforeDeleteHook:
+		youtubeTVPairingTokenBeforeDeleteMu.Lock()
+		youtubeTVPairingTokenBeforeDeleteHooks = append(youtubeTVPairingTokenBeforeDeleteHooks, youtubeTVPairingTokenHook)
+		youtubeTVPairingTokenBeforeDeleteMu.Unlock()
+	case boil.AfterDeleteHook:
+		youtubeTVPairingTokenAfterDeleteMu.Lock()
+		youtubeTVPairingTokenAfterDeleteHooks = append(youtubeTVPairingTokenAfterDeleteHooks, youtubeTVPairingTokenHook)
+		youtubeTVPairingTokenAfterDeleteMu.Unlock()
+	case boil.BeforeUpsertHook:
+		youtubeTVPairingTokenBeforeUpsertMu.Lock()
+		youtubeTVPairingTokenBeforeUpsertHooks = append(youtubeTVPairingTokenBeforeUpsertHooks, youtubeTVPairingTokenHook)
+		youtubeTVPairingTokenBeforeUpsertMu.Unlock()
+	case boil.AfterUpsertHook:
+		youtubeTVPairingTokenAfterUpsertMu.Lock()
+		youtubeTVPairingTokenAfterUpsertHooks = append(youtubeTVPairingTokenAfterUpsertHooks, youtubeTVPairingTokenHook)
+		youtubeTVPairingTokenAfterUpsertMu.Unlock()
+	}
+}
+
+// One returns a single youtubeTVPairingToken record from the query.
+func (q youtubeTVPairingTokenQuery) One(ctx context.Context, exec boil.ContextExecutor) (*YoutubeTVPairingToken, error) {
+	o := &YoutubeTVPairingToken{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: failed to execute a one query for youtube_tv_pairing_tokens")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all YoutubeTVPairingToken records from the query.
+func (q youtubeTVPairingTokenQuery) All(ctx context.Context, exec boil.ContextExecutor) (YoutubeTVPairingTokenSlice, error) {
+	var o []*YoutubeTVPairingToken
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "models: failed to assign all query results to YoutubeTVPairingToken slice")
+	}
+
+	if len(youtubeTVPairingTokenAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all YoutubeTVPairingToken records in the query.
+func (q youtubeTVPairingTokenQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to count youtube_tv_pairing_tokens rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q youtubeTVPairingTokenQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "models: failed to check if youtube_tv_pairing_tokens exists")
+	}
+
+	return count > 0, nil
+}
+
+// User pointed to by the foreign key.
+func (o *YoutubeTVPairingToken) User(mods ...qm.QueryMod) userQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.UserID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Users(queryMods...)
+}
+
+// LoadUser allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (youtubeTVPairingTokenL) LoadUser(ctx context.Context, e boil.ContextExecutor, singular bool, maybeYoutubeTVPairingToken any, mods queries.Applicator) error {
+	var slice []*YoutubeTVPairingToken
+	var object *YoutubeTVPairingToken
+
+	if singular {
+		var ok bool
+		object, ok = maybeYoutubeTVPairingToken.(*YoutubeTVPairingToken)
+		if !ok {
+			object = new(YoutubeTVPairingToken)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeYoutubeTVPairingToken)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeYoutubeTVPairingToken))
+			}
+		}
+	} else {
+		s, ok := maybeYoutubeTVPairingToken.(*[]*YoutubeTVPairingToken)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeYoutubeTVPairingToken)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeYoutubeTVPairingToken))
+			}
+		}
+	}
+
+	args := make(map[any]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &youtubeTVPairingTokenR{}
+		}
+		args[object.UserID] = struct{}{}
+
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &youtubeTVPairingTokenR{}
+			}
+
+			args[obj.UserID] = struct{}{}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]any, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`users`),
+		qm.WhereIn(`users.id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load User")
+	}
+
+	var resultSlice []*User
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice User")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for users")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for users")
+	}
+
+	if len(userAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.User = foreign
+		if foreign.R == nil {
+			foreign.R = &userR{}
+		}
+		foreign.R.YoutubeTVPairingTokens = append(foreign.R.YoutubeTVPairingTokens, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.UserID == foreign.ID {
+				local.R.User = foreign
+				if foreign.R == nil {
+					foreign.R = &userR{}
+				}
+				foreign.R.YoutubeTVPairingTokens = append(foreign.R.YoutubeTVPairingTokens, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetUser of the youtubeTVPairingToken to the related item.
+// Sets o.R.User to related.
+// Adds o to related.R.YoutubeTVPairingTokens.
+func (o *YoutubeTVPairingToken) SetUser(ctx context.Context, exec boil.ContextExecutor, insert bool, related *User) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"youtube_tv_pairing_tokens\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 0, []string{"user_id"}),
+		strmangle.WhereClause("\"", "\"", 0, youtubeTVPairingTokenPrimaryKeyColumns),
+	)
+	values := []any{related.ID, o.TokenHash}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.UserID = related.ID
+	if o.R == nil {
+		o.R = &youtubeTVPairingTokenR{
+			User: related,
+		}
+	} else {
+		o.R.User = related
+	}
+
+	if related.R == nil {
+		related.R = &userR{
+			YoutubeTVPairingTokens: YoutubeTVPairingTokenSlice{o},
+		}
+	} else {
+		related.R.YoutubeTVPairingTokens = append(related.R.YoutubeTVPairingTokens, o)
+	}
+
+	return nil
+}
+
+// YoutubeTVPairingTokens retrieves all the records using an executor.
+func YoutubeTVPairingTokens(mods ...qm.QueryMod) youtubeTVPairingTokenQuery {
+	mods = append(mods, qm.From("\"youtube_tv_pairing_tokens\""))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"\"youtube_tv_pairing_tokens\".*"})
+	}
+
+	return youtubeTVPairingTokenQuery{q}
+}
+
+// FindYoutubeTVPairingToken retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindYoutubeTVPairingToken(ctx context.Context, exec boil.ContextExecutor, tokenHash string, selectCols ...string) (*YoutubeTVPairingToken, error) {
+	youtubeTVPairingTokenObj := &YoutubeTVPairingToken{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"youtube_tv_pairing_tokens\" where \"token_hash\"=?", sel,
+	)
+
+	q := queries.Raw(query, tokenHash)
+
+	err := q.Bind(ctx, exec, youtubeTVPairingTokenObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: unable to select from youtube_tv_pairing_tokens")
+	}
+
+	if err = youtubeTVPairingTokenObj.doAfterSelectHooks(ctx, exec); err != nil {
+		return youtubeTVPairingTokenObj, err
+	}
+
+	return youtubeTVPairingTokenObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *YoutubeTVPairingToken) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no youtube_tv_pairing_tokens provided for insertion")
+	}
+
+	var err error
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+	}
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(youtubeTVPairingTokenColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	youtubeTVPairingTokenInsertCacheMut.RLock()
+	cache, cached := youtubeTVPairingTokenInsertCache[key]
+	youtubeTVPairingTokenInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			youtubeTVPairingTokenAllColumns,
+			youtubeTVPairingTokenColumnsWithDefault,
+			youtubeTVPairingTokenColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(youtubeTVPairingTokenType, youtubeTVPairingTokenMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(youtubeTVPairingTokenType, youtubeTVPairingTokenMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"youtube_tv_pairing_tokens\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"youtube_tv_pairing_tokens\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "models: unable to insert into youtube_tv_pairing_tokens")
+	}
+
+	if !cached {
+		youtubeTVPairingTokenInsertCacheMut.Lock()
+		youtubeTVPairingTokenInsertCache[key] = cache
+		youtubeTVPairingTokenInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// Update uses an executor to update the YoutubeTVPairingToken.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *YoutubeTVPairingToken) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	youtubeTVPairingTokenUpdateCacheMut.RLock()
+	cache, cached := youtubeTVPairingTokenUpdateCache[key]
+	youtubeTVPairingTokenUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			youtubeTVPairingTokenAllColumns,
+			youtubeTVPairingTokenPrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("models: unable to update youtube_tv_pairing_tokens, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"youtube_tv_pairing_tokens\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 0, wl),
+			strmangle.WhereClause("\"", "\"", 0, youtubeTVPairingTokenPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(youtubeTVPairingTokenType, youtubeTVPairingTokenMapping, append(wl, youtubeTVPairingTokenPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update youtube_tv_pairing_tokens row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by update for youtube_tv_pairing_tokens")
+	}
+
+	if !cached {
+		youtubeTVPairingTokenUpdateCacheMut.Lock()
+		youtubeTVPairingTokenUpdateCache[key] = cache
+		youtubeTVPairingTokenUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q youtubeTVPairingTokenQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all for youtube_tv_pairing_tokens")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected for youtube_tv_pairing_tokens")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o YoutubeTVPairingTokenSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("models: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]any, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), youtubeTVPairingTokenPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"youtube_tv_pairing_tokens\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 0, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, youtubeTVPairingTokenPrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all in youtubeTVPairingToken slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected all in update all youtubeTVPairingToken")
+	}
+	return rowsAff, nil
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *YoutubeTVPairingToken) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no youtube_tv_pairing_tokens provided for upsert")
+	}
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(youtubeTVPairingTokenColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	youtubeTVPairingTokenUpsertCacheMut.RLock()
+	cache, cached := youtubeTVPairingTokenUpsertCache[key]
+	youtubeTVPairingTokenUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, _ := insertColumns.InsertColumnSet(
+			youtubeTVPairingTokenAllColumns,
+			youtubeTVPairingTokenColumnsWithDefault,
+			youtubeTVPairingTokenColumnsWithoutDefault,
+			nzDefaults,
+		)
+		update := updateColumns.UpdateColumnSet(
+			youtubeTVPairingTokenAllColumns,
+			youtubeTVPairingTokenPrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("models: unable to upsert youtube_tv_pairing_tokens, could not build update column list")
+		}
+
+		ret := strmangle.SetComplement(youtubeTVPairingTokenAllColumns, strmangle.SetIntersect(insert, update))
+
+		conflict := conflictColumns
+		if len(conflict) == 0 {
+			conflict = make([]string, len(youtubeTVPairingTokenPrimaryKeyColumns))
+			copy(conflict, youtubeTVPairingTokenPrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQuerySQLite(dialect, "\"youtube_tv_pairing_tokens\"", updateOnConflict, ret, update, conflict, insert)
+
+		cache.valueMapping, err = queries.BindMapping(youtubeTVPairingTokenType, youtubeTVPairingTokenMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(youtubeTVPairingTokenType, youtubeTVPairingTokenMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []any
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if errors.Is(err, sql.ErrNoRows) {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "models: unable to upsert youtube_tv_pairing_tokens")
+	}
+
+	if !cached {
+		youtubeTVPairingTokenUpsertCacheMut.Lock()
+		youtubeTVPairingTokenUpsertCache[key] = cache
+		youtubeTVPairingTokenUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// Delete deletes a single YoutubeTVPairingToken record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *YoutubeTVPairingToken) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("models: no YoutubeTVPairingToken provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), youtubeTVPairingTokenPrimaryKeyMapping)
+	sql := "DELETE FROM \"youtube_tv_pairing_tokens\" WHERE \"token_hash\"=?"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete from youtube_tv_pairing_tokens")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by delete for youtube_tv_pairing_tokens")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q youtubeTVPairingTokenQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("models: no youtubeTVPairingTokenQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from youtube_tv_pairing_tokens")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for youtube_tv_pairing_tokens")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o YoutubeTVPairingTokenSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(youtubeTVPairingTokenBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []any
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), youtubeTVPairingTokenPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"youtube_tv_pairing_tokens\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, youtubeTVPairingTokenPrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from youtubeTVPairingToken slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for youtube_tv_pairing_tokens")
+	}
+
+	if len(youtubeTVPairingTokenAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *YoutubeTVPairingToken) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindYoutubeTVPairingToken(ctx, exec, o.TokenHash)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *YoutubeTVPairingTokenSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := YoutubeTVPairingTokenSlice{}
+	var args []any
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), youtubeTVPairingTokenPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"youtube_tv_pairing_tokens\".* FROM \"youtube_tv_pairing_tokens\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, youtubeTVPairingTokenPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to reload all in YoutubeTVPairingTokenSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// YoutubeTVPairingTokenExists checks if the YoutubeTVPairingToken row exists.
+func YoutubeTVPairingTokenExists(ctx context.Context, exec boil.ContextExecutor, tokenHash string) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"youtube_tv_pairing_tokens\" where \"token_hash\"=? limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, tokenHash)
+	}
+	row := exec.QueryRowContext(ctx, sql, tokenHash)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "models: unable to check if youtube_tv_pairing_tokens exists")
+	}
+
+	return exists, nil
+}
+
+// Exists checks if the YoutubeTVPairingToken row exists.
+func (o *YoutubeTVPairingToken) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	return YoutubeTVPairingTokenExists(ctx, exec, o.TokenHash)
+}
@@ -0,0 +1,850 @@
+// Code generated by SQLBoiler 4.19.7 (https://github.com/aarondl/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"bytes"
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/aarondl/randomize"
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries"
+	"github.com/aarondl/strmangle"
+)
+
+var (
+	// Relationships sometimes use the reflection helper queries.Equal/queries.Assign
+	// so force a package dependency in case they don't.
+	_ = queries.Equal
+)
+
+func testYoutubeTVPairingTokens(t *testing.T) {
+	t.Parallel()
+
+	query := YoutubeTVPairingTokens()
+
+	if query.Query == nil {
+		t.Error("expected a query, got nothing")
+	}
+}
+
+func testYoutubeTVPairingTokensDelete(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &YoutubeTVPairingToken{}
+	if err = randomize.Struct(seed, o, youtubeTVPairingTokenDBTypes, true, youtubeTVPairingTokenColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize YoutubeTVPairingToken struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := o.Delete(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := YoutubeTVPairingTokens().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testYoutubeTVPairingTokensQueryDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &YoutubeTVPairingToken{}
+	if err = randomize.Struct(seed, o, youtubeTVPairingTokenDBTypes, true, youtubeTVPairingTokenColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize YoutubeTVPairingToken struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := YoutubeTVPairingTokens().DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := YoutubeTVPairingTokens().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testYoutubeTVPairingTokensSliceDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &YoutubeTVPairingToken{}
+	if err = randomize.Struct(seed, o, youtubeTVPairingTokenDBTypes, true, youtubeTVPairingTokenColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize YoutubeTVPairingToken struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := YoutubeTVPairingTokenSlice{o}
+
+	if rowsAff, err := slice.DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := YoutubeTVPairingTokens().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testYoutubeTVPairingTokensExists(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &YoutubeTVPairingToken{}
+	if err = randomize.Struct(seed, o, youtubeTVPairingTokenDBTypes, true, youtubeTVPairingTokenColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize YoutubeTVPairingToken struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	e, err := YoutubeTVPairingTokenExists(ctx, tx, o.TokenHash)
+	if err != nil {
+		t.Errorf("Unable to check if YoutubeTVPairingToken exists: %s", err)
+	}
+	if !e {
+		t.Errorf("Expected YoutubeTVPairingTokenExists to return true, but got false.")
+	}
+}
+
+func testYoutubeTVPairingTokensFind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &YoutubeTVPairingToken{}
+	if err = randomize.Struct(seed, o, youtubeTVPairingTokenDBTypes, true, youtubeTVPairingTokenColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize YoutubeTVPairingToken struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	youtubeTVPairingTokenFound, err := FindYoutubeTVPairingToken(ctx, tx, o.TokenHash)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if youtubeTVPairingTokenFound == nil {
+		t.Error("want a record, got nil")
+	}
+}
+
+func testYoutubeTVPairingTokensBind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &YoutubeTVPairingToken{}
+	if err = randomize.Struct(seed, o, youtubeTVPairingTokenDBTypes, true, youtubeTVPairingTokenColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize YoutubeTVPairingToken struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = YoutubeTVPairingTokens().Bind(ctx, tx, o); err != nil {
+		t.Error(err)
+	}
+}
+
+func testYoutubeTVPairingTokensOne(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &YoutubeTVPairingToken{}
+	if err = randomize.Struct(seed, o, youtubeTVPairingTokenDBTypes, true, youtubeTVPairingTokenColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize YoutubeTVPairingToken struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if x, err := YoutubeTVPairingTokens().One(ctx, tx); err != nil {
+		t.Error(err)
+	} else if x == nil {
+		t.Error("expected to get a non nil record")
+	}
+}
+
+func testYoutubeTVPairingTokensAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	youtubeTVPairingTokenOne := &YoutubeTVPairingToken{}
+	youtubeTVPairingTokenTwo := &YoutubeTVPairingToken{}
+	if err = randomize.Struct(seed, youtubeTVPairingTokenOne, youtubeTVPairingTokenDBTypes, false, youtubeTVPairingTokenColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize YoutubeTVPairingToken struct: %s", err)
+	}
+	if err = randomize.Struct(seed, youtubeTVPairingTokenTwo, youtubeTVPairingTokenDBTypes, false, youtubeTVPairingTokenColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize YoutubeTVPairingToken struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = youtubeTVPairingTokenOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = youtubeTVPairingTokenTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := YoutubeTVPairingTokens().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 2 {
+		t.Error("want 2 records, got:", len(slice))
+	}
+}
+
+func testYoutubeTVPairingTokensCount(t *testing.T) {
+	t.Parallel()
+
+	var err error
+	seed := randomize.NewSeed()
+	youtubeTVPairingTokenOne := &YoutubeTVPairingToken{}
+	youtubeTVPairingTokenTwo := &YoutubeTVPairingToken{}
+	if err = randomize.Struct(seed, youtubeTVPairingTokenOne, youtubeTVPairingTokenDBTypes, false, youtubeTVPairingTokenColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize YoutubeTVPairingToken struct: %s", err)
+	}
+	if err = randomize.Struct(seed, youtubeTVPairingTokenTwo, youtubeTVPairingTokenDBTypes, false, youtubeTVPairingTokenColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize YoutubeTVPairingToken struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = youtubeTVPairingTokenOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = youtubeTVPairingTokenTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := YoutubeTVPairingTokens().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 2 {
+		t.Error("want 2 records, got:", count)
+	}
+}
+
+func youtubeTVPairingTokenBeforeInsertHook(ctx context.Context, e boil.ContextExecutor, o *YoutubeTVPairingToken) error {
+	*o = YoutubeTVPairingToken{}
+	return nil
+}
+
+func youtubeTVPairingTokenAfterInsertHook(ctx context.Context, e boil.ContextExecutor, o *YoutubeTVPairingToken) error {
+	*o = YoutubeTVPairingToken{}
+	return nil
+}
+
+func youtubeTVPairingTokenAfterSelectHook(ctx context.Context, e boil.ContextExecutor, o *YoutubeTVPairingToken) error {
+	*o = YoutubeTVPairingToken{}
+	return nil
+}
+
+func youtubeTVPairingTokenBeforeUpdateHook(ctx context.Context, e boil.ContextExecutor, o *YoutubeTVPairingToken) error {
+	*o = YoutubeTVPairingToken{}
+	return nil
+}
+
+func youtubeTVPairingTokenAfterUpdateHook(ctx context.Context, e boil.ContextExecutor, o *YoutubeTVPairingToken) error {
+	*o = YoutubeTVPairingToken{}
+	return nil
+}
+
+func youtubeTVPairingTokenBeforeDeleteHook(ctx context.Context, e boil.ContextExecutor, o *YoutubeTVPairingToken) error {
+	*o = YoutubeTVPairingToken{}
+	return nil
+}
+
+func youtubeTVPairingTokenAfterDeleteHook(ctx context.Context, e boil.ContextExecutor, o *YoutubeTVPairingToken) error {
+	*o = YoutubeTVPairingToken{}
+	return nil
+}
+
+func youtubeTVPairingTokenBeforeUpsertHook(ctx context.Context, e boil.ContextExecutor, o *YoutubeTVPairingToken) error {
+	*o = YoutubeTVPairingToken{}
+	return nil
+}
+
+func youtubeTVPairingTokenAfterUpsertHook(ctx context.Context, e boil.ContextExecutor, o *YoutubeTVPairingToken) error {
+	*o = YoutubeTVPairingToken{}
+	return nil
+}
+
+func testYoutubeTVPairingTokensHooks(t *testing.T) {
+	t.Parallel()
+
+	var err error
+
+	ctx := context.Background()
+	empty := &YoutubeTVPairingToken{}
+	o := &YoutubeTVPairingToken{}
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, o, youtubeTVPairingTokenDBTypes, false); err != nil {
+		t.Errorf("Unable to randomize YoutubeTVPairingToken object: %s", err)
+	}
+
+	AddYoutubeTVPairingTokenHook(boil.BeforeInsertHook, youtubeTVPairingTokenBeforeInsertHook)
+	if err = o.doBeforeInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeInsertHook function to empty object, but got: %#v", o)
+	}
+	youtubeTVPairingTokenBeforeInsertHooks = []YoutubeTVPairingTokenHook{}
+
+	AddYoutubeTVPairingTokenHook(boil.AfterInsertHook, youtubeTVPairingTokenAfterInsertHook)
+	if err = o.doAfterInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterInsertHook function to empty object, but got: %#v", o)
+	}
+	youtubeTVPairingTokenAfterInsertHooks = []YoutubeTVPairingTokenHook{}
+
+	AddYoutubeTVPairingTokenHook(boil.AfterSelectHook, youtubeTVPairingTokenAfterSelectHook)
+	if err = o.doAfterSelectHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterSelectHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterSelectHook function to empty object, but got: %#v", o)
+	}
+	youtubeTVPairingTokenAfterSelectHooks = []YoutubeTVPairingTokenHook{}
+
+	AddYoutubeTVPairingTokenHook(boil.BeforeUpdateHook, youtubeTVPairingTokenBeforeUpdateHook)
+	if err = o.doBeforeUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpdateHook function to empty object, but got: %#v", o)
+	}
+	youtubeTVPairingTokenBeforeUpdateHooks = []YoutubeTVPairingTokenHook{}
+
+	AddYoutubeTVPairingTokenHook(boil.AfterUpdateHook, youtubeTVPairingTokenAfterUpdateHook)
+	if err = o.doAfterUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpdateHook function to empty object, but got: %#v", o)
+	}
+	youtubeTVPairingTokenAfterUpdateHooks = []YoutubeTVPairingTokenHook{}
+
+	AddYoutubeTVPairingTokenHook(boil.BeforeDeleteHook, youtubeTVPairingTokenBeforeDeleteHook)
+	if err = o.doBeforeDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeDeleteHook function to empty object, but got: %#v", o)
+	}
+	youtubeTVPairingTokenBeforeDeleteHooks = []YoutubeTVPairingTokenHook{}
+
+	AddYoutubeTVPairingTokenHook(boil.AfterDeleteHook, youtubeTVPairingTokenAfterDeleteHook)
+	if err = o.doAfterDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterDeleteHook function to empty object, but got: %#v", o)
+	}
+	youtubeTVPairingTokenAfterDeleteHooks = []YoutubeTVPairingTokenHook{}
+
+	AddYoutubeTVPairingTokenHook(boil.BeforeUpsertHook, youtubeTVPairingTokenBeforeUpsertHook)
+	if err = o.doBeforeUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpsertHook function to empty object, but got: %#v", o)
+	}
+	youtubeTVPairingTokenBeforeUpsertHooks = []YoutubeTVPairingTokenHook{}
+
+	AddYoutubeTVPairingTokenHook(boil.AfterUpsertHook, youtubeTVPairingTokenAfterUpsertHook)
+	if err = o.doAfterUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpsertHook function to empty object, but got: %#v", o)
+	}
+	youtubeTVPairingTokenAfterUpsertHooks = []YoutubeTVPairingTokenHook{}
+}
+
+func testYoutubeTVPairingTokensInsert(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &YoutubeTVPairingToken{}
+	if err = randomize.Struct(seed, o, youtubeTVPairingTokenDBTypes, true, youtubeTVPairingTokenColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize YoutubeTVPairingToken struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := YoutubeTVPairingTokens().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testYoutubeTVPairingTokensInsertWhitelist(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &YoutubeTVPairingToken{}
+	if err = randomize.Struct(seed, o, youtubeTVPairingTokenDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize YoutubeTVPairingToken struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Whitelist(strmangle.SetMerge(youtubeTVPairingTokenPrimaryKeyColumns, youtubeTVPairingTokenColumnsWithoutDefault)...)); err != nil {
+		t.Error(err)
+	}
+
+	count, err := YoutubeTVPairingTokens().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testYoutubeTVPairingTokenToOneUserUsingUser(t *testing.T) {
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var local YoutubeTVPairingToken
+	var foreign User
+
+	seed := randomize.NewSeed()
+	if err := randomize.Struct(seed, &local, youtubeTVPairingTokenDBTypes, false, youtubeTVPairingTokenColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize YoutubeTVPairingToken struct: %s", err)
+	}
+	if err := randomize.Struct(seed, &foreign, userDBTypes, false, userColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize User struct: %s", err)
+	}
+
+	if err := foreign.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	local.UserID = foreign.ID
+	if err := local.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := local.User().One(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if check.ID != foreign.ID {
+		t.Errorf("want: %v, got %v", foreign.ID, check.ID)
+	}
+
+	ranAfterSelectHook := false
+	AddUserHook(boil.AfterSelectHook, func(ctx context.Context, e boil.ContextExecutor, o *User) error {
+		ranAfterSelectHook = true
+		return nil
+	})
+
+	slice := YoutubeTVPairingTokenSlice{&local}
+	if err = local.L.LoadUser(ctx, tx, false, (*[]*YoutubeTVPairingToken)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.User == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	local.R.User = nil
+	if err = local.L.LoadUser(ctx, tx, true, &local, nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.User == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	if !ranAfterSelectHook {
+		t.Error("failed to run AfterSelect hook for relationship")
+	}
+}
+
+func testYoutubeTVPairingTokenToOneSetOpUserUsingUser(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a YoutubeTVPairingToken
+	var b, c User
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, youtubeTVPairingTokenDBTypes, false, strmangle.SetComplement(youtubeTVPairingTokenPrimaryKeyColumns, youtubeTVPairingTokenColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, userDBTypes, false, strmangle.SetComplement(userPrimaryKeyColumns, userColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, userDBTypes, false, strmangle.SetComplement(userPrimaryKeyColumns, userColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	for i, x := range []*User{&b, &c} {
+		err = a.SetUser(ctx, tx, i != 0, x)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if a.R.User != x {
+			t.Error("relationship struct not set to correct value")
+		}
+
+		if x.R.YoutubeTVPairingTokens[0] != &a {
+			t.Error("failed to append to foreign relationship struct")
+		}
+		if a.UserID != x.ID {
+			t.Error("foreign key was wrong value", a.UserID)
+		}
+
+		zero := reflect.Zero(reflect.TypeOf(a.UserID))
+		reflect.Indirect(reflect.ValueOf(&a.UserID)).Set(zero)
+
+		if err = a.Reload(ctx, tx); err != nil {
+			t.Fatal("failed to reload", err)
+		}
+
+		if a.UserID != x.ID {
+			t.Error("foreign key was wrong value", a.UserID, x.ID)
+		}
+	}
+}
+
+func testYoutubeTVPairingTokensReload(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &YoutubeTVPairingToken{}
+	if err = randomize.Struct(seed, o, youtubeTVPairingTokenDBTypes, true, youtubeTVPairingTokenColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize YoutubeTVPairingToken struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = o.Reload(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testYoutubeTVPairingTokensReloadAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &YoutubeTVPairingToken{}
+	if err = randomize.Struct(seed, o, youtubeTVPairingTokenDBTypes, true, youtubeTVPairingTokenColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize YoutubeTVPairingToken struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := YoutubeTVPairingTokenSlice{o}
+
+	if err = slice.ReloadAll(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testYoutubeTVPairingTokensSelect(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &YoutubeTVPairingToken{}
+	if err = randomize.Struct(seed, o, youtubeTVPairingTokenDBTypes, true, youtubeTVPairingTokenColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize YoutubeTVPairingToken struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := YoutubeTVPairingTokens().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 1 {
+		t.Error("want one record, got:", len(slice))
+	}
+}
+
+var (
+	youtubeTVPairingTokenDBTypes = map[string]string{`TokenHash`: `TEXT`, `CreatedAt`: `DATE`, `ExpiresAt`: `DATE`, `UserID`: `TEXT`}
+	_                            = bytes.MinRead
+)
+
+func testYoutubeTVPairingTokensUpdate(t *testing.T) {
+	t.Parallel()
+
+	if 0 == len(youtubeTVPairingTokenPrimaryKeyColumns) {
+		t.Skip("Skipping table with no primary key columns")
+	}
+	if len(youtubeTVPairingTokenAllColumns) == len(youtubeTVPairingTokenPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &YoutubeTVPairingToken{}
+	if err = randomize.Struct(seed, o, youtubeTVPairingTokenDBTypes, true, youtubeTVPairingTokenColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize YoutubeTVPairingToken struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := YoutubeTVPairingTokens().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, youtubeTVPairingTokenDBTypes, true, youtubeTVPairingTokenPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize YoutubeTVPairingToken struct: %s", err)
+	}
+
+	if rowsAff, err := o.Update(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only affect one row but affected", rowsAff)
+	}
+}
+
+func testYoutubeTVPairingTokensSliceUpdateAll(t *testing.T) {
+	t.Parallel()
+
+	if len(youtubeTVPairingTokenAllColumns) == len(youtubeTVPairingTokenPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &YoutubeTVPairingToken{}
+	if err = randomize.Struct(seed, o, youtubeTVPairingTokenDBTypes, true, youtubeTVPairingTokenColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize YoutubeTVPairingToken struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := YoutubeTVPairingTokens().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, youtubeTVPairingTokenDBTypes, true, youtubeTVPairingTokenPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize YoutubeTVPairingToken struct: %s", err)
+	}
+
+	// Remove Primary keys and unique columns from what we plan to update
+	var fields []string
+	if strmangle.StringSliceMatch(youtubeTVPairingTokenAllColumns, youtubeTVPairingTokenPrimaryKeyColumns) {
+		fields = youtubeTVPairingTokenAllColumns
+	} else {
+		fields = strmangle.SetComplement(
+			youtubeTVPairingTokenAllColumns,
+			youtubeTVPairingTokenPrimaryKeyColumns,
+		)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	typ := reflect.TypeOf(o).Elem()
+	n := typ.NumField()
+
+	updateMap := M{}
+	for _, col := range fields {
+		for i := 0; i < n; i++ {
+			f := typ.Field(i)
+			if f.Tag.Get("boil") == col {
+				updateMap[col] = value.Field(i).Interface()
+			}
+		}
+	}
+
+	slice := YoutubeTVPairingTokenSlice{o}
+	if rowsAff, err := slice.UpdateAll(ctx, tx, updateMap); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("wanted one record updated but got", rowsAff)
+	}
+}
+
+func testYoutubeTVPairingTokensUpsert(t *testing.T) {
+	t.Parallel()
+	if len(youtubeTVPairingTokenAllColumns) == len(youtubeTVPairingTokenPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	// Attempt the INSERT side of an UPSERT
+	o := YoutubeTVPairingToken{}
+	if err = randomize.Struct(seed, &o, youtubeTVPairingTokenDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize YoutubeTVPairingToken struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Upsert(ctx, tx, false, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert YoutubeTVPairingToken: %s", err)
+	}
+
+	count, err := YoutubeTVPairingTokens().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	// Attempt the UPDATE side of an UPSERT
+	if err = randomize.Struct(seed, &o, youtubeTVPairingTokenDBTypes, false, youtubeTVPairingTokenPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize YoutubeTVPairingToken struct: %s", err)
+	}
+
+	if err = o.Upsert(ctx, tx, true, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert YoutubeTVPairingToken: %s", err)
+	}
+
+	count, err = YoutubeTVPairingTokens().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
@@ -41,6 +41,8 @@ type YoutubeTVSyncAccount struct {
 	LastUserActivityAt null.Time   `boil:"last_user_activity_at" json:"last_user_activity_at,omitempty" toml:"last_user_activity_at" yaml:"last_user_activity_at,omitempty"`
 	LastVideoID        null.String `boil:"last_video_id" json:"last_video_id,omitempty" toml:"last_video_id" yaml:"last_video_id,omitempty"`
 	LastError          string      `boil:"last_error" json:"last_error" toml:"last_error" yaml:"last_error"`
+	DisplayName        string      `boil:"display_name" json:"display_name" toml:"display_name" yaml:"display_name"`
+	SponsorblockMode   string      `boil:"sponsorblock_mode" json:"sponsorblock_mode" toml:"sponsorblock_mode" yaml:"sponsorblock_mode"`
 
 	R *youtubeTVSyncAccountR `boil:"-" json:"-" toml:"-" yaml:"-"`
 	L youtubeTVSyncAccountL  `boil:"-" json:"-" toml:"-" yaml:"-"`
@@ -64,6 +66,8 @@ var YoutubeTVSyncAccountColumns = struct {
 	LastUserActivityAt string
 	LastVideoID        string
 	LastError          string
+	DisplayName        string
+	SponsorblockMode   string
 }{
 	ID:                 "id",
 	CreatedAt:          "created_at",
@@ -82,6 +86,8 @@ var YoutubeTVSyncAccountColumns = struct {
 	LastUserActivityAt: "last_user_activity_at",
 	LastVideoID:        "last_video_id",
 	LastError:          "last_error",
+	DisplayName:        "display_name",
+	SponsorblockMode:   "sponsorblock_mode",
 }
 
 var YoutubeTVSyncAccountTableColumns = struct {
@@ -102,6 +108,8 @@ var YoutubeTVSyncAccountTableColumns = struct {
 	LastUserActivityAt string
 	LastVideoID        string
 	LastError          string
+	DisplayName        string
+	SponsorblockMode   string
 }{
 	ID:                 "youtube_tv_sync_accounts.id",
 	CreatedAt:          "youtube_tv_sync_accounts.created_at",
@@ -120,6 +128,8 @@ var YoutubeTVSyncAccountTableColumns = struct {
 	LastUserActivityAt: "youtube_tv_sync_accounts.last_user_activity_at",
 	LastVideoID:        "youtube_tv_sync_accounts.last_video_id",
 	LastError:          "youtube_tv_sync_accounts.last_error",
+	DisplayName:        "youtube_tv_sync_accounts.display_name",
+	SponsorblockMode:   "youtube_tv_sync_accounts.sponsorblock_mode",
 }
 
 // Generated where
@@ -142,6 +152,8 @@ var YoutubeTVSyncAccountWhere = struct {
 	LastUserActivityAt whereHelpernull_Time
 	LastVideoID        whereHelpernull_String
 	LastError          whereHelperstring
+	DisplayName        whereHelperstring
+	SponsorblockMode   whereHelperstring
 }{
 	ID:                 whereHelperstring{field: "\"youtube_tv_sync_accounts\".\"id\""},
 	CreatedAt:          whereHelpertime_Time{field: "\"youtube_tv_sync_accounts\".\"created_at\""},
@@ -160,18 +172,23 @@ var YoutubeTVSyncAccountWhere = struct {
 	LastUserActivityAt: whereHelpernull_Time{field: "\"youtube_tv_sync_accounts\".\"last_user_activity_at\""},
 	LastVideoID:        whereHelpernull_String{field: "\"youtube_tv_sync_accounts\".\"last_video_id\""},
 	LastError:          whereHelperstring{field: "\"youtube_tv_sync_accounts\".\"last_error\""},
+	DisplayName:        whereHelperstring{field: "\"youtube_tv_sync_accounts\".\"display_name\""},
+	SponsorblockMode:   whereHelperstring{field: "\"youtube_tv_sync_accounts\".\"sponsorblock_mode\""},
 }
 
 // YoutubeTVSyncAccountRels is where relationship names are stored.
 var YoutubeTVSyncAccountRels = struct {
-	User string
+	User                       string
+	AccountYoutubeTVSyncEvents string
 }{
-	User: "User",
+	User:                       "User",
+	AccountYoutubeTVSyncEvents: "AccountYoutubeTVSyncEvents",
 }
 
 // youtubeTVSyncAccountR is where relationships are stored.
 type youtubeTVSyncAccountR struct {
-	User *User `boil:"User" json:"User" toml:"User" yaml:"User"`
+	User                       *User                   `boil:"User" json:"User" toml:"User" yaml:"User"`
+	AccountYoutubeTVSyncEvents YoutubeTVSyncEventSlice `boil:"AccountYoutubeTVSyncEvents" json:"AccountYoutubeTVSyncEvents" toml:"AccountYoutubeTVSyncEvents" yaml:"AccountYoutubeTVSyncEvents"`
 }
 
 // NewStruct creates a new relationship struct
@@ -195,13 +212,29 @@ func (r *youtubeTVSyncAccountR) GetUser() *User {
 	return r.User
 }
 
+func (o *YoutubeTVSyncAccount) GetAccountYoutubeTVSyncEvents() YoutubeTVSyncEventSlice {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetAccountYoutubeTVSyncEvents()
+}
+
+func (r *youtubeTVSyncAccountR) GetAccountYoutubeTVSyncEvents() YoutubeTVSyncEventSlice {
+	if r == nil {
+		return nil
+	}
+
+	return r.AccountYoutubeTVSyncEvents
+}
+
 // youtubeTVSyncAccountL is where Load methods for each relationship are stored.
 type youtubeTVSyncAccountL struct{}
 
 var (
-	youtubeTVSyncAccountAllColumns            = []string{"id", "created_at", "updated_at", "user_id", "screen_id", "screen_name", "lounge_token_enc", "enc_secret_hash", "sync_enabled", "connection_state", "state_reason", "last_connected_at", "last_event_at", "last_disconnect_at", "last_user_activity_at", "last_video_id", "last_error"}
+	youtubeTVSyncAccountAllColumns            = []string{"id", "created_at", "updated_at", "user_id", "screen_id", "screen_name", "lounge_token_enc", "enc_secret_hash", "sync_enabled", "connection_state", "state_reason", "last_connected_at", "last_event_at", "last_disconnect_at", "last_user_activity_at", "last_video_id", "last_error", "display_name", "sponsorblock_mode"}
 	youtubeTVSyncAccountColumnsWithoutDefault = []string{"id", "created_at", "updated_at", "user_id", "screen_id", "lounge_token_enc", "enc_secret_hash"}
-	youtubeTVSyncAccountColumnsWithDefault    = []string{"screen_name", "sync_enabled", "connection_state", "state_reason", "last_connected_at", "last_event_at", "last_disconnect_at", "last_user_activity_at", "last_video_id", "last_error"}
+	youtubeTVSyncAccountColumnsWithDefault    = []string{"screen_name", "sync_enabled", "connection_state", "state_reason", "last_connected_at", "last_event_at", "last_disconnect_at", "last_user_activity_at", "last_video_id", "last_error", "display_name", "sponsorblock_mode"}
 	youtubeTVSyncAccountPrimaryKeyColumns     = []string{"id"}
 	youtubeTVSyncAccountGeneratedColumns      = []string{}
 )
@@ -522,6 +555,20 @@ func (o *YoutubeTVSyncAccount) User(mods ...qm.QueryMod) userQuery {
 	return Users(queryMods...)
 }
 
+// AccountYoutubeTVSyncEvents retrieves all the youtube_tv_sync_event's YoutubeTVSyncEvents with an executor via account_id column.
+func (o *YoutubeTVSyncAccount) AccountYoutubeTVSyncEvents(mods ...qm.QueryMod) youtubeTVSyncEventQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"youtube_tv_sync_events\".\"account_id\"=?", o.ID),
+	)
+
+	return YoutubeTVSyncEvents(queryMods...)
+}
+
 // LoadUser allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for an N-1 relationship.
 func (youtubeTVSyncAccountL) LoadUser(ctx context.Context, e boil.ContextExecutor, singular bool, maybeYoutubeTVSyncAccount any, mods queries.Applicator) error {
@@ -622,7 +669,7 @@ func (youtubeTVSyncAccountL) LoadUser(ctx context.Context, e boil.ContextExecuto
 		if foreign.R == nil {
 			foreign.R = &userR{}
 		}
-		foreign.R.YoutubeTVSyncAccount = object
+		foreign.R.YoutubeTVSyncAccounts = append(foreign.R.YoutubeTVSyncAccounts, object)
 		return nil
 	}
 
@@ -633,7 +680,120 @@ func (youtubeTVSyncAccountL) LoadUser(ctx context.Context, e boil.ContextExecuto
 				if foreign.R == nil {
 					foreign.R = &userR{}
 				}
-				foreign.R.YoutubeTVSyncAccount = local
+				foreign.R.YoutubeTVSyncAccounts = append(foreign.R.YoutubeTVSyncAccounts, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadAccountYoutubeTVSyncEvents allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (youtubeTVSyncAccountL) LoadAccountYoutubeTVSyncEvents(ctx context.Context, e boil.ContextExecutor, singular bool, maybeYoutubeTVSyncAccount any, mods queries.Applicator) error {
+	var slice []*YoutubeTVSyncAccount
+	var object *YoutubeTVSyncAccount
+
+	if singular {
+		var ok bool
+		object, ok = maybeYoutubeTVSyncAccount.(*YoutubeTVSyncAccount)
+		if !ok {
+			object = new(YoutubeTVSyncAccount)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeYoutubeTVSyncAccount)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeYoutubeTVSyncAccount))
+			}
+		}
+	} else {
+		s, ok := maybeYoutubeTVSyncAccount.(*[]*YoutubeTVSyncAccount)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeYoutubeTVSyncAccount)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeYoutubeTVSyncAccount))
+			}
+		}
+	}
+
+	args := make(map[any]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &youtubeTVSyncAccountR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &youtubeTVSyncAccountR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]any, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`youtube_tv_sync_events`),
+		qm.WhereIn(`youtube_tv_sync_events.account_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load youtube_tv_sync_events")
+	}
+
+	var resultSlice []*YoutubeTVSyncEvent
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice youtube_tv_sync_events")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on youtube_tv_sync_events")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for youtube_tv_sync_events")
+	}
+
+	if len(youtubeTVSyncEventAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.AccountYoutubeTVSyncEvents = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &youtubeTVSyncEventR{}
+			}
+			foreign.R.Account = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.AccountID {
+				local.R.AccountYoutubeTVSyncEvents = append(local.R.AccountYoutubeTVSyncEvents, foreign)
+				if foreign.R == nil {
+					foreign.R = &youtubeTVSyncEventR{}
+				}
+				foreign.R.Account = local
 				break
 			}
 		}
@@ -644,7 +804,7 @@ func (youtubeTVSyncAccountL) LoadUser(ctx context.Context, e boil.ContextExecuto
 
 // SetUser of the youtubeTVSyncAccount to the related item.
 // Sets o.R.User to related.
-// Adds o to related.R.YoutubeTVSyncAccount.
+// Adds o to related.R.YoutubeTVSyncAccounts.
 func (o *YoutubeTVSyncAccount) SetUser(ctx context.Context, exec boil.ContextExecutor, insert bool, related *User) error {
 	var err error
 	if insert {
@@ -680,12 +840,65 @@ func (o *YoutubeTVSyncAccount) SetUser(ctx context.Context, exec boil.ContextExe
 
 	if related.R == nil {
 		related.R = &userR{
-			YoutubeTVSyncAccount: o,
+			YoutubeTVSyncAccounts: YoutubeTVSyncAccountSlice{o},
+		}
+	} else {
+		related.R.YoutubeTVSyncAccounts = append(related.R.YoutubeTVSyncAccounts, o)
+	}
+
+	return nil
+}
+
+// AddAccountYoutubeTVSyncEvents adds the given related objects to the existing relationships
+// of the youtube_tv_sync_account, optionally inserting them as new records.
+// Appends related to o.R.AccountYoutubeTVSyncEvents.
+// Sets related.R.Account appropriately.
+func (o *YoutubeTVSyncAccount) AddAccountYoutubeTVSyncEvents(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*YoutubeTVSyncEvent) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.AccountID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"youtube_tv_sync_events\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 0, []string{"account_id"}),
+				strmangle.WhereClause("\"", "\"", 0, youtubeTVSyncEventPrimaryKeyColumns),
+			)
+			values := []any{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.AccountID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &youtubeTVSyncAccountR{
+			AccountYoutubeTVSyncEvents: related,
 		}
 	} else {
-		related.R.YoutubeTVSyncAccount = o
+		o.R.AccountYoutubeTVSyncEvents = append(o.R.AccountYoutubeTVSyncEvents, related...)
 	}
 
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &youtubeTVSyncEventR{
+				Account: o,
+			}
+		} else {
+			rel.R.Account = o
+		}
+	}
 	return nil
 }
 
@@ -494,6 +494,159 @@ func testYoutubeTVSyncAccountsInsertWhitelist(t *testing.T) {
 	}
 }
 
+func testYoutubeTVSyncAccountToManyAccountYoutubeTVSyncEvents(t *testing.T) {
+	var err error
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a YoutubeTVSyncAccount
+	var b, c YoutubeTVSyncEvent
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, youtubeTVSyncAccountDBTypes, true, youtubeTVSyncAccountColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize YoutubeTVSyncAccount struct: %s", err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = randomize.Struct(seed, &b, youtubeTVSyncEventDBTypes, false, youtubeTVSyncEventColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, youtubeTVSyncEventDBTypes, false, youtubeTVSyncEventColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+
+	b.AccountID = a.ID
+	c.AccountID = a.ID
+
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := a.AccountYoutubeTVSyncEvents().All(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	bFound, cFound := false, false
+	for _, v := range check {
+		if v.AccountID == b.AccountID {
+			bFound = true
+		}
+		if v.AccountID == c.AccountID {
+			cFound = true
+		}
+	}
+
+	if !bFound {
+		t.Error("expected to find b")
+	}
+	if !cFound {
+		t.Error("expected to find c")
+	}
+
+	slice := YoutubeTVSyncAccountSlice{&a}
+	if err = a.L.LoadAccountYoutubeTVSyncEvents(ctx, tx, false, (*[]*YoutubeTVSyncAccount)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.AccountYoutubeTVSyncEvents); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	a.R.AccountYoutubeTVSyncEvents = nil
+	if err = a.L.LoadAccountYoutubeTVSyncEvents(ctx, tx, true, &a, nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.AccountYoutubeTVSyncEvents); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	if t.Failed() {
+		t.Logf("%#v", check)
+	}
+}
+
+func testYoutubeTVSyncAccountToManyAddOpAccountYoutubeTVSyncEvents(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a YoutubeTVSyncAccount
+	var b, c, d, e YoutubeTVSyncEvent
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, youtubeTVSyncAccountDBTypes, false, strmangle.SetComplement(youtubeTVSyncAccountPrimaryKeyColumns, youtubeTVSyncAccountColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*YoutubeTVSyncEvent{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, youtubeTVSyncEventDBTypes, false, strmangle.SetComplement(youtubeTVSyncEventPrimaryKeyColumns, youtubeTVSyncEventColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	foreignersSplitByInsertion := [][]*YoutubeTVSyncEvent{
+		{&b, &c},
+		{&d, &e},
+	}
+
+	for i, x := range foreignersSplitByInsertion {
+		err = a.AddAccountYoutubeTVSyncEvents(ctx, tx, i != 0, x...)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		first := x[0]
+		second := x[1]
+
+		if a.ID != first.AccountID {
+			t.Error("foreign key was wrong value", a.ID, first.AccountID)
+		}
+		if a.ID != second.AccountID {
+			t.Error("foreign key was wrong value", a.ID, second.AccountID)
+		}
+
+		if first.R.Account != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+		if second.R.Account != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+
+		if a.R.AccountYoutubeTVSyncEvents[i*2] != first {
+			t.Error("relationship struct slice not set to correct value")
+		}
+		if a.R.AccountYoutubeTVSyncEvents[i*2+1] != second {
+			t.Error("relationship struct slice not set to correct value")
+		}
+
+		count, err := a.AccountYoutubeTVSyncEvents().Count(ctx, tx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if want := int64((i + 1) * 2); count != want {
+			t.Error("want", want, "got", count)
+		}
+	}
+}
 func testYoutubeTVSyncAccountToOneUserUsingUser(t *testing.T) {
 	ctx := context.Background()
 	tx := MustTx(boil.BeginTx(ctx, nil))
@@ -593,7 +746,7 @@ func testYoutubeTVSyncAccountToOneSetOpUserUsingUser(t *testing.T) {
 			t.Error("relationship struct not set to correct value")
 		}
 
-		if x.R.YoutubeTVSyncAccount != &a {
+		if x.R.YoutubeTVSyncAccounts[0] != &a {
 			t.Error("failed to append to foreign relationship struct")
 		}
 		if a.UserID != x.ID {
@@ -687,7 +840,7 @@ func testYoutubeTVSyncAccountsSelect(t *testing.T) {
 }
 
 var (
-	youtubeTVSyncAccountDBTypes = map[string]string{`ID`: `TEXT`, `CreatedAt`: `DATE`, `UpdatedAt`: `DATE`, `UserID`: `TEXT`, `ScreenID`: `TEXT`, `ScreenName`: `TEXT`, `LoungeTokenEnc`: `BLOB`, `EncSecretHash`: `TEXT`, `SyncEnabled`: `BOOLEAN`, `ConnectionState`: `TEXT`, `StateReason`: `TEXT`, `LastConnectedAt`: `DATE`, `LastEventAt`: `DATE`, `LastDisconnectAt`: `DATE`, `LastUserActivityAt`: `DATE`, `LastVideoID`: `TEXT`, `LastError`: `TEXT`}
+	youtubeTVSyncAccountDBTypes = map[string]string{`ID`: `TEXT`, `CreatedAt`: `DATE`, `UpdatedAt`: `DATE`, `UserID`: `TEXT`, `ScreenID`: `TEXT`, `ScreenName`: `TEXT`, `LoungeTokenEnc`: `BLOB`, `EncSecretHash`: `TEXT`, `SyncEnabled`: `BOOLEAN`, `ConnectionState`: `TEXT`, `StateReason`: `TEXT`, `LastConnectedAt`: `DATE`, `LastEventAt`: `DATE`, `LastDisconnectAt`: `DATE`, `LastUserActivityAt`: `DATE`, `LastVideoID`: `TEXT`, `LastError`: `TEXT`, `DisplayName`: `TEXT`, `SponsorblockMode`: `TEXT`}
 	_                           = bytes.MinRead
 )
 
@@ -0,0 +1,1367 @@
+// Code generated by SQLBoiler 4.19.7 (https://github.com/aarondl/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries"
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+	"github.com/aarondl/sqlboiler/v4/queries/qmhelper"
+	"github.com/aarondl/strmangle"
+	"github.com/friendsofgo/errors"
+)
+
+// YoutubeTVSyncEvent is an object representing the database table.
+type YoutubeTVSyncEvent struct {
+	ID        string    `boil:"id" json:"id" toml:"id" yaml:"id"`
+	CreatedAt time.Time `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+	AccountID string    `boil:"account_id" json:"account_id" toml:"account_id" yaml:"account_id"`
+	UserID    string    `boil:"user_id" json:"user_id" toml:"user_id" yaml:"user_id"`
+	Kind      string    `boil:"kind" json:"kind" toml:"kind" yaml:"kind"`
+	VideoID   string    `boil:"video_id" json:"video_id" toml:"video_id" yaml:"video_id"`
+	Message   string    `boil:"message" json:"message" toml:"message" yaml:"message"`
+
+	R *youtubeTVSyncEventR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L youtubeTVSyncEventL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var YoutubeTVSyncEventColumns = struct {
+	ID        string
+	CreatedAt string
+	AccountID string
+	UserID    string
+	Kind      string
+	VideoID   string
+	Message   string
+}{
+	ID:        "id",
+	CreatedAt: "created_at",
+	AccountID: "account_id",
+	UserID:    "user_id",
+	Kind:      "kind",
+	VideoID:   "video_id",
+	Message:   "message",
+}
+
+var YoutubeTVSyncEventTableColumns = struct {
+	ID        string
+	CreatedAt string
+	AccountID string
+	UserID    string
+	Kind      string
+	VideoID   string
+	Message   string
+}{
+	ID:        "youtube_tv_sync_events.id",
+	CreatedAt: "youtube_tv_sync_events.created_at",
+	AccountID: "youtube_tv_sync_events.account_id",
+	UserID:    "youtube_tv_sync_events.user_id",
+	Kind:      "youtube_tv_sync_events.kind",
+	VideoID:   "youtube_tv_sync_events.video_id",
+	Message:   "youtube_tv_sync_events.message",
+}
+
+// Generated where
+
+var YoutubeTVSyncEventWhere = struct {
+	ID        whereHelperstring
+	CreatedAt whereHelpertime_Time
+	AccountID whereHelperstring
+	UserID    whereHelperstring
+	Kind      whereHelperstring
+	VideoID   whereHelperstring
+	Message   whereHelperstring
+}{
+	ID:        whereHelperstring{field: "\"youtube_tv_sync_events\".\"id\""},
+	CreatedAt: whereHelpertime_Time{field: "\"youtube_tv_sync_events\".\"created_at\""},
+	AccountID: whereHelperstring{field: "\"youtube_tv_sync_events\".\"account_id\""},
+	UserID:    whereHelperstring{field: "\"youtube_tv_sync_events\".\"user_id\""},
+	Kind:      whereHelperstring{field: "\"youtube_tv_sync_events\".\"kind\""},
+	VideoID:   whereHelperstring{field: "\"youtube_tv_sync_events\".\"video_id\""},
+	Message:   whereHelperstring{field: "\"youtube_tv_sync_events\".\"message\""},
+}
+
+// YoutubeTVSyncEventRels is where relationship names are stored.
+var YoutubeTVSyncEventRels = struct {
+	User    string
+	Account string
+}{
+	User:    "User",
+	Account: "Account",
+}
+
+// youtubeTVSyncEventR is where relationships are stored.
+type youtubeTVSyncEventR struct {
+	User    *User                 `boil:"User" json:"User" toml:"User" yaml:"User"`
+	Account *YoutubeTVSyncAccount `boil:"Account" json:"Account" toml:"Account" yaml:"Account"`
+}
+
+// NewStruct creates a new relationship struct
+func (*youtubeTVSyncEventR) NewStruct() *youtubeTVSyncEventR {
+	return &youtubeTVSyncEventR{}
+}
+
+func (o *YoutubeTVSyncEvent) GetUser() *User {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetUser()
+}
+
+func (r *youtubeTVSyncEventR) GetUser() *User {
+	if r == nil {
+		return nil
+	}
+
+	return r.User
+}
+
+func (o *YoutubeTVSyncEvent) GetAccount() *YoutubeTVSyncAccount {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetAccount()
+}
+
+func (r *youtubeTVSyncEventR) GetAccount() *YoutubeTVSyncAccount {
+	if r == nil {
+		return nil
+	}
+
+	return r.Account
+}
+
+// youtubeTVSyncEventL is where Load methods for each relationship are stored.
+type youtubeTVSyncEventL struct{}
+
+var (
+	youtubeTVSyncEventAllColumns            = []string{"id", "created_at", "account_id", "user_id", "kind", "video_id", "message"}
+	youtubeTVSyncEventColumnsWithoutDefault = []string{"id", "created_at", "account_id", "user_id", "kind"}
+	youtubeTVSyncEventColumnsWithDefault    = []string{"video_id", "message"}
+	youtubeTVSyncEventPrimaryKeyColumns     = []string{"id"}
+	youtubeTVSyncEventGeneratedColumns      = []string{}
+)
+
+type (
+	// YoutubeTVSyncEventSlice is an alias for a slice of pointers to YoutubeTVSyncEvent.
+	// This should almost always be used instead of []YoutubeTVSyncEvent.
+	YoutubeTVSyncEventSlice []*YoutubeTVSyncEvent
+	// YoutubeTVSyncEventHook is the signature for custom YoutubeTVSyncEvent hook methods
+	YoutubeTVSyncEventHook func(context.Context, boil.ContextExecutor, *YoutubeTVSyncEvent) error
+
+	youtubeTVSyncEventQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	youtubeTVSyncEventType                 = reflect.TypeOf(&YoutubeTVSyncEvent{})
+	youtubeTVSyncEventMapping              = queries.MakeStructMapping(youtubeTVSyncEventType)
+	youtubeTVSyncEventPrimaryKeyMapping, _ = queries.BindMapping(youtubeTVSyncEventType, youtubeTVSyncEventMapping, youtubeTVSyncEventPrimaryKeyColumns)
+	youtubeTVSyncEventInsertCacheMut       sync.RWMutex
+	youtubeTVSyncEventInsertCache          = make(map[string]insertCache)
+	youtubeTVSyncEventUpdateCacheMut       sync.RWMutex
+	youtubeTVSyncEventUpdateCache          = make(map[string]updateCache)
+	youtubeTVSyncEventUpsertCacheMut       sync.RWMutex
+	youtubeTVSyncEventUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var youtubeTVSyncEventAfterSelectMu sync.Mutex
+var youtubeTVSyncEventAfterSelectHooks []YoutubeTVSyncEventHook
+
+var youtubeTVSyncEventBeforeInsertMu sync.Mutex
+var youtubeTVSyncEventBeforeInsertHooks []YoutubeTVSyncEventHook
+var youtubeTVSyncEventAfterInsertMu sync.Mutex
+var youtubeTVSyncEventAfterInsertHooks []YoutubeTVSyncEventHook
+
+var youtubeTVSyncEventBeforeUpdateMu sync.Mutex
+var youtubeTVSyncEventBeforeUpdateHooks []YoutubeTVSyncEventHook
+var youtubeTVSyncEventAfterUpdateMu sync.Mutex
+var youtubeTVSyncEventAfterUpdateHooks []YoutubeTVSyncEventHook
+
+var youtubeTVSyncEventBeforeDeleteMu sync.Mutex
+var youtubeTVSyncEventBeforeDeleteHooks []YoutubeTVSyncEventHook
+var youtubeTVSyncEventAfterDeleteMu sync.Mutex
+var youtubeTVSyncEventAfterDeleteHooks []YoutubeTVSyncEventHook
+
+var youtubeTVSyncEventBeforeUpsertMu sync.Mutex
+var youtubeTVSyncEventBeforeUpsertHooks []YoutubeTVSyncEventHook
+var youtubeTVSyncEventAfterUpsertMu sync.Mutex
+var youtubeTVSyncEventAfterUpsertHooks []YoutubeTVSyncEventHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *YoutubeTVSyncEvent) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range youtubeTVSyncEventAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *YoutubeTVSyncEvent) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range youtubeTVSyncEventBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *YoutubeTVSyncEvent) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range youtubeTVSyncEventAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *YoutubeTVSyncEvent) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range youtubeTVSyncEventBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *YoutubeTVSyncEvent) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range youtubeTVSyncEventAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *YoutubeTVSyncEvent) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range youtubeTVSyncEventBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *YoutubeTVSyncEvent) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range youtubeTVSyncEventAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *YoutubeTVSyncEvent) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range youtubeTVSyncEventBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *YoutubeTVSyncEvent) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range youtubeTVSyncEventAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddYoutubeTVSyncEventHook registers your hook function for all future operations.
+func AddYoutubeTVSyncEventHook(hookPoint boil.HookPoint, youtubeTVSyncEventHook YoutubeTVSyncEventHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		youtubeTVSyncEventAfterSelectMu.Lock()
+		youtubeTVSyncEventAfterSelectHooks = append(youtubeTVSyncEventAfterSelectHooks, youtubeTVSyncEventHook)
+		youtubeTVSyncEventAfterSelectMu.Unlock()
+	case boil.BeforeInsertHook:
+		youtubeTVSyncEventBeforeInsertMu.Lock()
+		youtubeTVSyncEventBeforeInsertHooks = append(youtubeTVSyncEventBeforeInsertHooks, youtubeTVSyncEventHook)
+		youtubeTVSyncEventBeforeInsertMu.Unlock()
+	case boil.AfterInsertHook:
+		youtubeTVSyncEventAfterInsertMu.Lock()
+		youtubeTVSyncEventAfterInsertHooks = append(youtubeTVSyncEventAfterInsertHooks, youtubeTVSyncEventHook)
+		youtubeTVSyncEventAfterInsertMu.Unlock()
+	case boil.BeforeUpdateHook:
+		youtubeTVSyncEventBeforeUpdateMu.Lock()
+		youtubeTVSyncEventBeforeUpdateHooks = append(youtubeTVSyncEventBeforeUpdateHooks, youtubeTVSyncEventHook)
+		youtubeTVSyncEventBeforeUpdateMu.Unlock()
+	case boil.AfterUpdateHook:
+		youtubeTVSyncEventAfterUpdateMu.Lock()
+		youtubeTVSyncEventAfterUpdateHooks = append(youtubeTVSyncEventAfterUpdateHooks, youtubeTVSyncEventHook)
+		youtubeTVSyncEventAfterUpdateMu.Unlock()
+	case boil.BeforeDeleteHook:
+		youtubeTVSyncEventBeforeDeleteMu.Lock()
+		youtubeTVSyncEventBeforeDeleteHooks = append(youtubeTVSyncEventBeforeDeleteHooks, youtubeTVSyncEventHook)
+		youtubeTVSyncEventBeforeDeleteMu.Unlock()
+	case boil.AfterDeleteHook:
+		youtubeTVSyncEventAfterDeleteMu.Lock()
+		youtubeTVSyncEventAfterDeleteHooks = append(youtubeTVSyncEventAfterDeleteHooks, youtubeTVSyncEventHook)
+		youtubeTVSyncEventAfterDeleteMu.Unlock()
+	case boil.BeforeUpsertHook:
+		youtubeTVSyncEventBeforeUpsertMu.Lock()
+		youtubeTVSyncEventBeforeUpsertHooks = append(youtubeTVSyncEventBeforeUpsertHooks, youtubeTVSyncEventHook)
+		youtubeTVSyncEventBeforeUpsertMu.Unlock()
+	case boil.AfterUpsertHook:
+		youtubeTVSyncEventAfterUpsertMu.Lock()
+		youtubeTVSyncEventAfterUpsertHooks = append(youtubeTVSyncEventAfterUpsertHooks, youtubeTVSyncEventHook)
+		youtubeTVSyncEventAfterUpsertMu.Unlock()
+	}
+}
+
+// One returns a single youtubeTVSyncEvent record from the query.
+func (q youtubeTVSyncEventQuery) One(ctx context.Context, exec boil.ContextExecutor) (*YoutubeTVSyncEvent, error) {
+	o := &YoutubeTVSyncEvent{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: failed to execute a one query for youtube_tv_sync_events")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all YoutubeTVSyncEvent records from the query.
+func (q youtubeTVSyncEventQuery) All(ctx context.Context, exec boil.ContextExecutor) (YoutubeTVSyncEventSlice, error) {
+	var o []*YoutubeTVSyncEvent
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "models: failed to assign all query results to YoutubeTVSyncEvent slice")
+	}
+
+	if len(youtubeTVSyncEventAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all YoutubeTVSyncEvent records in the query.
+func (q youtubeTVSyncEventQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to count youtube_tv_sync_events rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q youtubeTVSyncEventQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "models: failed to check if youtube_tv_sync_events exists")
+	}
+
+	return count > 0, nil
+}
+
+// User pointed to by the foreign key.
+func (o *YoutubeTVSyncEvent) User(mods ...qm.QueryMod) userQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.UserID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Users(queryMods...)
+}
+
+// Account pointed to by the foreign key.
+func (o *YoutubeTVSyncEvent) Account(mods ...qm.QueryMod) youtubeTVSyncAccountQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.AccountID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return YoutubeTVSyncAccounts(queryMods...)
+}
+
+// LoadUser allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (youtubeTVSyncEventL) LoadUser(ctx context.Context, e boil.ContextExecutor, singular bool, maybeYoutubeTVSyncEvent any, mods queries.Applicator) error {
+	var slice []*YoutubeTVSyncEvent
+	var object *YoutubeTVSyncEvent
+
+	if singular {
+		var ok bool
+		object, ok = maybeYoutubeTVSyncEvent.(*YoutubeTVSyncEvent)
+		if !ok {
+			object = new(YoutubeTVSyncEvent)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeYoutubeTVSyncEvent)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeYoutubeTVSyncEvent))
+			}
+		}
+	} else {
+		s, ok := maybeYoutubeTVSyncEvent.(*[]*YoutubeTVSyncEvent)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeYoutubeTVSyncEvent)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeYoutubeTVSyncEvent))
+			}
+		}
+	}
+
+	args := make(map[any]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &youtubeTVSyncEventR{}
+		}
+		args[object.UserID] = struct{}{}
+
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &youtubeTVSyncEventR{}
+			}
+
+			args[obj.UserID] = struct{}{}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]any, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`users`),
+		qm.WhereIn(`users.id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load User")
+	}
+
+	var resultSlice []*User
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice User")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for users")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for users")
+	}
+
+	if len(userAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.User = foreign
+		if foreign.R == nil {
+			foreign.R = &userR{}
+		}
+		foreign.R.YoutubeTVSyncEvents = append(foreign.R.YoutubeTVSyncEvents, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.UserID == foreign.ID {
+				local.R.User = foreign
+				if foreign.R == nil {
+					foreign.R = &userR{}
+				}
+				foreign.R.YoutubeTVSyncEvents = append(foreign.R.YoutubeTVSyncEvents, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadAccount allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (youtubeTVSyncEventL) LoadAccount(ctx context.Context, e boil.ContextExecutor, singular bool, maybeYoutubeTVSyncEvent any, mods queries.Applicator) error {
+	var slice []*YoutubeTVSyncEvent
+	var object *YoutubeTVSyncEvent
+
+	if singular {
+		var ok bool
+		object, ok = maybeYoutubeTVSyncEvent.(*YoutubeTVSyncEvent)
+		if !ok {
+			object = new(YoutubeTVSyncEvent)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeYoutubeTVSyncEvent)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeYoutubeTVSyncEvent))
+			}
+		}
+	} else {
+		s, ok := maybeYoutubeTVSyncEvent.(*[]*YoutubeTVSyncEvent)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeYoutubeTVSyncEvent)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeYoutubeTVSyncEvent))
+			}
+		}
+	}
+
+	args := make(map[any]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &youtubeTVSyncEventR{}
+		}
+		args[object.AccountID] = struct{}{}
+
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &youtubeTVSyncEventR{}
+			}
+
+			args[obj.AccountID] = struct{}{}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]any, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`youtube_tv_sync_accounts`),
+		qm.WhereIn(`youtube_tv_sync_accounts.id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load YoutubeTVSyncAccount")
+	}
+
+	var resultSlice []*YoutubeTVSyncAccount
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice YoutubeTVSyncAccount")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for youtube_tv_sync_accounts")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for youtube_tv_sync_accounts")
+	}
+
+	if len(youtubeTVSyncAccountAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Account = foreign
+		if foreign.R == nil {
+			foreign.R = &youtubeTVSyncAccountR{}
+		}
+		foreign.R.AccountYoutubeTVSyncEvents = append(foreign.R.AccountYoutubeTVSyncEvents, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.AccountID == foreign.ID {
+				local.R.Account = foreign
+				if foreign.R == nil {
+					foreign.R = &youtubeTVSyncAccountR{}
+				}
+				foreign.R.AccountYoutubeTVSyncEvents = append(foreign.R.AccountYoutubeTVSyncEvents, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetUser of the youtubeTVSyncEvent to the related item.
+// Sets o.R.User to related.
+// Adds o to related.R.YoutubeTVSyncEvents.
+func (o *YoutubeTVSyncEvent) SetUser(ctx context.Context, exec boil.ContextExecutor, insert bool, related *User) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"youtube_tv_sync_events\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 0, []string{"user_id"}),
+		strmangle.WhereClause("\"", "\"", 0, youtubeTVSyncEventPrimaryKeyColumns),
+	)
+	values := []any{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.UserID = related.ID
+	if o.R == nil {
+		o.R = &youtubeTVSyncEventR{
+			User: related,
+		}
+	} else {
+		o.R.User = related
+	}
+
+	if related.R == nil {
+		related.R = &userR{
+			YoutubeTVSyncEvents: YoutubeTVSyncEventSlice{o},
+		}
+	} else {
+		related.R.YoutubeTVSyncEvents = append(related.R.YoutubeTVSyncEvents, o)
+	}
+
+	return nil
+}
+
+// SetAccount of the youtubeTVSyncEvent to the related item.
+// Sets o.R.Account to related.
+// Adds o to related.R.AccountYoutubeTVSyncEvents.
+func (o *YoutubeTVSyncEvent) SetAccount(ctx context.Context, exec boil.ContextExecutor, insert bool, related *YoutubeTVSyncAccount) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"youtube_tv_sync_events\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 0, []string{"account_id"}),
+		strmangle.WhereClause("\"", "\"", 0, youtubeTVSyncEventPrimaryKeyColumns),
+	)
+	values := []any{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.AccountID = related.ID
+	if o.R == nil {
+		o.R = &youtubeTVSyncEventR{
+			Account: related,
+		}
+	} else {
+		o.R.Account = related
+	}
+
+	if related.R == nil {
+		related.R = &youtubeTVSyncAccountR{
+			AccountYoutubeTVSyncEvents: YoutubeTVSyncEventSlice{o},
+		}
+	} else {
+		related.R.AccountYoutubeTVSyncEvents = append(related.R.AccountYoutubeTVSyncEvents, o)
+	}
+
+	return nil
+}
+
+// YoutubeTVSyncEvents retrieves all the records using an executor.
+func YoutubeTVSyncEvents(mods ...qm.QueryMod) youtubeTVSyncEventQuery {
+	mods = append(mods, qm.From("\"youtube_tv_sync_events\""))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"\"youtube_tv_sync_events\".*"})
+	}
+
+	return youtubeTVSyncEventQuery{q}
+}
+
+// FindYoutubeTVSyncEvent retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindYoutubeTVSyncEvent(ctx context.Context, exec boil.ContextExecutor, iD string, selectCols ...string) (*YoutubeTVSyncEvent, error) {
+	youtubeTVSyncEventObj := &YoutubeTVSyncEvent{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"youtube_tv_sync_events\" where \"id\"=?", sel,
+	)
+
+	q := queries.Raw(query, iD)
+
+	err := q.Bind(ctx, exec, youtubeTVSyncEventObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: unable to select from youtube_tv_sync_events")
+	}
+
+	if err = youtubeTVSyncEventObj.doAfterSelectHooks(ctx, exec); err != nil {
+		return youtubeTVSyncEventObj, err
+	}
+
+	return youtubeTVSyncEventObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *YoutubeTVSyncEvent) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no youtube_tv_sync_events provided for insertion")
+	}
+
+	var err error
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+	}
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(youtubeTVSyncEventColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	youtubeTVSyncEventInsertCacheMut.RLock()
+	cache, cached := youtubeTVSyncEventInsertCache[key]
+	youtubeTVSyncEventInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			youtubeTVSyncEventAllColumns,
+			youtubeTVSyncEventColumnsWithDefault,
+			youtubeTVSyncEventColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(youtubeTVSyncEventType, youtubeTVSyncEventMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(youtubeTVSyncEventType, youtubeTVSyncEventMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"youtube_tv_sync_events\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"youtube_tv_sync_events\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "models: unable to insert into youtube_tv_sync_events")
+	}
+
+	if !cached {
+		youtubeTVSyncEventInsertCacheMut.Lock()
+		youtubeTVSyncEventInsertCache[key] = cache
+		youtubeTVSyncEventInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// Update uses an executor to update the YoutubeTVSyncEvent.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *YoutubeTVSyncEvent) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	youtubeTVSyncEventUpdateCacheMut.RLock()
+	cache, cached := youtubeTVSyncEventUpdateCache[key]
+	youtubeTVSyncEventUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			youtubeTVSyncEventAllColumns,
+			youtubeTVSyncEventPrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("models: unable to update youtube_tv_sync_events, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"youtube_tv_sync_events\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 0, wl),
+			strmangle.WhereClause("\"", "\"", 0, youtubeTVSyncEventPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(youtubeTVSyncEventType, youtubeTVSyncEventMapping, append(wl, youtubeTVSyncEventPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update youtube_tv_sync_events row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by update for youtube_tv_sync_events")
+	}
+
+	if !cached {
+		youtubeTVSyncEventUpdateCacheMut.Lock()
+		youtubeTVSyncEventUpdateCache[key] = cache
+		youtubeTVSyncEventUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q youtubeTVSyncEventQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all for youtube_tv_sync_events")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected for youtube_tv_sync_events")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o YoutubeTVSyncEventSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("models: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]any, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), youtubeTVSyncEventPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"youtube_tv_sync_events\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 0, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, youtubeTVSyncEventPrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all in youtubeTVSyncEvent slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected all in update all youtubeTVSyncEvent")
+	}
+	return rowsAff, nil
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *YoutubeTVSyncEvent) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no youtube_tv_sync_events provided for upsert")
+	}
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(youtubeTVSyncEventColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	youtubeTVSyncEventUpsertCacheMut.RLock()
+	cache, cached := youtubeTVSyncEventUpsertCache[key]
+	youtubeTVSyncEventUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, _ := insertColumns.InsertColumnSet(
+			youtubeTVSyncEventAllColumns,
+			youtubeTVSyncEventColumnsWithDefault,
+			youtubeTVSyncEventColumnsWithoutDefault,
+			nzDefaults,
+		)
+		update := updateColumns.UpdateColumnSet(
+			youtubeTVSyncEventAllColumns,
+			youtubeTVSyncEventPrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("models: unable to upsert youtube_tv_sync_events, could not build update column list")
+		}
+
+		ret := strmangle.SetComplement(youtubeTVSyncEventAllColumns, strmangle.SetIntersect(insert, update))
+
+		conflict := conflictColumns
+		if len(conflict) == 0 {
+			conflict = make([]string, len(youtubeTVSyncEventPrimaryKeyColumns))
+			copy(conflict, youtubeTVSyncEventPrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQuerySQLite(dialect, "\"youtube_tv_sync_events\"", updateOnConflict, ret, update, conflict, insert)
+
+		cache.valueMapping, err = queries.BindMapping(youtubeTVSyncEventType, youtubeTVSyncEventMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(youtubeTVSyncEventType, youtubeTVSyncEventMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []any
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if errors.Is(err, sql.ErrNoRows) {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "models: unable to upsert youtube_tv_sync_events")
+	}
+
+	if !cached {
+		youtubeTVSyncEventUpsertCacheMut.Lock()
+		youtubeTVSyncEventUpsertCache[key] = cache
+		youtubeTVSyncEventUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// Delete deletes a single YoutubeTVSyncEvent record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *YoutubeTVSyncEvent) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("models: no YoutubeTVSyncEvent provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), youtubeTVSyncEventPrimaryKeyMapping)
+	sql := "DELETE FROM \"youtube_tv_sync_events\" WHERE \"id\"=?"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete from youtube_tv_sync_events")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by delete for youtube_tv_sync_events")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q youtubeTVSyncEventQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("models: no youtubeTVSyncEventQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from youtube_tv_sync_events")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for youtube_tv_sync_events")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o YoutubeTVSyncEventSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(youtubeTVSyncEventBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []any
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), youtubeTVSyncEventPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"youtube_tv_sync_events\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, youtubeTVSyncEventPrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from youtubeTVSyncEvent slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for youtube_tv_sync_events")
+	}
+
+	if len(youtubeTVSyncEventAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *YoutubeTVSyncEvent) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindYoutubeTVSyncEvent(ctx, exec, o.ID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *YoutubeTVSyncEventSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := YoutubeTVSyncEventSlice{}
+	var args []any
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), youtubeTVSyncEventPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"youtube_tv_sync_events\".* FROM \"youtube_tv_sync_events\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, youtubeTVSyncEventPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to reload all in YoutubeTVSyncEventSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// YoutubeTVSyncEventExists checks if the YoutubeTVSyncEvent row exists.
+func YoutubeTVSyncEventExists(ctx context.Context, exec boil.ContextExecutor, iD string) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"youtube_tv_sync_events\" where \"id\"=? limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, iD)
+	}
+	row := exec.QueryRowContext(ctx, sql, iD)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "models: unable to check if youtube_tv_sync_events exists")
+	}
+
+	return exists, nil
+}
+
+// Exists checks if the YoutubeTVSyncEvent row exists.
+func (o *YoutubeTVSyncEvent) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	return YoutubeTVSyncEventExists(ctx, exec, o.ID)
+}
@@ -0,0 +1,968 @@
+// Code generated by SQLBoiler 4.19.7 (https://github.com/aarondl/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"bytes"
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/aarondl/randomize"
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries"
+	"github.com/aarondl/strmangle"
+)
+
+var (
+	// Relationships sometimes use the reflection helper queries.Equal/queries.Assign
+	// so force a package dependency in case they don't.
+	_ = queries.Equal
+)
+
+func testYoutubeTVSyncEvents(t *testing.T) {
+	t.Parallel()
+
+	query := YoutubeTVSyncEvents()
+
+	if query.Query == nil {
+		t.Error("expected a query, got nothing")
+	}
+}
+
+func testYoutubeTVSyncEventsDelete(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &YoutubeTVSyncEvent{}
+	if err = randomize.Struct(seed, o, youtubeTVSyncEventDBTypes, true, youtubeTVSyncEventColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize YoutubeTVSyncEvent struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := o.Delete(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := YoutubeTVSyncEvents().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testYoutubeTVSyncEventsQueryDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &YoutubeTVSyncEvent{}
+	if err = randomize.Struct(seed, o, youtubeTVSyncEventDBTypes, true, youtubeTVSyncEventColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize YoutubeTVSyncEvent struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := YoutubeTVSyncEvents().DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := YoutubeTVSyncEvents().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testYoutubeTVSyncEventsSliceDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &YoutubeTVSyncEvent{}
+	if err = randomize.Struct(seed, o, youtubeTVSyncEventDBTypes, true, youtubeTVSyncEventColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize YoutubeTVSyncEvent struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := YoutubeTVSyncEventSlice{o}
+
+	if rowsAff, err := slice.DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := YoutubeTVSyncEvents().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testYoutubeTVSyncEventsExists(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &YoutubeTVSyncEvent{}
+	if err = randomize.Struct(seed, o, youtubeTVSyncEventDBTypes, true, youtubeTVSyncEventColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize YoutubeTVSyncEvent struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	e, err := YoutubeTVSyncEventExists(ctx, tx, o.ID)
+	if err != nil {
+		t.Errorf("Unable to check if YoutubeTVSyncEvent exists: %s", err)
+	}
+	if !e {
+		t.Errorf("Expected YoutubeTVSyncEventExists to return true, but got false.")
+	}
+}
+
+func testYoutubeTVSyncEventsFind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &YoutubeTVSyncEvent{}
+	if err = randomize.Struct(seed, o, youtubeTVSyncEventDBTypes, true, youtubeTVSyncEventColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize YoutubeTVSyncEvent struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	youtubeTVSyncEventFound, err := FindYoutubeTVSyncEvent(ctx, tx, o.ID)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if youtubeTVSyncEventFound == nil {
+		t.Error("want a record, got nil")
+	}
+}
+
+func testYoutubeTVSyncEventsBind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &YoutubeTVSyncEvent{}
+	if err = randomize.Struct(seed, o, youtubeTVSyncEventDBTypes, true, youtubeTVSyncEventColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize YoutubeTVSyncEvent struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = YoutubeTVSyncEvents().Bind(ctx, tx, o); err != nil {
+		t.Error(err)
+	}
+}
+
+func testYoutubeTVSyncEventsOne(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &YoutubeTVSyncEvent{}
+	if err = randomize.Struct(seed, o, youtubeTVSyncEventDBTypes, true, youtubeTVSyncEventColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize YoutubeTVSyncEvent struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if x, err := YoutubeTVSyncEvents().One(ctx, tx); err != nil {
+		t.Error(err)
+	} else if x == nil {
+		t.Error("expected to get a non nil record")
+	}
+}
+
+func testYoutubeTVSyncEventsAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	youtubeTVSyncEventOne := &YoutubeTVSyncEvent{}
+	youtubeTVSyncEventTwo := &YoutubeTVSyncEvent{}
+	if err = randomize.Struct(seed, youtubeTVSyncEventOne, youtubeTVSyncEventDBTypes, false, youtubeTVSyncEventColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize YoutubeTVSyncEvent struct: %s", err)
+	}
+	if err = randomize.Struct(seed, youtubeTVSyncEventTwo, youtubeTVSyncEventDBTypes, false, youtubeTVSyncEventColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize YoutubeTVSyncEvent struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = youtubeTVSyncEventOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = youtubeTVSyncEventTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := YoutubeTVSyncEvents().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 2 {
+		t.Error("want 2 records, got:", len(slice))
+	}
+}
+
+func testYoutubeTVSyncEventsCount(t *testing.T) {
+	t.Parallel()
+
+	var err error
+	seed := randomize.NewSeed()
+	youtubeTVSyncEventOne := &YoutubeTVSyncEvent{}
+	youtubeTVSyncEventTwo := &YoutubeTVSyncEvent{}
+	if err = randomize.Struct(seed, youtubeTVSyncEventOne, youtubeTVSyncEventDBTypes, false, youtubeTVSyncEventColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize YoutubeTVSyncEvent struct: %s", err)
+	}
+	if err = randomize.Struct(seed, youtubeTVSyncEventTwo, youtubeTVSyncEventDBTypes, false, youtubeTVSyncEventColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize YoutubeTVSyncEvent struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = youtubeTVSyncEventOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = youtubeTVSyncEventTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := YoutubeTVSyncEvents().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 2 {
+		t.Error("want 2 records, got:", count)
+	}
+}
+
+func youtubeTVSyncEventBeforeInsertHook(ctx context.Context, e boil.ContextExecutor, o *YoutubeTVSyncEvent) error {
+	*o = YoutubeTVSyncEvent{}
+	return nil
+}
+
+func youtubeTVSyncEventAfterInsertHook(ctx context.Context, e boil.ContextExecutor, o *YoutubeTVSyncEvent) error {
+	*o = YoutubeTVSyncEvent{}
+	return nil
+}
+
+func youtubeTVSyncEventAfterSelectHook(ctx context.Context, e boil.ContextExecutor, o *YoutubeTVSyncEvent) error {
+	*o = YoutubeTVSyncEvent{}
+	return nil
+}
+
+func youtubeTVSyncEventBeforeUpdateHook(ctx context.Context, e boil.ContextExecutor, o *YoutubeTVSyncEvent) error {
+	*o = YoutubeTVSyncEvent{}
+	return nil
+}
+
+func youtubeTVSyncEventAfterUpdateHook(ctx context.Context, e boil.ContextExecutor, o *YoutubeTVSyncEvent) error {
+	*o = YoutubeTVSyncEvent{}
+	return nil
+}
+
+func youtubeTVSyncEventBeforeDeleteHook(ctx context.Context, e boil.ContextExecutor, o *YoutubeTVSyncEvent) error {
+	*o = YoutubeTVSyncEvent{}
+	return nil
+}
+
+func youtubeTVSyncEventAfterDeleteHook(ctx context.Context, e boil.ContextExecutor, o *YoutubeTVSyncEvent) error {
+	*o = YoutubeTVSyncEvent{}
+	return nil
+}
+
+func youtubeTVSyncEventBeforeUpsertHook(ctx context.Context, e boil.ContextExecutor, o *YoutubeTVSyncEvent) error {
+	*o = YoutubeTVSyncEvent{}
+	return nil
+}
+
+func youtubeTVSyncEventAfterUpsertHook(ctx context.Context, e boil.ContextExecutor, o *YoutubeTVSyncEvent) error {
+	*o = YoutubeTVSyncEvent{}
+	return nil
+}
+
+func testYoutubeTVSyncEventsHooks(t *testing.T) {
+	t.Parallel()
+
+	var err error
+
+	ctx := context.Background()
+	empty := &YoutubeTVSyncEvent{}
+	o := &YoutubeTVSyncEvent{}
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, o, youtubeTVSyncEventDBTypes, false); err != nil {
+		t.Errorf("Unable to randomize YoutubeTVSyncEvent object: %s", err)
+	}
+
+	AddYoutubeTVSyncEventHook(boil.BeforeInsertHook, youtubeTVSyncEventBeforeInsertHook)
+	if err = o.doBeforeInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeInsertHook function to empty object, but got: %#v", o)
+	}
+	youtubeTVSyncEventBeforeInsertHooks = []YoutubeTVSyncEventHook{}
+
+	AddYoutubeTVSyncEventHook(boil.AfterInsertHook, youtubeTVSyncEventAfterInsertHook)
+	if err = o.doAfterInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterInsertHook function to empty object, but got: %#v", o)
+	}
+	youtubeTVSyncEventAfterInsertHooks = []YoutubeTVSyncEventHook{}
+
+	AddYoutubeTVSyncEventHook(boil.AfterSelectHook, youtubeTVSyncEventAfterSelectHook)
+	if err = o.doAfterSelectHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterSelectHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterSelectHook function to empty object, but got: %#v", o)
+	}
+	youtubeTVSyncEventAfterSelectHooks = []YoutubeTVSyncEventHook{}
+
+	AddYoutubeTVSyncEventHook(boil.BeforeUpdateHook, youtubeTVSyncEventBeforeUpdateHook)
+	if err = o.doBeforeUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpdateHook function to empty object, but got: %#v", o)
+	}
+	youtubeTVSyncEventBeforeUpdateHooks = []YoutubeTVSyncEventHook{}
+
+	AddYoutubeTVSyncEventHook(boil.AfterUpdateHook, youtubeTVSyncEventAfterUpdateHook)
+	if err = o.doAfterUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpdateHook function to empty object, but got: %#v", o)
+	}
+	youtubeTVSyncEventAfterUpdateHooks = []YoutubeTVSyncEventHook{}
+
+	AddYoutubeTVSyncEventHook(boil.BeforeDeleteHook, youtubeTVSyncEventBeforeDeleteHook)
+	if err = o.doBeforeDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeDeleteHook function to empty object, but got: %#v", o)
+	}
+	youtubeTVSyncEventBeforeDeleteHooks = []YoutubeTVSyncEventHook{}
+
+	AddYoutubeTVSyncEventHook(boil.AfterDeleteHook, youtubeTVSyncEventAfterDeleteHook)
+	if err = o.doAfterDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterDeleteHook function to empty object, but got: %#v", o)
+	}
+	youtubeTVSyncEventAfterDeleteHooks = []YoutubeTVSyncEventHook{}
+
+	AddYoutubeTVSyncEventHook(boil.BeforeUpsertHook, youtubeTVSyncEventBeforeUpsertHook)
+	if err = o.doBeforeUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpsertHook function to empty object, but got: %#v", o)
+	}
+	youtubeTVSyncEventBeforeUpsertHooks = []YoutubeTVSyncEventHook{}
+
+	AddYoutubeTVSyncEventHook(boil.AfterUpsertHook, youtubeTVSyncEventAfterUpsertHook)
+	if err = o.doAfterUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpsertHook function to empty object, but got: %#v", o)
+	}
+	youtubeTVSyncEventAfterUpsertHooks = []YoutubeTVSyncEventHook{}
+}
+
+func testYoutubeTVSyncEventsInsert(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &YoutubeTVSyncEvent{}
+	if err = randomize.Struct(seed, o, youtubeTVSyncEventDBTypes, true, youtubeTVSyncEventColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize YoutubeTVSyncEvent struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := YoutubeTVSyncEvents().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testYoutubeTVSyncEventsInsertWhitelist(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &YoutubeTVSyncEvent{}
+	if err = randomize.Struct(seed, o, youtubeTVSyncEventDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize YoutubeTVSyncEvent struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Whitelist(strmangle.SetMerge(youtubeTVSyncEventPrimaryKeyColumns, youtubeTVSyncEventColumnsWithoutDefault)...)); err != nil {
+		t.Error(err)
+	}
+
+	count, err := YoutubeTVSyncEvents().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testYoutubeTVSyncEventToOneUserUsingUser(t *testing.T) {
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var local YoutubeTVSyncEvent
+	var foreign User
+
+	seed := randomize.NewSeed()
+	if err := randomize.Struct(seed, &local, youtubeTVSyncEventDBTypes, false, youtubeTVSyncEventColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize YoutubeTVSyncEvent struct: %s", err)
+	}
+	if err := randomize.Struct(seed, &foreign, userDBTypes, false, userColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize User struct: %s", err)
+	}
+
+	if err := foreign.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	local.UserID = foreign.ID
+	if err := local.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := local.User().One(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if check.ID != foreign.ID {
+		t.Errorf("want: %v, got %v", foreign.ID, check.ID)
+	}
+
+	ranAfterSelectHook := false
+	AddUserHook(boil.AfterSelectHook, func(ctx context.Context, e boil.ContextExecutor, o *User) error {
+		ranAfterSelectHook = true
+		return nil
+	})
+
+	slice := YoutubeTVSyncEventSlice{&local}
+	if err = local.L.LoadUser(ctx, tx, false, (*[]*YoutubeTVSyncEvent)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.User == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	local.R.User = nil
+	if err = local.L.LoadUser(ctx, tx, true, &local, nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.User == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	if !ranAfterSelectHook {
+		t.Error("failed to run AfterSelect hook for relationship")
+	}
+}
+
+func testYoutubeTVSyncEventToOneYoutubeTVSyncAccountUsingAccount(t *testing.T) {
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var local YoutubeTVSyncEvent
+	var foreign YoutubeTVSyncAccount
+
+	seed := randomize.NewSeed()
+	if err := randomize.Struct(seed, &local, youtubeTVSyncEventDBTypes, false, youtubeTVSyncEventColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize YoutubeTVSyncEvent struct: %s", err)
+	}
+	if err := randomize.Struct(seed, &foreign, youtubeTVSyncAccountDBTypes, false, youtubeTVSyncAccountColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize YoutubeTVSyncAccount struct: %s", err)
+	}
+
+	if err := foreign.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	local.AccountID = foreign.ID
+	if err := local.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := local.Account().One(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if check.ID != foreign.ID {
+		t.Errorf("want: %v, got %v", foreign.ID, check.ID)
+	}
+
+	ranAfterSelectHook := false
+	AddYoutubeTVSyncAccountHook(boil.AfterSelectHook, func(ctx context.Context, e boil.ContextExecutor, o *YoutubeTVSyncAccount) error {
+		ranAfterSelectHook = true
+		return nil
+	})
+
+	slice := YoutubeTVSyncEventSlice{&local}
+	if err = local.L.LoadAccount(ctx, tx, false, (*[]*YoutubeTVSyncEvent)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.Account == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	local.R.Account = nil
+	if err = local.L.LoadAccount(ctx, tx, true, &local, nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.Account == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	if !ranAfterSelectHook {
+		t.Error("failed to run AfterSelect hook for relationship")
+	}
+}
+
+func testYoutubeTVSyncEventToOneSetOpUserUsingUser(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a YoutubeTVSyncEvent
+	var b, c User
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, youtubeTVSyncEventDBTypes, false, strmangle.SetComplement(youtubeTVSyncEventPrimaryKeyColumns, youtubeTVSyncEventColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, userDBTypes, false, strmangle.SetComplement(userPrimaryKeyColumns, userColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, userDBTypes, false, strmangle.SetComplement(userPrimaryKeyColumns, userColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	for i, x := range []*User{&b, &c} {
+		err = a.SetUser(ctx, tx, i != 0, x)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if a.R.User != x {
+			t.Error("relationship struct not set to correct value")
+		}
+
+		if x.R.YoutubeTVSyncEvents[0] != &a {
+			t.Error("failed to append to foreign relationship struct")
+		}
+		if a.UserID != x.ID {
+			t.Error("foreign key was wrong value", a.UserID)
+		}
+
+		zero := reflect.Zero(reflect.TypeOf(a.UserID))
+		reflect.Indirect(reflect.ValueOf(&a.UserID)).Set(zero)
+
+		if err = a.Reload(ctx, tx); err != nil {
+			t.Fatal("failed to reload", err)
+		}
+
+		if a.UserID != x.ID {
+			t.Error("foreign key was wrong value", a.UserID, x.ID)
+		}
+	}
+}
+func testYoutubeTVSyncEventToOneSetOpYoutubeTVSyncAccountUsingAccount(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a YoutubeTVSyncEvent
+	var b, c YoutubeTVSyncAccount
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, youtubeTVSyncEventDBTypes, false, strmangle.SetComplement(youtubeTVSyncEventPrimaryKeyColumns, youtubeTVSyncEventColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, youtubeTVSyncAccountDBTypes, false, strmangle.SetComplement(youtubeTVSyncAccountPrimaryKeyColumns, youtubeTVSyncAccountColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, youtubeTVSyncAccountDBTypes, false, strmangle.SetComplement(youtubeTVSyncAccountPrimaryKeyColumns, youtubeTVSyncAccountColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	for i, x := range []*YoutubeTVSyncAccount{&b, &c} {
+		err = a.SetAccount(ctx, tx, i != 0, x)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if a.R.Account != x {
+			t.Error("relationship struct not set to correct value")
+		}
+
+		if x.R.AccountYoutubeTVSyncEvents[0] != &a {
+			t.Error("failed to append to foreign relationship struct")
+		}
+		if a.AccountID != x.ID {
+			t.Error("foreign key was wrong value", a.AccountID)
+		}
+
+		zero := reflect.Zero(reflect.TypeOf(a.AccountID))
+		reflect.Indirect(reflect.ValueOf(&a.AccountID)).Set(zero)
+
+		if err = a.Reload(ctx, tx); err != nil {
+			t.Fatal("failed to reload", err)
+		}
+
+		if a.AccountID != x.ID {
+			t.Error("foreign key was wrong value", a.AccountID, x.ID)
+		}
+	}
+}
+
+func testYoutubeTVSyncEventsReload(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &YoutubeTVSyncEvent{}
+	if err = randomize.Struct(seed, o, youtubeTVSyncEventDBTypes, true, youtubeTVSyncEventColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize YoutubeTVSyncEvent struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = o.Reload(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testYoutubeTVSyncEventsReloadAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &YoutubeTVSyncEvent{}
+	if err = randomize.Struct(seed, o, youtubeTVSyncEventDBTypes, true, youtubeTVSyncEventColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize YoutubeTVSyncEvent struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := YoutubeTVSyncEventSlice{o}
+
+	if err = slice.ReloadAll(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testYoutubeTVSyncEventsSelect(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &YoutubeTVSyncEvent{}
+	if err = randomize.Struct(seed, o, youtubeTVSyncEventDBTypes, true, youtubeTVSyncEventColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize YoutubeTVSyncEvent struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := YoutubeTVSyncEvents().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 1 {
+		t.Error("want one record, got:", len(slice))
+	}
+}
+
+var (
+	youtubeTVSyncEventDBTypes = map[string]string{`ID`: `TEXT`, `CreatedAt`: `DATE`, `AccountID`: `TEXT`, `UserID`: `TEXT`, `Kind`: `TEXT`, `VideoID`: `TEXT`, `Message`: `TEXT`}
+	_                         = bytes.MinRead
+)
+
+func testYoutubeTVSyncEventsUpdate(t *testing.T) {
+	t.Parallel()
+
+	if 0 == len(youtubeTVSyncEventPrimaryKeyColumns) {
+		t.Skip("Skipping table with no primary key columns")
+	}
+	if len(youtubeTVSyncEventAllColumns) == len(youtubeTVSyncEventPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &YoutubeTVSyncEvent{}
+	if err = randomize.Struct(seed, o, youtubeTVSyncEventDBTypes, true, youtubeTVSyncEventColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize YoutubeTVSyncEvent struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := YoutubeTVSyncEvents().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, youtubeTVSyncEventDBTypes, true, youtubeTVSyncEventPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize YoutubeTVSyncEvent struct: %s", err)
+	}
+
+	if rowsAff, err := o.Update(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only affect one row but affected", rowsAff)
+	}
+}
+
+func testYoutubeTVSyncEventsSliceUpdateAll(t *testing.T) {
+	t.Parallel()
+
+	if len(youtubeTVSyncEventAllColumns) == len(youtubeTVSyncEventPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &YoutubeTVSyncEvent{}
+	if err = randomize.Struct(seed, o, youtubeTVSyncEventDBTypes, true, youtubeTVSyncEventColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize YoutubeTVSyncEvent struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := YoutubeTVSyncEvents().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, youtubeTVSyncEventDBTypes, true, youtubeTVSyncEventPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize YoutubeTVSyncEvent struct: %s", err)
+	}
+
+	// Remove Primary keys and unique columns from what we plan to update
+	var fields []string
+	if strmangle.StringSliceMatch(youtubeTVSyncEventAllColumns, youtubeTVSyncEventPrimaryKeyColumns) {
+		fields = youtubeTVSyncEventAllColumns
+	} else {
+		fields = strmangle.SetComplement(
+			youtubeTVSyncEventAllColumns,
+			youtubeTVSyncEventPrimaryKeyColumns,
+		)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	typ := reflect.TypeOf(o).Elem()
+	n := typ.NumField()
+
+	updateMap := M{}
+	for _, col := range fields {
+		for i := 0; i < n; i++ {
+			f := typ.Field(i)
+			if f.Tag.Get("boil") == col {
+				updateMap[col] = value.Field(i).Interface()
+			}
+		}
+	}
+
+	slice := YoutubeTVSyncEventSlice{o}
+	if rowsAff, err := slice.UpdateAll(ctx, tx, updateMap); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("wanted one record updated but got", rowsAff)
+	}
+}
+
+func testYoutubeTVSyncEventsUpsert(t *testing.T) {
+	t.Parallel()
+	if len(youtubeTVSyncEventAllColumns) == len(youtubeTVSyncEventPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	// Attempt the INSERT side of an UPSERT
+	o := YoutubeTVSyncEvent{}
+	if err = randomize.Struct(seed, &o, youtubeTVSyncEventDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize YoutubeTVSyncEvent struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Upsert(ctx, tx, false, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert YoutubeTVSyncEvent: %s", err)
+	}
+
+	count, err := YoutubeTVSyncEvents().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	// Attempt the UPDATE side of an UPSERT
+	if err = randomize.Struct(seed, &o, youtubeTVSyncEventDBTypes, false, youtubeTVSyncEventPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize YoutubeTVSyncEvent struct: %s", err)
+	}
+
+	if err = o.Upsert(ctx, tx, true, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert YoutubeTVSyncEvent: %s", err)
+	}
+
+	count, err = YoutubeTVSyncEvents().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
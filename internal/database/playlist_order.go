@@ -0,0 +1,209 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"slices"
+
+	"github.com/pkg/errors"
+)
+
+/*
+PlaylistPositionGap is the space left between the positions of playlist items.
+An item moved between two others takes the position halfway between them, so a move writes one row until the
+space between two items runs out and the playlist is renumbered.
+*/
+const PlaylistPositionGap = 1024
+
+type playlistItemPosition struct {
+	id       string
+	videoID  string
+	position int64
+}
+
+func getPlaylistItemPositions(ctx context.Context, tx *sql.Tx, playlistID string) ([]playlistItemPosition, error) {
+	rows, err := tx.QueryContext(ctx,
+		"SELECT id, video_id, position FROM playlist_items WHERE playlist_id = ? ORDER BY position ASC, created_at ASC",
+		playlistID,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var items []playlistItemPosition
+	for rows.Next() {
+		var item playlistItemPosition
+		if err := rows.Scan(&item.id, &item.videoID, &item.position); err != nil {
+			return nil, err
+		}
+		items = append(items, item)
+	}
+	return items, rows.Err()
+}
+
+/*
+MovePlaylistItemToIndex moves a playlist item to an index in the playlist ordered by position, indexes past the end move the item to the end
+*/
+func (c *sqliteClient) MovePlaylistItemToIndex(ctx context.Context, playlistID, videoID string, index int) error {
+	tx, err := c.db.BeginTx(ctx, nil)
+	if err != nil {
+		return errors.Wrap(err, "failed to begin transaction")
+	}
+	defer tx.Rollback()
+
+	items, err := getPlaylistItemPositions(ctx, tx, playlistID)
+	if err != nil {
+		return errors.Wrap(err, "failed to get playlist items")
+	}
+
+	current := -1
+	for i, item := range items {
+		if item.videoID == videoID {
+			current = i
+			break
+		}
+	}
+	if current < 0 {
+		return sql.ErrNoRows
+	}
+
+	index = max(0, min(index, len(items)-1))
+	if index == current {
+		return nil
+	}
+
+	moved := items[current]
+	order := slices.Insert(slices.Delete(slices.Clone(items), current, current+1), index, moved)
+
+	var prev, next *int64
+	if index > 0 {
+		prev = &order[index-1].position
+	}
+	if index < len(order)-1 {
+		next = &order[index+1].position
+	}
+
+	if position, ok := positionBetween(prev, next); ok {
+		_, err = tx.ExecContext(ctx, "UPDATE playlist_items SET position = ? WHERE id = ?", position, moved.id)
+		if err != nil {
+			return errors.Wrap(err, "failed to update item position")
+		}
+		return tx.Commit()
+	}
+
+	if err := renumberPlaylistItems(ctx, tx, order); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+/*
+SetPlaylistItemOrder puts the listed videos in the given order. The videos take the positions they occupy between
+themselves, so listing only some videos of a playlist, like the unwatched ones, leaves every other item where it is.
+Videos that are not in the playlist are ignored.
+*/
+func (c *sqliteClient) SetPlaylistItemOrder(ctx context.Context, playlistID string, videoIDs []string) error {
+	tx, err := c.db.BeginTx(ctx, nil)
+	if err != nil {
+		return errors.Wrap(err, "failed to begin transaction")
+	}
+	defer tx.Rollback()
+
+	items, err := getPlaylistItemPositions(ctx, tx, playlistID)
+	if err != nil {
+		return errors.Wrap(err, "failed to get playlist items")
+	}
+
+	byVideo := make(map[string]playlistItemPosition, len(items))
+	for _, item := range items {
+		byVideo[item.videoID] = item
+	}
+
+	var listed []playlistItemPosition
+	seen := make(map[string]bool, len(videoIDs))
+	for _, id := range videoIDs {
+		item, ok := byVideo[id]
+		if !ok || seen[id] {
+			continue
+		}
+		seen[id] = true
+		listed = append(listed, item)
+	}
+
+	var slots []int64
+	for _, item := range items {
+		if seen[item.videoID] {
+			slots = append(slots, item.position)
+		}
+	}
+
+	if !strictlyIncreasing(slots) {
+		// Items sharing a position cannot be told apart, renumber the whole playlist with the listed items in their new order
+		order := make([]playlistItemPosition, 0, len(items))
+		next := 0
+		for _, item := range items {
+			if seen[item.videoID] {
+				order = append(order, listed[next])
+				next++
+				continue
+			}
+			order = append(order, item)
+		}
+		if err := renumberPlaylistItems(ctx, tx, order); err != nil {
+			return err
+		}
+		return tx.Commit()
+	}
+
+	for i, item := range listed {
+		if item.position == slots[i] {
+			continue
+		}
+		_, err = tx.ExecContext(ctx, "UPDATE playlist_items SET position = ? WHERE id = ?", slots[i], item.id)
+		if err != nil {
+			return errors.Wrap(err, "failed to update item position")
+		}
+	}
+	return tx.Commit()
+}
+
+// renumberPlaylistItems spreads the positions of items in the given order PlaylistPositionGap apart, items that already have their new position are not written
+func renumberPlaylistItems(ctx context.Context, tx *sql.Tx, order []playlistItemPosition) error {
+	for i, item := range order {
+		position := int64(i+1) * PlaylistPositionGap
+		if item.position == position {
+			continue
+		}
+		_, err := tx.ExecContext(ctx, "UPDATE playlist_items SET position = ? WHERE id = ?", position, item.id)
+		if err != nil {
+			return errors.Wrap(err, "failed to renumber playlist items")
+		}
+	}
+	return nil
+}
+
+// positionBetween returns a position between two neighbors, either can be nil at the start or end of a playlist. It returns false when there is no free position left between them.
+func positionBetween(prev, next *int64) (int64, bool) {
+	switch {
+	case prev == nil && next == nil:
+		return PlaylistPositionGap, true
+	case prev == nil:
+		return *next - PlaylistPositionGap, true
+	case next == nil:
+		return *prev + PlaylistPositionGap, true
+	case *next-*prev < 2:
+		return 0, false
+	default:
+		return *prev + (*next-*prev)/2, true
+	}
+}
+
+func strictlyIncreasing(values []int64) bool {
+	for i := 1; i < len(values); i++ {
+		if values[i] <= values[i-1] {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,122 @@
+package database
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/cufee/feedlr-yt/internal/database/models"
+	"github.com/matryer/is"
+)
+
+func TestPositionBetween(t *testing.T) {
+	is := is.New(t)
+
+	ptr := func(v int64) *int64 { return &v }
+
+	position, ok := positionBetween(nil, nil)
+	is.True(ok)
+	is.Equal(position, int64(PlaylistPositionGap))
+
+	position, ok = positionBetween(nil, ptr(100))
+	is.True(ok)
+	is.Equal(position, int64(100-PlaylistPositionGap))
+
+	position, ok = positionBetween(ptr(100), nil)
+	is.True(ok)
+	is.Equal(position, int64(100+PlaylistPositionGap))
+
+	position, ok = positionBetween(ptr(100), ptr(200))
+	is.True(ok)
+	is.Equal(position, int64(150))
+
+	_, ok = positionBetween(ptr(100), ptr(101))
+	is.True(!ok)
+	_, ok = positionBetween(ptr(100), ptr(100))
+	is.True(!ok)
+}
+
+func TestPlaylistItemOrder(t *testing.T) {
+	is := is.New(t)
+
+	dbPath := os.Getenv("DATABASE_PATH")
+	if dbPath == "" {
+		t.Skip("DATABASE_PATH not set")
+	}
+
+	c, err := NewSQLiteClient(dbPath)
+	is.NoErr(err)
+	defer c.Close()
+
+	ctx := context.Background()
+	db := c.(*sqliteClient).db
+
+	channel := &models.Channel{ID: "test-channel-playlist-order", Title: "Test Channel"}
+	is.NoErr(channel.Insert(ctx, db, boil.Infer()))
+	defer channel.Delete(ctx, db)
+
+	var videoIDs []string
+	for i := range 5 {
+		video := &models.Video{
+			ID:          fmt.Sprintf("test-video-playlist-order-%d", i),
+			Title:       "Test Video",
+			ChannelID:   channel.ID,
+			Type:        "video",
+			PublishedAt: time.Now(),
+		}
+		is.NoErr(video.Insert(ctx, db, boil.Infer()))
+		defer video.Delete(ctx, db)
+		videoIDs = append(videoIDs, video.ID)
+	}
+
+	// Deleted before the videos, the playlist items go with the user
+	user := &models.User{ID: "test-user-playlist-order", Username: "test-user-playlist-order"}
+	is.NoErr(user.Insert(ctx, db, boil.Infer()))
+	defer user.Delete(ctx, db)
+
+	playlist := &models.Playlist{UserID: user.ID, Slug: "test-order", Name: "Order"}
+	is.NoErr(c.CreatePlaylist(ctx, playlist))
+
+	// Positions without gaps, like playlists created before the gaps were added
+	for i, id := range videoIDs {
+		is.NoErr(c.AddPlaylistItemAtPosition(ctx, playlist.ID, id, i+1))
+	}
+
+	order := func() []string {
+		items, err := c.GetPlaylistItems(ctx, playlist.ID, PlaylistItem.OrderByPosition())
+		is.NoErr(err)
+		var ids []string
+		for _, item := range items {
+			ids = append(ids, item.VideoID)
+		}
+		return ids
+	}
+	v := videoIDs
+
+	// No room between the neighbors, the playlist is renumbered
+	is.NoErr(c.MovePlaylistItemToIndex(ctx, playlist.ID, v[4], 1))
+	is.Equal(order(), []string{v[0], v[4], v[1], v[2], v[3]})
+
+	// Gaps are left after renumbering, moving again writes a single position
+	is.NoErr(c.MovePlaylistItemToIndex(ctx, playlist.ID, v[0], 3))
+	is.Equal(order(), []string{v[4], v[1], v[2], v[0], v[3]})
+
+	is.NoErr(c.MovePlaylistItemToIndex(ctx, playlist.ID, v[3], 0))
+	is.Equal(order(), []string{v[3], v[4], v[1], v[2], v[0]})
+
+	// Past the end moves to the end
+	is.NoErr(c.MovePlaylistItemToIndex(ctx, playlist.ID, v[3], 100))
+	is.Equal(order(), []string{v[4], v[1], v[2], v[0], v[3]})
+
+	// Listing some videos only reorders them between themselves
+	is.NoErr(c.SetPlaylistItemOrder(ctx, playlist.ID, []string{v[0], v[4], "not-in-playlist"}))
+	is.Equal(order(), []string{v[0], v[1], v[2], v[4], v[3]})
+
+	is.NoErr(c.SetPlaylistItemOrder(ctx, playlist.ID, []string{v[3], v[2], v[1], v[0], v[4]}))
+	is.Equal(order(), []string{v[3], v[2], v[1], v[0], v[4]})
+
+	is.True(IsErrNotFound(c.MovePlaylistItemToIndex(ctx, playlist.ID, "not-in-playlist", 0)))
+}
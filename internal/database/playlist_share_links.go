@@ -0,0 +1,66 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+	"github.com/cufee/feedlr-yt/internal/database/models"
+)
+
+/*
+PlaylistShareLinksClient stores public read-only links to playlists.
+Only a hash of the link token is stored, a link without an expiry stays valid until it is revoked.
+*/
+type PlaylistShareLinksClient interface {
+	CreatePlaylistShareLink(ctx context.Context, link *models.PlaylistShareLink) error
+	GetPlaylistShareLinks(ctx context.Context, playlistID string) ([]*models.PlaylistShareLink, error)
+	GetPlaylistShareLinkByTokenHash(ctx context.Context, tokenHash string) (*models.PlaylistShareLink, error)
+	DeletePlaylistShareLink(ctx context.Context, playlistID, linkID string) error
+}
+
+func (c *sqliteClient) CreatePlaylistShareLink(ctx context.Context, link *models.PlaylistShareLink) error {
+	if link.ExpiresAt.Valid {
+		link.ExpiresAt.Time = link.ExpiresAt.Time.UTC()
+	}
+	return link.Insert(ctx, c.db, boil.Infer())
+}
+
+/*
+GetPlaylistShareLinks returns all links of a playlist including expired ones, newest first
+*/
+func (c *sqliteClient) GetPlaylistShareLinks(ctx context.Context, playlistID string) ([]*models.PlaylistShareLink, error) {
+	return models.PlaylistShareLinks(
+		models.PlaylistShareLinkWhere.PlaylistID.EQ(playlistID),
+		qm.OrderBy(models.PlaylistShareLinkColumns.CreatedAt+" DESC"),
+	).All(ctx, c.db)
+}
+
+/*
+GetPlaylistShareLinkByTokenHash returns the link a token belongs to, expired and unknown tokens return sql.ErrNoRows
+*/
+func (c *sqliteClient) GetPlaylistShareLinkByTokenHash(ctx context.Context, tokenHash string) (*models.PlaylistShareLink, error) {
+	return models.PlaylistShareLinks(
+		models.PlaylistShareLinkWhere.TokenHash.EQ(tokenHash),
+		qm.Where("("+models.PlaylistShareLinkColumns.ExpiresAt+" IS NULL OR "+models.PlaylistShareLinkColumns.ExpiresAt+" > ?)", time.Now().UTC()),
+	).One(ctx, c.db)
+}
+
+/*
+DeletePlaylistShareLink revokes a link of a playlist, sql.ErrNoRows is returned when the playlist has no such link
+*/
+func (c *sqliteClient) DeletePlaylistShareLink(ctx context.Context, playlistID, linkID string) error {
+	deleted, err := models.PlaylistShareLinks(
+		models.PlaylistShareLinkWhere.ID.EQ(linkID),
+		models.PlaylistShareLinkWhere.PlaylistID.EQ(playlistID),
+	).DeleteAll(ctx, c.db)
+	if err != nil {
+		return err
+	}
+	if deleted == 0 {
+		return sql.ErrNoRows
+	}
+	return nil
+}
@@ -0,0 +1,76 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/aarondl/null/v8"
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/cufee/feedlr-yt/internal/database/models"
+	"github.com/matryer/is"
+)
+
+func TestPlaylistShareLinks(t *testing.T) {
+	is := is.New(t)
+
+	dbPath := os.Getenv("DATABASE_PATH")
+	if dbPath == "" {
+		t.Skip("DATABASE_PATH not set")
+	}
+
+	c, err := NewSQLiteClient(dbPath)
+	is.NoErr(err)
+	defer c.Close()
+
+	ctx := context.Background()
+	db := c.(*sqliteClient).db
+
+	// Playlists and their links go with the user
+	user := &models.User{ID: "test-user-share-links", Username: "test-user-share-links"}
+	is.NoErr(user.Insert(ctx, db, boil.Infer()))
+	defer user.Delete(ctx, db)
+
+	playlist := &models.Playlist{UserID: user.ID, Slug: "shared", Name: "Shared"}
+	is.NoErr(c.CreatePlaylist(ctx, playlist))
+
+	open := &models.PlaylistShareLink{PlaylistID: playlist.ID, TokenHash: "test-share-open"}
+	is.NoErr(c.CreatePlaylistShareLink(ctx, open))
+	expiring := &models.PlaylistShareLink{PlaylistID: playlist.ID, TokenHash: "test-share-expiring", ExpiresAt: null.TimeFrom(time.Now().Add(time.Hour))}
+	is.NoErr(c.CreatePlaylistShareLink(ctx, expiring))
+	expired := &models.PlaylistShareLink{PlaylistID: playlist.ID, TokenHash: "test-share-expired", ExpiresAt: null.TimeFrom(time.Now().Add(-time.Hour))}
+	is.NoErr(c.CreatePlaylistShareLink(ctx, expired))
+
+	// Token hashes are unique
+	is.True(c.CreatePlaylistShareLink(ctx, &models.PlaylistShareLink{PlaylistID: playlist.ID, TokenHash: "test-share-open"}) != nil)
+
+	links, err := c.GetPlaylistShareLinks(ctx, playlist.ID)
+	is.NoErr(err)
+	is.Equal(len(links), 3)
+
+	link, err := c.GetPlaylistShareLinkByTokenHash(ctx, "test-share-open")
+	is.NoErr(err)
+	is.Equal(link.ID, open.ID)
+	link, err = c.GetPlaylistShareLinkByTokenHash(ctx, "test-share-expiring")
+	is.NoErr(err)
+	is.Equal(link.ID, expiring.ID)
+	_, err = c.GetPlaylistShareLinkByTokenHash(ctx, "test-share-expired")
+	is.Equal(err, sql.ErrNoRows)
+	_, err = c.GetPlaylistShareLinkByTokenHash(ctx, "test-share-unknown")
+	is.Equal(err, sql.ErrNoRows)
+
+	// Links are revoked through their playlist
+	is.Equal(c.DeletePlaylistShareLink(ctx, "test-playlist-other", open.ID), sql.ErrNoRows)
+	is.NoErr(c.DeletePlaylistShareLink(ctx, playlist.ID, open.ID))
+	_, err = c.GetPlaylistShareLinkByTokenHash(ctx, "test-share-open")
+	is.Equal(err, sql.ErrNoRows)
+	is.Equal(c.DeletePlaylistShareLink(ctx, playlist.ID, open.ID), sql.ErrNoRows)
+
+	// Deleting the playlist removes its links
+	is.NoErr(c.DeletePlaylist(ctx, playlist.ID))
+	links, err = c.GetPlaylistShareLinks(ctx, playlist.ID)
+	is.NoErr(err)
+	is.Equal(len(links), 0)
+}
@@ -3,6 +3,7 @@ package database
 import (
 	"context"
 	"database/sql"
+	"time"
 
 	"github.com/aarondl/null/v8"
 	"github.com/aarondl/sqlboiler/v4/boil"
@@ -30,6 +31,55 @@ type PlaylistsClient interface {
 	IsVideoInPlaylist(ctx context.Context, playlistID, videoID string) (bool, error)
 	CleanupExpiredPlaylistItems(ctx context.Context) (int64, error)
 	GetMaxPlaylistItemPosition(ctx context.Context, playlistID string) (int, error)
+	MovePlaylistItemToIndex(ctx context.Context, playlistID, videoID string, index int) error
+	SetPlaylistItemOrder(ctx context.Context, playlistID string, videoIDs []string) error
+	UpdatePlaylistLimits(ctx context.Context, playlistID string, limits PlaylistLimits) error
+	UpdatePlaylistAutoSync(ctx context.Context, playlistID string, options PlaylistAutoSyncOptions) error
+	UpdatePlaylistSyncResult(ctx context.Context, playlistID string, result PlaylistSyncResult) error
+	GetPlaylistsDueForSync(ctx context.Context, now time.Time, limit int) ([]*models.Playlist, error)
+	SetPlaylistNextSyncAt(ctx context.Context, playlistID string, next null.Time) error
+}
+
+const (
+	// PlaylistSyncAppend only adds new videos from the YouTube playlist
+	PlaylistSyncAppend = "append"
+	// PlaylistSyncMirror also removes videos that are no longer in the YouTube playlist
+	PlaylistSyncMirror = "mirror"
+)
+
+const (
+	// PlaylistOverflowReject refuses new items once the playlist is full
+	PlaylistOverflowReject = "reject"
+	// PlaylistOverflowDropOldest removes the items added first to make room
+	PlaylistOverflowDropOldest = "drop_oldest"
+	// PlaylistOverflowDropWatched removes watched or hidden items, added first, and refuses new items when there are not enough of them
+	PlaylistOverflowDropWatched = "drop_watched"
+)
+
+// DefaultWatchLaterTTLDays is how long videos stay in a new Watch Later playlist
+const DefaultWatchLaterTTLDays = 30
+
+var ErrPlaylistFull = errors.New("playlist is full")
+
+type PlaylistLimits struct {
+	// TTLDays removes items older than this many days, null keeps items forever
+	TTLDays null.Int64
+	// MaxSize caps the number of items, null leaves the playlist unbounded
+	MaxSize        null.Int64
+	OverflowPolicy string
+}
+
+type PlaylistAutoSyncOptions struct {
+	// IntervalHours of 0 turns auto sync off
+	IntervalHours int64
+	Mode          string
+	NextSyncAt    null.Time
+}
+
+type PlaylistSyncResult struct {
+	// LastSyncedAt is left as is when it is not set, a failed sync keeps the time of the last successful one
+	LastSyncedAt  null.Time
+	LastSyncError string
 }
 
 // PlaylistItemQuery options
@@ -135,43 +185,19 @@ func (c *sqliteClient) DeletePlaylist(ctx context.Context, playlistID string) er
 }
 
 func (c *sqliteClient) AddPlaylistItem(ctx context.Context, playlistID, videoID string) error {
-	// First, check max_size constraint
-	playlist, err := models.FindPlaylist(ctx, c.db, playlistID)
+	tx, err := c.db.BeginTx(ctx, nil)
 	if err != nil {
-		return errors.Wrap(err, "failed to find playlist")
+		return errors.Wrap(err, "failed to begin transaction")
 	}
+	defer tx.Rollback()
 
-	if playlist.MaxSize.Valid && playlist.MaxSize.Int64 > 0 {
-		// Count current items
-		count, err := models.PlaylistItems(
-			qm.Where(models.PlaylistItemColumns.PlaylistID+"=?", playlistID),
-		).Count(ctx, c.db)
-		if err != nil {
-			return errors.Wrap(err, "failed to count playlist items")
-		}
-
-		// If at max size, remove oldest items
-		if count >= playlist.MaxSize.Int64 {
-			toRemove := count - playlist.MaxSize.Int64 + 1
-			oldestItems, err := models.PlaylistItems(
-				qm.Where(models.PlaylistItemColumns.PlaylistID+"=?", playlistID),
-				qm.OrderBy(models.PlaylistItemColumns.CreatedAt+" ASC"),
-				qm.Limit(int(toRemove)),
-			).All(ctx, c.db)
-			if err != nil {
-				return errors.Wrap(err, "failed to find oldest items")
-			}
-			for _, item := range oldestItems {
-				_, err := item.Delete(ctx, c.db)
-				if err != nil {
-					return errors.Wrap(err, "failed to delete oldest item")
-				}
-			}
-		}
+	if err := makePlaylistRoom(ctx, tx, playlistID); err != nil {
+		return err
 	}
 
 	// Compute next position
-	maxPos, err := c.GetMaxPlaylistItemPosition(ctx, playlistID)
+	var maxPos sql.NullInt64
+	err = tx.QueryRowContext(ctx, "SELECT MAX(position) FROM playlist_items WHERE playlist_id = ?", playlistID).Scan(&maxPos)
 	if err != nil {
 		return errors.Wrap(err, "failed to get max position")
 	}
@@ -179,18 +205,101 @@ func (c *sqliteClient) AddPlaylistItem(ctx context.Context, playlistID, videoID
 	item := &models.PlaylistItem{
 		PlaylistID: playlistID,
 		VideoID:    videoID,
-		Position:   int64(maxPos + 1),
+		Position:   maxPos.Int64 + PlaylistPositionGap,
+	}
+	if err := item.Insert(ctx, tx, boil.Infer()); err != nil {
+		return err
 	}
-	return item.Insert(ctx, c.db, boil.Infer())
+	return tx.Commit()
 }
 
 func (c *sqliteClient) AddPlaylistItemAtPosition(ctx context.Context, playlistID, videoID string, position int) error {
+	tx, err := c.db.BeginTx(ctx, nil)
+	if err != nil {
+		return errors.Wrap(err, "failed to begin transaction")
+	}
+	defer tx.Rollback()
+
+	// Imports add videos again on every sync, a video that is already in the playlist needs no room
+	exists, err := models.PlaylistItems(
+		qm.Where(models.PlaylistItemColumns.PlaylistID+"=?", playlistID),
+		qm.Where(models.PlaylistItemColumns.VideoID+"=?", videoID),
+	).Exists(ctx, tx)
+	if err != nil {
+		return errors.Wrap(err, "failed to check playlist membership")
+	}
+	if exists {
+		return nil
+	}
+	if err := makePlaylistRoom(ctx, tx, playlistID); err != nil {
+		return err
+	}
+
 	// Use INSERT OR IGNORE for idempotent imports
-	_, err := c.db.ExecContext(ctx,
+	_, err = tx.ExecContext(ctx,
 		"INSERT OR IGNORE INTO playlist_items (id, created_at, updated_at, playlist_id, video_id, position) VALUES (?, datetime('now'), datetime('now'), ?, ?, ?)",
 		ensureID(""), playlistID, videoID, position,
 	)
-	return err
+	if err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+// makePlaylistRoom makes room for one more item when a playlist is at its max_size, following the overflow policy of the playlist.
+// Nothing is removed when the policy cannot free enough room, ErrPlaylistFull is returned instead.
+func makePlaylistRoom(ctx context.Context, exec boil.ContextExecutor, playlistID string) error {
+	playlist, err := models.FindPlaylist(ctx, exec, playlistID)
+	if err != nil {
+		return errors.Wrap(err, "failed to find playlist")
+	}
+	if !playlist.MaxSize.Valid || playlist.MaxSize.Int64 <= 0 {
+		return nil
+	}
+
+	count, err := models.PlaylistItems(
+		qm.Where(models.PlaylistItemColumns.PlaylistID+"=?", playlistID),
+	).Count(ctx, exec)
+	if err != nil {
+		return errors.Wrap(err, "failed to count playlist items")
+	}
+	if count < playlist.MaxSize.Int64 {
+		return nil
+	}
+	toRemove := count - playlist.MaxSize.Int64 + 1
+
+	var items models.PlaylistItemSlice
+	switch playlist.OverflowPolicy {
+	case PlaylistOverflowReject:
+		return ErrPlaylistFull
+	case PlaylistOverflowDropWatched:
+		items, err = models.PlaylistItems(
+			qm.InnerJoin("videos v ON v.id = playlist_items.video_id"),
+			qm.InnerJoin("views ON views.video_id = playlist_items.video_id AND views.user_id = ?", playlist.UserID),
+			qm.Where("playlist_items.playlist_id = ?", playlist.ID),
+			qm.Where("((views.progress > 0 AND views.progress >= "+watchedProgressSQL+") OR views.hidden = 1)"),
+			qm.OrderBy("playlist_items.created_at ASC"),
+			qm.Limit(int(toRemove)),
+		).All(ctx, exec)
+	default:
+		items, err = models.PlaylistItems(
+			qm.Where(models.PlaylistItemColumns.PlaylistID+"=?", playlist.ID),
+			qm.OrderBy(models.PlaylistItemColumns.CreatedAt+" ASC"),
+			qm.Limit(int(toRemove)),
+		).All(ctx, exec)
+	}
+	if err != nil {
+		return errors.Wrap(err, "failed to find items to remove")
+	}
+	if int64(len(items)) < toRemove {
+		return ErrPlaylistFull
+	}
+
+	_, err = items.DeleteAll(ctx, exec)
+	if err != nil {
+		return errors.Wrap(err, "failed to delete items")
+	}
+	return nil
 }
 
 func (c *sqliteClient) RemovePlaylistItem(ctx context.Context, playlistID, videoID string) error {
@@ -384,6 +493,99 @@ func (c *sqliteClient) CleanupExpiredPlaylistItems(ctx context.Context) (int64,
 	return totalDeleted, nil
 }
 
+func (c *sqliteClient) UpdatePlaylistLimits(ctx context.Context, playlistID string, limits PlaylistLimits) error {
+	updated, err := models.Playlists(
+		models.PlaylistWhere.ID.EQ(playlistID),
+	).UpdateAll(ctx, c.db, models.M{
+		models.PlaylistColumns.TTLDays:        limits.TTLDays,
+		models.PlaylistColumns.MaxSize:        limits.MaxSize,
+		models.PlaylistColumns.OverflowPolicy: limits.OverflowPolicy,
+	})
+	if err != nil {
+		return err
+	}
+	if updated == 0 {
+		return sql.ErrNoRows
+	}
+	return nil
+}
+
+func (c *sqliteClient) UpdatePlaylistAutoSync(ctx context.Context, playlistID string, options PlaylistAutoSyncOptions) error {
+	if options.NextSyncAt.Valid {
+		options.NextSyncAt = null.TimeFrom(options.NextSyncAt.Time.UTC())
+	}
+	updated, err := models.Playlists(
+		models.PlaylistWhere.ID.EQ(playlistID),
+	).UpdateAll(ctx, c.db, models.M{
+		models.PlaylistColumns.AutoSyncIntervalHours: options.IntervalHours,
+		models.PlaylistColumns.SyncMode:              options.Mode,
+		models.PlaylistColumns.NextSyncAt:            options.NextSyncAt,
+	})
+	if err != nil {
+		return err
+	}
+	if updated == 0 {
+		return sql.ErrNoRows
+	}
+	return nil
+}
+
+func (c *sqliteClient) UpdatePlaylistSyncResult(ctx context.Context, playlistID string, result PlaylistSyncResult) error {
+	columns := models.M{
+		models.PlaylistColumns.LastSyncError: result.LastSyncError,
+	}
+	if result.LastSyncedAt.Valid {
+		columns[models.PlaylistColumns.LastSyncedAt] = null.TimeFrom(result.LastSyncedAt.Time.UTC())
+	}
+
+	updated, err := models.Playlists(
+		models.PlaylistWhere.ID.EQ(playlistID),
+	).UpdateAll(ctx, c.db, columns)
+	if err != nil {
+		return err
+	}
+	if updated == 0 {
+		return sql.ErrNoRows
+	}
+	return nil
+}
+
+/*
+GetPlaylistsDueForSync returns imported playlists with auto sync turned on that are due for a sync, the most overdue first
+*/
+func (c *sqliteClient) GetPlaylistsDueForSync(ctx context.Context, now time.Time, limit int) ([]*models.Playlist, error) {
+	playlists, err := models.Playlists(
+		models.PlaylistWhere.AutoSyncIntervalHours.GT(0),
+		qm.Where(models.PlaylistColumns.YoutubePlaylistID+" IS NOT NULL"),
+		qm.Where(models.PlaylistColumns.NextSyncAt+" IS NOT NULL"),
+		qm.Where(models.PlaylistColumns.NextSyncAt+" <= ?", now.UTC()),
+		qm.OrderBy(models.PlaylistColumns.NextSyncAt+" ASC"),
+		qm.Limit(limit),
+	).All(ctx, c.db)
+	if err != nil {
+		return nil, err
+	}
+	return playlists, nil
+}
+
+func (c *sqliteClient) SetPlaylistNextSyncAt(ctx context.Context, playlistID string, next null.Time) error {
+	if next.Valid {
+		next = null.TimeFrom(next.Time.UTC())
+	}
+	updated, err := models.Playlists(
+		models.PlaylistWhere.ID.EQ(playlistID),
+	).UpdateAll(ctx, c.db, models.M{
+		models.PlaylistColumns.NextSyncAt: next,
+	})
+	if err != nil {
+		return err
+	}
+	if updated == 0 {
+		return sql.ErrNoRows
+	}
+	return nil
+}
+
 // Helper function to create a system playlist with defaults
 func NewWatchLaterPlaylist(userID string) *models.Playlist {
 	return &models.Playlist{
@@ -391,6 +593,6 @@ func NewWatchLaterPlaylist(userID string) *models.Playlist {
 		Slug:    "watch-later",
 		Name:    "Watch Later",
 		System:  true,
-		TTLDays: null.Int64From(30),
+		TTLDays: null.Int64From(DefaultWatchLaterTTLDays),
 	}
 }
@@ -6,9 +6,11 @@ import (
 	"testing"
 	"time"
 
+	"github.com/aarondl/null/v8"
 	"github.com/aarondl/sqlboiler/v4/boil"
 	"github.com/cufee/feedlr-yt/internal/database/models"
 	"github.com/matryer/is"
+	"github.com/pkg/errors"
 )
 
 func TestPlaylistsCRUD(t *testing.T) {
@@ -118,3 +120,167 @@ func TestPlaylistsCRUD(t *testing.T) {
 
 	t.Log("All playlist tests passed!")
 }
+
+func TestPlaylistAutoSync(t *testing.T) {
+	is := is.New(t)
+
+	dbPath := os.Getenv("DATABASE_PATH")
+	if dbPath == "" {
+		t.Skip("DATABASE_PATH not set")
+	}
+
+	c, err := NewSQLiteClient(dbPath)
+	is.NoErr(err)
+	defer c.Close()
+
+	ctx := context.Background()
+	db := c.(*sqliteClient).db
+
+	user := &models.User{
+		ID:       "test-user-playlist-auto-sync",
+		Username: "test-user-playlist-auto-sync",
+	}
+	is.NoErr(user.Insert(ctx, db, boil.Infer()))
+	defer user.Delete(ctx, db)
+
+	playlist := &models.Playlist{
+		UserID:            user.ID,
+		Slug:              "test-auto-sync",
+		Name:              "Auto Sync",
+		YoutubePlaylistID: null.StringFrom("PLtest"),
+	}
+	is.NoErr(c.CreatePlaylist(ctx, playlist))
+	defer playlist.Delete(ctx, db)
+
+	now := time.Now()
+
+	// Auto sync is off by default
+	due, err := c.GetPlaylistsDueForSync(ctx, now, 10)
+	is.NoErr(err)
+	is.True(!containsPlaylist(due, playlist.ID))
+
+	is.NoErr(c.UpdatePlaylistAutoSync(ctx, playlist.ID, PlaylistAutoSyncOptions{IntervalHours: 24, Mode: PlaylistSyncMirror, NextSyncAt: null.TimeFrom(now.Add(-time.Minute))}))
+	due, err = c.GetPlaylistsDueForSync(ctx, now, 10)
+	is.NoErr(err)
+	is.True(containsPlaylist(due, playlist.ID))
+
+	// Scheduled for later, not due
+	is.NoErr(c.SetPlaylistNextSyncAt(ctx, playlist.ID, null.TimeFrom(now.Add(24*time.Hour))))
+	due, err = c.GetPlaylistsDueForSync(ctx, now, 10)
+	is.NoErr(err)
+	is.True(!containsPlaylist(due, playlist.ID))
+
+	// A failed sync keeps the time of the last successful one
+	syncedAt := now.Add(-time.Hour).Truncate(time.Second)
+	is.NoErr(c.UpdatePlaylistSyncResult(ctx, playlist.ID, PlaylistSyncResult{LastSyncedAt: null.TimeFrom(syncedAt)}))
+	is.NoErr(c.UpdatePlaylistSyncResult(ctx, playlist.ID, PlaylistSyncResult{LastSyncError: "failed to fetch YouTube playlist"}))
+
+	retrieved, err := c.GetPlaylistByID(ctx, playlist.ID)
+	is.NoErr(err)
+	is.Equal(retrieved.AutoSyncIntervalHours, int64(24))
+	is.Equal(retrieved.SyncMode, PlaylistSyncMirror)
+	is.True(retrieved.LastSyncedAt.Valid && retrieved.LastSyncedAt.Time.Equal(syncedAt))
+	is.Equal(retrieved.LastSyncError, "failed to fetch YouTube playlist")
+
+	is.True(IsErrNotFound(c.UpdatePlaylistSyncResult(ctx, "missing-playlist", PlaylistSyncResult{})))
+}
+
+func containsPlaylist(playlists []*models.Playlist, id string) bool {
+	for _, p := range playlists {
+		if p.ID == id {
+			return true
+		}
+	}
+	return false
+}
+
+func TestPlaylistOverflowPolicies(t *testing.T) {
+	is := is.New(t)
+
+	dbPath := os.Getenv("DATABASE_PATH")
+	if dbPath == "" {
+		t.Skip("DATABASE_PATH not set")
+	}
+
+	c, err := NewSQLiteClient(dbPath)
+	is.NoErr(err)
+	defer c.Close()
+
+	ctx := context.Background()
+	db := c.(*sqliteClient).db
+
+	user := &models.User{
+		ID:       "test-user-playlist-overflow",
+		Username: "test-user-playlist-overflow",
+	}
+	is.NoErr(user.Insert(ctx, db, boil.Infer()))
+	defer user.Delete(ctx, db)
+
+	channel := &models.Channel{
+		ID:          "test-channel-playlist-overflow",
+		Title:       "Test Channel",
+		Description: "Test Description",
+	}
+	is.NoErr(channel.Insert(ctx, db, boil.Infer()))
+	defer channel.Delete(ctx, db)
+
+	videoIDs := []string{"test-overflow-a", "test-overflow-b", "test-overflow-c"}
+	for _, id := range videoIDs {
+		video := &models.Video{ID: id, Title: id, ChannelID: channel.ID, Type: "video", Duration: 600, PublishedAt: time.Now()}
+		is.NoErr(video.Insert(ctx, db, boil.Infer()))
+		defer video.Delete(ctx, db)
+	}
+
+	playlist := &models.Playlist{
+		UserID: user.ID,
+		Slug:   "test-overflow",
+		Name:   "Overflow",
+	}
+	is.NoErr(c.CreatePlaylist(ctx, playlist))
+	defer playlist.Delete(ctx, db)
+
+	retrieved, err := c.GetPlaylistByID(ctx, playlist.ID)
+	is.NoErr(err)
+	is.Equal(retrieved.OverflowPolicy, PlaylistOverflowDropOldest) // existing playlists keep dropping the oldest items
+
+	inPlaylist := func(videoID string) bool {
+		ok, err := c.IsVideoInPlaylist(ctx, playlist.ID, videoID)
+		is.NoErr(err)
+		return ok
+	}
+
+	// A full playlist rejects new items
+	is.NoErr(c.UpdatePlaylistLimits(ctx, playlist.ID, PlaylistLimits{MaxSize: null.Int64From(2), OverflowPolicy: PlaylistOverflowReject}))
+	is.NoErr(c.AddPlaylistItem(ctx, playlist.ID, videoIDs[0]))
+	is.NoErr(c.AddPlaylistItem(ctx, playlist.ID, videoIDs[1]))
+	is.True(errors.Is(c.AddPlaylistItem(ctx, playlist.ID, videoIDs[2]), ErrPlaylistFull))
+	is.True(!inPlaylist(videoIDs[2]))
+
+	// Nothing is watched yet, so there is nothing to drop
+	is.NoErr(c.UpdatePlaylistLimits(ctx, playlist.ID, PlaylistLimits{MaxSize: null.Int64From(2), OverflowPolicy: PlaylistOverflowDropWatched}))
+	is.True(errors.Is(c.AddPlaylistItem(ctx, playlist.ID, videoIDs[2]), ErrPlaylistFull))
+	is.True(inPlaylist(videoIDs[0]) && inPlaylist(videoIDs[1]))
+
+	// A started video is not watched yet
+	started := &models.View{UserID: user.ID, VideoID: videoIDs[0], Progress: 100}
+	is.NoErr(started.Insert(ctx, db, boil.Infer()))
+	defer started.Delete(ctx, db)
+	is.True(errors.Is(c.AddPlaylistItem(ctx, playlist.ID, videoIDs[2]), ErrPlaylistFull))
+
+	// The watched video makes room, even though it was added last
+	watched := &models.View{UserID: user.ID, VideoID: videoIDs[1], Progress: 590}
+	is.NoErr(watched.Insert(ctx, db, boil.Infer()))
+	defer watched.Delete(ctx, db)
+	is.NoErr(c.AddPlaylistItem(ctx, playlist.ID, videoIDs[2]))
+	is.True(inPlaylist(videoIDs[0]) && !inPlaylist(videoIDs[1]) && inPlaylist(videoIDs[2]))
+
+	// Imports are limited as well, a video that is already in the playlist is left alone
+	is.NoErr(c.UpdatePlaylistLimits(ctx, playlist.ID, PlaylistLimits{MaxSize: null.Int64From(2), OverflowPolicy: PlaylistOverflowReject}))
+	is.NoErr(c.AddPlaylistItemAtPosition(ctx, playlist.ID, videoIDs[2], 1))
+	is.True(errors.Is(c.AddPlaylistItemAtPosition(ctx, playlist.ID, videoIDs[1], 1), ErrPlaylistFull))
+	is.NoErr(c.UpdatePlaylistLimits(ctx, playlist.ID, PlaylistLimits{MaxSize: null.Int64From(2), OverflowPolicy: PlaylistOverflowDropOldest}))
+	is.NoErr(c.AddPlaylistItemAtPosition(ctx, playlist.ID, videoIDs[1], 1))
+	is.True(!inPlaylist(videoIDs[0]) && inPlaylist(videoIDs[1]) && inPlaylist(videoIDs[2]))
+
+	is.True(IsErrNotFound(c.UpdatePlaylistLimits(ctx, "missing-playlist", PlaylistLimits{OverflowPolicy: PlaylistOverflowReject})))
+}
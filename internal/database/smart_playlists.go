@@ -0,0 +1,130 @@
+package database
+
+import (
+	"context"
+	"time"
+
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+	"github.com/cufee/feedlr-yt/internal/database/models"
+	"github.com/doug-martin/goqu/v9"
+	"github.com/pkg/errors"
+)
+
+type SmartPlaylistsClient interface {
+	FindSmartPlaylistVideos(ctx context.Context, query SmartPlaylistQuery) ([]*models.Video, error)
+}
+
+/*
+SmartPlaylistQuery selects videos from the subscriptions of a user, videos the user hid are never selected
+*/
+type SmartPlaylistQuery struct {
+	UserID string
+	// ChannelIDs limits the videos to some of the subscribed channels
+	ChannelIDs    []string
+	FavoritesOnly bool
+	TypesIn       []string
+	TypesNotIn    []string
+	// Progress is "unwatched", "started" or "watched", empty selects videos regardless of progress
+	Progress       string
+	MinDuration    int64 // seconds
+	MaxDuration    int64 // seconds
+	PublishedAfter time.Time
+	// Order is "newest", "oldest", "shortest" or "longest"
+	Order string
+	Limit int
+}
+
+// A video counts as watched once the progress is within this many seconds of the end, the same buffer as Watch Later uses.
+// Videos are expected under the v alias.
+const watchedProgressSQL = `CASE WHEN v.duration > 1800 THEN v.duration - 300 WHEN v.duration > 900 THEN v.duration - 60 ELSE v.duration - 30 END`
+
+var watchedProgressExpression = goqu.L(watchedProgressSQL)
+
+/*
+FindSmartPlaylistVideos returns the videos matching a smart playlist query with their channels loaded
+*/
+func (c *sqliteClient) FindSmartPlaylistVideos(ctx context.Context, query SmartPlaylistQuery) ([]*models.Video, error) {
+	if query.UserID == "" {
+		return nil, errors.New("user id is required")
+	}
+
+	subscriptionOn := goqu.And(
+		goqu.I("s.channel_id").Eq(goqu.I("v.channel_id")),
+		goqu.I("s.user_id").Eq(query.UserID),
+	)
+	if query.FavoritesOnly {
+		subscriptionOn = subscriptionOn.Append(goqu.I("s.favorite").Eq(goqu.L("1")))
+	}
+
+	sql := goqu.From(goqu.T(models.TableNames.Videos).As("v")).
+		Select(goqu.I("v.*")).
+		Join(goqu.T(models.TableNames.Subscriptions).As("s"), goqu.On(subscriptionOn)).
+		LeftJoin(goqu.T(models.TableNames.Views).As("w"), goqu.On(
+			goqu.I("w.video_id").Eq(goqu.I("v.id")),
+			goqu.I("w.user_id").Eq(query.UserID),
+		)).
+		Where(goqu.Or(goqu.I("w.hidden").IsNull(), goqu.I("w.hidden").Eq(goqu.L("0"))))
+
+	if len(query.ChannelIDs) > 0 {
+		sql = sql.Where(goqu.I("v.channel_id").In(toAny(query.ChannelIDs)...))
+	}
+	if len(query.TypesIn) > 0 {
+		sql = sql.Where(goqu.I("v.type").In(toAny(query.TypesIn)...))
+	}
+	if len(query.TypesNotIn) > 0 {
+		sql = sql.Where(goqu.I("v.type").NotIn(toAny(query.TypesNotIn)...))
+	}
+	if query.MinDuration > 0 {
+		sql = sql.Where(goqu.I("v.duration").Gte(query.MinDuration))
+	}
+	if query.MaxDuration > 0 {
+		sql = sql.Where(goqu.I("v.duration").Lte(query.MaxDuration))
+	}
+	if !query.PublishedAfter.IsZero() {
+		sql = sql.Where(goqu.I("v.published_at").Gte(query.PublishedAfter.UTC()))
+	}
+
+	switch query.Progress {
+	case "":
+	case "unwatched":
+		sql = sql.Where(goqu.Or(goqu.I("w.progress").IsNull(), goqu.I("w.progress").Eq(0)))
+	case "started":
+		sql = sql.Where(goqu.I("w.progress").Gt(0), goqu.I("w.progress").Lt(watchedProgressExpression))
+	case "watched":
+		sql = sql.Where(goqu.I("w.progress").Gt(0), goqu.I("w.progress").Gte(watchedProgressExpression))
+	default:
+		return nil, errors.Errorf("unknown progress %q", query.Progress)
+	}
+
+	switch query.Order {
+	case "", "newest":
+		sql = sql.Order(goqu.I("v.published_at").Desc(), goqu.I("v.id").Desc())
+	case "oldest":
+		sql = sql.Order(goqu.I("v.published_at").Asc(), goqu.I("v.id").Asc())
+	case "shortest":
+		sql = sql.Order(goqu.I("v.duration").Asc(), goqu.I("v.published_at").Desc())
+	case "longest":
+		sql = sql.Order(goqu.I("v.duration").Desc(), goqu.I("v.published_at").Desc())
+	default:
+		return nil, errors.Errorf("unknown order %q", query.Order)
+	}
+	if query.Limit > 0 {
+		sql = sql.Limit(uint(query.Limit))
+	}
+
+	q, args, err := sql.Prepared(true).ToSQL()
+	if err != nil {
+		return nil, err
+	}
+	var videos []*models.Video
+	videos, err = models.Videos(qm.SQL(q, args...)).All(ctx, c.db)
+	if err != nil {
+		return nil, err
+	}
+	if len(videos) > 0 {
+		if err := (models.Video{}).L.LoadChannel(ctx, c.db, false, &videos, nil); err != nil {
+			return nil, errors.Wrap(err, "failed to load video channels")
+		}
+	}
+	return videos, nil
+}
@@ -0,0 +1,100 @@
+package database
+
+import (
+	"context"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/aarondl/null/v8"
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/cufee/feedlr-yt/internal/database/models"
+	"github.com/matryer/is"
+)
+
+func TestFindSmartPlaylistVideos(t *testing.T) {
+	is := is.New(t)
+
+	dbPath := os.Getenv("DATABASE_PATH")
+	if dbPath == "" {
+		t.Skip("DATABASE_PATH not set")
+	}
+
+	c, err := NewSQLiteClient(dbPath)
+	is.NoErr(err)
+	defer c.Close()
+
+	ctx := context.Background()
+	db := c.(*sqliteClient).db
+
+	tech := &models.Channel{ID: "test-channel-smart-tech", Title: "Tech"}
+	is.NoErr(tech.Insert(ctx, db, boil.Infer()))
+	defer tech.Delete(ctx, db)
+	other := &models.Channel{ID: "test-channel-smart-other", Title: "Other"}
+	is.NoErr(other.Insert(ctx, db, boil.Infer()))
+	defer other.Delete(ctx, db)
+
+	now := time.Now()
+	videos := []*models.Video{
+		{ID: "test-smart-new", ChannelID: tech.ID, Type: "video", Duration: 2400, PublishedAt: now.Add(-48 * time.Hour)},
+		{ID: "test-smart-started", ChannelID: tech.ID, Type: "video", Duration: 2400, PublishedAt: now.Add(-72 * time.Hour)},
+		{ID: "test-smart-watched", ChannelID: tech.ID, Type: "video", Duration: 2400, PublishedAt: now.Add(-96 * time.Hour)},
+		{ID: "test-smart-old", ChannelID: tech.ID, Type: "video", Duration: 2400, PublishedAt: now.AddDate(0, 0, -30)},
+		{ID: "test-smart-short", ChannelID: tech.ID, Type: "short", Duration: 50, PublishedAt: now.Add(-time.Hour)},
+		{ID: "test-smart-stream", ChannelID: other.ID, Type: "stream_recording", Duration: 7200, PublishedAt: now.Add(-2 * time.Hour)},
+		{ID: "test-smart-brief", ChannelID: other.ID, Type: "video", Duration: 300, PublishedAt: now.Add(-3 * time.Hour)},
+		{ID: "test-smart-hidden", ChannelID: other.ID, Type: "video", Duration: 2400, PublishedAt: now.Add(-4 * time.Hour)},
+	}
+	for _, video := range videos {
+		video.Title = "Test Video"
+		is.NoErr(video.Insert(ctx, db, boil.Infer()))
+		defer video.Delete(ctx, db)
+	}
+
+	// Deleted before the videos and channels, subscriptions and views go with the user
+	user := &models.User{ID: "test-user-smart", Username: "test-user-smart"}
+	is.NoErr(user.Insert(ctx, db, boil.Infer()))
+	defer user.Delete(ctx, db)
+
+	is.NoErr((&models.Subscription{UserID: user.ID, ChannelID: tech.ID, Favorite: true}).Insert(ctx, db, boil.Infer()))
+	is.NoErr((&models.Subscription{UserID: user.ID, ChannelID: other.ID}).Insert(ctx, db, boil.Infer()))
+
+	views := []*models.View{
+		{UserID: user.ID, VideoID: "test-smart-started", Progress: 600},
+		// Within the completion buffer of a 40 minute video
+		{UserID: user.ID, VideoID: "test-smart-watched", Progress: 2200},
+		{UserID: user.ID, VideoID: "test-smart-hidden", Hidden: null.BoolFrom(true)},
+	}
+	for _, view := range views {
+		is.NoErr(view.Insert(ctx, db, boil.Infer()))
+	}
+
+	find := func(query SmartPlaylistQuery) []string {
+		query.UserID = user.ID
+		videos, err := c.FindSmartPlaylistVideos(ctx, query)
+		is.NoErr(err)
+		var ids []string
+		for _, video := range videos {
+			is.True(video.R != nil && video.R.Channel != nil)
+			ids = append(ids, video.ID)
+		}
+		return ids
+	}
+
+	// Hidden videos are never selected
+	is.Equal(find(SmartPlaylistQuery{TypesNotIn: []string{"short"}}), []string{"test-smart-stream", "test-smart-brief", "test-smart-new", "test-smart-started", "test-smart-watched", "test-smart-old"})
+
+	is.Equal(find(SmartPlaylistQuery{TypesIn: []string{"video"}, Progress: "unwatched", MinDuration: 1800, PublishedAfter: now.AddDate(0, 0, -14)}), []string{"test-smart-new"})
+	is.Equal(find(SmartPlaylistQuery{Progress: "started"}), []string{"test-smart-started"})
+	is.Equal(find(SmartPlaylistQuery{Progress: "watched"}), []string{"test-smart-watched"})
+
+	is.Equal(find(SmartPlaylistQuery{FavoritesOnly: true, TypesIn: []string{"video"}, Order: "oldest", Limit: 2}), []string{"test-smart-old", "test-smart-watched"})
+	is.Equal(find(SmartPlaylistQuery{ChannelIDs: []string{other.ID}, Order: "shortest"}), []string{"test-smart-brief", "test-smart-stream"})
+	is.Equal(find(SmartPlaylistQuery{MaxDuration: 600, TypesNotIn: []string{"short"}}), []string{"test-smart-brief"})
+
+	// Channels the user is not subscribed to are never selected
+	is.Equal(find(SmartPlaylistQuery{ChannelIDs: []string{"test-channel-smart-unknown"}}), nil)
+
+	_, err = c.FindSmartPlaylistVideos(ctx, SmartPlaylistQuery{UserID: user.ID, Progress: "unknown"})
+	is.True(err != nil)
+}
@@ -0,0 +1,110 @@
+package database
+
+import (
+	"context"
+	"strings"
+	"time"
+)
+
+type SponsorBlockVideoSegments struct {
+	VideoID      string
+	CreatedAt    time.Time
+	UpdatedAt    time.Time
+	ExpiresAt    time.Time
+	SegmentCount int
+	Segments     string
+}
+
+type SponsorBlockSegmentsClient interface {
+	GetSponsorBlockSegments(ctx context.Context, videoIDs ...string) (map[string]*SponsorBlockVideoSegments, error)
+	UpsertSponsorBlockSegments(ctx context.Context, record *SponsorBlockVideoSegments) error
+	DeleteExpiredSponsorBlockSegments(ctx context.Context, before time.Time) (int64, error)
+}
+
+func scanSponsorBlockVideoSegments(row scanner) (*SponsorBlockVideoSegments, error) {
+	record := &SponsorBlockVideoSegments{}
+	err := row.Scan(
+		&record.VideoID,
+		&record.CreatedAt,
+		&record.UpdatedAt,
+		&record.ExpiresAt,
+		&record.SegmentCount,
+		&record.Segments,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return record, nil
+}
+
+/*
+GetSponsorBlockSegments returns cached segments keyed by video ID, expired records are included so callers can fall back to them
+*/
+func (c *sqliteClient) GetSponsorBlockSegments(ctx context.Context, videoIDs ...string) (map[string]*SponsorBlockVideoSegments, error) {
+	records := make(map[string]*SponsorBlockVideoSegments, len(videoIDs))
+	if len(videoIDs) == 0 {
+		return records, nil
+	}
+
+	args := make([]any, 0, len(videoIDs))
+	for _, id := range videoIDs {
+		args = append(args, id)
+	}
+
+	rows, err := c.db.QueryContext(
+		ctx,
+		`SELECT video_id, created_at, updated_at, expires_at, segment_count, segments
+         FROM sponsorblock_video_segments
+         WHERE video_id IN (?`+strings.Repeat(", ?", len(videoIDs)-1)+`)`,
+		args...,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		record, err := scanSponsorBlockVideoSegments(rows)
+		if err != nil {
+			return nil, err
+		}
+		records[record.VideoID] = record
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return records, nil
+}
+
+func (c *sqliteClient) UpsertSponsorBlockSegments(ctx context.Context, record *SponsorBlockVideoSegments) error {
+	now := time.Now().UTC()
+	_, err := c.db.ExecContext(
+		ctx,
+		`INSERT INTO sponsorblock_video_segments (video_id, created_at, updated_at, expires_at, segment_count, segments)
+         VALUES (?, ?, ?, ?, ?, ?)
+         ON CONFLICT(video_id) DO UPDATE SET
+           updated_at = excluded.updated_at,
+           expires_at = excluded.expires_at,
+           segment_count = excluded.segment_count,
+           segments = excluded.segments`,
+		record.VideoID,
+		now,
+		now,
+		record.ExpiresAt.UTC(),
+		record.SegmentCount,
+		record.Segments,
+	)
+	if err != nil {
+		return err
+	}
+	record.UpdatedAt = now
+	return nil
+}
+
+func (c *sqliteClient) DeleteExpiredSponsorBlockSegments(ctx context.Context, before time.Time) (int64, error) {
+	result, err := c.db.ExecContext(ctx, `DELETE FROM sponsorblock_video_segments WHERE expires_at < ?`, before.UTC())
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
@@ -0,0 +1,66 @@
+package database
+
+import (
+	"context"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/matryer/is"
+)
+
+func TestSponsorBlockSegments(t *testing.T) {
+	is := is.New(t)
+
+	dbPath := os.Getenv("DATABASE_PATH")
+	if dbPath == "" {
+		t.Skip("DATABASE_PATH not set")
+	}
+
+	c, err := NewSQLiteClient(dbPath)
+	is.NoErr(err)
+	defer c.Close()
+
+	ctx := context.Background()
+
+	err = c.UpsertSponsorBlockSegments(ctx, &SponsorBlockVideoSegments{
+		VideoID:      "test-video-sb-fresh",
+		ExpiresAt:    time.Now().Add(time.Hour),
+		SegmentCount: 1,
+		Segments:     `[{"segment":[1,2],"UUID":"a","category":"sponsor"}]`,
+	})
+	is.NoErr(err)
+	err = c.UpsertSponsorBlockSegments(ctx, &SponsorBlockVideoSegments{
+		VideoID:   "test-video-sb-expired",
+		ExpiresAt: time.Now().Add(-48 * time.Hour),
+		Segments:  "[]",
+	})
+	is.NoErr(err)
+
+	records, err := c.GetSponsorBlockSegments(ctx, "test-video-sb-fresh", "test-video-sb-expired", "test-video-sb-missing")
+	is.NoErr(err)
+	is.Equal(len(records), 2)
+	is.Equal(records["test-video-sb-fresh"].SegmentCount, 1)
+	is.True(records["test-video-sb-expired"].ExpiresAt.Before(time.Now()))
+
+	// Upserts replace the cached payload
+	err = c.UpsertSponsorBlockSegments(ctx, &SponsorBlockVideoSegments{
+		VideoID:   "test-video-sb-fresh",
+		ExpiresAt: time.Now().Add(2 * time.Hour),
+		Segments:  "[]",
+	})
+	is.NoErr(err)
+
+	deleted, err := c.DeleteExpiredSponsorBlockSegments(ctx, time.Now().Add(-24*time.Hour))
+	is.NoErr(err)
+	is.Equal(deleted, int64(1))
+
+	records, err = c.GetSponsorBlockSegments(ctx, "test-video-sb-fresh", "test-video-sb-expired")
+	is.NoErr(err)
+	is.Equal(len(records), 1)
+	is.Equal(records["test-video-sb-fresh"].SegmentCount, 0)
+	is.Equal(records["test-video-sb-fresh"].Segments, "[]")
+
+	_, err = c.DeleteExpiredSponsorBlockSegments(ctx, time.Now().Add(24*time.Hour))
+	is.NoErr(err)
+}
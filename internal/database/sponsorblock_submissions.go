@@ -0,0 +1,175 @@
+package database
+
+import (
+	"context"
+	"time"
+
+	"github.com/lucsky/cuid"
+)
+
+const (
+	SponsorBlockSubmissionKindSegment = "segment"
+	SponsorBlockSubmissionKindVote    = "vote"
+
+	SponsorBlockSubmissionStatusPending   = "pending"
+	SponsorBlockSubmissionStatusSubmitted = "submitted"
+	SponsorBlockSubmissionStatusFailed    = "failed"
+)
+
+type SponsorBlockSubmission struct {
+	ID            string
+	CreatedAt     time.Time
+	UpdatedAt     time.Time
+	UserID        string
+	VideoID       string
+	Kind          string
+	Payload       string
+	Status        string
+	Attempts      int
+	NextAttemptAt time.Time
+	LastError     string
+	Result        string
+}
+
+type SponsorBlockSubmissionsClient interface {
+	CreateSponsorBlockSubmission(ctx context.Context, submission *SponsorBlockSubmission) error
+	GetDueSponsorBlockSubmissions(ctx context.Context, before time.Time, limit int) ([]*SponsorBlockSubmission, error)
+	GetUserSponsorBlockSubmissions(ctx context.Context, userID, videoID string) ([]*SponsorBlockSubmission, error)
+	UpdateSponsorBlockSubmission(ctx context.Context, submission *SponsorBlockSubmission) error
+}
+
+const sponsorBlockSubmissionColumns = `id, created_at, updated_at, user_id, video_id, kind, payload, status, attempts, next_attempt_at, last_error, result`
+
+func scanSponsorBlockSubmission(row scanner) (*SponsorBlockSubmission, error) {
+	submission := &SponsorBlockSubmission{}
+	err := row.Scan(
+		&submission.ID,
+		&submission.CreatedAt,
+		&submission.UpdatedAt,
+		&submission.UserID,
+		&submission.VideoID,
+		&submission.Kind,
+		&submission.Payload,
+		&submission.Status,
+		&submission.Attempts,
+		&submission.NextAttemptAt,
+		&submission.LastError,
+		&submission.Result,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return submission, nil
+}
+
+func (c *sqliteClient) querySponsorBlockSubmissions(ctx context.Context, query string, args ...any) ([]*SponsorBlockSubmission, error) {
+	rows, err := c.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var submissions []*SponsorBlockSubmission
+	for rows.Next() {
+		submission, err := scanSponsorBlockSubmission(rows)
+		if err != nil {
+			return nil, err
+		}
+		submissions = append(submissions, submission)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return submissions, nil
+}
+
+/*
+CreateSponsorBlockSubmission queues a new submission, it is due immediately unless NextAttemptAt is set
+*/
+func (c *sqliteClient) CreateSponsorBlockSubmission(ctx context.Context, submission *SponsorBlockSubmission) error {
+	now := time.Now().UTC()
+	if submission.ID == "" {
+		submission.ID = cuid.New()
+	}
+	if submission.Status == "" {
+		submission.Status = SponsorBlockSubmissionStatusPending
+	}
+	if submission.NextAttemptAt.IsZero() {
+		submission.NextAttemptAt = now
+	}
+
+	_, err := c.db.ExecContext(
+		ctx,
+		`INSERT INTO sponsorblock_submissions (`+sponsorBlockSubmissionColumns+`)
+         VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`,
+		submission.ID,
+		now,
+		now,
+		submission.UserID,
+		submission.VideoID,
+		submission.Kind,
+		submission.Payload,
+		submission.Status,
+		submission.Attempts,
+		submission.NextAttemptAt.UTC(),
+		submission.LastError,
+		submission.Result,
+	)
+	if err != nil {
+		return err
+	}
+	submission.CreatedAt = now
+	submission.UpdatedAt = now
+	return nil
+}
+
+/*
+GetDueSponsorBlockSubmissions returns pending submissions that are due for an attempt, oldest first
+*/
+func (c *sqliteClient) GetDueSponsorBlockSubmissions(ctx context.Context, before time.Time, limit int) ([]*SponsorBlockSubmission, error) {
+	return c.querySponsorBlockSubmissions(
+		ctx,
+		`SELECT `+sponsorBlockSubmissionColumns+`
+         FROM sponsorblock_submissions
+         WHERE status = ? AND next_attempt_at <= ?
+         ORDER BY next_attempt_at ASC
+         LIMIT ?`,
+		SponsorBlockSubmissionStatusPending,
+		before.UTC(),
+		limit,
+	)
+}
+
+func (c *sqliteClient) GetUserSponsorBlockSubmissions(ctx context.Context, userID, videoID string) ([]*SponsorBlockSubmission, error) {
+	return c.querySponsorBlockSubmissions(
+		ctx,
+		`SELECT `+sponsorBlockSubmissionColumns+`
+         FROM sponsorblock_submissions
+         WHERE user_id = ? AND video_id = ?
+         ORDER BY created_at DESC`,
+		userID,
+		videoID,
+	)
+}
+
+func (c *sqliteClient) UpdateSponsorBlockSubmission(ctx context.Context, submission *SponsorBlockSubmission) error {
+	now := time.Now().UTC()
+	_, err := c.db.ExecContext(
+		ctx,
+		`UPDATE sponsorblock_submissions
+         SET updated_at = ?, status = ?, attempts = ?, next_attempt_at = ?, last_error = ?, result = ?
+         WHERE id = ?`,
+		now,
+		submission.Status,
+		submission.Attempts,
+		submission.NextAttemptAt.UTC(),
+		submission.LastError,
+		submission.Result,
+		submission.ID,
+	)
+	if err != nil {
+		return err
+	}
+	submission.UpdatedAt = now
+	return nil
+}
@@ -0,0 +1,74 @@
+package database
+
+import (
+	"context"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/cufee/feedlr-yt/internal/database/models"
+	"github.com/matryer/is"
+)
+
+func TestSponsorBlockSubmissions(t *testing.T) {
+	is := is.New(t)
+
+	dbPath := os.Getenv("DATABASE_PATH")
+	if dbPath == "" {
+		t.Skip("DATABASE_PATH not set")
+	}
+
+	c, err := NewSQLiteClient(dbPath)
+	is.NoErr(err)
+	defer c.Close()
+
+	ctx := context.Background()
+	db := c.(*sqliteClient).db
+
+	user := &models.User{
+		ID:       "test-user-sb-submissions",
+		Username: "test-user-sb-submissions",
+	}
+	err = user.Insert(ctx, db, boil.Infer())
+	is.NoErr(err)
+	defer user.Delete(ctx, db)
+
+	due := &SponsorBlockSubmission{
+		UserID:  user.ID,
+		VideoID: "test-video-sb-submit",
+		Kind:    SponsorBlockSubmissionKindSegment,
+		Payload: `{"segment":[1,2],"category":"sponsor"}`,
+	}
+	is.NoErr(c.CreateSponsorBlockSubmission(ctx, due))
+	is.True(due.ID != "")
+	is.Equal(due.Status, SponsorBlockSubmissionStatusPending)
+
+	later := &SponsorBlockSubmission{
+		UserID:        user.ID,
+		VideoID:       "test-video-sb-submit",
+		Kind:          SponsorBlockSubmissionKindVote,
+		Payload:       `{"uuid":"a"}`,
+		NextAttemptAt: time.Now().Add(time.Hour),
+	}
+	is.NoErr(c.CreateSponsorBlockSubmission(ctx, later))
+
+	submissions, err := c.GetDueSponsorBlockSubmissions(ctx, time.Now().Add(time.Second), 10)
+	is.NoErr(err)
+	is.Equal(len(submissions), 1)
+	is.Equal(submissions[0].ID, due.ID)
+
+	due.Status = SponsorBlockSubmissionStatusSubmitted
+	due.Attempts = 1
+	due.Result = "uuid-1"
+	is.NoErr(c.UpdateSponsorBlockSubmission(ctx, due))
+
+	submissions, err = c.GetDueSponsorBlockSubmissions(ctx, time.Now().Add(2*time.Hour), 10)
+	is.NoErr(err)
+	is.Equal(len(submissions), 1)
+	is.Equal(submissions[0].ID, later.ID)
+
+	submissions, err = c.GetUserSponsorBlockSubmissions(ctx, user.ID, "test-video-sb-submit")
+	is.NoErr(err)
+	is.Equal(len(submissions), 2)
+}
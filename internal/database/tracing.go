@@ -0,0 +1,27 @@
+package database
+
+import (
+	"github.com/cufee/feedlr-yt/internal/tracing"
+	"go.opentelemetry.io/otel/trace"
+)
+
+//go:generate go run ./internal/tracegen
+
+/*
+WithTracing wraps a client so every method call is recorded as a span, the methods are generated in client_traced.go
+*/
+func WithTracing(next Client) Client {
+	return &tracedClient{next: next}
+}
+
+type tracedClient struct {
+	next Client
+}
+
+// Missing records are an expected outcome for most lookups, they do not mark the span as failed
+func endSpan(span trace.Span, err error) {
+	if IsErrNotFound(err) {
+		err = nil
+	}
+	tracing.End(span, err)
+}
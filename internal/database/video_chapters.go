@@ -0,0 +1,120 @@
+package database
+
+import (
+	"context"
+	"time"
+
+	"github.com/lucsky/cuid"
+	"github.com/pkg/errors"
+)
+
+const (
+	VideoChapterSourceDescription  = "description"
+	VideoChapterSourceSponsorBlock = "sponsorblock"
+)
+
+type VideoChapter struct {
+	ID           string
+	CreatedAt    time.Time
+	UpdatedAt    time.Time
+	VideoID      string
+	Source       string
+	Position     int
+	StartSeconds int
+	EndSeconds   int
+	Title        string
+}
+
+type VideoChaptersClient interface {
+	GetVideoChapters(ctx context.Context, videoID string) ([]*VideoChapter, error)
+	ReplaceVideoChapters(ctx context.Context, videoID, source string, chapters []*VideoChapter) error
+}
+
+func scanVideoChapter(row scanner) (*VideoChapter, error) {
+	chapter := &VideoChapter{}
+	err := row.Scan(
+		&chapter.ID,
+		&chapter.CreatedAt,
+		&chapter.UpdatedAt,
+		&chapter.VideoID,
+		&chapter.Source,
+		&chapter.Position,
+		&chapter.StartSeconds,
+		&chapter.EndSeconds,
+		&chapter.Title,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return chapter, nil
+}
+
+/*
+GetVideoChapters returns all stored chapters for a video, grouped by source and ordered by position
+*/
+func (c *sqliteClient) GetVideoChapters(ctx context.Context, videoID string) ([]*VideoChapter, error) {
+	rows, err := c.db.QueryContext(
+		ctx,
+		`SELECT id, created_at, updated_at, video_id, source, position, start_seconds, end_seconds, title
+         FROM video_chapters
+         WHERE video_id = ?
+         ORDER BY source ASC, position ASC`,
+		videoID,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var chapters []*VideoChapter
+	for rows.Next() {
+		chapter, err := scanVideoChapter(rows)
+		if err != nil {
+			return nil, err
+		}
+		chapters = append(chapters, chapter)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return chapters, nil
+}
+
+/*
+ReplaceVideoChapters atomically swaps all chapters of a video from a given source. Passing no chapters clears the source.
+*/
+func (c *sqliteClient) ReplaceVideoChapters(ctx context.Context, videoID, source string, chapters []*VideoChapter) error {
+	tx, err := c.db.BeginTx(ctx, nil)
+	if err != nil {
+		return errors.Wrap(err, "failed to begin transaction")
+	}
+	defer tx.Rollback()
+
+	_, err = tx.ExecContext(ctx, `DELETE FROM video_chapters WHERE video_id = ? AND source = ?`, videoID, source)
+	if err != nil {
+		return errors.Wrap(err, "failed to delete existing chapters")
+	}
+
+	now := time.Now().UTC()
+	for i, chapter := range chapters {
+		_, err := tx.ExecContext(
+			ctx,
+			`INSERT INTO video_chapters (id, created_at, updated_at, video_id, source, position, start_seconds, end_seconds, title)
+             VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?)`,
+			cuid.New(),
+			now,
+			now,
+			videoID,
+			source,
+			i,
+			chapter.StartSeconds,
+			chapter.EndSeconds,
+			chapter.Title,
+		)
+		if err != nil {
+			return errors.Wrap(err, "failed to insert chapter")
+		}
+	}
+
+	return tx.Commit()
+}
@@ -0,0 +1,75 @@
+package database
+
+import (
+	"context"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/cufee/feedlr-yt/internal/database/models"
+	"github.com/matryer/is"
+)
+
+func TestReplaceVideoChapters(t *testing.T) {
+	is := is.New(t)
+
+	dbPath := os.Getenv("DATABASE_PATH")
+	if dbPath == "" {
+		t.Skip("DATABASE_PATH not set")
+	}
+
+	c, err := NewSQLiteClient(dbPath)
+	is.NoErr(err)
+	defer c.Close()
+
+	ctx := context.Background()
+	db := c.(*sqliteClient).db
+
+	channel := &models.Channel{
+		ID:    "test-channel-chapters",
+		Title: "Test Channel",
+	}
+	err = channel.Insert(ctx, db, boil.Infer())
+	is.NoErr(err)
+	defer channel.Delete(ctx, db)
+
+	video := &models.Video{
+		ID:          "test-video-chapters",
+		Title:       "Test Video",
+		Duration:    300,
+		ChannelID:   channel.ID,
+		Type:        "video",
+		PublishedAt: time.Now(),
+	}
+	err = video.Insert(ctx, db, boil.Infer())
+	is.NoErr(err)
+	defer video.Delete(ctx, db)
+
+	err = c.ReplaceVideoChapters(ctx, video.ID, VideoChapterSourceDescription, []*VideoChapter{
+		{Title: "Intro", StartSeconds: 0, EndSeconds: 60},
+		{Title: "Main", StartSeconds: 60, EndSeconds: 300},
+	})
+	is.NoErr(err)
+	err = c.ReplaceVideoChapters(ctx, video.ID, VideoChapterSourceSponsorBlock, []*VideoChapter{
+		{Title: "Community", StartSeconds: 0, EndSeconds: 300},
+	})
+	is.NoErr(err)
+
+	chapters, err := c.GetVideoChapters(ctx, video.ID)
+	is.NoErr(err)
+	is.Equal(len(chapters), 3)
+	is.Equal(chapters[0].Source, VideoChapterSourceDescription)
+	is.Equal(chapters[0].Title, "Intro")
+	is.Equal(chapters[1].Position, 1)
+	is.Equal(chapters[2].Source, VideoChapterSourceSponsorBlock)
+
+	// Replacing a source only touches chapters from that source
+	err = c.ReplaceVideoChapters(ctx, video.ID, VideoChapterSourceDescription, nil)
+	is.NoErr(err)
+
+	chapters, err = c.GetVideoChapters(ctx, video.ID)
+	is.NoErr(err)
+	is.Equal(len(chapters), 1)
+	is.Equal(chapters[0].Title, "Community")
+}
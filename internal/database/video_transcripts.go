@@ -0,0 +1,264 @@
+package database
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/lucsky/cuid"
+	"github.com/pkg/errors"
+)
+
+type VideoTranscript struct {
+	ID            string
+	CreatedAt     time.Time
+	UpdatedAt     time.Time
+	VideoID       string
+	Language      string
+	Name          string
+	AutoGenerated bool
+	CueCount      int
+	Content       []byte
+}
+
+type VideoTranscriptSearchResult struct {
+	VideoID      string
+	TranscriptID string
+	Language     string
+	StartSeconds int
+}
+
+type VideoTranscriptsClient interface {
+	GetVideoTranscripts(ctx context.Context, videoID string) ([]*VideoTranscript, error)
+	UpsertVideoTranscript(ctx context.Context, transcript *VideoTranscript, terms map[string]int) error
+	SetVideoTranscriptCheck(ctx context.Context, videoID string, trackCount int) error
+	GetVideosMissingTranscripts(ctx context.Context, recheckSince, recheckBefore time.Time, limit int) ([]string, error)
+	SearchVideoTranscripts(ctx context.Context, userID string, terms []string, limit int) ([]VideoTranscriptSearchResult, error)
+}
+
+func scanVideoTranscript(row scanner) (*VideoTranscript, error) {
+	transcript := &VideoTranscript{}
+	err := row.Scan(
+		&transcript.ID,
+		&transcript.CreatedAt,
+		&transcript.UpdatedAt,
+		&transcript.VideoID,
+		&transcript.Language,
+		&transcript.Name,
+		&transcript.AutoGenerated,
+		&transcript.CueCount,
+		&transcript.Content,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return transcript, nil
+}
+
+/*
+GetVideoTranscripts returns all stored transcripts for a video, creator-provided tracks first
+*/
+func (c *sqliteClient) GetVideoTranscripts(ctx context.Context, videoID string) ([]*VideoTranscript, error) {
+	rows, err := c.db.QueryContext(
+		ctx,
+		`SELECT id, created_at, updated_at, video_id, language, name, auto_generated, cue_count, content
+         FROM video_transcripts
+         WHERE video_id = ?
+         ORDER BY auto_generated ASC, language ASC`,
+		videoID,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var transcripts []*VideoTranscript
+	for rows.Next() {
+		transcript, err := scanVideoTranscript(rows)
+		if err != nil {
+			return nil, err
+		}
+		transcripts = append(transcripts, transcript)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return transcripts, nil
+}
+
+/*
+UpsertVideoTranscript saves a transcript for a video and language, replacing the search terms indexed for it.
+Terms are mapped to the first second they are spoken at.
+*/
+func (c *sqliteClient) UpsertVideoTranscript(ctx context.Context, transcript *VideoTranscript, terms map[string]int) error {
+	if transcript == nil || transcript.VideoID == "" || transcript.Language == "" {
+		return errors.New("transcript video id and language are required")
+	}
+
+	tx, err := c.db.BeginTx(ctx, nil)
+	if err != nil {
+		return errors.Wrap(err, "failed to begin transaction")
+	}
+	defer tx.Rollback()
+
+	now := time.Now().UTC()
+	_, err = tx.ExecContext(
+		ctx,
+		`INSERT INTO video_transcripts (id, created_at, updated_at, video_id, language, name, auto_generated, cue_count, content)
+         VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?)
+         ON CONFLICT(video_id, language) DO UPDATE SET
+           updated_at = excluded.updated_at,
+           name = excluded.name,
+           auto_generated = excluded.auto_generated,
+           cue_count = excluded.cue_count,
+           content = excluded.content`,
+		cuid.New(),
+		now,
+		now,
+		transcript.VideoID,
+		transcript.Language,
+		transcript.Name,
+		transcript.AutoGenerated,
+		transcript.CueCount,
+		transcript.Content,
+	)
+	if err != nil {
+		return errors.Wrap(err, "failed to upsert transcript")
+	}
+
+	err = tx.QueryRowContext(
+		ctx,
+		`SELECT id, created_at FROM video_transcripts WHERE video_id = ? AND language = ?`,
+		transcript.VideoID,
+		transcript.Language,
+	).Scan(&transcript.ID, &transcript.CreatedAt)
+	if err != nil {
+		return errors.Wrap(err, "failed to get upserted transcript")
+	}
+	transcript.UpdatedAt = now
+
+	_, err = tx.ExecContext(ctx, `DELETE FROM video_transcript_terms WHERE transcript_id = ?`, transcript.ID)
+	if err != nil {
+		return errors.Wrap(err, "failed to delete existing transcript terms")
+	}
+
+	stmt, err := tx.PrepareContext(ctx, `INSERT INTO video_transcript_terms (term, transcript_id, start_seconds) VALUES (?, ?, ?)`)
+	if err != nil {
+		return errors.Wrap(err, "failed to prepare transcript terms insert")
+	}
+	defer stmt.Close()
+
+	for term, start := range terms {
+		if _, err := stmt.ExecContext(ctx, term, transcript.ID, start); err != nil {
+			return errors.Wrap(err, "failed to insert transcript term")
+		}
+	}
+
+	return tx.Commit()
+}
+
+/*
+SetVideoTranscriptCheck records when caption tracks were last looked up for a video
+*/
+func (c *sqliteClient) SetVideoTranscriptCheck(ctx context.Context, videoID string, trackCount int) error {
+	_, err := c.db.ExecContext(
+		ctx,
+		`INSERT INTO video_transcript_checks (video_id, checked_at, track_count)
+         VALUES (?, ?, ?)
+         ON CONFLICT(video_id) DO UPDATE SET
+           checked_at = excluded.checked_at,
+           track_count = excluded.track_count`,
+		videoID,
+		time.Now().UTC(),
+		trackCount,
+	)
+	return err
+}
+
+/*
+GetVideosMissingTranscripts returns videos from channels with transcripts enabled on at least one subscription that have no stored transcripts.
+Videos that were never checked are always returned, videos published after recheckSince are checked again once their last check is older than recheckBefore.
+*/
+func (c *sqliteClient) GetVideosMissingTranscripts(ctx context.Context, recheckSince, recheckBefore time.Time, limit int) ([]string, error) {
+	rows, err := c.db.QueryContext(
+		ctx,
+		`SELECT v.id
+         FROM videos v
+         LEFT JOIN video_transcript_checks c ON c.video_id = v.id
+         WHERE v.channel_id IN (SELECT channel_id FROM subscriptions WHERE transcripts_enabled = 1)
+           AND v.type IN ('video', 'stream_recording')
+           AND v.private = 0
+           AND NOT EXISTS (SELECT 1 FROM video_transcripts t WHERE t.video_id = v.id)
+           AND (c.video_id IS NULL OR (v.published_at > ? AND c.checked_at < ?))
+         ORDER BY v.published_at DESC
+         LIMIT ?`,
+		recheckSince.UTC(),
+		recheckBefore.UTC(),
+		limit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var ids []string
+	for rows.Next() {
+		var id string
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		ids = append(ids, id)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+/*
+SearchVideoTranscripts returns transcripts of videos from the user's subscriptions that contain all of the terms, most recent videos first.
+StartSeconds is the earliest point where any of the terms is spoken.
+*/
+func (c *sqliteClient) SearchVideoTranscripts(ctx context.Context, userID string, terms []string, limit int) ([]VideoTranscriptSearchResult, error) {
+	if len(terms) == 0 {
+		return nil, nil
+	}
+
+	args := []any{userID}
+	for _, term := range terms {
+		args = append(args, term)
+	}
+	args = append(args, len(terms), limit)
+
+	rows, err := c.db.QueryContext(
+		ctx,
+		`SELECT t.video_id, t.id, t.language, MIN(tt.start_seconds)
+         FROM video_transcript_terms tt
+         JOIN video_transcripts t ON t.id = tt.transcript_id
+         JOIN videos v ON v.id = t.video_id
+         JOIN subscriptions s ON s.channel_id = v.channel_id AND s.user_id = ?
+         WHERE tt.term IN (?`+strings.Repeat(", ?", len(terms)-1)+`)
+         GROUP BY t.id
+         HAVING COUNT(DISTINCT tt.term) = ?
+         ORDER BY v.published_at DESC, t.auto_generated ASC
+         LIMIT ?`,
+		args...,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var results []VideoTranscriptSearchResult
+	for rows.Next() {
+		var result VideoTranscriptSearchResult
+		if err := rows.Scan(&result.VideoID, &result.TranscriptID, &result.Language, &result.StartSeconds); err != nil {
+			return nil, err
+		}
+		results = append(results, result)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return results, nil
+}
@@ -0,0 +1,123 @@
+package database
+
+import (
+	"context"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/cufee/feedlr-yt/internal/database/models"
+	"github.com/matryer/is"
+)
+
+func TestVideoTranscripts(t *testing.T) {
+	is := is.New(t)
+
+	dbPath := os.Getenv("DATABASE_PATH")
+	if dbPath == "" {
+		t.Skip("DATABASE_PATH not set")
+	}
+
+	c, err := NewSQLiteClient(dbPath)
+	is.NoErr(err)
+	defer c.Close()
+
+	ctx := context.Background()
+	db := c.(*sqliteClient).db
+
+	user := &models.User{Username: "test-user-transcripts"}
+	err = user.Insert(ctx, db, boil.Infer())
+	is.NoErr(err)
+	defer user.Delete(ctx, db)
+
+	channel := &models.Channel{
+		ID:    "test-channel-transcripts",
+		Title: "Test Channel",
+	}
+	err = channel.Insert(ctx, db, boil.Infer())
+	is.NoErr(err)
+	defer channel.Delete(ctx, db)
+
+	video := &models.Video{
+		ID:          "test-video-transcripts",
+		Title:       "Test Video",
+		Duration:    300,
+		ChannelID:   channel.ID,
+		Type:        "video",
+		PublishedAt: time.Now(),
+	}
+	err = video.Insert(ctx, db, boil.Infer())
+	is.NoErr(err)
+	defer video.Delete(ctx, db)
+
+	sub, err := c.NewSubscription(ctx, user.ID, channel.ID)
+	is.NoErr(err)
+
+	// Transcripts are only fetched for channels where a subscription opted in
+	missing, err := c.GetVideosMissingTranscripts(ctx, time.Now().Add(-time.Hour), time.Now(), 10)
+	is.NoErr(err)
+	is.True(!containsString(missing, video.ID))
+
+	sub.TranscriptsEnabled = true
+	err = c.UpdateSubscription(ctx, sub)
+	is.NoErr(err)
+
+	missing, err = c.GetVideosMissingTranscripts(ctx, time.Now().Add(-time.Hour), time.Now().Add(-time.Hour), 10)
+	is.NoErr(err)
+	is.True(containsString(missing, video.ID))
+
+	// A recent check skips the video until the recheck window passes
+	err = c.SetVideoTranscriptCheck(ctx, video.ID, 0)
+	is.NoErr(err)
+	missing, err = c.GetVideosMissingTranscripts(ctx, time.Now().Add(-time.Hour), time.Now().Add(-time.Hour), 10)
+	is.NoErr(err)
+	is.True(!containsString(missing, video.ID))
+
+	transcript := &VideoTranscript{
+		VideoID:  video.ID,
+		Language: "en",
+		Name:     "English",
+		CueCount: 2,
+		Content:  []byte{0x1f, 0x8b},
+	}
+	err = c.UpsertVideoTranscript(ctx, transcript, map[string]int{"hello": 3, "world": 10})
+	is.NoErr(err)
+	is.True(transcript.ID != "")
+
+	// Upserting the same language keeps the record and replaces the terms
+	firstID := transcript.ID
+	transcript.CueCount = 3
+	err = c.UpsertVideoTranscript(ctx, transcript, map[string]int{"hello": 5, "there": 12})
+	is.NoErr(err)
+	is.Equal(transcript.ID, firstID)
+
+	stored, err := c.GetVideoTranscripts(ctx, video.ID)
+	is.NoErr(err)
+	is.Equal(len(stored), 1)
+	is.Equal(stored[0].CueCount, 3)
+	is.Equal(stored[0].Content, []byte{0x1f, 0x8b})
+
+	results, err := c.SearchVideoTranscripts(ctx, user.ID, []string{"hello", "there"}, 10)
+	is.NoErr(err)
+	is.Equal(len(results), 1)
+	is.Equal(results[0].VideoID, video.ID)
+	is.Equal(results[0].StartSeconds, 5)
+
+	results, err = c.SearchVideoTranscripts(ctx, user.ID, []string{"world"}, 10)
+	is.NoErr(err)
+	is.Equal(len(results), 0)
+
+	results, err = c.SearchVideoTranscripts(ctx, "another-user", []string{"hello"}, 10)
+	is.NoErr(err)
+	is.Equal(len(results), 0)
+}
+
+func containsString(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
@@ -2,12 +2,14 @@ package database
 
 import (
 	"context"
+	"time"
 
 	"github.com/aarondl/sqlboiler/v4/boil"
 	"github.com/aarondl/sqlboiler/v4/queries/qm"
 	"github.com/cufee/feedlr-yt/internal/database/models"
 	"github.com/doug-martin/goqu/v9"
 	"github.com/huandu/go-sqlbuilder"
+	"github.com/lucsky/cuid"
 	"github.com/pkg/errors"
 )
 
@@ -174,6 +176,7 @@ type ViewsClient interface {
 	GetUserViews(ctx context.Context, userID string, videoID ...string) ([]*models.View, error)
 	GetRecentUserViews(ctx context.Context, userID string, limit int) ([]*models.View, error)
 	UpsertView(ctx context.Context, view *models.View) error
+	InsertViews(ctx context.Context, views ...*models.View) (int, error)
 }
 
 func (c *sqliteClient) GetUserViews(ctx context.Context, userID string, videoID ...string) ([]*models.View, error) {
@@ -197,6 +200,61 @@ func (c *sqliteClient) GetUserViews(ctx context.Context, userID string, videoID
 	return views, nil
 }
 
+/*
+InsertViews adds views that do not exist yet and returns the number of views added, existing views are left untouched.
+Timestamps set on a view are kept.
+*/
+func (c *sqliteClient) InsertViews(ctx context.Context, views ...*models.View) (int, error) {
+	if len(views) == 0 {
+		return 0, nil
+	}
+
+	tx, err := c.db.BeginTx(ctx, nil)
+	if err != nil {
+		return 0, errors.Wrap(err, "failed to begin transaction")
+	}
+	defer tx.Rollback()
+
+	now := time.Now().UTC()
+	inserted := 0
+	for _, view := range views {
+		createdAt, updatedAt := view.CreatedAt, view.UpdatedAt
+		if createdAt.IsZero() {
+			createdAt = now
+		}
+		if updatedAt.IsZero() {
+			updatedAt = createdAt
+		}
+
+		result, err := tx.ExecContext(
+			ctx,
+			`INSERT INTO views (id, created_at, updated_at, user_id, video_id, progress, hidden)
+             VALUES (?, ?, ?, ?, ?, ?, ?)
+             ON CONFLICT (video_id, user_id) DO NOTHING`,
+			cuid.New(),
+			createdAt.UTC(),
+			updatedAt.UTC(),
+			view.UserID,
+			view.VideoID,
+			view.Progress,
+			view.Hidden.Bool,
+		)
+		if err != nil {
+			return 0, errors.Wrap(err, "failed to insert view")
+		}
+		affected, err := result.RowsAffected()
+		if err != nil {
+			return 0, err
+		}
+		inserted += int(affected)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return 0, err
+	}
+	return inserted, nil
+}
+
 func (c *sqliteClient) GetRecentUserViews(ctx context.Context, userID string, limit int) ([]*models.View, error) {
 	sql := sqlbuilder.
 		Select("*").
@@ -0,0 +1,125 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/aarondl/null/v8"
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+	"github.com/cufee/feedlr-yt/internal/database/models"
+	"github.com/pkg/errors"
+)
+
+const (
+	WatchHistoryImportQueued  = "queued"
+	WatchHistoryImportRunning = "running"
+	WatchHistoryImportDone    = "done"
+	WatchHistoryImportFailed  = "failed"
+)
+
+/*
+WatchHistoryImportsClient stores Google Takeout watch history imports. The parsed entries are kept on the import
+until it finishes, the progress counters are updated as the entries are processed.
+*/
+type WatchHistoryImportsClient interface {
+	CreateWatchHistoryImport(ctx context.Context, record *models.WatchHistoryImport) error
+	GetWatchHistoryImport(ctx context.Context, id string) (*models.WatchHistoryImport, error)
+	GetLatestWatchHistoryImport(ctx context.Context, userID string) (*models.WatchHistoryImport, error)
+	UpdateWatchHistoryImportProgress(ctx context.Context, id string, progress WatchHistoryImportProgress) error
+	FinishWatchHistoryImport(ctx context.Context, id, status, lastError string) error
+	DeleteWatchHistoryImport(ctx context.Context, id string) error
+}
+
+type WatchHistoryImportProgress struct {
+	Processed int64
+	Imported  int64
+	Skipped   int64
+	Failed    int64
+	LastError string
+}
+
+func (c *sqliteClient) CreateWatchHistoryImport(ctx context.Context, record *models.WatchHistoryImport) error {
+	if record == nil {
+		return errors.New("import is required")
+	}
+	return record.Insert(ctx, c.db, boil.Infer())
+}
+
+func (c *sqliteClient) GetWatchHistoryImport(ctx context.Context, id string) (*models.WatchHistoryImport, error) {
+	record, err := models.FindWatchHistoryImport(ctx, c.db, id)
+	if err != nil {
+		return nil, err
+	}
+	return record, nil
+}
+
+/*
+GetLatestWatchHistoryImport returns the last import started by a user, the entries are not loaded
+*/
+func (c *sqliteClient) GetLatestWatchHistoryImport(ctx context.Context, userID string) (*models.WatchHistoryImport, error) {
+	columns := models.WatchHistoryImportColumns
+	record, err := models.WatchHistoryImports(
+		qm.Select(columns.ID, columns.CreatedAt, columns.UpdatedAt, columns.UserID, columns.Hide, columns.Status, columns.Total,
+			columns.Processed, columns.Imported, columns.Skipped, columns.Failed, columns.LastError, columns.FinishedAt),
+		models.WatchHistoryImportWhere.UserID.EQ(userID),
+		qm.OrderBy(columns.CreatedAt+" DESC"),
+	).One(ctx, c.db)
+	if err != nil {
+		return nil, err
+	}
+	return record, nil
+}
+
+/*
+UpdateWatchHistoryImportProgress saves the progress of an import and marks it as running
+*/
+func (c *sqliteClient) UpdateWatchHistoryImportProgress(ctx context.Context, id string, progress WatchHistoryImportProgress) error {
+	updated, err := models.WatchHistoryImports(
+		models.WatchHistoryImportWhere.ID.EQ(id),
+	).UpdateAll(ctx, c.db, models.M{
+		models.WatchHistoryImportColumns.Status:    WatchHistoryImportRunning,
+		models.WatchHistoryImportColumns.Processed: progress.Processed,
+		models.WatchHistoryImportColumns.Imported:  progress.Imported,
+		models.WatchHistoryImportColumns.Skipped:   progress.Skipped,
+		models.WatchHistoryImportColumns.Failed:    progress.Failed,
+		models.WatchHistoryImportColumns.LastError: progress.LastError,
+		models.WatchHistoryImportColumns.UpdatedAt: time.Now().UTC(),
+	})
+	if err != nil {
+		return err
+	}
+	if updated == 0 {
+		return sql.ErrNoRows
+	}
+	return nil
+}
+
+/*
+FinishWatchHistoryImport sets the final status of an import and drops its entries
+*/
+func (c *sqliteClient) FinishWatchHistoryImport(ctx context.Context, id, status, lastError string) error {
+	now := time.Now().UTC()
+	updated, err := models.WatchHistoryImports(
+		models.WatchHistoryImportWhere.ID.EQ(id),
+	).UpdateAll(ctx, c.db, models.M{
+		models.WatchHistoryImportColumns.Status:     status,
+		models.WatchHistoryImportColumns.Entries:    "[]",
+		models.WatchHistoryImportColumns.LastError:  lastError,
+		models.WatchHistoryImportColumns.FinishedAt: null.TimeFrom(now),
+		models.WatchHistoryImportColumns.UpdatedAt:  now,
+	})
+	if err != nil {
+		return err
+	}
+	if updated == 0 {
+		return sql.ErrNoRows
+	}
+	return nil
+}
+
+func (c *sqliteClient) DeleteWatchHistoryImport(ctx context.Context, id string) error {
+	_, err := models.WatchHistoryImports(models.WatchHistoryImportWhere.ID.EQ(id)).DeleteAll(ctx, c.db)
+	return err
+}
@@ -0,0 +1,119 @@
+package database
+
+import (
+	"context"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/aarondl/null/v8"
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/cufee/feedlr-yt/internal/database/models"
+	"github.com/matryer/is"
+)
+
+func TestWatchHistoryImports(t *testing.T) {
+	is := is.New(t)
+
+	dbPath := os.Getenv("DATABASE_PATH")
+	if dbPath == "" {
+		t.Skip("DATABASE_PATH not set")
+	}
+
+	c, err := NewSQLiteClient(dbPath)
+	is.NoErr(err)
+	defer c.Close()
+
+	ctx := context.Background()
+	db := c.(*sqliteClient).db
+
+	user := &models.User{
+		ID:       "test-user-watch-history",
+		Username: "test-user-watch-history",
+	}
+	is.NoErr(user.Insert(ctx, db, boil.Infer()))
+	defer user.Delete(ctx, db)
+
+	first := &models.WatchHistoryImport{UserID: user.ID, Entries: `[{"v":"a"}]`, Status: WatchHistoryImportQueued, Total: 1}
+	is.NoErr(c.CreateWatchHistoryImport(ctx, first))
+	is.True(first.ID != "")
+	is.NoErr(c.FinishWatchHistoryImport(ctx, first.ID, WatchHistoryImportDone, ""))
+
+	second := &models.WatchHistoryImport{UserID: user.ID, Hide: true, Entries: `[{"v":"a"},{"v":"b"}]`, Status: WatchHistoryImportQueued, Total: 2, CreatedAt: time.Now().Add(time.Minute)}
+	is.NoErr(c.CreateWatchHistoryImport(ctx, second))
+	is.NoErr(c.UpdateWatchHistoryImportProgress(ctx, second.ID, WatchHistoryImportProgress{Processed: 2, Imported: 1, Skipped: 1}))
+
+	latest, err := c.GetLatestWatchHistoryImport(ctx, user.ID)
+	is.NoErr(err)
+	is.Equal(latest.ID, second.ID)
+	is.Equal(latest.Status, WatchHistoryImportRunning)
+	is.Equal(latest.Processed, int64(2))
+	is.True(latest.Hide)
+
+	// Finished imports drop their entries
+	finished, err := c.GetWatchHistoryImport(ctx, first.ID)
+	is.NoErr(err)
+	is.Equal(finished.Entries, "[]")
+	is.True(finished.FinishedAt.Valid)
+
+	is.NoErr(c.DeleteWatchHistoryImport(ctx, second.ID))
+	_, err = c.GetWatchHistoryImport(ctx, second.ID)
+	is.True(IsErrNotFound(err))
+}
+
+func TestInsertViews(t *testing.T) {
+	is := is.New(t)
+
+	dbPath := os.Getenv("DATABASE_PATH")
+	if dbPath == "" {
+		t.Skip("DATABASE_PATH not set")
+	}
+
+	c, err := NewSQLiteClient(dbPath)
+	is.NoErr(err)
+	defer c.Close()
+
+	ctx := context.Background()
+	db := c.(*sqliteClient).db
+
+	channel := &models.Channel{ID: "test-channel-insert-views", Title: "Test Channel"}
+	is.NoErr(channel.Insert(ctx, db, boil.Infer()))
+	defer channel.Delete(ctx, db)
+
+	for _, id := range []string{"test-video-insert-views-1", "test-video-insert-views-2"} {
+		video := &models.Video{ID: id, Title: "Test Video", Duration: 100, ChannelID: channel.ID, Type: "video", PublishedAt: time.Now()}
+		is.NoErr(video.Insert(ctx, db, boil.Infer()))
+		defer video.Delete(ctx, db)
+	}
+
+	// The user is deleted first, its views reference the videos
+	user := &models.User{
+		ID:       "test-user-insert-views",
+		Username: "test-user-insert-views",
+	}
+	is.NoErr(user.Insert(ctx, db, boil.Infer()))
+	defer user.Delete(ctx, db)
+
+	is.NoErr(c.UpsertView(ctx, &models.View{UserID: user.ID, VideoID: "test-video-insert-views-1", Progress: 10}))
+
+	watchedAt := time.Date(2024, 3, 2, 10, 0, 0, 0, time.UTC)
+	inserted, err := c.InsertViews(ctx,
+		&models.View{UserID: user.ID, VideoID: "test-video-insert-views-1", Progress: 100, CreatedAt: watchedAt, UpdatedAt: watchedAt},
+		&models.View{UserID: user.ID, VideoID: "test-video-insert-views-2", Progress: 100, Hidden: null.BoolFrom(true), CreatedAt: watchedAt, UpdatedAt: watchedAt},
+	)
+	is.NoErr(err)
+	is.Equal(inserted, 1)
+
+	views, err := c.GetUserViews(ctx, user.ID, "test-video-insert-views-1", "test-video-insert-views-2")
+	is.NoErr(err)
+	is.Equal(len(views), 2)
+	for _, view := range views {
+		switch view.VideoID {
+		case "test-video-insert-views-1":
+			is.Equal(view.Progress, int64(10)) // existing views are left untouched
+		default:
+			is.True(view.Hidden.Bool)
+			is.True(view.UpdatedAt.Equal(watchedAt))
+		}
+	}
+}
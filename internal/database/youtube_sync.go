@@ -14,13 +14,14 @@ import (
 
 type YouTubeSyncClient interface {
 	GetYouTubeSyncAccountByUserID(ctx context.Context, userID string) (*models.YoutubeSyncAccount, error)
-	UpsertYouTubeSyncCredentials(ctx context.Context, userID string, encryptedRefreshToken []byte, secretHash string) error
+	UpsertYouTubeSyncCredentials(ctx context.Context, userID string, encryptedRefreshToken []byte, secretHash, grantedScopes string) error
 	UpdateYouTubeSyncRefreshToken(ctx context.Context, userID string, encryptedRefreshToken []byte, secretHash string) error
 	SetYouTubeSyncAccountEnabled(ctx context.Context, userID string, enabled bool) error
 	DeleteYouTubeSyncAccount(ctx context.Context, userID string) error
 	ListEnabledYouTubeSyncAccounts(ctx context.Context, limit int) ([]*models.YoutubeSyncAccount, error)
-	UpdateYouTubeSyncPlaylistID(ctx context.Context, userID, playlistID string) error
 	UpdateYouTubeSyncRunResult(ctx context.Context, userID string, result YouTubeSyncRunResult) error
+	ListYouTubeSyncAccountsBySecretHash(ctx context.Context, secretHash string) ([]*models.YoutubeSyncAccount, error)
+	ReplaceYouTubeSyncRefreshToken(ctx context.Context, userID string, previous, encryptedRefreshToken []byte, secretHash string) (bool, error)
 }
 
 type YouTubeSyncRunResult struct {
@@ -40,11 +41,16 @@ func (c *sqliteClient) GetYouTubeSyncAccountByUserID(ctx context.Context, userID
 	return account, nil
 }
 
-func (c *sqliteClient) UpsertYouTubeSyncCredentials(ctx context.Context, userID string, encryptedRefreshToken []byte, secretHash string) error {
+/*
+UpsertYouTubeSyncCredentials stores the refresh token of an account, grantedScopes is the space separated list of scopes
+the user consented to
+*/
+func (c *sqliteClient) UpsertYouTubeSyncCredentials(ctx context.Context, userID string, encryptedRefreshToken []byte, secretHash, grantedScopes string) error {
 	account := &models.YoutubeSyncAccount{
 		UserID:          userID,
 		RefreshTokenEnc: encryptedRefreshToken,
 		EncSecretHash:   secretHash,
+		GrantedScopes:   grantedScopes,
 		SyncEnabled:     true,
 		LastError:       "",
 	}
@@ -57,6 +63,7 @@ func (c *sqliteClient) UpsertYouTubeSyncCredentials(ctx context.Context, userID
 		boil.Whitelist(
 			models.YoutubeSyncAccountColumns.RefreshTokenEnc,
 			models.YoutubeSyncAccountColumns.EncSecretHash,
+			models.YoutubeSyncAccountColumns.GrantedScopes,
 			models.YoutubeSyncAccountColumns.SyncEnabled,
 			models.YoutubeSyncAccountColumns.LastError,
 			models.YoutubeSyncAccountColumns.UpdatedAt,
@@ -127,19 +134,37 @@ func (c *sqliteClient) ListEnabledYouTubeSyncAccounts(ctx context.Context, limit
 	return accounts, nil
 }
 
-func (c *sqliteClient) UpdateYouTubeSyncPlaylistID(ctx context.Context, userID, playlistID string) error {
+/*
+ListYouTubeSyncAccountsBySecretHash returns all accounts with a refresh token encrypted with the secret of the hash
+*/
+func (c *sqliteClient) ListYouTubeSyncAccountsBySecretHash(ctx context.Context, secretHash string) ([]*models.YoutubeSyncAccount, error) {
+	accounts, err := models.YoutubeSyncAccounts(
+		models.YoutubeSyncAccountWhere.EncSecretHash.EQ(secretHash),
+		qm.OrderBy(models.YoutubeSyncAccountColumns.ID+" ASC"),
+	).All(ctx, c.db)
+	if err != nil {
+		return nil, err
+	}
+	return accounts, nil
+}
+
+/*
+ReplaceYouTubeSyncRefreshToken swaps the encrypted refresh token only when it is still the previous value,
+it returns false when the token was changed in the meantime, for example by a token refresh.
+*/
+func (c *sqliteClient) ReplaceYouTubeSyncRefreshToken(ctx context.Context, userID string, previous, encryptedRefreshToken []byte, secretHash string) (bool, error) {
 	updated, err := models.YoutubeSyncAccounts(
 		models.YoutubeSyncAccountWhere.UserID.EQ(userID),
+		models.YoutubeSyncAccountWhere.RefreshTokenEnc.EQ(previous),
 	).UpdateAll(ctx, c.db, models.M{
-		models.YoutubeSyncAccountColumns.PlaylistID: null.StringFrom(playlistID),
+		models.YoutubeSyncAccountColumns.RefreshTokenEnc: encryptedRefreshToken,
+		models.YoutubeSyncAccountColumns.EncSecretHash:   secretHash,
+		models.YoutubeSyncAccountColumns.UpdatedAt:       time.Now().UTC(),
 	})
 	if err != nil {
-		return err
+		return false, err
 	}
-	if updated == 0 {
-		return sql.ErrNoRows
-	}
-	return nil
+	return updated > 0, nil
 }
 
 func (c *sqliteClient) UpdateYouTubeSyncRunResult(ctx context.Context, userID string, result YouTubeSyncRunResult) error {
@@ -0,0 +1,142 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/aarondl/null/v8"
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+	"github.com/cufee/feedlr-yt/internal/database/models"
+	"github.com/pkg/errors"
+)
+
+type YouTubeSyncTargetsClient interface {
+	ListYouTubeSyncTargets(ctx context.Context, userID string) ([]*models.YoutubeSyncTarget, error)
+	GetYouTubeSyncTarget(ctx context.Context, id string) (*models.YoutubeSyncTarget, error)
+	CreateYouTubeSyncTarget(ctx context.Context, target *models.YoutubeSyncTarget) error
+	UpdateYouTubeSyncTargetOptions(ctx context.Context, id string, options YouTubeSyncTargetOptions) error
+	UpdateYouTubeSyncTargetPlaylistID(ctx context.Context, id, playlistID string) error
+	UpdateYouTubeSyncTargetRunResult(ctx context.Context, id string, result YouTubeSyncTargetRunResult) error
+	DeleteYouTubeSyncTarget(ctx context.Context, id string) error
+}
+
+type YouTubeSyncTargetOptions struct {
+	Title    string
+	Size     int64
+	Ordering string
+	// Source and SourceID replace the source of the target when Source is set
+	Source   string
+	SourceID string
+}
+
+type YouTubeSyncTargetRunResult struct {
+	LastSyncedAt      null.Time
+	LastSyncAttemptAt time.Time
+	LastError         string
+}
+
+/*
+ListYouTubeSyncTargets returns all sync targets of a user, targets synced the longest time ago first
+*/
+func (c *sqliteClient) ListYouTubeSyncTargets(ctx context.Context, userID string) ([]*models.YoutubeSyncTarget, error) {
+	targets, err := models.YoutubeSyncTargets(
+		models.YoutubeSyncTargetWhere.UserID.EQ(userID),
+		qm.OrderBy(models.YoutubeSyncTargetColumns.LastSyncedAt+" ASC"),
+		qm.OrderBy(models.YoutubeSyncTargetColumns.CreatedAt+" ASC"),
+	).All(ctx, c.db)
+	if err != nil {
+		return nil, err
+	}
+	return targets, nil
+}
+
+func (c *sqliteClient) GetYouTubeSyncTarget(ctx context.Context, id string) (*models.YoutubeSyncTarget, error) {
+	target, err := models.FindYoutubeSyncTarget(ctx, c.db, id)
+	if err != nil {
+		return nil, err
+	}
+	return target, nil
+}
+
+func (c *sqliteClient) CreateYouTubeSyncTarget(ctx context.Context, target *models.YoutubeSyncTarget) error {
+	if target == nil {
+		return errors.New("target is required")
+	}
+	return target.Insert(ctx, c.db, boil.Infer())
+}
+
+func (c *sqliteClient) UpdateYouTubeSyncTargetOptions(ctx context.Context, id string, options YouTubeSyncTargetOptions) error {
+	columns := models.M{
+		models.YoutubeSyncTargetColumns.Title:     options.Title,
+		models.YoutubeSyncTargetColumns.Size:      options.Size,
+		models.YoutubeSyncTargetColumns.Ordering:  options.Ordering,
+		models.YoutubeSyncTargetColumns.UpdatedAt: time.Now().UTC(),
+	}
+	if options.Source != "" {
+		columns[models.YoutubeSyncTargetColumns.Source] = options.Source
+		columns[models.YoutubeSyncTargetColumns.SourceID] = options.SourceID
+	}
+
+	updated, err := models.YoutubeSyncTargets(
+		models.YoutubeSyncTargetWhere.ID.EQ(id),
+	).UpdateAll(ctx, c.db, columns)
+	if err != nil {
+		return err
+	}
+	if updated == 0 {
+		return sql.ErrNoRows
+	}
+	return nil
+}
+
+func (c *sqliteClient) UpdateYouTubeSyncTargetPlaylistID(ctx context.Context, id, playlistID string) error {
+	updated, err := models.YoutubeSyncTargets(
+		models.YoutubeSyncTargetWhere.ID.EQ(id),
+	).UpdateAll(ctx, c.db, models.M{
+		models.YoutubeSyncTargetColumns.PlaylistID: null.StringFrom(playlistID),
+		models.YoutubeSyncTargetColumns.UpdatedAt:  time.Now().UTC(),
+	})
+	if err != nil {
+		return err
+	}
+	if updated == 0 {
+		return sql.ErrNoRows
+	}
+	return nil
+}
+
+func (c *sqliteClient) UpdateYouTubeSyncTargetRunResult(ctx context.Context, id string, result YouTubeSyncTargetRunResult) error {
+	if result.LastSyncAttemptAt.IsZero() {
+		return errors.New("last sync attempt timestamp is required")
+	}
+
+	updated, err := models.YoutubeSyncTargets(
+		models.YoutubeSyncTargetWhere.ID.EQ(id),
+	).UpdateAll(ctx, c.db, models.M{
+		models.YoutubeSyncTargetColumns.LastSyncedAt:      result.LastSyncedAt,
+		models.YoutubeSyncTargetColumns.LastSyncAttemptAt: null.TimeFrom(result.LastSyncAttemptAt.UTC()),
+		models.YoutubeSyncTargetColumns.LastError:         result.LastError,
+	})
+	if err != nil {
+		return err
+	}
+	if updated == 0 {
+		return sql.ErrNoRows
+	}
+	return nil
+}
+
+func (c *sqliteClient) DeleteYouTubeSyncTarget(ctx context.Context, id string) error {
+	deleted, err := models.YoutubeSyncTargets(
+		models.YoutubeSyncTargetWhere.ID.EQ(id),
+	).DeleteAll(ctx, c.db)
+	if err != nil {
+		return err
+	}
+	if deleted == 0 {
+		return sql.ErrNoRows
+	}
+	return nil
+}
@@ -0,0 +1,80 @@
+package database
+
+import (
+	"context"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/aarondl/null/v8"
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/cufee/feedlr-yt/internal/database/models"
+	"github.com/matryer/is"
+)
+
+func TestYouTubeSyncTargets(t *testing.T) {
+	is := is.New(t)
+
+	dbPath := os.Getenv("DATABASE_PATH")
+	if dbPath == "" {
+		t.Skip("DATABASE_PATH not set")
+	}
+
+	c, err := NewSQLiteClient(dbPath)
+	is.NoErr(err)
+	defer c.Close()
+
+	ctx := context.Background()
+	db := c.(*sqliteClient).db
+
+	user := &models.User{
+		ID:       "test-user-sync-targets",
+		Username: "test-user-sync-targets",
+	}
+	err = user.Insert(ctx, db, boil.Infer())
+	is.NoErr(err)
+	defer user.Delete(ctx, db)
+
+	is.NoErr(c.UpsertYouTubeSyncCredentials(ctx, user.ID, []byte("token"), "hash", ""))
+
+	feed := &models.YoutubeSyncTarget{UserID: user.ID, Source: "feed", Title: "Feedlr Sync", Size: 36, Ordering: "source"}
+	is.NoErr(c.CreateYouTubeSyncTarget(ctx, feed))
+	is.True(feed.ID != "")
+
+	watchLater := &models.YoutubeSyncTarget{UserID: user.ID, Source: "watch_later", Title: "Watch Later", Size: 20, Ordering: "oldest"}
+	is.NoErr(c.CreateYouTubeSyncTarget(ctx, watchLater))
+
+	// A source can only be synced to one playlist
+	is.True(c.CreateYouTubeSyncTarget(ctx, &models.YoutubeSyncTarget{UserID: user.ID, Source: "feed", Title: "Again", Size: 10, Ordering: "source"}) != nil)
+
+	is.NoErr(c.UpdateYouTubeSyncTargetPlaylistID(ctx, feed.ID, "PL-feed"))
+	is.NoErr(c.UpdateYouTubeSyncTargetRunResult(ctx, feed.ID, YouTubeSyncTargetRunResult{
+		LastSyncedAt:      null.TimeFrom(time.Now()),
+		LastSyncAttemptAt: time.Now(),
+	}))
+	is.NoErr(c.UpdateYouTubeSyncTargetOptions(ctx, watchLater.ID, YouTubeSyncTargetOptions{Title: "Later", Size: 10, Ordering: "newest"}))
+	// The feed target can switch to another source and keeps its playlist
+	is.NoErr(c.UpdateYouTubeSyncTargetOptions(ctx, feed.ID, YouTubeSyncTargetOptions{Title: "Feedlr Sync", Size: 36, Ordering: "source", Source: "playlist", SourceID: "smart-playlist"}))
+
+	// Targets that were never synced come first
+	targets, err := c.ListYouTubeSyncTargets(ctx, user.ID)
+	is.NoErr(err)
+	is.Equal(len(targets), 2)
+	is.Equal(targets[0].ID, watchLater.ID)
+	is.Equal(targets[0].Title, "Later")
+	is.Equal(targets[0].Size, int64(10))
+	is.Equal(targets[0].Ordering, "newest")
+	is.Equal(targets[1].PlaylistID.String, "PL-feed")
+	is.Equal(targets[1].Source, "playlist")
+	is.Equal(targets[1].SourceID, "smart-playlist")
+
+	is.NoErr(c.DeleteYouTubeSyncTarget(ctx, watchLater.ID))
+	_, err = c.GetYouTubeSyncTarget(ctx, watchLater.ID)
+	is.True(IsErrNotFound(err))
+
+	// Disconnecting the account removes its targets
+	is.NoErr(c.DeleteYouTubeSyncAccount(ctx, user.ID))
+	targets, err = c.ListYouTubeSyncTargets(ctx, user.ID)
+	is.NoErr(err)
+	is.Equal(len(targets), 0)
+}
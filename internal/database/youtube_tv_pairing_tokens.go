@@ -0,0 +1,72 @@
+package database
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+/*
+YouTubeTVPairingTokensClient stores short-lived tokens used by the LAN pairing helper to add screens on behalf of a user.
+Only a hash of the token is stored, each user has at most one active token.
+*/
+type YouTubeTVPairingTokensClient interface {
+	CreateYouTubeTVPairingToken(ctx context.Context, userID, tokenHash string, expiresAt time.Time) error
+	GetYouTubeTVPairingTokenUser(ctx context.Context, tokenHash string) (string, error)
+	DeleteYouTubeTVPairingTokens(ctx context.Context, userID string) error
+}
+
+/*
+CreateYouTubeTVPairingToken replaces any earlier token of the user, expired tokens of other users are cleaned up as well
+*/
+func (c *sqliteClient) CreateYouTubeTVPairingToken(ctx context.Context, userID, tokenHash string, expiresAt time.Time) error {
+	tx, err := c.db.BeginTx(ctx, nil)
+	if err != nil {
+		return errors.Wrap(err, "failed to begin transaction")
+	}
+	defer tx.Rollback()
+
+	now := time.Now().UTC()
+	_, err = tx.ExecContext(ctx, `DELETE FROM youtube_tv_pairing_tokens WHERE user_id = ? OR expires_at < ?`, userID, now)
+	if err != nil {
+		return errors.Wrap(err, "failed to delete previous pairing tokens")
+	}
+
+	_, err = tx.ExecContext(
+		ctx,
+		`INSERT INTO youtube_tv_pairing_tokens (token_hash, created_at, expires_at, user_id)
+         VALUES (?, ?, ?, ?)`,
+		tokenHash,
+		now,
+		expiresAt.UTC(),
+		userID,
+	)
+	if err != nil {
+		return errors.Wrap(err, "failed to insert pairing token")
+	}
+
+	return tx.Commit()
+}
+
+/*
+GetYouTubeTVPairingTokenUser returns the user a token was issued for, expired and unknown tokens return sql.ErrNoRows
+*/
+func (c *sqliteClient) GetYouTubeTVPairingTokenUser(ctx context.Context, tokenHash string) (string, error) {
+	var userID string
+	err := c.db.QueryRowContext(
+		ctx,
+		`SELECT user_id FROM youtube_tv_pairing_tokens WHERE token_hash = ? AND expires_at > ?`,
+		tokenHash,
+		time.Now().UTC(),
+	).Scan(&userID)
+	if err != nil {
+		return "", err
+	}
+	return userID, nil
+}
+
+func (c *sqliteClient) DeleteYouTubeTVPairingTokens(ctx context.Context, userID string) error {
+	_, err := c.db.ExecContext(ctx, `DELETE FROM youtube_tv_pairing_tokens WHERE user_id = ?`, userID)
+	return err
+}
@@ -0,0 +1,59 @@
+package database
+
+import (
+	"context"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/cufee/feedlr-yt/internal/database/models"
+	"github.com/matryer/is"
+)
+
+func TestYouTubeTVPairingTokens(t *testing.T) {
+	is := is.New(t)
+
+	dbPath := os.Getenv("DATABASE_PATH")
+	if dbPath == "" {
+		t.Skip("DATABASE_PATH not set")
+	}
+
+	c, err := NewSQLiteClient(dbPath)
+	is.NoErr(err)
+	defer c.Close()
+
+	ctx := context.Background()
+	db := c.(*sqliteClient).db
+
+	user := &models.User{
+		ID:       "test-user-tv-pairing",
+		Username: "test-user-tv-pairing",
+	}
+	err = user.Insert(ctx, db, boil.Infer())
+	is.NoErr(err)
+	defer user.Delete(ctx, db)
+
+	is.NoErr(c.CreateYouTubeTVPairingToken(ctx, user.ID, "hash-first", time.Now().Add(time.Minute)))
+	userID, err := c.GetYouTubeTVPairingTokenUser(ctx, "hash-first")
+	is.NoErr(err)
+	is.Equal(userID, user.ID)
+
+	// A new token replaces the previous one
+	is.NoErr(c.CreateYouTubeTVPairingToken(ctx, user.ID, "hash-second", time.Now().Add(time.Minute)))
+	_, err = c.GetYouTubeTVPairingTokenUser(ctx, "hash-first")
+	is.True(IsErrNotFound(err))
+	userID, err = c.GetYouTubeTVPairingTokenUser(ctx, "hash-second")
+	is.NoErr(err)
+	is.Equal(userID, user.ID)
+
+	// Expired tokens are not accepted
+	is.NoErr(c.CreateYouTubeTVPairingToken(ctx, user.ID, "hash-expired", time.Now().Add(-time.Minute)))
+	_, err = c.GetYouTubeTVPairingTokenUser(ctx, "hash-expired")
+	is.True(IsErrNotFound(err))
+
+	is.NoErr(c.CreateYouTubeTVPairingToken(ctx, user.ID, "hash-revoked", time.Now().Add(time.Minute)))
+	is.NoErr(c.DeleteYouTubeTVPairingTokens(ctx, user.ID))
+	_, err = c.GetYouTubeTVPairingTokenUser(ctx, "hash-revoked")
+	is.True(IsErrNotFound(err))
+}
@@ -31,6 +31,9 @@ type YouTubeTVSyncAccount struct {
 	LastUserActivity null.Time
 	LastVideoID      null.String
 	LastError        string
+	// DisplayName is set by the user, ScreenName is reported by the TV
+	DisplayName      string
+	SponsorBlockMode string
 }
 
 type YouTubeTVSyncStateUpdate struct {
@@ -44,17 +47,27 @@ type YouTubeTVSyncStateUpdate struct {
 	LastVideoID      null.String
 }
 
+/*
+YouTubeTVSyncClient manages paired TV screens, a user can have one account per screen.
+All methods except the listing ones address a single screen by account ID.
+*/
 type YouTubeTVSyncClient interface {
-	GetYouTubeTVSyncAccountByUserID(ctx context.Context, userID string) (*YouTubeTVSyncAccount, error)
+	GetYouTubeTVSyncAccount(ctx context.Context, id string) (*YouTubeTVSyncAccount, error)
+	ListUserYouTubeTVSyncAccounts(ctx context.Context, userID string) ([]*YouTubeTVSyncAccount, error)
 	UpsertYouTubeTVSyncCredentials(ctx context.Context, userID, screenID, screenName string, loungeTokenEnc []byte, secretHash string) error
-	UpdateYouTubeTVSyncLoungeToken(ctx context.Context, userID, screenID, screenName string, loungeTokenEnc []byte, secretHash string) error
-	SetYouTubeTVSyncAccountEnabled(ctx context.Context, userID string, enabled bool) error
-	DeleteYouTubeTVSyncAccount(ctx context.Context, userID string) error
+	UpdateYouTubeTVSyncLoungeToken(ctx context.Context, id, screenID, screenName string, loungeTokenEnc []byte, secretHash string) error
+	SetYouTubeTVSyncAccountEnabled(ctx context.Context, id string, enabled bool) error
+	UpdateYouTubeTVSyncPreferences(ctx context.Context, id, displayName, sponsorBlockMode string) error
+	DeleteYouTubeTVSyncAccount(ctx context.Context, id string) error
 	ListEnabledYouTubeTVSyncAccounts(ctx context.Context, limit int) ([]*YouTubeTVSyncAccount, error)
-	UpdateYouTubeTVSyncState(ctx context.Context, userID string, update YouTubeTVSyncStateUpdate) error
+	UpdateYouTubeTVSyncState(ctx context.Context, id string, update YouTubeTVSyncStateUpdate) error
 	GetUserLastSessionActivity(ctx context.Context, userID string) (null.Time, error)
+	ListYouTubeTVSyncAccountsBySecretHash(ctx context.Context, secretHash string) ([]*YouTubeTVSyncAccount, error)
+	ReplaceYouTubeTVSyncLoungeToken(ctx context.Context, id string, previous, loungeTokenEnc []byte, secretHash string) (bool, error)
 }
 
+const youTubeTVSyncAccountColumns = `id, created_at, updated_at, user_id, screen_id, screen_name, lounge_token_enc, enc_secret_hash, sync_enabled, connection_state, state_reason, last_connected_at, last_event_at, last_disconnect_at, last_user_activity_at, last_video_id, last_error, display_name, sponsorblock_mode`
+
 func scanYouTubeTVSyncAccount(row scanner) (*YouTubeTVSyncAccount, error) {
 	account := &YouTubeTVSyncAccount{}
 	var lastConnected sql.NullTime
@@ -81,6 +94,8 @@ func scanYouTubeTVSyncAccount(row scanner) (*YouTubeTVSyncAccount, error) {
 		&lastUserActivity,
 		&lastVideoID,
 		&account.LastError,
+		&account.DisplayName,
+		&account.SponsorBlockMode,
 	)
 	if err != nil {
 		return nil, err
@@ -94,13 +109,13 @@ func scanYouTubeTVSyncAccount(row scanner) (*YouTubeTVSyncAccount, error) {
 	return account, nil
 }
 
-func (c *sqliteClient) GetYouTubeTVSyncAccountByUserID(ctx context.Context, userID string) (*YouTubeTVSyncAccount, error) {
+func (c *sqliteClient) GetYouTubeTVSyncAccount(ctx context.Context, id string) (*YouTubeTVSyncAccount, error) {
 	row := c.db.QueryRowContext(
 		ctx,
-		`SELECT id, created_at, updated_at, user_id, screen_id, screen_name, lounge_token_enc, enc_secret_hash, sync_enabled, connection_state, state_reason, last_connected_at, last_event_at, last_disconnect_at, last_user_activity_at, last_video_id, last_error
+		`SELECT `+youTubeTVSyncAccountColumns+`
          FROM youtube_tv_sync_accounts
-         WHERE user_id = ?`,
-		userID,
+         WHERE id = ?`,
+		id,
 	)
 
 	account, err := scanYouTubeTVSyncAccount(row)
@@ -110,6 +125,35 @@ func (c *sqliteClient) GetYouTubeTVSyncAccountByUserID(ctx context.Context, user
 	return account, nil
 }
 
+func (c *sqliteClient) ListUserYouTubeTVSyncAccounts(ctx context.Context, userID string) ([]*YouTubeTVSyncAccount, error) {
+	rows, err := c.db.QueryContext(
+		ctx,
+		`SELECT `+youTubeTVSyncAccountColumns+`
+         FROM youtube_tv_sync_accounts
+         WHERE user_id = ?
+         ORDER BY created_at ASC, id ASC`,
+		userID,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var accounts []*YouTubeTVSyncAccount
+	for rows.Next() {
+		account, err := scanYouTubeTVSyncAccount(rows)
+		if err != nil {
+			return nil, err
+		}
+		accounts = append(accounts, account)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return accounts, nil
+}
+
 func (c *sqliteClient) UpsertYouTubeTVSyncCredentials(ctx context.Context, userID, screenID, screenName string, loungeTokenEnc []byte, secretHash string) error {
 	now := time.Now().UTC()
 
@@ -118,7 +162,7 @@ func (c *sqliteClient) UpsertYouTubeTVSyncCredentials(ctx context.Context, userI
 		`INSERT INTO youtube_tv_sync_accounts
         (id, created_at, updated_at, user_id, screen_id, screen_name, lounge_token_enc, enc_secret_hash, sync_enabled, connection_state, state_reason, last_connected_at, last_event_at, last_disconnect_at, last_user_activity_at, last_video_id, last_error)
          VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, NULL, NULL, NULL, NULL, NULL, '')
-         ON CONFLICT(user_id) DO UPDATE SET
+         ON CONFLICT(user_id, screen_id) DO UPDATE SET
             updated_at = excluded.updated_at,
             screen_id = excluded.screen_id,
             screen_name = excluded.screen_name,
@@ -149,15 +193,15 @@ func (c *sqliteClient) UpsertYouTubeTVSyncCredentials(ctx context.Context, userI
 	return err
 }
 
-func (c *sqliteClient) SetYouTubeTVSyncAccountEnabled(ctx context.Context, userID string, enabled bool) error {
+func (c *sqliteClient) SetYouTubeTVSyncAccountEnabled(ctx context.Context, id string, enabled bool) error {
 	result, err := c.db.ExecContext(
 		ctx,
 		`UPDATE youtube_tv_sync_accounts
          SET sync_enabled = ?, updated_at = ?
-         WHERE user_id = ?`,
+         WHERE id = ?`,
 		enabled,
 		time.Now().UTC(),
-		userID,
+		id,
 	)
 	if err != nil {
 		return err
@@ -172,7 +216,31 @@ func (c *sqliteClient) SetYouTubeTVSyncAccountEnabled(ctx context.Context, userI
 	return nil
 }
 
-func (c *sqliteClient) UpdateYouTubeTVSyncLoungeToken(ctx context.Context, userID, screenID, screenName string, loungeTokenEnc []byte, secretHash string) error {
+func (c *sqliteClient) UpdateYouTubeTVSyncPreferences(ctx context.Context, id, displayName, sponsorBlockMode string) error {
+	result, err := c.db.ExecContext(
+		ctx,
+		`UPDATE youtube_tv_sync_accounts
+         SET display_name = ?, sponsorblock_mode = ?, updated_at = ?
+         WHERE id = ?`,
+		displayName,
+		sponsorBlockMode,
+		time.Now().UTC(),
+		id,
+	)
+	if err != nil {
+		return err
+	}
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if affected == 0 {
+		return sql.ErrNoRows
+	}
+	return nil
+}
+
+func (c *sqliteClient) UpdateYouTubeTVSyncLoungeToken(ctx context.Context, id, screenID, screenName string, loungeTokenEnc []byte, secretHash string) error {
 	result, err := c.db.ExecContext(
 		ctx,
 		`UPDATE youtube_tv_sync_accounts
@@ -181,13 +249,13 @@ func (c *sqliteClient) UpdateYouTubeTVSyncLoungeToken(ctx context.Context, userI
              screen_name = ?,
              lounge_token_enc = ?,
              enc_secret_hash = ?
-         WHERE user_id = ?`,
+         WHERE id = ?`,
 		time.Now().UTC(),
 		screenID,
 		screenName,
 		loungeTokenEnc,
 		secretHash,
-		userID,
+		id,
 	)
 	if err != nil {
 		return err
@@ -202,8 +270,8 @@ func (c *sqliteClient) UpdateYouTubeTVSyncLoungeToken(ctx context.Context, userI
 	return nil
 }
 
-func (c *sqliteClient) DeleteYouTubeTVSyncAccount(ctx context.Context, userID string) error {
-	result, err := c.db.ExecContext(ctx, `DELETE FROM youtube_tv_sync_accounts WHERE user_id = ?`, userID)
+func (c *sqliteClient) DeleteYouTubeTVSyncAccount(ctx context.Context, id string) error {
+	result, err := c.db.ExecContext(ctx, `DELETE FROM youtube_tv_sync_accounts WHERE id = ?`, id)
 	if err != nil {
 		return err
 	}
@@ -224,7 +292,7 @@ func (c *sqliteClient) ListEnabledYouTubeTVSyncAccounts(ctx context.Context, lim
 
 	rows, err := c.db.QueryContext(
 		ctx,
-		`SELECT id, created_at, updated_at, user_id, screen_id, screen_name, lounge_token_enc, enc_secret_hash, sync_enabled, connection_state, state_reason, last_connected_at, last_event_at, last_disconnect_at, last_user_activity_at, last_video_id, last_error
+		`SELECT `+youTubeTVSyncAccountColumns+`
          FROM youtube_tv_sync_accounts
          WHERE sync_enabled = 1
          ORDER BY updated_at ASC
@@ -251,7 +319,66 @@ func (c *sqliteClient) ListEnabledYouTubeTVSyncAccounts(ctx context.Context, lim
 	return accounts, nil
 }
 
-func (c *sqliteClient) UpdateYouTubeTVSyncState(ctx context.Context, userID string, update YouTubeTVSyncStateUpdate) error {
+/*
+ListYouTubeTVSyncAccountsBySecretHash returns all screens with a lounge token encrypted with the secret of the hash
+*/
+func (c *sqliteClient) ListYouTubeTVSyncAccountsBySecretHash(ctx context.Context, secretHash string) ([]*YouTubeTVSyncAccount, error) {
+	rows, err := c.db.QueryContext(
+		ctx,
+		`SELECT `+youTubeTVSyncAccountColumns+`
+         FROM youtube_tv_sync_accounts
+         WHERE enc_secret_hash = ?
+         ORDER BY id ASC`,
+		secretHash,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var accounts []*YouTubeTVSyncAccount
+	for rows.Next() {
+		account, err := scanYouTubeTVSyncAccount(rows)
+		if err != nil {
+			return nil, err
+		}
+		accounts = append(accounts, account)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return accounts, nil
+}
+
+/*
+ReplaceYouTubeTVSyncLoungeToken swaps the encrypted lounge token only when it is still the previous value,
+it returns false when the token was changed in the meantime, for example by a token refresh.
+*/
+func (c *sqliteClient) ReplaceYouTubeTVSyncLoungeToken(ctx context.Context, id string, previous, loungeTokenEnc []byte, secretHash string) (bool, error) {
+	result, err := c.db.ExecContext(
+		ctx,
+		`UPDATE youtube_tv_sync_accounts
+         SET updated_at = ?,
+             lounge_token_enc = ?,
+             enc_secret_hash = ?
+         WHERE id = ? AND lounge_token_enc = ?`,
+		time.Now().UTC(),
+		loungeTokenEnc,
+		secretHash,
+		id,
+		previous,
+	)
+	if err != nil {
+		return false, err
+	}
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+	return affected > 0, nil
+}
+
+func (c *sqliteClient) UpdateYouTubeTVSyncState(ctx context.Context, id string, update YouTubeTVSyncStateUpdate) error {
 	result, err := c.db.ExecContext(
 		ctx,
 		`UPDATE youtube_tv_sync_accounts
@@ -264,7 +391,7 @@ func (c *sqliteClient) UpdateYouTubeTVSyncState(ctx context.Context, userID stri
              last_disconnect_at = COALESCE(?, last_disconnect_at),
              last_user_activity_at = COALESCE(?, last_user_activity_at),
              last_video_id = COALESCE(?, last_video_id)
-         WHERE user_id = ?`,
+         WHERE id = ?`,
 		time.Now().UTC(),
 		update.ConnectionState,
 		update.StateReason,
@@ -274,7 +401,7 @@ func (c *sqliteClient) UpdateYouTubeTVSyncState(ctx context.Context, userID stri
 		nullableTime(update.LastDisconnectAt),
 		nullableTime(update.LastUserActivity),
 		nullableString(update.LastVideoID),
-		userID,
+		id,
 	)
 	if err != nil {
 		return err
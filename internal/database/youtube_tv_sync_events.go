@@ -0,0 +1,157 @@
+package database
+
+import (
+	"context"
+	"time"
+
+	"github.com/lucsky/cuid"
+)
+
+const (
+	YouTubeTVSyncEventPaired           = "paired"
+	YouTubeTVSyncEventConnect          = "connect"
+	YouTubeTVSyncEventConnectFailed    = "connect_failed"
+	YouTubeTVSyncEventDisconnect       = "disconnect"
+	YouTubeTVSyncEventResumeSeek       = "resume_seek"
+	YouTubeTVSyncEventResumeSuppressed = "resume_suppressed"
+	YouTubeTVSyncEventSponsorSkip      = "sponsor_skip"
+	YouTubeTVSyncEventProgress         = "progress"
+	YouTubeTVSyncEventAutoplay         = "autoplay"
+)
+
+type YouTubeTVSyncEvent struct {
+	ID        string
+	CreatedAt time.Time
+	AccountID string
+	UserID    string
+	Kind      string
+	VideoID   string
+	Message   string
+
+	// ScreenName is the name set by the user, or the name reported by the TV
+	ScreenName string
+}
+
+/*
+YouTubeTVSyncEventsClient manages the per-screen TV sync journal used for diagnostics.
+Entries are deleted with the screen, PruneYouTubeTVSyncEvents keeps the journal bounded.
+*/
+type YouTubeTVSyncEventsClient interface {
+	CreateYouTubeTVSyncEvent(ctx context.Context, event *YouTubeTVSyncEvent) error
+	ListYouTubeTVSyncEvents(ctx context.Context, accountID string, limit int) ([]*YouTubeTVSyncEvent, error)
+	ListRecentYouTubeTVSyncEvents(ctx context.Context, limit int) ([]*YouTubeTVSyncEvent, error)
+	PruneYouTubeTVSyncEvents(ctx context.Context, before time.Time, keepPerAccount int) (int64, error)
+}
+
+const youTubeTVSyncEventColumns = `e.id, e.created_at, e.account_id, e.user_id, e.kind, e.video_id, e.message,
+                CASE WHEN a.display_name != '' THEN a.display_name ELSE a.screen_name END`
+
+func (c *sqliteClient) queryYouTubeTVSyncEvents(ctx context.Context, query string, args ...any) ([]*YouTubeTVSyncEvent, error) {
+	rows, err := c.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var events []*YouTubeTVSyncEvent
+	for rows.Next() {
+		event := &YouTubeTVSyncEvent{}
+		err := rows.Scan(
+			&event.ID,
+			&event.CreatedAt,
+			&event.AccountID,
+			&event.UserID,
+			&event.Kind,
+			&event.VideoID,
+			&event.Message,
+			&event.ScreenName,
+		)
+		if err != nil {
+			return nil, err
+		}
+		events = append(events, event)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return events, nil
+}
+
+func (c *sqliteClient) CreateYouTubeTVSyncEvent(ctx context.Context, event *YouTubeTVSyncEvent) error {
+	if event.ID == "" {
+		event.ID = cuid.New()
+	}
+	if event.CreatedAt.IsZero() {
+		event.CreatedAt = time.Now().UTC()
+	}
+
+	_, err := c.db.ExecContext(
+		ctx,
+		`INSERT INTO youtube_tv_sync_events (id, created_at, account_id, user_id, kind, video_id, message)
+         VALUES (?, ?, ?, ?, ?, ?, ?)`,
+		event.ID,
+		event.CreatedAt.UTC(),
+		event.AccountID,
+		event.UserID,
+		event.Kind,
+		event.VideoID,
+		event.Message,
+	)
+	return err
+}
+
+/*
+ListYouTubeTVSyncEvents returns the latest journal entries of a screen, newest first
+*/
+func (c *sqliteClient) ListYouTubeTVSyncEvents(ctx context.Context, accountID string, limit int) ([]*YouTubeTVSyncEvent, error) {
+	return c.queryYouTubeTVSyncEvents(
+		ctx,
+		`SELECT `+youTubeTVSyncEventColumns+`
+         FROM youtube_tv_sync_events e
+         JOIN youtube_tv_sync_accounts a ON a.id = e.account_id
+         WHERE e.account_id = ?
+         ORDER BY e.created_at DESC, e.id DESC
+         LIMIT ?`,
+		accountID,
+		limit,
+	)
+}
+
+/*
+ListRecentYouTubeTVSyncEvents returns the latest journal entries across all screens, newest first
+*/
+func (c *sqliteClient) ListRecentYouTubeTVSyncEvents(ctx context.Context, limit int) ([]*YouTubeTVSyncEvent, error) {
+	return c.queryYouTubeTVSyncEvents(
+		ctx,
+		`SELECT `+youTubeTVSyncEventColumns+`
+         FROM youtube_tv_sync_events e
+         JOIN youtube_tv_sync_accounts a ON a.id = e.account_id
+         ORDER BY e.created_at DESC, e.id DESC
+         LIMIT ?`,
+		limit,
+	)
+}
+
+/*
+PruneYouTubeTVSyncEvents deletes entries created before the cutoff and anything past the newest keepPerAccount entries of each screen
+*/
+func (c *sqliteClient) PruneYouTubeTVSyncEvents(ctx context.Context, before time.Time, keepPerAccount int) (int64, error) {
+	result, err := c.db.ExecContext(
+		ctx,
+		`DELETE FROM youtube_tv_sync_events
+         WHERE created_at < ?
+            OR id IN (
+                SELECT id FROM (
+                    SELECT id, ROW_NUMBER() OVER (PARTITION BY account_id ORDER BY created_at DESC, id DESC) AS position
+                    FROM youtube_tv_sync_events
+                )
+                WHERE position > ?
+            )`,
+		before.UTC(),
+		keepPerAccount,
+	)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
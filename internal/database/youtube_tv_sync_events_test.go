@@ -0,0 +1,87 @@
+package database
+
+import (
+	"context"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/cufee/feedlr-yt/internal/database/models"
+	"github.com/matryer/is"
+)
+
+func TestYouTubeTVSyncEvents(t *testing.T) {
+	is := is.New(t)
+
+	dbPath := os.Getenv("DATABASE_PATH")
+	if dbPath == "" {
+		t.Skip("DATABASE_PATH not set")
+	}
+
+	c, err := NewSQLiteClient(dbPath)
+	is.NoErr(err)
+	defer c.Close()
+
+	ctx := context.Background()
+	db := c.(*sqliteClient).db
+
+	user := &models.User{
+		ID:       "test-user-tv-events",
+		Username: "test-user-tv-events",
+	}
+	err = user.Insert(ctx, db, boil.Infer())
+	is.NoErr(err)
+	defer user.Delete(ctx, db)
+
+	is.NoErr(c.UpsertYouTubeTVSyncCredentials(ctx, user.ID, "screen-events", "Living Room TV", []byte("token"), "hash"))
+	accounts, err := c.ListUserYouTubeTVSyncAccounts(ctx, user.ID)
+	is.NoErr(err)
+	is.Equal(len(accounts), 1)
+	account := accounts[0]
+
+	now := time.Now().UTC()
+	for i, kind := range []string{YouTubeTVSyncEventConnect, YouTubeTVSyncEventProgress, YouTubeTVSyncEventSponsorSkip, YouTubeTVSyncEventDisconnect} {
+		is.NoErr(c.CreateYouTubeTVSyncEvent(ctx, &YouTubeTVSyncEvent{
+			CreatedAt: now.Add(time.Duration(i) * time.Second),
+			AccountID: account.ID,
+			UserID:    user.ID,
+			Kind:      kind,
+			VideoID:   "test-video-tv-events",
+		}))
+	}
+	is.NoErr(c.CreateYouTubeTVSyncEvent(ctx, &YouTubeTVSyncEvent{
+		CreatedAt: now.Add(-30 * 24 * time.Hour),
+		AccountID: account.ID,
+		UserID:    user.ID,
+		Kind:      YouTubeTVSyncEventConnect,
+	}))
+
+	events, err := c.ListYouTubeTVSyncEvents(ctx, account.ID, 10)
+	is.NoErr(err)
+	is.Equal(len(events), 5)
+	is.Equal(events[0].Kind, YouTubeTVSyncEventDisconnect)
+	is.Equal(events[0].ScreenName, "Living Room TV")
+
+	is.NoErr(c.UpdateYouTubeTVSyncPreferences(ctx, account.ID, "Den", "inherit"))
+	recent, err := c.ListRecentYouTubeTVSyncEvents(ctx, 1)
+	is.NoErr(err)
+	is.Equal(len(recent), 1)
+	is.Equal(recent[0].ScreenName, "Den")
+
+	// The old entry is past the cutoff and the connect entry is past the per-screen limit
+	deleted, err := c.PruneYouTubeTVSyncEvents(ctx, now.Add(-7*24*time.Hour), 3)
+	is.NoErr(err)
+	is.Equal(deleted, int64(2))
+
+	events, err = c.ListYouTubeTVSyncEvents(ctx, account.ID, 10)
+	is.NoErr(err)
+	is.Equal(len(events), 3)
+	is.Equal(events[2].Kind, YouTubeTVSyncEventProgress)
+
+	// Removing the screen removes its journal
+	is.NoErr(c.DeleteYouTubeTVSyncAccount(ctx, account.ID))
+	events, err = c.ListYouTubeTVSyncEvents(ctx, account.ID, 10)
+	is.NoErr(err)
+	is.Equal(len(events), 0)
+}
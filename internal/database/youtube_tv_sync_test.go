@@ -0,0 +1,132 @@
+package database
+
+import (
+	"context"
+	"os"
+	"testing"
+
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/cufee/feedlr-yt/internal/database/models"
+	"github.com/matryer/is"
+)
+
+func TestYouTubeTVSyncAccounts_MultipleScreens(t *testing.T) {
+	is := is.New(t)
+
+	dbPath := os.Getenv("DATABASE_PATH")
+	if dbPath == "" {
+		t.Skip("DATABASE_PATH not set")
+	}
+
+	c, err := NewSQLiteClient(dbPath)
+	is.NoErr(err)
+	defer c.Close()
+
+	ctx := context.Background()
+	db := c.(*sqliteClient).db
+
+	user := &models.User{
+		ID:       "test-user-tv-screens",
+		Username: "test-user-tv-screens",
+	}
+	err = user.Insert(ctx, db, boil.Infer())
+	is.NoErr(err)
+	defer user.Delete(ctx, db)
+
+	is.NoErr(c.UpsertYouTubeTVSyncCredentials(ctx, user.ID, "screen-living-room", "Living Room TV", []byte("token-1"), "hash"))
+	is.NoErr(c.UpsertYouTubeTVSyncCredentials(ctx, user.ID, "screen-bedroom", "Bedroom TV", []byte("token-2"), "hash"))
+
+	accounts, err := c.ListUserYouTubeTVSyncAccounts(ctx, user.ID)
+	is.NoErr(err)
+	is.Equal(len(accounts), 2)
+	is.Equal(accounts[0].SponsorBlockMode, "inherit")
+
+	var bedroom *YouTubeTVSyncAccount
+	for _, account := range accounts {
+		if account.ScreenID == "screen-bedroom" {
+			bedroom = account
+		}
+	}
+	is.True(bedroom != nil)
+
+	is.NoErr(c.UpdateYouTubeTVSyncPreferences(ctx, bedroom.ID, "Kids room", "disabled"))
+	is.NoErr(c.SetYouTubeTVSyncAccountEnabled(ctx, bedroom.ID, false))
+
+	// Pairing the same screen again keeps the account and its preferences
+	is.NoErr(c.UpsertYouTubeTVSyncCredentials(ctx, user.ID, "screen-bedroom", "Bedroom TV", []byte("token-3"), "hash"))
+	updated, err := c.GetYouTubeTVSyncAccount(ctx, bedroom.ID)
+	is.NoErr(err)
+	is.Equal(updated.DisplayName, "Kids room")
+	is.Equal(updated.SponsorBlockMode, "disabled")
+	is.Equal(string(updated.LoungeTokenEnc), "token-3")
+	is.True(updated.SyncEnabled)
+
+	// State updates address a single screen
+	is.NoErr(c.UpdateYouTubeTVSyncState(ctx, bedroom.ID, YouTubeTVSyncStateUpdate{ConnectionState: "disconnected", StateReason: "Feedlr restarted"}))
+	updated, err = c.GetYouTubeTVSyncAccount(ctx, bedroom.ID)
+	is.NoErr(err)
+	is.Equal(updated.ConnectionState, "disconnected")
+	is.Equal(updated.StateReason, "Feedlr restarted")
+	for _, account := range accounts {
+		if account.ID != bedroom.ID {
+			other, err := c.GetYouTubeTVSyncAccount(ctx, account.ID)
+			is.NoErr(err)
+			is.True(other.StateReason != "Feedlr restarted")
+		}
+	}
+
+	is.NoErr(c.DeleteYouTubeTVSyncAccount(ctx, bedroom.ID))
+	accounts, err = c.ListUserYouTubeTVSyncAccounts(ctx, user.ID)
+	is.NoErr(err)
+	is.Equal(len(accounts), 1)
+	is.Equal(accounts[0].ScreenID, "screen-living-room")
+}
+
+func TestYouTubeTVSyncAccounts_ReplaceLoungeToken(t *testing.T) {
+	is := is.New(t)
+
+	dbPath := os.Getenv("DATABASE_PATH")
+	if dbPath == "" {
+		t.Skip("DATABASE_PATH not set")
+	}
+
+	c, err := NewSQLiteClient(dbPath)
+	is.NoErr(err)
+	defer c.Close()
+
+	ctx := context.Background()
+	db := c.(*sqliteClient).db
+
+	user := &models.User{
+		ID:       "test-user-tv-reencrypt",
+		Username: "test-user-tv-reencrypt",
+	}
+	err = user.Insert(ctx, db, boil.Infer())
+	is.NoErr(err)
+	defer user.Delete(ctx, db)
+
+	is.NoErr(c.UpsertYouTubeTVSyncCredentials(ctx, user.ID, "screen-reencrypt", "TV", []byte("token-old"), "retired-hash"))
+
+	accounts, err := c.ListYouTubeTVSyncAccountsBySecretHash(ctx, "retired-hash")
+	is.NoErr(err)
+	is.Equal(len(accounts), 1)
+	account := accounts[0]
+
+	// A token that changed since it was read is not replaced
+	replaced, err := c.ReplaceYouTubeTVSyncLoungeToken(ctx, account.ID, []byte("token-other"), []byte("token-new"), "current-hash")
+	is.NoErr(err)
+	is.True(!replaced)
+
+	replaced, err = c.ReplaceYouTubeTVSyncLoungeToken(ctx, account.ID, account.LoungeTokenEnc, []byte("token-new"), "current-hash")
+	is.NoErr(err)
+	is.True(replaced)
+
+	updated, err := c.GetYouTubeTVSyncAccount(ctx, account.ID)
+	is.NoErr(err)
+	is.Equal(string(updated.LoungeTokenEnc), "token-new")
+	is.Equal(updated.EncSecretHash, "current-hash")
+
+	accounts, err = c.ListYouTubeTVSyncAccountsBySecretHash(ctx, "retired-hash")
+	is.NoErr(err)
+	is.Equal(len(accounts), 0)
+}
@@ -11,34 +11,119 @@ import (
 	"github.com/cufee/feedlr-yt/internal/metrics"
 	"github.com/cufee/feedlr-yt/internal/utils"
 	"github.com/go-co-op/gocron"
+	"github.com/pkg/errors"
 )
 
-func StartCronTasks(db database.Client, sync *logic.YouTubeSyncService, tvSync *logic.YouTubeTVSyncService) (*gocron.Scheduler, error) {
+// Tasks still running at the stop deadline are canceled and get this long to return
+const cronCancelGrace = 5 * time.Second
+
+/*
+CronTasks are the background tasks scheduled by StartCronTasks
+*/
+type CronTasks struct {
+	scheduler *gocron.Scheduler
+	// ctx is passed to every task, it is canceled when tasks are still running at the stop deadline
+	ctx    context.Context
+	cancel context.CancelFunc
+}
+
+/*
+Stop stops scheduling tasks and waits for running tasks to finish.
+Tasks still running once the context is done are canceled, Stop then returns an error.
+*/
+func (c *CronTasks) Stop(ctx context.Context) error {
+	done := make(chan struct{})
+	go func() {
+		c.scheduler.Stop()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+		c.cancel()
+		return nil
+	case <-ctx.Done():
+	}
+
+	c.cancel()
+	select {
+	case <-done:
+	case <-time.After(cronCancelGrace):
+	}
+	return errors.Wrap(ctx.Err(), "cron tasks did not finish in time")
+}
+
+func StartCronTasks(db database.Client, sync *logic.YouTubeSyncService, tvSync *logic.YouTubeTVSyncService) (*CronTasks, error) {
 	s := gocron.NewScheduler(time.UTC)
+	ctx, cancel := context.WithCancel(context.Background())
+	tasks := &CronTasks{scheduler: s, ctx: ctx, cancel: cancel}
 
-	_, err := s.Cron(utils.MustGetEnv("VIDEO_CACHE_UPDATE_CRON")).Do(func() {
-		runErr := CacheAllChannelsWithVideos(db)
+	_, err := s.Cron(utils.MustGetEnv("VIDEO_CACHE_UPDATE_CRON")).Do(tasks.exclusive(db, "cache_all_channels_with_videos", func(ctx context.Context) {
+		ctx, cancel := context.WithTimeout(ctx, time.Minute)
+		defer cancel()
+		queued, runErr := logic.EnqueueChannelRefreshes(ctx, db)
 		metrics.ObserveBackgroundTask("cache_all_channels_with_videos", runErr)
 		if runErr != nil {
-			log.Printf("CacheAllChannelsWithVideos: %v", runErr)
+			log.Printf("EnqueueChannelRefreshes: %v", runErr)
+		} else if queued > 0 {
+			log.Printf("EnqueueChannelRefreshes: queued %d channels", queued)
 		}
-	})
+	}))
 	if err != nil {
 		return nil, err
 	}
 
-	// Daily cleanup of expired playlist items (runs at 3 AM UTC)
-	_, err = s.Cron("0 3 * * *").Do(func() {
-		ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
-		defer cancel()
-		deleted, err := db.CleanupExpiredPlaylistItems(ctx)
-		metrics.ObserveBackgroundTask("cleanup_expired_playlist_items", err)
+	// Daily cleanups run as jobs, so a failed cleanup is retried and shows up in the admin panel
+	cleanups := []struct {
+		cron string
+		task string
+	}{
+		{"0 3 * * *", logic.CleanupTaskPlaylistItems},
+		{"30 3 * * *", logic.CleanupTaskSponsorBlock},
+		{"45 3 * * *", logic.CleanupTaskVideoBranding},
+		{"0 4 * * *", logic.CleanupTaskJobs},
+		// Hourly, keeps a bounded number of tv sync journal entries per screen
+		{"10 * * * *", logic.CleanupTaskTVSyncJournal},
+	}
+	for _, cleanup := range cleanups {
+		task := cleanup.task
+		_, err = s.Cron(cleanup.cron).Do(tasks.exclusive(db, task, func(ctx context.Context) {
+			ctx, cancel := context.WithTimeout(ctx, time.Minute)
+			defer cancel()
+			if _, err := logic.JobCleanup.Enqueue(ctx, db, logic.CleanupJob{Task: task}); err != nil {
+				log.Printf("%s: %v", task, err)
+			}
+		}))
 		if err != nil {
-			log.Printf("CleanupExpiredPlaylistItems: %v", err)
-		} else if deleted > 0 {
-			log.Printf("CleanupExpiredPlaylistItems: deleted %d expired items", deleted)
+			return nil, err
+		}
+	}
+
+	// Retry queued sponsorblock submissions and votes, queued as a job so the pass is shared with the one queued by a new submission
+	_, err = s.Cron("*/1 * * * *").Do(tasks.exclusive(db, "process_sponsorblock_submissions", func(ctx context.Context) {
+		ctx, cancel := context.WithTimeout(ctx, time.Minute)
+		defer cancel()
+		if _, err := logic.JobProcessSponsorSubmissions.Enqueue(ctx, db, logic.ProcessSponsorSubmissionsJob{}); err != nil {
+			log.Printf("process_sponsorblock_submissions: %v", err)
+		}
+	}))
+	if err != nil {
+		return nil, err
+	}
+
+	transcriptCron := os.Getenv("TRANSCRIPT_CACHE_CRON")
+	if transcriptCron == "" {
+		transcriptCron = "*/20 * * * *"
+	}
+
+	// Queued as a job so the scan is shared with the one queued when a subscriber enables transcripts
+	_, err = s.Cron(transcriptCron).Do(tasks.exclusive(db, "cache_missing_transcripts", func(ctx context.Context) {
+		ctx, cancel := context.WithTimeout(ctx, time.Minute)
+		defer cancel()
+		if _, err := logic.JobCacheTranscripts.Enqueue(ctx, db, logic.CacheTranscriptsJob{}); err != nil {
+			log.Printf("cache_missing_transcripts: %v", err)
 		}
-	})
+	}))
 	if err != nil {
 		return nil, err
 	}
@@ -48,8 +133,8 @@ func StartCronTasks(db database.Client, sync *logic.YouTubeSyncService, tvSync *
 		playlistCron = "*/30 * * * *"
 	}
 
-	_, err = s.Cron(playlistCron).Do(func() {
-		ctx, cancel := context.WithTimeout(context.Background(), time.Minute*5)
+	_, err = s.Cron(playlistCron).Do(tasks.exclusive(db, "youtube_sync_run_tick", func(ctx context.Context) {
+		ctx, cancel := context.WithTimeout(ctx, time.Minute)
 		defer cancel()
 
 		runErr := sync.RunSyncTick(ctx)
@@ -57,14 +142,31 @@ func StartCronTasks(db database.Client, sync *logic.YouTubeSyncService, tvSync *
 		if runErr != nil {
 			log.Printf("RunSyncTick: %v", runErr)
 		}
-	})
+	}))
+	if err != nil {
+		return nil, err
+	}
+
+	// Queue syncs of imported playlists with auto sync turned on
+	_, err = s.Cron("*/15 * * * *").Do(tasks.exclusive(db, "enqueue_playlist_syncs", func(ctx context.Context) {
+		ctx, cancel := context.WithTimeout(ctx, time.Minute)
+		defer cancel()
+
+		queued, runErr := logic.EnqueueDuePlaylistSyncs(ctx, db)
+		metrics.ObserveBackgroundTask("enqueue_playlist_syncs", runErr)
+		if runErr != nil {
+			log.Printf("EnqueueDuePlaylistSyncs: %v", runErr)
+		} else if queued > 0 {
+			log.Printf("EnqueueDuePlaylistSyncs: queued %d playlists", queued)
+		}
+	}))
 	if err != nil {
 		return nil, err
 	}
 
 	if tvSync != nil {
 		_, err = s.Cron("*/1 * * * *").Do(func() {
-			ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+			ctx, cancel := context.WithTimeout(tasks.ctx, time.Minute)
 			defer cancel()
 
 			runErr := tvSync.RunConnectionTick(ctx)
@@ -78,7 +180,7 @@ func StartCronTasks(db database.Client, sync *logic.YouTubeSyncService, tvSync *
 		}
 
 		_, err = s.Cron("*/15 * * * *").Do(func() {
-			ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+			ctx, cancel := context.WithTimeout(tasks.ctx, time.Minute)
 			defer cancel()
 
 			runErr := tvSync.RunLifecycleTick(ctx)
@@ -93,5 +195,24 @@ func StartCronTasks(db database.Client, sync *logic.YouTubeSyncService, tvSync *
 	}
 
 	s.StartAsync()
-	return s, nil
+	return tasks, nil
+}
+
+/*
+exclusive wraps a cron task so it runs on one Feedlr process at a time when several processes share the database.
+TV sync ticks are not wrapped, every process runs them for the screens it holds leases for.
+*/
+func (c *CronTasks) exclusive(db database.LeasesClient, name string, task func(ctx context.Context)) func() {
+	return func() {
+		ran, err := logic.RunExclusive(c.ctx, db, "cron:"+name, func(ctx context.Context) error {
+			task(ctx)
+			return nil
+		})
+		if err != nil {
+			log.Printf("%s: %v", name, err)
+		}
+		if !ran {
+			metrics.ObserveBackgroundTask(name+"_skipped", err)
+		}
+	}
 }
@@ -8,16 +8,21 @@ import (
 	"github.com/cufee/feedlr-yt/internal/database"
 	"github.com/cufee/feedlr-yt/internal/database/models"
 	"github.com/cufee/feedlr-yt/internal/metrics"
+	"github.com/cufee/feedlr-yt/internal/tracing"
 	"github.com/friendsofgo/errors"
 	"github.com/rs/zerolog/log"
 	"go.mongodb.org/mongo-driver/mongo"
+	"go.opentelemetry.io/otel/attribute"
 	"golang.org/x/sync/errgroup"
 )
 
 /*
 Cache recent videos for each channel to the database
 */
-func CacheChannelVideos(ctx context.Context, db database.Client, limit int, channelIds ...string) ([]*models.Video, error) {
+func CacheChannelVideos(ctx context.Context, db database.Client, limit int, channelIds ...string) (_ []*models.Video, err error) {
+	ctx, span := tracing.Start(ctx, "logic.CacheChannelVideos", attribute.StringSlice("channel.ids", channelIds))
+	defer func() { tracing.End(span, err) }()
+
 	if len(channelIds) < 1 {
 		err := errors.New("at least 1 channel id is required")
 		metrics.ObserveVideoRefresh("cache_channel_videos", err)
@@ -103,12 +108,32 @@ func CacheChannelVideos(ctx context.Context, db database.Client, limit int, chan
 	ctx, cancel := context.WithTimeout(ctx, time.Second*5)
 	defer cancel()
 
-	err := db.UpsertVideos(ctx, updates...)
+	err = db.UpsertVideos(ctx, updates...)
 	if err != nil {
 		metrics.ObserveVideoRefresh("cache_channel_videos", err)
 		return nil, errors.Wrap(err, "db#UpsertVideos")
 	}
 
+	// Always re-parsed, a description edited to drop its chapters has to clear the stored ones
+	for _, video := range updates {
+		if err := UpdateDescriptionChapters(ctx, db, video.ID, video.Description, int(video.Duration)); err != nil {
+			log.Warn().Err(err).Str("videoID", video.ID).Msg("failed to save chapters for cached video")
+		}
+	}
+
+	// New uploads land in subscriber feeds, warm the segment cache before anyone hits play
+	var videoIDs []string
+	for _, video := range updates {
+		if video.Type == string(youtube.VideoTypeVideo) {
+			videoIDs = append(videoIDs, video.ID)
+		}
+	}
+	prefetches.goPrefetch(func(ctx context.Context) {
+		if err := PrefetchSponsorBlockSegments(ctx, db, videoIDs...); err != nil {
+			log.Warn().Err(err).Msg("failed to prefetch sponsorblock segments for cached videos")
+		}
+	})
+
 	metrics.ObserveVideoRefresh("cache_channel_videos", nil)
 	metrics.AddVideoRefreshItems("cache_channel_videos", len(updates))
 	return updates, nil
@@ -166,32 +191,44 @@ func CacheChannel(ctx context.Context, db database.ChannelsClient, channelID str
 	return record, existing != nil, nil
 }
 
-func RefreshVideoCache(ctx context.Context, db database.Client, videoID string) {
+// Streams change state quickly, regular videos rarely change after they are published
+func videoCacheFresh(video *models.Video) bool {
+	staleThreshold := 6 * time.Hour
+	switch video.Type {
+	case string(youtube.VideoTypeLiveStream), string(youtube.VideoTypeUpcomingStream), string(youtube.VideoTypeStreamRecording):
+		staleThreshold = time.Hour
+	}
+	return time.Since(video.UpdatedAt) < staleThreshold
+}
+
+// Deleted videos and private videos we never cached have no channel, so they cannot be stored
+var ErrVideoUnavailable = errors.New("video is not available on YouTube")
+
+/*
+RefreshVideoCache fetches the details of a video and stores them when the cached copy is missing or stale.
+Deleted and private videos that were never cached return ErrVideoUnavailable.
+*/
+func RefreshVideoCache(ctx context.Context, db database.Client, videoID string) error {
+	ctx, span := tracing.Start(ctx, "logic.RefreshVideoCache", attribute.String("video.id", videoID))
+	defer span.End()
+
 	current, err := db.GetVideoByID(ctx, videoID)
 	if err != nil && !database.IsErrNotFound(err) {
 		metrics.ObserveVideoRefresh("refresh_video_cache", err)
 		log.Warn().Err(err).Str("videoID", videoID).Msg("failed to get video for cache refresh")
-		return
+		return errors.Wrap(err, "failed to get video")
 	}
 
-	var staleThreshold time.Duration
 	if current != nil {
-		switch current.Type {
-		case string(youtube.VideoTypeLiveStream), string(youtube.VideoTypeUpcomingStream), string(youtube.VideoTypeStreamRecording):
-			staleThreshold = time.Hour
-		default:
-			staleThreshold = 6 * time.Hour
-		}
-
-		if time.Since(current.UpdatedAt) < staleThreshold {
+		if videoCacheFresh(current) {
 			metrics.ObserveVideoRefresh("refresh_video_cache_skip_fresh", nil)
-			return
+			return nil
 		}
 
 		if err := db.TouchVideoUpdatedAt(ctx, videoID); err != nil {
 			metrics.ObserveVideoRefresh("refresh_video_cache", err)
 			log.Warn().Err(err).Str("videoID", videoID).Msg("failed to touch video timestamp")
-			return
+			return errors.Wrap(err, "failed to touch video timestamp")
 		}
 	}
 
@@ -199,7 +236,7 @@ func RefreshVideoCache(ctx context.Context, db database.Client, videoID string)
 	if err != nil {
 		metrics.ObserveVideoRefresh("refresh_video_cache", err)
 		log.Warn().Err(err).Str("videoID", videoID).Msg("failed to fetch video details for cache refresh")
-		return
+		return errors.Wrap(err, "failed to fetch video details")
 	}
 
 	if current != nil {
@@ -207,8 +244,8 @@ func RefreshVideoCache(ctx context.Context, db database.Client, videoID string)
 	}
 	if video.ChannelID == "" {
 		metrics.ObserveVideoRefresh("refresh_video_cache", errors.New("missing_channel_id"))
-		log.Warn().Str("videoID", videoID).Msg("cannot refresh uncached private video without channel id")
-		return
+		log.Debug().Str("videoID", videoID).Msg("cannot refresh uncached private video without channel id")
+		return ErrVideoUnavailable
 	}
 	update := &models.Video{
 		ChannelID:   video.ChannelID,
@@ -250,7 +287,11 @@ func RefreshVideoCache(ctx context.Context, db database.Client, videoID string)
 	if err := db.UpsertVideos(ctx, update); err != nil {
 		metrics.ObserveVideoRefresh("refresh_video_cache", err)
 		log.Warn().Err(err).Str("videoID", videoID).Msg("failed to upsert video during cache refresh")
-		return
+		return errors.Wrap(err, "failed to upsert video")
+	}
+
+	if err := UpdateDescriptionChapters(ctx, db, update.ID, update.Description, int(update.Duration)); err != nil {
+		log.Warn().Err(err).Str("videoID", videoID).Msg("failed to update chapters during video refresh")
 	}
 
 	if _, _, err := CacheChannel(ctx, db, video.ChannelID); err != nil {
@@ -259,4 +300,5 @@ func RefreshVideoCache(ctx context.Context, db database.Client, videoID string)
 	}
 	metrics.ObserveVideoRefresh("refresh_video_cache", nil)
 	metrics.AddVideoRefreshItems("refresh_video_cache", 1)
+	return nil
 }
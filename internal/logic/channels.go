@@ -55,6 +55,8 @@ func GetChannelPageProps(ctx context.Context, db database.Client, userID, channe
 		sub, err := db.FindSubscription(ctx, userID, channelID)
 		if err == nil {
 			props.Subscribed = true
+			props.PlayOnTV = TVRemoteAvailable(userID)
+			props.TranscriptsEnabled = sub.TranscriptsEnabled
 			props.VideoFilter = types.VideoFilter(sub.VideoFilter)
 			if props.VideoFilter == "" {
 				props.VideoFilter = types.VideoFilterAll
@@ -106,6 +108,7 @@ func GetChannelPageProps(ctx context.Context, db database.Client, userID, channe
 		}
 	}
 
+	applyUserVideoTitles(ctx, db, userID, props.Channel.Videos)
 	return &props, nil
 }
 
@@ -0,0 +1,273 @@
+package logic
+
+import (
+	"context"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/cufee/feedlr-yt/internal/api/sponsorblock"
+	"github.com/cufee/feedlr-yt/internal/database"
+	"github.com/cufee/feedlr-yt/internal/metrics"
+	"github.com/cufee/feedlr-yt/internal/types"
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	minDescriptionChapters = 3
+	minChapterLength       = 10
+	maxChapterTitleLength  = 120
+)
+
+var (
+	chapterLeadingTimestamp  = regexp.MustCompile(`^[^\p{L}\p{N}(\[]*[(\[]?((?:\d{1,2}:)?\d{1,2}:\d{2})[)\]]?\s*(?:[-–—:|•]\s*)?(.*)$`)
+	chapterTrailingTimestamp = regexp.MustCompile(`^(.*?)\s*(?:[-–—:|•]\s*)?[(\[]?((?:\d{1,2}:)?\d{1,2}:\d{2})[)\]]?\s*$`)
+)
+
+/*
+ParseDescriptionChapters extracts a chapter list from a video description, following the same rules YouTube uses:
+  - chapters are lines with a timestamp at the start or the end
+  - the first chapter starts at 0:00
+  - timestamps are strictly increasing
+  - there are at least 3 chapters and each one is at least 10 seconds long
+*/
+func ParseDescriptionChapters(description string, duration int) []types.ChapterProps {
+	var chapters []types.ChapterProps
+	for _, line := range strings.Split(description, "\n") {
+		start, title, ok := parseChapterLine(line)
+		if !ok {
+			continue
+		}
+
+		if len(chapters) == 0 {
+			// Chapters only begin at the first 0:00 timestamp
+			if start != 0 {
+				continue
+			}
+			chapters = append(chapters, types.ChapterProps{Title: title, Start: start})
+			continue
+		}
+
+		last := chapters[len(chapters)-1]
+		if start <= last.Start {
+			// A second list of timestamps (tracklists, corrections) ends the chapter block
+			break
+		}
+		if duration > 0 && start >= duration {
+			break
+		}
+		chapters = append(chapters, types.ChapterProps{Title: title, Start: start})
+	}
+
+	if len(chapters) < minDescriptionChapters {
+		return nil
+	}
+	chapters = closeChapters(chapters, duration)
+
+	// YouTube ignores the whole list when a single chapter is too short, the end of the last one is unknown without a duration
+	for i, chapter := range chapters {
+		if i == len(chapters)-1 && duration <= 0 {
+			break
+		}
+		if chapter.End-chapter.Start < minChapterLength {
+			return nil
+		}
+	}
+	return chapters
+}
+
+func parseChapterLine(line string) (int, string, bool) {
+	line = strings.TrimSpace(line)
+	if line == "" {
+		return 0, "", false
+	}
+
+	var timestamp, title string
+	if match := chapterLeadingTimestamp.FindStringSubmatch(line); match != nil {
+		timestamp, title = match[1], match[2]
+	} else if match := chapterTrailingTimestamp.FindStringSubmatch(line); match != nil {
+		title, timestamp = match[1], match[2]
+	} else {
+		return 0, "", false
+	}
+
+	seconds, ok := parseChapterTimestamp(timestamp)
+	if !ok {
+		return 0, "", false
+	}
+
+	title = strings.TrimSpace(strings.Trim(strings.TrimSpace(title), "-–—:|•"))
+	if title == "" {
+		return 0, "", false
+	}
+	if r := []rune(title); len(r) > maxChapterTitleLength {
+		title = string(r[:maxChapterTitleLength])
+	}
+	return seconds, title, true
+}
+
+func parseChapterTimestamp(value string) (int, bool) {
+	parts := strings.Split(value, ":")
+	if len(parts) < 2 || len(parts) > 3 {
+		return 0, false
+	}
+
+	var seconds int
+	for i, part := range parts {
+		n, err := strconv.Atoi(part)
+		if err != nil || n < 0 {
+			return 0, false
+		}
+		// minutes and seconds cannot overflow when there is a larger unit before them
+		if i > 0 && n >= 60 {
+			return 0, false
+		}
+		seconds = seconds*60 + n
+	}
+	return seconds, true
+}
+
+// closeChapters sets the end of each chapter to the start of the next one, with the last chapter ending with the video
+func closeChapters(chapters []types.ChapterProps, duration int) []types.ChapterProps {
+	for i := range chapters {
+		if i+1 < len(chapters) {
+			chapters[i].End = chapters[i+1].Start
+			continue
+		}
+		chapters[i].End = max(duration, chapters[i].Start)
+	}
+	return chapters
+}
+
+/*
+chaptersFromSponsorBlock converts SponsorBlock chapter segments into a flat, non-overlapping chapter list
+*/
+func chaptersFromSponsorBlock(segments []sponsorblock.Segment) []types.ChapterProps {
+	var chapters []types.ChapterProps
+	for _, segment := range segments {
+		if segment.ActionType != sponsorblock.ActionTypeChapter || len(segment.Segment) != 2 {
+			continue
+		}
+		title := strings.TrimSpace(segment.Description)
+		start, end := int(segment.Segment[0]), int(segment.Segment[1])
+		if title == "" || end <= start {
+			continue
+		}
+		chapters = append(chapters, types.ChapterProps{Title: title, Start: start, End: end})
+	}
+
+	slices.SortFunc(chapters, func(a, b types.ChapterProps) int {
+		return a.Start - b.Start
+	})
+
+	// SponsorBlock allows nested chapters, only keep the outermost ones
+	var flat []types.ChapterProps
+	for _, chapter := range chapters {
+		if len(flat) > 0 && chapter.Start < flat[len(flat)-1].End {
+			continue
+		}
+		flat = append(flat, chapter)
+	}
+	return flat
+}
+
+func chaptersToRecords(chapters []types.ChapterProps) []*database.VideoChapter {
+	records := make([]*database.VideoChapter, 0, len(chapters))
+	for _, chapter := range chapters {
+		records = append(records, &database.VideoChapter{
+			Title:        chapter.Title,
+			StartSeconds: chapter.Start,
+			EndSeconds:   chapter.End,
+		})
+	}
+	return records
+}
+
+func chaptersFromRecords(records []*database.VideoChapter, source string) []types.ChapterProps {
+	var chapters []types.ChapterProps
+	for _, record := range records {
+		if record.Source != source {
+			continue
+		}
+		chapters = append(chapters, types.ChapterProps{
+			Title: record.Title,
+			Start: record.StartSeconds,
+			End:   record.EndSeconds,
+		})
+	}
+	return chapters
+}
+
+/*
+UpdateDescriptionChapters re-parses the description of a video and replaces the stored description chapters
+*/
+func UpdateDescriptionChapters(ctx context.Context, db database.VideoChaptersClient, videoID, description string, duration int) error {
+	chapters := ParseDescriptionChapters(description, duration)
+	err := db.ReplaceVideoChapters(ctx, videoID, database.VideoChapterSourceDescription, chaptersToRecords(chapters))
+	metrics.ObserveVideoRefresh("update_description_chapters", err)
+	if err != nil {
+		return errors.Wrap(err, "failed to save description chapters")
+	}
+	return nil
+}
+
+type videoChaptersDB interface {
+	database.VideoChaptersClient
+	database.SponsorBlockSegmentsClient
+}
+
+/*
+GetVideoChapters returns chapters for a video, preferring the chapters defined by the creator in the description.
+When the description has no chapters and withSponsorBlock is set, community chapters from SponsorBlock are used instead.
+*/
+func GetVideoChapters(ctx context.Context, db videoChaptersDB, video types.VideoProps, withSponsorBlock bool) ([]types.ChapterProps, error) {
+	records, err := db.GetVideoChapters(ctx, video.ID)
+	if err != nil && !database.IsErrNotFound(err) {
+		return nil, errors.Wrap(err, "failed to get video chapters")
+	}
+
+	if chapters := chaptersFromRecords(records, database.VideoChapterSourceDescription); len(chapters) > 0 {
+		return chapters, nil
+	}
+
+	// Videos cached before chapters were introduced have nothing stored, parse on demand
+	if chapters := ParseDescriptionChapters(video.Description, video.Duration); len(chapters) > 0 {
+		if err := db.ReplaceVideoChapters(ctx, video.ID, database.VideoChapterSourceDescription, chaptersToRecords(chapters)); err != nil {
+			log.Warn().Err(err).Str("videoID", video.ID).Msg("failed to save description chapters")
+		}
+		return chapters, nil
+	}
+
+	if !withSponsorBlock {
+		return nil, nil
+	}
+	if chapters := chaptersFromRecords(records, database.VideoChapterSourceSponsorBlock); len(chapters) > 0 {
+		return chapters, nil
+	}
+	segments, err := GetSponsorBlockChapters(ctx, db, video.ID)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get sponsorblock chapters")
+	}
+	chapters := chaptersFromSponsorBlock(segments)
+	if len(chapters) == 0 {
+		return nil, nil
+	}
+	if err := db.ReplaceVideoChapters(ctx, video.ID, database.VideoChapterSourceSponsorBlock, chaptersToRecords(chapters)); err != nil {
+		log.Warn().Err(err).Str("videoID", video.ID).Msg("failed to save sponsorblock chapters")
+	}
+	return chapters, nil
+}
+
+/*
+nextChapterStart returns the start of the first chapter after the current position
+*/
+func nextChapterStart(chapters []types.ChapterProps, current float64) (int, bool) {
+	for _, chapter := range chapters {
+		if float64(chapter.Start) > current+1 {
+			return chapter.Start, true
+		}
+	}
+	return 0, false
+}
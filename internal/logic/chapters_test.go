@@ -0,0 +1,121 @@
+package logic
+
+import (
+	"testing"
+
+	"github.com/cufee/feedlr-yt/internal/api/sponsorblock"
+	"github.com/cufee/feedlr-yt/internal/types"
+)
+
+func TestParseDescriptionChapters(t *testing.T) {
+	description := `Video about things.
+
+Timestamps:
+0:00 Intro
+(1:30) - Setup
+Wiring the board 12:05
+1:02:03 | Wrap up
+
+Songs used:
+0:00 Track one
+2:00 Track two`
+
+	chapters := ParseDescriptionChapters(description, 4000)
+	expected := []types.ChapterProps{
+		{Title: "Intro", Start: 0, End: 90},
+		{Title: "Setup", Start: 90, End: 725},
+		{Title: "Wiring the board", Start: 725, End: 3723},
+		{Title: "Wrap up", Start: 3723, End: 4000},
+	}
+	if len(chapters) != len(expected) {
+		t.Fatalf("expected %d chapters, got %d: %+v", len(expected), len(chapters), chapters)
+	}
+	for i := range expected {
+		if chapters[i] != expected[i] {
+			t.Fatalf("unexpected chapter %d: %+v", i, chapters[i])
+		}
+	}
+}
+
+func TestParseDescriptionChaptersRequiresZeroStart(t *testing.T) {
+	description := "Check out 1:30 and 2:45 for the best parts\n1:30 Highlight\n2:45 Another highlight"
+	if chapters := ParseDescriptionChapters(description, 600); chapters != nil {
+		t.Fatalf("expected no chapters without a 0:00 timestamp, got %+v", chapters)
+	}
+
+	if chapters := ParseDescriptionChapters("0:00 Intro\n5:00 Outro", 600); chapters != nil {
+		t.Fatalf("expected no chapters for fewer than 3 timestamps, got %+v", chapters)
+	}
+}
+
+func TestParseDescriptionChaptersRequiresMinLength(t *testing.T) {
+	if chapters := ParseDescriptionChapters("0:00 Intro\n0:05 Too short\n5:00 Outro", 600); chapters != nil {
+		t.Fatalf("expected no chapters with a chapter under 10 seconds, got %+v", chapters)
+	}
+	if chapters := ParseDescriptionChapters("0:00 Intro\n2:00 Middle\n9:55 Last seconds", 600); chapters != nil {
+		t.Fatalf("expected no chapters with a last chapter under 10 seconds, got %+v", chapters)
+	}
+	if chapters := ParseDescriptionChapters("0:00 Intro\n0:10 Middle\n9:50 Outro", 600); len(chapters) != 3 {
+		t.Fatalf("expected chapters of exactly 10 seconds to be kept, got %+v", chapters)
+	}
+}
+
+func TestParseDescriptionChaptersDropsPastDuration(t *testing.T) {
+	chapters := ParseDescriptionChapters("00:00 Start\n05:00 Middle\n08:00 End\n20:00 Past the end", 600)
+	if len(chapters) != 3 {
+		t.Fatalf("expected 3 chapters, got %+v", chapters)
+	}
+	if chapters[2].End != 600 {
+		t.Fatalf("expected last chapter to end with the video, got %+v", chapters[2])
+	}
+}
+
+func TestParseChapterTimestamp(t *testing.T) {
+	cases := map[string]int{
+		"0:00":    0,
+		"12:34":   754,
+		"1:02:03": 3723,
+	}
+	for value, expected := range cases {
+		got, ok := parseChapterTimestamp(value)
+		if !ok || got != expected {
+			t.Fatalf("parseChapterTimestamp(%q) = %d, %t; expected %d", value, got, ok, expected)
+		}
+	}
+	if _, ok := parseChapterTimestamp("1:75"); ok {
+		t.Fatal("expected out of range seconds to be rejected")
+	}
+}
+
+func TestChaptersFromSponsorBlock(t *testing.T) {
+	segments := []sponsorblock.Segment{
+		{Segment: []float64{60, 120}, ActionType: sponsorblock.ActionTypeChapter, Description: "Second"},
+		{Segment: []float64{0, 60}, ActionType: sponsorblock.ActionTypeChapter, Description: "First"},
+		{Segment: []float64{70, 80}, ActionType: sponsorblock.ActionTypeChapter, Description: "Nested"},
+		{Segment: []float64{130, 140}, ActionType: sponsorblock.ActionTypeSkip, Description: "Not a chapter"},
+		{Segment: []float64{150, 160}, ActionType: sponsorblock.ActionTypeChapter},
+	}
+
+	chapters := chaptersFromSponsorBlock(segments)
+	if len(chapters) != 2 {
+		t.Fatalf("expected 2 chapters, got %+v", chapters)
+	}
+	if chapters[0].Title != "First" || chapters[1].Title != "Second" {
+		t.Fatalf("unexpected chapter order: %+v", chapters)
+	}
+}
+
+func TestNextChapterStart(t *testing.T) {
+	chapters := []types.ChapterProps{{Start: 0, End: 60}, {Start: 60, End: 120}, {Start: 120, End: 180}}
+
+	if start, ok := nextChapterStart(chapters, 30); !ok || start != 60 {
+		t.Fatalf("expected next chapter at 60, got %d, %t", start, ok)
+	}
+	// Right at a chapter boundary the following chapter is the next one
+	if start, ok := nextChapterStart(chapters, 60.4); !ok || start != 120 {
+		t.Fatalf("expected next chapter at 120, got %d, %t", start, ok)
+	}
+	if _, ok := nextChapterStart(chapters, 150); ok {
+		t.Fatal("expected no next chapter in the last chapter")
+	}
+}
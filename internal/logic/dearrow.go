@@ -0,0 +1,228 @@
+package logic
+
+import (
+	"context"
+	"time"
+
+	"github.com/aarondl/null/v8"
+	"github.com/cufee/feedlr-yt/internal/api/dearrow"
+	"github.com/cufee/feedlr-yt/internal/database"
+	"github.com/cufee/feedlr-yt/internal/metrics"
+	"github.com/cufee/feedlr-yt/internal/types"
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog/log"
+	"golang.org/x/sync/errgroup"
+	"golang.org/x/sync/singleflight"
+)
+
+const (
+	videoBrandingTTL = 24 * time.Hour
+	// Most titles are submitted shortly after a video goes up, check videos without submissions more often
+	videoBrandingNegativeTTL = 3 * time.Hour
+	// Expired titles keep being shown while the refresh is pending, a week covers long DeArrow outages
+	videoBrandingRetention = 7 * 24 * time.Hour
+
+	videoBrandingPrefetchTimeout = 5 * time.Second
+	videoBrandingPrefetchLimit   = 4
+	videoBrandingPrefetchMax     = 48
+)
+
+var videoBrandingLookups singleflight.Group
+
+type videoBrandingSource interface {
+	LookupBranding(ctx context.Context, videoID string) (*dearrow.Branding, error)
+}
+
+func defaultVideoBrandingSource() videoBrandingSource {
+	if dearrow.C == nil {
+		return nil
+	}
+	return dearrow.C
+}
+
+// DeArrowAvailable reports whether community titles can be looked up
+func DeArrowAvailable() bool {
+	return dearrow.C != nil
+}
+
+func deArrowThumbnailsAvailable() bool {
+	return dearrow.C != nil && dearrow.C.ThumbnailsEnabled()
+}
+
+type videoTitlesDB interface {
+	database.SettingsClient
+	database.VideoBrandingClient
+}
+
+/*
+applyUserVideoTitles applies the user title mode to lists of videos, it is a no-op for anonymous users and clients that do not cache branding
+*/
+func applyUserVideoTitles(ctx context.Context, db any, userID string, videos ...[]types.VideoProps) {
+	titlesDB, ok := db.(videoTitlesDB)
+	if !ok || userID == "" {
+		return
+	}
+
+	settings, err := GetUserSettings(ctx, titlesDB, userID)
+	if err != nil {
+		log.Warn().Err(err).Str("userID", userID).Msg("failed to get user settings for video titles")
+		return
+	}
+
+	mode := settings.DisplayTitleMode()
+	for _, list := range videos {
+		ApplyVideoTitleMode(ctx, titlesDB, mode, list)
+	}
+}
+
+/*
+ApplyVideoTitleMode replaces video titles based on the title mode, only cached DeArrow branding is used so rendering never waits on the upstream.
+Videos without fresh branding are looked up in the background and show community titles on the next load.
+*/
+func ApplyVideoTitleMode(ctx context.Context, db database.VideoBrandingClient, mode types.VideoTitleMode, videos []types.VideoProps) {
+	stale := applyVideoTitleMode(ctx, db, mode, deArrowThumbnailsAvailable(), videos)
+	if len(stale) == 0 || defaultVideoBrandingSource() == nil {
+		return
+	}
+
+	prefetches.goPrefetch(func(ctx context.Context) {
+		if err := PrefetchVideoBranding(ctx, db, stale...); err != nil {
+			log.Warn().Err(err).Msg("failed to prefetch video branding")
+		}
+	})
+}
+
+func applyVideoTitleMode(ctx context.Context, db database.VideoBrandingClient, mode types.VideoTitleMode, withThumbnails bool, videos []types.VideoProps) []string {
+	if mode == types.VideoTitleModeCasing {
+		for i := range videos {
+			setVideoTitle(&videos[i], dearrow.FixTitleCasing(videos[i].Title))
+		}
+		return nil
+	}
+	if mode != types.VideoTitleModeDeArrow {
+		return nil
+	}
+
+	videoIDs := make([]string, 0, len(videos))
+	for _, video := range videos {
+		videoIDs = append(videoIDs, video.ID)
+	}
+	records, err := db.GetVideoBranding(ctx, videoIDs...)
+	if err != nil {
+		log.Warn().Err(err).Msg("failed to read cached video branding")
+	}
+
+	now := time.Now()
+	var stale []string
+	for i, video := range videos {
+		record := records[video.ID]
+		if record == nil || now.After(record.ExpiresAt) {
+			stale = append(stale, video.ID)
+		}
+
+		// Videos without a community title get the same formatting DeArrow applies to original titles
+		title := dearrow.FixTitleCasing(video.Title)
+		if record != nil && record.Title != "" {
+			title = record.Title
+		}
+		setVideoTitle(&videos[i], title)
+
+		if withThumbnails && record != nil && record.ThumbnailTime.Valid {
+			timestamp := record.ThumbnailTime.Float64
+			videos[i].ThumbnailTime = &timestamp
+		}
+	}
+	return stale
+}
+
+func setVideoTitle(video *types.VideoProps, title string) {
+	if title == "" || title == video.Title {
+		return
+	}
+	if video.OriginalTitle == "" {
+		video.OriginalTitle = video.Title
+	}
+	video.Title = title
+}
+
+/*
+PrefetchVideoBranding looks up DeArrow branding for videos that have no fresh cache entries
+*/
+func PrefetchVideoBranding(ctx context.Context, db database.VideoBrandingClient, videoIDs ...string) error {
+	source := defaultVideoBrandingSource()
+	if source == nil || len(videoIDs) == 0 {
+		return nil
+	}
+	if len(videoIDs) > videoBrandingPrefetchMax {
+		videoIDs = videoIDs[:videoBrandingPrefetchMax]
+	}
+	return prefetchVideoBranding(ctx, db, source, videoIDs)
+}
+
+func prefetchVideoBranding(ctx context.Context, db database.VideoBrandingClient, source videoBrandingSource, videoIDs []string) error {
+	records, err := db.GetVideoBranding(ctx, videoIDs...)
+	if err != nil {
+		metrics.ObserveVideoRefresh("dearrow_prefetch", err)
+		return errors.Wrap(err, "failed to read cached video branding")
+	}
+
+	now := time.Now()
+	var group errgroup.Group
+	group.SetLimit(videoBrandingPrefetchLimit)
+	for _, id := range videoIDs {
+		if record, ok := records[id]; ok && now.Before(record.ExpiresAt) {
+			continue
+		}
+		group.Go(func() error {
+			_, err, _ := videoBrandingLookups.Do(id, func() (any, error) {
+				lctx, cancel := context.WithTimeout(ctx, videoBrandingPrefetchTimeout)
+				defer cancel()
+				return nil, refreshVideoBranding(lctx, db, source, id)
+			})
+			if err != nil {
+				log.Debug().Err(err).Str("videoID", id).Msg("failed to prefetch video branding")
+			}
+			return nil
+		})
+	}
+
+	err = group.Wait()
+	metrics.ObserveVideoRefresh("dearrow_prefetch", err)
+	return err
+}
+
+func refreshVideoBranding(ctx context.Context, db database.VideoBrandingClient, source videoBrandingSource, videoID string) error {
+	branding, err := source.LookupBranding(ctx, videoID)
+	metrics.ObserveVideoRefresh("dearrow_lookup", err)
+	if err != nil {
+		return errors.Wrap(err, "failed to look up video branding")
+	}
+
+	record := &database.DeArrowVideoBranding{VideoID: videoID}
+	if branding != nil {
+		record.Title = branding.BestTitle()
+		if timestamp, ok := branding.BestThumbnail(); ok {
+			record.ThumbnailTime = null.Float64From(timestamp)
+		}
+	}
+
+	ttl := videoBrandingTTL
+	if record.Title == "" && !record.ThumbnailTime.Valid {
+		ttl = videoBrandingNegativeTTL
+	}
+	record.ExpiresAt = time.Now().Add(ttl)
+
+	err = db.UpsertVideoBranding(ctx, record)
+	metrics.ObserveVideoRefresh("dearrow_cache_write", err)
+	if err != nil {
+		return errors.Wrap(err, "failed to cache video branding")
+	}
+	return nil
+}
+
+/*
+CleanupVideoBranding removes cache records that expired long enough ago to not be useful as a fallback
+*/
+func CleanupVideoBranding(ctx context.Context, db database.VideoBrandingClient) (int64, error) {
+	return db.DeleteExpiredVideoBranding(ctx, time.Now().Add(-videoBrandingRetention))
+}
@@ -0,0 +1,138 @@
+package logic
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/aarondl/null/v8"
+	"github.com/cufee/feedlr-yt/internal/api/dearrow"
+	"github.com/cufee/feedlr-yt/internal/api/youtube"
+	"github.com/cufee/feedlr-yt/internal/database"
+	"github.com/cufee/feedlr-yt/internal/types"
+)
+
+type mockVideoBrandingStore struct {
+	mu      sync.Mutex
+	records map[string]*database.DeArrowVideoBranding
+}
+
+func (m *mockVideoBrandingStore) GetVideoBranding(_ context.Context, videoIDs ...string) (map[string]*database.DeArrowVideoBranding, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	result := make(map[string]*database.DeArrowVideoBranding)
+	for _, id := range videoIDs {
+		if record, ok := m.records[id]; ok {
+			copied := *record
+			result[id] = &copied
+		}
+	}
+	return result, nil
+}
+
+func (m *mockVideoBrandingStore) UpsertVideoBranding(_ context.Context, record *database.DeArrowVideoBranding) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if m.records == nil {
+		m.records = make(map[string]*database.DeArrowVideoBranding)
+	}
+	copied := *record
+	m.records[record.VideoID] = &copied
+	return nil
+}
+
+func (m *mockVideoBrandingStore) DeleteExpiredVideoBranding(context.Context, time.Time) (int64, error) {
+	return 0, nil
+}
+
+type stubVideoBrandingSource struct {
+	mu       sync.Mutex
+	branding map[string]*dearrow.Branding
+	calls    int
+}
+
+func (s *stubVideoBrandingSource) LookupBranding(_ context.Context, videoID string) (*dearrow.Branding, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.calls++
+	return s.branding[videoID], nil
+}
+
+func TestApplyVideoTitleMode(t *testing.T) {
+	store := &mockVideoBrandingStore{records: map[string]*database.DeArrowVideoBranding{
+		"fresh": {VideoID: "fresh", ExpiresAt: time.Now().Add(time.Hour), Title: "A calm title", ThumbnailTime: null.Float64From(42.5)},
+		"stale": {VideoID: "stale", ExpiresAt: time.Now().Add(-time.Hour), Title: "An old community title"},
+	}}
+	newVideos := func() []types.VideoProps {
+		return []types.VideoProps{
+			{Video: youtube.Video{ID: "fresh", Title: "YOU WON'T BELIEVE THIS"}},
+			{Video: youtube.Video{ID: "stale", Title: "Original stale title"}},
+			{Video: youtube.Video{ID: "missing", Title: "This is INSANE!!!"}},
+		}
+	}
+
+	videos := newVideos()
+	if stale := applyVideoTitleMode(context.Background(), store, types.VideoTitleModeOriginal, true, videos); len(stale) != 0 || videos[0].Title != "YOU WON'T BELIEVE THIS" {
+		t.Fatalf("original mode should not change titles, got %+v", videos)
+	}
+
+	videos = newVideos()
+	applyVideoTitleMode(context.Background(), store, types.VideoTitleModeCasing, true, videos)
+	if videos[2].Title != "This is Insane!" || videos[2].OriginalTitle != "This is INSANE!!!" || videos[0].ThumbnailTime != nil {
+		t.Fatalf("unexpected casing mode result %+v", videos)
+	}
+
+	videos = newVideos()
+	stale := applyVideoTitleMode(context.Background(), store, types.VideoTitleModeDeArrow, true, videos)
+	if len(stale) != 2 || stale[0] != "stale" || stale[1] != "missing" {
+		t.Fatalf("expected stale and missing videos to be refreshed, got %v", stale)
+	}
+	if videos[0].Title != "A calm title" || videos[0].ThumbnailTime == nil || *videos[0].ThumbnailTime != 42.5 {
+		t.Fatalf("expected community branding, got %+v", videos[0])
+	}
+	if videos[1].Title != "An old community title" {
+		t.Fatalf("expected stale branding to be used as a fallback, got %+v", videos[1])
+	}
+	if videos[2].Title != "This is Insane!" {
+		t.Fatalf("expected videos without branding to have casing fixed, got %+v", videos[2])
+	}
+
+	videos = newVideos()
+	applyVideoTitleMode(context.Background(), store, types.VideoTitleModeDeArrow, false, videos)
+	if videos[0].ThumbnailTime != nil {
+		t.Fatalf("thumbnails should not be set when the thumbnail server is disabled")
+	}
+}
+
+func TestPrefetchVideoBranding(t *testing.T) {
+	timestamp := 12.0
+	source := &stubVideoBrandingSource{branding: map[string]*dearrow.Branding{
+		"branded": {
+			Titles:     []dearrow.Title{{Title: "Community title", Votes: 1}},
+			Thumbnails: []dearrow.Thumbnail{{Timestamp: &timestamp}},
+		},
+	}}
+	store := &mockVideoBrandingStore{}
+
+	for range 2 {
+		if err := prefetchVideoBranding(context.Background(), store, source, []string{"branded", "plain"}); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+	if source.calls != 2 {
+		t.Fatalf("expected fresh records to be skipped, got %d lookups", source.calls)
+	}
+
+	branded := store.records["branded"]
+	if branded.Title != "Community title" || branded.ThumbnailTime.Float64 != 12 || time.Until(branded.ExpiresAt) < videoBrandingTTL-time.Minute {
+		t.Fatalf("unexpected record %+v", branded)
+	}
+	// Videos without submissions are cached with a shorter TTL
+	if plain := store.records["plain"]; plain.Title != "" || plain.ThumbnailTime.Valid || time.Until(plain.ExpiresAt) > videoBrandingNegativeTTL {
+		t.Fatalf("unexpected negative record %+v", plain)
+	}
+}
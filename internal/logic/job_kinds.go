@@ -0,0 +1,296 @@
+package logic
+
+import (
+	"context"
+	"time"
+
+	"github.com/cufee/feedlr-yt/internal/api/youtube"
+	"github.com/cufee/feedlr-yt/internal/database"
+	"github.com/cufee/feedlr-yt/internal/metrics"
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog/log"
+	"golang.org/x/sync/errgroup"
+)
+
+const (
+	// Finished jobs are kept around for the admin panel, failed jobs longer so they can be looked into and retried
+	succeededJobRetention = 7 * 24 * time.Hour
+	failedJobRetention    = 30 * 24 * time.Hour
+)
+
+type RefreshChannelJob struct {
+	ChannelID string `json:"channelId"`
+	// Limit is the number of latest videos to cache
+	Limit int `json:"limit"`
+}
+
+var JobRefreshChannel = JobType[RefreshChannelJob]{
+	Kind:     "refresh_channel",
+	Options:  JobOptions{Priority: JobPriorityLow, MaxAttempts: 3, Timeout: 5 * time.Minute},
+	DedupKey: func(p RefreshChannelJob) string { return p.ChannelID },
+}
+
+type RefreshVideoJob struct {
+	VideoID string `json:"videoId"`
+}
+
+// Video refreshes are best effort, the next page view queues another one
+var JobRefreshVideo = JobType[RefreshVideoJob]{
+	Kind:     "refresh_video",
+	Options:  JobOptions{Priority: JobPriorityNormal, MaxAttempts: 1, Timeout: 15 * time.Second},
+	DedupKey: func(p RefreshVideoJob) string { return p.VideoID },
+}
+
+type ImportPlaylistJob struct {
+	PlaylistID        string `json:"playlistId"`
+	YouTubePlaylistID string `json:"youtubePlaylistId"`
+}
+
+var JobImportPlaylist = JobType[ImportPlaylistJob]{
+	Kind:     "import_playlist",
+	Options:  JobOptions{Priority: JobPriorityHigh, MaxAttempts: 5, Timeout: 5 * time.Minute},
+	DedupKey: func(p ImportPlaylistJob) string { return p.PlaylistID },
+}
+
+type YouTubeSyncJob struct {
+	UserID string `json:"userId"`
+	// Scheduled syncs are skipped when the account disabled sync after the job was queued
+	Scheduled bool `json:"scheduled"`
+}
+
+// Each attempt spends YouTube API quota, a sync that keeps failing is picked up by the next tick instead
+var JobYouTubeSync = JobType[YouTubeSyncJob]{
+	Kind:     "youtube_sync",
+	Options:  JobOptions{Priority: JobPriorityNormal, MaxAttempts: 3, Timeout: 2 * time.Minute},
+	DedupKey: func(p YouTubeSyncJob) string { return p.UserID },
+}
+
+type CleanupJob struct {
+	Task string `json:"task"`
+}
+
+var JobCleanup = JobType[CleanupJob]{
+	Kind:     "cleanup",
+	Options:  JobOptions{Priority: JobPriorityLow, MaxAttempts: 3, Timeout: time.Minute},
+	DedupKey: func(p CleanupJob) string { return p.Task },
+}
+
+const (
+	CleanupTaskPlaylistItems = "cleanup_expired_playlist_items"
+	CleanupTaskSponsorBlock  = "cleanup_sponsorblock_segments"
+	CleanupTaskVideoBranding = "cleanup_video_branding"
+	CleanupTaskTVSyncJournal = "prune_tv_sync_journal"
+	CleanupTaskJobs          = "prune_jobs"
+)
+
+/*
+RegisterJobHandlers registers the handlers of all job kinds, youtubeSync can be nil when account sync is not configured
+*/
+func RegisterJobHandlers(q *JobQueue, db database.Client, youtubeSync *YouTubeSyncService) {
+	HandleJobs(q, JobRefreshChannel, func(ctx context.Context, p RefreshChannelJob) error {
+		_, err := CacheChannelVideos(ctx, db, p.Limit, p.ChannelID)
+		return err
+	})
+
+	HandleJobs(q, JobRefreshVideo, func(ctx context.Context, p RefreshVideoJob) error {
+		RefreshVideoCache(ctx, db, p.VideoID)
+		return nil
+	})
+
+	HandleJobs(q, JobImportPlaylist, func(ctx context.Context, p ImportPlaylistJob) error {
+		return importYouTubePlaylistItems(ctx, db, p.PlaylistID, p.YouTubePlaylistID)
+	})
+
+	HandleJobs(q, JobSyncPlaylist, func(ctx context.Context, p SyncPlaylistJob) error {
+		return runPlaylistAutoSync(ctx, db, p.PlaylistID)
+	})
+
+	HandleJobs(q, JobImportSubscriptions, func(ctx context.Context, p ImportSubscriptionsJob) error {
+		return importSubscriptions(ctx, db, p.UserID, p.ChannelIDs)
+	})
+
+	HandleJobs(q, JobImportWatchHistory, func(ctx context.Context, p ImportWatchHistoryJob) error {
+		err := importWatchHistory(ctx, db, p.ImportID)
+		if isPermanentJobError(err) {
+			if finishErr := db.FinishWatchHistoryImport(ctx, p.ImportID, database.WatchHistoryImportFailed, err.Error()); finishErr != nil {
+				log.Warn().Err(finishErr).Str("import", p.ImportID).Msg("failed to mark watch history import as failed")
+			}
+		}
+		return err
+	})
+
+	HandleJobs(q, JobYouTubeSync, func(ctx context.Context, p YouTubeSyncJob) error {
+		if youtubeSync == nil {
+			return PermanentJobError(errors.New("youtube sync is not configured"))
+		}
+		account, err := db.GetYouTubeSyncAccountByUserID(ctx, p.UserID)
+		if database.IsErrNotFound(err) {
+			// The account was disconnected after the job was queued
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if p.Scheduled && !account.SyncEnabled {
+			return nil
+		}
+		return youtubeSync.RunSyncForUser(ctx, p.UserID)
+	})
+
+	HandleJobs(q, JobReencryptTokens, func(ctx context.Context, p ReencryptTokensJob) error {
+		if youtubeSync == nil {
+			return PermanentJobError(errors.New("youtube sync is not configured"))
+		}
+		if p.SecretHash != youtubeSync.crypto.secretHash {
+			// The secret was rotated again since the job was queued, the job of the new secret takes over
+			return nil
+		}
+		result, err := ReencryptTokens(ctx, db, youtubeSync.crypto)
+		log.Info().Int("reencrypted", result.Reencrypted).Int("changed", result.Changed).Int("failed", result.Failed).Msg("token re-encryption finished")
+		return err
+	})
+
+	HandleJobs(q, JobProcessSponsorSubmissions, func(ctx context.Context, p ProcessSponsorSubmissionsJob) error {
+		processed, err := ProcessSponsorBlockSubmissions(ctx, db)
+		metrics.ObserveBackgroundTask("process_sponsorblock_submissions", err)
+		if err != nil {
+			return err
+		}
+		if processed > 0 {
+			log.Info().Int("processed", processed).Msg("sponsorblock submissions processed")
+		}
+		return nil
+	})
+
+	HandleJobs(q, JobCacheTranscripts, func(ctx context.Context, p CacheTranscriptsJob) error {
+		checked, err := CacheMissingTranscripts(ctx, db)
+		metrics.ObserveBackgroundTask("cache_missing_transcripts", err)
+		if err != nil {
+			return err
+		}
+		if checked > 0 {
+			log.Info().Int("checked", checked).Msg("transcript caching finished")
+		}
+		return nil
+	})
+
+	HandleJobs(q, JobCleanup, func(ctx context.Context, p CleanupJob) error {
+		deleted, err := runCleanup(ctx, db, p.Task)
+		metrics.ObserveBackgroundTask(p.Task, err)
+		if err != nil {
+			return err
+		}
+		if deleted > 0 {
+			log.Info().Str("task", p.Task).Int64("deleted", deleted).Msg("cleanup finished")
+		}
+		return nil
+	})
+}
+
+func runCleanup(ctx context.Context, db database.Client, task string) (int64, error) {
+	switch task {
+	case CleanupTaskPlaylistItems:
+		return db.CleanupExpiredPlaylistItems(ctx)
+	case CleanupTaskSponsorBlock:
+		return CleanupSponsorBlockSegments(ctx, db)
+	case CleanupTaskVideoBranding:
+		return CleanupVideoBranding(ctx, db)
+	case CleanupTaskTVSyncJournal:
+		return PruneTVSyncJournal(ctx, db)
+	case CleanupTaskJobs:
+		return PruneFinishedJobs(ctx, db)
+	default:
+		return 0, PermanentJobError(errors.New("unknown cleanup task " + task))
+	}
+}
+
+/*
+PruneFinishedJobs deletes succeeded and failed jobs past their retention
+*/
+func PruneFinishedJobs(ctx context.Context, db database.JobsClient) (int64, error) {
+	succeeded, err := db.PruneJobs(ctx, database.JobStatusSucceeded, time.Now().Add(-succeededJobRetention))
+	if err != nil {
+		return 0, errors.Wrap(err, "failed to prune succeeded jobs")
+	}
+	failed, err := db.PruneJobs(ctx, database.JobStatusFailed, time.Now().Add(-failedJobRetention))
+	if err != nil {
+		return succeeded, errors.Wrap(err, "failed to prune failed jobs")
+	}
+	return succeeded + failed, nil
+}
+
+/*
+EnqueueChannelRefreshes queues a refresh for every channel with subscribers, it returns the number of refreshes queued
+*/
+func EnqueueChannelRefreshes(ctx context.Context, db database.Client) (int, error) {
+	channels, err := db.GetChannelsForUpdate(ctx)
+	metrics.ObserveVideoRefresh("cache_all_channels_fetch_channels", err)
+	if err != nil {
+		return 0, err
+	}
+
+	queued := 0
+	for _, id := range channels {
+		ok, err := JobRefreshChannel.Enqueue(ctx, db, RefreshChannelJob{ChannelID: id, Limit: 12})
+		if err != nil {
+			return queued, err
+		}
+		if ok {
+			queued++
+		}
+	}
+	return queued, nil
+}
+
+/*
+EnqueueVideoRefresh queues a refresh of the cached video details when they are missing or stale
+*/
+func EnqueueVideoRefresh(ctx context.Context, db database.Client, videoID string) error {
+	current, err := db.GetVideoByID(ctx, videoID)
+	if err != nil && !database.IsErrNotFound(err) {
+		return errors.Wrap(err, "failed to get video")
+	}
+	if current != nil && videoCacheFresh(current) {
+		return nil
+	}
+	_, err = JobRefreshVideo.Enqueue(ctx, db, RefreshVideoJob{VideoID: videoID})
+	return err
+}
+
+// importYouTubePlaylistItems adds the videos of a YouTube playlist to an imported playlist, items that were already added are skipped
+func importYouTubePlaylistItems(ctx context.Context, db database.Client, playlistID, youtubePlaylistID string) error {
+	if _, err := db.GetPlaylistByID(ctx, playlistID); err != nil {
+		if database.IsErrNotFound(err) {
+			// The playlist was deleted before the import finished
+			return nil
+		}
+		return errors.Wrap(err, "failed to get playlist")
+	}
+
+	videoIDs, err := youtube.DefaultClient.GetAllPlaylistVideoIDs(youtubePlaylistID, youtubePlaylistFetchLimit)
+	if err != nil {
+		return errors.Wrap(err, "failed to fetch playlist videos from YouTube")
+	}
+
+	var group errgroup.Group
+	group.SetLimit(5)
+
+	for idx, vid := range videoIDs {
+		videoID := vid
+		position := (idx + 1) * database.PlaylistPositionGap
+		group.Go(func() error {
+			// Cache the video in our DB
+			RefreshVideoCache(ctx, db, videoID)
+			// Only add if the video exists in the DB (FK constraint)
+			if _, err := db.GetVideoByID(ctx, videoID); err != nil {
+				return nil // skip videos that couldn't be cached
+			}
+			return db.AddPlaylistItemAtPosition(ctx, playlistID, videoID, position)
+		})
+	}
+
+	if err := group.Wait(); err != nil {
+		return errors.Wrap(err, "failed to add playlist items")
+	}
+	return nil
+}
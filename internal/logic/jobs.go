@@ -0,0 +1,491 @@
+package logic
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/rand/v2"
+	"os"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/aarondl/null/v8"
+	"github.com/cufee/feedlr-yt/internal/database"
+	"github.com/cufee/feedlr-yt/internal/metrics"
+	"github.com/cufee/feedlr-yt/internal/tracing"
+	"github.com/cufee/feedlr-yt/internal/types"
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog"
+	"github.com/rs/zerolog/log"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+const (
+	JobPriorityLow    = -10
+	JobPriorityNormal = 0
+	// High priority jobs are started by a user who is waiting for the result
+	JobPriorityHigh = 10
+
+	jobDefaultWorkers     = 4
+	jobDefaultMaxAttempts = 5
+	jobDefaultTimeout     = 5 * time.Minute
+
+	// Claims are renewed every third of the ttl while the job runs, a job of a crashed process is picked up once its claim expires
+	jobLockTTL      = 2 * time.Minute
+	jobPollInterval = 5 * time.Second
+
+	jobRetryBase = 30 * time.Second
+	jobRetryMax  = time.Hour
+
+	jobFinishTimeout  = 5 * time.Second
+	jobCancelGrace    = 5 * time.Second
+	jobLastErrorLimit = 1000
+	jobListLimit      = 50
+)
+
+// jobsWake is poked when a job is queued, so an idle worker of this process picks it up without waiting for the next poll
+var jobsWake = make(chan struct{}, 1)
+
+func wakeJobWorkers() {
+	select {
+	case jobsWake <- struct{}{}:
+	default:
+	}
+}
+
+type permanentJobError struct {
+	err error
+}
+
+func (e permanentJobError) Error() string {
+	return e.err.Error()
+}
+
+func (e permanentJobError) Unwrap() error {
+	return e.err
+}
+
+/*
+PermanentJobError marks a job error that retrying will not fix, the job is moved to the failed jobs right away
+*/
+func PermanentJobError(err error) error {
+	if err == nil {
+		return nil
+	}
+	return permanentJobError{err: err}
+}
+
+func isPermanentJobError(err error) bool {
+	var permanent permanentJobError
+	return errors.As(err, &permanent)
+}
+
+type JobOptions struct {
+	Priority    int
+	MaxAttempts int
+	// Timeout bounds a single attempt
+	Timeout time.Duration
+}
+
+/*
+JobType is a kind of background job with a typed payload. Payloads are stored as JSON.
+*/
+type JobType[T any] struct {
+	Kind    string
+	Options JobOptions
+	// DedupKey keeps the same work from being queued twice while a job is queued or running, an empty key is never deduplicated
+	DedupKey func(payload T) string
+}
+
+/*
+Enqueue queues a job with the default priority of the job type. It reports false when the same work is already queued or running.
+*/
+func (t JobType[T]) Enqueue(ctx context.Context, db database.JobsClient, payload T) (bool, error) {
+	return t.EnqueueWithPriority(ctx, db, payload, t.Options.Priority)
+}
+
+func (t JobType[T]) EnqueueWithPriority(ctx context.Context, db database.JobsClient, payload T, priority int) (bool, error) {
+	encoded, err := json.Marshal(payload)
+	if err != nil {
+		return false, errors.Wrap(err, "failed to encode job payload")
+	}
+
+	job := &database.Job{
+		Kind:        t.Kind,
+		Payload:     string(encoded),
+		Priority:    priority,
+		MaxAttempts: t.maxAttempts(),
+	}
+	if t.DedupKey != nil {
+		if key := t.DedupKey(payload); key != "" {
+			job.DedupKey = null.StringFrom(t.Kind + ":" + key)
+		}
+	}
+
+	ok, err := db.EnqueueJob(ctx, job)
+	if err != nil {
+		metrics.IncJobEvent(ctx, t.Kind, "error")
+		return false, errors.Wrap(err, "failed to enqueue job")
+	}
+	if !ok {
+		metrics.IncJobEvent(ctx, t.Kind, "duplicate")
+		return false, nil
+	}
+	metrics.IncJobEvent(ctx, t.Kind, "queued")
+	wakeJobWorkers()
+	return true, nil
+}
+
+func (t JobType[T]) maxAttempts() int {
+	if t.Options.MaxAttempts > 0 {
+		return t.Options.MaxAttempts
+	}
+	return jobDefaultMaxAttempts
+}
+
+func (t JobType[T]) timeout() time.Duration {
+	if t.Options.Timeout > 0 {
+		return t.Options.Timeout
+	}
+	return jobDefaultTimeout
+}
+
+type jobHandler struct {
+	timeout time.Duration
+	run     func(ctx context.Context, payload string) error
+}
+
+/*
+HandleJobs registers the handler for a job type. Handlers are registered before the queue is started.
+*/
+func HandleJobs[T any](q *JobQueue, t JobType[T], handler func(ctx context.Context, payload T) error) {
+	q.handlers[t.Kind] = &jobHandler{
+		timeout: t.timeout(),
+		run: func(ctx context.Context, raw string) error {
+			var payload T
+			if err := json.Unmarshal([]byte(raw), &payload); err != nil {
+				return PermanentJobError(errors.Wrap(err, "failed to decode job payload"))
+			}
+			return handler(ctx, payload)
+		},
+	}
+}
+
+/*
+JobQueue runs background jobs stored in the database on a pool of workers.
+Every Feedlr process sharing the database runs its own queue, a job is claimed by one worker at a time.
+*/
+type JobQueue struct {
+	db       database.JobsClient
+	workers  int
+	handlers map[string]*jobHandler
+
+	// claimCtx stops workers from claiming new jobs, runCtx cancels jobs that are still running at the stop deadline
+	claimCtx    context.Context
+	stopClaims  context.CancelFunc
+	runCtx      context.Context
+	cancelRuns  context.CancelFunc
+	wg          sync.WaitGroup
+	startedOnce sync.Once
+}
+
+/*
+JobWorkers returns the number of job workers each process runs, set with JOB_WORKERS
+*/
+func JobWorkers() int {
+	workers, err := strconv.Atoi(os.Getenv("JOB_WORKERS"))
+	if err != nil || workers < 1 {
+		return jobDefaultWorkers
+	}
+	return workers
+}
+
+func NewJobQueue(db database.JobsClient, workers int) *JobQueue {
+	if workers < 1 {
+		workers = 1
+	}
+	claimCtx, stopClaims := context.WithCancel(context.Background())
+	runCtx, cancelRuns := context.WithCancel(context.Background())
+	return &JobQueue{
+		db:         db,
+		workers:    workers,
+		handlers:   map[string]*jobHandler{},
+		claimCtx:   claimCtx,
+		stopClaims: stopClaims,
+		runCtx:     runCtx,
+		cancelRuns: cancelRuns,
+	}
+}
+
+func (q *JobQueue) Start() {
+	q.startedOnce.Do(func() {
+		kinds := make([]string, 0, len(q.handlers))
+		for kind := range q.handlers {
+			kinds = append(kinds, kind)
+		}
+		for range q.workers {
+			q.wg.Add(1)
+			go q.work(kinds)
+		}
+	})
+}
+
+/*
+Stop stops claiming jobs and waits for running jobs to finish.
+Jobs still running once the context is done are canceled and put back in the queue, Stop then returns an error.
+*/
+func (q *JobQueue) Stop(ctx context.Context) error {
+	q.stopClaims()
+
+	done := make(chan struct{})
+	go func() {
+		q.wg.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+		q.cancelRuns()
+		return nil
+	case <-ctx.Done():
+	}
+
+	q.cancelRuns()
+	select {
+	case <-done:
+	case <-time.After(jobCancelGrace):
+	}
+	return errors.Wrap(ctx.Err(), "jobs did not finish in time")
+}
+
+func (q *JobQueue) work(kinds []string) {
+	defer q.wg.Done()
+
+	for {
+		if q.claimCtx.Err() != nil {
+			return
+		}
+
+		job, err := q.db.ClaimJob(q.claimCtx, leaseHolderID, kinds, jobLockTTL)
+		if err == nil {
+			// More jobs may be due, let another idle worker look
+			wakeJobWorkers()
+			q.run(job)
+			continue
+		}
+		if !database.IsErrNotFound(err) && q.claimCtx.Err() == nil {
+			log.Warn().Err(err).Msg("failed to claim a job")
+		}
+
+		select {
+		case <-q.claimCtx.Done():
+			return
+		case <-jobsWake:
+		case <-time.After(jobPollInterval):
+		}
+	}
+}
+
+func (q *JobQueue) run(job *database.Job) {
+	// Each job is its own trace, database calls and outbound requests made by the handler are part of it
+	traceCtx, span := tracing.Start(q.runCtx, "job "+job.Kind,
+		attribute.String("job.id", job.ID),
+		attribute.String("job.kind", job.Kind),
+		attribute.Int("job.attempt", job.Attempts),
+	)
+	traceCtx = tracing.WithLogger(traceCtx)
+	var err error
+	defer func() { tracing.End(span, err) }()
+
+	handler, ok := q.handlers[job.Kind]
+	if !ok {
+		err = PermanentJobError(errors.New("no handler for job kind " + job.Kind))
+		q.finish(traceCtx, job, err, false)
+		return
+	}
+	metrics.IncJobEvent(traceCtx, job.Kind, "started")
+
+	ctx, cancel := context.WithTimeout(traceCtx, handler.timeout)
+	defer cancel()
+
+	lost := make(chan struct{})
+	heartbeatDone := make(chan struct{})
+	go func() {
+		defer close(heartbeatDone)
+		q.heartbeat(ctx, job, lost, cancel)
+	}()
+
+	err = runJobHandler(ctx, handler, job.Payload)
+	cancel()
+	<-heartbeatDone
+
+	select {
+	case <-lost:
+		// Another worker claimed the job after our claim expired, it owns the outcome now
+		metrics.IncJobEvent(traceCtx, job.Kind, "lost")
+		zerolog.Ctx(traceCtx).Warn().Str("job", job.ID).Str("kind", job.Kind).Msg("job was claimed by another worker while running")
+		return
+	default:
+	}
+	q.finish(traceCtx, job, err, q.runCtx.Err() != nil)
+}
+
+func runJobHandler(ctx context.Context, handler *jobHandler, payload string) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("job panicked: %v", r)
+		}
+	}()
+	return handler.run(ctx, payload)
+}
+
+func (q *JobQueue) heartbeat(ctx context.Context, job *database.Job, lost chan<- struct{}, cancel context.CancelFunc) {
+	interval := jobLockTTL / 3
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		extendCtx, extendCancel := context.WithTimeout(ctx, interval)
+		ok, err := q.db.ExtendJobLock(extendCtx, job.ID, leaseHolderID, jobLockTTL)
+		extendCancel()
+		if ctx.Err() != nil {
+			return
+		}
+		if err != nil {
+			log.Warn().Err(err).Str("job", job.ID).Msg("failed to renew job claim")
+			continue
+		}
+		if !ok {
+			close(lost)
+			cancel()
+			return
+		}
+	}
+}
+
+func (q *JobQueue) finish(traceCtx context.Context, job *database.Job, err error, interrupted bool) {
+	// The outcome is saved even when the job was canceled by a shutdown
+	ctx, cancel := context.WithTimeout(context.WithoutCancel(traceCtx), jobFinishTimeout)
+	defer cancel()
+
+	logger := zerolog.Ctx(traceCtx).With().Str("job", job.ID).Str("kind", job.Kind).Int("attempt", job.Attempts).Logger()
+
+	var finishErr error
+	switch {
+	case err == nil:
+		metrics.IncJobEvent(ctx, job.Kind, "succeeded")
+		finishErr = q.db.CompleteJob(ctx, job.ID, leaseHolderID)
+
+	case interrupted:
+		// The job was cut short by a shutdown, the attempt does not count against it
+		metrics.IncJobEvent(ctx, job.Kind, "interrupted")
+		logger.Info().Err(err).Msg("job was interrupted by a shutdown")
+		finishErr = q.db.ReleaseJob(ctx, job.ID, leaseHolderID)
+
+	case isPermanentJobError(err) || job.Attempts >= job.MaxAttempts:
+		metrics.IncJobEvent(ctx, job.Kind, "failed")
+		logger.Error().Err(err).Msg("job failed")
+		finishErr = q.db.FailJob(ctx, job.ID, leaseHolderID, jobErrorMessage(err))
+
+	default:
+		delay := jobRetryDelay(job.Attempts)
+		metrics.IncJobEvent(ctx, job.Kind, "retried")
+		logger.Warn().Err(err).Dur("delay", delay).Msg("job failed, retrying")
+		finishErr = q.db.RetryJob(ctx, job.ID, leaseHolderID, time.Now().Add(delay), jobErrorMessage(err))
+	}
+	if finishErr != nil && !database.IsErrNotFound(finishErr) {
+		logger.Warn().Err(finishErr).Msg("failed to save job outcome")
+	}
+}
+
+// jobRetryDelay doubles the delay with every attempt, jitter spreads out jobs that failed together
+func jobRetryDelay(attempts int) time.Duration {
+	delay := jobRetryMax
+	if attempts < 1 {
+		attempts = 1
+	}
+	if attempts <= 10 {
+		delay = min(jobRetryBase<<(attempts-1), jobRetryMax)
+	}
+	jitter := 0.8 + rand.Float64()*0.4
+	return time.Duration(float64(delay) * jitter)
+}
+
+func jobErrorMessage(err error) string {
+	message := err.Error()
+	if len(message) > jobLastErrorLimit {
+		message = message[:jobLastErrorLimit]
+	}
+	return message
+}
+
+/*
+GetJobsOverview returns job counts and the latest running, queued and failed jobs, used by the admin panel
+*/
+func GetJobsOverview(ctx context.Context, db database.JobsClient) (types.JobsOverviewProps, error) {
+	counts, err := db.CountJobs(ctx)
+	if err != nil {
+		return types.JobsOverviewProps{}, errors.Wrap(err, "failed to count jobs")
+	}
+
+	overview := types.JobsOverviewProps{
+		Queued:    counts[database.JobStatusQueued],
+		Running:   counts[database.JobStatusRunning],
+		Succeeded: counts[database.JobStatusSucceeded],
+		Failed:    counts[database.JobStatusFailed],
+	}
+
+	for status, target := range map[string]*[]types.JobProps{
+		database.JobStatusRunning: &overview.RunningJobs,
+		database.JobStatusQueued:  &overview.QueuedJobs,
+		database.JobStatusFailed:  &overview.FailedJobs,
+	} {
+		jobs, err := db.ListJobs(ctx, status, jobListLimit)
+		if err != nil && !database.IsErrNotFound(err) {
+			return types.JobsOverviewProps{}, errors.Wrap(err, "failed to list jobs")
+		}
+		for _, job := range jobs {
+			*target = append(*target, jobProps(job))
+		}
+	}
+	return overview, nil
+}
+
+func jobProps(job *database.Job) types.JobProps {
+	return types.JobProps{
+		ID:          job.ID,
+		Kind:        job.Kind,
+		Payload:     job.Payload,
+		Priority:    job.Priority,
+		Status:      job.Status,
+		Attempts:    job.Attempts,
+		MaxAttempts: job.MaxAttempts,
+		RunAt:       job.RunAt,
+		UpdatedAt:   job.UpdatedAt,
+		LastError:   job.LastError,
+		Holder:      job.LockedBy.String,
+		Local:       job.LockedBy.String == leaseHolderID,
+	}
+}
+
+/*
+RetryFailedJob queues a failed job again with a fresh set of attempts
+*/
+func RetryFailedJob(ctx context.Context, db database.JobsClient, id string) error {
+	if err := db.RequeueJob(ctx, id); err != nil {
+		if database.IsErrNotFound(err) {
+			return errors.New("job is not failed or the same work is already queued")
+		}
+		return errors.Wrap(err, "failed to requeue job")
+	}
+	metrics.IncJobEvent(ctx, "admin", "requeued")
+	wakeJobWorkers()
+	return nil
+}
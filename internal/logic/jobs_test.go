@@ -0,0 +1,356 @@
+package logic
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"sort"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/aarondl/null/v8"
+	"github.com/cufee/feedlr-yt/internal/database"
+	"github.com/lucsky/cuid"
+)
+
+type memoryJobs struct {
+	mu   sync.Mutex
+	jobs map[string]*database.Job
+}
+
+func newMemoryJobs() *memoryJobs {
+	return &memoryJobs{jobs: map[string]*database.Job{}}
+}
+
+func (m *memoryJobs) EnqueueJob(_ context.Context, job *database.Job) (bool, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if job.DedupKey.Valid {
+		for _, existing := range m.jobs {
+			active := existing.Status == database.JobStatusQueued || existing.Status == database.JobStatusRunning
+			if active && existing.DedupKey == job.DedupKey {
+				return false, nil
+			}
+		}
+	}
+	job.ID = cuid.New()
+	job.Status = database.JobStatusQueued
+	if job.RunAt.IsZero() {
+		job.RunAt = time.Now()
+	}
+	cp := *job
+	m.jobs[job.ID] = &cp
+	return true, nil
+}
+
+func (m *memoryJobs) ClaimJob(_ context.Context, holder string, kinds []string, lockTTL time.Duration) (*database.Job, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	now := time.Now()
+	var due []*database.Job
+	for _, job := range m.jobs {
+		queued := job.Status == database.JobStatusQueued && !job.RunAt.After(now)
+		expired := job.Status == database.JobStatusRunning && job.LockedUntil.Time.Before(now)
+		for _, kind := range kinds {
+			if (queued || expired) && job.Kind == kind {
+				due = append(due, job)
+			}
+		}
+	}
+	if len(due) == 0 {
+		return nil, sql.ErrNoRows
+	}
+	sort.Slice(due, func(i, j int) bool {
+		if due[i].Priority != due[j].Priority {
+			return due[i].Priority > due[j].Priority
+		}
+		return due[i].RunAt.Before(due[j].RunAt)
+	})
+
+	job := due[0]
+	job.Status = database.JobStatusRunning
+	job.LockedBy = null.StringFrom(holder)
+	job.LockedUntil = null.TimeFrom(now.Add(lockTTL))
+	job.Attempts++
+	cp := *job
+	return &cp, nil
+}
+
+func (m *memoryJobs) ExtendJobLock(_ context.Context, id, holder string, lockTTL time.Duration) (bool, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	job, ok := m.jobs[id]
+	if !ok || job.Status != database.JobStatusRunning || job.LockedBy.String != holder {
+		return false, nil
+	}
+	job.LockedUntil = null.TimeFrom(time.Now().Add(lockTTL))
+	return true, nil
+}
+
+func (m *memoryJobs) finish(id, holder string, update func(job *database.Job)) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	job, ok := m.jobs[id]
+	if !ok || job.Status != database.JobStatusRunning || job.LockedBy.String != holder {
+		return sql.ErrNoRows
+	}
+	job.LockedBy = null.String{}
+	job.LockedUntil = null.Time{}
+	update(job)
+	return nil
+}
+
+func (m *memoryJobs) CompleteJob(_ context.Context, id, holder string) error {
+	return m.finish(id, holder, func(job *database.Job) {
+		job.Status = database.JobStatusSucceeded
+		job.FinishedAt = null.TimeFrom(time.Now())
+	})
+}
+
+func (m *memoryJobs) RetryJob(_ context.Context, id, holder string, runAt time.Time, lastErr string) error {
+	return m.finish(id, holder, func(job *database.Job) {
+		job.Status = database.JobStatusQueued
+		job.RunAt = runAt
+		job.LastError = lastErr
+	})
+}
+
+func (m *memoryJobs) FailJob(_ context.Context, id, holder string, lastErr string) error {
+	return m.finish(id, holder, func(job *database.Job) {
+		job.Status = database.JobStatusFailed
+		job.LastError = lastErr
+		job.FinishedAt = null.TimeFrom(time.Now())
+	})
+}
+
+func (m *memoryJobs) ReleaseJob(_ context.Context, id, holder string) error {
+	return m.finish(id, holder, func(job *database.Job) {
+		job.Status = database.JobStatusQueued
+		job.Attempts--
+	})
+}
+
+func (m *memoryJobs) RequeueJob(_ context.Context, id string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	job, ok := m.jobs[id]
+	if !ok || job.Status != database.JobStatusFailed {
+		return sql.ErrNoRows
+	}
+	job.Status = database.JobStatusQueued
+	job.Attempts = 0
+	job.RunAt = time.Now()
+	return nil
+}
+
+func (m *memoryJobs) GetJob(_ context.Context, id string) (*database.Job, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	job, ok := m.jobs[id]
+	if !ok {
+		return nil, sql.ErrNoRows
+	}
+	cp := *job
+	return &cp, nil
+}
+
+func (m *memoryJobs) ListJobs(_ context.Context, status string, limit int) ([]*database.Job, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	var jobs []*database.Job
+	for _, job := range m.jobs {
+		if job.Status == status && len(jobs) < limit {
+			cp := *job
+			jobs = append(jobs, &cp)
+		}
+	}
+	return jobs, nil
+}
+
+func (m *memoryJobs) CountJobs(_ context.Context) (map[string]int, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	counts := map[string]int{}
+	for _, job := range m.jobs {
+		counts[job.Status]++
+	}
+	return counts, nil
+}
+
+func (m *memoryJobs) PruneJobs(_ context.Context, status string, finishedBefore time.Time) (int64, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	var deleted int64
+	for id, job := range m.jobs {
+		if job.Status == status && job.FinishedAt.Valid && job.FinishedAt.Time.Before(finishedBefore) {
+			delete(m.jobs, id)
+			deleted++
+		}
+	}
+	return deleted, nil
+}
+
+// only returns the single job of a kind, tests queue one job per kind
+func (m *memoryJobs) only(kind string) *database.Job {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for _, job := range m.jobs {
+		if job.Kind == kind {
+			cp := *job
+			return &cp
+		}
+	}
+	return nil
+}
+
+func waitForJob(t *testing.T, jobs *memoryJobs, kind string, done func(job *database.Job) bool) *database.Job {
+	t.Helper()
+	deadline := time.Now().Add(2 * time.Second)
+	for time.Now().Before(deadline) {
+		if job := jobs.only(kind); job != nil && done(job) {
+			return job
+		}
+		time.Sleep(5 * time.Millisecond)
+	}
+	t.Fatalf("job %s did not reach the expected state: %+v", kind, jobs.only(kind))
+	return nil
+}
+
+type testJob struct {
+	Name string `json:"name"`
+}
+
+func TestJobQueue_RunsRetriesAndFails(t *testing.T) {
+	jobs := newMemoryJobs()
+	ctx := context.Background()
+
+	succeeds := JobType[testJob]{Kind: "test_succeeds", DedupKey: func(p testJob) string { return p.Name }}
+	flaky := JobType[testJob]{Kind: "test_flaky", Options: JobOptions{MaxAttempts: 3}}
+	broken := JobType[testJob]{Kind: "test_broken", Options: JobOptions{MaxAttempts: 3}}
+	exhausted := JobType[testJob]{Kind: "test_exhausted", Options: JobOptions{MaxAttempts: 1}}
+
+	queue := NewJobQueue(jobs, 2)
+	var received []string
+	var mu sync.Mutex
+	HandleJobs(queue, succeeds, func(ctx context.Context, p testJob) error {
+		mu.Lock()
+		received = append(received, p.Name)
+		mu.Unlock()
+		return nil
+	})
+	HandleJobs(queue, flaky, func(ctx context.Context, p testJob) error {
+		return errors.New("temporary")
+	})
+	HandleJobs(queue, broken, func(ctx context.Context, p testJob) error {
+		return PermanentJobError(errors.New("bad input"))
+	})
+	var panics atomic.Int32
+	HandleJobs(queue, exhausted, func(ctx context.Context, p testJob) error {
+		panics.Add(1)
+		panic("handler bug")
+	})
+
+	if ok, err := succeeds.Enqueue(ctx, jobs, testJob{Name: "a"}); err != nil || !ok {
+		t.Fatalf("expected the job to be queued, got %t %v", ok, err)
+	}
+	// The same work is not queued twice
+	if ok, _ := succeeds.Enqueue(ctx, jobs, testJob{Name: "a"}); ok {
+		t.Fatal("expected a duplicate job to be skipped")
+	}
+	for _, job := range []JobType[testJob]{flaky, broken, exhausted} {
+		if _, err := job.Enqueue(ctx, jobs, testJob{}); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+
+	queue.Start()
+	defer queue.Stop(ctx)
+
+	waitForJob(t, jobs, "test_succeeds", func(job *database.Job) bool { return job.Status == database.JobStatusSucceeded })
+	mu.Lock()
+	if len(received) != 1 || received[0] != "a" {
+		t.Fatalf("expected the handler to get the typed payload once, got %v", received)
+	}
+	mu.Unlock()
+
+	// A failed attempt is retried later with backoff
+	job := waitForJob(t, jobs, "test_flaky", func(job *database.Job) bool { return job.LastError != "" })
+	if job.Status != database.JobStatusQueued || job.Attempts != 1 || time.Until(job.RunAt) < jobRetryBase/2 {
+		t.Fatalf("expected the job to be retried after a delay, got %+v", job)
+	}
+
+	// Permanent errors and the last attempt move the job to the failed jobs
+	job = waitForJob(t, jobs, "test_broken", func(job *database.Job) bool { return job.Status == database.JobStatusFailed })
+	if job.Attempts != 1 || job.LastError != "bad input" {
+		t.Fatalf("expected the job to fail without retries, got %+v", job)
+	}
+	job = waitForJob(t, jobs, "test_exhausted", func(job *database.Job) bool { return job.Status == database.JobStatusFailed })
+	if job.LastError != "job panicked: handler bug" {
+		t.Fatalf("expected the panic to be recorded, got %q", job.LastError)
+	}
+
+	if err := RetryFailedJob(ctx, jobs, job.ID); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	waitForJob(t, jobs, "test_exhausted", func(job *database.Job) bool {
+		return panics.Load() == 2 && job.Status == database.JobStatusFailed && job.Attempts == 1
+	})
+
+	overview, err := GetJobsOverview(ctx, jobs)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if overview.Failed != 2 || overview.Queued != 1 || overview.Succeeded != 1 || len(overview.FailedJobs) != 2 {
+		t.Fatalf("unexpected overview: %+v", overview)
+	}
+}
+
+func TestJobQueue_StopReleasesRunningJobs(t *testing.T) {
+	jobs := newMemoryJobs()
+	ctx := context.Background()
+
+	slow := JobType[testJob]{Kind: "test_slow"}
+	queue := NewJobQueue(jobs, 1)
+	started := make(chan struct{})
+	HandleJobs(queue, slow, func(ctx context.Context, p testJob) error {
+		close(started)
+		<-ctx.Done()
+		return ctx.Err()
+	})
+	if _, err := slow.Enqueue(ctx, jobs, testJob{}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	queue.Start()
+	<-started
+
+	stopCtx, cancel := context.WithTimeout(ctx, 20*time.Millisecond)
+	defer cancel()
+	if err := queue.Stop(stopCtx); err == nil {
+		t.Fatal("expected an error for a job that did not finish in time")
+	}
+
+	// The interrupted attempt does not count, the next process runs the job again
+	job := jobs.only("test_slow")
+	if job.Status != database.JobStatusQueued || job.Attempts != 0 || job.LockedBy.Valid {
+		t.Fatalf("expected the job to be put back in the queue, got %+v", job)
+	}
+}
+
+func TestJobRetryDelay(t *testing.T) {
+	previous := time.Duration(0)
+	for attempts := 1; attempts <= 6; attempts++ {
+		delay := jobRetryDelay(attempts)
+		if delay < previous {
+			t.Fatalf("expected the delay to grow, attempt %d got %s after %s", attempts, delay, previous)
+		}
+		previous = delay
+	}
+	if delay := jobRetryDelay(100); delay > jobRetryMax*6/5 {
+		t.Fatalf("expected the delay to be capped, got %s", delay)
+	}
+}
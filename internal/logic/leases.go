@@ -0,0 +1,226 @@
+package logic
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/cufee/feedlr-yt/internal/database"
+	"github.com/cufee/feedlr-yt/internal/metrics"
+	"github.com/cufee/feedlr-yt/internal/types"
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	// Cron leases are kept for a short cooldown after the job, so a process whose scheduler fires a moment later skips the same run
+	cronLeaseTTL      = 2 * time.Minute
+	cronLeaseCooldown = 30 * time.Second
+
+	leaseReleaseTimeout = 5 * time.Second
+)
+
+var leaseHolderID = newLeaseHolderID()
+
+// Replicas often share a hostname pattern and a restarted process can get the same pid, the random part keeps IDs unique
+func newLeaseHolderID() string {
+	host, _ := os.Hostname()
+	if host == "" {
+		host = "feedlr"
+	}
+	suffix := make([]byte, 4)
+	_, _ = rand.Read(suffix)
+	return fmt.Sprintf("%s-%d-%s", host, os.Getpid(), hex.EncodeToString(suffix))
+}
+
+/*
+LeaseHolderID identifies this process in the leases table
+*/
+func LeaseHolderID() string {
+	return leaseHolderID
+}
+
+func leaseKind(name string) string {
+	kind, _, _ := strings.Cut(name, ":")
+	return kind
+}
+
+/*
+Lease is a lease held by this process. It is renewed in the background until it is released,
+the lease context is canceled once the lease is released or lost to another process.
+*/
+type Lease struct {
+	db     database.LeasesClient
+	name   string
+	holder string
+	ttl    time.Duration
+
+	ctx    context.Context
+	cancel context.CancelFunc
+	done   chan struct{}
+
+	lost    atomic.Bool
+	release sync.Once
+}
+
+/*
+AcquireLease takes a lease for this process, it reports false when another process holds it.
+The context is only used for the initial request, the lease is renewed every third of the ttl until it is released.
+*/
+func AcquireLease(ctx context.Context, db database.LeasesClient, name string, ttl time.Duration) (*Lease, bool, error) {
+	ok, err := db.AcquireLease(ctx, name, leaseHolderID, ttl)
+	if err != nil {
+		metrics.IncLeaseEvent(leaseKind(name), "error")
+		return nil, false, errors.Wrap(err, "failed to acquire lease")
+	}
+	if !ok {
+		metrics.IncLeaseEvent(leaseKind(name), "busy")
+		return nil, false, nil
+	}
+	metrics.IncLeaseEvent(leaseKind(name), "acquired")
+
+	leaseCtx, cancel := context.WithCancel(context.Background())
+	lease := &Lease{
+		db:     db,
+		name:   name,
+		holder: leaseHolderID,
+		ttl:    ttl,
+		ctx:    leaseCtx,
+		cancel: cancel,
+		done:   make(chan struct{}),
+	}
+	go lease.heartbeat()
+	return lease, true, nil
+}
+
+/*
+Context is canceled when the lease is released or lost, work done under the lease should stop at that point
+*/
+func (l *Lease) Context() context.Context {
+	return l.ctx
+}
+
+func (l *Lease) heartbeat() {
+	defer close(l.done)
+
+	interval := l.ttl / 3
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	lastRenewed := time.Now()
+	for {
+		select {
+		case <-l.ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		ctx, cancel := context.WithTimeout(l.ctx, interval)
+		ok, err := l.db.AcquireLease(ctx, l.name, l.holder, l.ttl)
+		cancel()
+		if l.ctx.Err() != nil {
+			return
+		}
+		if err != nil {
+			log.Warn().Err(err).Str("lease", l.name).Msg("failed to renew lease")
+			// The lease is still ours until it expires, give up before another process can take it
+			if time.Since(lastRenewed) < l.ttl-interval {
+				continue
+			}
+		}
+		if !ok {
+			l.lost.Store(true)
+			metrics.IncLeaseEvent(leaseKind(l.name), "lost")
+			log.Warn().Str("lease", l.name).Msg("lease was lost to another process")
+			l.cancel()
+			return
+		}
+		lastRenewed = time.Now()
+	}
+}
+
+/*
+Release stops renewing the lease and frees it, so another process can take it over right away
+*/
+func (l *Lease) Release() {
+	l.releaseAfter(0)
+}
+
+/*
+ReleaseAfter stops renewing the lease and keeps it for the hold duration before it expires
+*/
+func (l *Lease) ReleaseAfter(hold time.Duration) {
+	l.releaseAfter(hold)
+}
+
+func (l *Lease) releaseAfter(hold time.Duration) {
+	l.release.Do(func() {
+		l.cancel()
+		<-l.done
+		if l.lost.Load() {
+			return
+		}
+
+		ctx, cancel := context.WithTimeout(context.Background(), leaseReleaseTimeout)
+		defer cancel()
+
+		var err error
+		if hold > 0 {
+			_, err = l.db.AcquireLease(ctx, l.name, l.holder, hold)
+		} else {
+			err = l.db.ReleaseLease(ctx, l.name, l.holder)
+		}
+		if err != nil {
+			log.Warn().Err(err).Str("lease", l.name).Msg("failed to release lease")
+			return
+		}
+		metrics.IncLeaseEvent(leaseKind(l.name), "released")
+	})
+}
+
+/*
+RunExclusive runs a task while holding a lease, so a task scheduled on several Feedlr processes only runs on one of them.
+It reports false without running the task when another process holds the lease. The task context is canceled if the lease is lost.
+*/
+func RunExclusive(ctx context.Context, db database.LeasesClient, name string, task func(ctx context.Context) error) (bool, error) {
+	lease, ok, err := AcquireLease(ctx, db, name, cronLeaseTTL)
+	if err != nil || !ok {
+		return false, err
+	}
+	defer lease.ReleaseAfter(cronLeaseCooldown)
+
+	runCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	stop := context.AfterFunc(lease.Context(), cancel)
+	defer stop()
+
+	return true, task(runCtx)
+}
+
+/*
+GetLeases returns the leases currently held by Feedlr processes, used by the admin panel
+*/
+func GetLeases(ctx context.Context, db database.LeasesClient) ([]types.LeaseProps, error) {
+	leases, err := db.ListLeases(ctx)
+	if err != nil && !database.IsErrNotFound(err) {
+		return nil, errors.Wrap(err, "failed to list leases")
+	}
+
+	props := make([]types.LeaseProps, 0, len(leases))
+	for _, lease := range leases {
+		props = append(props, types.LeaseProps{
+			Name:       lease.Name,
+			Holder:     lease.Holder,
+			AcquiredAt: lease.AcquiredAt,
+			ExpiresAt:  lease.ExpiresAt,
+			Local:      lease.Holder == leaseHolderID,
+		})
+	}
+	return props, nil
+}
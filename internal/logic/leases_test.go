@@ -0,0 +1,239 @@
+package logic
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/cufee/feedlr-yt/internal/api/youtube/lounge"
+	"github.com/cufee/feedlr-yt/internal/database"
+)
+
+type memoryLeases struct {
+	mu     sync.Mutex
+	leases map[string]*database.Lease
+}
+
+func newMemoryLeases() *memoryLeases {
+	return &memoryLeases{leases: map[string]*database.Lease{}}
+}
+
+func (m *memoryLeases) AcquireLease(_ context.Context, name, holder string, ttl time.Duration) (bool, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	now := time.Now()
+	lease, ok := m.leases[name]
+	if ok && lease.Holder != holder && lease.ExpiresAt.After(now) {
+		return false, nil
+	}
+	if !ok || lease.Holder != holder {
+		lease = &database.Lease{Name: name, Holder: holder, AcquiredAt: now}
+		m.leases[name] = lease
+	}
+	lease.ExpiresAt = now.Add(ttl)
+	return true, nil
+}
+
+func (m *memoryLeases) ReleaseLease(_ context.Context, name, holder string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if lease, ok := m.leases[name]; ok && lease.Holder == holder {
+		delete(m.leases, name)
+	}
+	return nil
+}
+
+func (m *memoryLeases) ListLeases(_ context.Context) ([]*database.Lease, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	var leases []*database.Lease
+	for _, lease := range m.leases {
+		cp := *lease
+		leases = append(leases, &cp)
+	}
+	return leases, nil
+}
+
+func (m *memoryLeases) holder(name string) string {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if lease, ok := m.leases[name]; ok {
+		return lease.Holder
+	}
+	return ""
+}
+
+// steal hands a lease to another process, like a process that took over after a network partition
+func (m *memoryLeases) steal(name, holder string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.leases[name] = &database.Lease{Name: name, Holder: holder, AcquiredAt: time.Now(), ExpiresAt: time.Now().Add(time.Hour)}
+}
+
+func TestLease_LostToAnotherProcess(t *testing.T) {
+	leases := newMemoryLeases()
+	ctx := context.Background()
+
+	lease, ok, err := AcquireLease(ctx, leases, "test:lease", 30*time.Millisecond)
+	if err != nil || !ok {
+		t.Fatalf("expected to acquire a free lease, got %t %v", ok, err)
+	}
+	if _, ok, _ := AcquireLease(ctx, leases, "test:other", time.Minute); !ok {
+		t.Fatal("expected leases to be independent")
+	}
+
+	// Heartbeats keep the lease past its ttl
+	time.Sleep(80 * time.Millisecond)
+	if lease.Context().Err() != nil || leases.holder("test:lease") != LeaseHolderID() {
+		t.Fatal("expected the heartbeat to renew the lease")
+	}
+
+	leases.steal("test:lease", "other-process")
+	select {
+	case <-lease.Context().Done():
+	case <-time.After(time.Second):
+		t.Fatal("expected the lease context to be canceled once the lease is lost")
+	}
+
+	// Releasing a lost lease leaves the new holder alone
+	lease.Release()
+	if leases.holder("test:lease") != "other-process" {
+		t.Fatal("expected the new holder to keep the lease")
+	}
+}
+
+func TestRunExclusive(t *testing.T) {
+	leases := newMemoryLeases()
+	ctx := context.Background()
+
+	started := make(chan struct{})
+	finish := make(chan struct{})
+	done := make(chan bool)
+	go func() {
+		ran, err := RunExclusive(ctx, leases, "cron:test", func(ctx context.Context) error {
+			close(started)
+			<-finish
+			return nil
+		})
+		if err != nil {
+			t.Errorf("unexpected error: %v", err)
+		}
+		done <- ran
+	}()
+	<-started
+
+	// Another process sees the lease as taken
+	if ok, _ := leases.AcquireLease(ctx, "cron:test", "other-process", time.Minute); ok {
+		t.Fatal("expected the lease to be held while the task runs")
+	}
+
+	close(finish)
+	if !<-done {
+		t.Fatal("expected the task to run")
+	}
+
+	// The cooldown keeps a scheduler that fires a moment later from running the same job again
+	if ok, _ := leases.AcquireLease(ctx, "cron:test", "other-process", time.Minute); ok {
+		t.Fatal("expected the lease to be kept for the cooldown")
+	}
+
+	leases.steal("cron:test", "other-process")
+	ran, err := RunExclusive(ctx, leases, "cron:test", func(ctx context.Context) error {
+		t.Fatal("expected the task not to run while another process holds the lease")
+		return nil
+	})
+	if err != nil || ran {
+		t.Fatalf("expected the task to be skipped, got %t %v", ran, err)
+	}
+}
+
+type mockTVLeaseStore struct {
+	*mockTVSyncStore
+	*memoryLeases
+}
+
+func TestTVSync_ConnectionTickWithLeases(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer server.Close()
+
+	store := &mockTVLeaseStore{
+		mockTVSyncStore: &mockTVSyncStore{accounts: []*database.YouTubeTVSyncAccount{
+			{ID: "account-1", UserID: "user-1", ScreenID: "screen-1", SyncEnabled: true, ConnectionState: tvSyncStateDisconnected},
+			{ID: "account-2", UserID: "user-2", ScreenID: "screen-2", SyncEnabled: true, ConnectionState: tvSyncStateDisconnected},
+		}},
+		memoryLeases: newMemoryLeases(),
+	}
+	service := &YouTubeTVSyncService{
+		db:           store,
+		crypto:       newYouTubeSyncCrypto("test-tv-sync-secret"),
+		lounge:       lounge.NewClientWithBaseURL(server.Client(), server.URL+"/api/lounge"),
+		reconnectMin: time.Minute,
+		reconnectMax: time.Minute,
+		metrics:      &tvSyncMetrics{},
+		workers:      map[string]*tvSyncWorker{},
+	}
+	ctx := context.Background()
+
+	// Another process already runs the second screen
+	store.steal(tvSyncLeaseName("account-2"), "other-process")
+
+	if err := service.RunConnectionTick(ctx); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if service.worker("account-1") == nil {
+		t.Fatal("expected a worker for the screen with a free lease")
+	}
+	if service.worker("account-2") != nil {
+		t.Fatal("expected no worker for a screen leased by another process")
+	}
+	if store.holder(tvSyncLeaseName("account-1")) != LeaseHolderID() {
+		t.Fatal("expected this process to hold the lease of the first screen")
+	}
+
+	// The other process shuts down and hands the screen over
+	if err := store.ReleaseLease(ctx, tvSyncLeaseName("account-2"), "other-process"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := service.RunConnectionTick(ctx); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if service.worker("account-2") == nil {
+		t.Fatal("expected the released screen to be taken over")
+	}
+
+	// Losing a lease stops the worker
+	worker := service.worker("account-1")
+	store.steal(tvSyncLeaseName("account-1"), "other-process")
+	worker.lease.cancel()
+	select {
+	case <-worker.done:
+	case <-time.After(time.Second):
+		t.Fatal("expected the worker to stop once its lease is gone")
+	}
+	if service.worker("account-1") != nil {
+		t.Fatal("expected the stopped worker to be unregistered")
+	}
+
+	stopCtx, cancel := context.WithTimeout(ctx, time.Second)
+	defer cancel()
+	service.Stop(stopCtx)
+	if holder := store.holder(tvSyncLeaseName("account-2")); holder != "" {
+		t.Fatalf("expected leases to be released on stop, held by %q", holder)
+	}
+	if store.holder(tvSyncLeaseName("account-1")) != "other-process" {
+		t.Fatal("expected the lease taken by another process to be left alone")
+	}
+
+	if err := service.RunConnectionTick(ctx); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if service.worker("account-2") != nil {
+		t.Fatal("expected no workers to start after stop")
+	}
+}
@@ -0,0 +1,123 @@
+package logic
+
+import (
+	"cmp"
+	"context"
+	"hash/fnv"
+	"math/rand/v2"
+	"slices"
+	"strconv"
+
+	"github.com/cufee/feedlr-yt/internal/api/youtube"
+	"github.com/cufee/feedlr-yt/internal/database"
+	"github.com/cufee/feedlr-yt/internal/tracing"
+	"github.com/cufee/feedlr-yt/internal/types"
+	"github.com/pkg/errors"
+)
+
+var ErrPlayerQueueSource = errors.New("unknown player queue source")
+
+/*
+NewPlayerQueueShuffleSeed returns a seed for a new shuffled order of a queue
+*/
+func NewPlayerQueueShuffleSeed() int64 {
+	return rand.Int64N(1<<31) + 1
+}
+
+/*
+GetPlayerQueue builds the queue of a source around the current video, the queue holds the same videos as the TV queue of the source.
+The next video is the first one of the queue when the current video is not part of it, so an empty current video starts the queue.
+*/
+func GetPlayerQueue(ctx context.Context, db database.Client, userID, source, currentVideoID, returnURL string, shuffle int64) (queue *types.PlayerQueueProps, err error) {
+	ctx, span := tracing.Start(ctx, "logic.GetPlayerQueue")
+	defer func() { tracing.End(span, err) }()
+
+	queue = &types.PlayerQueueProps{Source: source, ReturnURL: returnURL, Shuffle: shuffle, Position: -1}
+
+	var videoIDs []string
+	switch source {
+	case types.PlayerQueueFeed:
+		queue.Title = "Feed"
+		videoIDs, err = GetFeedTVQueue(ctx, db, userID)
+	case types.PlayerQueueWatchLater:
+		queue.Title = "Watch Later"
+		videoIDs, err = GetWatchLaterTVQueue(ctx, db, userID)
+	default:
+		playlistID, ok := types.ParsePlayerQueuePlaylist(source)
+		if !ok {
+			return nil, ErrPlayerQueueSource
+		}
+		playlist, perr := db.GetPlaylistByID(ctx, playlistID)
+		if perr != nil {
+			return nil, errors.Wrap(perr, "failed to get playlist")
+		}
+		if playlist.UserID != userID {
+			return nil, errors.New("playlist not found")
+		}
+		queue.Title = playlist.Name
+		videoIDs, err = GetPlaylistTVQueue(ctx, db, userID, playlistID)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if shuffle != 0 {
+		shufflePlayerQueue(videoIDs, shuffle)
+	} else {
+		queue.ToggleShuffle = NewPlayerQueueShuffleSeed()
+	}
+
+	if len(videoIDs) > 0 {
+		records, err := db.FindVideos(ctx, database.Video.ID(videoIDs...), database.Video.WithChannel())
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to find queue videos")
+		}
+		videos := make(map[string]types.VideoProps, len(records))
+		for _, video := range records {
+			var channelProps types.ChannelProps
+			if video.R != nil && video.R.Channel != nil {
+				channelProps = types.ChannelModelToProps(video.R.Channel)
+			}
+			videos[video.ID] = types.VideoModelToProps(video, channelProps)
+		}
+		for _, id := range videoIDs {
+			video, ok := videos[id]
+			if !ok || !playableInQueue(video) {
+				continue
+			}
+			queue.Videos = append(queue.Videos, video)
+		}
+		applyUserVideoTitles(ctx, db, userID, queue.Videos)
+	}
+
+	queue.Position = slices.IndexFunc(queue.Videos, func(video types.VideoProps) bool { return video.ID == currentVideoID })
+	switch {
+	case queue.Position >= 0 && queue.Position+1 < len(queue.Videos):
+		queue.Next = &queue.Videos[queue.Position+1]
+	case queue.Position < 0 && len(queue.Videos) > 0:
+		queue.Next = &queue.Videos[0]
+	}
+	return queue, nil
+}
+
+// playableInQueue leaves out videos the player cannot open, they would send the queue off to YouTube
+func playableInQueue(video types.VideoProps) bool {
+	switch video.Type {
+	case youtube.VideoTypePrivate, youtube.VideoTypeFailed, youtube.VideoTypeUpcomingStream:
+		return false
+	default:
+		return true
+	}
+}
+
+// shufflePlayerQueue orders videos by a hash of the seed and their id, a video keeps its place in the order when other videos leave the queue
+func shufflePlayerQueue(videoIDs []string, seed int64) {
+	prefix := strconv.FormatInt(seed, 10) + ":"
+	key := func(id string) uint64 {
+		h := fnv.New64a()
+		h.Write([]byte(prefix + id))
+		return h.Sum64()
+	}
+	slices.SortStableFunc(videoIDs, func(a, b string) int {
+		return cmp.Compare(key(a), key(b))
+	})
+}
@@ -0,0 +1,43 @@
+package logic
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/cufee/feedlr-yt/internal/types"
+	"github.com/matryer/is"
+)
+
+func TestShufflePlayerQueue(t *testing.T) {
+	is := is.New(t)
+
+	ids := []string{"a", "b", "c", "d", "e", "f", "g", "h"}
+	shuffled := slices.Clone(ids)
+	shufflePlayerQueue(shuffled, 42)
+	is.True(!slices.Equal(shuffled, ids))
+
+	again := slices.Clone(ids)
+	shufflePlayerQueue(again, 42)
+	is.Equal(again, shuffled) // the same seed gives the same order
+
+	// A finished video leaving the queue keeps the order of the rest
+	removed := slices.DeleteFunc(slices.Clone(ids), func(id string) bool { return id == shuffled[2] })
+	shufflePlayerQueue(removed, 42)
+	is.Equal(removed, slices.Delete(slices.Clone(shuffled), 2, 3))
+}
+
+func TestPlayerQueueURLs(t *testing.T) {
+	is := is.New(t)
+
+	source := types.PlayerQueuePlaylist("p1")
+	id, ok := types.ParsePlayerQueuePlaylist(source)
+	is.True(ok)
+	is.Equal(id, "p1")
+	_, ok = types.ParsePlayerQueuePlaylist(types.PlayerQueueWatchLater)
+	is.True(!ok)
+
+	queue := types.PlayerQueueProps{Source: source, ReturnURL: "/app/playlist/p1", ToggleShuffle: 7}
+	is.Equal(queue.VideoURL("v1"), "/video/v1?queue=playlist%3Ap1&return=%2Fapp%2Fplaylist%2Fp1")
+	is.Equal(queue.ShuffleURL("v1"), "/video/v1?queue=playlist%3Ap1&return=%2Fapp%2Fplaylist%2Fp1&shuffle=7")
+	is.Equal(types.PlayerQueueStartURL(types.PlayerQueueFeed, "/app", true), "/app/queue?return=%2Fapp&shuffle=true&source=feed")
+}
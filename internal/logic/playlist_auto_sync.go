@@ -0,0 +1,271 @@
+package logic
+
+import (
+	"context"
+	"slices"
+	"sync/atomic"
+	"time"
+
+	"github.com/aarondl/null/v8"
+	"github.com/cufee/feedlr-yt/internal/api/youtube"
+	"github.com/cufee/feedlr-yt/internal/database"
+	"github.com/cufee/feedlr-yt/internal/database/models"
+	"github.com/cufee/feedlr-yt/internal/tracing"
+	"github.com/cufee/feedlr-yt/internal/types"
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog/log"
+	"golang.org/x/sync/errgroup"
+)
+
+const (
+	// Imports and syncs read at most this many videos of a YouTube playlist
+	youtubePlaylistFetchLimit = 500
+	// Due playlists queued by a single tick, the rest are picked up by the next one
+	playlistAutoSyncBatchSize = 100
+)
+
+var ErrInvalidAutoSyncSettings = errors.New("invalid auto sync settings")
+
+type SyncPlaylistJob struct {
+	PlaylistID string `json:"playlistId"`
+}
+
+// Failed syncs are stored on the playlist, the next scheduled sync tries again
+var JobSyncPlaylist = JobType[SyncPlaylistJob]{
+	Kind:     "sync_playlist",
+	Options:  JobOptions{Priority: JobPriorityLow, MaxAttempts: 3, Timeout: 5 * time.Minute},
+	DedupKey: func(p SyncPlaylistJob) string { return p.PlaylistID },
+}
+
+type PlaylistSyncResult struct {
+	Added   int
+	Removed int
+	// Skipped are videos of the YouTube playlist that are deleted or private
+	Skipped int
+}
+
+/*
+UpdatePlaylistAutoSync changes the auto sync interval and mode of an imported playlist.
+Turning auto sync on schedules the next sync one interval after the last one, or right away when that time has passed.
+*/
+func UpdatePlaylistAutoSync(ctx context.Context, db database.PlaylistsClient, userID, playlistID string, intervalHours int64, mode string) error {
+	if !slices.Contains(types.PlaylistAutoSyncIntervals, intervalHours) {
+		return ErrInvalidAutoSyncSettings
+	}
+	if mode != database.PlaylistSyncAppend && mode != database.PlaylistSyncMirror {
+		return ErrInvalidAutoSyncSettings
+	}
+
+	playlist, err := db.GetPlaylistByID(ctx, playlistID)
+	if err != nil {
+		return errors.Wrap(err, "failed to get playlist")
+	}
+	if playlist.UserID != userID || playlist.System {
+		return errors.New("cannot sync this playlist")
+	}
+	if !playlist.YoutubePlaylistID.Valid || playlist.YoutubePlaylistID.String == "" {
+		return errors.New("playlist is not imported from YouTube")
+	}
+
+	options := database.PlaylistAutoSyncOptions{IntervalHours: intervalHours, Mode: mode}
+	if intervalHours > 0 {
+		options.NextSyncAt = null.TimeFrom(nextPlaylistSyncAt(playlist, intervalHours, time.Now()))
+	}
+	return db.UpdatePlaylistAutoSync(ctx, playlistID, options)
+}
+
+/*
+EnqueueDuePlaylistSyncs queues a sync for every playlist that is due for one, it returns the number of syncs queued.
+The next sync is scheduled before the job is queued, so a playlist is not queued again while its sync is running.
+*/
+func EnqueueDuePlaylistSyncs(ctx context.Context, db database.Client) (queued int, err error) {
+	ctx, span := tracing.Start(ctx, "logic.EnqueueDuePlaylistSyncs")
+	defer func() { tracing.End(span, err) }()
+
+	now := time.Now()
+	playlists, err := db.GetPlaylistsDueForSync(ctx, now, playlistAutoSyncBatchSize)
+	if err != nil {
+		return 0, errors.Wrap(err, "failed to get playlists due for sync")
+	}
+
+	for _, playlist := range playlists {
+		next := now.Add(time.Duration(playlist.AutoSyncIntervalHours) * time.Hour)
+		if err := db.SetPlaylistNextSyncAt(ctx, playlist.ID, null.TimeFrom(next)); err != nil {
+			return queued, errors.Wrap(err, "failed to schedule next playlist sync")
+		}
+		ok, err := JobSyncPlaylist.Enqueue(ctx, db, SyncPlaylistJob{PlaylistID: playlist.ID})
+		if err != nil {
+			return queued, err
+		}
+		if ok {
+			queued++
+		}
+	}
+	return queued, nil
+}
+
+// runPlaylistAutoSync syncs a playlist queued by EnqueueDuePlaylistSyncs, playlists that were deleted or had auto sync turned off since are skipped
+func runPlaylistAutoSync(ctx context.Context, db database.Client, playlistID string) error {
+	playlist, err := db.GetPlaylistByID(ctx, playlistID)
+	if database.IsErrNotFound(err) {
+		return nil
+	}
+	if err != nil {
+		return errors.Wrap(err, "failed to get playlist")
+	}
+	if playlist.AutoSyncIntervalHours <= 0 || !playlist.YoutubePlaylistID.Valid || playlist.YoutubePlaylistID.String == "" {
+		return nil
+	}
+
+	result, err := syncYouTubePlaylist(ctx, db, playlist)
+	if err != nil {
+		return err
+	}
+	if result.Added > 0 || result.Removed > 0 {
+		log.Info().Str("playlist", playlistID).Int("added", result.Added).Int("removed", result.Removed).Int("skipped", result.Skipped).Msg("playlist auto sync finished")
+	}
+	return nil
+}
+
+// syncYouTubePlaylist syncs the items of an imported playlist and stores the outcome as its sync status
+func syncYouTubePlaylist(ctx context.Context, db database.Client, playlist *models.Playlist) (result PlaylistSyncResult, err error) {
+	ctx, span := tracing.Start(ctx, "logic.syncYouTubePlaylist")
+	defer func() { tracing.End(span, err) }()
+
+	result, err = syncYouTubePlaylistItems(ctx, db, playlist.ID, playlist.YoutubePlaylistID.String, playlist.SyncMode == database.PlaylistSyncMirror)
+
+	status := database.PlaylistSyncResult{}
+	if err != nil {
+		status.LastSyncError = err.Error()
+	} else {
+		status.LastSyncedAt = null.TimeFrom(time.Now())
+	}
+	if statusErr := db.UpdatePlaylistSyncResult(ctx, playlist.ID, status); statusErr != nil && !database.IsErrNotFound(statusErr) {
+		log.Warn().Err(statusErr).Str("playlist", playlist.ID).Msg("failed to save playlist sync status")
+	}
+	if err != nil {
+		return result, err
+	}
+
+	if result.Added > 0 || result.Removed > 0 {
+		// The playlist list is ordered by the last update, playlists that changed move to the top
+		playlist.UpdatedAt = time.Now()
+		_ = db.UpdatePlaylist(ctx, playlist)
+	}
+	return result, nil
+}
+
+// syncYouTubePlaylistItems adds videos from a YouTube playlist that are missing from a playlist at the end of it, with mirror set videos that are no longer on YouTube are removed
+func syncYouTubePlaylistItems(ctx context.Context, db database.Client, playlistID, youtubePlaylistID string, mirror bool) (PlaylistSyncResult, error) {
+	var result PlaylistSyncResult
+
+	existingItems, err := db.GetPlaylistItems(ctx, playlistID)
+	if err != nil {
+		return result, errors.Wrap(err, "failed to get existing items")
+	}
+	existingIDs := make(map[string]bool, len(existingItems))
+	for _, item := range existingItems {
+		existingIDs[item.VideoID] = true
+	}
+
+	ytVideoIDs, err := youtube.DefaultClient.GetAllPlaylistVideoIDs(youtubePlaylistID, youtubePlaylistFetchLimit)
+	if err != nil {
+		return result, errors.Wrap(err, "failed to fetch YouTube playlist")
+	}
+
+	maxPos, err := db.GetMaxPlaylistItemPosition(ctx, playlistID)
+	if err != nil {
+		return result, errors.Wrap(err, "failed to get max position")
+	}
+
+	var added, skipped, failed atomic.Int32
+	var group errgroup.Group
+	group.SetLimit(5)
+
+	for _, vid := range ytVideoIDs {
+		videoID := vid
+		if existingIDs[videoID] {
+			continue
+		}
+
+		maxPos += database.PlaylistPositionGap
+		pos := maxPos
+
+		group.Go(func() error {
+			// Only add if the video exists in the DB (FK constraint)
+			err := RefreshVideoCache(ctx, db, videoID)
+			if errors.Is(err, ErrVideoUnavailable) {
+				// Deleted and private videos stay in YouTube playlists, they never become available
+				skipped.Add(1)
+				return nil
+			}
+			if err != nil {
+				failed.Add(1)
+				return errors.Wrapf(err, "failed to cache video %s", videoID)
+			}
+			if err := db.AddPlaylistItemAtPosition(ctx, playlistID, videoID, pos); err != nil {
+				failed.Add(1)
+				return errors.Wrapf(err, "failed to add video %s", videoID)
+			}
+			added.Add(1)
+			return nil
+		})
+	}
+
+	// Videos that failed are retried by the next sync, the error is stored on the playlist so the sync does not look successful
+	addErr := group.Wait()
+	result.Added = int(added.Load())
+	result.Skipped = int(skipped.Load())
+	if addErr != nil {
+		addErr = errors.Wrapf(addErr, "failed to add %d of %d videos", failed.Load(), failed.Load()+added.Load())
+	}
+
+	// Videos past the fetch limit and empty responses cannot be told apart from removed videos
+	if !mirror || len(ytVideoIDs) == 0 || len(ytVideoIDs) >= youtubePlaylistFetchLimit {
+		return result, addErr
+	}
+
+	for _, videoID := range removedPlaylistVideos(existingItems, ytVideoIDs) {
+		if err := db.RemovePlaylistItem(ctx, playlistID, videoID); err != nil {
+			return result, errors.Wrap(err, "failed to remove playlist item")
+		}
+		result.Removed++
+	}
+	return result, addErr
+}
+
+// removedPlaylistVideos returns the videos of playlist items that are not in the YouTube playlist
+func removedPlaylistVideos(items []*models.PlaylistItem, youtubeVideoIDs []string) []string {
+	current := make(map[string]struct{}, len(youtubeVideoIDs))
+	for _, id := range youtubeVideoIDs {
+		current[id] = struct{}{}
+	}
+
+	var removed []string
+	for _, item := range items {
+		if _, ok := current[item.VideoID]; !ok {
+			removed = append(removed, item.VideoID)
+		}
+	}
+	return removed
+}
+
+// playlistSyncedAt is when a playlist was last synced, playlists synced before the sync status was stored fall back to the last update
+func playlistSyncedAt(playlist *models.Playlist) time.Time {
+	if playlist.LastSyncedAt.Valid {
+		return playlist.LastSyncedAt.Time
+	}
+	return playlist.UpdatedAt
+}
+
+// nextPlaylistSyncAt is one interval after the last sync, or now when that time has already passed
+func nextPlaylistSyncAt(playlist *models.Playlist, intervalHours int64, now time.Time) time.Time {
+	if !playlist.LastSyncedAt.Valid {
+		return now
+	}
+	next := playlist.LastSyncedAt.Time.Add(time.Duration(intervalHours) * time.Hour)
+	if next.Before(now) {
+		return now
+	}
+	return next
+}
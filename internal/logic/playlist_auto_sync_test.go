@@ -0,0 +1,35 @@
+package logic
+
+import (
+	"testing"
+	"time"
+
+	"github.com/aarondl/null/v8"
+	"github.com/cufee/feedlr-yt/internal/database/models"
+	"github.com/matryer/is"
+)
+
+func TestRemovedPlaylistVideos(t *testing.T) {
+	is := is.New(t)
+
+	items := []*models.PlaylistItem{{VideoID: "a"}, {VideoID: "b"}, {VideoID: "c"}}
+	is.Equal(removedPlaylistVideos(items, []string{"c", "a", "d"}), []string{"b"})
+	is.Equal(len(removedPlaylistVideos(items, []string{"a", "b", "c"})), 0)
+}
+
+func TestNextPlaylistSyncAt(t *testing.T) {
+	is := is.New(t)
+
+	now := time.Date(2026, 10, 19, 12, 0, 0, 0, time.UTC)
+
+	// Never synced, sync right away
+	is.Equal(nextPlaylistSyncAt(&models.Playlist{}, 24, now), now)
+
+	// Synced recently, wait for the interval
+	recent := &models.Playlist{LastSyncedAt: null.TimeFrom(now.Add(-2 * time.Hour))}
+	is.Equal(nextPlaylistSyncAt(recent, 24, now), now.Add(22*time.Hour))
+
+	// The interval already passed
+	stale := &models.Playlist{LastSyncedAt: null.TimeFrom(now.Add(-48 * time.Hour))}
+	is.Equal(nextPlaylistSyncAt(stale, 24, now), now)
+}
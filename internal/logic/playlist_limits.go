@@ -0,0 +1,96 @@
+package logic
+
+import (
+	"context"
+	"slices"
+
+	"github.com/aarondl/null/v8"
+	"github.com/cufee/feedlr-yt/internal/database"
+	"github.com/cufee/feedlr-yt/internal/types"
+	"github.com/pkg/errors"
+)
+
+var ErrInvalidPlaylistLimits = errors.New("invalid playlist limits")
+
+/*
+ErrPlaylistFull is returned when a video is added to a full playlist and the overflow policy cannot make room for it
+*/
+var ErrPlaylistFull = database.ErrPlaylistFull
+
+// playlistLimitsRecord validates limits picked by a user, an empty overflow policy drops the oldest items
+func playlistLimitsRecord(limits types.PlaylistLimits) (database.PlaylistLimits, error) {
+	if !slices.Contains(types.PlaylistTTLOptions, limits.TTLDays) {
+		return database.PlaylistLimits{}, ErrInvalidPlaylistLimits
+	}
+	if limits.MaxSize < 0 || limits.MaxSize > types.PlaylistMaxSizeLimit {
+		return database.PlaylistLimits{}, ErrInvalidPlaylistLimits
+	}
+
+	record := database.PlaylistLimits{OverflowPolicy: limits.OverflowPolicy}
+	switch record.OverflowPolicy {
+	case "":
+		record.OverflowPolicy = database.PlaylistOverflowDropOldest
+	case database.PlaylistOverflowReject, database.PlaylistOverflowDropOldest, database.PlaylistOverflowDropWatched:
+	default:
+		return database.PlaylistLimits{}, ErrInvalidPlaylistLimits
+	}
+	if limits.TTLDays > 0 {
+		record.TTLDays = null.Int64From(limits.TTLDays)
+	}
+	if limits.MaxSize > 0 {
+		record.MaxSize = null.Int64From(limits.MaxSize)
+	}
+	return record, nil
+}
+
+/*
+UpdatePlaylistLimits changes how long videos stay in a custom playlist and how many it holds.
+A lower size limit is applied when the next video is added, expired videos are removed by the cleanup job.
+*/
+func UpdatePlaylistLimits(ctx context.Context, db database.PlaylistsClient, userID, playlistID string, limits types.PlaylistLimits) error {
+	record, err := playlistLimitsRecord(limits)
+	if err != nil {
+		return err
+	}
+	if err := checkPlaylistModifiable(ctx, db, userID, playlistID); err != nil {
+		return err
+	}
+	return db.UpdatePlaylistLimits(ctx, playlistID, record)
+}
+
+/*
+GetWatchLaterTTL returns how many days videos stay in Watch Later, 0 keeps them until they are watched or removed
+*/
+func GetWatchLaterTTL(ctx context.Context, db database.PlaylistsClient, userID string) (int64, error) {
+	playlist, err := db.GetPlaylistBySlug(ctx, userID, WatchLaterSlug)
+	if database.IsErrNotFound(err) {
+		return database.DefaultWatchLaterTTLDays, nil
+	}
+	if err != nil {
+		return 0, errors.Wrap(err, "failed to get watch later playlist")
+	}
+	return playlist.TTLDays.Int64, nil
+}
+
+/*
+SetWatchLaterTTL changes how many days videos stay in Watch Later, the size limit of the playlist is left as is
+*/
+func SetWatchLaterTTL(ctx context.Context, db database.PlaylistsClient, userID string, days int64) error {
+	if !slices.Contains(types.PlaylistTTLOptions, days) {
+		return ErrInvalidPlaylistLimits
+	}
+
+	playlist, err := GetOrCreateWatchLater(ctx, db, userID)
+	if err != nil {
+		return err
+	}
+
+	limits := database.PlaylistLimits{MaxSize: playlist.MaxSize, OverflowPolicy: playlist.OverflowPolicy}
+	if limits.OverflowPolicy == "" {
+		limits.OverflowPolicy = database.PlaylistOverflowDropOldest
+	}
+	if days > 0 {
+		limits.TTLDays = null.Int64From(days)
+	}
+	return db.UpdatePlaylistLimits(ctx, playlist.ID, limits)
+}
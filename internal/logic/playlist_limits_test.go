@@ -0,0 +1,35 @@
+package logic
+
+import (
+	"testing"
+
+	"github.com/aarondl/null/v8"
+	"github.com/cufee/feedlr-yt/internal/database"
+	"github.com/cufee/feedlr-yt/internal/types"
+	"github.com/matryer/is"
+	"github.com/pkg/errors"
+)
+
+func TestPlaylistLimitsRecord(t *testing.T) {
+	is := is.New(t)
+
+	// No limits keep the existing behavior of dropping the oldest videos
+	record, err := playlistLimitsRecord(types.PlaylistLimits{})
+	is.NoErr(err)
+	is.Equal(record, database.PlaylistLimits{OverflowPolicy: database.PlaylistOverflowDropOldest})
+
+	record, err = playlistLimitsRecord(types.PlaylistLimits{TTLDays: 30, MaxSize: 50, OverflowPolicy: database.PlaylistOverflowDropWatched})
+	is.NoErr(err)
+	is.Equal(record, database.PlaylistLimits{TTLDays: null.Int64From(30), MaxSize: null.Int64From(50), OverflowPolicy: database.PlaylistOverflowDropWatched})
+
+	for _, limits := range []types.PlaylistLimits{
+		{TTLDays: 3},
+		{TTLDays: -1},
+		{MaxSize: -1},
+		{MaxSize: types.PlaylistMaxSizeLimit + 1},
+		{OverflowPolicy: "drop_newest"},
+	} {
+		_, err := playlistLimitsRecord(limits)
+		is.True(errors.Is(err, ErrInvalidPlaylistLimits))
+	}
+}
@@ -0,0 +1,255 @@
+package logic
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"strings"
+	"time"
+
+	"github.com/aarondl/null/v8"
+	"github.com/cufee/feedlr-yt/internal/database"
+	"github.com/cufee/feedlr-yt/internal/database/models"
+	"github.com/cufee/feedlr-yt/internal/tracing"
+	"github.com/cufee/feedlr-yt/internal/types"
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog/log"
+)
+
+var ErrPlaylistShareLinkInvalid = errors.New("share link is invalid or expired")
+
+// YouTube ignores anything past the first 50 videos of a watch_videos link
+const youtubeWatchVideosLimit = 50
+
+/*
+SharedPlaylistPath is the public page of a share link token
+*/
+func SharedPlaylistPath(token string) string {
+	return "/shared/playlist/" + token
+}
+
+/*
+YouTubeWatchVideosURL opens videos as an unsaved playlist on YouTube, only the first 50 videos are included
+*/
+func YouTubeWatchVideosURL(videoIDs []string) string {
+	if len(videoIDs) == 0 {
+		return ""
+	}
+	videoIDs = videoIDs[:min(len(videoIDs), youtubeWatchVideosLimit)]
+	return "https://www.youtube.com/watch_videos?video_ids=" + strings.Join(videoIDs, ",")
+}
+
+func videoPropsIDs(videos ...[]types.VideoProps) []string {
+	var ids []string
+	for _, list := range videos {
+		for _, video := range list {
+			ids = append(ids, video.ID)
+		}
+	}
+	return ids
+}
+
+/*
+CreatePlaylistShareLink creates a public read-only link to a playlist of the user, a link with expiresInDays of 0 stays valid until it is revoked.
+The URL of the returned link is the only copy of the token, it is a path the caller should prefix with the server address.
+*/
+func CreatePlaylistShareLink(ctx context.Context, db interface {
+	database.PlaylistsClient
+	database.PlaylistShareLinksClient
+}, userID, playlistID string, expiresInDays int) (types.PlaylistShareLinkProps, error) {
+	playlist, err := db.GetPlaylistByID(ctx, playlistID)
+	if err != nil {
+		return types.PlaylistShareLinkProps{}, errors.Wrap(err, "failed to get playlist")
+	}
+	if playlist.UserID != userID {
+		return types.PlaylistShareLinkProps{}, errors.New("cannot share this playlist")
+	}
+	if !types.ValidPlaylistShareExpiry(expiresInDays) {
+		return types.PlaylistShareLinkProps{}, errors.Errorf("invalid link expiry %d", expiresInDays)
+	}
+
+	raw := make([]byte, 24)
+	if _, err := rand.Read(raw); err != nil {
+		return types.PlaylistShareLinkProps{}, errors.Wrap(err, "failed to generate share token")
+	}
+	token := hex.EncodeToString(raw)
+
+	link := &models.PlaylistShareLink{
+		PlaylistID: playlistID,
+		TokenHash:  HashString(token),
+	}
+	if expiresInDays > 0 {
+		link.ExpiresAt = null.TimeFrom(time.Now().UTC().AddDate(0, 0, expiresInDays))
+	}
+	if err := db.CreatePlaylistShareLink(ctx, link); err != nil {
+		return types.PlaylistShareLinkProps{}, errors.Wrap(err, "failed to save share link")
+	}
+
+	props := playlistShareLinkProps(link)
+	props.URL = SharedPlaylistPath(token)
+	return props, nil
+}
+
+/*
+GetPlaylistShareLinks returns the share links of a playlist of the user, expired links are included until they are revoked
+*/
+func GetPlaylistShareLinks(ctx context.Context, db interface {
+	database.PlaylistsClient
+	database.PlaylistShareLinksClient
+}, userID, playlistID string) ([]types.PlaylistShareLinkProps, error) {
+	playlist, err := db.GetPlaylistByID(ctx, playlistID)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get playlist")
+	}
+	if playlist.UserID != userID {
+		return nil, errors.New("playlist not found")
+	}
+
+	links, err := db.GetPlaylistShareLinks(ctx, playlistID)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get share links")
+	}
+	props := make([]types.PlaylistShareLinkProps, 0, len(links))
+	for _, link := range links {
+		props = append(props, playlistShareLinkProps(link))
+	}
+	return props, nil
+}
+
+/*
+RevokePlaylistShareLink deletes a share link, the shared page stops working right away
+*/
+func RevokePlaylistShareLink(ctx context.Context, db interface {
+	database.PlaylistsClient
+	database.PlaylistShareLinksClient
+}, userID, playlistID, linkID string) error {
+	playlist, err := db.GetPlaylistByID(ctx, playlistID)
+	if err != nil {
+		return errors.Wrap(err, "failed to get playlist")
+	}
+	if playlist.UserID != userID {
+		return errors.New("cannot modify this playlist")
+	}
+	return db.DeletePlaylistShareLink(ctx, playlistID, linkID)
+}
+
+func playlistShareLinkProps(link *models.PlaylistShareLink) types.PlaylistShareLinkProps {
+	props := types.PlaylistShareLinkProps{ID: link.ID, CreatedAt: link.CreatedAt}
+	if link.ExpiresAt.Valid {
+		props.ExpiresAt = link.ExpiresAt.Time
+	}
+	return props
+}
+
+/*
+GetSharedPlaylistProps returns the playlist a share link token points to, unknown, revoked and expired tokens return ErrPlaylistShareLinkInvalid.
+Videos are listed in the order of the playlist without the progress of the owner, smart playlists are evaluated for the owner.
+*/
+func GetSharedPlaylistProps(ctx context.Context, db interface {
+	database.PlaylistsClient
+	database.PlaylistShareLinksClient
+	database.SmartPlaylistsClient
+	database.ViewsClient
+}, token string) (props types.SharedPlaylistPageProps, err error) {
+	ctx, span := tracing.Start(ctx, "logic.GetSharedPlaylistProps")
+	defer func() { tracing.End(span, err) }()
+
+	token = strings.TrimSpace(token)
+	if token == "" {
+		return props, ErrPlaylistShareLinkInvalid
+	}
+	link, err := db.GetPlaylistShareLinkByTokenHash(ctx, HashString(token))
+	if database.IsErrNotFound(err) {
+		return props, ErrPlaylistShareLinkInvalid
+	}
+	if err != nil {
+		return props, errors.Wrap(err, "failed to get share link")
+	}
+
+	playlist, err := db.GetPlaylistByID(ctx, link.PlaylistID)
+	if err != nil {
+		return props, errors.Wrap(err, "failed to get playlist")
+	}
+
+	var videos []types.VideoProps
+	if playlist.Rules.Valid {
+		rules, err := types.ParseSmartPlaylistRules(playlist.Rules.String)
+		if err != nil {
+			return props, err
+		}
+		videos, err = GetSmartPlaylistVideos(ctx, db, playlist.UserID, rules)
+		if err != nil {
+			return props, err
+		}
+		for i := range videos {
+			videos[i].Progress = 0
+		}
+	} else {
+		items, err := db.GetPlaylistItems(ctx, playlist.ID,
+			database.PlaylistItem.OrderByPosition(),
+			database.PlaylistItem.WithVideo(),
+			database.PlaylistItem.WithChannel(),
+		)
+		if err != nil {
+			return props, errors.Wrap(err, "failed to get playlist items")
+		}
+		for _, item := range items {
+			video := playlistItemVideo(item)
+			if video == nil {
+				continue
+			}
+			var channelProps types.ChannelProps
+			if video.R != nil && video.R.Channel != nil {
+				channelProps = types.ChannelModelToProps(video.R.Channel)
+			}
+			videos = append(videos, types.VideoModelToProps(video, channelProps))
+		}
+	}
+
+	var thumbnailVideoID string
+	if len(videos) > 0 {
+		thumbnailVideoID = videos[0].ID
+	}
+	return types.SharedPlaylistPageProps{
+		Token:      token,
+		OwnerID:    playlist.UserID,
+		Playlist:   types.PlaylistModelToProps(playlist, len(videos), 0, thumbnailVideoID),
+		Videos:     videos,
+		YouTubeURL: YouTubeWatchVideosURL(videoPropsIDs(videos)),
+	}, nil
+}
+
+/*
+CopySharedPlaylist saves the videos of a shared playlist as a new playlist of the user, smart playlists are copied as the videos they list right now.
+The new playlist is deleted again when not all videos could be added.
+*/
+func CopySharedPlaylist(ctx context.Context, db interface {
+	database.PlaylistsClient
+	database.PlaylistShareLinksClient
+	database.SmartPlaylistsClient
+	database.ViewsClient
+}, userID, token string) (playlist *models.Playlist, err error) {
+	ctx, span := tracing.Start(ctx, "logic.CopySharedPlaylist")
+	defer func() { tracing.End(span, err) }()
+
+	shared, err := GetSharedPlaylistProps(ctx, db, token)
+	if err != nil {
+		return nil, err
+	}
+
+	playlist, err = CreateUserPlaylist(ctx, db, userID, shared.Playlist.Name, shared.Playlist.Description, types.PlaylistLimits{})
+	if err != nil {
+		return nil, err
+	}
+	for i, video := range shared.Videos {
+		err := db.AddPlaylistItemAtPosition(ctx, playlist.ID, video.ID, (i+1)*database.PlaylistPositionGap)
+		if err != nil {
+			// A partial copy would look like a complete one, the user can try again instead
+			if deleteErr := db.DeletePlaylist(context.WithoutCancel(ctx), playlist.ID); deleteErr != nil {
+				log.Warn().Err(deleteErr).Str("playlistID", playlist.ID).Msg("failed to delete partial copy of a shared playlist")
+			}
+			return nil, errors.Wrapf(err, "failed to add video %s", video.ID)
+		}
+	}
+	return playlist, nil
+}
@@ -0,0 +1,23 @@
+package logic
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/matryer/is"
+)
+
+func TestYouTubeWatchVideosURL(t *testing.T) {
+	is := is.New(t)
+
+	is.Equal(YouTubeWatchVideosURL(nil), "")
+	is.Equal(YouTubeWatchVideosURL([]string{"a1", "b_2", "c-3"}), "https://www.youtube.com/watch_videos?video_ids=a1,b_2,c-3")
+
+	// Videos past the limit are left out
+	var ids []string
+	for i := range youtubeWatchVideosLimit + 10 {
+		ids = append(ids, fmt.Sprintf("v%d", i))
+	}
+	is.Equal(YouTubeWatchVideosURL(ids), "https://www.youtube.com/watch_videos?video_ids="+strings.Join(ids[:youtubeWatchVideosLimit], ","))
+}
@@ -1,8 +1,10 @@
 package logic
 
 import (
+	"cmp"
 	"context"
 	"regexp"
+	"slices"
 	"strings"
 	"time"
 	"unicode"
@@ -15,11 +17,9 @@ import (
 	"github.com/friendsofgo/errors"
 	"github.com/lucsky/cuid"
 	"github.com/rs/zerolog/log"
-	"golang.org/x/sync/errgroup"
 )
 
 const WatchLaterSlug = "watch-later"
-const WatchLaterTTLDays = 30
 
 // getCompletionBuffer returns the buffer in seconds for considering a video fully watched
 // - Videos over 30 minutes: 5 minutes buffer
@@ -212,6 +212,7 @@ func GetWatchLaterVideos(ctx context.Context, db interface {
 		videos = append(videos, props)
 	}
 
+	applyUserVideoTitles(ctx, db, userID, videos)
 	return videos, hasMore, nil
 }
 
@@ -281,18 +282,25 @@ func slugFromName(name string) string {
 	return slug + "-" + suffix
 }
 
-func CreateUserPlaylist(ctx context.Context, db database.PlaylistsClient, userID, name, description string) (*models.Playlist, error) {
+func CreateUserPlaylist(ctx context.Context, db database.PlaylistsClient, userID, name, description string, limits types.PlaylistLimits) (*models.Playlist, error) {
 	name = strings.TrimSpace(name)
 	if name == "" {
 		return nil, errors.New("playlist name is required")
 	}
+	record, err := playlistLimitsRecord(limits)
+	if err != nil {
+		return nil, err
+	}
 
 	playlist := &models.Playlist{
-		UserID:      userID,
-		Slug:        slugFromName(name),
-		Name:        name,
-		Description: strings.TrimSpace(description),
-		System:      false,
+		UserID:         userID,
+		Slug:           slugFromName(name),
+		Name:           name,
+		Description:    strings.TrimSpace(description),
+		System:         false,
+		TTLDays:        record.TTLDays,
+		MaxSize:        record.MaxSize,
+		OverflowPolicy: record.OverflowPolicy,
 	}
 	if err := db.CreatePlaylist(ctx, playlist); err != nil {
 		return nil, errors.Wrap(err, "failed to create playlist")
@@ -353,8 +361,28 @@ func DeleteUserPlaylist(ctx context.Context, db database.PlaylistsClient, userID
 
 func GetUserPlaylistsProps(ctx context.Context, db interface {
 	database.PlaylistsClient
+	database.SmartPlaylistsClient
+	database.ViewsClient
+}, userID string) ([]types.PlaylistProps, error) {
+	return userPlaylistsProps(ctx, db, userID, true)
+}
+
+/*
+GetEditablePlaylistsProps returns the playlists videos can be added to, smart playlists are left out
+*/
+func GetEditablePlaylistsProps(ctx context.Context, db interface {
+	database.PlaylistsClient
+	database.SmartPlaylistsClient
 	database.ViewsClient
 }, userID string) ([]types.PlaylistProps, error) {
+	return userPlaylistsProps(ctx, db, userID, false)
+}
+
+func userPlaylistsProps(ctx context.Context, db interface {
+	database.PlaylistsClient
+	database.SmartPlaylistsClient
+	database.ViewsClient
+}, userID string, withSmart bool) ([]types.PlaylistProps, error) {
 	playlists, err := db.GetUserPlaylists(ctx, userID)
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to get user playlists")
@@ -362,6 +390,18 @@ func GetUserPlaylistsProps(ctx context.Context, db interface {
 
 	result := make([]types.PlaylistProps, 0, len(playlists))
 	for _, p := range playlists {
+		if p.Rules.Valid {
+			if !withSmart {
+				continue
+			}
+			props, _, err := smartPlaylistProps(ctx, db, userID, p)
+			if err != nil {
+				log.Warn().Err(err).Str("playlist", p.ID).Msg("failed to evaluate smart playlist")
+			}
+			result = append(result, props)
+			continue
+		}
+
 		count, _ := db.GetPlaylistItemCount(ctx, p.ID)
 		thumbVideoID, _ := db.GetPlaylistFirstVideoID(ctx, p.ID)
 
@@ -408,10 +448,14 @@ type PlaylistPageProps struct {
 	Playlist types.PlaylistProps
 	New      []types.VideoProps
 	Watched  []types.VideoProps
+	PlayOnTV bool
+	// YouTubeURL opens the videos of the page as an unsaved playlist on YouTube
+	YouTubeURL string
 }
 
 func GetPlaylistPageProps(ctx context.Context, db interface {
 	database.PlaylistsClient
+	database.SmartPlaylistsClient
 	database.ViewsClient
 }, userID, playlistID string) (*PlaylistPageProps, error) {
 	playlist, err := db.GetPlaylistByID(ctx, playlistID)
@@ -422,6 +466,17 @@ func GetPlaylistPageProps(ctx context.Context, db interface {
 		return nil, errors.New("playlist not found")
 	}
 
+	if playlist.Rules.Valid {
+		playlistProps, videos, err := smartPlaylistProps(ctx, db, userID, playlist)
+		if err != nil {
+			return nil, err
+		}
+		props := &PlaylistPageProps{Playlist: playlistProps, PlayOnTV: TVRemoteAvailable(userID)}
+		props.New, props.Watched = splitWatchedVideos(videos)
+		props.YouTubeURL = YouTubeWatchVideosURL(videoPropsIDs(props.New, props.Watched))
+		return props, nil
+	}
+
 	items, err := db.GetPlaylistItems(ctx, playlistID,
 		database.PlaylistItem.OrderByPosition(),
 		database.PlaylistItem.WithVideo(),
@@ -481,6 +536,9 @@ func GetPlaylistPageProps(ctx context.Context, db interface {
 		}
 	}
 
+	applyUserVideoTitles(ctx, db, userID, props.New, props.Watched)
+	props.PlayOnTV = TVRemoteAvailable(userID)
+	props.YouTubeURL = YouTubeWatchVideosURL(videoPropsIDs(props.New, props.Watched))
 	return props, nil
 }
 
@@ -492,6 +550,9 @@ func AddVideoToPlaylist(ctx context.Context, db database.PlaylistsClient, userID
 	if playlist.UserID != userID || playlist.System {
 		return errors.New("cannot modify this playlist")
 	}
+	if playlist.Rules.Valid {
+		return ErrSmartPlaylist
+	}
 
 	already, err := db.IsVideoInPlaylist(ctx, playlistID, videoID)
 	if err != nil {
@@ -512,10 +573,117 @@ func RemoveVideoFromPlaylist(ctx context.Context, db database.PlaylistsClient, u
 	if playlist.UserID != userID || playlist.System {
 		return errors.New("cannot modify this playlist")
 	}
+	if playlist.Rules.Valid {
+		return ErrSmartPlaylist
+	}
 	return db.RemovePlaylistItem(ctx, playlistID, videoID)
 }
 
 func MovePlaylistItem(ctx context.Context, db database.PlaylistsClient, userID, playlistID, videoID, direction string) error {
+	if err := checkPlaylistModifiable(ctx, db, userID, playlistID); err != nil {
+		return err
+	}
+	return db.SwapPlaylistItemPositions(ctx, playlistID, videoID, direction)
+}
+
+/*
+MovePlaylistItemToIndex moves a video to an index in the playlist, 0 moves it to the top
+*/
+func MovePlaylistItemToIndex(ctx context.Context, db database.PlaylistsClient, userID, playlistID, videoID string, index int) error {
+	if err := checkPlaylistModifiable(ctx, db, userID, playlistID); err != nil {
+		return err
+	}
+	return db.MovePlaylistItemToIndex(ctx, playlistID, videoID, index)
+}
+
+/*
+SetPlaylistOrder saves a new order of the listed videos, videos that are not listed keep their place
+*/
+func SetPlaylistOrder(ctx context.Context, db database.PlaylistsClient, userID, playlistID string, videoIDs []string) error {
+	if err := checkPlaylistModifiable(ctx, db, userID, playlistID); err != nil {
+		return err
+	}
+	return db.SetPlaylistItemOrder(ctx, playlistID, videoIDs)
+}
+
+/*
+SortPlaylist sorts the videos in a playlist once and saves the result as the playlist order
+*/
+func SortPlaylist(ctx context.Context, db database.PlaylistsClient, userID, playlistID string, sort types.PlaylistSort) error {
+	if err := checkPlaylistModifiable(ctx, db, userID, playlistID); err != nil {
+		return err
+	}
+
+	items, err := db.GetPlaylistItems(ctx, playlistID,
+		database.PlaylistItem.OrderByPosition(),
+		database.PlaylistItem.WithVideo(),
+		database.PlaylistItem.WithChannel(),
+	)
+	if err != nil {
+		return errors.Wrap(err, "failed to get playlist items")
+	}
+	return db.SetPlaylistItemOrder(ctx, playlistID, sortedPlaylistVideoIDs(items, sort))
+}
+
+// sortedPlaylistVideoIDs sorts playlist items that are ordered by position, ties and items without a cached video keep their current order
+func sortedPlaylistVideoIDs(items []*models.PlaylistItem, sort types.PlaylistSort) []string {
+	sorted := slices.Clone(items)
+	slices.SortStableFunc(sorted, func(a, b *models.PlaylistItem) int {
+		va, vb := playlistItemVideo(a), playlistItemVideo(b)
+		if va == nil || vb == nil {
+			// Items without a video go last
+			return cmp.Compare(boolToInt(va == nil), boolToInt(vb == nil))
+		}
+
+		switch sort {
+		case types.PlaylistSortNewest:
+			return vb.PublishedAt.Compare(va.PublishedAt)
+		case types.PlaylistSortOldest:
+			return va.PublishedAt.Compare(vb.PublishedAt)
+		case types.PlaylistSortShortest:
+			return cmp.Compare(va.Duration, vb.Duration)
+		case types.PlaylistSortLongest:
+			return cmp.Compare(vb.Duration, va.Duration)
+		case types.PlaylistSortChannel:
+			if c := strings.Compare(strings.ToLower(playlistItemChannelTitle(va)), strings.ToLower(playlistItemChannelTitle(vb))); c != 0 {
+				return c
+			}
+			return va.PublishedAt.Compare(vb.PublishedAt)
+		default:
+			return a.CreatedAt.Compare(b.CreatedAt)
+		}
+	})
+
+	ids := make([]string, 0, len(sorted))
+	for _, item := range sorted {
+		ids = append(ids, item.VideoID)
+	}
+	return ids
+}
+
+func playlistItemVideo(item *models.PlaylistItem) *models.Video {
+	if item.R == nil {
+		return nil
+	}
+	return item.R.Video
+}
+
+func playlistItemChannelTitle(video *models.Video) string {
+	if video.R == nil || video.R.Channel == nil {
+		return video.ChannelID
+	}
+	return video.R.Channel.Title
+}
+
+func boolToInt(b bool) int {
+	if b {
+		return 1
+	}
+	return 0
+}
+
+// checkPlaylistModifiable returns an error when the playlist does not belong to the user, is a system playlist or has its videos picked by rules
+func checkPlaylistModifiable(ctx context.Context, db database.PlaylistsClient, userID, playlistID string) error {
 	playlist, err := db.GetPlaylistByID(ctx, playlistID)
 	if err != nil {
 		return errors.Wrap(err, "failed to get playlist")
@@ -523,7 +691,10 @@ func MovePlaylistItem(ctx context.Context, db database.PlaylistsClient, userID,
 	if playlist.UserID != userID || playlist.System {
 		return errors.New("cannot modify this playlist")
 	}
-	return db.SwapPlaylistItemPositions(ctx, playlistID, videoID, direction)
+	if playlist.Rules.Valid {
+		return ErrSmartPlaylist
+	}
+	return nil
 }
 
 func GetVideoPlaylistMembership(ctx context.Context, db database.PlaylistsClient, userID, videoID string) (map[string]bool, error) {
@@ -545,6 +716,9 @@ func GetVideoPlaylistMembership(ctx context.Context, db database.PlaylistsClient
 	return result, nil
 }
 
+/*
+ImportYouTubePlaylist creates a playlist from a YouTube playlist and queues a job that imports its videos
+*/
 func ImportYouTubePlaylist(ctx context.Context, db database.Client, userID, youtubePlaylistID string) (string, error) {
 	title, description, err := youtube.DefaultClient.GetPlaylistMetadata(youtubePlaylistID)
 	if err != nil {
@@ -559,35 +733,19 @@ func ImportYouTubePlaylist(ctx context.Context, db database.Client, userID, yout
 		return "", err
 	}
 
-	videoIDs, err := youtube.DefaultClient.GetAllPlaylistVideoIDs(youtubePlaylistID, 500)
+	// Fetching and caching hundreds of videos takes a while, the playlist page shows them as the import job adds them
+	_, err = JobImportPlaylist.Enqueue(ctx, db, ImportPlaylistJob{PlaylistID: playlist.ID, YouTubePlaylistID: youtubePlaylistID})
 	if err != nil {
-		return playlist.ID, errors.Wrap(err, "failed to fetch playlist videos from YouTube")
-	}
-
-	var group errgroup.Group
-	group.SetLimit(5)
-
-	for idx, vid := range videoIDs {
-		videoID := vid
-		position := idx
-		group.Go(func() error {
-			// Cache the video in our DB
-			RefreshVideoCache(ctx, db, videoID)
-			// Only add if the video exists in the DB (FK constraint)
-			if _, err := db.GetVideoByID(ctx, videoID); err != nil {
-				return nil // skip videos that couldn't be cached
-			}
-			return db.AddPlaylistItemAtPosition(ctx, playlist.ID, videoID, position)
-		})
-	}
-
-	if err := group.Wait(); err != nil {
-		log.Warn().Err(err).Str("playlistID", playlist.ID).Msg("some videos failed to import")
+		return playlist.ID, errors.Wrap(err, "failed to queue playlist import")
 	}
 
 	return playlist.ID, nil
 }
 
+/*
+SyncYouTubePlaylist adds new videos from the YouTube playlist a playlist was imported from, playlists in mirror mode also drop videos removed on YouTube.
+The result is stored as the sync status of the playlist.
+*/
 func SyncYouTubePlaylist(ctx context.Context, db database.Client, userID, playlistID string) (int, error) {
 	playlist, err := db.GetPlaylistByID(ctx, playlistID)
 	if err != nil {
@@ -602,65 +760,15 @@ func SyncYouTubePlaylist(ctx context.Context, db database.Client, userID, playli
 
 	// Skip cooldown if playlist is empty (e.g., failed initial import)
 	itemCount, _ := db.GetPlaylistItemCount(ctx, playlistID)
-	if itemCount > 0 && time.Since(playlist.UpdatedAt) < time.Hour {
+	if itemCount > 0 && time.Since(playlistSyncedAt(playlist)) < time.Hour {
 		return 0, ErrSyncTooSoon
 	}
 
-	// Get current video IDs in playlist
-	existingItems, err := db.GetPlaylistItems(ctx, playlistID)
-	if err != nil {
-		return 0, errors.Wrap(err, "failed to get existing items")
-	}
-	existingIDs := make(map[string]bool, len(existingItems))
-	for _, item := range existingItems {
-		existingIDs[item.VideoID] = true
-	}
-
-	// Fetch from YouTube
-	ytVideoIDs, err := youtube.DefaultClient.GetAllPlaylistVideoIDs(playlist.YoutubePlaylistID.String, 500)
+	result, err := syncYouTubePlaylist(ctx, db, playlist)
 	if err != nil {
-		return 0, errors.Wrap(err, "failed to fetch YouTube playlist")
-	}
-
-	// Get current max position
-	maxPos, err := db.GetMaxPlaylistItemPosition(ctx, playlistID)
-	if err != nil {
-		return 0, errors.Wrap(err, "failed to get max position")
-	}
-
-	added := 0
-	var group errgroup.Group
-	group.SetLimit(5)
-
-	for _, vid := range ytVideoIDs {
-		videoID := vid
-		if existingIDs[videoID] {
-			continue
-		}
-
-		maxPos++
-		pos := maxPos
-		added++
-
-		group.Go(func() error {
-			RefreshVideoCache(ctx, db, videoID)
-			// Only add if the video exists in the DB (FK constraint)
-			if _, err := db.GetVideoByID(ctx, videoID); err != nil {
-				return nil // skip videos that couldn't be cached
-			}
-			return db.AddPlaylistItemAtPosition(ctx, playlistID, videoID, pos)
-		})
+		return 0, err
 	}
-
-	if err := group.Wait(); err != nil {
-		log.Warn().Err(err).Str("playlistID", playlistID).Int("added", added).Msg("some videos failed during sync")
-	}
-
-	// Bump updated_at
-	playlist.UpdatedAt = time.Now()
-	_ = db.UpdatePlaylist(ctx, playlist)
-
-	return added, nil
+	return result.Added, nil
 }
 
 func cleanDescription(s string) string {
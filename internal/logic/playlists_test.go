@@ -0,0 +1,40 @@
+package logic
+
+import (
+	"testing"
+	"time"
+
+	"github.com/cufee/feedlr-yt/internal/database/models"
+	"github.com/cufee/feedlr-yt/internal/types"
+	"github.com/matryer/is"
+)
+
+func TestSortedPlaylistVideoIDs(t *testing.T) {
+	is := is.New(t)
+
+	now := time.Date(2026, 10, 19, 12, 0, 0, 0, time.UTC)
+	item := func(id, channel string, published time.Time, duration int64, added time.Time) *models.PlaylistItem {
+		video := &models.Video{ID: id, ChannelID: channel, PublishedAt: published, Duration: duration}
+		video.R = video.R.NewStruct()
+		video.R.Channel = &models.Channel{ID: channel, Title: channel}
+		item := &models.PlaylistItem{VideoID: id, CreatedAt: added}
+		item.R = item.R.NewStruct()
+		item.R.Video = video
+		return item
+	}
+
+	items := []*models.PlaylistItem{
+		item("a", "Beta", now.Add(-48*time.Hour), 300, now.Add(-time.Hour)),
+		item("b", "alpha", now, 60, now.Add(-3*time.Hour)),
+		item("c", "Beta", now.Add(-24*time.Hour), 900, now.Add(-2*time.Hour)),
+		{VideoID: "missing", CreatedAt: now.Add(-4 * time.Hour)},
+	}
+
+	is.Equal(sortedPlaylistVideoIDs(items, types.PlaylistSortNewest), []string{"b", "c", "a", "missing"})
+	is.Equal(sortedPlaylistVideoIDs(items, types.PlaylistSortOldest), []string{"a", "c", "b", "missing"})
+	is.Equal(sortedPlaylistVideoIDs(items, types.PlaylistSortShortest), []string{"b", "a", "c", "missing"})
+	is.Equal(sortedPlaylistVideoIDs(items, types.PlaylistSortLongest), []string{"c", "a", "b", "missing"})
+	// Channel titles are compared case insensitive, videos of a channel oldest first
+	is.Equal(sortedPlaylistVideoIDs(items, types.PlaylistSortChannel), []string{"b", "a", "c", "missing"})
+	is.Equal(sortedPlaylistVideoIDs(items, types.PlaylistSortAdded), []string{"b", "c", "a", "missing"})
+}
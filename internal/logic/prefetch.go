@@ -0,0 +1,74 @@
+package logic
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+const prefetchTimeout = time.Minute
+
+// prefetches tracks lookups that outlive the request that started them, they write to the database so shutdown has to wait for them
+var prefetches = newPrefetchTracker()
+
+type prefetchTracker struct {
+	ctx    context.Context
+	cancel context.CancelFunc
+
+	mu      sync.Mutex
+	stopped bool
+	runs    sync.WaitGroup
+}
+
+func newPrefetchTracker() *prefetchTracker {
+	ctx, cancel := context.WithCancel(context.Background())
+	return &prefetchTracker{ctx: ctx, cancel: cancel}
+}
+
+// goPrefetch runs fn in the background, it is dropped once prefetches are stopped
+func (t *prefetchTracker) goPrefetch(fn func(ctx context.Context)) {
+	t.mu.Lock()
+	if t.stopped {
+		t.mu.Unlock()
+		return
+	}
+	t.runs.Add(1)
+	t.mu.Unlock()
+
+	go func() {
+		defer t.runs.Done()
+
+		ctx, cancel := context.WithTimeout(t.ctx, prefetchTimeout)
+		defer cancel()
+		fn(ctx)
+	}()
+}
+
+func (t *prefetchTracker) stop(ctx context.Context) error {
+	t.mu.Lock()
+	t.stopped = true
+	t.mu.Unlock()
+
+	done := make(chan struct{})
+	go func() {
+		t.runs.Wait()
+		close(done)
+	}()
+	select {
+	case <-done:
+		return nil
+	case <-ctx.Done():
+		// Prefetches are best effort, cancel the ones still running so they do not touch a closed database
+		t.cancel()
+		<-done
+		return ctx.Err()
+	}
+}
+
+/*
+StopPrefetches waits for background SponsorBlock and DeArrow lookups to finish, no new lookups are started after StopPrefetches.
+Lookups still running once the context is done are cancelled, the context error is returned after they exit.
+*/
+func StopPrefetches(ctx context.Context) error {
+	return prefetches.stop(ctx)
+}
@@ -0,0 +1,39 @@
+package logic
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/matryer/is"
+)
+
+func TestPrefetchTracker_Stop(t *testing.T) {
+	is := is.New(t)
+
+	tracker := newPrefetchTracker()
+
+	started := make(chan struct{})
+	canceled := make(chan struct{})
+	tracker.goPrefetch(func(ctx context.Context) {
+		close(started)
+		<-ctx.Done()
+		close(canceled)
+	})
+	<-started
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	is.Equal(tracker.stop(ctx), context.DeadlineExceeded) // running prefetch outlives the deadline
+
+	select {
+	case <-canceled:
+	default:
+		t.Fatal("stop returned before the running prefetch exited")
+	}
+
+	var ran bool
+	tracker.goPrefetch(func(ctx context.Context) { ran = true })
+	is.NoErr(tracker.stop(context.Background()))
+	is.True(!ran) // prefetches are dropped after stop
+}
@@ -19,23 +19,37 @@ var defaultSettings = types.SettingsPageProps{
 		SelectedSponsorBlockCategories:  []string{sponsorblock.SelfPromo.Value, sponsorblock.Sponsor.Value, sponsorblock.Interaction.Value}},
 }
 
-func ToggleSponsorBlockCategory(ctx context.Context, db database.SettingsClient, id string, category string) (types.SettingsPageProps, error) {
+/*
+SetSponsorBlockCategoryAction updates what happens to segments in a category, the list of selected categories is kept in sync for older clients of the settings
+*/
+func SetSponsorBlockCategoryAction(ctx context.Context, db database.SettingsClient, id string, category string, action types.SponsorBlockAction) (types.SettingsPageProps, error) {
 	if !slices.Contains(sponsorblock.ValidCategoryValues, category) {
 		return types.SettingsPageProps{}, errors.New("invalid category")
 	}
+	if !slices.Contains(types.SponsorBlockCategoryActions(category), action) {
+		return types.SettingsPageProps{}, errors.New("invalid action")
+	}
 
 	settings, err := GetUserSettings(ctx, db, id)
 	if err != nil {
 		return types.SettingsPageProps{}, err
 	}
 
-	if slices.Contains(settings.SponsorBlock.SelectedSponsorBlockCategories, category) {
-		settings.SponsorBlock.SelectedSponsorBlockCategories = slices.DeleteFunc(settings.SponsorBlock.SelectedSponsorBlockCategories, func(i string) bool {
-			return i == category
-		})
-	} else {
-		settings.SponsorBlock.SelectedSponsorBlockCategories = append(settings.SponsorBlock.SelectedSponsorBlockCategories, category)
+	actions := make(map[string]types.SponsorBlockAction, len(sponsorblock.ValidCategoryValues))
+	for _, value := range sponsorblock.ValidCategoryValues {
+		actions[value] = settings.SponsorBlock.CategoryAction(value)
+	}
+	actions[category] = action
+
+	var selected []string
+	for _, value := range sponsorblock.ValidCategoryValues {
+		if actions[value] != types.SponsorBlockActionIgnore {
+			selected = append(selected, value)
+		}
 	}
+
+	settings.SponsorBlock.CategoryActions = actions
+	settings.SponsorBlock.SelectedSponsorBlockCategories = selected
 	return settings, UpdateUserSettings(ctx, db, id, settings)
 }
 
@@ -49,6 +63,23 @@ func ToggleSponsorBlock(ctx context.Context, db database.SettingsClient, id stri
 	return settings, UpdateUserSettings(ctx, db, id, settings)
 }
 
+/*
+SetVideoTitleMode updates how video titles are displayed, the DeArrow mode falls back to fixed casing when community titles are unavailable
+*/
+func SetVideoTitleMode(ctx context.Context, db database.SettingsClient, id string, mode types.VideoTitleMode) (types.SettingsPageProps, error) {
+	if !slices.Contains(types.VideoTitleModes, mode) {
+		return types.SettingsPageProps{}, errors.New("invalid title mode")
+	}
+
+	settings, err := GetUserSettings(ctx, db, id)
+	if err != nil {
+		return types.SettingsPageProps{}, err
+	}
+
+	settings.TitleMode = mode
+	return settings, UpdateUserSettings(ctx, db, id, settings)
+}
+
 func UpdateFeedMode(ctx context.Context, db database.SettingsClient, user, mode string) (types.SettingsPageProps, error) {
 	settings, err := GetUserSettings(ctx, db, user)
 	if err != nil {
@@ -113,6 +144,8 @@ func GetUserSettings(ctx context.Context, db database.SettingsClient, id string)
 	if err != nil {
 		return types.SettingsPageProps{}, err
 	}
+	// Available categories are stored with the settings, new categories should show up for existing users
+	props.SponsorBlock.AvailableSponsorBlockCategories = sponsorblock.AvailableCategories
 
 	return props, nil
 }
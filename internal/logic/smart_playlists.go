@@ -0,0 +1,191 @@
+package logic
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/aarondl/null/v8"
+	"github.com/cufee/feedlr-yt/internal/api/youtube"
+	"github.com/cufee/feedlr-yt/internal/database"
+	"github.com/cufee/feedlr-yt/internal/database/models"
+	"github.com/cufee/feedlr-yt/internal/tracing"
+	"github.com/cufee/feedlr-yt/internal/types"
+	"github.com/pkg/errors"
+)
+
+var ErrSmartPlaylist = errors.New("videos cannot be added to or moved in a smart playlist")
+
+/*
+CreateSmartPlaylist creates a playlist that lists the videos matching rules instead of saved items
+*/
+func CreateSmartPlaylist(ctx context.Context, db database.PlaylistsClient, userID, name, description string, rules types.SmartPlaylistRules) (*models.Playlist, error) {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return nil, errors.New("playlist name is required")
+	}
+	encoded, err := encodeSmartPlaylistRules(rules)
+	if err != nil {
+		return nil, err
+	}
+
+	playlist := &models.Playlist{
+		UserID:      userID,
+		Slug:        slugFromName(name),
+		Name:        name,
+		Description: strings.TrimSpace(description),
+		Rules:       null.StringFrom(encoded),
+	}
+	if err := db.CreatePlaylist(ctx, playlist); err != nil {
+		return nil, errors.Wrap(err, "failed to create playlist")
+	}
+	return playlist, nil
+}
+
+/*
+UpdateSmartPlaylistRules replaces the rules of a smart playlist
+*/
+func UpdateSmartPlaylistRules(ctx context.Context, db database.PlaylistsClient, userID, playlistID string, rules types.SmartPlaylistRules) error {
+	playlist, err := db.GetPlaylistByID(ctx, playlistID)
+	if err != nil {
+		return errors.Wrap(err, "failed to get playlist")
+	}
+	if playlist.UserID != userID || !playlist.Rules.Valid {
+		return errors.New("cannot modify this playlist")
+	}
+	encoded, err := encodeSmartPlaylistRules(rules)
+	if err != nil {
+		return err
+	}
+
+	playlist.Rules = null.StringFrom(encoded)
+	playlist.UpdatedAt = time.Now()
+	return db.UpdatePlaylist(ctx, playlist)
+}
+
+/*
+GetSmartPlaylistVideos evaluates smart playlist rules for a user, the result is limited and ordered by the rules
+*/
+func GetSmartPlaylistVideos(ctx context.Context, db interface {
+	database.SmartPlaylistsClient
+	database.ViewsClient
+}, userID string, rules types.SmartPlaylistRules) (videos []types.VideoProps, err error) {
+	ctx, span := tracing.Start(ctx, "logic.GetSmartPlaylistVideos")
+	defer func() { tracing.End(span, err) }()
+
+	rules, err = rules.Normalize()
+	if err != nil {
+		return nil, err
+	}
+
+	records, err := db.FindSmartPlaylistVideos(ctx, smartPlaylistQuery(userID, rules, time.Now()))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to find smart playlist videos")
+	}
+
+	videoIDs := make([]string, 0, len(records))
+	for _, video := range records {
+		videoIDs = append(videoIDs, video.ID)
+	}
+	views, err := GetUserViews(ctx, db, userID, videoIDs...)
+	if err != nil && !database.IsErrNotFound(err) {
+		return nil, errors.Wrap(err, "failed to get user views")
+	}
+
+	videos = make([]types.VideoProps, 0, len(records))
+	for _, video := range records {
+		var channelProps types.ChannelProps
+		if video.R != nil && video.R.Channel != nil {
+			channelProps = types.ChannelModelToProps(video.R.Channel)
+		}
+		props := types.VideoModelToProps(video, channelProps)
+		if view, ok := views[video.ID]; ok {
+			props.Progress = int(view.Progress)
+		}
+		videos = append(videos, props)
+	}
+
+	applyUserVideoTitles(ctx, db, userID, videos)
+	return videos, nil
+}
+
+// smartPlaylistQuery translates normalized rules into a database query, the publish window is counted back from now
+func smartPlaylistQuery(userID string, rules types.SmartPlaylistRules, now time.Time) database.SmartPlaylistQuery {
+	query := database.SmartPlaylistQuery{
+		UserID:        userID,
+		ChannelIDs:    rules.Channels,
+		FavoritesOnly: rules.FavoritesOnly,
+		Progress:      string(rules.Progress),
+		MinDuration:   int64(rules.MinDurationMinutes) * 60,
+		MaxDuration:   int64(rules.MaxDurationMinutes) * 60,
+		Order:         string(rules.Sort),
+		Limit:         rules.Limit,
+	}
+	if rules.PublishedWithinDays > 0 {
+		query.PublishedAfter = now.AddDate(0, 0, -rules.PublishedWithinDays)
+	}
+
+	// Same types as filterVideosByType, shorts are never included
+	switch rules.Filter {
+	case types.VideoFilterVideos:
+		query.TypesIn = []string{string(youtube.VideoTypeVideo)}
+	case types.VideoFilterStreams:
+		query.TypesIn = []string{string(youtube.VideoTypeLiveStream), string(youtube.VideoTypeUpcomingStream), string(youtube.VideoTypeStreamRecording)}
+	default:
+		query.TypesNotIn = []string{string(youtube.VideoTypePrivate), string(youtube.VideoTypeShort), string(youtube.VideoTypeFailed)}
+	}
+	return query
+}
+
+func encodeSmartPlaylistRules(rules types.SmartPlaylistRules) (string, error) {
+	rules, err := rules.Normalize()
+	if err != nil {
+		return "", err
+	}
+	return rules.Encode()
+}
+
+// smartPlaylistProps evaluates the rules of a smart playlist, the video count, progress and thumbnail are taken from the matching videos
+func smartPlaylistProps(ctx context.Context, db interface {
+	database.PlaylistsClient
+	database.SmartPlaylistsClient
+	database.ViewsClient
+}, userID string, playlist *models.Playlist) (types.PlaylistProps, []types.VideoProps, error) {
+	rules, err := types.ParseSmartPlaylistRules(playlist.Rules.String)
+	if err != nil {
+		return types.PlaylistModelToProps(playlist, 0, 0, ""), nil, err
+	}
+	videos, err := GetSmartPlaylistVideos(ctx, db, userID, rules)
+	if err != nil {
+		return types.PlaylistModelToProps(playlist, 0, 0, ""), nil, err
+	}
+
+	watchLater, _ := GetWatchLaterVideoIDs(ctx, db, userID)
+	watched := 0
+	for i := range videos {
+		videos[i].InWatchLater = watchLater[videos[i].ID]
+		if videos[i].Progress > 0 {
+			watched++
+		}
+	}
+
+	var progress int
+	var thumbnailVideoID string
+	if len(videos) > 0 {
+		progress = (watched * 100) / len(videos)
+		thumbnailVideoID = videos[0].ID
+	}
+	return types.PlaylistModelToProps(playlist, len(videos), progress, thumbnailVideoID), videos, nil
+}
+
+// splitWatchedVideos splits videos into the new and watched sections of a playlist page, keeping their order
+func splitWatchedVideos(videos []types.VideoProps) (newVideos, watched []types.VideoProps) {
+	for _, video := range videos {
+		if video.Progress > 0 {
+			watched = append(watched, video)
+		} else {
+			newVideos = append(newVideos, video)
+		}
+	}
+	return newVideos, watched
+}
@@ -0,0 +1,65 @@
+package logic
+
+import (
+	"testing"
+	"time"
+
+	"github.com/cufee/feedlr-yt/internal/types"
+	"github.com/matryer/is"
+)
+
+func TestSmartPlaylistRules(t *testing.T) {
+	is := is.New(t)
+
+	// Defaults are filled in and channels are cleaned up
+	rules, err := types.SmartPlaylistRules{Channels: []string{"b", "", "a", "b"}, Limit: 1000}.Normalize()
+	is.NoErr(err)
+	is.Equal(rules.Filter, types.VideoFilterAll)
+	is.Equal(rules.Sort, types.PlaylistSortNewest)
+	is.Equal(rules.Limit, types.SmartPlaylistMaxLimit)
+	is.Equal(rules.Channels, []string{"a", "b"})
+
+	encoded, err := rules.Encode()
+	is.NoErr(err)
+	decoded, err := types.ParseSmartPlaylistRules(encoded)
+	is.NoErr(err)
+	is.Equal(decoded, rules)
+
+	_, err = types.SmartPlaylistRules{Sort: types.PlaylistSortChannel}.Normalize()
+	is.True(err != nil)
+	_, err = types.SmartPlaylistRules{Progress: "halfway"}.Normalize()
+	is.True(err != nil)
+	_, err = types.SmartPlaylistRules{MinDurationMinutes: 30, MaxDurationMinutes: 10}.Normalize()
+	is.True(err != nil)
+}
+
+func TestSmartPlaylistQuery(t *testing.T) {
+	is := is.New(t)
+
+	now := time.Date(2026, 10, 19, 12, 0, 0, 0, time.UTC)
+	rules, err := types.SmartPlaylistRules{
+		Channels:            []string{"tech"},
+		Filter:              types.VideoFilterVideos,
+		Progress:            types.SmartPlaylistProgressUnwatched,
+		MinDurationMinutes:  30,
+		PublishedWithinDays: 14,
+	}.Normalize()
+	is.NoErr(err)
+
+	query := smartPlaylistQuery("user", rules, now)
+	is.Equal(query.UserID, "user")
+	is.Equal(query.ChannelIDs, []string{"tech"})
+	is.Equal(query.TypesIn, []string{"video"})
+	is.Equal(query.Progress, "unwatched")
+	is.Equal(query.MinDuration, int64(1800))
+	is.Equal(query.MaxDuration, int64(0))
+	is.Equal(query.PublishedAfter, now.AddDate(0, 0, -14))
+	is.Equal(query.Order, "newest")
+	is.Equal(query.Limit, types.SmartPlaylistDefaultLimit)
+
+	// Without a filter shorts and private videos are left out, and there is no publish window
+	query = smartPlaylistQuery("user", types.SmartPlaylistRules{Filter: types.VideoFilterAll}, now)
+	is.Equal(query.TypesIn, nil)
+	is.Equal(query.TypesNotIn, []string{"private", "short", "failed"})
+	is.True(query.PublishedAfter.IsZero())
+}
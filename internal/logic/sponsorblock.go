@@ -0,0 +1,264 @@
+package logic
+
+import (
+	"context"
+	"encoding/json"
+	"slices"
+	"time"
+
+	"github.com/cufee/feedlr-yt/internal/api/sponsorblock"
+	"github.com/cufee/feedlr-yt/internal/database"
+	"github.com/cufee/feedlr-yt/internal/metrics"
+	"github.com/cufee/feedlr-yt/internal/types"
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog/log"
+	"golang.org/x/sync/errgroup"
+	"golang.org/x/sync/singleflight"
+)
+
+const (
+	sponsorSegmentsTTL = 12 * time.Hour
+	// Segments are usually submitted within hours of a video going up, do not remember a miss for long
+	sponsorSegmentsNegativeTTL = time.Hour
+	// Segments rarely change once a video has settled, an expired record still skips when a lookup fails
+	sponsorSegmentsRetention = 7 * 24 * time.Hour
+
+	sponsorSegmentsLookupTimeout   = 500 * time.Millisecond
+	sponsorSegmentsPrefetchTimeout = 5 * time.Second
+	sponsorSegmentsPrefetchLimit   = 4
+	sponsorSegmentsFeedPrefetch    = 24
+)
+
+var (
+	// All categories and action types are cached together, so every user preference is served from a single lookup
+	cachedSponsorCategories  = sponsorblock.AvailableCategories
+	cachedSponsorActionTypes = []string{sponsorblock.ActionTypeSkip, sponsorblock.ActionTypeMute, sponsorblock.ActionTypeFull, sponsorblock.ActionTypePOI, sponsorblock.ActionTypeChapter}
+
+	sponsorSegmentsLookups singleflight.Group
+)
+
+type sponsorSegmentsSource interface {
+	LookupSegments(ctx context.Context, videoID string, categories []sponsorblock.Category, actionTypes []string) ([]sponsorblock.Segment, error)
+}
+
+func defaultSponsorSegmentsSource() sponsorSegmentsSource {
+	if sponsorblock.C == nil {
+		return nil
+	}
+	return sponsorblock.C
+}
+
+/*
+GetSponsorBlockSegments returns segments of every category and action type for a video, callers pick what to act on based on user settings.
+Segments are read from the local cache and only looked up upstream when the cache is missing or expired.
+*/
+func GetSponsorBlockSegments(ctx context.Context, db database.SponsorBlockSegmentsClient, videoID string) ([]sponsorblock.Segment, error) {
+	return cachedSponsorSegments(ctx, db, defaultSponsorSegmentsSource(), videoID, sponsorSegmentsLookupTimeout)
+}
+
+/*
+GetSponsorBlockChapters returns community chapter segments for a video from the local cache
+*/
+func GetSponsorBlockChapters(ctx context.Context, db database.SponsorBlockSegmentsClient, videoID string) ([]sponsorblock.Segment, error) {
+	segments, err := cachedSponsorSegments(ctx, db, defaultSponsorSegmentsSource(), videoID, sponsorSegmentsLookupTimeout)
+	if err != nil {
+		return nil, err
+	}
+	return filterSponsorSegments(segments, sponsorblock.ActionTypeChapter, []sponsorblock.Category{sponsorblock.Chapter}), nil
+}
+
+func filterSponsorSegments(segments []sponsorblock.Segment, actionType string, categories []sponsorblock.Category) []sponsorblock.Segment {
+	var filtered []sponsorblock.Segment
+	for _, segment := range segments {
+		segmentAction := segment.ActionType
+		if segmentAction == "" {
+			segmentAction = sponsorblock.ActionTypeSkip
+		}
+		if segmentAction != actionType {
+			continue
+		}
+		if !slices.ContainsFunc(categories, func(c sponsorblock.Category) bool { return c.Value == segment.Category }) {
+			continue
+		}
+		filtered = append(filtered, segment)
+	}
+	return filtered
+}
+
+func cachedSponsorSegments(ctx context.Context, db database.SponsorBlockSegmentsClient, source sponsorSegmentsSource, videoID string, timeout time.Duration) ([]sponsorblock.Segment, error) {
+	records, err := db.GetSponsorBlockSegments(ctx, videoID)
+	if err != nil {
+		metrics.ObserveVideoRefresh("sponsorblock_cache_read", err)
+		log.Warn().Err(err).Str("videoID", videoID).Msg("failed to read cached sponsorblock segments")
+	}
+
+	cached := records[videoID]
+	if cached != nil && time.Now().Before(cached.ExpiresAt) {
+		metrics.ObserveVideoRefresh("sponsorblock_cache_hit", nil)
+		return decodeSponsorSegments(cached.Segments)
+	}
+	if source == nil {
+		if cached != nil {
+			return decodeSponsorSegments(cached.Segments)
+		}
+		return nil, nil
+	}
+
+	segments, err, _ := sponsorSegmentsLookups.Do(videoID, func() (any, error) {
+		lctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), timeout)
+		defer cancel()
+		return refreshSponsorSegments(lctx, db, source, videoID)
+	})
+	if err != nil {
+		if cached != nil {
+			// A stale answer is better than no skipping at all
+			metrics.ObserveVideoRefresh("sponsorblock_cache_stale", err)
+			return decodeSponsorSegments(cached.Segments)
+		}
+		return nil, err
+	}
+	return segments.([]sponsorblock.Segment), nil
+}
+
+func refreshSponsorSegments(ctx context.Context, db database.SponsorBlockSegmentsClient, source sponsorSegmentsSource, videoID string) ([]sponsorblock.Segment, error) {
+	segments, err := source.LookupSegments(ctx, videoID, cachedSponsorCategories, cachedSponsorActionTypes)
+	metrics.ObserveVideoRefresh("sponsorblock_lookup", err)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to look up sponsorblock segments")
+	}
+
+	encoded, err := json.Marshal(segments)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to encode sponsorblock segments")
+	}
+	if segments == nil {
+		encoded = []byte("[]")
+	}
+
+	ttl := sponsorSegmentsTTL
+	if len(segments) == 0 {
+		ttl = sponsorSegmentsNegativeTTL
+	}
+	err = db.UpsertSponsorBlockSegments(ctx, &database.SponsorBlockVideoSegments{
+		VideoID:      videoID,
+		ExpiresAt:    time.Now().Add(ttl),
+		SegmentCount: len(segments),
+		Segments:     string(encoded),
+	})
+	metrics.ObserveVideoRefresh("sponsorblock_cache_write", err)
+	if err != nil {
+		log.Warn().Err(err).Str("videoID", videoID).Msg("failed to cache sponsorblock segments")
+	}
+	return segments, nil
+}
+
+func decodeSponsorSegments(data string) ([]sponsorblock.Segment, error) {
+	var segments []sponsorblock.Segment
+	if err := json.Unmarshal([]byte(data), &segments); err != nil {
+		return nil, errors.Wrap(err, "failed to decode cached sponsorblock segments")
+	}
+	return segments, nil
+}
+
+/*
+PrefetchSponsorBlockSegments warms the segment cache for videos that are likely to be played soon, videos with fresh cache entries are skipped
+*/
+func PrefetchSponsorBlockSegments(ctx context.Context, db database.SponsorBlockSegmentsClient, videoIDs ...string) error {
+	source := defaultSponsorSegmentsSource()
+	if source == nil || len(videoIDs) == 0 {
+		return nil
+	}
+	return prefetchSponsorSegments(ctx, db, source, videoIDs)
+}
+
+func prefetchSponsorSegments(ctx context.Context, db database.SponsorBlockSegmentsClient, source sponsorSegmentsSource, videoIDs []string) error {
+	records, err := db.GetSponsorBlockSegments(ctx, videoIDs...)
+	if err != nil {
+		metrics.ObserveVideoRefresh("sponsorblock_prefetch", err)
+		return errors.Wrap(err, "failed to read cached sponsorblock segments")
+	}
+
+	now := time.Now()
+	var group errgroup.Group
+	group.SetLimit(sponsorSegmentsPrefetchLimit)
+	for _, id := range videoIDs {
+		if record, ok := records[id]; ok && now.Before(record.ExpiresAt) {
+			continue
+		}
+		group.Go(func() error {
+			_, err := cachedSponsorSegments(ctx, db, source, id, sponsorSegmentsPrefetchTimeout)
+			if err != nil {
+				log.Debug().Err(err).Str("videoID", id).Msg("failed to prefetch sponsorblock segments")
+			}
+			return nil
+		})
+	}
+
+	err = group.Wait()
+	metrics.ObserveVideoRefresh("sponsorblock_prefetch", err)
+	return err
+}
+
+/*
+PrefetchFeedSponsorBlockSegments warms the segment cache for the top of a user feed in the background when the user has SponsorBlock enabled
+*/
+func PrefetchFeedSponsorBlockSegments(db database.SponsorBlockSegmentsClient, settings types.SponsorBlockSettingsProps, videos ...types.VideoProps) {
+	if !settings.SponsorBlockEnabled || len(videos) == 0 || defaultSponsorSegmentsSource() == nil {
+		return
+	}
+
+	var videoIDs []string
+	for _, video := range videos {
+		if video.Progress > 0 && video.Duration > 0 && video.Progress >= video.Duration {
+			continue
+		}
+		videoIDs = append(videoIDs, video.ID)
+		if len(videoIDs) >= sponsorSegmentsFeedPrefetch {
+			break
+		}
+	}
+
+	prefetches.goPrefetch(func(ctx context.Context) {
+		if err := PrefetchSponsorBlockSegments(ctx, db, videoIDs...); err != nil {
+			log.Warn().Err(err).Msg("failed to prefetch feed sponsorblock segments")
+		}
+	})
+}
+
+/*
+AddSponsorBlockLabels sets full video labels on feed videos, only cached segments are used so rendering a feed never waits on the upstream
+*/
+func AddSponsorBlockLabels(ctx context.Context, db database.SponsorBlockSegmentsClient, settings types.SponsorBlockSettingsProps, videos []types.VideoProps) {
+	if !settings.SponsorBlockEnabled || len(videos) == 0 {
+		return
+	}
+
+	videoIDs := make([]string, 0, len(videos))
+	for _, video := range videos {
+		videoIDs = append(videoIDs, video.ID)
+	}
+	records, err := db.GetSponsorBlockSegments(ctx, videoIDs...)
+	if err != nil {
+		log.Warn().Err(err).Msg("failed to read cached sponsorblock segments for feed labels")
+		return
+	}
+
+	for i, video := range videos {
+		record, ok := records[video.ID]
+		if !ok || record.SegmentCount == 0 {
+			continue
+		}
+		segments, err := decodeSponsorSegments(record.Segments)
+		if err != nil {
+			continue
+		}
+		videos[i].SponsorBlockLabel = settings.FullVideoLabel(segments)
+	}
+}
+
+/*
+CleanupSponsorBlockSegments removes cache records that expired long enough ago to not be useful as a fallback
+*/
+func CleanupSponsorBlockSegments(ctx context.Context, db database.SponsorBlockSegmentsClient) (int64, error) {
+	return db.DeleteExpiredSponsorBlockSegments(ctx, time.Now().Add(-sponsorSegmentsRetention))
+}
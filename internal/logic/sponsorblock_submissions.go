@@ -0,0 +1,332 @@
+package logic
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/cufee/feedlr-yt/internal/api/sponsorblock"
+	"github.com/cufee/feedlr-yt/internal/database"
+	"github.com/cufee/feedlr-yt/internal/metrics"
+	"github.com/cufee/feedlr-yt/internal/types"
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	sponsorSubmissionBatchSize   = 25
+	sponsorSubmissionMaxAttempts = 8
+	sponsorSubmissionBaseBackoff = time.Minute
+	sponsorSubmissionMaxBackoff  = 6 * time.Hour
+	// Segments shorter than this are almost always misclicks
+	sponsorSubmissionMinLength = 1
+)
+
+var (
+	ErrInvalidSegment          = errors.New("invalid segment")
+	ErrSponsorBlockUnavailable = errors.New("sponsorblock submissions are not available")
+)
+
+type ProcessSponsorSubmissionsJob struct{}
+
+// A pass sends every due submission, two passes running at once on different instances would send them twice
+var JobProcessSponsorSubmissions = JobType[ProcessSponsorSubmissionsJob]{
+	Kind:     "process_sponsorblock_submissions",
+	Options:  JobOptions{Priority: JobPriorityNormal, MaxAttempts: 1, Timeout: time.Minute},
+	DedupKey: func(ProcessSponsorSubmissionsJob) string { return "all" },
+}
+
+type sponsorSegmentsSubmitter interface {
+	SubmitSegments(ctx context.Context, submission sponsorblock.Submission) ([]sponsorblock.SubmittedSegment, error)
+	VoteOnSegment(ctx context.Context, userID, segmentUUID string, upvote bool) error
+}
+
+func defaultSponsorSegmentsSubmitter() sponsorSegmentsSubmitter {
+	if sponsorblock.C == nil {
+		return nil
+	}
+	return sponsorblock.C
+}
+
+type sponsorSubmissionsDB interface {
+	database.SponsorBlockSubmissionsClient
+	database.SponsorBlockSegmentsClient
+	database.SettingsClient
+}
+
+// sponsorSubmissionsQueueDB also queues the job that sends new submissions
+type sponsorSubmissionsQueueDB interface {
+	sponsorSubmissionsDB
+	database.JobsClient
+}
+
+type SponsorBlockSegmentRequest struct {
+	VideoID       string
+	VideoDuration int
+	Start         float64
+	End           float64
+	Category      string
+	Action        types.SponsorBlockAction
+}
+
+type sponsorVotePayload struct {
+	UserID string `json:"userID"`
+	UUID   string `json:"uuid"`
+	Upvote bool   `json:"upvote"`
+}
+
+/*
+GetSponsorBlockSubmitterID returns the private SponsorBlock user ID for a user, a new ID is generated and saved on first use.
+The ID is what ties submissions and votes to a SponsorBlock account, it should never be shown to the user or logged.
+*/
+func GetSponsorBlockSubmitterID(ctx context.Context, db database.SettingsClient, userID string) (string, error) {
+	settings, err := GetUserSettings(ctx, db, userID)
+	if err != nil {
+		return "", err
+	}
+	if settings.SponsorBlock.SubmitterID != "" {
+		return settings.SponsorBlock.SubmitterID, nil
+	}
+
+	id := make([]byte, 32)
+	if _, err := rand.Read(id); err != nil {
+		return "", errors.Wrap(err, "failed to generate sponsorblock user id")
+	}
+	settings.SponsorBlock.SubmitterID = hex.EncodeToString(id)
+	return settings.SponsorBlock.SubmitterID, UpdateUserSettings(ctx, db, userID, settings)
+}
+
+func validateSponsorSegment(request SponsorBlockSegmentRequest) error {
+	if !slices.ContainsFunc(sponsorblock.SubmittableCategories, func(c sponsorblock.Category) bool { return c.Value == request.Category }) {
+		return fmt.Errorf("%w: category cannot be submitted", ErrInvalidSegment)
+	}
+	if request.Action != types.SponsorBlockActionSkip && request.Action != types.SponsorBlockActionMute {
+		return fmt.Errorf("%w: action cannot be submitted", ErrInvalidSegment)
+	}
+	if request.Start < 0 || request.End-request.Start < sponsorSubmissionMinLength {
+		return fmt.Errorf("%w: segment is too short", ErrInvalidSegment)
+	}
+	if request.VideoDuration > 0 && request.End > float64(request.VideoDuration) {
+		return fmt.Errorf("%w: segment ends after the video", ErrInvalidSegment)
+	}
+	return nil
+}
+
+/*
+SubmitSponsorBlockSegment validates and queues a new segment submission, the queue is processed in the background
+*/
+func SubmitSponsorBlockSegment(ctx context.Context, db sponsorSubmissionsQueueDB, userID string, request SponsorBlockSegmentRequest) error {
+	if err := validateSponsorSegment(request); err != nil {
+		return err
+	}
+
+	submitterID, err := GetSponsorBlockSubmitterID(ctx, db, userID)
+	if err != nil {
+		return err
+	}
+
+	actionType := sponsorblock.ActionTypeSkip
+	if request.Action == types.SponsorBlockActionMute {
+		actionType = sponsorblock.ActionTypeMute
+	}
+	payload, err := json.Marshal(sponsorblock.Submission{
+		VideoID:       request.VideoID,
+		UserID:        submitterID,
+		VideoDuration: float64(request.VideoDuration),
+		Segments: []sponsorblock.SegmentSubmission{{
+			Segment:    []float64{request.Start, request.End},
+			Category:   request.Category,
+			ActionType: actionType,
+		}},
+	})
+	if err != nil {
+		return errors.Wrap(err, "failed to encode sponsorblock submission")
+	}
+
+	return enqueueSponsorSubmission(ctx, db, &database.SponsorBlockSubmission{
+		UserID:  userID,
+		VideoID: request.VideoID,
+		Kind:    database.SponsorBlockSubmissionKindSegment,
+		Payload: string(payload),
+	})
+}
+
+/*
+VoteOnSponsorBlockSegment queues a vote on a segment, downvotes are used to report segments that were skipped incorrectly
+*/
+func VoteOnSponsorBlockSegment(ctx context.Context, db sponsorSubmissionsQueueDB, userID, videoID, segmentUUID string, upvote bool) error {
+	if segmentUUID == "" {
+		return fmt.Errorf("%w: missing segment uuid", ErrInvalidSegment)
+	}
+
+	submitterID, err := GetSponsorBlockSubmitterID(ctx, db, userID)
+	if err != nil {
+		return err
+	}
+
+	payload, err := json.Marshal(sponsorVotePayload{UserID: submitterID, UUID: segmentUUID, Upvote: upvote})
+	if err != nil {
+		return errors.Wrap(err, "failed to encode sponsorblock vote")
+	}
+
+	return enqueueSponsorSubmission(ctx, db, &database.SponsorBlockSubmission{
+		UserID:  userID,
+		VideoID: videoID,
+		Kind:    database.SponsorBlockSubmissionKindVote,
+		Payload: string(payload),
+	})
+}
+
+func enqueueSponsorSubmission(ctx context.Context, db sponsorSubmissionsQueueDB, submission *database.SponsorBlockSubmission) error {
+	if defaultSponsorSegmentsSubmitter() == nil {
+		return ErrSponsorBlockUnavailable
+	}
+
+	err := db.CreateSponsorBlockSubmission(ctx, submission)
+	if err != nil {
+		return errors.Wrap(err, "failed to queue sponsorblock submission")
+	}
+
+	// Most submissions go through on the first attempt, there is no reason to wait for the next cron tick.
+	// When a pass is already queued or running the submission is picked up by it or by the next tick.
+	if _, err := JobProcessSponsorSubmissions.Enqueue(ctx, db, ProcessSponsorSubmissionsJob{}); err != nil {
+		log.Warn().Err(err).Msg("failed to queue sponsorblock submissions processing")
+	}
+	return nil
+}
+
+/*
+GetUserSponsorBlockSubmissions returns the segments and votes a user submitted for a video, newest first
+*/
+func GetUserSponsorBlockSubmissions(ctx context.Context, db database.SponsorBlockSubmissionsClient, userID, videoID string) ([]types.SegmentSubmissionProps, error) {
+	submissions, err := db.GetUserSponsorBlockSubmissions(ctx, userID, videoID)
+	if err != nil {
+		return nil, err
+	}
+
+	var props []types.SegmentSubmissionProps
+	for _, submission := range submissions {
+		item := types.SegmentSubmissionProps{
+			Kind:      submission.Kind,
+			Status:    submission.Status,
+			LastError: submission.LastError,
+			CreatedAt: submission.CreatedAt,
+		}
+		if submission.Kind == database.SponsorBlockSubmissionKindSegment {
+			var payload sponsorblock.Submission
+			if err := json.Unmarshal([]byte(submission.Payload), &payload); err == nil && len(payload.Segments) > 0 && len(payload.Segments[0].Segment) == 2 {
+				item.Start = int(payload.Segments[0].Segment[0])
+				item.End = int(payload.Segments[0].Segment[1])
+				item.Category = payload.Segments[0].Category
+			}
+		}
+		props = append(props, item)
+	}
+	return props, nil
+}
+
+/*
+ProcessSponsorBlockSubmissions sends queued submissions that are due, failed attempts are retried with an exponential backoff.
+Returns the number of submissions that were attempted. It runs as JobProcessSponsorSubmissions, so only one pass runs at a time.
+*/
+func ProcessSponsorBlockSubmissions(ctx context.Context, db sponsorSubmissionsDB) (int, error) {
+	submitter := defaultSponsorSegmentsSubmitter()
+	if submitter == nil {
+		return 0, nil
+	}
+	return processSponsorSubmissions(ctx, db, submitter, time.Now())
+}
+
+func processSponsorSubmissions(ctx context.Context, db sponsorSubmissionsDB, submitter sponsorSegmentsSubmitter, now time.Time) (int, error) {
+	submissions, err := db.GetDueSponsorBlockSubmissions(ctx, now, sponsorSubmissionBatchSize)
+	if err != nil {
+		return 0, errors.Wrap(err, "failed to get due sponsorblock submissions")
+	}
+
+	for _, submission := range submissions {
+		if ctx.Err() != nil {
+			return 0, ctx.Err()
+		}
+
+		result, err := sendSponsorSubmission(ctx, submitter, submission)
+		metrics.ObserveVideoRefresh("sponsorblock_submit_"+submission.Kind, err)
+
+		submission.Attempts++
+		switch {
+		case err == nil:
+			submission.Status = database.SponsorBlockSubmissionStatusSubmitted
+			submission.Result = result
+			submission.LastError = ""
+			// The next lookup should include the new segment or leave out the downvoted one
+			expireSponsorSegments(ctx, db, submission.VideoID)
+
+		case errors.Is(err, sponsorblock.ErrSubmissionRejected) || submission.Attempts >= sponsorSubmissionMaxAttempts:
+			submission.Status = database.SponsorBlockSubmissionStatusFailed
+			submission.LastError = err.Error()
+
+		default:
+			submission.NextAttemptAt = now.Add(sponsorSubmissionBackoff(submission.Attempts))
+			submission.LastError = err.Error()
+		}
+
+		if err := db.UpdateSponsorBlockSubmission(ctx, submission); err != nil {
+			return 0, errors.Wrap(err, "failed to update sponsorblock submission")
+		}
+	}
+	return len(submissions), nil
+}
+
+func sendSponsorSubmission(ctx context.Context, submitter sponsorSegmentsSubmitter, submission *database.SponsorBlockSubmission) (string, error) {
+	switch submission.Kind {
+	case database.SponsorBlockSubmissionKindSegment:
+		var payload sponsorblock.Submission
+		if err := json.Unmarshal([]byte(submission.Payload), &payload); err != nil {
+			return "", errors.Wrap(sponsorblock.ErrSubmissionRejected, err.Error())
+		}
+		submitted, err := submitter.SubmitSegments(ctx, payload)
+		if err != nil {
+			return "", err
+		}
+		var uuids []string
+		for _, segment := range submitted {
+			uuids = append(uuids, segment.UUID)
+		}
+		encoded, _ := json.Marshal(uuids)
+		return string(encoded), nil
+
+	case database.SponsorBlockSubmissionKindVote:
+		var payload sponsorVotePayload
+		if err := json.Unmarshal([]byte(submission.Payload), &payload); err != nil {
+			return "", errors.Wrap(sponsorblock.ErrSubmissionRejected, err.Error())
+		}
+		return "", submitter.VoteOnSegment(ctx, payload.UserID, payload.UUID, payload.Upvote)
+
+	default:
+		return "", errors.Wrap(sponsorblock.ErrSubmissionRejected, "unknown submission kind "+submission.Kind)
+	}
+}
+
+func sponsorSubmissionBackoff(attempts int) time.Duration {
+	backoff := sponsorSubmissionBaseBackoff
+	for i := 1; i < attempts && backoff < sponsorSubmissionMaxBackoff; i++ {
+		backoff *= 2
+	}
+	return min(backoff, sponsorSubmissionMaxBackoff)
+}
+
+// expireSponsorSegments marks the cached segments for a video as expired, they are kept as a fallback until the next lookup
+func expireSponsorSegments(ctx context.Context, db database.SponsorBlockSegmentsClient, videoID string) {
+	records, err := db.GetSponsorBlockSegments(ctx, videoID)
+	if err != nil || records[videoID] == nil {
+		return
+	}
+	record := records[videoID]
+	record.ExpiresAt = time.Now()
+	if err := db.UpsertSponsorBlockSegments(ctx, record); err != nil {
+		log.Warn().Err(err).Str("videoID", videoID).Msg("failed to expire cached sponsorblock segments")
+	}
+}
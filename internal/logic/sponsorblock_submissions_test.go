@@ -0,0 +1,197 @@
+package logic
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/cufee/feedlr-yt/internal/api/sponsorblock"
+	"github.com/cufee/feedlr-yt/internal/database"
+	"github.com/cufee/feedlr-yt/internal/types"
+)
+
+type mockSponsorSubmissionsStore struct {
+	mockTVSyncStore
+	mockSponsorSegmentsStore
+
+	submissions []*database.SponsorBlockSubmission
+}
+
+func (m *mockSponsorSubmissionsStore) CreateSponsorBlockSubmission(_ context.Context, submission *database.SponsorBlockSubmission) error {
+	submission.ID = fmt.Sprintf("submission-%d", len(m.submissions)+1)
+	submission.Status = database.SponsorBlockSubmissionStatusPending
+	if submission.NextAttemptAt.IsZero() {
+		submission.NextAttemptAt = time.Now()
+	}
+	copied := *submission
+	m.submissions = append(m.submissions, &copied)
+	return nil
+}
+
+func (m *mockSponsorSubmissionsStore) GetDueSponsorBlockSubmissions(_ context.Context, before time.Time, limit int) ([]*database.SponsorBlockSubmission, error) {
+	var due []*database.SponsorBlockSubmission
+	for _, submission := range m.submissions {
+		if submission.Status == database.SponsorBlockSubmissionStatusPending && !submission.NextAttemptAt.After(before) && len(due) < limit {
+			copied := *submission
+			due = append(due, &copied)
+		}
+	}
+	return due, nil
+}
+
+func (m *mockSponsorSubmissionsStore) GetUserSponsorBlockSubmissions(_ context.Context, userID, videoID string) ([]*database.SponsorBlockSubmission, error) {
+	var found []*database.SponsorBlockSubmission
+	for _, submission := range m.submissions {
+		if submission.UserID == userID && submission.VideoID == videoID {
+			found = append(found, submission)
+		}
+	}
+	return found, nil
+}
+
+func (m *mockSponsorSubmissionsStore) UpdateSponsorBlockSubmission(_ context.Context, submission *database.SponsorBlockSubmission) error {
+	for i, existing := range m.submissions {
+		if existing.ID == submission.ID {
+			copied := *submission
+			m.submissions[i] = &copied
+		}
+	}
+	return nil
+}
+
+type stubSponsorSegmentsSubmitter struct {
+	submitted []sponsorblock.Submission
+	votes     []string
+	errs      []error
+}
+
+func (s *stubSponsorSegmentsSubmitter) nextErr() error {
+	if len(s.errs) == 0 {
+		return nil
+	}
+	err := s.errs[0]
+	s.errs = s.errs[1:]
+	return err
+}
+
+func (s *stubSponsorSegmentsSubmitter) SubmitSegments(_ context.Context, submission sponsorblock.Submission) ([]sponsorblock.SubmittedSegment, error) {
+	if err := s.nextErr(); err != nil {
+		return nil, err
+	}
+	s.submitted = append(s.submitted, submission)
+	return []sponsorblock.SubmittedSegment{{UUID: "new-uuid"}}, nil
+}
+
+func (s *stubSponsorSegmentsSubmitter) VoteOnSegment(_ context.Context, userID, segmentUUID string, upvote bool) error {
+	if err := s.nextErr(); err != nil {
+		return err
+	}
+	s.votes = append(s.votes, fmt.Sprintf("%s:%s:%v", userID, segmentUUID, upvote))
+	return nil
+}
+
+func TestGetSponsorBlockSubmitterID_Stable(t *testing.T) {
+	store := &mockTVSyncStore{}
+
+	first, err := GetSponsorBlockSubmitterID(context.Background(), store, "user-1")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(first) != 64 {
+		t.Fatalf("unexpected submitter id %q", first)
+	}
+	second, err := GetSponsorBlockSubmitterID(context.Background(), store, "user-1")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if first != second {
+		t.Fatalf("expected a stable submitter id, got %q and %q", first, second)
+	}
+}
+
+func TestValidateSponsorSegment(t *testing.T) {
+	valid := SponsorBlockSegmentRequest{VideoID: "video-1", VideoDuration: 600, Start: 10, End: 40, Category: "sponsor", Action: types.SponsorBlockActionSkip}
+	if err := validateSponsorSegment(valid); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	invalid := []SponsorBlockSegmentRequest{
+		{VideoDuration: 600, Start: 10, End: 40, Category: "poi_highlight", Action: types.SponsorBlockActionSkip},
+		{VideoDuration: 600, Start: 10, End: 40, Category: "sponsor", Action: types.SponsorBlockActionButton},
+		{VideoDuration: 600, Start: 40, End: 10, Category: "sponsor", Action: types.SponsorBlockActionSkip},
+		{VideoDuration: 600, Start: 590, End: 700, Category: "sponsor", Action: types.SponsorBlockActionSkip},
+	}
+	for _, request := range invalid {
+		if err := validateSponsorSegment(request); !errors.Is(err, ErrInvalidSegment) {
+			t.Fatalf("expected %+v to be invalid, got %v", request, err)
+		}
+	}
+}
+
+func TestProcessSponsorSubmissions_RetriesAndSubmits(t *testing.T) {
+	store := &mockSponsorSubmissionsStore{}
+	store.records = map[string]*database.SponsorBlockVideoSegments{
+		"video-1": {VideoID: "video-1", ExpiresAt: time.Now().Add(time.Hour), Segments: "[]"},
+	}
+	payload, _ := json.Marshal(sponsorblock.Submission{VideoID: "video-1", UserID: "private", Segments: []sponsorblock.SegmentSubmission{{Segment: []float64{1, 5}, Category: "sponsor"}}})
+	_ = store.CreateSponsorBlockSubmission(context.Background(), &database.SponsorBlockSubmission{UserID: "user-1", VideoID: "video-1", Kind: database.SponsorBlockSubmissionKindSegment, Payload: string(payload)})
+
+	submitter := &stubSponsorSegmentsSubmitter{errs: []error{sponsorblock.ErrRateLimited}}
+	now := time.Now()
+
+	processed, err := processSponsorSubmissions(context.Background(), store, submitter, now)
+	if err != nil || processed != 1 {
+		t.Fatalf("unexpected result %d, %v", processed, err)
+	}
+	submission := store.submissions[0]
+	if submission.Status != database.SponsorBlockSubmissionStatusPending || submission.Attempts != 1 || !submission.NextAttemptAt.Equal(now.Add(time.Minute)) {
+		t.Fatalf("expected a retry in a minute, got %+v", submission)
+	}
+
+	// Not due yet
+	processed, _ = processSponsorSubmissions(context.Background(), store, submitter, now.Add(30*time.Second))
+	if processed != 0 {
+		t.Fatalf("expected no due submissions, got %d", processed)
+	}
+
+	processed, _ = processSponsorSubmissions(context.Background(), store, submitter, now.Add(time.Minute))
+	if processed != 1 || len(submitter.submitted) != 1 {
+		t.Fatalf("expected the submission to be sent, got %d", processed)
+	}
+	submission = store.submissions[0]
+	if submission.Status != database.SponsorBlockSubmissionStatusSubmitted || submission.Result != `["new-uuid"]` {
+		t.Fatalf("unexpected submission %+v", submission)
+	}
+	if store.records["video-1"].ExpiresAt.After(time.Now()) {
+		t.Fatal("expected cached segments to be expired after a submission")
+	}
+}
+
+func TestProcessSponsorSubmissions_RejectedVote(t *testing.T) {
+	store := &mockSponsorSubmissionsStore{}
+	payload, _ := json.Marshal(sponsorVotePayload{UserID: "private", UUID: "segment-uuid"})
+	_ = store.CreateSponsorBlockSubmission(context.Background(), &database.SponsorBlockSubmission{UserID: "user-1", VideoID: "video-1", Kind: database.SponsorBlockSubmissionKindVote, Payload: string(payload)})
+
+	submitter := &stubSponsorSegmentsSubmitter{errs: []error{fmt.Errorf("%w: status 403", sponsorblock.ErrSubmissionRejected)}}
+	if _, err := processSponsorSubmissions(context.Background(), store, submitter, time.Now()); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if submission := store.submissions[0]; submission.Status != database.SponsorBlockSubmissionStatusFailed || submission.LastError == "" {
+		t.Fatalf("expected rejected vote to fail without retries, got %+v", submission)
+	}
+}
+
+func TestSponsorSubmissionBackoff(t *testing.T) {
+	if got := sponsorSubmissionBackoff(1); got != time.Minute {
+		t.Fatalf("unexpected first backoff %v", got)
+	}
+	if got := sponsorSubmissionBackoff(3); got != 4*time.Minute {
+		t.Fatalf("unexpected third backoff %v", got)
+	}
+	if got := sponsorSubmissionBackoff(20); got != sponsorSubmissionMaxBackoff {
+		t.Fatalf("expected backoff to be capped, got %v", got)
+	}
+}
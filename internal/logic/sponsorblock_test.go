@@ -0,0 +1,277 @@
+package logic
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/cufee/feedlr-yt/internal/api/sponsorblock"
+	"github.com/cufee/feedlr-yt/internal/api/youtube"
+	"github.com/cufee/feedlr-yt/internal/database"
+	"github.com/cufee/feedlr-yt/internal/types"
+)
+
+type mockSponsorSegmentsStore struct {
+	mu      sync.Mutex
+	records map[string]*database.SponsorBlockVideoSegments
+}
+
+func (m *mockSponsorSegmentsStore) GetSponsorBlockSegments(_ context.Context, videoIDs ...string) (map[string]*database.SponsorBlockVideoSegments, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	result := make(map[string]*database.SponsorBlockVideoSegments)
+	for _, id := range videoIDs {
+		if record, ok := m.records[id]; ok {
+			copied := *record
+			result[id] = &copied
+		}
+	}
+	return result, nil
+}
+
+func (m *mockSponsorSegmentsStore) UpsertSponsorBlockSegments(_ context.Context, record *database.SponsorBlockVideoSegments) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if m.records == nil {
+		m.records = make(map[string]*database.SponsorBlockVideoSegments)
+	}
+	copied := *record
+	m.records[record.VideoID] = &copied
+	return nil
+}
+
+func (m *mockSponsorSegmentsStore) DeleteExpiredSponsorBlockSegments(context.Context, time.Time) (int64, error) {
+	return 0, nil
+}
+
+type stubSponsorSegmentsSource struct {
+	mu       sync.Mutex
+	segments map[string][]sponsorblock.Segment
+	err      error
+	calls    int
+}
+
+func (s *stubSponsorSegmentsSource) LookupSegments(_ context.Context, videoID string, _ []sponsorblock.Category, _ []string) ([]sponsorblock.Segment, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.calls++
+	if s.err != nil {
+		return nil, s.err
+	}
+	return s.segments[videoID], nil
+}
+
+func TestCachedSponsorSegments_CachesLookups(t *testing.T) {
+	source := &stubSponsorSegmentsSource{segments: map[string][]sponsorblock.Segment{
+		"with-segments": {
+			{UUID: "sponsor", Category: "sponsor", ActionType: sponsorblock.ActionTypeSkip, Segment: []float64{10, 20}},
+			{UUID: "chapter", Category: "chapter", ActionType: sponsorblock.ActionTypeChapter, Segment: []float64{0, 60}},
+		},
+	}}
+	store := &mockSponsorSegmentsStore{}
+
+	for range 2 {
+		segments, err := cachedSponsorSegments(context.Background(), store, source, "with-segments", time.Second)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if len(segments) != 2 {
+			t.Fatalf("expected 2 segments, got %+v", segments)
+		}
+	}
+	if source.calls != 1 {
+		t.Fatalf("expected a single upstream lookup, got %d", source.calls)
+	}
+	if record := store.records["with-segments"]; record.SegmentCount != 2 || time.Until(record.ExpiresAt) < sponsorSegmentsTTL-time.Minute {
+		t.Fatalf("unexpected cache record %+v", record)
+	}
+
+	// Misses are cached as well, with a shorter TTL
+	for range 2 {
+		segments, err := cachedSponsorSegments(context.Background(), store, source, "no-segments", time.Second)
+		if err != nil || len(segments) != 0 {
+			t.Fatalf("expected no segments, got %+v, %v", segments, err)
+		}
+	}
+	if source.calls != 2 {
+		t.Fatalf("expected the miss to be cached, got %d lookups", source.calls)
+	}
+	if record := store.records["no-segments"]; record.Segments != "[]" || time.Until(record.ExpiresAt) > sponsorSegmentsNegativeTTL {
+		t.Fatalf("unexpected negative cache record %+v", record)
+	}
+}
+
+func TestCachedSponsorSegments_StaleFallback(t *testing.T) {
+	store := &mockSponsorSegmentsStore{records: map[string]*database.SponsorBlockVideoSegments{
+		"stale": {
+			VideoID:      "stale",
+			ExpiresAt:    time.Now().Add(-time.Hour),
+			SegmentCount: 1,
+			Segments:     `[{"segment":[5,15],"UUID":"old","category":"sponsor","actionType":"skip"}]`,
+		},
+	}}
+	source := &stubSponsorSegmentsSource{err: sponsorblock.ErrRequestTimeout}
+
+	segments, err := cachedSponsorSegments(context.Background(), store, source, "stale", time.Second)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(segments) != 1 || segments[0].UUID != "old" {
+		t.Fatalf("expected stale segments, got %+v", segments)
+	}
+
+	if _, err := cachedSponsorSegments(context.Background(), store, source, "missing", time.Second); !errors.Is(err, sponsorblock.ErrRequestTimeout) {
+		t.Fatalf("expected timeout error without a cached record, got %v", err)
+	}
+}
+
+func TestFilterSponsorSegments(t *testing.T) {
+	segments := []sponsorblock.Segment{
+		{UUID: "sponsor", Category: "sponsor", ActionType: sponsorblock.ActionTypeSkip},
+		{UUID: "intro", Category: "intro", ActionType: sponsorblock.ActionTypeSkip},
+		{UUID: "legacy", Category: "selfpromo"},
+		{UUID: "chapter", Category: "chapter", ActionType: sponsorblock.ActionTypeChapter},
+	}
+
+	skips := filterSponsorSegments(segments, sponsorblock.ActionTypeSkip, []sponsorblock.Category{sponsorblock.Sponsor, sponsorblock.SelfPromo})
+	if len(skips) != 2 || skips[0].UUID != "sponsor" || skips[1].UUID != "legacy" {
+		t.Fatalf("unexpected skip segments %+v", skips)
+	}
+
+	chapters := filterSponsorSegments(segments, sponsorblock.ActionTypeChapter, []sponsorblock.Category{sponsorblock.Chapter})
+	if len(chapters) != 1 || chapters[0].UUID != "chapter" {
+		t.Fatalf("unexpected chapter segments %+v", chapters)
+	}
+}
+
+func TestPrefetchSponsorSegments_SkipsFresh(t *testing.T) {
+	store := &mockSponsorSegmentsStore{records: map[string]*database.SponsorBlockVideoSegments{
+		"fresh": {VideoID: "fresh", ExpiresAt: time.Now().Add(time.Hour), Segments: "[]"},
+	}}
+	source := &stubSponsorSegmentsSource{}
+
+	if err := prefetchSponsorSegments(context.Background(), store, source, []string{"fresh", "new-1", "new-2"}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if source.calls != 2 {
+		t.Fatalf("expected 2 upstream lookups, got %d", source.calls)
+	}
+	if _, ok := store.records["new-2"]; !ok {
+		t.Fatal("expected prefetched video to be cached")
+	}
+}
+
+func TestSponsorBlockSettingsActions(t *testing.T) {
+	// Settings saved before per-category actions only have the selected list
+	legacy := types.SponsorBlockSettingsProps{SponsorBlockEnabled: true, SelectedSponsorBlockCategories: []string{"sponsor"}}
+	if action := legacy.CategoryAction("sponsor"); action != types.SponsorBlockActionSkip {
+		t.Fatalf("expected selected category to be skipped, got %q", action)
+	}
+	if action := legacy.CategoryAction("intro"); action != types.SponsorBlockActionIgnore {
+		t.Fatalf("expected unselected category to be ignored, got %q", action)
+	}
+	if action := legacy.CategoryAction("poi_highlight"); action != types.SponsorBlockActionButton {
+		t.Fatalf("expected highlight button by default, got %q", action)
+	}
+
+	settings := types.SponsorBlockSettingsProps{
+		SponsorBlockEnabled: true,
+		CategoryActions: map[string]types.SponsorBlockAction{
+			"sponsor":          types.SponsorBlockActionSkip,
+			"selfpromo":        types.SponsorBlockActionButton,
+			"music_offtopic":   types.SponsorBlockActionMute,
+			"poi_highlight":    types.SponsorBlockActionSkip,
+			"exclusive_access": types.SponsorBlockActionMute, // not allowed, falls back to the default
+		},
+	}
+	if action := settings.CategoryAction("exclusive_access"); action != types.SponsorBlockActionButton {
+		t.Fatalf("expected invalid action to fall back, got %q", action)
+	}
+
+	segments := []sponsorblock.Segment{
+		{Segment: []float64{10, 20}, Category: "sponsor", ActionType: sponsorblock.ActionTypeSkip},
+		{Segment: []float64{30, 40}, Category: "sponsor", ActionType: sponsorblock.ActionTypeMute},
+		{Segment: []float64{50, 60}, Category: "selfpromo", ActionType: sponsorblock.ActionTypeSkip},
+		{Segment: []float64{70, 80}, Category: "music_offtopic", ActionType: sponsorblock.ActionTypeSkip},
+		{Segment: []float64{90, 95}, Category: "intro", ActionType: sponsorblock.ActionTypeSkip},
+		{Segment: []float64{120, 120}, Category: "poi_highlight", ActionType: sponsorblock.ActionTypePOI},
+		{Segment: []float64{0, 0}, Category: "exclusive_access", ActionType: sponsorblock.ActionTypeFull},
+		{Segment: []float64{0, 100}, Category: "chapter", ActionType: sponsorblock.ActionTypeChapter},
+	}
+
+	var player types.VideoPlayerProps
+	if err := player.AddSegments(settings, segments...); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	expected := []types.SponsorBlockAction{types.SponsorBlockActionSkip, types.SponsorBlockActionMute, types.SponsorBlockActionButton, types.SponsorBlockActionMute}
+	if len(player.Segments) != len(expected) {
+		t.Fatalf("expected %d segments, got %+v", len(expected), player.Segments)
+	}
+	for i, action := range expected {
+		if player.Segments[i].Action != action {
+			t.Fatalf("expected segment %d to %s, got %+v", i, action, player.Segments[i])
+		}
+	}
+	if player.Highlight == nil || player.Highlight.Start != 120 || player.Highlight.Action != types.SponsorBlockActionSkip {
+		t.Fatalf("unexpected highlight %+v", player.Highlight)
+	}
+	if player.Video.SponsorBlockLabel != sponsorblock.ExclusiveAccess.Name {
+		t.Fatalf("unexpected full video label %q", player.Video.SponsorBlockLabel)
+	}
+}
+
+func TestAddSponsorBlockLabels(t *testing.T) {
+	store := &mockSponsorSegmentsStore{records: map[string]*database.SponsorBlockVideoSegments{
+		"labeled":   {VideoID: "labeled", SegmentCount: 1, Segments: `[{"segment":[0,0],"category":"sponsor","actionType":"full"}]`},
+		"unlabeled": {VideoID: "unlabeled", SegmentCount: 1, Segments: `[{"segment":[1,2],"category":"sponsor","actionType":"skip"}]`},
+	}}
+	videos := []types.VideoProps{{Video: youtube.Video{ID: "labeled"}}, {Video: youtube.Video{ID: "unlabeled"}}, {Video: youtube.Video{ID: "missing"}}}
+
+	settings := types.SponsorBlockSettingsProps{SponsorBlockEnabled: true, SelectedSponsorBlockCategories: []string{"sponsor"}}
+	AddSponsorBlockLabels(context.Background(), store, settings, videos)
+	if videos[0].SponsorBlockLabel != sponsorblock.Sponsor.Name || videos[1].SponsorBlockLabel != "" || videos[2].SponsorBlockLabel != "" {
+		t.Fatalf("unexpected labels %q, %q, %q", videos[0].SponsorBlockLabel, videos[1].SponsorBlockLabel, videos[2].SponsorBlockLabel)
+	}
+
+	videos[0].SponsorBlockLabel = ""
+	settings.CategoryActions = map[string]types.SponsorBlockAction{"sponsor": types.SponsorBlockActionIgnore}
+	AddSponsorBlockLabels(context.Background(), store, settings, videos)
+	if videos[0].SponsorBlockLabel != "" {
+		t.Fatalf("expected ignored category to not be labeled, got %q", videos[0].SponsorBlockLabel)
+	}
+}
+
+func TestSetSponsorBlockCategoryAction(t *testing.T) {
+	store := &mockTVSyncStore{}
+
+	settings, err := SetSponsorBlockCategoryAction(context.Background(), store, "user-1", "intro", types.SponsorBlockActionMute)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if settings.SponsorBlock.CategoryAction("intro") != types.SponsorBlockActionMute {
+		t.Fatalf("expected intro to be muted, got %q", settings.SponsorBlock.CategoryAction("intro"))
+	}
+	// Defaults are kept for categories that were not changed
+	if settings.SponsorBlock.CategoryAction("sponsor") != types.SponsorBlockActionSkip {
+		t.Fatalf("expected sponsor to be skipped, got %q", settings.SponsorBlock.CategoryAction("sponsor"))
+	}
+
+	saved, err := GetUserSettings(context.Background(), store, "user-1")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !slices.Contains(saved.SponsorBlock.SelectedSponsorBlockCategories, "intro") || saved.SponsorBlock.CategoryAction("intro") != types.SponsorBlockActionMute {
+		t.Fatalf("unexpected saved settings %+v", saved.SponsorBlock)
+	}
+
+	if _, err := SetSponsorBlockCategoryAction(context.Background(), store, "user-1", "exclusive_access", types.SponsorBlockActionSkip); err == nil {
+		t.Fatal("expected full video categories to reject skipping")
+	}
+}
@@ -7,6 +7,7 @@ import (
 	"github.com/cufee/feedlr-yt/internal/database"
 	"github.com/cufee/feedlr-yt/internal/types"
 	"github.com/pkg/errors"
+	"github.com/rs/zerolog/log"
 )
 
 func SubscriptionExists(ctx context.Context, db database.SubscriptionsClient, userId, channelId string) (bool, error) {
@@ -26,14 +27,13 @@ func NewSubscription(ctx context.Context, db database.Client, userId, channelId
 		return nil, errors.Wrap(err, "failed to cache channel")
 	}
 
-	go func() {
-		if time.Since(channel.FeedUpdatedAt) < time.Hour {
-			return
+	if time.Since(channel.FeedUpdatedAt) >= time.Hour {
+		// The user is waiting for the channel to show up in their feed
+		_, err := JobRefreshChannel.EnqueueWithPriority(ctx, db, RefreshChannelJob{ChannelID: channel.ID, Limit: 3}, JobPriorityHigh)
+		if err != nil {
+			log.Warn().Err(err).Str("channelID", channel.ID).Msg("failed to queue channel refresh")
 		}
-		ctx, cancel := context.WithTimeout(context.Background(), time.Second*15)
-		defer cancel()
-		CacheChannelVideos(ctx, db, 3, channelId)
-	}()
+	}
 
 	sub, err := db.NewSubscription(ctx, userId, channel.ID)
 	if err != nil {
@@ -0,0 +1,380 @@
+package logic
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"io"
+	"slices"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/cufee/feedlr-yt/internal/api/youtube"
+	"github.com/cufee/feedlr-yt/internal/database"
+	"github.com/cufee/feedlr-yt/internal/metrics"
+	"github.com/cufee/feedlr-yt/internal/types"
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	// Transcripts are large, only keep the spoken language and one more track per video
+	maxTranscriptLanguages = 2
+
+	minTranscriptTermLength = 2
+	maxTranscriptTermLength = 40
+	maxTranscriptQueryTerms = 6
+
+	transcriptFetchBatchSize = 20
+	// Auto-generated captions show up a while after upload, recent videos without tracks are checked again
+	transcriptRecheckWindow   = 3 * 24 * time.Hour
+	transcriptRecheckInterval = 6 * time.Hour
+)
+
+type transcriptSource interface {
+	GetVideoCaptionTracks(videoID string) ([]youtube.CaptionTrack, error)
+	GetTranscript(track youtube.CaptionTrack) ([]youtube.TranscriptCue, error)
+}
+
+/*
+selectCaptionTracks picks the tracks worth storing. The spoken language comes first, a creator-provided track is preferred over an auto-generated one.
+*/
+func selectCaptionTracks(tracks []youtube.CaptionTrack, limit int) []youtube.CaptionTrack {
+	byLanguage := make(map[string]youtube.CaptionTrack)
+	var languages []string
+	var spoken string
+	for _, track := range tracks {
+		if track.AutoGenerated && spoken == "" {
+			spoken = track.LanguageCode
+		}
+		existing, ok := byLanguage[track.LanguageCode]
+		if !ok {
+			languages = append(languages, track.LanguageCode)
+		}
+		if !ok || (existing.AutoGenerated && !track.AutoGenerated) {
+			byLanguage[track.LanguageCode] = track
+		}
+	}
+
+	if spoken != "" {
+		languages = slices.DeleteFunc(languages, func(language string) bool { return language == spoken })
+		languages = append([]string{spoken}, languages...)
+	}
+
+	var selected []youtube.CaptionTrack
+	for _, language := range languages {
+		if len(selected) >= limit {
+			break
+		}
+		selected = append(selected, byLanguage[language])
+	}
+	return selected
+}
+
+func encodeTranscriptCues(cues []youtube.TranscriptCue) ([]byte, error) {
+	var buf bytes.Buffer
+	writer := gzip.NewWriter(&buf)
+	if err := json.NewEncoder(writer).Encode(cues); err != nil {
+		return nil, err
+	}
+	if err := writer.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func decodeTranscriptCues(content []byte) ([]youtube.TranscriptCue, error) {
+	reader, err := gzip.NewReader(bytes.NewReader(content))
+	if err != nil {
+		return nil, err
+	}
+	defer reader.Close()
+
+	data, err := io.ReadAll(reader)
+	if err != nil {
+		return nil, err
+	}
+
+	var cues []youtube.TranscriptCue
+	if err := json.Unmarshal(data, &cues); err != nil {
+		return nil, err
+	}
+	return cues, nil
+}
+
+/*
+tokenizeTranscriptText splits text into lowercase search terms
+*/
+func tokenizeTranscriptText(text string) []string {
+	words := strings.FieldsFunc(strings.ToLower(text), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsNumber(r)
+	})
+
+	terms := words[:0]
+	for _, word := range words {
+		length := len([]rune(word))
+		if length < minTranscriptTermLength || length > maxTranscriptTermLength {
+			continue
+		}
+		terms = append(terms, word)
+	}
+	return terms
+}
+
+/*
+transcriptTerms builds the search index for a transcript, mapping each term to the first second it is spoken at
+*/
+func transcriptTerms(cues []youtube.TranscriptCue) map[string]int {
+	terms := make(map[string]int)
+	for _, cue := range cues {
+		for _, term := range tokenizeTranscriptText(cue.Text) {
+			if _, ok := terms[term]; !ok {
+				terms[term] = cue.StartMs / 1000
+			}
+		}
+	}
+	return terms
+}
+
+/*
+UpdateVideoTranscripts fetches caption tracks for a video and stores them along with the search index
+*/
+func UpdateVideoTranscripts(ctx context.Context, db database.VideoTranscriptsClient, videoID string) error {
+	if youtube.DefaultClient == nil {
+		return errors.New("youtube client is not initialized")
+	}
+	return updateVideoTranscripts(ctx, db, youtube.DefaultClient, videoID)
+}
+
+func updateVideoTranscripts(ctx context.Context, db database.VideoTranscriptsClient, source transcriptSource, videoID string) error {
+	tracks, err := source.GetVideoCaptionTracks(videoID)
+	if err != nil && !errors.Is(err, youtube.ErrNoCaptionTracks) {
+		metrics.ObserveVideoRefresh("update_video_transcripts", err)
+		return errors.Wrap(err, "failed to get caption tracks")
+	}
+
+	selected := selectCaptionTracks(tracks, maxTranscriptLanguages)
+	var stored int
+	for _, track := range selected {
+		cues, err := source.GetTranscript(track)
+		if err != nil {
+			log.Warn().Err(err).Str("videoID", videoID).Str("language", track.LanguageCode).Msg("failed to fetch transcript")
+			continue
+		}
+		if len(cues) == 0 {
+			continue
+		}
+
+		content, err := encodeTranscriptCues(cues)
+		if err != nil {
+			metrics.ObserveVideoRefresh("update_video_transcripts", err)
+			return errors.Wrap(err, "failed to compress transcript")
+		}
+
+		err = db.UpsertVideoTranscript(ctx, &database.VideoTranscript{
+			VideoID:       videoID,
+			Language:      track.LanguageCode,
+			Name:          track.Name,
+			AutoGenerated: track.AutoGenerated,
+			CueCount:      len(cues),
+			Content:       content,
+		}, transcriptTerms(cues))
+		if err != nil {
+			metrics.ObserveVideoRefresh("update_video_transcripts", err)
+			return errors.Wrap(err, "failed to save transcript")
+		}
+		stored++
+	}
+
+	err = db.SetVideoTranscriptCheck(ctx, videoID, stored)
+	metrics.ObserveVideoRefresh("update_video_transcripts", err)
+	if err != nil {
+		return errors.Wrap(err, "failed to save transcript check")
+	}
+	metrics.AddVideoRefreshItems("update_video_transcripts", stored)
+	return nil
+}
+
+type CacheTranscriptsJob struct{}
+
+// A single scan covers every channel with transcripts enabled, so there is never more than one queued
+var JobCacheTranscripts = JobType[CacheTranscriptsJob]{
+	Kind:     "cache_transcripts",
+	Options:  JobOptions{Priority: JobPriorityLow, MaxAttempts: 1, Timeout: 5 * time.Minute},
+	DedupKey: func(CacheTranscriptsJob) string { return "all" },
+}
+
+/*
+CacheMissingTranscripts fetches transcripts for a batch of videos from channels where a subscriber enabled transcripts
+*/
+func CacheMissingTranscripts(ctx context.Context, db database.VideoTranscriptsClient) (int, error) {
+	now := time.Now()
+	videoIDs, err := db.GetVideosMissingTranscripts(ctx, now.Add(-transcriptRecheckWindow), now.Add(-transcriptRecheckInterval), transcriptFetchBatchSize)
+	if err != nil {
+		metrics.ObserveVideoRefresh("cache_missing_transcripts", err)
+		return 0, errors.Wrap(err, "failed to get videos missing transcripts")
+	}
+
+	var updated int
+	for _, id := range videoIDs {
+		if ctx.Err() != nil {
+			break
+		}
+		if err := UpdateVideoTranscripts(ctx, db, id); err != nil {
+			log.Warn().Err(err).Str("videoID", id).Msg("failed to update video transcripts")
+			continue
+		}
+		updated++
+	}
+
+	metrics.ObserveVideoRefresh("cache_missing_transcripts", ctx.Err())
+	return updated, ctx.Err()
+}
+
+/*
+GetVideoTranscriptProps returns a stored transcript for a video in the requested language, or the preferred one when the language is empty.
+Returns nil when no transcript is stored.
+*/
+func GetVideoTranscriptProps(ctx context.Context, db database.VideoTranscriptsClient, videoID, language string) (*types.TranscriptProps, error) {
+	transcripts, err := db.GetVideoTranscripts(ctx, videoID)
+	if err != nil && !database.IsErrNotFound(err) {
+		return nil, errors.Wrap(err, "failed to get video transcripts")
+	}
+	if len(transcripts) == 0 {
+		return nil, nil
+	}
+
+	selected := transcripts[0]
+	props := types.TranscriptProps{VideoID: videoID}
+	for _, transcript := range transcripts {
+		if transcript.Language == language {
+			selected = transcript
+		}
+		props.Languages = append(props.Languages, types.TranscriptLanguageProps{
+			Language:      transcript.Language,
+			Name:          transcript.Name,
+			AutoGenerated: transcript.AutoGenerated,
+		})
+	}
+
+	cues, err := decodeTranscriptCues(selected.Content)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to decode transcript")
+	}
+
+	props.Language = selected.Language
+	props.Name = selected.Name
+	props.AutoGenerated = selected.AutoGenerated
+	for _, cue := range cues {
+		props.Cues = append(props.Cues, types.TranscriptCueProps{Start: cue.StartMs / 1000, Text: cue.Text})
+	}
+	return &props, nil
+}
+
+/*
+SearchTranscripts finds videos from the user's subscriptions where all words of the query are spoken
+*/
+func SearchTranscripts(ctx context.Context, db database.Client, userID, query string, limit int) ([]types.TranscriptSearchResultProps, error) {
+	var terms []string
+	for _, term := range tokenizeTranscriptText(query) {
+		if !slices.Contains(terms, term) {
+			terms = append(terms, term)
+		}
+	}
+	if len(terms) == 0 {
+		return nil, nil
+	}
+	if len(terms) > maxTranscriptQueryTerms {
+		terms = terms[:maxTranscriptQueryTerms]
+	}
+
+	// The same video can match in several languages
+	matches, err := db.SearchVideoTranscripts(ctx, userID, terms, limit*maxTranscriptLanguages)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to search transcripts")
+	}
+
+	var videoIDs []string
+	byVideo := make(map[string]database.VideoTranscriptSearchResult)
+	for _, match := range matches {
+		if _, ok := byVideo[match.VideoID]; ok || len(videoIDs) >= limit {
+			continue
+		}
+		byVideo[match.VideoID] = match
+		videoIDs = append(videoIDs, match.VideoID)
+	}
+	if len(videoIDs) == 0 {
+		return nil, nil
+	}
+
+	videos, err := db.FindVideos(ctx, database.Video.ID(videoIDs...), database.Video.WithChannel())
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to find videos")
+	}
+
+	var results []types.TranscriptSearchResultProps
+	for _, video := range videos {
+		match := byVideo[video.ID]
+
+		var channel types.ChannelProps
+		if video.R != nil && video.R.Channel != nil {
+			channel = types.ChannelModelToProps(video.R.Channel)
+		}
+		result := types.TranscriptSearchResultProps{
+			Video:        types.VideoModelToProps(video, channel),
+			StartSeconds: match.StartSeconds,
+		}
+
+		transcript, err := GetVideoTranscriptProps(ctx, db, video.ID, match.Language)
+		if err != nil {
+			log.Warn().Err(err).Str("videoID", video.ID).Msg("failed to load transcript for search snippet")
+		} else if transcript != nil {
+			result.Snippet = transcriptSnippet(transcript.Cues, match.StartSeconds)
+		}
+		results = append(results, result)
+	}
+	return results, nil
+}
+
+/*
+transcriptSnippet returns the text around a position in the transcript
+*/
+func transcriptSnippet(cues []types.TranscriptCueProps, start int) string {
+	for i, cue := range cues {
+		if cue.Start < start {
+			continue
+		}
+		var parts []string
+		for _, c := range cues[i:min(i+3, len(cues))] {
+			parts = append(parts, c.Text)
+		}
+		return strings.Join(parts, " ")
+	}
+	return ""
+}
+
+/*
+ToggleSubscriptionTranscripts flips transcript fetching for a subscription and returns the new state
+*/
+func ToggleSubscriptionTranscripts(ctx context.Context, db database.Client, userID, channelID string) (bool, error) {
+	sub, err := db.FindSubscription(ctx, userID, channelID)
+	if err != nil {
+		return false, errors.Wrap(err, "failed to find subscription")
+	}
+
+	sub.TranscriptsEnabled = !sub.TranscriptsEnabled
+	err = db.UpdateSubscription(ctx, sub)
+	if err != nil {
+		return false, errors.Wrap(err, "failed to update subscription")
+	}
+
+	if sub.TranscriptsEnabled {
+		// The scan is global, opting in to several channels in a row still queues a single one
+		if _, err := JobCacheTranscripts.Enqueue(ctx, db, CacheTranscriptsJob{}); err != nil {
+			log.Warn().Err(err).Str("channelID", channelID).Msg("failed to queue transcript caching after opt-in")
+		}
+	}
+	return sub.TranscriptsEnabled, nil
+}
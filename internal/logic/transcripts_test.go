@@ -0,0 +1,189 @@
+package logic
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/cufee/feedlr-yt/internal/api/youtube"
+	"github.com/cufee/feedlr-yt/internal/database"
+)
+
+type mockTranscriptStore struct {
+	transcripts []*database.VideoTranscript
+	terms       map[string]map[string]int
+	checks      map[string]int
+}
+
+func (m *mockTranscriptStore) GetVideoTranscripts(_ context.Context, videoID string) ([]*database.VideoTranscript, error) {
+	var result []*database.VideoTranscript
+	for _, transcript := range m.transcripts {
+		if transcript.VideoID == videoID {
+			result = append(result, transcript)
+		}
+	}
+	return result, nil
+}
+
+func (m *mockTranscriptStore) UpsertVideoTranscript(_ context.Context, transcript *database.VideoTranscript, terms map[string]int) error {
+	if m.terms == nil {
+		m.terms = make(map[string]map[string]int)
+	}
+	m.transcripts = append(m.transcripts, transcript)
+	m.terms[transcript.Language] = terms
+	return nil
+}
+
+func (m *mockTranscriptStore) SetVideoTranscriptCheck(_ context.Context, videoID string, trackCount int) error {
+	if m.checks == nil {
+		m.checks = make(map[string]int)
+	}
+	m.checks[videoID] = trackCount
+	return nil
+}
+
+func (m *mockTranscriptStore) GetVideosMissingTranscripts(context.Context, time.Time, time.Time, int) ([]string, error) {
+	return nil, nil
+}
+
+func (m *mockTranscriptStore) SearchVideoTranscripts(context.Context, string, []string, int) ([]database.VideoTranscriptSearchResult, error) {
+	return nil, nil
+}
+
+// recordedTranscriptSource serves caption tracks and cues captured from real responses
+type recordedTranscriptSource struct {
+	tracks []youtube.CaptionTrack
+	cues   map[string][]youtube.TranscriptCue
+	err    error
+}
+
+func (s recordedTranscriptSource) GetVideoCaptionTracks(string) ([]youtube.CaptionTrack, error) {
+	if s.err != nil {
+		return nil, s.err
+	}
+	return s.tracks, nil
+}
+
+func (s recordedTranscriptSource) GetTranscript(track youtube.CaptionTrack) ([]youtube.TranscriptCue, error) {
+	cues, ok := s.cues[track.BaseURL]
+	if !ok {
+		return nil, errors.New("unexpected track")
+	}
+	return cues, nil
+}
+
+func TestSelectCaptionTracks(t *testing.T) {
+	tracks := []youtube.CaptionTrack{
+		{LanguageCode: "de", BaseURL: "de"},
+		{LanguageCode: "fr", BaseURL: "fr"},
+		{LanguageCode: "en", BaseURL: "en-asr", AutoGenerated: true},
+		{LanguageCode: "en", BaseURL: "en"},
+	}
+
+	selected := selectCaptionTracks(tracks, 2)
+	if len(selected) != 2 {
+		t.Fatalf("expected 2 tracks, got %d", len(selected))
+	}
+	// The spoken language is the one with an auto-generated track, the creator track wins over it
+	if selected[0].BaseURL != "en" {
+		t.Fatalf("expected creator english track first, got %+v", selected[0])
+	}
+	if selected[1].BaseURL != "de" {
+		t.Fatalf("expected german track second, got %+v", selected[1])
+	}
+
+	if selected := selectCaptionTracks(nil, 2); len(selected) != 0 {
+		t.Fatalf("expected no tracks, got %d", len(selected))
+	}
+}
+
+func TestTranscriptTerms(t *testing.T) {
+	terms := transcriptTerms([]youtube.TranscriptCue{
+		{StartMs: 1500, Text: "We're no strangers to love"},
+		{StartMs: 4200, Text: "You know the rules, and so do I"},
+		{StartMs: 9000, Text: "Love is all around"},
+	})
+
+	expected := map[string]int{"strangers": 1, "love": 1, "rules": 4, "re": 1, "around": 9}
+	for term, start := range expected {
+		if got, ok := terms[term]; !ok || got != start {
+			t.Fatalf("expected term %q at %d, got %d (found %v)", term, start, got, ok)
+		}
+	}
+	if _, ok := terms["i"]; ok {
+		t.Fatal("expected single character terms to be skipped")
+	}
+}
+
+func TestUpdateVideoTranscripts_RecordedSource(t *testing.T) {
+	source := recordedTranscriptSource{
+		tracks: []youtube.CaptionTrack{
+			{LanguageCode: "en", Name: "English (auto-generated)", BaseURL: "en-asr", AutoGenerated: true},
+			{LanguageCode: "de", Name: "German", BaseURL: "de"},
+			{LanguageCode: "fr", Name: "French", BaseURL: "fr"},
+		},
+		cues: map[string][]youtube.TranscriptCue{
+			"en-asr": {
+				{StartMs: 18800, DurationMs: 3120, Text: "We're no strangers to love"},
+				{StartMs: 22640, DurationMs: 4160, Text: "You know the rules and so do I"},
+			},
+			"de": {
+				{StartMs: 18800, DurationMs: 3120, Text: "Wir sind keine Fremden"},
+			},
+		},
+	}
+
+	store := &mockTranscriptStore{}
+	err := updateVideoTranscripts(context.Background(), store, source, "dQw4w9WgXcQ")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(store.transcripts) != 2 {
+		t.Fatalf("expected 2 stored transcripts, got %d", len(store.transcripts))
+	}
+	if store.checks["dQw4w9WgXcQ"] != 2 {
+		t.Fatalf("expected check with 2 tracks, got %d", store.checks["dQw4w9WgXcQ"])
+	}
+	if store.terms["en"]["rules"] != 22 {
+		t.Fatalf("expected indexed term, got %v", store.terms["en"])
+	}
+
+	props, err := GetVideoTranscriptProps(context.Background(), store, "dQw4w9WgXcQ", "de")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if props.Language != "de" || len(props.Cues) != 1 || props.Cues[0].Text != "Wir sind keine Fremden" || props.Cues[0].Start != 18 {
+		t.Fatalf("unexpected german transcript: %+v", props)
+	}
+	if len(props.Languages) != 2 {
+		t.Fatalf("expected 2 languages, got %d", len(props.Languages))
+	}
+
+	props, err = GetVideoTranscriptProps(context.Background(), store, "dQw4w9WgXcQ", "")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if props.Language != "en" || len(props.Cues) != 2 {
+		t.Fatalf("expected english transcript by default, got %+v", props)
+	}
+	if snippet := transcriptSnippet(props.Cues, 22); snippet != "You know the rules and so do I" {
+		t.Fatalf("unexpected snippet %q", snippet)
+	}
+}
+
+func TestUpdateVideoTranscripts_NoCaptions(t *testing.T) {
+	store := &mockTranscriptStore{}
+	err := updateVideoTranscripts(context.Background(), store, recordedTranscriptSource{err: youtube.ErrNoCaptionTracks}, "no-captions")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if count, ok := store.checks["no-captions"]; !ok || count != 0 {
+		t.Fatalf("expected the check to be recorded with no tracks, got %d (found %v)", count, ok)
+	}
+
+	props, err := GetVideoTranscriptProps(context.Background(), store, "no-captions", "")
+	if err != nil || props != nil {
+		t.Fatalf("expected no transcript, got %+v, %v", props, err)
+	}
+}
@@ -13,9 +13,11 @@ import (
 	"github.com/cufee/feedlr-yt/internal/api/youtube"
 	"github.com/cufee/feedlr-yt/internal/database"
 	"github.com/cufee/feedlr-yt/internal/database/models"
+	"github.com/cufee/feedlr-yt/internal/tracing"
 	"github.com/cufee/feedlr-yt/internal/types"
 	"github.com/friendsofgo/errors"
 	"github.com/gofiber/fiber/v2/log"
+	"go.opentelemetry.io/otel/attribute"
 )
 
 const (
@@ -26,7 +28,10 @@ const (
 /*
 Returns a list of channel props with videos for all user subscriptions
 */
-func GetUserVideosProps(ctx context.Context, db database.Client, userId string) (*types.UserVideoFeedProps, error) {
+func GetUserVideosProps(ctx context.Context, db database.Client, userId string) (_ *types.UserVideoFeedProps, err error) {
+	ctx, span := tracing.Start(ctx, "logic.GetUserVideosProps")
+	defer func() { tracing.End(span, err) }()
+
 	// Get channels and convert them to WithVideo props
 	channels, err := GetUserSubscribedChannels(ctx, db, userId)
 	if err != nil {
@@ -103,6 +108,8 @@ func GetUserVideosProps(ctx context.Context, db database.Client, userId string)
 		}
 	}
 
+	applyUserVideoTitles(ctx, db, userId, feed.New, feed.Watched)
+	feed.PlayOnTV = TVRemoteAvailable(userId)
 	return &feed, nil
 }
 
@@ -152,6 +159,7 @@ func GetRecentVideosProps(ctx context.Context, db interface {
 		return bu.Compare(au)
 	})
 
+	applyUserVideoTitles(ctx, db, userId, feed)
 	return feed, nil
 }
 
@@ -199,6 +207,7 @@ func GetChannelVideos(ctx context.Context, db database.ChannelsClient, limit int
 func GetVideoByID(ctx context.Context, db interface {
 	database.VideosClient
 	database.ChannelsClient
+	database.JobsClient
 }, id string) (types.VideoProps, error) {
 	vid, err := db.GetVideoByID(ctx, id, database.Video.WithChannel())
 	if err != nil && !database.IsErrNotFound(err) {
@@ -222,11 +231,10 @@ func GetVideoByID(ctx context.Context, db interface {
 		return types.VideoProps{}, errors.Wrap(err, "GetVideoByID.CacheChannel failed to cache channel")
 	}
 
-	go func() {
-		ctx, cancel := context.WithTimeout(context.Background(), time.Second*1)
-		defer cancel()
-		_ = UpdateVideoCache(ctx, db, details)
-	}()
+	// The video is cached in the background, the details are already enough to render it
+	if _, err := JobRefreshVideo.Enqueue(ctx, db, RefreshVideoJob{VideoID: details.ID}); err != nil {
+		log.Warnf("failed to queue video cache refresh for %v: %v", details.ID, err)
+	}
 
 	props := types.VideoProps{Video: details.Video, Channel: types.ChannelModelToProps(channel)}
 	return props, nil
@@ -309,9 +317,17 @@ func EnsureVideoCached(ctx context.Context, db interface {
 type GetPlayerOptions struct {
 	WithProgress bool
 	WithSegments bool
+	// SponsorBlock preferences used with WithSegments, the defaults are used when not set
+	SponsorBlock *types.SponsorBlockSettingsProps
+	WithChapters bool
+	// WithTranscript loads the stored transcript, transcripts are never fetched on demand
+	WithTranscript bool
 }
 
-func GetPlayerPropsWithOpts(ctx context.Context, db database.Client, userId, videoId string, opts ...GetPlayerOptions) (types.VideoPlayerProps, error) {
+func GetPlayerPropsWithOpts(ctx context.Context, db database.Client, userId, videoId string, opts ...GetPlayerOptions) (_ types.VideoPlayerProps, err error) {
+	ctx, span := tracing.Start(ctx, "logic.GetPlayerProps", attribute.String("video.id", videoId))
+	defer func() { tracing.End(span, err) }()
+
 	var options GetPlayerOptions
 	if len(opts) > 0 {
 		options = opts[0]
@@ -323,8 +339,15 @@ func GetPlayerPropsWithOpts(ctx context.Context, db database.Client, userId, vid
 	}
 
 	playerProps := types.VideoPlayerProps{
-		Authenticated: userId != "",
-		Video:         video,
+		Authenticated:  userId != "",
+		SubmitSegments: userId != "" && sponsorblock.C != nil,
+		PlayOnTV:       TVRemoteAvailable(userId),
+		Video:          video,
+	}
+	if userId != "" {
+		videos := []types.VideoProps{playerProps.Video}
+		applyUserVideoTitles(ctx, db, userId, videos)
+		playerProps.Video = videos[0]
 	}
 
 	if options.WithProgress {
@@ -342,13 +365,32 @@ func GetPlayerPropsWithOpts(ctx context.Context, db database.Client, userId, vid
 		}
 	}
 
-	if options.WithSegments {
-		if sponsorblock.C == nil {
-			return playerProps, nil
+	sponsorSettings := defaultSettings.SponsorBlock
+	if options.SponsorBlock != nil {
+		sponsorSettings = *options.SponsorBlock
+	}
+
+	if options.WithChapters {
+		withSponsorChapters := options.WithSegments && sponsorSettings.CategoryAction(sponsorblock.Chapter.Value) != types.SponsorBlockActionIgnore
+		chapters, err := GetVideoChapters(ctx, db, playerProps.Video, withSponsorChapters)
+		if err != nil {
+			log.Warnf("failed to get chapters for video %v: %v", videoId, err)
+		}
+		playerProps.Chapters = chapters
+	}
+
+	if options.WithTranscript {
+		transcript, err := GetVideoTranscriptProps(ctx, db, videoId, "")
+		if err != nil {
+			log.Warnf("failed to get transcript for video %v: %v", videoId, err)
 		}
-		segments, err := sponsorblock.C.GetVideoSegments(videoId)
+		playerProps.Transcript = transcript
+	}
+
+	if options.WithSegments {
+		segments, err := GetSponsorBlockSegments(ctx, db, videoId)
 		if err == nil {
-			err := playerProps.AddSegments(segments...)
+			err := playerProps.AddSegments(sponsorSettings, segments...)
 			if err != nil {
 				return playerProps, errors.Wrap(err, "GetPlayerPropsWithOpts.playerProps.AddSegments failed to add segments")
 			}
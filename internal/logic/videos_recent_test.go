@@ -31,6 +31,10 @@ func (*recentVideosMockDB) UpsertView(context.Context, *models.View) error {
 	return nil
 }
 
+func (*recentVideosMockDB) InsertViews(context.Context, ...*models.View) (int, error) {
+	return 0, nil
+}
+
 func (*recentVideosMockDB) GetVideoByID(context.Context, string, ...database.VideoQuery) (*models.Video, error) {
 	return nil, nil
 }
@@ -0,0 +1,433 @@
+package logic
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"html"
+	"net/url"
+	"regexp"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aarondl/null/v8"
+	"github.com/cufee/feedlr-yt/internal/database"
+	"github.com/cufee/feedlr-yt/internal/database/models"
+	"github.com/cufee/feedlr-yt/internal/tracing"
+	"github.com/cufee/feedlr-yt/internal/types"
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog/log"
+	"go.opentelemetry.io/otel/attribute"
+	"golang.org/x/sync/errgroup"
+)
+
+const (
+	// Only the most recently watched videos are imported, every video that is not cached yet is fetched from YouTube
+	watchHistoryImportMaxVideos = 10000
+	watchHistoryImportBatchSize = 50
+	watchHistoryImportWorkers   = 5
+	// An import that did not save progress for this long is no longer running, its job ran out of attempts
+	watchHistoryImportStallAfter = 2 * time.Hour
+)
+
+var ErrWatchHistoryImportRunning = errors.New("an import is already running, try again once it finished")
+
+type ImportWatchHistoryJob struct {
+	UserID   string `json:"userId"`
+	ImportID string `json:"importId"`
+}
+
+// Progress is saved after every batch, a retry continues from the last saved batch
+var JobImportWatchHistory = JobType[ImportWatchHistoryJob]{
+	Kind:     "import_watch_history",
+	Options:  JobOptions{Priority: JobPriorityNormal, MaxAttempts: 5, Timeout: 30 * time.Minute},
+	DedupKey: func(p ImportWatchHistoryJob) string { return p.UserID },
+}
+
+// WatchHistoryEntry is a video from a Takeout watch history, the time is zero when the file had no readable time
+type WatchHistoryEntry struct {
+	VideoID   string    `json:"v"`
+	WatchedAt time.Time `json:"t"`
+}
+
+var (
+	youtubeVideoIDPattern = regexp.MustCompile(`^[A-Za-z0-9_-]{11}$`)
+	takeoutHTMLLink       = regexp.MustCompile(`href="([^"]+)"`)
+	takeoutHTMLTag        = regexp.MustCompile(`<[^>]*>`)
+	takeoutHTMLLineBreak  = regexp.MustCompile(`(?i)<br\s*/?>`)
+)
+
+// Takeout writes times in the locale of the account, these cover the English formats
+var takeoutHTMLTimeLayouts = []string{
+	"Jan 2, 2006, 3:04:05 PM MST",
+	"Jan 2, 2006, 15:04:05 MST",
+	"2 Jan 2006, 15:04:05 MST",
+	"2 Jan 2006, 3:04:05 PM MST",
+}
+
+/*
+ParseTakeoutWatchHistory reads a watch-history.json or watch-history.html file from Google Takeout.
+Every video is listed once with the last time it was watched, the most recent first. YouTube Music plays,
+searches and removed videos are left out.
+*/
+func ParseTakeoutWatchHistory(data []byte) ([]WatchHistoryEntry, error) {
+	data = bytes.TrimSpace(bytes.TrimPrefix(data, []byte("\xef\xbb\xbf")))
+
+	var entries []WatchHistoryEntry
+	var err error
+	switch {
+	case bytes.HasPrefix(data, []byte("[")):
+		entries, err = parseTakeoutJSON(data)
+	case bytes.HasPrefix(data, []byte("<")):
+		entries = parseTakeoutHTML(string(data))
+	default:
+		return nil, errors.New("unsupported file, upload watch-history.json or watch-history.html from Google Takeout")
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	latest := make(map[string]int, len(entries))
+	var unique []WatchHistoryEntry
+	for _, entry := range entries {
+		if i, ok := latest[entry.VideoID]; ok {
+			if entry.WatchedAt.After(unique[i].WatchedAt) {
+				unique[i].WatchedAt = entry.WatchedAt
+			}
+			continue
+		}
+		latest[entry.VideoID] = len(unique)
+		unique = append(unique, entry)
+	}
+	slices.SortStableFunc(unique, func(a, b WatchHistoryEntry) int {
+		return b.WatchedAt.Compare(a.WatchedAt)
+	})
+	return unique, nil
+}
+
+func parseTakeoutJSON(data []byte) ([]WatchHistoryEntry, error) {
+	var activities []struct {
+		Header   string `json:"header"`
+		TitleURL string `json:"titleUrl"`
+		Time     string `json:"time"`
+	}
+	if err := json.Unmarshal(data, &activities); err != nil {
+		return nil, errors.Wrap(err, "failed to read watch history")
+	}
+
+	var entries []WatchHistoryEntry
+	for _, activity := range activities {
+		if activity.Header == "YouTube Music" {
+			continue
+		}
+		videoID, ok := takeoutVideoID(activity.TitleURL)
+		if !ok {
+			continue
+		}
+		watchedAt, _ := time.Parse(time.RFC3339Nano, activity.Time)
+		entries = append(entries, WatchHistoryEntry{VideoID: videoID, WatchedAt: watchedAt.UTC()})
+	}
+	return entries, nil
+}
+
+func parseTakeoutHTML(document string) []WatchHistoryEntry {
+	var entries []WatchHistoryEntry
+	for _, cell := range strings.Split(document, `class="outer-cell`)[1:] {
+		if strings.Contains(cell, ">YouTube Music<") {
+			continue
+		}
+
+		content := cell
+		if i := strings.Index(cell, `class="content-cell`); i >= 0 {
+			content = cell[i:]
+			// The cell with the video is followed by a cell listing the products
+			if j := strings.Index(content[1:], `class="content-cell`); j >= 0 {
+				content = content[:j+1]
+			}
+		}
+
+		var videoID string
+		for _, link := range takeoutHTMLLink.FindAllStringSubmatch(content, -1) {
+			if id, ok := takeoutVideoID(html.UnescapeString(link[1])); ok {
+				videoID = id
+				break
+			}
+		}
+		if videoID == "" {
+			continue
+		}
+
+		var watchedAt time.Time
+		for _, line := range takeoutHTMLLineBreak.Split(content, -1) {
+			if parsed, ok := parseTakeoutHTMLTime(line); ok {
+				watchedAt = parsed
+				break
+			}
+		}
+		entries = append(entries, WatchHistoryEntry{VideoID: videoID, WatchedAt: watchedAt})
+	}
+	return entries
+}
+
+func parseTakeoutHTMLTime(line string) (time.Time, bool) {
+	text := html.UnescapeString(takeoutHTMLTag.ReplaceAllString(line, ""))
+	text = strings.Join(strings.FieldsFunc(text, func(r rune) bool {
+		return r == ' ' || r == '\u00a0' || r == '\u202f' || r == '\n' || r == '\t'
+	}), " ")
+	for _, layout := range takeoutHTMLTimeLayouts {
+		if parsed, err := time.Parse(layout, text); err == nil {
+			return parsed.UTC(), true
+		}
+	}
+	return time.Time{}, false
+}
+
+func takeoutVideoID(link string) (string, bool) {
+	parsed, err := url.Parse(strings.TrimSpace(link))
+	if err != nil {
+		return "", false
+	}
+
+	var videoID string
+	switch host := strings.TrimPrefix(parsed.Hostname(), "www."); host {
+	case "youtube.com", "m.youtube.com", "music.youtube.com":
+		if parsed.Path != "/watch" {
+			return "", false
+		}
+		videoID = parsed.Query().Get("v")
+	case "youtu.be":
+		videoID = strings.Trim(parsed.Path, "/")
+	default:
+		return "", false
+	}
+	return videoID, youtubeVideoIDPattern.MatchString(videoID)
+}
+
+/*
+StartWatchHistoryImport parses a Takeout watch history and queues its import, a single import runs per user at a time
+*/
+func StartWatchHistoryImport(ctx context.Context, db database.Client, userID string, data []byte, hide bool) (err error) {
+	ctx, span := tracing.Start(ctx, "logic.StartWatchHistoryImport")
+	defer func() { tracing.End(span, err) }()
+
+	entries, err := ParseTakeoutWatchHistory(data)
+	if err != nil {
+		return err
+	}
+	if len(entries) == 0 {
+		return errors.New("no watched videos found in the file")
+	}
+	if len(entries) > watchHistoryImportMaxVideos {
+		entries = entries[:watchHistoryImportMaxVideos]
+	}
+
+	encoded, err := json.Marshal(entries)
+	if err != nil {
+		return errors.Wrap(err, "failed to encode watch history")
+	}
+
+	record := &models.WatchHistoryImport{
+		UserID:  userID,
+		Hide:    hide,
+		Entries: string(encoded),
+		Status:  database.WatchHistoryImportQueued,
+		Total:   int64(len(entries)),
+	}
+	err = db.CreateWatchHistoryImport(ctx, record)
+	if err != nil {
+		return errors.Wrap(err, "failed to save watch history import")
+	}
+
+	queued, err := JobImportWatchHistory.Enqueue(ctx, db, ImportWatchHistoryJob{UserID: userID, ImportID: record.ID})
+	if err != nil || !queued {
+		if deleteErr := db.DeleteWatchHistoryImport(ctx, record.ID); deleteErr != nil {
+			log.Warn().Err(deleteErr).Str("import", record.ID).Msg("failed to delete watch history import")
+		}
+		if err != nil {
+			return err
+		}
+		return ErrWatchHistoryImportRunning
+	}
+	return nil
+}
+
+/*
+GetWatchHistoryImportProps returns the progress of the last watch history import of a user
+*/
+func GetWatchHistoryImportProps(ctx context.Context, db database.WatchHistoryImportsClient, userID string) (types.WatchHistoryImportProps, error) {
+	record, err := db.GetLatestWatchHistoryImport(ctx, userID)
+	if err != nil {
+		if database.IsErrNotFound(err) {
+			return types.WatchHistoryImportProps{}, nil
+		}
+		return types.WatchHistoryImportProps{}, errors.Wrap(err, "failed to get watch history import")
+	}
+
+	props := types.WatchHistoryImportProps{
+		Status:     record.Status,
+		Hide:       record.Hide,
+		Total:      int(record.Total),
+		Processed:  int(record.Processed),
+		Imported:   int(record.Imported),
+		Skipped:    int(record.Skipped),
+		Failed:     int(record.Failed),
+		LastError:  record.LastError,
+		StartedAt:  record.CreatedAt,
+		FinishedAt: record.FinishedAt.Time,
+	}
+	props.Stalled = !record.FinishedAt.Valid && time.Since(record.UpdatedAt) > watchHistoryImportStallAfter
+	return props, nil
+}
+
+/*
+importWatchHistory adds a view for every video of an import, continuing from the last saved batch.
+Videos the user already has a view for are skipped, so importing a newer Takeout only adds the videos watched since.
+*/
+func importWatchHistory(ctx context.Context, db database.Client, importID string) (err error) {
+	ctx, span := tracing.Start(ctx, "logic.importWatchHistory", attribute.String("import.id", importID))
+	defer func() { tracing.End(span, err) }()
+
+	record, err := db.GetWatchHistoryImport(ctx, importID)
+	if err != nil {
+		if database.IsErrNotFound(err) {
+			return nil
+		}
+		return errors.Wrap(err, "failed to get watch history import")
+	}
+	if record.FinishedAt.Valid {
+		return nil
+	}
+
+	var entries []WatchHistoryEntry
+	if err := json.Unmarshal([]byte(record.Entries), &entries); err != nil {
+		return PermanentJobError(errors.Wrap(err, "failed to decode watch history entries"))
+	}
+
+	progress := database.WatchHistoryImportProgress{
+		Processed: record.Processed,
+		Imported:  record.Imported,
+		Skipped:   record.Skipped,
+		Failed:    record.Failed,
+	}
+	for start := int(progress.Processed); start < len(entries); start += watchHistoryImportBatchSize {
+		batch := entries[start:min(start+watchHistoryImportBatchSize, len(entries))]
+
+		result, err := importWatchHistoryBatch(ctx, db, record.UserID, record.Hide, batch)
+		if err != nil {
+			return err
+		}
+		progress.Processed += int64(len(batch))
+		progress.Imported += int64(result.imported)
+		progress.Skipped += int64(result.skipped)
+		progress.Failed += int64(result.failed)
+		if result.firstErr != nil && progress.LastError == "" {
+			progress.LastError = result.firstErr.Error()
+		}
+
+		err = db.UpdateWatchHistoryImportProgress(ctx, importID, progress)
+		if err != nil {
+			return errors.Wrap(err, "failed to save watch history import progress")
+		}
+	}
+
+	log.Info().Str("userID", record.UserID).Int64("imported", progress.Imported).Int64("skipped", progress.Skipped).Int64("failed", progress.Failed).Msg("watch history import finished")
+	return db.FinishWatchHistoryImport(ctx, importID, database.WatchHistoryImportDone, progress.LastError)
+}
+
+type watchHistoryBatchResult struct {
+	imported int
+	skipped  int
+	failed   int
+	// firstErr is the first video that could not be cached, it does not fail the import
+	firstErr error
+}
+
+func importWatchHistoryBatch(ctx context.Context, db database.Client, userID string, hide bool, batch []WatchHistoryEntry) (watchHistoryBatchResult, error) {
+	var result watchHistoryBatchResult
+
+	videoIDs := make([]string, 0, len(batch))
+	for _, entry := range batch {
+		videoIDs = append(videoIDs, entry.VideoID)
+	}
+	existing, err := GetUserViews(ctx, db, userID, videoIDs...)
+	if err != nil && !database.IsErrNotFound(err) {
+		return result, err
+	}
+
+	var missing []WatchHistoryEntry
+	for _, entry := range batch {
+		if existing[entry.VideoID] != nil {
+			result.skipped++
+			continue
+		}
+		missing = append(missing, entry)
+	}
+	if len(missing) == 0 {
+		return result, nil
+	}
+
+	var mu sync.Mutex
+	var cached []string
+	var group errgroup.Group
+	group.SetLimit(watchHistoryImportWorkers)
+	for _, entry := range missing {
+		group.Go(func() error {
+			err := EnsureVideoCached(ctx, db, entry.VideoID)
+			mu.Lock()
+			defer mu.Unlock()
+			if err != nil {
+				result.failed++
+				if result.firstErr == nil {
+					result.firstErr = errors.Wrap(err, entry.VideoID)
+				}
+				return nil
+			}
+			cached = append(cached, entry.VideoID)
+			return nil
+		})
+	}
+	_ = group.Wait()
+	if ctx.Err() != nil {
+		// Videos that failed because the job was stopped are tried again with the batch
+		return result, ctx.Err()
+	}
+	if len(cached) == 0 {
+		return result, nil
+	}
+
+	videos, err := db.FindVideos(ctx, database.Video.ID(cached...), database.Video.Select(models.VideoColumns.ID, models.VideoColumns.Duration))
+	if err != nil {
+		return result, errors.Wrap(err, "failed to get imported videos")
+	}
+	durations := make(map[string]int64, len(videos))
+	for _, video := range videos {
+		durations[video.ID] = video.Duration
+	}
+
+	var views []*models.View
+	for _, entry := range missing {
+		duration, ok := durations[entry.VideoID]
+		if !ok {
+			continue
+		}
+		views = append(views, &models.View{
+			CreatedAt: entry.WatchedAt,
+			UpdatedAt: entry.WatchedAt,
+			UserID:    userID,
+			VideoID:   entry.VideoID,
+			Progress:  duration,
+			Hidden:    null.BoolFrom(hide),
+		})
+	}
+
+	inserted, err := db.InsertViews(ctx, views...)
+	if err != nil {
+		return result, errors.Wrap(err, "failed to save views")
+	}
+	result.imported = inserted
+	// A view added since the batch was checked is kept as it is
+	result.skipped += len(views) - inserted
+	return result, nil
+}
@@ -0,0 +1,55 @@
+package logic
+
+import (
+	"testing"
+	"time"
+
+	"github.com/matryer/is"
+)
+
+func TestParseTakeoutWatchHistoryJSON(t *testing.T) {
+	is := is.New(t)
+
+	data := []byte(`[
+		{"header": "YouTube", "title": "Watched again", "titleUrl": "https://www.youtube.com/watch?v=dQw4w9WgXcQ", "time": "2024-03-02T10:00:00.123Z"},
+		{"header": "YouTube Music", "title": "Watched a song", "titleUrl": "https://music.youtube.com/watch?v=aaaaaaaaaaa", "time": "2024-03-01T12:00:00Z"},
+		{"header": "YouTube", "title": "Watched a video that has been removed", "time": "2024-03-01T11:00:00Z"},
+		{"header": "YouTube", "title": "Searched for cats", "titleUrl": "https://www.youtube.com/results?search_query=cats", "time": "2024-03-01T10:30:00Z"},
+		{"header": "YouTube", "title": "Watched older", "titleUrl": "https://www.youtube.com/watch?v=bbbbbbbbbbb", "time": "2024-02-01T09:00:00Z"},
+		{"header": "YouTube", "title": "Watched", "titleUrl": "https://www.youtube.com/watch?v=dQw4w9WgXcQ", "time": "2023-01-01T10:00:00Z"}
+	]`)
+
+	entries, err := ParseTakeoutWatchHistory(data)
+	is.NoErr(err)
+	is.Equal(len(entries), 2) // music, removed videos and searches are skipped, videos are listed once
+	is.Equal(entries[0].VideoID, "dQw4w9WgXcQ")
+	is.Equal(entries[0].WatchedAt, time.Date(2024, 3, 2, 10, 0, 0, 123000000, time.UTC)) // the latest watch is kept
+	is.Equal(entries[1].VideoID, "bbbbbbbbbbb")
+}
+
+func TestParseTakeoutWatchHistoryHTML(t *testing.T) {
+	is := is.New(t)
+
+	data := []byte(`<html><body><div class="mdl-grid">
+<div class="outer-cell mdl-cell mdl-cell--12-col mdl-shadow--2dp"><div class="mdl-grid"><div class="header-cell mdl-cell mdl-cell--12-col"><p class="mdl-typography--title">YouTube<br></p></div><div class="content-cell mdl-cell mdl-cell--6-col mdl-typography--body-1">Watched&nbsp;<a href="https://www.youtube.com/watch?v=dQw4w9WgXcQ">A video</a><br><a href="https://www.youtube.com/channel/UCuAXFkgsw1L7xaCfnd5JJOw">A channel</a><br>Mar 2, 2024, 10:00:00&#8239;AM UTC<br></div><div class="content-cell mdl-cell mdl-cell--6-col mdl-typography--body-1 mdl-typography--text-right"></div><div class="content-cell mdl-cell mdl-cell--12-col mdl-typography--caption"><b>Products:</b><br>&emsp;YouTube<br></div></div></div>
+<div class="outer-cell mdl-cell mdl-cell--12-col mdl-shadow--2dp"><div class="mdl-grid"><div class="header-cell mdl-cell mdl-cell--12-col"><p class="mdl-typography--title">YouTube Music<br></p></div><div class="content-cell mdl-cell mdl-cell--6-col mdl-typography--body-1">Watched&nbsp;<a href="https://music.youtube.com/watch?v=aaaaaaaaaaa">A song</a><br>Mar 1, 2024, 9:00:00 AM UTC<br></div></div></div>
+<div class="outer-cell mdl-cell mdl-cell--12-col mdl-shadow--2dp"><div class="mdl-grid"><div class="header-cell mdl-cell mdl-cell--12-col"><p class="mdl-typography--title">YouTube<br></p></div><div class="content-cell mdl-cell mdl-cell--6-col mdl-typography--body-1">Watched&nbsp;<a href="https://www.youtube.com/watch?v=bbbbbbbbbbb&amp;t=10s">Another video</a><br>Feb 1, 2024, 21:00:00 UTC<br></div></div></div>
+</div></body></html>`)
+
+	entries, err := ParseTakeoutWatchHistory(data)
+	is.NoErr(err)
+	is.Equal(len(entries), 2)
+	is.Equal(entries[0].VideoID, "dQw4w9WgXcQ")
+	is.Equal(entries[0].WatchedAt, time.Date(2024, 3, 2, 10, 0, 0, 0, time.UTC))
+	is.Equal(entries[1].VideoID, "bbbbbbbbbbb")
+	is.Equal(entries[1].WatchedAt, time.Date(2024, 2, 1, 21, 0, 0, 0, time.UTC))
+}
+
+func TestParseTakeoutWatchHistoryUnsupported(t *testing.T) {
+	is := is.New(t)
+
+	_, err := ParseTakeoutWatchHistory([]byte("video_id,watched_at"))
+	is.True(err != nil)
+	_, err = ParseTakeoutWatchHistory([]byte(`[{"titleUrl": `))
+	is.True(err != nil)
+}
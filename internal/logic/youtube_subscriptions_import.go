@@ -0,0 +1,188 @@
+package logic
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/cufee/feedlr-yt/internal/api/youtube"
+	"github.com/cufee/feedlr-yt/internal/database"
+	"github.com/cufee/feedlr-yt/internal/metrics"
+	"github.com/cufee/feedlr-yt/internal/tracing"
+	"github.com/cufee/feedlr-yt/internal/types"
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	// Each page costs a single quota unit, 20 pages cover 1000 subscriptions
+	youtubeSubscriptionsMaxPages  = 20
+	subscriptionImportMaxChannels = youtubeSubscriptionsMaxPages * 50
+)
+
+var ErrSubscriptionImportRunning = errors.New("an import is already running, try again once it finished")
+
+type ImportSubscriptionsJob struct {
+	UserID     string   `json:"userId"`
+	ChannelIDs []string `json:"channelIds"`
+}
+
+var JobImportSubscriptions = JobType[ImportSubscriptionsJob]{
+	Kind:     "import_subscriptions",
+	Options:  JobOptions{Priority: JobPriorityNormal, MaxAttempts: 3, Timeout: 15 * time.Minute},
+	DedupKey: func(p ImportSubscriptionsJob) string { return "subscriptions:" + p.UserID },
+}
+
+/*
+SubscriptionImportProps lists the YouTube subscriptions of the connected account that are not in Feedlr yet
+*/
+func (s *YouTubeSyncService) SubscriptionImportProps(ctx context.Context, userID string) (props types.YouTubeSubscriptionImportProps, err error) {
+	ctx, span := tracing.Start(ctx, "logic.SubscriptionImportProps")
+	defer func() { tracing.End(span, err) }()
+
+	account, err := s.db.GetYouTubeSyncAccountByUserID(ctx, userID)
+	if err != nil {
+		if database.IsErrNotFound(err) {
+			return props, nil
+		}
+		return props, err
+	}
+	props.Connected = true
+	props.CanRead = hasYouTubeSyncScope(account.GrantedScopes, youTubeSyncReadOAuthScope)
+	if !props.CanRead {
+		return props, nil
+	}
+
+	service, err := s.youtubeServiceForAccount(ctx, account)
+	if err != nil {
+		return props, err
+	}
+
+	var channels []youtube.Channel
+	pageToken := ""
+	for page := 0; ; page++ {
+		if page >= youtubeSubscriptionsMaxPages {
+			props.Truncated = true
+			break
+		}
+
+		call := service.Subscriptions.List([]string{"snippet"}).Mine(true).MaxResults(50).Order("alphabetical")
+		if pageToken != "" {
+			call = call.PageToken(pageToken)
+		}
+		result, err := call.Context(ctx).Do()
+		metrics.ObserveYouTubeAPICall("subscriptions_import", "list_subscriptions", err)
+		if err != nil {
+			return props, errors.Wrap(err, "failed to list youtube subscriptions")
+		}
+
+		for _, item := range result.Items {
+			if item.Snippet == nil || item.Snippet.ResourceId == nil || item.Snippet.ResourceId.ChannelId == "" {
+				continue
+			}
+			channel := youtube.Channel{
+				ID:          item.Snippet.ResourceId.ChannelId,
+				Title:       item.Snippet.Title,
+				Description: item.Snippet.Description,
+			}
+			if item.Snippet.Thumbnails != nil && item.Snippet.Thumbnails.Default != nil {
+				channel.Thumbnail = item.Snippet.Thumbnails.Default.Url
+			}
+			channels = append(channels, channel)
+		}
+
+		pageToken = result.NextPageToken
+		if pageToken == "" {
+			break
+		}
+	}
+
+	subscriptions, err := s.db.UserSubscriptions(ctx, userID)
+	if err != nil && !database.IsErrNotFound(err) {
+		return props, errors.Wrap(err, "failed to get subscriptions")
+	}
+	subscribed := make(map[string]bool, len(subscriptions))
+	for _, subscription := range subscriptions {
+		subscribed[subscription.ChannelID] = true
+	}
+
+	for _, channel := range channels {
+		if subscribed[channel.ID] {
+			props.AlreadySubscribed++
+			continue
+		}
+		props.Channels = append(props.Channels, channel)
+	}
+	return props, nil
+}
+
+/*
+EnqueueSubscriptionImport queues a job subscribing the user to the channels and returns the number of channels queued.
+Channels are cached by the job, a single import runs per user at a time.
+*/
+func EnqueueSubscriptionImport(ctx context.Context, db database.JobsClient, userID string, channelIDs []string) (int, error) {
+	var unique []string
+	seen := make(map[string]bool, len(channelIDs))
+	for _, id := range channelIDs {
+		id = strings.TrimSpace(id)
+		if id == "" || seen[id] {
+			continue
+		}
+		seen[id] = true
+		unique = append(unique, id)
+	}
+	if len(unique) == 0 {
+		return 0, errors.New("no channels selected")
+	}
+	if len(unique) > subscriptionImportMaxChannels {
+		unique = unique[:subscriptionImportMaxChannels]
+	}
+
+	queued, err := JobImportSubscriptions.Enqueue(ctx, db, ImportSubscriptionsJob{UserID: userID, ChannelIDs: unique})
+	if err != nil {
+		return 0, err
+	}
+	if !queued {
+		return 0, ErrSubscriptionImportRunning
+	}
+	return len(unique), nil
+}
+
+/*
+importSubscriptions subscribes the user to each channel, channels the user is already subscribed to are skipped so a
+retry only picks up the channels that failed
+*/
+func importSubscriptions(ctx context.Context, db database.Client, userID string, channelIDs []string) error {
+	var failed []string
+	var firstErr error
+	imported := 0
+	for _, channelID := range channelIDs {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+
+		_, err := db.FindSubscription(ctx, userID, channelID)
+		if err == nil {
+			continue
+		}
+		if !database.IsErrNotFound(err) {
+			return err
+		}
+
+		_, err = NewSubscription(ctx, db, userID, channelID)
+		if err != nil {
+			if firstErr == nil {
+				firstErr = err
+			}
+			failed = append(failed, channelID)
+			continue
+		}
+		imported++
+	}
+
+	log.Info().Str("userID", userID).Int("imported", imported).Int("failed", len(failed)).Msg("subscription import finished")
+	if firstErr != nil {
+		return errors.Wrapf(firstErr, "failed to subscribe to %d channels", len(failed))
+	}
+	return nil
+}
@@ -0,0 +1,25 @@
+package logic
+
+import (
+	"context"
+	"testing"
+
+	"github.com/matryer/is"
+)
+
+func TestHasYouTubeSyncScope(t *testing.T) {
+	is := is.New(t)
+
+	is.True(hasYouTubeSyncScope(youTubeSyncOAuthScope+" "+youTubeSyncReadOAuthScope, youTubeSyncReadOAuthScope))
+	is.True(!hasYouTubeSyncScope(youTubeSyncOAuthScope, youTubeSyncReadOAuthScope))
+	is.True(!hasYouTubeSyncScope("", youTubeSyncReadOAuthScope)) // accounts connected before scopes were stored
+}
+
+func TestEnqueueSubscriptionImportNoChannels(t *testing.T) {
+	is := is.New(t)
+
+	// Nothing is queued when no channels are selected
+	queued, err := EnqueueSubscriptionImport(context.Background(), nil, "user", []string{"", " "})
+	is.True(err != nil)
+	is.Equal(queued, 0)
+}
@@ -3,9 +3,11 @@ package logic
 import (
 	"context"
 	stdErrors "errors"
+	"fmt"
 	"net/http"
 	"slices"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/aarondl/null/v8"
@@ -13,6 +15,7 @@ import (
 	"github.com/cufee/feedlr-yt/internal/database/models"
 	"github.com/cufee/feedlr-yt/internal/metrics"
 	"github.com/cufee/feedlr-yt/internal/netproxy"
+	"github.com/cufee/feedlr-yt/internal/tracing"
 	"github.com/cufee/feedlr-yt/internal/types"
 	"github.com/cufee/feedlr-yt/internal/utils"
 	"github.com/pkg/errors"
@@ -27,13 +30,24 @@ import (
 
 const (
 	youTubeSyncOAuthScope = "https://www.googleapis.com/auth/youtube"
+	// The read scope is requested explicitly so the consent of accounts connected before subscription import can be checked
+	youTubeSyncReadOAuthScope = "https://www.googleapis.com/auth/youtube.readonly"
 
 	youtubeSyncPlaylistName        = "Feedlr Sync"
 	youtubeSyncPlaylistDescription = "Managed by Feedlr"
+	// youtubeSyncPlaylistSize is the default size of a target, a target can keep at most one page of playlist items in sync
 	youtubeSyncPlaylistSize        = 36
+	youtubeSyncMaxTargetSize       = 50
+	youtubeSyncTargetTitleMaxRunes = 150
+	// YouTube limits playlist descriptions to 5000 bytes
+	youtubeSyncDescriptionMaxBytes = 4900
 	youtubeSyncListRetryAttempts   = 4
+	// Playlist writes of a sync are not canceled with the sync, this bounds how long they can take
+	youtubeSyncMutationTimeout = 30 * time.Second
 )
 
+var ErrYouTubeSyncStopped = errors.New("youtube sync is stopped")
+
 var DefaultYouTubeSync *YouTubeSyncService
 
 type YouTubeSyncService struct {
@@ -44,6 +58,11 @@ type YouTubeSyncService struct {
 
 	maxExpensiveCallsPerSync int
 	maxUsersPerTick          int
+
+	// runs tracks syncs in progress, Stop waits for them so a playlist is not left between an insert and a delete
+	runsMu  sync.Mutex
+	runs    sync.WaitGroup
+	stopped bool
 }
 
 type persistingRefreshTokenSource struct {
@@ -73,15 +92,13 @@ func (s *persistingRefreshTokenSource) Token() (*oauth2.Token, error) {
 
 func NewYouTubeSyncService(db database.Client) (*YouTubeSyncService, error) {
 	service := &YouTubeSyncService{
-		db: db,
-		crypto: newYouTubeSyncCrypto(
-			utils.MustGetEnv("YOUTUBE_SYNC_ENCRYPTION_SECRET"),
-		),
+		db:     db,
+		crypto: newYouTubeSyncCryptoFromEnv(),
 		oauthConfig: &oauth2.Config{
 			ClientID:     utils.MustGetEnv("YOUTUBE_OAUTH_CLIENT_ID"),
 			ClientSecret: utils.MustGetEnv("YOUTUBE_OAUTH_CLIENT_SECRET"),
 			RedirectURL:  utils.MustGetEnv("YOUTUBE_OAUTH_REDIRECT_URL"),
-			Scopes:       []string{youTubeSyncOAuthScope},
+			Scopes:       []string{youTubeSyncOAuthScope, youTubeSyncReadOAuthScope},
 			Endpoint:     google.Endpoint,
 		},
 		maxExpensiveCallsPerSync: 4,
@@ -102,6 +119,7 @@ func (s *YouTubeSyncService) OAuthAuthURL(state string) string {
 		state,
 		oauth2.AccessTypeOffline,
 		oauth2.SetAuthURLParam("prompt", "consent"),
+		oauth2.SetAuthURLParam("include_granted_scopes", "true"),
 	)
 }
 
@@ -137,15 +155,31 @@ func (s *YouTubeSyncService) CompleteOAuth(ctx context.Context, userID, code str
 		return errors.Wrap(err, "failed to encrypt refresh token")
 	}
 
-	err = s.db.UpsertYouTubeSyncCredentials(ctx, userID, encrypted, s.crypto.secretHash)
+	err = s.db.UpsertYouTubeSyncCredentials(ctx, userID, encrypted, s.crypto.secretHash, s.grantedScopes(token))
 	metrics.ObserveYouTubeOAuthCall("playlist_sync_save_credentials", err)
 	if err != nil {
 		return errors.Wrap(err, "failed to persist oauth credentials")
 	}
 
+	err = s.ensureDefaultTarget(ctx, userID)
+	if err != nil {
+		return errors.Wrap(err, "failed to create default sync target")
+	}
 	return nil
 }
 
+// grantedScopes returns the scopes of a token, Google lists them in the token response
+func (s *YouTubeSyncService) grantedScopes(token *oauth2.Token) string {
+	if scope, ok := token.Extra("scope").(string); ok && strings.TrimSpace(scope) != "" {
+		return strings.TrimSpace(scope)
+	}
+	return strings.Join(s.oauthConfig.Scopes, " ")
+}
+
+func hasYouTubeSyncScope(grantedScopes, scope string) bool {
+	return slices.Contains(strings.Fields(grantedScopes), scope)
+}
+
 func (s *YouTubeSyncService) Disconnect(ctx context.Context, userID string) error {
 	err := s.db.DeleteYouTubeSyncAccount(ctx, userID)
 	if err != nil && !database.IsErrNotFound(err) {
@@ -177,38 +211,52 @@ func (s *YouTubeSyncService) Status(ctx context.Context, userID string) (types.Y
 
 	status.Connected = true
 	status.Enabled = account.SyncEnabled
-	status.PlaylistID = account.PlaylistID.String
+	status.CanImportSubscriptions = hasYouTubeSyncScope(account.GrantedScopes, youTubeSyncReadOAuthScope)
 	status.LastError = account.LastError
 	if account.LastSyncedAt.Valid {
 		status.LastSyncedAt = account.LastSyncedAt.Time
 	}
+	status.DefaultTargetSize = youtubeSyncPlaylistSize
+	status.MaxTargetSize = youtubeSyncMaxTargetSize
+
+	status.Targets, status.Sources, err = s.targetsStatus(ctx, userID)
+	if err != nil {
+		return status, err
+	}
 	return status, nil
 }
 
+/*
+RunSyncTick queues a sync job for every account with sync enabled, accounts synced the longest time ago first.
+A sync already queued or running for an account is not queued again.
+*/
 func (s *YouTubeSyncService) RunSyncTick(ctx context.Context) error {
 	accounts, err := s.db.ListEnabledYouTubeSyncAccounts(ctx, s.maxUsersPerTick)
 	metrics.ObserveBackgroundTask("youtube_sync_list_accounts", err)
 	if err != nil {
 		return err
 	}
-	if len(accounts) == 0 {
-		return nil
-	}
 
+	var queueErr error
 	for _, account := range accounts {
-		runCtx, cancel := context.WithTimeout(ctx, time.Minute)
-		err := s.syncUser(runCtx, account)
-		metrics.ObserveBackgroundTask("youtube_sync_user", err)
-		cancel()
+		_, err := JobYouTubeSync.Enqueue(ctx, s.db, YouTubeSyncJob{UserID: account.UserID, Scheduled: true})
 		if err != nil {
-			log.Warn().Err(err).Str("userID", account.UserID).Msg("youtube sync failed")
+			queueErr = err
+			log.Warn().Err(err).Str("userID", account.UserID).Msg("failed to queue youtube sync")
 		}
 	}
-
-	return nil
+	return queueErr
 }
 
-func (s *YouTubeSyncService) RunSyncForUser(ctx context.Context, userID string) error {
+func (s *YouTubeSyncService) RunSyncForUser(ctx context.Context, userID string) (err error) {
+	ctx, span := tracing.Start(ctx, "logic.RunYouTubeSync")
+	defer func() { tracing.End(span, err) }()
+
+	if !s.beginRun() {
+		return ErrYouTubeSyncStopped
+	}
+	defer s.runs.Done()
+
 	account, err := s.db.GetYouTubeSyncAccountByUserID(ctx, userID)
 	metrics.ObserveBackgroundTask("youtube_sync_load_user_account", err)
 	if err != nil {
@@ -219,176 +267,273 @@ func (s *YouTubeSyncService) RunSyncForUser(ctx context.Context, userID string)
 	return err
 }
 
+func (s *YouTubeSyncService) beginRun() bool {
+	s.runsMu.Lock()
+	defer s.runsMu.Unlock()
+	if s.stopped {
+		return false
+	}
+	s.runs.Add(1)
+	return true
+}
+
+/*
+Stop waits for syncs in progress to finish, no new syncs are started after Stop.
+It returns the context error when syncs are still running once the context is done.
+*/
+func (s *YouTubeSyncService) Stop(ctx context.Context) error {
+	s.runsMu.Lock()
+	s.stopped = true
+	s.runsMu.Unlock()
+
+	done := make(chan struct{})
+	go func() {
+		s.runs.Wait()
+		close(done)
+	}()
+	select {
+	case <-done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+/*
+syncUser syncs every target of the account, targets synced the longest time ago first.
+All targets share the write budget of a single sync, targets left without budget are synced on the next run.
+*/
 func (s *YouTubeSyncService) syncUser(ctx context.Context, account *models.YoutubeSyncAccount) error {
 	attemptedAt := time.Now().UTC()
 
-	desired, latestPublishedAt, err := s.desiredVideosForUser(ctx, account.UserID)
+	targets, err := s.db.ListYouTubeSyncTargets(ctx, account.UserID)
 	if err != nil {
 		s.storeRunResult(ctx, account.UserID, account.LastFeedVideoPublishedAt, account.LastSyncedAt, attemptedAt, err.Error())
 		return err
 	}
-
-	if account.LastFeedVideoPublishedAt.Valid && !latestPublishedAt.Valid {
-		latestPublishedAt = account.LastFeedVideoPublishedAt
+	if len(targets) == 0 {
+		s.storeRunResult(ctx, account.UserID, account.LastFeedVideoPublishedAt, null.TimeFrom(attemptedAt), attemptedAt, "")
+		return nil
 	}
 
 	service, err := s.youtubeServiceForAccount(ctx, account)
 	if err != nil {
-		s.storeRunResult(ctx, account.UserID, latestPublishedAt, account.LastSyncedAt, attemptedAt, err.Error())
+		s.storeRunResult(ctx, account.UserID, account.LastFeedVideoPublishedAt, account.LastSyncedAt, attemptedAt, err.Error())
 		return err
 	}
 
-	expensiveCallsLeft := s.maxExpensiveCallsPerSync
-
-	playlistID := ""
-	if account.PlaylistID.Valid {
-		playlistID = strings.TrimSpace(account.PlaylistID.String)
+	withTitles := false
+	if settings, err := GetUserSettings(ctx, s.db, account.UserID); err == nil {
+		withTitles = settings.DisplayTitleMode() != types.VideoTitleModeOriginal
 	}
 
-	if playlistID == "" {
+	expensiveCallsLeft := s.maxExpensiveCallsPerSync
+	latestPublishedAt := account.LastFeedVideoPublishedAt
+
+	var targetErrors []string
+	var firstErr error
+	for _, target := range targets {
 		if expensiveCallsLeft < 1 {
-			err := errors.New("playlist missing but no write calls left in current sync budget")
-			s.storeRunResult(ctx, account.UserID, latestPublishedAt, account.LastSyncedAt, attemptedAt, err.Error())
-			return err
+			break
 		}
 
-		playlistID, err = createYouTubeSyncPlaylist(ctx, service)
+		result, err := s.syncTarget(ctx, service, target, withTitles, expensiveCallsLeft)
+		expensiveCallsLeft -= result.expensiveCalls
+		s.storeTargetRunResult(ctx, target, attemptedAt, err)
 		if err != nil {
-			s.storeRunResult(ctx, account.UserID, latestPublishedAt, account.LastSyncedAt, attemptedAt, err.Error())
-			return err
+			if firstErr == nil {
+				firstErr = err
+			}
+			targetErrors = append(targetErrors, fmt.Sprintf("%s: %s", target.Title, err.Error()))
+			continue
 		}
-		expensiveCallsLeft--
-		if err := s.db.UpdateYouTubeSyncPlaylistID(ctx, account.UserID, playlistID); err != nil {
-			s.storeRunResult(ctx, account.UserID, latestPublishedAt, account.LastSyncedAt, attemptedAt, err.Error())
-			return err
+
+		if types.YouTubeSyncSource(target.Source) == types.YouTubeSyncSourceFeed && result.latestPublishedAt.Valid {
+			latestPublishedAt = result.latestPublishedAt
 		}
 	}
 
-	remoteItems, err := listPlaylistItemsWithRetry(ctx, service, playlistID, 50)
-	if err != nil && isYouTubePlaylistNotFound(err) {
-		if expensiveCallsLeft < 1 {
-			err = errors.Wrap(err, "playlist missing remotely and no write calls left in current sync budget")
-			s.storeRunResult(ctx, account.UserID, latestPublishedAt, account.LastSyncedAt, attemptedAt, err.Error())
-			return err
-		}
+	if firstErr != nil {
+		s.storeRunResult(ctx, account.UserID, latestPublishedAt, account.LastSyncedAt, attemptedAt, truncateYouTubeSyncError(strings.Join(targetErrors, " | ")))
+		return firstErr
+	}
+	s.storeRunResult(ctx, account.UserID, latestPublishedAt, null.TimeFrom(time.Now().UTC()), attemptedAt, "")
+	return nil
+}
+
+type youtubeSyncTargetResult struct {
+	expensiveCalls    int
+	latestPublishedAt null.Time
+}
+
+/*
+syncTarget brings the YouTube playlist of a target in line with its source, the playlist is created when it is missing
+*/
+func (s *YouTubeSyncService) syncTarget(ctx context.Context, service *ytv3.Service, target *models.YoutubeSyncTarget, withTitles bool, expensiveCallsLeft int) (youtubeSyncTargetResult, error) {
+	var result youtubeSyncTargetResult
 
-		playlistID, err = createYouTubeSyncPlaylist(ctx, service)
+	videos, err := s.sourceVideos(ctx, target)
+	if err != nil {
+		return result, err
+	}
+	desired, titles, latestPublishedAt := selectYouTubeSyncVideos(videos, int(target.Size), types.ParseYouTubeSyncOrdering(target.Ordering), withTitles)
+
+	createPlaylist := func() (string, error) {
+		if expensiveCallsLeft-result.expensiveCalls < 1 {
+			return "", errors.New("playlist missing but no write calls left in current sync budget")
+		}
+		playlistID, err := createYouTubeSyncPlaylist(ctx, service, target.Title)
 		if err != nil {
-			s.storeRunResult(ctx, account.UserID, latestPublishedAt, account.LastSyncedAt, attemptedAt, err.Error())
-			return err
+			return "", err
 		}
-		expensiveCallsLeft--
-		if err := s.db.UpdateYouTubeSyncPlaylistID(ctx, account.UserID, playlistID); err != nil {
-			s.storeRunResult(ctx, account.UserID, latestPublishedAt, account.LastSyncedAt, attemptedAt, err.Error())
-			return err
+		result.expensiveCalls++
+		if err := s.db.UpdateYouTubeSyncTargetPlaylistID(ctx, target.ID, playlistID); err != nil {
+			return "", err
 		}
+		return playlistID, nil
+	}
 
-		remoteItems, err = listPlaylistItemsWithRetry(ctx, service, playlistID, 50)
+	playlistID := ""
+	if target.PlaylistID.Valid {
+		playlistID = strings.TrimSpace(target.PlaylistID.String)
+	}
+	if playlistID == "" {
+		playlistID, err = createPlaylist()
+		if err != nil {
+			return result, err
+		}
+	}
+
+	remoteItems, err := listPlaylistItemsWithRetry(ctx, service, playlistID, youtubeSyncMaxTargetSize)
+	if err != nil && isYouTubePlaylistNotFound(err) {
+		// The playlist was deleted on YouTube
+		playlistID, err = createPlaylist()
+		if err != nil {
+			return result, err
+		}
+		remoteItems, err = listPlaylistItemsWithRetry(ctx, service, playlistID, youtubeSyncMaxTargetSize)
 	}
 	if err != nil {
-		s.storeRunResult(ctx, account.UserID, latestPublishedAt, account.LastSyncedAt, attemptedAt, err.Error())
-		return err
+		return result, err
 	}
 
-	plan := buildPlaylistSyncPlan(desired, remoteItems, expensiveCallsLeft)
-	if len(plan.ToAdd) == 0 && len(plan.ToDelete) == 0 {
-		syncedAt := null.TimeFrom(time.Now().UTC())
-		s.storeRunResult(ctx, account.UserID, latestPublishedAt, syncedAt, attemptedAt, "")
-		return nil
+	plan := buildPlaylistSyncPlan(desired, remoteItems, expensiveCallsLeft-result.expensiveCalls)
+	result.expensiveCalls += len(plan.ToAdd) + len(plan.ToDelete)
+	if len(plan.ToAdd) > 0 || len(plan.ToDelete) > 0 {
+		if err := applyPlaylistSyncPlan(ctx, service, playlistID, plan); err != nil {
+			return result, err
+		}
 	}
 
-	var mutationErrors []string
-	var firstMutationErr error
+	if expensiveCallsLeft-result.expensiveCalls > 0 {
+		result.expensiveCalls += s.syncPlaylistSnippet(ctx, service, target.UserID, playlistID, target.Title, buildYouTubeSyncDescription(titles))
+	}
+	result.latestPublishedAt = latestPublishedAt
+	return result, nil
+}
+
+/*
+applyPlaylistSyncPlan deletes and then inserts playlist items, once the playlist is being changed the whole plan is
+applied, even when the sync is canceled
+*/
+func applyPlaylistSyncPlan(ctx context.Context, service *ytv3.Service, playlistID string, plan playlistSyncPlan) error {
+	ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), youtubeSyncMutationTimeout)
+	defer cancel()
 
+	var mutationErrors []string
 	for _, itemID := range plan.ToDelete {
 		err := deletePlaylistItem(ctx, service, itemID)
 		if err != nil {
-			err = errors.Wrapf(err, "failed to delete playlist item %s", itemID)
-			if firstMutationErr == nil {
-				firstMutationErr = err
-			}
-			mutationErrors = append(mutationErrors, err.Error())
+			mutationErrors = append(mutationErrors, errors.Wrapf(err, "failed to delete playlist item %s", itemID).Error())
 		}
 	}
-
-	if firstMutationErr != nil {
-		lastErr := strings.Join(mutationErrors, " | ")
-		if len(lastErr) > 4000 {
-			lastErr = lastErr[:4000]
-		}
-		s.storeRunResult(ctx, account.UserID, account.LastFeedVideoPublishedAt, account.LastSyncedAt, attemptedAt, lastErr)
-		return firstMutationErr
+	if len(mutationErrors) > 0 {
+		return errors.New(truncateYouTubeSyncError(strings.Join(mutationErrors, " | ")))
 	}
 
 	for _, add := range plan.ToAdd {
 		err := insertVideoIntoPlaylist(ctx, service, playlistID, add.VideoID, add.Position)
 		if err != nil {
-			err = errors.Wrapf(err, "failed to insert video %s into playlist %s at position %d", add.VideoID, playlistID, add.Position)
-			if firstMutationErr == nil {
-				firstMutationErr = err
-			}
-			mutationErrors = append(mutationErrors, err.Error())
+			mutationErrors = append(mutationErrors, errors.Wrapf(err, "failed to insert video %s into playlist %s at position %d", add.VideoID, playlistID, add.Position).Error())
 		}
 	}
-
-	if firstMutationErr != nil {
-		lastErr := strings.Join(mutationErrors, " | ")
-		if len(lastErr) > 4000 {
-			lastErr = lastErr[:4000]
-		}
-		s.storeRunResult(ctx, account.UserID, account.LastFeedVideoPublishedAt, account.LastSyncedAt, attemptedAt, lastErr)
-		return firstMutationErr
+	if len(mutationErrors) > 0 {
+		return errors.New(truncateYouTubeSyncError(strings.Join(mutationErrors, " | ")))
 	}
-
-	syncedAt := null.TimeFrom(time.Now().UTC())
-	s.storeRunResult(ctx, account.UserID, latestPublishedAt, syncedAt, attemptedAt, "")
 	return nil
 }
 
-func (s *YouTubeSyncService) desiredVideosForUser(ctx context.Context, userID string) ([]string, null.Time, error) {
-	props, err := GetUserVideosProps(ctx, s.db, userID)
-	if err != nil {
-		return nil, null.Time{}, err
+func truncateYouTubeSyncError(message string) string {
+	if len(message) > 4000 {
+		return message[:4000]
 	}
+	return message
+}
 
-	seen := make(map[string]bool)
-	var desired []string
-	var latest null.Time
+// YouTube rejects descriptions with angle brackets
+var youtubeSyncDescriptionReplacer = strings.NewReplacer("<", "", ">", "", "\r", " ", "\n", " ")
 
-	add := func(videos []types.VideoProps) {
-		for _, video := range videos {
-			if video.ID == "" || seen[video.ID] {
-				continue
-			}
-			seen[video.ID] = true
-			desired = append(desired, video.ID)
+/*
+buildYouTubeSyncDescription lists display titles in playlist order, YouTube shows original titles on playlist items so this is the only place replaced titles can be surfaced
+*/
+func buildYouTubeSyncDescription(titles []string) string {
+	var description strings.Builder
+	description.WriteString(youtubeSyncPlaylistDescription)
+	if len(titles) == 0 {
+		return description.String()
+	}
 
-			if !video.PublishedAt.IsZero() && (!latest.Valid || video.PublishedAt.After(latest.Time)) {
-				latest = null.TimeFrom(video.PublishedAt.UTC())
-			}
-			if len(desired) >= youtubeSyncPlaylistSize {
-				return
-			}
+	description.WriteString("\n")
+	for i, title := range titles {
+		line := fmt.Sprintf("\n%d. %s", i+1, strings.TrimSpace(youtubeSyncDescriptionReplacer.Replace(title)))
+		if description.Len()+len(line) > youtubeSyncDescriptionMaxBytes {
+			break
 		}
+		description.WriteString(line)
+	}
+	return description.String()
+}
+
+/*
+syncPlaylistSnippet updates the playlist title and description when they changed and returns the number of write calls made.
+Failures are logged and never fail the sync since the playlist items are already up to date.
+*/
+func (s *YouTubeSyncService) syncPlaylistSnippet(ctx context.Context, service *ytv3.Service, userID, playlistID, title, description string) int {
+	result, err := service.Playlists.List([]string{"snippet"}).Id(playlistID).Context(ctx).Do()
+	metrics.ObserveYouTubeAPICall("playlist_sync", "list_playlist", err)
+	if err != nil {
+		log.Warn().Err(err).Str("userID", userID).Msg("failed to get synced playlist snippet")
+		return 0
+	}
+	if len(result.Items) == 0 || result.Items[0].Snippet == nil {
+		return 0
 	}
 
-	add(props.New)
-	if len(desired) < youtubeSyncPlaylistSize {
-		add(props.Watched)
+	playlist := result.Items[0]
+	if title == "" {
+		title = playlist.Snippet.Title
+	}
+	if playlist.Snippet.Title == title && playlist.Snippet.Description == description {
+		return 0
 	}
 
-	if len(desired) > youtubeSyncPlaylistSize {
-		desired = desired[:youtubeSyncPlaylistSize]
+	_, err = service.Playlists.Update([]string{"snippet"}, &ytv3.Playlist{
+		Id: playlist.Id,
+		Snippet: &ytv3.PlaylistSnippet{
+			Title:           title,
+			Description:     description,
+			DefaultLanguage: playlist.Snippet.DefaultLanguage,
+		},
+	}).Context(ctx).Do()
+	metrics.ObserveYouTubeAPICall("playlist_sync", "update_playlist", err)
+	if err != nil {
+		log.Warn().Err(err).Str("userID", userID).Msg("failed to update synced playlist snippet")
 	}
-	return desired, latest, nil
+	return 1
 }
 
 func (s *YouTubeSyncService) decryptRefreshToken(account *models.YoutubeSyncAccount) ([]byte, error) {
-	if account.EncSecretHash != s.crypto.secretHash {
-		return nil, errors.New("encryption secret hash mismatch; reconnect required")
-	}
-
-	decrypted, err := s.crypto.Decrypt(account.RefreshTokenEnc, account.UserID)
+	decrypted, err := s.crypto.DecryptWithSecret(account.EncSecretHash, account.RefreshTokenEnc, account.UserID)
 	if err != nil {
 		return nil, err
 	}
@@ -400,6 +545,11 @@ func (s *YouTubeSyncService) youtubeServiceForAccount(ctx context.Context, accou
 	if err != nil {
 		return nil, err
 	}
+	if s.crypto.IsRetired(account.EncSecretHash) {
+		if _, err := reencryptRefreshToken(ctx, s.db, s.crypto, account); err != nil {
+			log.Warn().Err(err).Str("userID", account.UserID).Msg("failed to re-encrypt refresh token with the current secret")
+		}
+	}
 	currentRefreshToken := string(refreshToken)
 	defer func() {
 		for i := range refreshToken {
@@ -632,10 +782,13 @@ func filterRemoteItems(remote []playlistRemoteItem, excludedItemIDs map[string]b
 	return filtered
 }
 
-func createYouTubeSyncPlaylist(ctx context.Context, service *ytv3.Service) (string, error) {
+func createYouTubeSyncPlaylist(ctx context.Context, service *ytv3.Service, title string) (string, error) {
+	if title == "" {
+		title = youtubeSyncPlaylistName
+	}
 	playlist, err := service.Playlists.Insert([]string{"snippet", "status"}, &ytv3.Playlist{
 		Snippet: &ytv3.PlaylistSnippet{
-			Title:       youtubeSyncPlaylistName,
+			Title:       title,
 			Description: youtubeSyncPlaylistDescription,
 		},
 		Status: &ytv3.PlaylistStatus{
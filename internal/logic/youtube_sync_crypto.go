@@ -7,20 +7,72 @@ import (
 	"crypto/sha256"
 	"fmt"
 	"io"
+	"os"
+	"strings"
+
+	"github.com/cufee/feedlr-yt/internal/utils"
 )
 
 const youtubeSyncCipherVersion byte = 1
 
+const (
+	youtubeSyncSecretEnv         = "YOUTUBE_SYNC_ENCRYPTION_SECRET"
+	youtubeSyncRetiredSecretsEnv = "YOUTUBE_SYNC_RETIRED_ENCRYPTION_SECRETS"
+)
+
+/*
+youtubeSyncCrypto encrypts tokens with the current secret, retired secrets are kept to decrypt tokens written before a rotation.
+Stored tokens are matched to a key by the enc_secret_hash saved next to them.
+*/
 type youtubeSyncCrypto struct {
 	key        [32]byte
 	secretHash string
+
+	retired map[string][32]byte
 }
 
-func newYouTubeSyncCrypto(secret string) *youtubeSyncCrypto {
-	return &youtubeSyncCrypto{
+func newYouTubeSyncCrypto(secret string, retiredSecrets ...string) *youtubeSyncCrypto {
+	c := &youtubeSyncCrypto{
 		key:        sha256.Sum256([]byte(secret)),
 		secretHash: HashString(secret),
+		retired:    make(map[string][32]byte),
 	}
+	for _, retired := range retiredSecrets {
+		hash := HashString(retired)
+		if hash == c.secretHash {
+			continue
+		}
+		c.retired[hash] = sha256.Sum256([]byte(retired))
+	}
+	return c
+}
+
+/*
+newYouTubeSyncCryptoFromEnv loads the current secret and a comma separated list of retired secrets
+*/
+func newYouTubeSyncCryptoFromEnv() *youtubeSyncCrypto {
+	var retired []string
+	for _, secret := range strings.Split(os.Getenv(youtubeSyncRetiredSecretsEnv), ",") {
+		if secret = strings.TrimSpace(secret); secret != "" {
+			retired = append(retired, secret)
+		}
+	}
+	return newYouTubeSyncCrypto(utils.MustGetEnv(youtubeSyncSecretEnv), retired...)
+}
+
+// RetiredSecretHashes returns the hashes of secrets that still decrypt but are no longer used to encrypt
+func (c *youtubeSyncCrypto) RetiredSecretHashes() []string {
+	hashes := make([]string, 0, len(c.retired))
+	for hash := range c.retired {
+		hashes = append(hashes, hash)
+	}
+	return hashes
+}
+
+// IsRetired reports whether a value encrypted with the secret of the hash should be encrypted again with the current secret
+func (c *youtubeSyncCrypto) IsRetired(secretHash string) bool {
+	_, ok := c.retired[secretHash]
+	return ok
 }
 
 func (c *youtubeSyncCrypto) Encrypt(plaintext []byte, aad string) ([]byte, error) {
@@ -47,7 +99,42 @@ func (c *youtubeSyncCrypto) Encrypt(plaintext []byte, aad string) ([]byte, error
 	return payload, nil
 }
 
+// Decrypt decrypts a payload encrypted with the current secret
 func (c *youtubeSyncCrypto) Decrypt(payload []byte, aad string) ([]byte, error) {
+	return decryptYouTubeSyncPayload(c.key, payload, aad)
+}
+
+/*
+DecryptWithSecret decrypts a payload with the current or a retired secret, picked by the stored secret hash
+*/
+func (c *youtubeSyncCrypto) DecryptWithSecret(secretHash string, payload []byte, aad string) ([]byte, error) {
+	if secretHash == c.secretHash {
+		return c.Decrypt(payload, aad)
+	}
+	key, ok := c.retired[secretHash]
+	if !ok {
+		return nil, fmt.Errorf("encryption secret hash mismatch; reconnect required")
+	}
+	return decryptYouTubeSyncPayload(key, payload, aad)
+}
+
+/*
+Reencrypt decrypts a payload written with a retired secret and encrypts it with the current secret
+*/
+func (c *youtubeSyncCrypto) Reencrypt(secretHash string, payload []byte, aad string) ([]byte, error) {
+	plaintext, err := c.DecryptWithSecret(secretHash, payload, aad)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		for i := range plaintext {
+			plaintext[i] = 0
+		}
+	}()
+	return c.Encrypt(plaintext, aad)
+}
+
+func decryptYouTubeSyncPayload(key [32]byte, payload []byte, aad string) ([]byte, error) {
 	if len(payload) == 0 {
 		return nil, fmt.Errorf("ciphertext payload is empty")
 	}
@@ -55,7 +142,7 @@ func (c *youtubeSyncCrypto) Decrypt(payload []byte, aad string) ([]byte, error)
 		return nil, fmt.Errorf("unsupported ciphertext version")
 	}
 
-	block, err := aes.NewCipher(c.key[:])
+	block, err := aes.NewCipher(key[:])
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,114 @@
+package logic
+
+import (
+	"context"
+	"time"
+
+	"github.com/cufee/feedlr-yt/internal/database"
+	"github.com/cufee/feedlr-yt/internal/database/models"
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog/log"
+)
+
+type ReencryptTokensJob struct {
+	// SecretHash is the hash of the current secret, a new rotation queues a new job
+	SecretHash string `json:"secretHash"`
+}
+
+var JobReencryptTokens = JobType[ReencryptTokensJob]{
+	Kind:     "reencrypt_tokens",
+	Options:  JobOptions{Priority: JobPriorityLow, MaxAttempts: 5, Timeout: 10 * time.Minute},
+	DedupKey: func(p ReencryptTokensJob) string { return p.SecretHash },
+}
+
+type tokenReencryptionDB interface {
+	database.YouTubeSyncClient
+	database.YouTubeTVSyncClient
+}
+
+type TokenReencryptionResult struct {
+	Reencrypted int
+	// Changed tokens were replaced while they were re-encrypted, the new token already uses the current secret
+	Changed int
+	Failed  int
+}
+
+/*
+EnqueueTokenReencryption queues a pass that encrypts all stored tokens with the current secret, it does nothing when no secret is retired
+*/
+func EnqueueTokenReencryption(ctx context.Context, db database.JobsClient, youtubeSync *YouTubeSyncService) error {
+	if youtubeSync == nil || len(youtubeSync.crypto.retired) == 0 {
+		return nil
+	}
+	_, err := JobReencryptTokens.Enqueue(ctx, db, ReencryptTokensJob{SecretHash: youtubeSync.crypto.secretHash})
+	return err
+}
+
+/*
+ReencryptTokens encrypts the OAuth refresh tokens and lounge tokens written with a retired secret again with the current secret.
+Once it finished without failures the retired secret can be removed from the environment.
+*/
+func ReencryptTokens(ctx context.Context, db tokenReencryptionDB, crypto *youtubeSyncCrypto) (TokenReencryptionResult, error) {
+	var result TokenReencryptionResult
+	count := func(replaced bool, err error) {
+		switch {
+		case err != nil:
+			result.Failed++
+		case replaced:
+			result.Reencrypted++
+		default:
+			result.Changed++
+		}
+	}
+
+	for _, hash := range crypto.RetiredSecretHashes() {
+		accounts, err := db.ListYouTubeSyncAccountsBySecretHash(ctx, hash)
+		if err != nil {
+			return result, errors.Wrap(err, "failed to list youtube sync accounts")
+		}
+		for _, account := range accounts {
+			replaced, err := reencryptRefreshToken(ctx, db, crypto, account)
+			if err != nil {
+				log.Warn().Err(err).Str("userID", account.UserID).Msg("failed to re-encrypt refresh token")
+			}
+			count(replaced, err)
+		}
+
+		screens, err := db.ListYouTubeTVSyncAccountsBySecretHash(ctx, hash)
+		if err != nil {
+			return result, errors.Wrap(err, "failed to list tv sync accounts")
+		}
+		for _, account := range screens {
+			replaced, err := reencryptLoungeToken(ctx, db, crypto, account)
+			if err != nil {
+				log.Warn().Err(err).Str("accountID", account.ID).Msg("failed to re-encrypt lounge token")
+			}
+			count(replaced, err)
+		}
+
+		if err := ctx.Err(); err != nil {
+			return result, err
+		}
+	}
+
+	if result.Failed > 0 {
+		return result, errors.Errorf("failed to re-encrypt %d tokens", result.Failed)
+	}
+	return result, nil
+}
+
+func reencryptRefreshToken(ctx context.Context, db database.YouTubeSyncClient, crypto *youtubeSyncCrypto, account *models.YoutubeSyncAccount) (bool, error) {
+	encrypted, err := crypto.Reencrypt(account.EncSecretHash, account.RefreshTokenEnc, account.UserID)
+	if err != nil {
+		return false, errors.Wrap(err, "failed to re-encrypt refresh token")
+	}
+	return db.ReplaceYouTubeSyncRefreshToken(ctx, account.UserID, account.RefreshTokenEnc, encrypted, crypto.secretHash)
+}
+
+func reencryptLoungeToken(ctx context.Context, db database.YouTubeTVSyncClient, crypto *youtubeSyncCrypto, account *database.YouTubeTVSyncAccount) (bool, error) {
+	encrypted, err := crypto.Reencrypt(account.EncSecretHash, account.LoungeTokenEnc, account.UserID)
+	if err != nil {
+		return false, errors.Wrap(err, "failed to re-encrypt lounge token")
+	}
+	return db.ReplaceYouTubeTVSyncLoungeToken(ctx, account.ID, account.LoungeTokenEnc, encrypted, crypto.secretHash)
+}
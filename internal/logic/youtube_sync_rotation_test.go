@@ -0,0 +1,87 @@
+package logic
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/cufee/feedlr-yt/internal/database"
+	"github.com/cufee/feedlr-yt/internal/database/models"
+	"github.com/matryer/is"
+)
+
+type mockReencryptionStore struct {
+	*mockTVSyncStore
+	database.YouTubeSyncClient
+
+	syncAccounts []*models.YoutubeSyncAccount
+}
+
+func (m *mockReencryptionStore) ListYouTubeSyncAccountsBySecretHash(_ context.Context, secretHash string) ([]*models.YoutubeSyncAccount, error) {
+	var out []*models.YoutubeSyncAccount
+	for _, account := range m.syncAccounts {
+		if account.EncSecretHash == secretHash {
+			cp := *account
+			out = append(out, &cp)
+		}
+	}
+	return out, nil
+}
+
+func (m *mockReencryptionStore) ReplaceYouTubeSyncRefreshToken(_ context.Context, userID string, previous, encrypted []byte, secretHash string) (bool, error) {
+	for _, account := range m.syncAccounts {
+		if account.UserID == userID && bytes.Equal(account.RefreshTokenEnc, previous) {
+			account.RefreshTokenEnc = encrypted
+			account.EncSecretHash = secretHash
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func TestReencryptTokens(t *testing.T) {
+	is := is.New(t)
+	ctx := context.Background()
+
+	old := newYouTubeSyncCrypto("old-secret")
+	crypto := newYouTubeSyncCrypto("new-secret", "old-secret")
+
+	refreshToken, err := old.Encrypt([]byte("refresh-token"), "user-1")
+	is.NoErr(err)
+	loungeToken, err := old.Encrypt([]byte("lounge-token"), "user-1")
+	is.NoErr(err)
+	currentToken, err := crypto.Encrypt([]byte("current-token"), "user-2")
+	is.NoErr(err)
+
+	store := &mockReencryptionStore{
+		mockTVSyncStore: &mockTVSyncStore{accounts: []*database.YouTubeTVSyncAccount{
+			{ID: "tv-1", UserID: "user-1", LoungeTokenEnc: loungeToken, EncSecretHash: old.secretHash},
+			{ID: "tv-2", UserID: "user-2", LoungeTokenEnc: currentToken, EncSecretHash: crypto.secretHash},
+		}},
+		syncAccounts: []*models.YoutubeSyncAccount{
+			{UserID: "user-1", RefreshTokenEnc: refreshToken, EncSecretHash: old.secretHash},
+		},
+	}
+
+	result, err := ReencryptTokens(ctx, store, crypto)
+	is.NoErr(err)
+	is.Equal(result.Reencrypted, 2)
+	is.Equal(result.Failed, 0)
+
+	is.Equal(store.syncAccounts[0].EncSecretHash, crypto.secretHash)
+	decrypted, err := crypto.Decrypt(store.syncAccounts[0].RefreshTokenEnc, "user-1")
+	is.NoErr(err)
+	is.Equal(string(decrypted), "refresh-token")
+
+	screen, err := store.GetYouTubeTVSyncAccount(ctx, "tv-1")
+	is.NoErr(err)
+	is.Equal(screen.EncSecretHash, crypto.secretHash)
+	decrypted, err = crypto.Decrypt(screen.LoungeTokenEnc, "user-1")
+	is.NoErr(err)
+	is.Equal(string(decrypted), "lounge-token")
+
+	// A second pass has nothing left to do
+	result, err = ReencryptTokens(ctx, store, crypto)
+	is.NoErr(err)
+	is.Equal(result.Reencrypted, 0)
+}
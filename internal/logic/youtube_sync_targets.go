@@ -0,0 +1,465 @@
+package logic
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/aarondl/null/v8"
+	"github.com/cufee/feedlr-yt/internal/database"
+	"github.com/cufee/feedlr-yt/internal/database/models"
+	"github.com/cufee/feedlr-yt/internal/types"
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog/log"
+)
+
+// Every target lists its playlist on each sync, this keeps the read quota of a single account bounded
+const youtubeSyncMaxTargets = 10
+
+var (
+	ErrYouTubeSyncNotConnected      = errors.New("youtube account is not connected")
+	ErrYouTubeSyncTargetNotFound    = errors.New("sync target not found")
+	ErrYouTubeSyncTargetExists      = errors.New("this source is already synced to a playlist")
+	ErrYouTubeSyncTooManyTargets    = errors.New("too many sync targets")
+	ErrYouTubeSyncSourceUnavailable = errors.New("sync source not found")
+)
+
+/*
+CreateTarget adds a playlist synced from a source, source is a value of YouTubeSyncSourceOptionProps.
+The playlist is created on YouTube by the next sync.
+*/
+func (s *YouTubeSyncService) CreateTarget(ctx context.Context, userID, source, title string, size int, ordering types.YouTubeSyncOrdering) (*models.YoutubeSyncTarget, error) {
+	_, err := s.db.GetYouTubeSyncAccountByUserID(ctx, userID)
+	if err != nil {
+		if database.IsErrNotFound(err) {
+			return nil, ErrYouTubeSyncNotConnected
+		}
+		return nil, err
+	}
+
+	kind, sourceID, ok := parseYouTubeSyncSourceValue(source)
+	if !ok {
+		return nil, ErrYouTubeSyncSourceUnavailable
+	}
+
+	targets, err := s.db.ListYouTubeSyncTargets(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if len(targets) >= youtubeSyncMaxTargets {
+		return nil, ErrYouTubeSyncTooManyTargets
+	}
+	for _, target := range targets {
+		if target.Source == string(kind) && target.SourceID == sourceID {
+			return nil, ErrYouTubeSyncTargetExists
+		}
+	}
+
+	sourceName, err := s.sourceName(ctx, userID, kind, sourceID)
+	if err != nil {
+		return nil, err
+	}
+
+	target := &models.YoutubeSyncTarget{
+		UserID:   userID,
+		Source:   string(kind),
+		SourceID: sourceID,
+		Title:    youtubeSyncTargetTitle(title, kind, sourceName),
+		Size:     int64(clampYouTubeSyncTargetSize(size)),
+		Ordering: string(types.ParseYouTubeSyncOrdering(string(ordering))),
+	}
+	err = s.db.CreateYouTubeSyncTarget(ctx, target)
+	if err != nil {
+		return nil, err
+	}
+	return target, nil
+}
+
+/*
+UpdateTarget changes the source, title, size and ordering of a target, an empty source or title keeps the current one.
+A target that switches sources keeps its playlist on YouTube, the next sync replaces the videos in it.
+*/
+func (s *YouTubeSyncService) UpdateTarget(ctx context.Context, userID, targetID, source, title string, size int, ordering types.YouTubeSyncOrdering) error {
+	target, err := s.userTarget(ctx, userID, targetID)
+	if err != nil {
+		return err
+	}
+
+	options := database.YouTubeSyncTargetOptions{
+		Size:     int64(clampYouTubeSyncTargetSize(size)),
+		Ordering: string(types.ParseYouTubeSyncOrdering(string(ordering))),
+	}
+
+	kind := types.YouTubeSyncSource(target.Source)
+	if source = strings.TrimSpace(source); source != "" && source != youtubeSyncSourceValue(kind, target.SourceID) {
+		newKind, sourceID, ok := parseYouTubeSyncSourceValue(source)
+		if !ok {
+			return ErrYouTubeSyncSourceUnavailable
+		}
+		targets, err := s.db.ListYouTubeSyncTargets(ctx, userID)
+		if err != nil {
+			return err
+		}
+		for _, other := range targets {
+			if other.ID != target.ID && other.Source == string(newKind) && other.SourceID == sourceID {
+				return ErrYouTubeSyncTargetExists
+			}
+		}
+		if _, err := s.sourceName(ctx, userID, newKind, sourceID); err != nil {
+			return err
+		}
+		kind = newKind
+		options.Source = string(newKind)
+		options.SourceID = sourceID
+	}
+
+	title = strings.TrimSpace(title)
+	if title == "" {
+		title = target.Title
+	}
+	options.Title = youtubeSyncTargetTitle(title, kind, "")
+	return s.db.UpdateYouTubeSyncTargetOptions(ctx, target.ID, options)
+}
+
+/*
+DeleteTarget stops syncing a target, the playlist on YouTube is kept
+*/
+func (s *YouTubeSyncService) DeleteTarget(ctx context.Context, userID, targetID string) error {
+	target, err := s.userTarget(ctx, userID, targetID)
+	if err != nil {
+		if errors.Is(err, ErrYouTubeSyncTargetNotFound) {
+			return nil
+		}
+		return err
+	}
+	return s.db.DeleteYouTubeSyncTarget(ctx, target.ID)
+}
+
+func (s *YouTubeSyncService) userTarget(ctx context.Context, userID, targetID string) (*models.YoutubeSyncTarget, error) {
+	target, err := s.db.GetYouTubeSyncTarget(ctx, targetID)
+	if err != nil {
+		if database.IsErrNotFound(err) {
+			return nil, ErrYouTubeSyncTargetNotFound
+		}
+		return nil, err
+	}
+	if target.UserID != userID {
+		return nil, ErrYouTubeSyncTargetNotFound
+	}
+	return target, nil
+}
+
+// ensureDefaultTarget gives a newly connected account the feed playlist, accounts that already have targets keep them
+func (s *YouTubeSyncService) ensureDefaultTarget(ctx context.Context, userID string) error {
+	targets, err := s.db.ListYouTubeSyncTargets(ctx, userID)
+	if err != nil {
+		return err
+	}
+	if len(targets) > 0 {
+		return nil
+	}
+	return s.db.CreateYouTubeSyncTarget(ctx, &models.YoutubeSyncTarget{
+		UserID:   userID,
+		Source:   string(types.YouTubeSyncSourceFeed),
+		Title:    youtubeSyncPlaylistName,
+		Size:     youtubeSyncPlaylistSize,
+		Ordering: string(types.YouTubeSyncOrderingSource),
+	})
+}
+
+// targetsStatus returns the targets of a user and the sources that can still be picked for a new target
+func (s *YouTubeSyncService) targetsStatus(ctx context.Context, userID string) ([]types.YouTubeSyncTargetProps, []types.YouTubeSyncSourceOptionProps, error) {
+	targets, err := s.db.ListYouTubeSyncTargets(ctx, userID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	names := make(map[string]string)
+	var options []types.YouTubeSyncSourceOptionProps
+	options = append(options,
+		types.YouTubeSyncSourceOptionProps{Value: string(types.YouTubeSyncSourceFeed), Label: types.YouTubeSyncSourceLabel(types.YouTubeSyncSourceFeed)},
+		types.YouTubeSyncSourceOptionProps{Value: string(types.YouTubeSyncSourceWatchLater), Label: types.YouTubeSyncSourceLabel(types.YouTubeSyncSourceWatchLater)},
+	)
+
+	playlists, err := s.db.GetUserPlaylists(ctx, userID)
+	if err != nil && !database.IsErrNotFound(err) {
+		return nil, nil, err
+	}
+	for _, playlist := range playlists {
+		if playlist.Slug == WatchLaterSlug {
+			continue
+		}
+		group := "Playlists"
+		if playlist.Rules.Valid {
+			group = "Smart Playlists"
+		}
+		value := youtubeSyncSourceValue(types.YouTubeSyncSourcePlaylist, playlist.ID)
+		names[value] = playlist.Name
+		options = append(options, types.YouTubeSyncSourceOptionProps{Value: value, Label: playlist.Name, Group: group})
+	}
+
+	channels, err := GetUserSubscribedChannels(ctx, s.db, userID)
+	if err != nil {
+		return nil, nil, err
+	}
+	for _, channel := range channels {
+		value := youtubeSyncSourceValue(types.YouTubeSyncSourceChannel, channel.ID)
+		names[value] = channel.Title
+		options = append(options, types.YouTubeSyncSourceOptionProps{Value: value, Label: channel.Title, Group: "Channels"})
+	}
+
+	// Targets are listed in the order they were added, the database returns them by sync time
+	ordered := slices.Clone(targets)
+	slices.SortStableFunc(ordered, func(a, b *models.YoutubeSyncTarget) int {
+		return a.CreatedAt.Compare(b.CreatedAt)
+	})
+
+	taken := make(map[string]bool, len(targets))
+	props := make([]types.YouTubeSyncTargetProps, 0, len(targets))
+	for _, target := range ordered {
+		value := youtubeSyncSourceValue(types.YouTubeSyncSource(target.Source), target.SourceID)
+		taken[value] = true
+
+		p := types.YouTubeSyncTargetProps{
+			ID:         target.ID,
+			Source:     types.YouTubeSyncSource(target.Source),
+			SourceID:   target.SourceID,
+			SourceName: names[value],
+			Title:      target.Title,
+			PlaylistID: target.PlaylistID.String,
+			Size:       int(target.Size),
+			Ordering:   types.ParseYouTubeSyncOrdering(target.Ordering),
+			LastError:  target.LastError,
+		}
+		if target.LastSyncedAt.Valid {
+			p.LastSyncedAt = target.LastSyncedAt.Time
+		}
+		props = append(props, p)
+	}
+	options = slices.DeleteFunc(options, func(option types.YouTubeSyncSourceOptionProps) bool {
+		return taken[option.Value]
+	})
+	if len(targets) >= youtubeSyncMaxTargets {
+		options = nil
+	}
+	return props, options, nil
+}
+
+// sourceName checks that the source belongs to the user and returns the name of a playlist or channel
+func (s *YouTubeSyncService) sourceName(ctx context.Context, userID string, source types.YouTubeSyncSource, sourceID string) (string, error) {
+	switch source {
+	case types.YouTubeSyncSourcePlaylist:
+		playlist, err := s.db.GetPlaylistByID(ctx, sourceID)
+		if err != nil {
+			if database.IsErrNotFound(err) {
+				return "", ErrYouTubeSyncSourceUnavailable
+			}
+			return "", err
+		}
+		if playlist.UserID != userID || playlist.Slug == WatchLaterSlug {
+			return "", ErrYouTubeSyncSourceUnavailable
+		}
+		return playlist.Name, nil
+
+	case types.YouTubeSyncSourceChannel:
+		subscription, err := s.db.FindSubscription(ctx, userID, sourceID, database.Subscription{}.WithChannel())
+		if err != nil {
+			if database.IsErrNotFound(err) {
+				return "", ErrYouTubeSyncSourceUnavailable
+			}
+			return "", err
+		}
+		if subscription.R != nil && subscription.R.Channel != nil {
+			return subscription.R.Channel.Title, nil
+		}
+		return "", nil
+
+	default:
+		return "", nil
+	}
+}
+
+/*
+sourceVideos returns the videos of a target source in the order Feedlr shows them, hidden videos are left out.
+Feed and playlist sources list unwatched videos before watched ones.
+*/
+func (s *YouTubeSyncService) sourceVideos(ctx context.Context, target *models.YoutubeSyncTarget) ([]types.VideoProps, error) {
+	switch types.YouTubeSyncSource(target.Source) {
+	case types.YouTubeSyncSourceFeed:
+		props, err := GetUserVideosProps(ctx, s.db, target.UserID)
+		if err != nil {
+			return nil, err
+		}
+		return append(slices.Clone(props.New), props.Watched...), nil
+
+	case types.YouTubeSyncSourceWatchLater:
+		playlist, err := s.db.GetPlaylistBySlug(ctx, target.UserID, WatchLaterSlug)
+		if err != nil {
+			if database.IsErrNotFound(err) {
+				return nil, nil
+			}
+			return nil, errors.Wrap(err, "failed to get watch later playlist")
+		}
+		props, err := GetPlaylistPageProps(ctx, s.db, target.UserID, playlist.ID)
+		if err != nil {
+			return nil, err
+		}
+		return append(slices.Clone(props.New), props.Watched...), nil
+
+	case types.YouTubeSyncSourcePlaylist:
+		if _, err := s.sourceName(ctx, target.UserID, types.YouTubeSyncSourcePlaylist, target.SourceID); err != nil {
+			return nil, errors.Wrap(err, "playlist was deleted")
+		}
+		props, err := GetPlaylistPageProps(ctx, s.db, target.UserID, target.SourceID)
+		if err != nil {
+			return nil, err
+		}
+		return append(slices.Clone(props.New), props.Watched...), nil
+
+	case types.YouTubeSyncSourceChannel:
+		subscription, err := s.db.FindSubscription(ctx, target.UserID, target.SourceID)
+		if err != nil {
+			if database.IsErrNotFound(err) {
+				return nil, errors.New("not subscribed to this channel anymore")
+			}
+			return nil, err
+		}
+
+		filter := types.VideoFilter(subscription.VideoFilter)
+		if filter == "" {
+			filter = types.VideoFilterAll
+		}
+		videos, err := GetChannelVideosFiltered(ctx, s.db, youtubeSyncMaxTargetSize, filter, target.SourceID)
+		if err != nil {
+			return nil, err
+		}
+
+		videoIDs := make([]string, 0, len(videos))
+		for _, video := range videos {
+			videoIDs = append(videoIDs, video.ID)
+		}
+		views, err := GetUserViews(ctx, s.db, target.UserID, videoIDs...)
+		if err != nil && !database.IsErrNotFound(err) {
+			return nil, err
+		}
+		videos = slices.DeleteFunc(videos, func(video types.VideoProps) bool {
+			view, ok := views[video.ID]
+			return ok && view.Hidden.Bool
+		})
+
+		applyUserVideoTitles(ctx, s.db, target.UserID, videos)
+		return videos, nil
+
+	default:
+		return nil, errors.Errorf("unknown sync source %q", target.Source)
+	}
+}
+
+/*
+selectYouTubeSyncVideos picks the first size videos of a source and puts them in the target ordering, the ordering
+never changes which videos are picked. Titles are only returned when the user replaces original titles.
+*/
+func selectYouTubeSyncVideos(videos []types.VideoProps, size int, ordering types.YouTubeSyncOrdering, withTitles bool) ([]string, []string, null.Time) {
+	size = clampYouTubeSyncTargetSize(size)
+
+	seen := make(map[string]bool)
+	var selected []types.VideoProps
+	for _, video := range videos {
+		if video.ID == "" || seen[video.ID] {
+			continue
+		}
+		seen[video.ID] = true
+		selected = append(selected, video)
+		if len(selected) >= size {
+			break
+		}
+	}
+
+	switch ordering {
+	case types.YouTubeSyncOrderingNewest:
+		slices.SortStableFunc(selected, func(a, b types.VideoProps) int { return b.PublishedAt.Compare(a.PublishedAt) })
+	case types.YouTubeSyncOrderingOldest:
+		slices.SortStableFunc(selected, func(a, b types.VideoProps) int { return a.PublishedAt.Compare(b.PublishedAt) })
+	}
+
+	var desired []string
+	var titles []string
+	var latest null.Time
+	for _, video := range selected {
+		desired = append(desired, video.ID)
+		if withTitles {
+			titles = append(titles, video.Title)
+		}
+		if !video.PublishedAt.IsZero() && (!latest.Valid || video.PublishedAt.After(latest.Time)) {
+			latest = null.TimeFrom(video.PublishedAt.UTC())
+		}
+	}
+	return desired, titles, latest
+}
+
+func (s *YouTubeSyncService) storeTargetRunResult(ctx context.Context, target *models.YoutubeSyncTarget, attemptedAt time.Time, syncErr error) {
+	result := database.YouTubeSyncTargetRunResult{
+		LastSyncedAt:      target.LastSyncedAt,
+		LastSyncAttemptAt: attemptedAt,
+	}
+	if syncErr != nil {
+		result.LastError = truncateYouTubeSyncError(syncErr.Error())
+	} else {
+		result.LastSyncedAt = null.TimeFrom(time.Now().UTC())
+	}
+
+	err := s.db.UpdateYouTubeSyncTargetRunResult(ctx, target.ID, result)
+	if err != nil && !database.IsErrNotFound(err) {
+		log.Warn().Err(err).Str("userID", target.UserID).Str("targetID", target.ID).Msg("failed to persist youtube sync target run result")
+	}
+}
+
+func clampYouTubeSyncTargetSize(size int) int {
+	if size <= 0 {
+		return youtubeSyncPlaylistSize
+	}
+	return min(size, youtubeSyncMaxTargetSize)
+}
+
+// youtubeSyncTargetTitle trims a title to the YouTube limit, an empty title is named after the source
+func youtubeSyncTargetTitle(title string, source types.YouTubeSyncSource, sourceName string) string {
+	title = strings.TrimSpace(youtubeSyncDescriptionReplacer.Replace(title))
+	if title == "" {
+		switch {
+		case source == types.YouTubeSyncSourceFeed:
+			title = youtubeSyncPlaylistName
+		case sourceName != "":
+			title = fmt.Sprintf("Feedlr: %s", sourceName)
+		default:
+			title = fmt.Sprintf("Feedlr: %s", types.YouTubeSyncSourceLabel(source))
+		}
+	}
+	if runes := []rune(title); len(runes) > youtubeSyncTargetTitleMaxRunes {
+		title = string(runes[:youtubeSyncTargetTitleMaxRunes])
+	}
+	return title
+}
+
+func youtubeSyncSourceValue(source types.YouTubeSyncSource, sourceID string) string {
+	if sourceID == "" {
+		return string(source)
+	}
+	return string(source) + ":" + sourceID
+}
+
+// parseYouTubeSyncSourceValue reads a value of youtubeSyncSourceValue, playlists and channels need an id
+func parseYouTubeSyncSourceValue(value string) (types.YouTubeSyncSource, string, bool) {
+	kind, sourceID, _ := strings.Cut(strings.TrimSpace(value), ":")
+	source, ok := types.ParseYouTubeSyncSource(kind)
+	if !ok {
+		return "", "", false
+	}
+	switch source {
+	case types.YouTubeSyncSourcePlaylist, types.YouTubeSyncSourceChannel:
+		return source, sourceID, sourceID != ""
+	default:
+		return source, "", sourceID == ""
+	}
+}
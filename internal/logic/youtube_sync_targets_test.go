@@ -0,0 +1,71 @@
+package logic
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/cufee/feedlr-yt/internal/types"
+	"github.com/matryer/is"
+)
+
+func TestSelectYouTubeSyncVideos(t *testing.T) {
+	is := is.New(t)
+
+	day := time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC)
+	var videos []types.VideoProps
+	for i, id := range []string{"b", "a", "w", "a", "o"} {
+		video := types.VideoProps{PublishedAt: day.Add([]time.Duration{2, 3, 1, 3, 0}[i] * time.Hour)}
+		video.ID = id
+		video.Title = []string{"new b", "new a", "watched", "new a again", "old"}[i]
+		videos = append(videos, video)
+	}
+
+	desired, titles, latest := selectYouTubeSyncVideos(videos, 3, types.YouTubeSyncOrderingSource, false)
+	is.Equal(desired, []string{"b", "a", "w"}) // duplicates are skipped
+	is.Equal(len(titles), 0)
+	is.Equal(latest.Time, day.Add(3*time.Hour))
+
+	// The ordering only sorts the videos picked from the source
+	desired, titles, _ = selectYouTubeSyncVideos(videos, 3, types.YouTubeSyncOrderingNewest, true)
+	is.Equal(desired, []string{"a", "b", "w"})
+	is.Equal(titles, []string{"new a", "new b", "watched"})
+
+	desired, _, _ = selectYouTubeSyncVideos(videos, 3, types.YouTubeSyncOrderingOldest, false)
+	is.Equal(desired, []string{"w", "b", "a"})
+
+	// Sizes out of range fall back to the default or the maximum
+	desired, _, _ = selectYouTubeSyncVideos(videos, 0, types.YouTubeSyncOrderingSource, false)
+	is.Equal(len(desired), 4)
+	is.Equal(clampYouTubeSyncTargetSize(500), youtubeSyncMaxTargetSize)
+}
+
+func TestParseYouTubeSyncSourceValue(t *testing.T) {
+	is := is.New(t)
+
+	source, id, ok := parseYouTubeSyncSourceValue("feed")
+	is.True(ok)
+	is.Equal(source, types.YouTubeSyncSourceFeed)
+	is.Equal(id, "")
+
+	source, id, ok = parseYouTubeSyncSourceValue(youtubeSyncSourceValue(types.YouTubeSyncSourceChannel, "UC123"))
+	is.True(ok)
+	is.Equal(source, types.YouTubeSyncSourceChannel)
+	is.Equal(id, "UC123")
+
+	_, _, ok = parseYouTubeSyncSourceValue("playlist")
+	is.True(!ok) // playlists need an id
+	_, _, ok = parseYouTubeSyncSourceValue("watch_later:abc")
+	is.True(!ok)
+	_, _, ok = parseYouTubeSyncSourceValue("history")
+	is.True(!ok)
+}
+
+func TestYouTubeSyncTargetTitle(t *testing.T) {
+	is := is.New(t)
+
+	is.Equal(youtubeSyncTargetTitle("", types.YouTubeSyncSourceFeed, ""), youtubeSyncPlaylistName)
+	is.Equal(youtubeSyncTargetTitle(" ", types.YouTubeSyncSourceChannel, "Some Channel"), "Feedlr: Some Channel")
+	is.Equal(youtubeSyncTargetTitle("My <list>", types.YouTubeSyncSourceWatchLater, ""), "My list")
+	is.Equal(len([]rune(youtubeSyncTargetTitle(strings.Repeat("é", 200), types.YouTubeSyncSourceFeed, ""))), youtubeSyncTargetTitleMaxRunes)
+}
@@ -3,7 +3,9 @@ package logic
 import (
 	"context"
 	stdErrors "errors"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/matryer/is"
 	"golang.org/x/oauth2"
@@ -25,6 +27,32 @@ func TestYouTubeSyncCryptoRoundTrip(t *testing.T) {
 	is.Equal(string(decrypted), string(plaintext))
 }
 
+func TestYouTubeSyncCryptoRetiredSecret(t *testing.T) {
+	is := is.New(t)
+
+	old := newYouTubeSyncCrypto("old-secret")
+	encrypted, err := old.Encrypt([]byte("refresh-token"), "user-1")
+	is.NoErr(err)
+
+	rotated := newYouTubeSyncCrypto("new-secret", "old-secret")
+	is.True(rotated.IsRetired(old.secretHash))
+	is.True(!rotated.IsRetired(rotated.secretHash))
+
+	decrypted, err := rotated.DecryptWithSecret(old.secretHash, encrypted, "user-1")
+	is.NoErr(err)
+	is.Equal(string(decrypted), "refresh-token")
+
+	reencrypted, err := rotated.Reencrypt(old.secretHash, encrypted, "user-1")
+	is.NoErr(err)
+	decrypted, err = rotated.Decrypt(reencrypted, "user-1")
+	is.NoErr(err)
+	is.Equal(string(decrypted), "refresh-token")
+
+	// Without the retired secret the token can not be read
+	_, err = newYouTubeSyncCrypto("new-secret").DecryptWithSecret(old.secretHash, encrypted, "user-1")
+	is.True(err != nil)
+}
+
 func TestBuildPlaylistSyncPlanSplitBudget(t *testing.T) {
 	is := is.New(t)
 
@@ -179,3 +207,41 @@ func TestPersistingRefreshTokenSourceReturnsPersistError(t *testing.T) {
 	_, err := src.Token()
 	is.True(stdErrors.Is(err, persistErr))
 }
+
+func TestBuildYouTubeSyncDescription(t *testing.T) {
+	is := is.New(t)
+
+	is.Equal(buildYouTubeSyncDescription(nil), youtubeSyncPlaylistDescription)
+
+	description := buildYouTubeSyncDescription([]string{"First <video>", "Second\nvideo "})
+	is.Equal(description, youtubeSyncPlaylistDescription+"\n\n1. First video\n2. Second video")
+
+	var titles []string
+	for range youtubeSyncPlaylistSize {
+		titles = append(titles, strings.Repeat("a", 200))
+	}
+	description = buildYouTubeSyncDescription(titles)
+	is.True(len(description) <= youtubeSyncDescriptionMaxBytes)
+	is.True(strings.HasSuffix(description, strings.Repeat("a", 200))) // lines are never cut in half
+}
+
+func TestYouTubeSyncStopWaitsForRunningSyncs(t *testing.T) {
+	is := is.New(t)
+
+	service := &YouTubeSyncService{}
+	is.True(service.beginRun())
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	is.True(stdErrors.Is(service.Stop(ctx), context.DeadlineExceeded))
+
+	// No new syncs start once stopping
+	is.True(!service.beginRun())
+	is.True(stdErrors.Is(service.RunSyncForUser(context.Background(), "user-1"), ErrYouTubeSyncStopped))
+
+	go func() {
+		time.Sleep(10 * time.Millisecond)
+		service.runs.Done()
+	}()
+	is.NoErr(service.Stop(context.Background()))
+}
@@ -0,0 +1,195 @@
+package logic
+
+import (
+	"context"
+	"slices"
+	"time"
+
+	"github.com/cufee/feedlr-yt/internal/api/youtube/lounge"
+	"github.com/cufee/feedlr-yt/internal/database"
+	"github.com/cufee/feedlr-yt/internal/metrics"
+	"github.com/cufee/feedlr-yt/internal/types"
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	// Autoplay stops once this many videos were started in a row without anyone touching the TV
+	tvAutoplayMaxChain = 10
+	// Keeps a video that ends right away, like an unavailable one, from skipping through the whole queue
+	tvAutoplayCooldown = 30 * time.Second
+	// Ended events further from the end of the video than this are treated as the user stopping playback
+	tvAutoplayEndWindowSec = 30
+)
+
+type tvAutoplayQueueDB interface {
+	database.PlaylistsClient
+	database.SmartPlaylistsClient
+	database.ViewsClient
+}
+
+/*
+SetTVAutoplay updates what plays on the user's TVs once a video ends, the playlist is only kept for the playlist mode
+*/
+func SetTVAutoplay(ctx context.Context, db interface {
+	database.SettingsClient
+	database.PlaylistsClient
+}, userID string, mode types.TVAutoplayMode, playlistID string) (types.SettingsPageProps, error) {
+	if !slices.Contains(types.TVAutoplayModes, mode) {
+		return types.SettingsPageProps{}, errors.New("invalid autoplay mode")
+	}
+	if mode != types.TVAutoplayPlaylist {
+		playlistID = ""
+	}
+	if mode == types.TVAutoplayPlaylist {
+		if playlistID == "" {
+			return types.SettingsPageProps{}, errors.New("playlist is required")
+		}
+		playlist, err := db.GetPlaylistByID(ctx, playlistID)
+		if err != nil && !database.IsErrNotFound(err) {
+			return types.SettingsPageProps{}, errors.Wrap(err, "failed to get playlist")
+		}
+		if playlist == nil || playlist.UserID != userID {
+			return types.SettingsPageProps{}, errors.New("playlist not found")
+		}
+	}
+
+	settings, err := GetUserSettings(ctx, db, userID)
+	if err != nil {
+		return types.SettingsPageProps{}, err
+	}
+
+	settings.TVAutoplay = types.TVAutoplaySettingsProps{Mode: mode, PlaylistID: playlistID}
+	return settings, UpdateUserSettings(ctx, db, userID, settings)
+}
+
+// tvAutoplayPlaylists lists playlists that can be picked as an autoplay source, Watch Later has its own mode
+func (s *YouTubeTVSyncService) tvAutoplayPlaylists(ctx context.Context, userID string) []types.TVAutoplayPlaylistProps {
+	db, ok := s.db.(database.PlaylistsClient)
+	if !ok {
+		return nil
+	}
+	playlists, err := db.GetUserPlaylists(ctx, userID)
+	if err != nil {
+		log.Debug().Err(err).Str("userID", userID).Msg("failed to list playlists for tv autoplay")
+		return nil
+	}
+
+	var options []types.TVAutoplayPlaylistProps
+	for _, playlist := range playlists {
+		if playlist.Slug == WatchLaterSlug {
+			continue
+		}
+		options = append(options, types.TVAutoplayPlaylistProps{ID: playlist.ID, Name: playlist.Name})
+	}
+	return options
+}
+
+/*
+playbackEndedNaturally reports whether an ended event comes from a video that played to the end.
+Ended events for videos the worker never saw playing, like the first event after a reconnect, are ignored.
+*/
+func playbackEndedNaturally(previousState string, playback lounge.PlaybackEvent) bool {
+	switch previousState {
+	case "1", "2", "3":
+	default:
+		return false
+	}
+	if playback.HasCurrentTime && playback.HasDuration && playback.Duration > 0 {
+		return playback.CurrentTime >= playback.Duration-tvAutoplayEndWindowSec
+	}
+	return true
+}
+
+/*
+handlePlaybackEnded starts the next video picked by Feedlr autoplay, or stops playback so YouTube does not start a recommendation.
+It does nothing while the TV still has videos queued from Feedlr, so a queue sent from the app or the TV is never replaced.
+*/
+func (s *YouTubeTVSyncService) handlePlaybackEnded(ctx context.Context, account *database.YouTubeTVSyncAccount, session *lounge.Session, runtime *tvSyncRuntime, videoID string, now time.Time) {
+	settings, err := GetUserSettings(ctx, s.db, account.UserID)
+	if err != nil {
+		log.Debug().Err(err).Str("userID", account.UserID).Msg("failed to load settings for tv autoplay")
+		return
+	}
+	autoplay := settings.TVAutoplay
+	autoplay.Mode = types.ParseTVAutoplayMode(string(autoplay.Mode))
+	if autoplay.Mode == types.TVAutoplayOff {
+		return
+	}
+
+	ok, exhausted := runtime.beginAutoplay(now)
+	if !ok {
+		log.Debug().Str("userID", account.UserID).Str("videoID", videoID).Msg("skipped tv autoplay")
+		return
+	}
+
+	var next string
+	if autoplay.Mode != types.TVAutoplayStop && !exhausted {
+		next, err = s.nextAutoplayVideo(ctx, account.UserID, autoplay, videoID)
+		if err != nil {
+			log.Warn().Err(err).Str("userID", account.UserID).Str("mode", string(autoplay.Mode)).Msg("failed to pick the next tv autoplay video")
+		}
+	}
+
+	if next == "" {
+		err := s.lounge.StopVideo(ctx, session)
+		metrics.ObserveTVSyncEvent("autoplay_stop", err)
+		if err != nil {
+			log.Debug().Err(err).Str("userID", account.UserID).Msg("failed to stop tv playback after a video ended")
+			return
+		}
+		s.journal(ctx, account, database.YouTubeTVSyncEventAutoplay, videoID, "Stopped playback")
+		return
+	}
+
+	startAt := s.remoteResumePosition(ctx, account.UserID, next)
+	err = s.lounge.SetPlaylist(ctx, session, []string{next}, 0, float64(startAt))
+	metrics.ObserveTVSyncEvent("autoplay_next", err)
+	if err != nil {
+		log.Debug().Err(err).Str("userID", account.UserID).Str("videoID", next).Msg("failed to start the next tv autoplay video")
+		return
+	}
+	runtime.markAutoplayStarted(next)
+	s.journal(ctx, account, database.YouTubeTVSyncEventAutoplay, next, "Started after "+videoID)
+	log.Debug().Str("userID", account.UserID).Str("endedVideoID", videoID).Str("videoID", next).Str("mode", string(autoplay.Mode)).Msg("tv autoplay started the next video")
+}
+
+func (s *YouTubeTVSyncService) nextAutoplayVideo(ctx context.Context, userID string, autoplay types.TVAutoplaySettingsProps, endedVideoID string) (string, error) {
+	var queue []string
+	var err error
+	switch autoplay.Mode {
+	case types.TVAutoplayFeed:
+		db, ok := s.db.(database.Client)
+		if !ok {
+			return "", nil
+		}
+		queue, err = GetFeedTVQueue(ctx, db, userID)
+	case types.TVAutoplayWatchLater:
+		db, ok := s.db.(tvAutoplayQueueDB)
+		if !ok {
+			return "", nil
+		}
+		queue, err = GetWatchLaterTVQueue(ctx, db, userID)
+	case types.TVAutoplayPlaylist:
+		db, ok := s.db.(tvAutoplayQueueDB)
+		if !ok || autoplay.PlaylistID == "" {
+			return "", nil
+		}
+		queue, err = GetPlaylistTVQueue(ctx, db, userID, autoplay.PlaylistID)
+	}
+	if err != nil {
+		return "", err
+	}
+	return nextInTVQueue(queue, endedVideoID), nil
+}
+
+// nextInTVQueue returns the video after the one that ended, wrapping around to earlier videos that are still unwatched
+func nextInTVQueue(queue []string, endedVideoID string) string {
+	if i := slices.Index(queue, endedVideoID); i >= 0 {
+		queue = append(slices.Clone(queue[i+1:]), queue[:i]...)
+	}
+	if len(queue) == 0 {
+		return ""
+	}
+	return queue[0]
+}
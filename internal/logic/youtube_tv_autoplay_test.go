@@ -0,0 +1,178 @@
+package logic
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/cufee/feedlr-yt/internal/api/youtube/lounge"
+	"github.com/cufee/feedlr-yt/internal/database"
+	"github.com/cufee/feedlr-yt/internal/database/models"
+	"github.com/cufee/feedlr-yt/internal/types"
+)
+
+type mockTVAutoplayStore struct {
+	*mockTVSyncStore
+	database.PlaylistsClient
+	database.SmartPlaylistsClient
+
+	videoIDs []string
+}
+
+func (m *mockTVAutoplayStore) GetPlaylistBySlug(_ context.Context, userID, slug string) (*models.Playlist, error) {
+	return &models.Playlist{ID: "playlist-1", UserID: userID, Slug: slug}, nil
+}
+
+func (m *mockTVAutoplayStore) GetPlaylistItems(_ context.Context, playlistID string, _ ...database.PlaylistItemQuery) ([]*models.PlaylistItem, error) {
+	var items []*models.PlaylistItem
+	for i, videoID := range m.videoIDs {
+		item := &models.PlaylistItem{PlaylistID: playlistID, VideoID: videoID, Position: int64(i)}
+		item.R = item.R.NewStruct()
+		item.R.Video = &models.Video{ID: videoID, Duration: 120}
+		items = append(items, item)
+	}
+	return items, nil
+}
+
+func TestNextInTVQueue(t *testing.T) {
+	cases := []struct {
+		queue    []string
+		ended    string
+		expected string
+	}{
+		{queue: nil, ended: "video-a", expected: ""},
+		{queue: []string{"video-a"}, ended: "video-a", expected: ""},
+		{queue: []string{"video-a", "video-b", "video-c"}, ended: "video-b", expected: "video-c"},
+		{queue: []string{"video-a", "video-b", "video-c"}, ended: "video-c", expected: "video-a"},
+		{queue: []string{"video-a", "video-b"}, ended: "video-x", expected: "video-a"},
+	}
+	for _, c := range cases {
+		if got := nextInTVQueue(c.queue, c.ended); got != c.expected {
+			t.Fatalf("nextInTVQueue(%v, %s) = %q, expected %q", c.queue, c.ended, got, c.expected)
+		}
+	}
+}
+
+func TestPlaybackEndedNaturally(t *testing.T) {
+	ended := lounge.PlaybackEvent{State: "0", CurrentTime: 118, Duration: 120, HasCurrentTime: true, HasDuration: true}
+	if !playbackEndedNaturally("1", ended) {
+		t.Fatal("expected a video that played to the end to count as ended")
+	}
+	if playbackEndedNaturally("", ended) {
+		t.Fatal("expected an ended event without observed playback to be ignored")
+	}
+	if playbackEndedNaturally("0", ended) {
+		t.Fatal("expected repeated ended events to be ignored")
+	}
+	stopped := lounge.PlaybackEvent{State: "0", CurrentTime: 40, Duration: 120, HasCurrentTime: true, HasDuration: true}
+	if playbackEndedNaturally("1", stopped) {
+		t.Fatal("expected playback stopped far from the end to be ignored")
+	}
+}
+
+func TestTVAutoplay_ProcessEvent(t *testing.T) {
+	var mu sync.Mutex
+	var commands []string
+	var videoIDs []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/lounge/bc/bind" || r.Method != http.MethodPost {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		_ = r.ParseForm()
+		mu.Lock()
+		commands = append(commands, r.FormValue("req0__sc"))
+		videoIDs = append(videoIDs, r.FormValue("req0_videoId"))
+		mu.Unlock()
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	store := &mockTVAutoplayStore{
+		mockTVSyncStore: &mockTVSyncStore{},
+		videoIDs:        []string{"video-a", "video-b", "video-c"},
+	}
+	service := &YouTubeTVSyncService{
+		db:      store,
+		lounge:  lounge.NewClientWithBaseURL(server.Client(), server.URL+"/api/lounge"),
+		metrics: &tvSyncMetrics{},
+		workers: map[string]*tvSyncWorker{},
+	}
+	ctx := context.Background()
+	account := &database.YouTubeTVSyncAccount{ID: "account-1", UserID: "user-1"}
+	session := &lounge.Session{SID: "sid-1", GSessionID: "gs-1"}
+	runtime := newTVSyncRuntime(false, nil)
+
+	play := func(videoID string, state string, currentTime float64) {
+		t.Helper()
+		event := lounge.Event{Type: "onStateChange", Args: []any{map[string]any{
+			"videoId":     videoID,
+			"state":       state,
+			"currentTime": currentTime,
+			"duration":    120.0,
+		}}}
+		if err := service.processEvent(ctx, account, session, runtime, event); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+	resetCooldown := func() {
+		runtime.mu.Lock()
+		runtime.autoplayLastAt = time.Time{}
+		runtime.mu.Unlock()
+	}
+
+	// Autoplay is off by default, YouTube stays in charge
+	play("video-a", "1", 100)
+	play("video-a", "0", 120)
+
+	if _, err := SetTVAutoplay(ctx, store, "user-1", types.TVAutoplayWatchLater, ""); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	play("video-a", "1", 100)
+	play("video-a", "0", 120)
+	play("video-a", "0", 120)
+
+	// A video queued from Feedlr plays before anything autoplay would pick
+	play("video-b", "1", 100)
+	runtime.appendRemoteQueue("video-c")
+	resetCooldown()
+	play("video-b", "0", 120)
+
+	// Stopping far from the end is the user navigating, not the video ending
+	play("video-c", "1", 10)
+	resetCooldown()
+	play("video-c", "0", 40)
+
+	if _, err := SetTVAutoplay(ctx, store, "user-1", types.TVAutoplayStop, ""); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	play("video-a", "1", 110)
+	resetCooldown()
+	play("video-a", "0", 120)
+
+	// Long autoplay chains stop even when there is more to play
+	if _, err := SetTVAutoplay(ctx, store, "user-1", types.TVAutoplayWatchLater, ""); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	runtime.markAutoplayStarted("video-b")
+	runtime.mu.Lock()
+	runtime.autoplayChain = tvAutoplayMaxChain
+	runtime.mu.Unlock()
+	play("video-b", "1", 110)
+	resetCooldown()
+	play("video-b", "0", 120)
+
+	mu.Lock()
+	defer mu.Unlock()
+	expected := []string{"setPlaylist", "stopVideo", "stopVideo"}
+	if strings.Join(commands, ",") != strings.Join(expected, ",") {
+		t.Fatalf("expected commands %v, got %v", expected, commands)
+	}
+	if videoIDs[0] != "video-b" {
+		t.Fatalf("expected autoplay to start the next Watch Later video, got %q", videoIDs[0])
+	}
+}
@@ -0,0 +1,111 @@
+package logic
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/cufee/feedlr-yt/internal/database"
+	"github.com/cufee/feedlr-yt/internal/types"
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	tvSyncJournalRetention    = 7 * 24 * time.Hour
+	tvSyncJournalMaxPerScreen = 500
+	tvSyncJournalScreenLimit  = 100
+	tvSyncJournalAdminLimit   = 200
+	// Progress is saved every few seconds while a video plays, the journal keeps one entry per video and interval
+	tvSyncJournalProgressInterval = time.Minute
+)
+
+/*
+journal records a diagnostics entry for a screen, failures are logged and never interrupt the sync
+*/
+func (s *YouTubeTVSyncService) journal(ctx context.Context, account *database.YouTubeTVSyncAccount, kind, videoID, message string) {
+	journal, ok := s.db.(database.YouTubeTVSyncEventsClient)
+	if !ok || account == nil {
+		return
+	}
+	err := journal.CreateYouTubeTVSyncEvent(ctx, &database.YouTubeTVSyncEvent{
+		AccountID: account.ID,
+		UserID:    account.UserID,
+		Kind:      kind,
+		VideoID:   videoID,
+		Message:   message,
+	})
+	if err != nil {
+		log.Debug().Err(err).Str("userID", account.UserID).Str("accountID", account.ID).Str("kind", kind).Msg("failed to write tv sync journal entry")
+	}
+}
+
+// formatTVSyncSeconds formats a playback position as m:ss or h:mm:ss
+func formatTVSyncSeconds(seconds float64) string {
+	total := int(math.Max(0, math.Floor(seconds)))
+	if total >= 3600 {
+		return fmt.Sprintf("%d:%02d:%02d", total/3600, total/60%60, total%60)
+	}
+	return fmt.Sprintf("%d:%02d", total/60, total%60)
+}
+
+func tvSyncEventProps(event *database.YouTubeTVSyncEvent) types.TVSyncEventProps {
+	return types.TVSyncEventProps{
+		CreatedAt:  event.CreatedAt,
+		AccountID:  event.AccountID,
+		UserID:     event.UserID,
+		ScreenName: event.ScreenName,
+		Kind:       event.Kind,
+		VideoID:    event.VideoID,
+		Message:    event.Message,
+	}
+}
+
+/*
+Journal returns the latest diagnostics entries of a screen owned by the user, newest first
+*/
+func (s *YouTubeTVSyncService) Journal(ctx context.Context, userID, accountID string) ([]types.TVSyncEventProps, error) {
+	journal, ok := s.db.(database.YouTubeTVSyncEventsClient)
+	if !ok {
+		return nil, nil
+	}
+	account, err := s.userAccount(ctx, userID, accountID)
+	if err != nil {
+		return nil, err
+	}
+
+	events, err := journal.ListYouTubeTVSyncEvents(ctx, account.ID, tvSyncJournalScreenLimit)
+	if err != nil && !database.IsErrNotFound(err) {
+		return nil, errors.Wrap(err, "failed to list tv sync events")
+	}
+
+	props := make([]types.TVSyncEventProps, 0, len(events))
+	for _, event := range events {
+		props = append(props, tvSyncEventProps(event))
+	}
+	return props, nil
+}
+
+/*
+GetRecentTVSyncEvents returns the latest diagnostics entries across all screens, used by the admin panel
+*/
+func GetRecentTVSyncEvents(ctx context.Context, db database.YouTubeTVSyncEventsClient) ([]types.TVSyncEventProps, error) {
+	events, err := db.ListRecentYouTubeTVSyncEvents(ctx, tvSyncJournalAdminLimit)
+	if err != nil && !database.IsErrNotFound(err) {
+		return nil, errors.Wrap(err, "failed to list tv sync events")
+	}
+
+	props := make([]types.TVSyncEventProps, 0, len(events))
+	for _, event := range events {
+		props = append(props, tvSyncEventProps(event))
+	}
+	return props, nil
+}
+
+/*
+PruneTVSyncJournal deletes journal entries older than the retention window and keeps a bounded number of entries per screen
+*/
+func PruneTVSyncJournal(ctx context.Context, db database.YouTubeTVSyncEventsClient) (int64, error) {
+	return db.PruneYouTubeTVSyncEvents(ctx, time.Now().UTC().Add(-tvSyncJournalRetention), tvSyncJournalMaxPerScreen)
+}
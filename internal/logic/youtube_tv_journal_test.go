@@ -0,0 +1,66 @@
+package logic
+
+import (
+	"context"
+	"testing"
+
+	"github.com/cufee/feedlr-yt/internal/database"
+	"github.com/pkg/errors"
+)
+
+func TestFormatTVSyncSeconds(t *testing.T) {
+	cases := map[float64]string{
+		-5:     "0:00",
+		7.9:    "0:07",
+		83:     "1:23",
+		3600:   "1:00:00",
+		3725.5: "1:02:05",
+	}
+	for seconds, expected := range cases {
+		if got := formatTVSyncSeconds(seconds); got != expected {
+			t.Fatalf("formatTVSyncSeconds(%v) = %q, expected %q", seconds, got, expected)
+		}
+	}
+}
+
+func TestYouTubeTVSync_Journal(t *testing.T) {
+	store := &mockTVSyncStore{}
+	service := &YouTubeTVSyncService{
+		db:      store,
+		metrics: &tvSyncMetrics{},
+		workers: map[string]*tvSyncWorker{},
+	}
+	ctx := context.Background()
+
+	for _, screenID := range []string{"screen-living-room", "screen-bedroom"} {
+		if err := store.UpsertYouTubeTVSyncCredentials(ctx, "user-1", screenID, "YouTube on TV", nil, ""); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+	livingRoom, _ := store.GetYouTubeTVSyncAccount(ctx, "account-1")
+	bedroom, _ := store.GetYouTubeTVSyncAccount(ctx, "account-2")
+
+	service.journal(ctx, livingRoom, database.YouTubeTVSyncEventConnect, "", "")
+	service.journal(ctx, bedroom, database.YouTubeTVSyncEventConnect, "", "")
+	service.journal(ctx, livingRoom, database.YouTubeTVSyncEventDisconnect, "video-a", tvSyncNoEventsReason)
+
+	if _, err := service.Journal(ctx, "user-2", "account-1"); !errors.Is(err, ErrTVScreenNotFound) {
+		t.Fatalf("expected the journal of other users to be rejected, got %v", err)
+	}
+
+	events, err := service.Journal(ctx, "user-1", "account-1")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(events) != 2 || events[0].Kind != database.YouTubeTVSyncEventDisconnect || events[0].Message != tvSyncNoEventsReason || events[1].Kind != database.YouTubeTVSyncEventConnect {
+		t.Fatalf("unexpected journal %+v", events)
+	}
+
+	recent, err := GetRecentTVSyncEvents(ctx, store)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(recent) != 3 || recent[1].AccountID != "account-2" {
+		t.Fatalf("unexpected recent events %+v", recent)
+	}
+}
@@ -0,0 +1,97 @@
+package logic
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"strings"
+	"time"
+
+	"github.com/cufee/feedlr-yt/internal/database"
+	"github.com/cufee/feedlr-yt/internal/types"
+	"github.com/pkg/errors"
+)
+
+// The LAN helper is expected to run right after the token is created, a short lifetime limits what a leaked token can do
+const tvLANPairingTokenTTL = 15 * time.Minute
+
+var (
+	ErrTVPairingTokenInvalid = errors.New("pairing token is invalid or expired")
+	ErrTVLANPairingDisabled  = errors.New("lan pairing is not available")
+)
+
+/*
+CreateLANPairingToken issues a token the LAN pairing helper uses to add screens for the user, earlier tokens stop working
+*/
+func (s *YouTubeTVSyncService) CreateLANPairingToken(ctx context.Context, userID string) (types.TVLANPairingProps, error) {
+	tokens, ok := s.db.(database.YouTubeTVPairingTokensClient)
+	if !ok {
+		return types.TVLANPairingProps{}, ErrTVLANPairingDisabled
+	}
+
+	raw := make([]byte, 24)
+	if _, err := rand.Read(raw); err != nil {
+		return types.TVLANPairingProps{}, errors.Wrap(err, "failed to generate pairing token")
+	}
+	token := hex.EncodeToString(raw)
+	expiresAt := time.Now().UTC().Add(tvLANPairingTokenTTL)
+
+	err := tokens.CreateYouTubeTVPairingToken(ctx, userID, HashString(token), expiresAt)
+	if err != nil {
+		return types.TVLANPairingProps{}, errors.Wrap(err, "failed to save pairing token")
+	}
+	return types.TVLANPairingProps{Token: token, ExpiresAt: expiresAt}, nil
+}
+
+/*
+RevokeLANPairingTokens invalidates the pairing token of the user
+*/
+func (s *YouTubeTVSyncService) RevokeLANPairingTokens(ctx context.Context, userID string) error {
+	tokens, ok := s.db.(database.YouTubeTVPairingTokensClient)
+	if !ok {
+		return nil
+	}
+	return tokens.DeleteYouTubeTVPairingTokens(ctx, userID)
+}
+
+/*
+PairFromLAN adds a screen found by the LAN pairing helper to the user the token was issued for.
+The helper only knows the screen ID reported over DIAL, the lounge token is requested here so it never leaves the server.
+*/
+func (s *YouTubeTVSyncService) PairFromLAN(ctx context.Context, token, screenID, name string) (types.YouTubeTVScreenProps, error) {
+	tokens, ok := s.db.(database.YouTubeTVPairingTokensClient)
+	if !ok {
+		return types.YouTubeTVScreenProps{}, ErrTVLANPairingDisabled
+	}
+
+	token = strings.TrimSpace(token)
+	screenID = strings.TrimSpace(screenID)
+	if token == "" {
+		return types.YouTubeTVScreenProps{}, ErrTVPairingTokenInvalid
+	}
+	if screenID == "" {
+		return types.YouTubeTVScreenProps{}, errors.New("screen id is required")
+	}
+
+	userID, err := tokens.GetYouTubeTVPairingTokenUser(ctx, HashString(token))
+	if database.IsErrNotFound(err) {
+		return types.YouTubeTVScreenProps{}, ErrTVPairingTokenInvalid
+	}
+	if err != nil {
+		return types.YouTubeTVScreenProps{}, errors.Wrap(err, "failed to get pairing token")
+	}
+
+	screen, err := s.lounge.RefreshLoungeToken(ctx, screenID)
+	if err != nil {
+		return types.YouTubeTVScreenProps{}, errors.Wrap(err, "failed to get lounge token for screen")
+	}
+	if screen.Name == "" {
+		screen.Name = strings.TrimSpace(name)
+	}
+
+	account, err := s.saveScreen(ctx, userID, screen, "Paired from the local network")
+	if err != nil {
+		return types.YouTubeTVScreenProps{}, err
+	}
+	return tvScreenProps(account), nil
+}
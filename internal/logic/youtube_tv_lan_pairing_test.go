@@ -0,0 +1,121 @@
+package logic
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/cufee/feedlr-yt/internal/api/youtube/lounge"
+	"github.com/cufee/feedlr-yt/internal/database"
+)
+
+type mockTVPairingStore struct {
+	*mockTVSyncStore
+
+	tokens map[string]string
+}
+
+func (m *mockTVPairingStore) CreateYouTubeTVPairingToken(_ context.Context, userID, tokenHash string, _ time.Time) error {
+	for hash, owner := range m.tokens {
+		if owner == userID {
+			delete(m.tokens, hash)
+		}
+	}
+	m.tokens[tokenHash] = userID
+	return nil
+}
+
+func (m *mockTVPairingStore) GetYouTubeTVPairingTokenUser(_ context.Context, tokenHash string) (string, error) {
+	userID, ok := m.tokens[tokenHash]
+	if !ok {
+		return "", sql.ErrNoRows
+	}
+	return userID, nil
+}
+
+func (m *mockTVPairingStore) DeleteYouTubeTVPairingTokens(_ context.Context, userID string) error {
+	for hash, owner := range m.tokens {
+		if owner == userID {
+			delete(m.tokens, hash)
+		}
+	}
+	return nil
+}
+
+// Workers are not started for screens paired in these tests
+func (m *mockTVPairingStore) ListEnabledYouTubeTVSyncAccounts(_ context.Context, _ int) ([]*database.YouTubeTVSyncAccount, error) {
+	return nil, nil
+}
+
+func TestPairFromLAN(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/lounge/pairing/get_lounge_token_batch" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		_ = r.ParseForm()
+		if r.FormValue("screen_ids") != "screen-lan" {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"screens":[{"screenId":"screen-lan","name":"","loungeToken":"lan-token"}]}`))
+	}))
+	defer server.Close()
+
+	store := &mockTVPairingStore{mockTVSyncStore: &mockTVSyncStore{}, tokens: map[string]string{}}
+	crypto := newYouTubeSyncCrypto("test-tv-sync-secret")
+	service := &YouTubeTVSyncService{
+		db:      store,
+		crypto:  crypto,
+		lounge:  lounge.NewClientWithBaseURL(server.Client(), server.URL+"/api/lounge"),
+		metrics: &tvSyncMetrics{},
+		workers: map[string]*tvSyncWorker{},
+	}
+	ctx := context.Background()
+
+	if _, err := service.PairFromLAN(ctx, "unknown-token", "screen-lan", "Living Room TV"); !errors.Is(err, ErrTVPairingTokenInvalid) {
+		t.Fatalf("expected an invalid token error, got %v", err)
+	}
+
+	pairing, err := service.CreateLANPairingToken(ctx, "user-1")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if pairing.Token == "" || store.tokens[pairing.Token] != "" {
+		t.Fatal("expected only the token hash to be stored")
+	}
+
+	screen, err := service.PairFromLAN(ctx, pairing.Token, "screen-lan", "Living Room TV")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if screen.Name != "Living Room TV" {
+		t.Fatalf("expected the name found on the network to be used, got %q", screen.Name)
+	}
+
+	store.mu.Lock()
+	account := store.find(screen.ID)
+	store.mu.Unlock()
+	if account == nil || account.UserID != "user-1" || account.ScreenID != "screen-lan" {
+		t.Fatalf("unexpected account %+v", account)
+	}
+	token, err := crypto.Decrypt(account.LoungeTokenEnc, "user-1")
+	if err != nil || string(token) != "lan-token" {
+		t.Fatalf("expected the lounge token to be stored, got %q %v", token, err)
+	}
+	if len(store.events) != 1 || store.events[0].Kind != database.YouTubeTVSyncEventPaired {
+		t.Fatalf("expected a paired journal entry, got %+v", store.events)
+	}
+
+	if err := service.RevokeLANPairingTokens(ctx, "user-1"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, err := service.PairFromLAN(ctx, pairing.Token, "screen-lan", ""); !errors.Is(err, ErrTVPairingTokenInvalid) {
+		t.Fatalf("expected a revoked token to be rejected, got %v", err)
+	}
+}
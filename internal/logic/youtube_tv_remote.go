@@ -0,0 +1,328 @@
+package logic
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/cufee/feedlr-yt/internal/api/youtube/lounge"
+	"github.com/cufee/feedlr-yt/internal/database"
+	"github.com/cufee/feedlr-yt/internal/database/models"
+	"github.com/cufee/feedlr-yt/internal/metrics"
+	"github.com/cufee/feedlr-yt/internal/types"
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog/log"
+)
+
+// The TV app struggles with very long queues, anything past this is left out
+const tvRemoteQueueMax = 50
+
+var (
+	ErrTVQueueEmpty         = errors.New("nothing to play on the tv")
+	ErrInvalidRemoteCommand = errors.New("invalid tv remote command")
+)
+
+type TVRemoteCommand string
+
+const (
+	TVRemotePlay  TVRemoteCommand = "play"
+	TVRemotePause TVRemoteCommand = "pause"
+	TVRemoteNext  TVRemoteCommand = "next"
+)
+
+/*
+TVRemoteAvailable reports whether the user has a TV session that can receive remote commands.
+Remote commands take an account ID to pick a screen, an empty ID targets the screen that sent an event most recently.
+*/
+func TVRemoteAvailable(userID string) bool {
+	if DefaultYouTubeTVSync == nil || userID == "" {
+		return false
+	}
+	return DefaultYouTubeTVSync.RemoteAvailable(userID)
+}
+
+func (s *YouTubeTVSyncService) RemoteAvailable(userID string) bool {
+	_, _, err := s.activeSession(userID, "")
+	return err == nil
+}
+
+func (s *YouTubeTVSyncService) activeSession(userID, accountID string) (*lounge.Session, *tvSyncRuntime, error) {
+	if accountID != "" {
+		worker := s.worker(accountID)
+		if worker == nil || worker.userID != userID {
+			return nil, nil, ErrTVNotConnected
+		}
+		session, runtime := worker.active()
+		if session == nil || runtime == nil {
+			return nil, nil, ErrTVNotConnected
+		}
+		return session, runtime, nil
+	}
+
+	var session *lounge.Session
+	var runtime *tvSyncRuntime
+	var age time.Duration
+	now := time.Now().UTC()
+	for _, worker := range s.userWorkers(userID) {
+		workerSession, workerRuntime := worker.active()
+		if workerSession == nil || workerRuntime == nil {
+			continue
+		}
+		if workerAge := workerRuntime.eventAge(now); runtime == nil || workerAge < age {
+			session, runtime, age = workerSession, workerRuntime, workerAge
+		}
+	}
+	if session == nil {
+		return nil, nil, ErrTVNotConnected
+	}
+	return session, runtime, nil
+}
+
+/*
+PlayVideo starts a video on the user's TV, resuming from the saved progress
+*/
+func (s *YouTubeTVSyncService) PlayVideo(ctx context.Context, userID, accountID, videoID string) error {
+	return s.PlayQueue(ctx, userID, accountID, []string{videoID})
+}
+
+/*
+PlayQueue replaces the queue on the user's TV, the first video resumes from the saved progress.
+Later videos are resumed by the sync worker once the TV reports them as playing.
+*/
+func (s *YouTubeTVSyncService) PlayQueue(ctx context.Context, userID, accountID string, videoIDs []string) error {
+	queue := make([]string, 0, min(len(videoIDs), tvRemoteQueueMax))
+	for _, id := range videoIDs {
+		if id = strings.TrimSpace(id); id != "" && len(queue) < tvRemoteQueueMax {
+			queue = append(queue, id)
+		}
+	}
+	if len(queue) == 0 {
+		return ErrTVQueueEmpty
+	}
+
+	session, runtime, err := s.activeSession(userID, accountID)
+	if err != nil {
+		return err
+	}
+
+	startAt := s.remoteResumePosition(ctx, userID, queue[0])
+	err = s.lounge.SetPlaylist(ctx, session, queue, 0, float64(startAt))
+	metrics.ObserveTVSyncEvent("remote_set_playlist", err)
+	if err != nil {
+		return errors.Wrap(err, "failed to send queue to tv")
+	}
+	runtime.setRemoteQueue(queue)
+
+	log.Debug().Str("userID", userID).Str("videoID", queue[0]).Int("queue_length", len(queue)).Int("start_at", startAt).Msg("tv remote started a queue")
+	return nil
+}
+
+/*
+QueueVideo adds a video to the end of the queue on the user's TV
+*/
+func (s *YouTubeTVSyncService) QueueVideo(ctx context.Context, userID, accountID, videoID string) error {
+	session, runtime, err := s.activeSession(userID, accountID)
+	if err != nil {
+		return err
+	}
+
+	err = s.lounge.AddVideo(ctx, session, videoID)
+	metrics.ObserveTVSyncEvent("remote_add_video", err)
+	if err != nil {
+		return errors.Wrap(err, "failed to add video to tv queue")
+	}
+	runtime.appendRemoteQueue(videoID)
+	return nil
+}
+
+/*
+SendRemoteCommand sends a playback command to the user's TV
+*/
+func (s *YouTubeTVSyncService) SendRemoteCommand(ctx context.Context, userID, accountID string, command TVRemoteCommand) error {
+	session, _, err := s.activeSession(userID, accountID)
+	if err != nil {
+		return err
+	}
+
+	switch command {
+	case TVRemotePlay:
+		err = s.lounge.Play(ctx, session)
+	case TVRemotePause:
+		err = s.lounge.Pause(ctx, session)
+	case TVRemoteNext:
+		err = s.lounge.Next(ctx, session)
+	default:
+		return ErrInvalidRemoteCommand
+	}
+	metrics.ObserveTVSyncEvent("remote_"+string(command), err)
+	if err != nil {
+		return errors.Wrapf(err, "failed to send %s to tv", command)
+	}
+	return nil
+}
+
+/*
+SetVolume sets the volume on the user's TV, the level is between 0 and 100
+*/
+func (s *YouTubeTVSyncService) SetVolume(ctx context.Context, userID, accountID string, volume int) error {
+	if volume < 0 || volume > 100 {
+		return errors.New("volume must be between 0 and 100")
+	}
+
+	session, _, err := s.activeSession(userID, accountID)
+	if err != nil {
+		return err
+	}
+
+	err = s.lounge.SetVolume(ctx, session, volume)
+	metrics.ObserveTVSyncEvent("remote_set_volume", err)
+	if err != nil {
+		return errors.Wrap(err, "failed to set tv volume")
+	}
+	return nil
+}
+
+// remoteResumePosition returns the saved progress for a video, fully watched videos start over
+func (s *YouTubeTVSyncService) remoteResumePosition(ctx context.Context, userID, videoID string) int {
+	progress := s.getStoredProgress(ctx, userID, videoID)
+	if progress <= 0 {
+		return 0
+	}
+
+	videosDB, ok := s.db.(database.VideosClient)
+	if !ok {
+		return progress
+	}
+	video, err := videosDB.GetVideoByID(ctx, videoID)
+	if err != nil || video.Duration <= 0 {
+		return progress
+	}
+	if duration := int(video.Duration); progress+getCompletionBuffer(duration) >= duration {
+		return 0
+	}
+	return progress
+}
+
+/*
+GetFeedTVQueue returns unwatched videos from the user's home feed, in feed order
+*/
+func GetFeedTVQueue(ctx context.Context, db database.Client, userID string) ([]string, error) {
+	feed, err := GetUserVideosProps(ctx, db, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	queue := make([]string, 0, min(len(feed.New), tvRemoteQueueMax))
+	for _, video := range feed.New {
+		if len(queue) >= tvRemoteQueueMax {
+			break
+		}
+		queue = append(queue, video.ID)
+	}
+	return queue, nil
+}
+
+/*
+GetWatchLaterTVQueue returns Watch Later videos that are not hidden or fully watched, in playlist order
+*/
+func GetWatchLaterTVQueue(ctx context.Context, db interface {
+	database.PlaylistsClient
+	database.ViewsClient
+}, userID string) ([]string, error) {
+	playlist, err := db.GetPlaylistBySlug(ctx, userID, WatchLaterSlug)
+	if err != nil {
+		if database.IsErrNotFound(err) {
+			return nil, nil
+		}
+		return nil, errors.Wrap(err, "failed to get watch later playlist")
+	}
+	return playlistTVQueue(ctx, db, userID, playlist.ID)
+}
+
+/*
+GetPlaylistTVQueue returns playlist videos that are not hidden or fully watched, in playlist order
+*/
+func GetPlaylistTVQueue(ctx context.Context, db interface {
+	database.PlaylistsClient
+	database.SmartPlaylistsClient
+	database.ViewsClient
+}, userID, playlistID string) ([]string, error) {
+	playlist, err := db.GetPlaylistByID(ctx, playlistID)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get playlist")
+	}
+	if playlist.UserID != userID {
+		return nil, errors.New("playlist not found")
+	}
+	if playlist.Rules.Valid {
+		return smartPlaylistTVQueue(ctx, db, userID, playlist)
+	}
+	return playlistTVQueue(ctx, db, userID, playlist.ID)
+}
+
+// smartPlaylistTVQueue queues the videos matching the rules of a smart playlist that are not fully watched
+func smartPlaylistTVQueue(ctx context.Context, db interface {
+	database.SmartPlaylistsClient
+	database.ViewsClient
+}, userID string, playlist *models.Playlist) ([]string, error) {
+	rules, err := types.ParseSmartPlaylistRules(playlist.Rules.String)
+	if err != nil {
+		return nil, err
+	}
+	videos, err := GetSmartPlaylistVideos(ctx, db, userID, rules)
+	if err != nil {
+		return nil, err
+	}
+
+	var queue []string
+	for _, video := range videos {
+		if video.Duration > 0 && video.Progress+getCompletionBuffer(video.Duration) >= video.Duration {
+			continue
+		}
+		queue = append(queue, video.ID)
+		if len(queue) >= tvRemoteQueueMax {
+			break
+		}
+	}
+	return queue, nil
+}
+
+func playlistTVQueue(ctx context.Context, db interface {
+	database.PlaylistsClient
+	database.ViewsClient
+}, userID, playlistID string) ([]string, error) {
+	items, err := db.GetPlaylistItems(ctx, playlistID,
+		database.PlaylistItem.OrderByPosition(),
+		database.PlaylistItem.WithVideo(),
+	)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get playlist items")
+	}
+
+	videoIDs := make([]string, 0, len(items))
+	for _, item := range items {
+		videoIDs = append(videoIDs, item.VideoID)
+	}
+	views, err := GetUserViews(ctx, db, userID, videoIDs...)
+	if err != nil && !database.IsErrNotFound(err) {
+		return nil, errors.Wrap(err, "failed to get user views")
+	}
+
+	var queue []string
+	for _, item := range items {
+		if item.R == nil || item.R.Video == nil {
+			continue
+		}
+		if view, ok := views[item.VideoID]; ok {
+			duration := int(item.R.Video.Duration)
+			if view.Hidden.Bool || (duration > 0 && int(view.Progress)+getCompletionBuffer(duration) >= duration) {
+				continue
+			}
+		}
+		queue = append(queue, item.VideoID)
+		if len(queue) >= tvRemoteQueueMax {
+			break
+		}
+	}
+	return queue, nil
+}
@@ -0,0 +1,140 @@
+package logic
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/cufee/feedlr-yt/internal/api/youtube/lounge"
+	"github.com/cufee/feedlr-yt/internal/database/models"
+	"github.com/pkg/errors"
+)
+
+func TestTVRemote_PlayQueueAndCommands(t *testing.T) {
+	var mu sync.Mutex
+	var requests []url.Values
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/lounge/bc/bind" || r.Method != http.MethodPost {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		_ = r.ParseForm()
+		mu.Lock()
+		requests = append(requests, r.PostForm)
+		mu.Unlock()
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	store := &mockTVSyncStore{views: []*models.View{{UserID: "user-1", VideoID: "video-a", Progress: 95}}}
+	service := &YouTubeTVSyncService{
+		db:      store,
+		lounge:  lounge.NewClientWithBaseURL(server.Client(), server.URL+"/api/lounge"),
+		metrics: &tvSyncMetrics{},
+		workers: map[string]*tvSyncWorker{},
+	}
+
+	if err := service.PlayVideo(context.Background(), "user-1", "", "video-a"); !errors.Is(err, ErrTVNotConnected) {
+		t.Fatalf("expected ErrTVNotConnected without a session, got %v", err)
+	}
+	if service.RemoteAvailable("user-1") {
+		t.Fatal("expected remote to be unavailable without a session")
+	}
+
+	worker := &tvSyncWorker{userID: "user-1"}
+	worker.attach(&lounge.Session{SID: "sid-1", GSessionID: "gs-1"}, newTVSyncRuntime(false, nil))
+	service.workers["account-1"] = worker
+	if !service.RemoteAvailable("user-1") {
+		t.Fatal("expected remote to be available with an active session")
+	}
+
+	if err := service.PlayQueue(context.Background(), "user-1", "", []string{" ", ""}); !errors.Is(err, ErrTVQueueEmpty) {
+		t.Fatalf("expected ErrTVQueueEmpty, got %v", err)
+	}
+	if err := service.PlayQueue(context.Background(), "user-1", "", []string{"video-a", " video-b "}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := service.SendRemoteCommand(context.Background(), "user-1", "", TVRemotePause); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := service.SendRemoteCommand(context.Background(), "user-1", "", "rewind"); !errors.Is(err, ErrInvalidRemoteCommand) {
+		t.Fatalf("expected ErrInvalidRemoteCommand, got %v", err)
+	}
+	if err := service.SetVolume(context.Background(), "user-1", "", 101); err == nil {
+		t.Fatal("expected out of range volume to be rejected")
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+	if len(requests) != 2 {
+		t.Fatalf("expected 2 lounge requests, got %d", len(requests))
+	}
+	queue := requests[0]
+	if queue.Get("req0__sc") != "setPlaylist" || queue.Get("req0_videoIds") != "video-a,video-b" || queue.Get("req0_videoId") != "video-a" {
+		t.Fatalf("unexpected setPlaylist request %v", queue)
+	}
+	// Without a cached video the saved progress is used as is
+	if queue.Get("req0_currentTime") != "95.000" || queue.Get("req0_currentIndex") != "0" {
+		t.Fatalf("expected the queue to resume at the saved progress, got %v", queue)
+	}
+	if requests[1].Get("req0__sc") != "pause" {
+		t.Fatalf("expected pause command, got %v", requests[1])
+	}
+}
+
+func TestTVRemote_TargetsScreen(t *testing.T) {
+	var mu sync.Mutex
+	var sessions []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		sessions = append(sessions, r.URL.Query().Get("SID"))
+		mu.Unlock()
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	service := &YouTubeTVSyncService{
+		db:      &mockTVSyncStore{},
+		lounge:  lounge.NewClientWithBaseURL(server.Client(), server.URL+"/api/lounge"),
+		metrics: &tvSyncMetrics{},
+		workers: map[string]*tvSyncWorker{},
+	}
+
+	livingRoom := newTVSyncRuntime(false, nil)
+	livingRoom.markEvent(time.Now().UTC().Add(-time.Minute))
+	bedroom := newTVSyncRuntime(false, nil)
+	bedroom.markEvent(time.Now().UTC())
+	for accountID, screen := range map[string]struct {
+		userID  string
+		sid     string
+		runtime *tvSyncRuntime
+	}{
+		"account-living-room": {"user-1", "sid-living-room", livingRoom},
+		"account-bedroom":     {"user-1", "sid-bedroom", bedroom},
+		"account-neighbour":   {"user-2", "sid-neighbour", newTVSyncRuntime(false, nil)},
+	} {
+		worker := &tvSyncWorker{userID: screen.userID}
+		worker.attach(&lounge.Session{SID: screen.sid, GSessionID: "gs"}, screen.runtime)
+		service.workers[accountID] = worker
+	}
+
+	for _, accountID := range []string{"", "account-living-room"} {
+		if err := service.SendRemoteCommand(context.Background(), "user-1", accountID, TVRemotePlay); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+	if err := service.SendRemoteCommand(context.Background(), "user-1", "account-neighbour", TVRemotePlay); !errors.Is(err, ErrTVNotConnected) {
+		t.Fatalf("expected screens of other users to be unavailable, got %v", err)
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+	// Without an account the screen with the most recent event is used
+	if len(sessions) != 2 || sessions[0] != "sid-bedroom" || sessions[1] != "sid-living-room" {
+		t.Fatalf("unexpected target sessions %v", sessions)
+	}
+}
@@ -3,6 +3,7 @@ package logic
 import (
 	"context"
 	stdErrors "errors"
+	"fmt"
 	"math"
 	"slices"
 	"sort"
@@ -18,14 +19,15 @@ import (
 	"github.com/cufee/feedlr-yt/internal/metrics"
 	"github.com/cufee/feedlr-yt/internal/netproxy"
 	"github.com/cufee/feedlr-yt/internal/types"
-	"github.com/cufee/feedlr-yt/internal/utils"
 	"github.com/pkg/errors"
 	"github.com/rs/zerolog/log"
 )
 
 const (
 	tvSyncUserInactiveDays = 14
-	tvSyncMaxUsersPerTick  = 100
+	// Limits screens, not users, a user can have several paired screens
+	tvSyncMaxAccountsPerTick  = 100
+	tvSyncScreenNameMaxLength = 64
 )
 
 const (
@@ -44,7 +46,12 @@ const (
 	tvSyncNowPlayingPollInterval    = 15 * time.Second
 	tvSyncConnectionStateConnectMsg = "Establishing TV session"
 	tvSyncNoEventsReason            = "No TV events received"
-	tvSyncDeviceName                = "Feedlr TV Sync"
+	tvSyncStoppedReason             = "Feedlr restarted"
+	// Final writes of a stopping worker run after its context is canceled
+	tvSyncStopWriteTimeout = 5 * time.Second
+	tvSyncDeviceName       = "Feedlr TV Sync"
+	// Leases are renewed every third of the ttl, a screen moves to another process within this long after its holder dies
+	tvSyncLeaseTTL = 90 * time.Second
 )
 
 const (
@@ -59,23 +66,61 @@ const (
 
 var DefaultYouTubeTVSync *YouTubeTVSyncService
 
+var (
+	ErrTVNotConnected   = errors.New("tv is not connected")
+	ErrTVScreenNotFound = errors.New("tv screen not found")
+	ErrTVNotPlaying     = errors.New("nothing is playing on the tv")
+	ErrNoNextChapter    = errors.New("no next chapter")
+)
+
 type tvSyncWorker struct {
+	userID string
 	cancel context.CancelFunc
 	done   chan struct{}
+	// lease is nil when the store does not support leases, the worker then runs without coordination
+	lease *Lease
+
+	mu      sync.Mutex
+	session *lounge.Session
+	runtime *tvSyncRuntime
+}
+
+func (w *tvSyncWorker) attach(session *lounge.Session, runtime *tvSyncRuntime) {
+	w.mu.Lock()
+	w.session = session
+	w.runtime = runtime
+	w.mu.Unlock()
+}
+
+func (w *tvSyncWorker) detach(session *lounge.Session) {
+	w.mu.Lock()
+	if w.session == session {
+		w.session = nil
+		w.runtime = nil
+	}
+	w.mu.Unlock()
+}
+
+func (w *tvSyncWorker) active() (*lounge.Session, *tvSyncRuntime) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	return w.session, w.runtime
 }
 
 type tvSyncSegment struct {
-	Start float64
-	End   float64
+	Start  float64
+	End    float64
+	Action types.SponsorBlockAction
 }
 
 type tvSyncVideoRuntime struct {
-	lastProgressWrite time.Time
-	lastState         string
-	sponsorLoaded     bool
-	sponsorSegments   []tvSyncSegment
-	skippedSegments   map[int]bool
-	lastSponsorSkipAt time.Time
+	lastProgressWrite   time.Time
+	lastProgressJournal time.Time
+	lastState           string
+	sponsorLoaded       bool
+	sponsorSegments     []tvSyncSegment
+	skippedSegments     map[int]bool
+	lastSponsorSkipAt   time.Time
 }
 
 type tvSyncRuntime struct {
@@ -85,20 +130,33 @@ type tvSyncRuntime struct {
 	lastStatusPersistAt  time.Time
 	currentVideoID       string
 	currentPlaybackState string
+	currentPlaybackTime  float64
+	currentPlaybackAt    time.Time
 	resumeApplied        bool
 
-	sponsorEnabled    bool
-	sponsorCategories []sponsorblock.Category
-	videoState        map[string]*tvSyncVideoRuntime
+	sponsorEnabled  bool
+	sponsorSettings types.SponsorBlockSettingsProps
+	// sponsorMuted is set while the TV is muted for a segment, so it can be unmuted once playback leaves it
+	sponsorMuted bool
+	videoState   map[string]*tvSyncVideoRuntime
+
+	// remoteQueue holds videos sent by remote commands that the TV has not started yet
+	remoteQueue     []string
+	autoplayVideoID string
+	autoplayChain   int
+	autoplayLastAt  time.Time
 }
 
-func newTVSyncRuntime(sponsorEnabled bool, sponsorCategories []sponsorblock.Category) *tvSyncRuntime {
-	return &tvSyncRuntime{
-		lastEventAt:       time.Now().UTC(),
-		sponsorEnabled:    sponsorEnabled,
-		sponsorCategories: sponsorCategories,
-		videoState:        map[string]*tvSyncVideoRuntime{},
+func newTVSyncRuntime(sponsorEnabled bool, sponsorSettings *types.SponsorBlockSettingsProps) *tvSyncRuntime {
+	runtime := &tvSyncRuntime{
+		lastEventAt:    time.Now().UTC(),
+		sponsorEnabled: sponsorEnabled,
+		videoState:     map[string]*tvSyncVideoRuntime{},
+	}
+	if sponsorSettings != nil {
+		runtime.sponsorSettings = *sponsorSettings
 	}
+	return runtime
 }
 
 func (r *tvSyncRuntime) markEvent(at time.Time) {
@@ -138,6 +196,7 @@ func (r *tvSyncRuntime) setCurrentVideo(videoID string) bool {
 	}
 	r.currentVideoID = videoID
 	r.currentPlaybackState = ""
+	r.currentPlaybackAt = time.Time{}
 	r.resumeApplied = false
 	return true
 }
@@ -152,6 +211,7 @@ func (r *tvSyncRuntime) clearCurrentVideo() {
 	r.mu.Lock()
 	r.currentVideoID = ""
 	r.currentPlaybackState = ""
+	r.currentPlaybackAt = time.Time{}
 	r.resumeApplied = false
 	r.mu.Unlock()
 }
@@ -162,6 +222,42 @@ func (r *tvSyncRuntime) setCurrentPlaybackState(state string) {
 	r.mu.Unlock()
 }
 
+func (r *tvSyncRuntime) setCurrentPlaybackTime(seconds float64, at time.Time) {
+	r.mu.Lock()
+	r.currentPlaybackTime = seconds
+	r.currentPlaybackAt = at
+	r.mu.Unlock()
+}
+
+// currentPlaybackPosition estimates the playback position of the current video from the last observed event
+func (r *tvSyncRuntime) currentPlaybackPosition(now time.Time) (string, float64, bool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.currentVideoID == "" || r.currentPlaybackAt.IsZero() {
+		return r.currentVideoID, 0, false
+	}
+	position := r.currentPlaybackTime
+	if r.currentPlaybackState == "1" {
+		position += now.Sub(r.currentPlaybackAt).Seconds()
+	}
+	return r.currentVideoID, position, true
+}
+
+// unsavedProgress returns the estimated position of the current video when it was observed after the last progress write
+func (r *tvSyncRuntime) unsavedProgress(now time.Time) (string, float64, bool) {
+	videoID, position, ok := r.currentPlaybackPosition(now)
+	if !ok {
+		return "", 0, false
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if state, ok := r.videoState[videoID]; ok && !state.lastProgressWrite.Before(r.currentPlaybackAt) {
+		return "", 0, false
+	}
+	return videoID, position, true
+}
+
 func (r *tvSyncRuntime) currentPlaybackSnapshot() (string, string) {
 	r.mu.Lock()
 	defer r.mu.Unlock()
@@ -180,6 +276,70 @@ func (r *tvSyncRuntime) markResumeAppliedForCurrentVideo() {
 	r.mu.Unlock()
 }
 
+func (r *tvSyncRuntime) isSponsorMuted() bool {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.sponsorMuted
+}
+
+func (r *tvSyncRuntime) setSponsorMuted(muted bool) {
+	r.mu.Lock()
+	r.sponsorMuted = muted
+	r.mu.Unlock()
+}
+
+func (r *tvSyncRuntime) setRemoteQueue(videoIDs []string) {
+	r.mu.Lock()
+	r.remoteQueue = slices.Clone(videoIDs)
+	r.mu.Unlock()
+}
+
+func (r *tvSyncRuntime) appendRemoteQueue(videoID string) {
+	r.mu.Lock()
+	r.remoteQueue = append(r.remoteQueue, videoID)
+	r.mu.Unlock()
+}
+
+/*
+noteVideoStarted updates the remote queue and autoplay chain once the TV reports a new video.
+Any video that was not started by autoplay counts as the user taking over and resets the chain.
+*/
+func (r *tvSyncRuntime) noteVideoStarted(videoID string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if i := slices.Index(r.remoteQueue, videoID); i >= 0 {
+		r.remoteQueue = r.remoteQueue[i+1:]
+	} else {
+		r.remoteQueue = nil
+	}
+	if videoID != r.autoplayVideoID {
+		r.autoplayVideoID = ""
+		r.autoplayChain = 0
+	}
+}
+
+// beginAutoplay reports whether autoplay can act on an ended video and whether the chain limit was reached
+func (r *tvSyncRuntime) beginAutoplay(now time.Time) (bool, bool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if len(r.remoteQueue) > 0 {
+		return false, false
+	}
+	if !r.autoplayLastAt.IsZero() && now.Sub(r.autoplayLastAt) < tvAutoplayCooldown {
+		return false, false
+	}
+	r.autoplayLastAt = now
+	return true, r.autoplayChain >= tvAutoplayMaxChain
+}
+
+func (r *tvSyncRuntime) markAutoplayStarted(videoID string) {
+	r.mu.Lock()
+	r.autoplayVideoID = videoID
+	r.autoplayChain++
+	r.remoteQueue = nil
+	r.mu.Unlock()
+}
+
 func (r *tvSyncRuntime) videoRuntime(videoID string) *tvSyncVideoRuntime {
 	r.mu.Lock()
 	defer r.mu.Unlock()
@@ -207,8 +367,11 @@ type YouTubeTVSyncService struct {
 
 	metrics *tvSyncMetrics
 
+	// tickMu keeps connection ticks from starting workers concurrently, workersMu is not held while leases are acquired
+	tickMu    sync.Mutex
 	workersMu sync.Mutex
 	workers   map[string]*tvSyncWorker
+	stopped   atomic.Bool
 }
 
 type youTubeTVSyncStore interface {
@@ -222,6 +385,12 @@ type watchLaterCleanupDB interface {
 	database.VideosClient
 }
 
+type tvChaptersDB interface {
+	database.VideoChaptersClient
+	database.SponsorBlockSegmentsClient
+	database.VideosClient
+}
+
 type tvSyncMetrics struct {
 	connects        atomic.Uint64
 	disconnects     atomic.Uint64
@@ -237,10 +406,8 @@ func NewYouTubeTVSyncService(db database.Client) (*YouTubeTVSyncService, error)
 	}
 
 	service := &YouTubeTVSyncService{
-		db: db,
-		crypto: newYouTubeSyncCrypto(
-			utils.MustGetEnv("YOUTUBE_SYNC_ENCRYPTION_SECRET"),
-		),
+		db:                     db,
+		crypto:                 newYouTubeSyncCryptoFromEnv(),
 		lounge:                 lounge.NewClient(loungeHTTPClient),
 		noEventTimeout:         tvSyncNoEventTimeout,
 		watchdogPollInterval:   5 * time.Second,
@@ -259,7 +426,7 @@ func (s *YouTubeTVSyncService) Status(ctx context.Context, userID string) (types
 		Available: true,
 	}
 
-	account, err := s.db.GetYouTubeTVSyncAccountByUserID(ctx, userID)
+	accounts, err := s.db.ListUserYouTubeTVSyncAccounts(ctx, userID)
 	if err != nil {
 		if database.IsErrNotFound(err) {
 			return status, nil
@@ -267,27 +434,59 @@ func (s *YouTubeTVSyncService) Status(ctx context.Context, userID string) (types
 		return status, err
 	}
 
-	status.Enabled = account.SyncEnabled
-	status.Connected = account.ConnectionState == tvSyncStateConnected
-	status.ConnectionState = account.ConnectionState
-	status.StateReason = account.StateReason
-	status.ScreenName = account.ScreenName
-	status.LastError = account.LastError
+	for _, account := range accounts {
+		status.Screens = append(status.Screens, tvScreenProps(account))
+	}
+	if len(status.Screens) == 0 {
+		return status, nil
+	}
+
+	settings, err := GetUserSettings(ctx, s.db, userID)
+	if err != nil {
+		return status, err
+	}
+	status.Autoplay = settings.TVAutoplay
+	status.Autoplay.Mode = types.ParseTVAutoplayMode(string(settings.TVAutoplay.Mode))
+	status.AutoplayPlaylists = s.tvAutoplayPlaylists(ctx, userID)
+	return status, nil
+}
+
+func tvScreenProps(account *database.YouTubeTVSyncAccount) types.YouTubeTVScreenProps {
+	screen := types.YouTubeTVScreenProps{
+		ID:               account.ID,
+		Name:             account.DisplayName,
+		ScreenName:       account.ScreenName,
+		Enabled:          account.SyncEnabled,
+		Connected:        account.ConnectionState == tvSyncStateConnected,
+		SponsorBlockMode: types.ParseTVSponsorBlockMode(account.SponsorBlockMode),
+		ConnectionState:  account.ConnectionState,
+		StateReason:      account.StateReason,
+		LastError:        account.LastError,
+	}
+	if screen.Name == "" {
+		screen.Name = account.ScreenName
+	}
+	if screen.Name == "" {
+		screen.Name = "TV"
+	}
 	if account.LastConnectedAt.Valid {
-		status.LastConnectedAt = account.LastConnectedAt.Time
+		screen.LastConnectedAt = account.LastConnectedAt.Time
 	}
 	if account.LastEventAt.Valid {
-		status.LastEventAt = account.LastEventAt.Time
+		screen.LastEventAt = account.LastEventAt.Time
 	}
 	if account.LastDisconnectAt.Valid {
-		status.LastDisconnectAt = account.LastDisconnectAt.Time
+		screen.LastDisconnectAt = account.LastDisconnectAt.Time
 	}
 	if account.LastUserActivity.Valid {
-		status.LastUserActivityAt = account.LastUserActivity.Time
+		screen.LastUserActivityAt = account.LastUserActivity.Time
 	}
-	return status, nil
+	return screen
 }
 
+/*
+PairWithCode adds a screen for the user, pairing a screen that is already added refreshes its credentials
+*/
 func (s *YouTubeTVSyncService) PairWithCode(ctx context.Context, userID, pairingCode string) error {
 	pairingCode = strings.TrimSpace(pairingCode)
 	if pairingCode == "" {
@@ -299,32 +498,76 @@ func (s *YouTubeTVSyncService) PairWithCode(ctx context.Context, userID, pairing
 		return errors.Wrap(err, "failed to pair with tv")
 	}
 
+	_, err = s.saveScreen(ctx, userID, screen, "Paired with a TV code")
+	return err
+}
+
+// saveScreen stores the credentials of a paired screen and returns its account
+func (s *YouTubeTVSyncService) saveScreen(ctx context.Context, userID string, screen lounge.Screen, message string) (*database.YouTubeTVSyncAccount, error) {
 	encrypted, err := s.crypto.Encrypt([]byte(screen.LoungeToken), userID)
 	if err != nil {
-		return errors.Wrap(err, "failed to encrypt lounge token")
+		return nil, errors.Wrap(err, "failed to encrypt lounge token")
 	}
 
 	err = s.db.UpsertYouTubeTVSyncCredentials(ctx, userID, screen.ID, screen.Name, encrypted, s.crypto.secretHash)
 	if err != nil {
-		return errors.Wrap(err, "failed to persist tv sync credentials")
+		return nil, errors.Wrap(err, "failed to persist tv sync credentials")
 	}
-
 	s.kickConnectionTick()
-	return nil
+
+	accounts, err := s.db.ListUserYouTubeTVSyncAccounts(ctx, userID)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get tv sync accounts")
+	}
+	for _, account := range accounts {
+		if account.ScreenID == screen.ID {
+			s.journal(ctx, account, database.YouTubeTVSyncEventPaired, "", message)
+			return account, nil
+		}
+	}
+	return nil, errors.New("paired screen was not saved")
 }
 
-func (s *YouTubeTVSyncService) Disconnect(ctx context.Context, userID string) error {
-	s.stopWorker(userID)
+// userAccount loads a screen account and makes sure it belongs to the user
+func (s *YouTubeTVSyncService) userAccount(ctx context.Context, userID, accountID string) (*database.YouTubeTVSyncAccount, error) {
+	account, err := s.db.GetYouTubeTVSyncAccount(ctx, accountID)
+	if err != nil {
+		return nil, err
+	}
+	if account.UserID != userID {
+		return nil, ErrTVScreenNotFound
+	}
+	return account, nil
+}
 
-	err := s.db.DeleteYouTubeTVSyncAccount(ctx, userID)
+/*
+Disconnect removes a paired screen, other screens of the user are not affected
+*/
+func (s *YouTubeTVSyncService) Disconnect(ctx context.Context, userID, accountID string) error {
+	account, err := s.userAccount(ctx, userID, accountID)
+	if err != nil {
+		if database.IsErrNotFound(err) {
+			return nil
+		}
+		return err
+	}
+
+	s.stopWorker(account.ID)
+
+	err = s.db.DeleteYouTubeTVSyncAccount(ctx, account.ID)
 	if err != nil && !database.IsErrNotFound(err) {
 		return err
 	}
 	return nil
 }
 
-func (s *YouTubeTVSyncService) SetEnabled(ctx context.Context, userID string, enabled bool) error {
-	if err := s.db.SetYouTubeTVSyncAccountEnabled(ctx, userID, enabled); err != nil {
+func (s *YouTubeTVSyncService) SetEnabled(ctx context.Context, userID, accountID string, enabled bool) error {
+	account, err := s.userAccount(ctx, userID, accountID)
+	if err != nil {
+		return err
+	}
+
+	if err := s.db.SetYouTubeTVSyncAccountEnabled(ctx, account.ID, enabled); err != nil {
 		return err
 	}
 
@@ -333,7 +576,7 @@ func (s *YouTubeTVSyncService) SetEnabled(ctx context.Context, userID string, en
 		state = tvSyncStateDisabled
 	}
 
-	err := s.db.UpdateYouTubeTVSyncState(ctx, userID, database.YouTubeTVSyncStateUpdate{
+	err = s.db.UpdateYouTubeTVSyncState(ctx, account.ID, database.YouTubeTVSyncStateUpdate{
 		ConnectionState: state,
 		StateReason:     "",
 		LastError:       "",
@@ -343,7 +586,7 @@ func (s *YouTubeTVSyncService) SetEnabled(ctx context.Context, userID string, en
 	}
 
 	if !enabled {
-		s.stopWorker(userID)
+		s.stopWorker(account.ID)
 	} else {
 		s.kickConnectionTick()
 	}
@@ -351,8 +594,36 @@ func (s *YouTubeTVSyncService) SetEnabled(ctx context.Context, userID string, en
 	return nil
 }
 
+/*
+UpdateScreen renames a screen and changes its SponsorBlock mode, an empty name falls back to the name reported by the TV.
+SponsorBlock preferences are loaded when a session starts, so a connected screen is reconnected when the mode changes.
+*/
+func (s *YouTubeTVSyncService) UpdateScreen(ctx context.Context, userID, accountID, name string, mode types.TVSponsorBlockMode) error {
+	account, err := s.userAccount(ctx, userID, accountID)
+	if err != nil {
+		return err
+	}
+
+	name = strings.TrimSpace(name)
+	if len([]rune(name)) > tvSyncScreenNameMaxLength {
+		name = string([]rune(name)[:tvSyncScreenNameMaxLength])
+	}
+	mode = types.ParseTVSponsorBlockMode(string(mode))
+
+	err = s.db.UpdateYouTubeTVSyncPreferences(ctx, account.ID, name, string(mode))
+	if err != nil {
+		return err
+	}
+
+	if types.ParseTVSponsorBlockMode(account.SponsorBlockMode) != mode && s.worker(account.ID) != nil {
+		s.stopWorker(account.ID)
+		s.kickConnectionTick()
+	}
+	return nil
+}
+
 func (s *YouTubeTVSyncService) RunLifecycleTick(ctx context.Context) error {
-	accounts, err := s.db.ListEnabledYouTubeTVSyncAccounts(ctx, tvSyncMaxUsersPerTick)
+	accounts, err := s.db.ListEnabledYouTubeTVSyncAccounts(ctx, tvSyncMaxAccountsPerTick)
 	if err != nil {
 		return err
 	}
@@ -360,30 +631,36 @@ func (s *YouTubeTVSyncService) RunLifecycleTick(ctx context.Context) error {
 		return nil
 	}
 
+	// Screens of the same user share the activity lookup
+	userActivity := map[string]null.Time{}
 	inactiveCutoff := time.Now().UTC().AddDate(0, 0, -tvSyncUserInactiveDays)
 	for _, account := range accounts {
-		lastActivity, err := s.db.GetUserLastSessionActivity(ctx, account.UserID)
-		if err != nil && !database.IsErrNotFound(err) {
-			log.Warn().Err(err).Str("userID", account.UserID).Msg("failed to fetch session activity for tv sync")
-			continue
+		lastActivity, ok := userActivity[account.UserID]
+		if !ok {
+			lastActivity, err = s.db.GetUserLastSessionActivity(ctx, account.UserID)
+			if err != nil && !database.IsErrNotFound(err) {
+				log.Warn().Err(err).Str("userID", account.UserID).Msg("failed to fetch session activity for tv sync")
+				continue
+			}
+			userActivity[account.UserID] = lastActivity
 		}
 
 		shouldPause := !lastActivity.Valid || lastActivity.Time.Before(inactiveCutoff)
 		if shouldPause {
 			if account.ConnectionState != tvSyncStatePausedInactive {
-				_ = s.db.UpdateYouTubeTVSyncState(ctx, account.UserID, database.YouTubeTVSyncStateUpdate{
+				_ = s.db.UpdateYouTubeTVSyncState(ctx, account.ID, database.YouTubeTVSyncStateUpdate{
 					ConnectionState:  tvSyncStatePausedInactive,
 					StateReason:      "No recent login activity",
 					LastError:        "",
 					LastUserActivity: lastActivity,
 				})
 			}
-			s.stopWorker(account.UserID)
+			s.stopWorker(account.ID)
 			continue
 		}
 
 		if account.ConnectionState == tvSyncStatePausedInactive {
-			_ = s.db.UpdateYouTubeTVSyncState(ctx, account.UserID, database.YouTubeTVSyncStateUpdate{
+			_ = s.db.UpdateYouTubeTVSyncState(ctx, account.ID, database.YouTubeTVSyncStateUpdate{
 				ConnectionState:  tvSyncStateDisconnected,
 				StateReason:      "",
 				LastError:        "",
@@ -394,7 +671,7 @@ func (s *YouTubeTVSyncService) RunLifecycleTick(ctx context.Context) error {
 		}
 
 		if lastActivity.Valid {
-			_ = s.db.UpdateYouTubeTVSyncState(ctx, account.UserID, database.YouTubeTVSyncStateUpdate{
+			_ = s.db.UpdateYouTubeTVSyncState(ctx, account.ID, database.YouTubeTVSyncStateUpdate{
 				ConnectionState:  account.ConnectionState,
 				StateReason:      account.StateReason,
 				LastError:        account.LastError,
@@ -406,8 +683,22 @@ func (s *YouTubeTVSyncService) RunLifecycleTick(ctx context.Context) error {
 	return nil
 }
 
+func tvSyncLeaseName(accountID string) string {
+	return "tv_sync:" + accountID
+}
+
+/*
+RunConnectionTick starts a worker for every enabled screen and stops workers for screens that were removed or paused.
+With several Feedlr processes sharing a database, a worker is only started for screens this process holds the lease for.
+*/
 func (s *YouTubeTVSyncService) RunConnectionTick(ctx context.Context) error {
-	accounts, err := s.db.ListEnabledYouTubeTVSyncAccounts(ctx, tvSyncMaxUsersPerTick)
+	if s.stopped.Load() {
+		return nil
+	}
+	s.tickMu.Lock()
+	defer s.tickMu.Unlock()
+
+	accounts, err := s.db.ListEnabledYouTubeTVSyncAccounts(ctx, tvSyncMaxAccountsPerTick)
 	if err != nil {
 		return err
 	}
@@ -420,29 +711,23 @@ func (s *YouTubeTVSyncService) RunConnectionTick(ctx context.Context) error {
 		if account.ConnectionState == tvSyncStatePausedInactive {
 			continue
 		}
-		desired[account.UserID] = account
+		desired[account.ID] = account
 	}
 
 	var toStop []*tvSyncWorker
+	var toStart []*database.YouTubeTVSyncAccount
 	s.workersMu.Lock()
-	for userID, worker := range s.workers {
-		if _, ok := desired[userID]; ok {
+	for accountID, worker := range s.workers {
+		if _, ok := desired[accountID]; ok {
 			continue
 		}
 		toStop = append(toStop, worker)
-		delete(s.workers, userID)
+		delete(s.workers, accountID)
 	}
-
-	for userID := range desired {
-		if _, ok := s.workers[userID]; ok {
-			continue
+	for accountID, account := range desired {
+		if _, ok := s.workers[accountID]; !ok {
+			toStart = append(toStart, account)
 		}
-
-		workerCtx, cancel := context.WithCancel(context.Background())
-		worker := &tvSyncWorker{cancel: cancel, done: make(chan struct{})}
-		s.workers[userID] = worker
-
-		go s.runWorker(workerCtx, userID, worker)
 	}
 	s.workersMu.Unlock()
 
@@ -450,15 +735,68 @@ func (s *YouTubeTVSyncService) RunConnectionTick(ctx context.Context) error {
 		worker.cancel()
 	}
 
+	leases, withLeases := s.db.(database.LeasesClient)
+	for _, account := range toStart {
+		parent := context.Background()
+
+		var lease *Lease
+		if withLeases {
+			var ok bool
+			lease, ok, err = AcquireLease(ctx, leases, tvSyncLeaseName(account.ID), tvSyncLeaseTTL)
+			if err != nil {
+				log.Warn().Err(err).Str("accountID", account.ID).Msg("failed to acquire tv sync lease")
+				continue
+			}
+			if !ok {
+				// Another process runs this screen
+				continue
+			}
+			parent = lease.Context()
+		}
+
+		workerCtx, cancel := context.WithCancel(parent)
+		worker := &tvSyncWorker{userID: account.UserID, cancel: cancel, done: make(chan struct{}), lease: lease}
+
+		s.workersMu.Lock()
+		s.workers[account.ID] = worker
+		s.workersMu.Unlock()
+
+		go s.runWorker(workerCtx, account.ID, worker)
+	}
+
 	return nil
 }
 
-func (s *YouTubeTVSyncService) DecryptLoungeToken(account *database.YouTubeTVSyncAccount) ([]byte, error) {
-	if account.EncSecretHash != s.crypto.secretHash {
-		return nil, errors.New("lounge token secret mismatch")
+/*
+Stop ends all workers of this process and releases their leases, so other processes can take over the screens on their next connection tick.
+Each worker saves the last playback position it observed and marks its screen as disconnected before the lease is released.
+Workers are not started again after Stop.
+*/
+func (s *YouTubeTVSyncService) Stop(ctx context.Context) {
+	s.stopped.Store(true)
+	s.tickMu.Lock()
+	defer s.tickMu.Unlock()
+
+	s.workersMu.Lock()
+	workers := s.workers
+	s.workers = map[string]*tvSyncWorker{}
+	s.workersMu.Unlock()
+
+	for _, worker := range workers {
+		worker.cancel()
+	}
+	for accountID, worker := range workers {
+		select {
+		case <-worker.done:
+		case <-ctx.Done():
+			log.Warn().Str("accountID", accountID).Msg("tv sync worker did not stop in time")
+			return
+		}
 	}
+}
 
-	decrypted, err := s.crypto.Decrypt(account.LoungeTokenEnc, account.UserID)
+func (s *YouTubeTVSyncService) DecryptLoungeToken(account *database.YouTubeTVSyncAccount) ([]byte, error) {
+	decrypted, err := s.crypto.DecryptWithSecret(account.EncSecretHash, account.LoungeTokenEnc, account.UserID)
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to decrypt lounge token")
 	}
@@ -476,11 +814,74 @@ func (s *YouTubeTVSyncService) kickConnectionTick() {
 	}()
 }
 
-func (s *YouTubeTVSyncService) stopWorker(userID string) {
+func (s *YouTubeTVSyncService) worker(accountID string) *tvSyncWorker {
+	s.workersMu.Lock()
+	defer s.workersMu.Unlock()
+	return s.workers[accountID]
+}
+
+// userWorkers returns workers for all screens of a user, keyed by account ID
+func (s *YouTubeTVSyncService) userWorkers(userID string) map[string]*tvSyncWorker {
+	s.workersMu.Lock()
+	defer s.workersMu.Unlock()
+
+	workers := map[string]*tvSyncWorker{}
+	for accountID, worker := range s.workers {
+		if worker.userID == userID {
+			workers[accountID] = worker
+		}
+	}
+	return workers
+}
+
+/*
+SkipToNextChapter seeks the video currently playing on a TV to the start of the next chapter
+*/
+func (s *YouTubeTVSyncService) SkipToNextChapter(ctx context.Context, userID, accountID string) error {
+	session, runtime, err := s.activeSession(userID, accountID)
+	if err != nil {
+		return err
+	}
+
+	videoID, position, ok := runtime.currentPlaybackPosition(time.Now().UTC())
+	if !ok {
+		return ErrTVNotPlaying
+	}
+
+	chaptersDB, ok := s.db.(tvChaptersDB)
+	if !ok {
+		return errors.New("chapters are not available")
+	}
+	video, err := chaptersDB.GetVideoByID(ctx, videoID)
+	if err != nil {
+		return errors.Wrap(err, "failed to get video")
+	}
+	withSponsorChapters := runtime.sponsorEnabled && runtime.sponsorSettings.CategoryAction(sponsorblock.Chapter.Value) != types.SponsorBlockActionIgnore
+	chapters, err := GetVideoChapters(ctx, chaptersDB, types.VideoModelToProps(video, types.ChannelProps{}), withSponsorChapters)
+	if err != nil {
+		return err
+	}
+
+	start, ok := nextChapterStart(chapters, position)
+	if !ok {
+		return ErrNoNextChapter
+	}
+
+	err = s.lounge.SeekTo(ctx, session, float64(start))
+	metrics.ObserveTVSyncEvent("chapter_skip", err)
+	if err != nil {
+		return errors.Wrap(err, "failed to seek tv to next chapter")
+	}
+
+	log.Debug().Str("userID", userID).Str("videoID", videoID).Int("start", start).Msg("tv skipped to next chapter")
+	return nil
+}
+
+func (s *YouTubeTVSyncService) stopWorker(accountID string) {
 	s.workersMu.Lock()
-	worker, ok := s.workers[userID]
+	worker, ok := s.workers[accountID]
 	if ok {
-		delete(s.workers, userID)
+		delete(s.workers, accountID)
 	}
 	s.workersMu.Unlock()
 	if !ok {
@@ -494,9 +895,20 @@ func (s *YouTubeTVSyncService) stopWorker(userID string) {
 	}
 }
 
-func (s *YouTubeTVSyncService) runWorker(ctx context.Context, userID string, worker *tvSyncWorker) {
+func (s *YouTubeTVSyncService) runWorker(ctx context.Context, accountID string, worker *tvSyncWorker) {
 	defer close(worker.done)
-	defer s.unregisterWorker(userID, worker)
+	defer s.unregisterWorker(accountID, worker)
+	if worker.lease != nil {
+		defer worker.lease.Release()
+	}
+
+	// The final state is written while the lease is still held, so it cannot overwrite the state written by the next holder
+	var account *database.YouTubeTVSyncAccount
+	defer func() {
+		if ctx.Err() != nil && s.stopped.Load() {
+			s.persistStopped(account)
+		}
+	}()
 
 	backoff := s.reconnectMin
 	for {
@@ -506,21 +918,22 @@ func (s *YouTubeTVSyncService) runWorker(ctx context.Context, userID string, wor
 		default:
 		}
 
-		account, err := s.db.GetYouTubeTVSyncAccountByUserID(ctx, userID)
+		loaded, err := s.db.GetYouTubeTVSyncAccount(ctx, accountID)
 		if err != nil {
 			if database.IsErrNotFound(err) {
 				return
 			}
-			log.Warn().Err(err).Str("userID", userID).Msg("tv sync failed to load account")
+			log.Warn().Err(err).Str("userID", worker.userID).Str("accountID", accountID).Msg("tv sync failed to load account")
 			s.sleepWithContext(ctx, backoff)
 			backoff = min(backoff*2, s.reconnectMax)
 			continue
 		}
+		account = loaded
 		if !account.SyncEnabled || account.ConnectionState == tvSyncStatePausedInactive {
 			return
 		}
 
-		_ = s.db.UpdateYouTubeTVSyncState(ctx, userID, database.YouTubeTVSyncStateUpdate{
+		_ = s.db.UpdateYouTubeTVSyncState(ctx, accountID, database.YouTubeTVSyncStateUpdate{
 			ConnectionState: tvSyncStateConnecting,
 			StateReason:     tvSyncConnectionStateConnectMsg,
 			LastError:       "",
@@ -537,24 +950,66 @@ func (s *YouTubeTVSyncService) runWorker(ctx context.Context, userID string, wor
 			backoff = s.reconnectMin
 		} else {
 			backoff = min(backoff*2, s.reconnectMax)
-			s.recordReconnect(userID, err)
-			log.Warn().Err(err).Str("userID", userID).Msg("tv sync worker loop ended")
+			s.recordReconnect(account.UserID, accountID, err)
+			log.Warn().Err(err).Str("userID", account.UserID).Str("accountID", accountID).Msg("tv sync worker loop ended")
 		}
 
 		s.sleepWithContext(ctx, backoff)
 	}
 }
 
-func (s *YouTubeTVSyncService) unregisterWorker(userID string, worker *tvSyncWorker) {
+// persistStopped marks the screen of a worker stopped by Stop as disconnected, the next holder of the lease reconnects it
+func (s *YouTubeTVSyncService) persistStopped(account *database.YouTubeTVSyncAccount) {
+	if account == nil {
+		return
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), tvSyncStopWriteTimeout)
+	defer cancel()
+
+	err := s.db.UpdateYouTubeTVSyncState(ctx, account.ID, database.YouTubeTVSyncStateUpdate{
+		ConnectionState:  tvSyncStateDisconnected,
+		StateReason:      tvSyncStoppedReason,
+		LastError:        "",
+		LastDisconnectAt: null.TimeFrom(time.Now().UTC()),
+	})
+	if err != nil {
+		log.Warn().Err(err).Str("userID", account.UserID).Str("accountID", account.ID).Msg("failed to save tv sync state on stop")
+	}
+	s.recordDisconnect(account, tvSyncStateDisconnected, nil)
+	s.journal(ctx, account, database.YouTubeTVSyncEventDisconnect, "", tvSyncStoppedReason)
+}
+
+// flushProgress saves the position observed since the last throttled progress write, used when a worker is stopped mid-video
+func (s *YouTubeTVSyncService) flushProgress(account *database.YouTubeTVSyncAccount, runtime *tvSyncRuntime) {
+	videoID, position, ok := runtime.unsavedProgress(time.Now().UTC())
+	if !ok {
+		return
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), tvSyncStopWriteTimeout)
+	defer cancel()
+
+	observed := clampPlaybackSecond(position, 0, false)
+	resolved, err := UpdateViewProgress(ctx, s.db, account.UserID, videoID, observed)
+	if err != nil {
+		if !isUnknownVideoProgressError(err) {
+			log.Warn().Err(err).Str("userID", account.UserID).Str("videoID", videoID).Msg("failed to save tv progress on stop")
+		}
+		return
+	}
+	s.recordProgressUpdate(account.UserID, videoID, observed, resolved)
+	s.journal(ctx, account, database.YouTubeTVSyncEventProgress, videoID, fmt.Sprintf("Saved %s before stopping", formatTVSyncSeconds(float64(resolved))))
+}
+
+func (s *YouTubeTVSyncService) unregisterWorker(accountID string, worker *tvSyncWorker) {
 	s.workersMu.Lock()
 	defer s.workersMu.Unlock()
 
-	current, ok := s.workers[userID]
+	current, ok := s.workers[accountID]
 	if !ok {
 		return
 	}
 	if current == worker {
-		delete(s.workers, userID)
+		delete(s.workers, accountID)
 	}
 }
 
@@ -574,27 +1029,34 @@ func (s *YouTubeTVSyncService) connectAndRunOnce(ctx context.Context, account *d
 	session, account, err := s.connectSession(ctx, account)
 	if err != nil {
 		now := time.Now().UTC()
-		_ = s.db.UpdateYouTubeTVSyncState(ctx, account.UserID, database.YouTubeTVSyncStateUpdate{
+		_ = s.db.UpdateYouTubeTVSyncState(ctx, account.ID, database.YouTubeTVSyncStateUpdate{
 			ConnectionState:  tvSyncStateError,
 			StateReason:      "Could not connect to TV",
 			LastError:        sanitizeError(err),
 			LastDisconnectAt: null.TimeFrom(now),
 		})
+		s.journal(ctx, account, database.YouTubeTVSyncEventConnectFailed, "", sanitizeError(err))
 		return err
 	}
 
-	sponsorEnabled, sponsorCategories := s.loadSponsorPreferences(ctx, account.UserID)
-	runtime := newTVSyncRuntime(sponsorEnabled, sponsorCategories)
+	sponsorEnabled, sponsorSettings := s.loadSponsorPreferences(ctx, account)
+	runtime := newTVSyncRuntime(sponsorEnabled, sponsorSettings)
+
+	if worker := s.worker(account.ID); worker != nil {
+		worker.attach(session, runtime)
+		defer worker.detach(session)
+	}
 
 	now := time.Now().UTC()
-	_ = s.db.UpdateYouTubeTVSyncState(ctx, account.UserID, database.YouTubeTVSyncStateUpdate{
+	_ = s.db.UpdateYouTubeTVSyncState(ctx, account.ID, database.YouTubeTVSyncStateUpdate{
 		ConnectionState: tvSyncStateConnected,
 		StateReason:     "",
 		LastError:       "",
 		LastConnectedAt: null.TimeFrom(now),
 		LastEventAt:     null.TimeFrom(now),
 	})
-	s.recordConnect(account.UserID)
+	s.recordConnect(account)
+	s.journal(ctx, account, database.YouTubeTVSyncEventConnect, "", "")
 
 	subCtx, cancel := context.WithCancel(ctx)
 	defer cancel()
@@ -681,7 +1143,7 @@ func (s *YouTubeTVSyncService) connectAndRunOnce(ctx context.Context, account *d
 			if videoID := runtime.currentVideo(); videoID != "" {
 				update.LastVideoID = null.StringFrom(videoID)
 			}
-			_ = s.db.UpdateYouTubeTVSyncState(subCtx, account.UserID, update)
+			_ = s.db.UpdateYouTubeTVSyncState(subCtx, account.ID, update)
 		}
 
 		return s.processEvent(subCtx, account, session, runtime, event)
@@ -691,19 +1153,26 @@ func (s *YouTubeTVSyncService) connectAndRunOnce(ctx context.Context, account *d
 	<-watchdogDone
 	<-nowPlayingPollDone
 
+	// Runs for every way a session ends, including Stop and a lease handoff, the next session does not know the TV was muted
+	s.releaseSponsorMute(account, session, runtime)
+
 	if ctx.Err() != nil {
+		if s.stopped.Load() {
+			s.flushProgress(account, runtime)
+		}
 		return ctx.Err()
 	}
 
 	now = time.Now().UTC()
 	if timedOut.Load() {
-		_ = s.db.UpdateYouTubeTVSyncState(ctx, account.UserID, database.YouTubeTVSyncStateUpdate{
+		_ = s.db.UpdateYouTubeTVSyncState(ctx, account.ID, database.YouTubeTVSyncStateUpdate{
 			ConnectionState:  tvSyncStatePausedNoEvents,
 			StateReason:      tvSyncNoEventsReason,
 			LastError:        "",
 			LastDisconnectAt: null.TimeFrom(now),
 		})
-		s.recordDisconnect(account.UserID, tvSyncStatePausedNoEvents, nil)
+		s.recordDisconnect(account, tvSyncStatePausedNoEvents, nil)
+		s.journal(ctx, account, database.YouTubeTVSyncEventDisconnect, runtime.currentVideo(), tvSyncNoEventsReason)
 		return errors.New("tv sync subscription paused: no events")
 	}
 
@@ -712,23 +1181,25 @@ func (s *YouTubeTVSyncService) connectAndRunOnce(ctx context.Context, account *d
 			return ctx.Err()
 		}
 
-		_ = s.db.UpdateYouTubeTVSyncState(ctx, account.UserID, database.YouTubeTVSyncStateUpdate{
+		_ = s.db.UpdateYouTubeTVSyncState(ctx, account.ID, database.YouTubeTVSyncStateUpdate{
 			ConnectionState:  tvSyncStateDisconnected,
 			StateReason:      "Session ended",
 			LastError:        sanitizeError(subscribeErr),
 			LastDisconnectAt: null.TimeFrom(now),
 		})
-		s.recordDisconnect(account.UserID, tvSyncStateDisconnected, subscribeErr)
+		s.recordDisconnect(account, tvSyncStateDisconnected, subscribeErr)
+		s.journal(ctx, account, database.YouTubeTVSyncEventDisconnect, runtime.currentVideo(), "Session ended: "+sanitizeError(subscribeErr))
 		return subscribeErr
 	}
 
-	_ = s.db.UpdateYouTubeTVSyncState(ctx, account.UserID, database.YouTubeTVSyncStateUpdate{
+	_ = s.db.UpdateYouTubeTVSyncState(ctx, account.ID, database.YouTubeTVSyncStateUpdate{
 		ConnectionState:  tvSyncStateDisconnected,
 		StateReason:      "Session ended",
 		LastError:        "",
 		LastDisconnectAt: null.TimeFrom(now),
 	})
-	s.recordDisconnect(account.UserID, tvSyncStateDisconnected, nil)
+	s.recordDisconnect(account, tvSyncStateDisconnected, nil)
+	s.journal(ctx, account, database.YouTubeTVSyncEventDisconnect, runtime.currentVideo(), "Session ended")
 	return nil
 }
 
@@ -737,6 +1208,11 @@ func (s *YouTubeTVSyncService) connectSession(ctx context.Context, account *data
 	if err != nil {
 		return nil, account, err
 	}
+	if s.crypto.IsRetired(account.EncSecretHash) {
+		if _, err := reencryptLoungeToken(ctx, s.db, s.crypto, account); err != nil {
+			log.Warn().Err(err).Str("accountID", account.ID).Msg("failed to re-encrypt lounge token with the current secret")
+		}
+	}
 
 	session, err := s.lounge.Connect(ctx, account.ScreenID, string(token), tvSyncDeviceName)
 	if err == nil {
@@ -758,11 +1234,11 @@ func (s *YouTubeTVSyncService) connectSession(ctx context.Context, account *data
 	if refreshed.Name == "" {
 		refreshed.Name = account.ScreenName
 	}
-	if err := s.db.UpdateYouTubeTVSyncLoungeToken(ctx, account.UserID, refreshed.ID, refreshed.Name, encrypted, s.crypto.secretHash); err != nil {
+	if err := s.db.UpdateYouTubeTVSyncLoungeToken(ctx, account.ID, refreshed.ID, refreshed.Name, encrypted, s.crypto.secretHash); err != nil {
 		return nil, account, errors.Wrap(err, "failed to persist refreshed lounge token")
 	}
 
-	account, err = s.db.GetYouTubeTVSyncAccountByUserID(ctx, account.UserID)
+	account, err = s.db.GetYouTubeTVSyncAccount(ctx, account.ID)
 	if err != nil {
 		return nil, account, err
 	}
@@ -804,7 +1280,8 @@ func (s *YouTubeTVSyncService) processEvent(ctx context.Context, account *databa
 
 	isNewVideo := runtime.setCurrentVideo(videoID)
 	if isNewVideo {
-		_ = s.db.UpdateYouTubeTVSyncState(ctx, account.UserID, database.YouTubeTVSyncStateUpdate{
+		runtime.noteVideoStarted(videoID)
+		_ = s.db.UpdateYouTubeTVSyncState(ctx, account.ID, database.YouTubeTVSyncStateUpdate{
 			ConnectionState: tvSyncStateConnected,
 			StateReason:     "",
 			LastError:       "",
@@ -818,6 +1295,7 @@ func (s *YouTubeTVSyncService) processEvent(ctx context.Context, account *databa
 	}
 
 	videoRuntime := runtime.videoRuntime(videoID)
+	previousState := videoRuntime.lastState
 	now := time.Now().UTC()
 	state := strings.TrimSpace(playback.State)
 	if state != "" {
@@ -833,6 +1311,7 @@ func (s *YouTubeTVSyncService) processEvent(ctx context.Context, account *databa
 				log.Debug().Err(err).Str("userID", account.UserID).Str("videoID", videoID).Msg("failed to request nowPlaying after reconnect resume suppression")
 			}
 			log.Debug().Str("userID", account.UserID).Str("videoID", videoID).Msg("suppressed tv resume seek after recent reconnect")
+			s.journal(ctx, account, database.YouTubeTVSyncEventResumeSuppressed, videoID, fmt.Sprintf("Kept the TV at %s after a reconnect", formatTVSyncSeconds(playback.CurrentTime)))
 			// Ignore the potentially stale event that triggered suppression.
 			return nil
 		}
@@ -844,6 +1323,7 @@ func (s *YouTubeTVSyncService) processEvent(ctx context.Context, account *databa
 			} else {
 				videoRuntime.lastSponsorSkipAt = now
 				log.Debug().Str("userID", account.UserID).Str("videoID", videoID).Int("saved_progress", saved).Msg("applied tv resume seek from app state")
+				s.journal(ctx, account, database.YouTubeTVSyncEventResumeSeek, videoID, fmt.Sprintf("Seeked from %s to %s", formatTVSyncSeconds(playback.CurrentTime), formatTVSyncSeconds(float64(saved))))
 			}
 			runtime.markResumeAppliedForCurrentVideo()
 			if state != "" {
@@ -858,11 +1338,12 @@ func (s *YouTubeTVSyncService) processEvent(ctx context.Context, account *databa
 	observedSecond := 0
 	if playback.HasCurrentTime {
 		observedSecond = clampPlaybackSecond(playback.CurrentTime, playback.Duration, playback.HasDuration)
+		runtime.setCurrentPlaybackTime(playback.CurrentTime, now)
 	}
 
 	if playback.HasCurrentTime {
 		if runtime.sponsorEnabled {
-			s.processSponsorSkip(ctx, account.UserID, videoID, videoRuntime, playback, session, now, runtime.sponsorCategories)
+			s.processSponsorSkip(ctx, account, videoID, runtime, videoRuntime, playback, session, now)
 		}
 
 		if shouldWriteProgress(playback.State, now, videoRuntime.lastProgressWrite) {
@@ -876,6 +1357,10 @@ func (s *YouTubeTVSyncService) processEvent(ctx context.Context, account *databa
 			} else {
 				s.recordProgressUpdate(account.UserID, videoID, observedSecond, resolved)
 				videoRuntime.lastProgressWrite = now
+				if now.Sub(videoRuntime.lastProgressJournal) >= tvSyncJournalProgressInterval {
+					videoRuntime.lastProgressJournal = now
+					s.journal(ctx, account, database.YouTubeTVSyncEventProgress, videoID, fmt.Sprintf("Saved %s, TV reported %s", formatTVSyncSeconds(float64(resolved)), formatTVSyncSeconds(float64(observedSecond))))
+				}
 				if cleanupDB, ok := s.db.(watchLaterCleanupDB); ok {
 					_ = RemoveFromWatchLaterIfFullyWatched(ctx, cleanupDB, account.UserID, videoID, resolved)
 				}
@@ -887,6 +1372,9 @@ func (s *YouTubeTVSyncService) processEvent(ctx context.Context, account *databa
 	}
 	if state == "0" {
 		runtime.clearCurrentVideo()
+		if playbackEndedNaturally(previousState, playback) {
+			s.handlePlaybackEnded(ctx, account, session, runtime, videoID, now)
+		}
 	}
 	return nil
 }
@@ -977,23 +1465,26 @@ func isUnknownVideoProgressError(err error) bool {
 	return strings.Contains(strings.ToLower(err.Error()), "foreign key constraint failed")
 }
 
-func (s *YouTubeTVSyncService) processSponsorSkip(ctx context.Context, userID, videoID string, state *tvSyncVideoRuntime, playback lounge.PlaybackEvent, session *lounge.Session, now time.Time, categories []sponsorblock.Category) {
+func (s *YouTubeTVSyncService) processSponsorSkip(ctx context.Context, account *database.YouTubeTVSyncAccount, videoID string, runtime *tvSyncRuntime, state *tvSyncVideoRuntime, playback lounge.PlaybackEvent, session *lounge.Session, now time.Time) {
 	if !state.sponsorLoaded {
 		state.sponsorLoaded = true
-		state.sponsorSegments = s.loadSponsorSegments(videoID, categories)
+		state.sponsorSegments = s.loadSponsorSegments(ctx, videoID, runtime.sponsorSettings)
 	}
-	if len(state.sponsorSegments) == 0 {
+	if !playback.HasCurrentTime {
 		return
 	}
-	if now.Sub(state.lastSponsorSkipAt) < tvSyncSkipCooldown {
+	// Runs before the empty check so the TV is unmuted when the next video has no segments
+	s.processSponsorMute(ctx, account.UserID, videoID, runtime, state, playback, session)
+
+	if len(state.sponsorSegments) == 0 {
 		return
 	}
-	if !playback.HasCurrentTime {
+	if now.Sub(state.lastSponsorSkipAt) < tvSyncSkipCooldown {
 		return
 	}
 
 	for idx, segment := range state.sponsorSegments {
-		if state.skippedSegments[idx] {
+		if segment.Action != types.SponsorBlockActionSkip || state.skippedSegments[idx] {
 			continue
 		}
 		if playback.CurrentTime < segment.Start || playback.CurrentTime >= segment.End {
@@ -1001,30 +1492,106 @@ func (s *YouTubeTVSyncService) processSponsorSkip(ctx context.Context, userID, v
 		}
 
 		if err := s.lounge.SeekTo(ctx, session, segment.End); err != nil {
-			log.Debug().Err(err).Str("userID", userID).Str("videoID", videoID).Msg("failed to skip sponsor segment")
+			log.Debug().Err(err).Str("userID", account.UserID).Str("videoID", videoID).Msg("failed to skip sponsor segment")
 			return
 		}
 
 		state.skippedSegments[idx] = true
 		state.lastSponsorSkipAt = now
-		s.recordSponsorSkip(userID, videoID, segment.Start, segment.End)
+		s.recordSponsorSkip(account.UserID, videoID, segment.Start, segment.End)
+		s.journal(ctx, account, database.YouTubeTVSyncEventSponsorSkip, videoID, fmt.Sprintf("Skipped %s to %s", formatTVSyncSeconds(segment.Start), formatTVSyncSeconds(segment.End)))
 		return
 	}
 }
 
-func (s *YouTubeTVSyncService) loadSponsorSegments(videoID string, categories []sponsorblock.Category) []tvSyncSegment {
-	if len(categories) == 0 {
-		return nil
+func (s *YouTubeTVSyncService) processSponsorMute(ctx context.Context, userID, videoID string, runtime *tvSyncRuntime, state *tvSyncVideoRuntime, playback lounge.PlaybackEvent, session *lounge.Session) {
+	inside := slices.ContainsFunc(state.sponsorSegments, func(segment tvSyncSegment) bool {
+		return segment.Action == types.SponsorBlockActionMute && playback.CurrentTime >= segment.Start && playback.CurrentTime < segment.End
+	})
+	if inside == runtime.isSponsorMuted() {
+		return
+	}
+
+	var err error
+	if inside {
+		err = s.lounge.Mute(ctx, session)
+		metrics.ObserveTVSyncEvent("sponsor_mute", err)
+	} else {
+		err = s.lounge.Unmute(ctx, session)
+		metrics.ObserveTVSyncEvent("sponsor_unmute", err)
+	}
+	if err != nil {
+		log.Debug().Err(err).Str("userID", userID).Str("videoID", videoID).Bool("mute", inside).Msg("failed to toggle tv mute for sponsor segment")
+		return
+	}
+	runtime.setSponsorMuted(inside)
+}
+
+// releaseSponsorMute unmutes a TV that is still muted for a sponsor segment when its session ends, the session can already be gone so this is best effort
+func (s *YouTubeTVSyncService) releaseSponsorMute(account *database.YouTubeTVSyncAccount, session *lounge.Session, runtime *tvSyncRuntime) {
+	if !runtime.isSponsorMuted() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), tvSyncStopWriteTimeout)
+	defer cancel()
+
+	err := s.lounge.Unmute(ctx, session)
+	metrics.ObserveTVSyncEvent("sponsor_unmute", err)
+	if err != nil {
+		log.Debug().Err(err).Str("userID", account.UserID).Str("accountID", account.ID).Msg("failed to unmute tv after the session ended")
+		return
+	}
+	runtime.setSponsorMuted(false)
+}
+
+func (s *YouTubeTVSyncService) loadSponsorSegments(ctx context.Context, videoID string, settings types.SponsorBlockSettingsProps) []tvSyncSegment {
+	if segmentsDB, ok := s.db.(database.SponsorBlockSegmentsClient); ok {
+		raw, err := GetSponsorBlockSegments(ctx, segmentsDB, videoID)
+		if err != nil {
+			return nil
+		}
+		return tvSyncSponsorSegments(settings, raw)
 	}
+
 	if sponsorblock.C == nil {
 		return nil
 	}
-
-	raw, err := sponsorblock.C.GetVideoSegments(videoID, categories...)
+	lctx, cancel := context.WithTimeout(ctx, sponsorSegmentsLookupTimeout)
+	defer cancel()
+	raw, err := sponsorblock.C.LookupSegments(lctx, videoID, cachedSponsorCategories, cachedSponsorActionTypes)
 	if err != nil {
 		return nil
 	}
-	return normalizeSponsorSegments(raw)
+	return tvSyncSponsorSegments(settings, raw)
+}
+
+/*
+tvSyncSponsorSegments resolves what to do with each segment based on user settings.
+Skips and mutes are merged separately, a skip wins when both overlap. The TV has no skip button, so those segments are left alone.
+*/
+func tvSyncSponsorSegments(settings types.SponsorBlockSettingsProps, segments []sponsorblock.Segment) []tvSyncSegment {
+	var skips, mutes []sponsorblock.Segment
+	for _, segment := range segments {
+		if segment.ActionType == sponsorblock.ActionTypePOI {
+			continue
+		}
+		switch settings.SegmentAction(segment) {
+		case types.SponsorBlockActionSkip:
+			skips = append(skips, segment)
+		case types.SponsorBlockActionMute:
+			mutes = append(mutes, segment)
+		}
+	}
+
+	resolved := normalizeSponsorSegments(skips)
+	for _, segment := range normalizeSponsorSegments(mutes) {
+		segment.Action = types.SponsorBlockActionMute
+		resolved = append(resolved, segment)
+	}
+	sort.SliceStable(resolved, func(i, j int) bool {
+		return resolved[i].Start < resolved[j].Start
+	})
+	return resolved
 }
 
 func normalizeSponsorSegments(segments []sponsorblock.Segment) []tvSyncSegment {
@@ -1041,7 +1608,7 @@ func normalizeSponsorSegments(segments []sponsorblock.Segment) []tvSyncSegment {
 		if end-start < tvSyncMinSkipLengthSec {
 			continue
 		}
-		normalized = append(normalized, tvSyncSegment{Start: start, End: end})
+		normalized = append(normalized, tvSyncSegment{Start: start, End: end, Action: types.SponsorBlockActionSkip})
 	}
 	if len(normalized) == 0 {
 		return nil
@@ -1070,50 +1637,46 @@ func normalizeSponsorSegments(segments []sponsorblock.Segment) []tvSyncSegment {
 	return merged
 }
 
-func (s *YouTubeTVSyncService) loadSponsorPreferences(ctx context.Context, userID string) (bool, []sponsorblock.Category) {
-	settings, err := GetUserSettings(ctx, s.db, userID)
-	if err != nil {
-		log.Debug().Err(err).Str("userID", userID).Msg("failed to load settings for sponsor preferences")
+// loadSponsorPreferences returns the user SponsorBlock settings with the screen override applied
+func (s *YouTubeTVSyncService) loadSponsorPreferences(ctx context.Context, account *database.YouTubeTVSyncAccount) (bool, *types.SponsorBlockSettingsProps) {
+	mode := types.ParseTVSponsorBlockMode(account.SponsorBlockMode)
+	if mode == types.TVSponsorBlockDisabled {
 		return false, nil
 	}
-	if !settings.SponsorBlock.SponsorBlockEnabled {
+
+	settings, err := GetUserSettings(ctx, s.db, account.UserID)
+	if err != nil {
+		log.Debug().Err(err).Str("userID", account.UserID).Msg("failed to load settings for sponsor preferences")
 		return false, nil
 	}
-
-	categories := make([]sponsorblock.Category, 0, len(settings.SponsorBlock.SelectedSponsorBlockCategories))
-	for _, value := range settings.SponsorBlock.SelectedSponsorBlockCategories {
-		idx := slices.IndexFunc(sponsorblock.AvailableCategories, func(category sponsorblock.Category) bool {
-			return category.Value == value
-		})
-		if idx < 0 {
-			continue
-		}
-		categories = append(categories, sponsorblock.AvailableCategories[idx])
+	if !settings.SponsorBlock.SponsorBlockEnabled && mode != types.TVSponsorBlockEnabled {
+		return false, nil
 	}
-
-	return true, categories
+	return true, &settings.SponsorBlock
 }
 
-func (s *YouTubeTVSyncService) recordConnect(userID string) {
+func (s *YouTubeTVSyncService) recordConnect(account *database.YouTubeTVSyncAccount) {
 	if s.metrics == nil {
 		return
 	}
 	total := s.metrics.connects.Add(1)
 	metrics.ObserveTVSyncEvent("connect", nil)
 	log.Info().
-		Str("userID", userID).
+		Str("userID", account.UserID).
+		Str("accountID", account.ID).
 		Uint64("connect_total", total).
 		Msg("tv sync connected")
 }
 
-func (s *YouTubeTVSyncService) recordDisconnect(userID, state string, err error) {
+func (s *YouTubeTVSyncService) recordDisconnect(account *database.YouTubeTVSyncAccount, state string, err error) {
 	if s.metrics == nil {
 		return
 	}
 	total := s.metrics.disconnects.Add(1)
 	metrics.ObserveTVSyncEvent("disconnect_"+state, err)
 	entry := log.Info().
-		Str("userID", userID).
+		Str("userID", account.UserID).
+		Str("accountID", account.ID).
 		Str("state", state).
 		Uint64("disconnect_total", total)
 	if err != nil {
@@ -1122,7 +1685,7 @@ func (s *YouTubeTVSyncService) recordDisconnect(userID, state string, err error)
 	entry.Msg("tv sync disconnected")
 }
 
-func (s *YouTubeTVSyncService) recordReconnect(userID string, err error) {
+func (s *YouTubeTVSyncService) recordReconnect(userID, accountID string, err error) {
 	if s.metrics == nil {
 		return
 	}
@@ -1130,6 +1693,7 @@ func (s *YouTubeTVSyncService) recordReconnect(userID string, err error) {
 	metrics.ObserveTVSyncEvent("reconnect", err)
 	log.Info().
 		Str("userID", userID).
+		Str("accountID", accountID).
 		Str("reason", sanitizeError(err)).
 		Uint64("reconnect_total", total).
 		Msg("tv sync reconnect scheduled")
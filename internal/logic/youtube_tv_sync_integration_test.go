@@ -1,6 +1,7 @@
 package logic
 
 import (
+	"bytes"
 	"context"
 	"database/sql"
 	"encoding/json"
@@ -17,128 +18,255 @@ import (
 	"github.com/cufee/feedlr-yt/internal/api/youtube/lounge"
 	"github.com/cufee/feedlr-yt/internal/database"
 	"github.com/cufee/feedlr-yt/internal/database/models"
+	"github.com/cufee/feedlr-yt/internal/types"
+	"github.com/pkg/errors"
 )
 
 type mockTVSyncStore struct {
 	mu sync.Mutex
 
-	account  *database.YouTubeTVSyncAccount
+	accounts []*database.YouTubeTVSyncAccount
+	events   []*database.YouTubeTVSyncEvent
 	settings *models.Setting
+	views    []*models.View
+	// upserted records written views, views returned by GetUserViews are not changed by writes
+	upserted []*models.View
 }
 
-func (m *mockTVSyncStore) GetYouTubeTVSyncAccountByUserID(_ context.Context, userID string) (*database.YouTubeTVSyncAccount, error) {
+func copyTVSyncAccount(account *database.YouTubeTVSyncAccount) *database.YouTubeTVSyncAccount {
+	cp := *account
+	cp.LoungeTokenEnc = append([]byte(nil), account.LoungeTokenEnc...)
+	return &cp
+}
+
+// find must be called with the lock held
+func (m *mockTVSyncStore) find(id string) *database.YouTubeTVSyncAccount {
+	for _, account := range m.accounts {
+		if account.ID == id {
+			return account
+		}
+	}
+	return nil
+}
+
+func (m *mockTVSyncStore) GetYouTubeTVSyncAccount(_ context.Context, id string) (*database.YouTubeTVSyncAccount, error) {
 	m.mu.Lock()
 	defer m.mu.Unlock()
-	if m.account == nil || m.account.UserID != userID {
+	account := m.find(id)
+	if account == nil {
 		return nil, sql.ErrNoRows
 	}
-	cp := *m.account
-	cp.LoungeTokenEnc = append([]byte(nil), m.account.LoungeTokenEnc...)
-	return &cp, nil
+	return copyTVSyncAccount(account), nil
+}
+
+func (m *mockTVSyncStore) ListUserYouTubeTVSyncAccounts(_ context.Context, userID string) ([]*database.YouTubeTVSyncAccount, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	var accounts []*database.YouTubeTVSyncAccount
+	for _, account := range m.accounts {
+		if account.UserID == userID {
+			accounts = append(accounts, copyTVSyncAccount(account))
+		}
+	}
+	return accounts, nil
 }
 
 func (m *mockTVSyncStore) UpsertYouTubeTVSyncCredentials(_ context.Context, userID, screenID, screenName string, loungeTokenEnc []byte, secretHash string) error {
 	m.mu.Lock()
 	defer m.mu.Unlock()
-	m.account = &database.YouTubeTVSyncAccount{
-		UserID:          userID,
-		ScreenID:        screenID,
-		ScreenName:      screenName,
-		LoungeTokenEnc:  append([]byte(nil), loungeTokenEnc...),
-		EncSecretHash:   secretHash,
-		SyncEnabled:     true,
-		ConnectionState: tvSyncStateDisconnected,
+	for _, account := range m.accounts {
+		if account.UserID == userID && account.ScreenID == screenID {
+			account.ScreenName = screenName
+			account.LoungeTokenEnc = append([]byte(nil), loungeTokenEnc...)
+			account.EncSecretHash = secretHash
+			account.SyncEnabled = true
+			account.ConnectionState = tvSyncStateDisconnected
+			return nil
+		}
 	}
+	m.accounts = append(m.accounts, &database.YouTubeTVSyncAccount{
+		ID:               fmt.Sprintf("account-%d", len(m.accounts)+1),
+		UserID:           userID,
+		ScreenID:         screenID,
+		ScreenName:       screenName,
+		LoungeTokenEnc:   append([]byte(nil), loungeTokenEnc...),
+		EncSecretHash:    secretHash,
+		SyncEnabled:      true,
+		ConnectionState:  tvSyncStateDisconnected,
+		SponsorBlockMode: string(types.TVSponsorBlockInherit),
+	})
 	return nil
 }
 
-func (m *mockTVSyncStore) UpdateYouTubeTVSyncLoungeToken(_ context.Context, userID, screenID, screenName string, loungeTokenEnc []byte, secretHash string) error {
+func (m *mockTVSyncStore) UpdateYouTubeTVSyncLoungeToken(_ context.Context, id, screenID, screenName string, loungeTokenEnc []byte, secretHash string) error {
 	m.mu.Lock()
 	defer m.mu.Unlock()
-	if m.account == nil || m.account.UserID != userID {
+	account := m.find(id)
+	if account == nil {
 		return sql.ErrNoRows
 	}
-	m.account.ScreenID = screenID
-	m.account.ScreenName = screenName
-	m.account.LoungeTokenEnc = append([]byte(nil), loungeTokenEnc...)
-	m.account.EncSecretHash = secretHash
+	account.ScreenID = screenID
+	account.ScreenName = screenName
+	account.LoungeTokenEnc = append([]byte(nil), loungeTokenEnc...)
+	account.EncSecretHash = secretHash
 	return nil
 }
 
-func (m *mockTVSyncStore) SetYouTubeTVSyncAccountEnabled(_ context.Context, userID string, enabled bool) error {
+func (m *mockTVSyncStore) ListYouTubeTVSyncAccountsBySecretHash(_ context.Context, secretHash string) ([]*database.YouTubeTVSyncAccount, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	var out []*database.YouTubeTVSyncAccount
+	for _, account := range m.accounts {
+		if account.EncSecretHash == secretHash {
+			out = append(out, copyTVSyncAccount(account))
+		}
+	}
+	return out, nil
+}
+
+func (m *mockTVSyncStore) ReplaceYouTubeTVSyncLoungeToken(_ context.Context, id string, previous, loungeTokenEnc []byte, secretHash string) (bool, error) {
 	m.mu.Lock()
 	defer m.mu.Unlock()
-	if m.account == nil || m.account.UserID != userID {
+	account := m.find(id)
+	if account == nil || !bytes.Equal(account.LoungeTokenEnc, previous) {
+		return false, nil
+	}
+	account.LoungeTokenEnc = append([]byte(nil), loungeTokenEnc...)
+	account.EncSecretHash = secretHash
+	return true, nil
+}
+
+func (m *mockTVSyncStore) SetYouTubeTVSyncAccountEnabled(_ context.Context, id string, enabled bool) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	account := m.find(id)
+	if account == nil {
 		return sql.ErrNoRows
 	}
-	m.account.SyncEnabled = enabled
+	account.SyncEnabled = enabled
 	return nil
 }
 
-func (m *mockTVSyncStore) DeleteYouTubeTVSyncAccount(_ context.Context, userID string) error {
+func (m *mockTVSyncStore) UpdateYouTubeTVSyncPreferences(_ context.Context, id, displayName, sponsorBlockMode string) error {
 	m.mu.Lock()
 	defer m.mu.Unlock()
-	if m.account == nil || m.account.UserID != userID {
+	account := m.find(id)
+	if account == nil {
 		return sql.ErrNoRows
 	}
-	m.account = nil
+	account.DisplayName = displayName
+	account.SponsorBlockMode = sponsorBlockMode
 	return nil
 }
 
+func (m *mockTVSyncStore) DeleteYouTubeTVSyncAccount(_ context.Context, id string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for i, account := range m.accounts {
+		if account.ID == id {
+			m.accounts = append(m.accounts[:i], m.accounts[i+1:]...)
+			return nil
+		}
+	}
+	return sql.ErrNoRows
+}
+
 func (m *mockTVSyncStore) ListEnabledYouTubeTVSyncAccounts(_ context.Context, _ int) ([]*database.YouTubeTVSyncAccount, error) {
 	m.mu.Lock()
 	defer m.mu.Unlock()
-	if m.account == nil || !m.account.SyncEnabled {
-		return nil, nil
+	var accounts []*database.YouTubeTVSyncAccount
+	for _, account := range m.accounts {
+		if account.SyncEnabled {
+			accounts = append(accounts, copyTVSyncAccount(account))
+		}
 	}
-	cp := *m.account
-	cp.LoungeTokenEnc = append([]byte(nil), m.account.LoungeTokenEnc...)
-	return []*database.YouTubeTVSyncAccount{&cp}, nil
+	return accounts, nil
 }
 
-func (m *mockTVSyncStore) UpdateYouTubeTVSyncState(_ context.Context, userID string, update database.YouTubeTVSyncStateUpdate) error {
+func (m *mockTVSyncStore) UpdateYouTubeTVSyncState(_ context.Context, id string, update database.YouTubeTVSyncStateUpdate) error {
 	m.mu.Lock()
 	defer m.mu.Unlock()
-	if m.account == nil || m.account.UserID != userID {
+	account := m.find(id)
+	if account == nil {
 		return sql.ErrNoRows
 	}
-	m.account.ConnectionState = update.ConnectionState
-	m.account.StateReason = update.StateReason
-	m.account.LastError = update.LastError
+	account.ConnectionState = update.ConnectionState
+	account.StateReason = update.StateReason
+	account.LastError = update.LastError
 	if update.LastConnectedAt.Valid {
-		m.account.LastConnectedAt = update.LastConnectedAt
+		account.LastConnectedAt = update.LastConnectedAt
 	}
 	if update.LastEventAt.Valid {
-		m.account.LastEventAt = update.LastEventAt
+		account.LastEventAt = update.LastEventAt
 	}
 	if update.LastDisconnectAt.Valid {
-		m.account.LastDisconnectAt = update.LastDisconnectAt
+		account.LastDisconnectAt = update.LastDisconnectAt
 	}
 	if update.LastUserActivity.Valid {
-		m.account.LastUserActivity = update.LastUserActivity
+		account.LastUserActivity = update.LastUserActivity
 	}
 	if update.LastVideoID.Valid {
-		m.account.LastVideoID = update.LastVideoID
+		account.LastVideoID = update.LastVideoID
 	}
 	return nil
 }
 
+func (m *mockTVSyncStore) CreateYouTubeTVSyncEvent(_ context.Context, event *database.YouTubeTVSyncEvent) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	cp := *event
+	m.events = append([]*database.YouTubeTVSyncEvent{&cp}, m.events...)
+	return nil
+}
+
+func (m *mockTVSyncStore) ListYouTubeTVSyncEvents(_ context.Context, accountID string, limit int) ([]*database.YouTubeTVSyncEvent, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	var events []*database.YouTubeTVSyncEvent
+	for _, event := range m.events {
+		if event.AccountID == accountID && len(events) < limit {
+			events = append(events, event)
+		}
+	}
+	return events, nil
+}
+
+func (m *mockTVSyncStore) ListRecentYouTubeTVSyncEvents(_ context.Context, limit int) ([]*database.YouTubeTVSyncEvent, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.events[:min(limit, len(m.events))], nil
+}
+
+func (m *mockTVSyncStore) PruneYouTubeTVSyncEvents(_ context.Context, _ time.Time, _ int) (int64, error) {
+	return 0, nil
+}
+
 func (m *mockTVSyncStore) GetUserLastSessionActivity(_ context.Context, _ string) (null.Time, error) {
 	return null.Time{}, sql.ErrNoRows
 }
 
 func (m *mockTVSyncStore) GetUserViews(_ context.Context, _ string, _ ...string) ([]*models.View, error) {
-	return nil, nil
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.views, nil
 }
 
 func (m *mockTVSyncStore) GetRecentUserViews(_ context.Context, _ string, _ int) ([]*models.View, error) {
 	return nil, nil
 }
 
-func (m *mockTVSyncStore) UpsertView(_ context.Context, _ *models.View) error {
+func (m *mockTVSyncStore) UpsertView(_ context.Context, view *models.View) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	cp := *view
+	m.upserted = append(m.upserted, &cp)
 	return nil
 }
 
+func (m *mockTVSyncStore) InsertViews(_ context.Context, _ ...*models.View) (int, error) {
+	return 0, nil
+}
+
 func (m *mockTVSyncStore) GetUserSettings(_ context.Context, _ string) (*models.Setting, error) {
 	m.mu.Lock()
 	defer m.mu.Unlock()
@@ -161,6 +289,7 @@ func (m *mockTVSyncStore) UpsertSettings(_ context.Context, settings *models.Set
 
 func TestConnectAndRunOnce_RefreshReconnectAndNoEventPause(t *testing.T) {
 	const userID = "user-1"
+	const accountID = "account-1"
 	const screenID = "screen-1"
 
 	secret := "test-tv-sync-secret"
@@ -172,7 +301,8 @@ func TestConnectAndRunOnce_RefreshReconnectAndNoEventPause(t *testing.T) {
 	}
 
 	store := &mockTVSyncStore{
-		account: &database.YouTubeTVSyncAccount{
+		accounts: []*database.YouTubeTVSyncAccount{{
+			ID:              "account-1",
 			UserID:          userID,
 			ScreenID:        screenID,
 			ScreenName:      "Living Room",
@@ -180,7 +310,7 @@ func TestConnectAndRunOnce_RefreshReconnectAndNoEventPause(t *testing.T) {
 			EncSecretHash:   crypto.secretHash,
 			SyncEnabled:     true,
 			ConnectionState: tvSyncStateDisconnected,
-		},
+		}},
 	}
 
 	var connectCalls atomic.Uint64
@@ -237,7 +367,7 @@ func TestConnectAndRunOnce_RefreshReconnectAndNoEventPause(t *testing.T) {
 		workers:              map[string]*tvSyncWorker{},
 	}
 
-	account, err := store.GetYouTubeTVSyncAccountByUserID(context.Background(), userID)
+	account, err := store.GetYouTubeTVSyncAccount(context.Background(), accountID)
 	if err != nil {
 		t.Fatalf("load account: %v", err)
 	}
@@ -249,7 +379,7 @@ func TestConnectAndRunOnce_RefreshReconnectAndNoEventPause(t *testing.T) {
 		t.Fatalf("expected no events error, got %v", err)
 	}
 
-	finalAccount, err := store.GetYouTubeTVSyncAccountByUserID(context.Background(), userID)
+	finalAccount, err := store.GetYouTubeTVSyncAccount(context.Background(), accountID)
 	if err != nil {
 		t.Fatalf("load final account: %v", err)
 	}
@@ -281,19 +411,21 @@ func TestConnectAndRunOnce_RefreshReconnectAndNoEventPause(t *testing.T) {
 
 func TestConnectAndRunOnce_RefreshEndpointMalformed(t *testing.T) {
 	const userID = "user-2"
+	const accountID = "account-2"
 	secret := "test-tv-sync-secret"
 	crypto := newYouTubeSyncCrypto(secret)
 	encryptedToken, _ := crypto.Encrypt([]byte("expired-token"), userID)
 
 	store := &mockTVSyncStore{
-		account: &database.YouTubeTVSyncAccount{
+		accounts: []*database.YouTubeTVSyncAccount{{
+			ID:             "account-2",
 			UserID:         userID,
 			ScreenID:       "screen-2",
 			ScreenName:     "Bedroom",
 			LoungeTokenEnc: encryptedToken,
 			EncSecretHash:  crypto.secretHash,
 			SyncEnabled:    true,
-		},
+		}},
 	}
 
 	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
@@ -321,14 +453,227 @@ func TestConnectAndRunOnce_RefreshEndpointMalformed(t *testing.T) {
 		workers: map[string]*tvSyncWorker{},
 	}
 
-	account, _ := store.GetYouTubeTVSyncAccountByUserID(context.Background(), userID)
+	account, _ := store.GetYouTubeTVSyncAccount(context.Background(), accountID)
 	err := service.connectAndRunOnce(context.Background(), account)
 	if err == nil {
 		t.Fatal("expected connectAndRunOnce to fail on malformed refresh response")
 	}
 
-	finalAccount, _ := store.GetYouTubeTVSyncAccountByUserID(context.Background(), userID)
+	finalAccount, _ := store.GetYouTubeTVSyncAccount(context.Background(), accountID)
 	if finalAccount.ConnectionState != tvSyncStateError {
 		t.Fatalf("expected error state, got %s", finalAccount.ConnectionState)
 	}
 }
+
+func TestProcessSponsorSkip_MutesTV(t *testing.T) {
+	var mu sync.Mutex
+	var commands []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/lounge/bc/bind" || r.Method != http.MethodPost {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		_ = r.ParseForm()
+		mu.Lock()
+		commands = append(commands, r.FormValue("req0__sc"))
+		mu.Unlock()
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	store := &mockTVSyncStore{}
+	service := &YouTubeTVSyncService{
+		db:      store,
+		lounge:  lounge.NewClientWithBaseURL(server.Client(), server.URL+"/api/lounge"),
+		metrics: &tvSyncMetrics{},
+		workers: map[string]*tvSyncWorker{},
+	}
+	session := &lounge.Session{SID: "sid-1", GSessionID: "gs-1"}
+	runtime := newTVSyncRuntime(true, &types.SponsorBlockSettingsProps{})
+	state := runtime.videoRuntime("video-a")
+	state.sponsorLoaded = true
+	state.sponsorSegments = []tvSyncSegment{
+		{Start: 10, End: 20, Action: types.SponsorBlockActionMute},
+		{Start: 30, End: 40, Action: types.SponsorBlockActionSkip},
+	}
+
+	now := time.Now().UTC()
+	for i, second := range []float64{5, 12, 15, 25, 31} {
+		playback := lounge.PlaybackEvent{VideoID: "video-a", State: "1", CurrentTime: second, HasCurrentTime: true}
+		service.processSponsorSkip(context.Background(), &database.YouTubeTVSyncAccount{ID: "account-1", UserID: "user-1"}, "video-a", runtime, state, playback, session, now.Add(time.Duration(i)*5*time.Second))
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+	expected := []string{"mute", "unMute", "seekTo"}
+	if strings.Join(commands, ",") != strings.Join(expected, ",") {
+		t.Fatalf("expected commands %v, got %v", expected, commands)
+	}
+	if runtime.isSponsorMuted() {
+		t.Fatal("expected the tv to be unmuted after leaving the segment")
+	}
+	if len(store.events) != 1 || store.events[0].Kind != database.YouTubeTVSyncEventSponsorSkip || store.events[0].Message != "Skipped 0:30 to 0:40" {
+		t.Fatalf("expected the skip to be journaled, got %+v", store.events)
+	}
+}
+
+func TestReleaseSponsorMute_UnmutesMutedTV(t *testing.T) {
+	var commands atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_ = r.ParseForm()
+		if r.FormValue("req0__sc") == "unMute" {
+			commands.Add(1)
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	service := &YouTubeTVSyncService{
+		db:      &mockTVSyncStore{},
+		lounge:  lounge.NewClientWithBaseURL(server.Client(), server.URL+"/api/lounge"),
+		metrics: &tvSyncMetrics{},
+		workers: map[string]*tvSyncWorker{},
+	}
+	account := &database.YouTubeTVSyncAccount{ID: "account-1", UserID: "user-1"}
+	session := &lounge.Session{SID: "sid-1", GSessionID: "gs-1"}
+
+	// Nothing is sent when the TV was not muted by us
+	runtime := newTVSyncRuntime(true, &types.SponsorBlockSettingsProps{})
+	service.releaseSponsorMute(account, session, runtime)
+	if commands.Load() != 0 {
+		t.Fatalf("expected no unmute, got %d", commands.Load())
+	}
+
+	runtime.setSponsorMuted(true)
+	service.releaseSponsorMute(account, session, runtime)
+	if commands.Load() != 1 {
+		t.Fatalf("expected one unmute, got %d", commands.Load())
+	}
+	if runtime.isSponsorMuted() {
+		t.Fatal("expected the tv to be unmuted after the session ended")
+	}
+}
+
+func TestYouTubeTVSync_ScreenPreferences(t *testing.T) {
+	store := &mockTVSyncStore{}
+	service := &YouTubeTVSyncService{
+		db:      store,
+		metrics: &tvSyncMetrics{},
+		workers: map[string]*tvSyncWorker{},
+	}
+	ctx := context.Background()
+
+	for _, screenID := range []string{"screen-living-room", "screen-bedroom"} {
+		if err := store.UpsertYouTubeTVSyncCredentials(ctx, "user-1", screenID, "YouTube on TV", nil, ""); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+
+	if err := service.UpdateScreen(ctx, "user-2", "account-2", "Not mine", types.TVSponsorBlockDisabled); !errors.Is(err, ErrTVScreenNotFound) {
+		t.Fatalf("expected screens of other users to be rejected, got %v", err)
+	}
+	if err := service.UpdateScreen(ctx, "user-1", "account-2", "  Bedroom  ", "invalid"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := service.UpdateScreen(ctx, "user-1", "account-1", "", types.TVSponsorBlockDisabled); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	status, err := service.Status(ctx, "user-1")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(status.Screens) != 2 || status.Screens[0].Name != "YouTube on TV" || status.Screens[1].Name != "Bedroom" || status.Screens[1].SponsorBlockMode != types.TVSponsorBlockInherit {
+		t.Fatalf("unexpected screens %+v", status.Screens)
+	}
+
+	livingRoom, _ := store.GetYouTubeTVSyncAccount(ctx, "account-1")
+	bedroom, _ := store.GetYouTubeTVSyncAccount(ctx, "account-2")
+	if enabled, _ := service.loadSponsorPreferences(ctx, livingRoom); enabled {
+		t.Fatal("expected SponsorBlock to be disabled on the living room screen")
+	}
+	if enabled, _ := service.loadSponsorPreferences(ctx, bedroom); !enabled {
+		t.Fatal("expected the bedroom screen to follow the user settings")
+	}
+
+	// Screens set to always skip ignore the user toggle
+	if _, err := ToggleSponsorBlock(ctx, store, "user-1"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	bedroom.SponsorBlockMode = string(types.TVSponsorBlockEnabled)
+	if enabled, settings := service.loadSponsorPreferences(ctx, bedroom); !enabled || settings == nil {
+		t.Fatal("expected SponsorBlock to stay enabled on the bedroom screen")
+	}
+	bedroom.SponsorBlockMode = string(types.TVSponsorBlockInherit)
+	if enabled, _ := service.loadSponsorPreferences(ctx, bedroom); enabled {
+		t.Fatal("expected SponsorBlock to follow the disabled user setting")
+	}
+}
+
+func TestYouTubeTVSync_StopSavesProgressAndState(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer server.Close()
+
+	store := &mockTVSyncStore{accounts: []*database.YouTubeTVSyncAccount{
+		{ID: "account-1", UserID: "user-1", ScreenID: "screen-1", SyncEnabled: true, ConnectionState: tvSyncStateConnected},
+	}}
+	service := &YouTubeTVSyncService{
+		db:           store,
+		crypto:       newYouTubeSyncCrypto("test-tv-sync-secret"),
+		lounge:       lounge.NewClientWithBaseURL(server.Client(), server.URL+"/api/lounge"),
+		reconnectMin: time.Minute,
+		reconnectMax: time.Minute,
+		metrics:      &tvSyncMetrics{},
+		workers:      map[string]*tvSyncWorker{},
+	}
+	account := store.accounts[0]
+
+	// Progress observed after the last throttled write is saved, progress that was already written is not
+	runtime := newTVSyncRuntime(false, nil)
+	now := time.Now().UTC()
+	runtime.setCurrentVideo("video-1")
+	runtime.setCurrentPlaybackState("2")
+	runtime.setCurrentPlaybackTime(42.6, now)
+	runtime.videoRuntime("video-1").lastProgressWrite = now.Add(-5 * time.Second)
+
+	service.flushProgress(account, runtime)
+	if len(store.upserted) != 1 || store.upserted[0].VideoID != "video-1" || store.upserted[0].Progress != 42 {
+		t.Fatalf("expected the unsaved position to be written, got %+v", store.upserted)
+	}
+	runtime.videoRuntime("video-1").lastProgressWrite = now
+	service.flushProgress(account, runtime)
+	if len(store.upserted) != 1 {
+		t.Fatalf("expected saved progress not to be written again, got %+v", store.upserted)
+	}
+
+	// The worker fails to connect and waits to retry when the process stops
+	if err := service.RunConnectionTick(context.Background()); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	deadline := time.Now().Add(time.Second)
+	for {
+		current, _ := store.GetYouTubeTVSyncAccount(context.Background(), "account-1")
+		if current.ConnectionState == tvSyncStateError {
+			break
+		}
+		if time.Now().After(deadline) {
+			t.Fatalf("expected the connection to fail, state is %q", current.ConnectionState)
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	service.Stop(ctx)
+
+	current, _ := store.GetYouTubeTVSyncAccount(context.Background(), "account-1")
+	if current.ConnectionState != tvSyncStateDisconnected || current.StateReason != tvSyncStoppedReason || current.LastError != "" {
+		t.Fatalf("expected the screen to be marked as disconnected on stop, got %q %q %q", current.ConnectionState, current.StateReason, current.LastError)
+	}
+	events, _ := store.ListYouTubeTVSyncEvents(context.Background(), "account-1", 10)
+	if len(events) == 0 || events[0].Kind != database.YouTubeTVSyncEventDisconnect || events[0].Message != tvSyncStoppedReason {
+		t.Fatalf("expected the latest journal entry to be a disconnect, got %+v", events)
+	}
+}
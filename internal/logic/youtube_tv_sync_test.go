@@ -9,6 +9,7 @@ import (
 	"github.com/cufee/feedlr-yt/internal/api/sponsorblock"
 	"github.com/cufee/feedlr-yt/internal/api/youtube/lounge"
 	"github.com/cufee/feedlr-yt/internal/database"
+	"github.com/cufee/feedlr-yt/internal/types"
 )
 
 func TestNormalizeSponsorSegments(t *testing.T) {
@@ -32,6 +33,38 @@ func TestNormalizeSponsorSegments(t *testing.T) {
 	}
 }
 
+func TestTVSyncSponsorSegments(t *testing.T) {
+	settings := types.SponsorBlockSettingsProps{
+		CategoryActions: map[string]types.SponsorBlockAction{
+			"sponsor":        types.SponsorBlockActionSkip,
+			"music_offtopic": types.SponsorBlockActionMute,
+			"selfpromo":      types.SponsorBlockActionButton,
+		},
+	}
+	segments := []sponsorblock.Segment{
+		{Segment: []float64{100, 130}, Category: "music_offtopic", ActionType: sponsorblock.ActionTypeSkip},
+		{Segment: []float64{10, 20}, Category: "sponsor", ActionType: sponsorblock.ActionTypeSkip},
+		{Segment: []float64{40, 50}, Category: "sponsor", ActionType: sponsorblock.ActionTypeMute},
+		{Segment: []float64{60, 70}, Category: "selfpromo", ActionType: sponsorblock.ActionTypeSkip}, // no skip button on the TV
+		{Segment: []float64{80, 80}, Category: "poi_highlight", ActionType: sponsorblock.ActionTypePOI},
+	}
+
+	resolved := tvSyncSponsorSegments(settings, segments)
+	expected := []tvSyncSegment{
+		{Start: 10, End: 20, Action: types.SponsorBlockActionSkip},
+		{Start: 40, End: 50, Action: types.SponsorBlockActionMute},
+		{Start: 100, End: 130, Action: types.SponsorBlockActionMute},
+	}
+	if len(resolved) != len(expected) {
+		t.Fatalf("expected %d segments, got %+v", len(expected), resolved)
+	}
+	for i := range expected {
+		if resolved[i] != expected[i] {
+			t.Fatalf("unexpected segment %d: %+v", i, resolved[i])
+		}
+	}
+}
+
 func TestShouldWriteProgress(t *testing.T) {
 	now := time.Now().UTC()
 	if !shouldWriteProgress("1", now, time.Time{}) {
@@ -206,6 +239,35 @@ func TestTVSyncRuntimePlaybackSnapshotAndReset(t *testing.T) {
 	}
 }
 
+func TestTVSyncRuntimePlaybackPosition(t *testing.T) {
+	runtime := newTVSyncRuntime(false, nil)
+	now := time.Now().UTC()
+
+	if _, _, ok := runtime.currentPlaybackPosition(now); ok {
+		t.Fatal("expected no playback position without a video")
+	}
+
+	runtime.setCurrentVideo("video-a")
+	runtime.setCurrentPlaybackState("2")
+	runtime.setCurrentPlaybackTime(30, now)
+
+	videoID, position, ok := runtime.currentPlaybackPosition(now.Add(5 * time.Second))
+	if !ok || videoID != "video-a" || position != 30 {
+		t.Fatalf("expected paused position to stay put, got video=%q position=%v ok=%t", videoID, position, ok)
+	}
+
+	runtime.setCurrentPlaybackState("1")
+	_, position, _ = runtime.currentPlaybackPosition(now.Add(5 * time.Second))
+	if position != 35 {
+		t.Fatalf("expected playing position to advance, got %v", position)
+	}
+
+	runtime.setCurrentVideo("video-b")
+	if _, _, ok := runtime.currentPlaybackPosition(now); ok {
+		t.Fatal("expected playback position reset on video change")
+	}
+}
+
 func TestIsUnknownVideoProgressError(t *testing.T) {
 	if !isUnknownVideoProgressError(errors.New("FOREIGN KEY constraint failed")) {
 		t.Fatal("expected foreign key error to be treated as unknown video")
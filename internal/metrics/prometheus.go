@@ -1,9 +1,11 @@
 package metrics
 
 import (
+	"context"
 	"strconv"
 	"strings"
 
+	"github.com/cufee/feedlr-yt/internal/tracing"
 	"github.com/prometheus/client_golang/prometheus"
 )
 
@@ -108,6 +110,26 @@ var (
 		[]string{"event", "outcome"},
 	)
 
+	leaseEventsTotal = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: "feedlr",
+			Subsystem: "leases",
+			Name:      "events_total",
+			Help:      "Total number of lease events, like acquiring a lease or losing it to another process.",
+		},
+		[]string{"kind", "event"},
+	)
+
+	jobEventsTotal = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: "feedlr",
+			Subsystem: "jobs",
+			Name:      "events_total",
+			Help:      "Total number of background job events, like a job being queued, retried or failing.",
+		},
+		[]string{"kind", "event"},
+	)
+
 	proxyEventsTotal = prometheus.NewCounterVec(
 		prometheus.CounterOpts{
 			Namespace: "feedlr",
@@ -141,17 +163,19 @@ func init() {
 		videoRefreshItemsTotal,
 		backgroundTasksTotal,
 		tvSyncEventsTotal,
+		leaseEventsTotal,
+		jobEventsTotal,
 		proxyEventsTotal,
 		proxyErrorsTotal,
 	)
 }
 
-func IncHTTPRequest(method, route string, statusCode int) {
-	httpRequestsTotal.WithLabelValues(
+func IncHTTPRequest(ctx context.Context, method, route string, statusCode int) {
+	incWithExemplar(ctx, httpRequestsTotal.WithLabelValues(
 		normalizeLabel(strings.ToUpper(method)),
 		normalizeRoute(route),
 		statusClass(statusCode),
-	).Inc()
+	))
 }
 
 func IncUserEvent(event, outcome string) {
@@ -212,6 +236,31 @@ func ObserveTVSyncEvent(event string, err error) {
 	).Inc()
 }
 
+// IncLeaseEvent counts lease events by kind, the part of the lease name before the first colon
+func IncLeaseEvent(kind, event string) {
+	leaseEventsTotal.WithLabelValues(
+		normalizeLabel(kind),
+		normalizeLabel(event),
+	).Inc()
+}
+
+func IncJobEvent(ctx context.Context, kind, event string) {
+	incWithExemplar(ctx, jobEventsTotal.WithLabelValues(
+		normalizeLabel(kind),
+		normalizeLabel(event),
+	))
+}
+
+// incWithExemplar links the sample to the trace in the context, exemplars are only served in the OpenMetrics format
+func incWithExemplar(ctx context.Context, counter prometheus.Counter) {
+	traceID := tracing.TraceID(ctx)
+	if adder, ok := counter.(prometheus.ExemplarAdder); ok && traceID != "" {
+		adder.AddWithExemplar(1, prometheus.Labels{"trace_id": traceID})
+		return
+	}
+	counter.Inc()
+}
+
 func ObserveProxyEvent(scope, event string, err error) {
 	proxyEventsTotal.WithLabelValues(
 		normalizeLabel(scope),
@@ -13,7 +13,10 @@ import (
 	"unicode"
 
 	"github.com/cufee/feedlr-yt/internal/metrics"
+	"github.com/cufee/feedlr-yt/internal/tracing"
 	"github.com/pkg/errors"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
 )
 
 const youtubeProxyURLsEnv = "YOUTUBE_PROXY_URLS"
@@ -161,7 +164,22 @@ type observedRoundTripper struct {
 }
 
 func (rt *observedRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
-	resp, err := rt.base.RoundTrip(req)
+	// The query is left out of the span, API keys are passed in it. Trace headers are not sent to YouTube.
+	ctx, span := tracing.StartClient(req.Context(), "HTTP "+req.Method+" "+req.URL.Host,
+		attribute.String("http.request.method", req.Method),
+		attribute.String("server.address", req.URL.Host),
+		attribute.String("url.path", req.URL.Path),
+		attribute.Bool("proxy.enabled", rt.proxyEnabled),
+	)
+
+	resp, err := rt.base.RoundTrip(req.WithContext(ctx))
+	if resp != nil {
+		span.SetAttributes(attribute.Int("http.response.status_code", resp.StatusCode))
+		if resp.StatusCode >= 500 {
+			span.SetStatus(codes.Error, resp.Status)
+		}
+	}
+	tracing.End(span, err)
 	if err == nil || !rt.proxyEnabled {
 		return resp, err
 	}
@@ -2,7 +2,13 @@ package netproxy
 
 import (
 	"net/http"
+	"net/http/httptest"
+	"strings"
 	"testing"
+
+	"go.opentelemetry.io/otel"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
 )
 
 func TestNewProxyPool_AllowsArbitraryProxyCount(t *testing.T) {
@@ -63,3 +69,38 @@ func TestNewProxyPool_EmptyInput(t *testing.T) {
 		t.Fatal("expected nil pool when proxy list is empty")
 	}
 }
+
+func TestObservedRoundTripper_SpanLeavesOutQuery(t *testing.T) {
+	recorder := tracetest.NewSpanRecorder()
+	previous := otel.GetTracerProvider()
+	otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)))
+	t.Cleanup(func() { otel.SetTracerProvider(previous) })
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("traceparent") != "" {
+			t.Error("expected trace headers to not be sent")
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	client := &http.Client{Transport: &observedRoundTripper{base: http.DefaultTransport, scope: "test"}}
+	res, err := client.Get(server.URL + "/youtube/v3/videos?key=secret")
+	if err != nil {
+		t.Fatalf("request failed: %v", err)
+	}
+	res.Body.Close()
+
+	spans := recorder.Ended()
+	if len(spans) != 1 {
+		t.Fatalf("expected 1 span, got %d", len(spans))
+	}
+	for _, attr := range spans[0].Attributes() {
+		if strings.Contains(attr.Value.Emit(), "secret") {
+			t.Fatalf("expected the query to be left out, got %s=%s", attr.Key, attr.Value.Emit())
+		}
+		if attr.Key == "http.response.status_code" && attr.Value.AsInt64() != http.StatusOK {
+			t.Fatalf("expected status 200, got %d", attr.Value.AsInt64())
+		}
+	}
+}
@@ -14,6 +14,8 @@ import (
 	"github.com/gofiber/fiber/v2"
 	"github.com/microcosm-cc/bluemonday"
 	"github.com/pkg/errors"
+	"github.com/rs/zerolog"
+	"go.opentelemetry.io/otel/trace"
 )
 
 type contextKey byte
@@ -46,8 +48,12 @@ func (ctx *Context) Writer() http.ResponseWriter {
 func (ctx *Context) Request() *http.Request {
 	return ctx.r
 }
+
+/*
+Context returns the request context with the request span, set by the tracing middleware
+*/
 func (ctx *Context) Context() context.Context {
-	return ctx.Ctx.Context()
+	return ctx.Ctx.UserContext()
 }
 
 func (c *Context) Status(status int) *Context {
@@ -150,6 +156,24 @@ func (ctx *Context) FormValue(key string) (string, error) {
 	return ctx.Sanitize(ctx.r.Form.Get(key)), nil
 }
 
+/*
+FormValues returns all values of a repeated form field, like a group of checkboxes
+*/
+func (ctx *Context) FormValues(key string) ([]string, error) {
+	if !ctx.formParsed {
+		if err := ctx.r.ParseForm(); err != nil {
+			return nil, err
+		}
+		ctx.formParsed = true
+	}
+
+	values := make([]string, 0, len(ctx.r.Form[key]))
+	for _, value := range ctx.r.Form[key] {
+		values = append(values, ctx.Sanitize(value))
+	}
+	return values, nil
+}
+
 func (ctx *Context) Query(key string, fallback ...string) string {
 	return ctx.Sanitize(ctx.Ctx.Query(key, fallback...))
 }
@@ -171,6 +195,8 @@ func (ctx *Context) Err(err error) error {
 	query := make(url.Values)
 	if err != nil {
 		query.Set("message", err.Error())
+		trace.SpanFromContext(ctx.Context()).RecordError(err)
+		zerolog.Ctx(ctx.Context()).Warn().Err(err).Str("path", ctx.Path()).Msg("request failed")
 	}
 	return ctx.Redirect("/error?"+query.Encode(), http.StatusTemporaryRedirect)
 }
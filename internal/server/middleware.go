@@ -13,10 +13,17 @@ import (
 
 	"github.com/cufee/feedlr-yt/internal/metrics"
 	"github.com/cufee/feedlr-yt/internal/templates/pages"
+	"github.com/cufee/feedlr-yt/internal/tracing"
 	"github.com/gofiber/fiber/v2"
 	"github.com/gofiber/fiber/v2/middleware/filesystem"
 	"github.com/gofiber/fiber/v2/middleware/limiter"
 	"github.com/google/uuid"
+	"github.com/rs/zerolog"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
 )
 
 var limiterMiddleware = limiter.New(limiter.Config{
@@ -140,7 +147,101 @@ func requestMetricsMiddleware(c *fiber.Ctx) error {
 			status = fiber.StatusOK
 		}
 	}
-	metrics.IncHTTPRequest(c.Method(), route, status)
+	metrics.IncHTTPRequest(c.UserContext(), c.Method(), route, status)
 
 	return err
 }
+
+/*
+tracingMiddleware starts a span for each request and continues the trace of the caller when a traceparent header is set.
+Handlers get the span through the user context, which keeps the values of the request context.
+*/
+func tracingMiddleware(c *fiber.Ctx) error {
+	ctx := otel.GetTextMapPropagator().Extract(c.Context(), propagation.HeaderCarrier(c.GetReqHeaders()))
+	ctx, span := tracing.Tracer().Start(ctx, c.Method()+" "+c.Path(),
+		trace.WithSpanKind(trace.SpanKindServer),
+		trace.WithAttributes(
+			attribute.String("http.request.method", c.Method()),
+			attribute.String("url.path", c.Path()),
+		),
+	)
+	defer span.End()
+	c.SetUserContext(tracing.WithLogger(ctx))
+
+	err := c.Next()
+
+	// The route is only known once the request was matched
+	if r := c.Route(); r != nil && r.Path != "" {
+		span.SetName(c.Method() + " " + r.Path)
+		span.SetAttributes(attribute.String("http.route", r.Path))
+	}
+	status := c.Response().StatusCode()
+	span.SetAttributes(attribute.Int("http.response.status_code", status))
+	if err != nil {
+		span.RecordError(err)
+	}
+	if err != nil || status >= http.StatusInternalServerError {
+		span.SetStatus(codes.Error, http.StatusText(status))
+	}
+	return err
+}
+
+/*
+accessLogMiddleware logs each request with the logger of the request context, so access logs carry the trace and span IDs.
+Errors are handed to the error handler here, like the fiber logger does, to log the final status.
+*/
+func accessLogMiddleware(c *fiber.Ctx) error {
+	start := time.Now()
+	chainErr := c.Next()
+	if chainErr != nil {
+		if err := c.App().ErrorHandler(c, chainErr); err != nil {
+			_ = c.SendStatus(fiber.StatusInternalServerError)
+		}
+	}
+
+	status := c.Response().StatusCode()
+	event := zerolog.Ctx(c.UserContext()).Info()
+	if status >= http.StatusInternalServerError {
+		event = zerolog.Ctx(c.UserContext()).Error()
+	}
+	event.Err(chainErr).
+		Int("status", status).
+		Str("method", c.Method()).
+		Str("path", c.Path()).
+		Dur("latency", time.Since(start)).
+		Msg("request")
+	return nil
+}
+
+/*
+bodyLimitMiddleware rejects request bodies larger than limit. The server streams bodies past the default limit,
+so each route is held to the limit of this middleware instead, skipped paths set their own.
+*/
+func bodyLimitMiddleware(limit int, skipPaths ...string) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		for _, path := range skipPaths {
+			if c.Path() == path {
+				return c.Next()
+			}
+		}
+
+		length := c.Request().Header.ContentLength()
+		if length > limit {
+			c.Context().SetConnectionClose()
+			return c.SendStatus(fiber.StatusRequestEntityTooLarge)
+		}
+		// Chunked bodies have no length, they are read up to the limit
+		if stream := c.Context().RequestBodyStream(); length < 0 && stream != nil {
+			body, err := io.ReadAll(io.LimitReader(stream, int64(limit)+1))
+			if err != nil {
+				return c.SendStatus(fiber.StatusBadRequest)
+			}
+			if len(body) > limit {
+				c.Context().SetConnectionClose()
+				return c.SendStatus(fiber.StatusRequestEntityTooLarge)
+			}
+			c.Request().SetBody(body)
+		}
+		return c.Next()
+	}
+}
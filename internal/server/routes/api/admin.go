@@ -0,0 +1,37 @@
+package api
+
+import (
+	"net/http"
+
+	"github.com/a-h/templ"
+	"github.com/cufee/feedlr-yt/internal/logic"
+	"github.com/cufee/feedlr-yt/internal/permissions"
+	"github.com/cufee/feedlr-yt/internal/server/handler"
+	"github.com/cufee/feedlr-yt/internal/templates/pages/app"
+	"github.com/cufee/tpot/brewed"
+)
+
+var RetryJob brewed.Partial[*handler.Context] = func(ctx *handler.Context) (templ.Component, error) {
+	userID, ok := ctx.UserID()
+	if !ok {
+		return nil, ctx.SendStatus(http.StatusUnauthorized)
+	}
+	user, err := ctx.Database().GetUser(ctx.Context(), userID)
+	if err != nil {
+		return nil, ctx.SendStatus(http.StatusUnauthorized)
+	}
+	if !permissions.Parse(user.Permissions, permissions.Blank).Has(permissions.ViewAdminPanel) {
+		return nil, ctx.SendStatus(http.StatusNotFound)
+	}
+
+	message := "The job was queued again."
+	if err := logic.RetryFailedJob(ctx.Context(), ctx.Database(), ctx.Params("id")); err != nil {
+		message = err.Error()
+	}
+
+	jobs, err := logic.GetJobsOverview(ctx.Context(), ctx.Database())
+	if err != nil {
+		return nil, ctx.Err(err)
+	}
+	return app.AdminJobs(jobs, message), nil
+}
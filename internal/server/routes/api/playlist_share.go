@@ -0,0 +1,94 @@
+package api
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/a-h/templ"
+	"github.com/cufee/feedlr-yt/internal/logic"
+	"github.com/cufee/feedlr-yt/internal/server/handler"
+	"github.com/cufee/feedlr-yt/internal/templates/components/playlist"
+	"github.com/cufee/tpot/brewed"
+	"github.com/pkg/errors"
+)
+
+var CreatePlaylistShareLink brewed.Partial[*handler.Context] = func(ctx *handler.Context) (templ.Component, error) {
+	userID, ok := ctx.UserID()
+	if !ok {
+		return nil, ctx.SendStatus(http.StatusUnauthorized)
+	}
+
+	playlistID := ctx.Params("id")
+	if playlistID == "" {
+		return nil, ctx.SendStatus(http.StatusBadRequest)
+	}
+
+	value, _ := ctx.FormValue("expires_in_days")
+	days, err := strconv.Atoi(value)
+	if err != nil {
+		return nil, ctx.SendStatus(http.StatusBadRequest)
+	}
+
+	link, err := logic.CreatePlaylistShareLink(ctx.Context(), ctx.Database(), userID, playlistID, days)
+	if err != nil {
+		links, lerr := logic.GetPlaylistShareLinks(ctx.Context(), ctx.Database(), userID, playlistID)
+		if lerr != nil {
+			return nil, ctx.SendStatus(http.StatusNotFound)
+		}
+		return playlist.PlaylistShareLinks(playlistID, links, "Failed to create a link"), nil
+	}
+	link.URL = publicServerURL(ctx) + link.URL
+
+	links, err := logic.GetPlaylistShareLinks(ctx.Context(), ctx.Database(), userID, playlistID)
+	if err != nil {
+		return nil, err
+	}
+	for i := range links {
+		if links[i].ID == link.ID {
+			links[i] = link
+		}
+	}
+	return playlist.PlaylistShareLinks(playlistID, links, ""), nil
+}
+
+var RevokePlaylistShareLink brewed.Partial[*handler.Context] = func(ctx *handler.Context) (templ.Component, error) {
+	userID, ok := ctx.UserID()
+	if !ok {
+		return nil, ctx.SendStatus(http.StatusUnauthorized)
+	}
+
+	playlistID := ctx.Params("id")
+	linkID := ctx.Params("linkID")
+	if playlistID == "" || linkID == "" {
+		return nil, ctx.SendStatus(http.StatusBadRequest)
+	}
+
+	var message string
+	err := logic.RevokePlaylistShareLink(ctx.Context(), ctx.Database(), userID, playlistID, linkID)
+	if err != nil {
+		message = "Failed to revoke the link"
+	}
+
+	links, err := logic.GetPlaylistShareLinks(ctx.Context(), ctx.Database(), userID, playlistID)
+	if err != nil {
+		return nil, ctx.SendStatus(http.StatusNotFound)
+	}
+	return playlist.PlaylistShareLinks(playlistID, links, message), nil
+}
+
+var CopySharedPlaylist brewed.Partial[*handler.Context] = func(ctx *handler.Context) (templ.Component, error) {
+	userID, ok := ctx.UserID()
+	if !ok {
+		return nil, ctx.SendStatus(http.StatusUnauthorized)
+	}
+
+	p, err := logic.CopySharedPlaylist(ctx.Context(), ctx.Database(), userID, ctx.Params("token"))
+	if errors.Is(err, logic.ErrPlaylistShareLinkInvalid) {
+		return nil, ctx.SendStatus(http.StatusNotFound)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return nil, ctx.Redirect(fmt.Sprintf("/app/playlist/%s", p.ID), http.StatusSeeOther)
+}
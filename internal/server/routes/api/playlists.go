@@ -55,11 +55,11 @@ var ToggleWatchLater brewed.Partial[*handler.Context] = func(ctx *handler.Contex
 		count, _ := logic.GetWatchLaterCount(ctx.Context(), ctx.Database(), userID)
 		if count == 0 {
 			metrics.IncUserAction("toggle_watch_later", "success")
-			return feed.SectionRemoveWithCardSync(props.Video, feed.WithProgressActions, feed.WithProgressBar, feed.WithProgressOverlay), nil
+			return feed.SectionRemoveWithCardSync(props.Video, feed.WithProgressActions, feed.WithProgressBar, feed.WithProgressOverlay, feed.WithPlayOnTV(props.PlayOnTV)), nil
 		}
 		// Otherwise just remove the carousel item + update card
 		metrics.IncUserAction("toggle_watch_later", "success")
-		return feed.CarouselRemoveWithCardSync(props.Video, feed.WithProgressActions, feed.WithProgressBar, feed.WithProgressOverlay), nil
+		return feed.CarouselRemoveWithCardSync(props.Video, feed.WithProgressActions, feed.WithProgressBar, feed.WithProgressOverlay, feed.WithPlayOnTV(props.PlayOnTV)), nil
 
 	case "card":
 		// For card style, return the full video card with OOB carousel sync
@@ -72,11 +72,11 @@ var ToggleWatchLater brewed.Partial[*handler.Context] = func(ctx *handler.Contex
 		if inWatchLater {
 			// Added to watch later - add to carousel (OOB will no-op if section doesn't exist)
 			metrics.IncUserAction("toggle_watch_later", "success")
-			return feed.VideoCardWithCarouselAdd(props.Video, feed.WithProgressActions, feed.WithProgressBar, feed.WithProgressOverlay), nil
+			return feed.VideoCardWithCarouselAdd(props.Video, feed.WithProgressActions, feed.WithProgressBar, feed.WithProgressOverlay, feed.WithPlayOnTV(props.PlayOnTV)), nil
 		}
 		// Removed from watch later - remove from carousel
 		metrics.IncUserAction("toggle_watch_later", "success")
-		return feed.VideoCardWithCarouselRemove(props.Video, feed.WithProgressActions, feed.WithProgressBar, feed.WithProgressOverlay), nil
+		return feed.VideoCardWithCarouselRemove(props.Video, feed.WithProgressActions, feed.WithProgressBar, feed.WithProgressOverlay, feed.WithPlayOnTV(props.PlayOnTV)), nil
 
 	default:
 		metrics.IncUserAction("toggle_watch_later", "success")
@@ -2,34 +2,35 @@ package api
 
 import (
 	"net/http"
-	"slices"
+	"strconv"
 
 	"github.com/a-h/templ"
 	"github.com/cufee/feedlr-yt/internal/logic"
 	"github.com/cufee/feedlr-yt/internal/metrics"
 	"github.com/cufee/feedlr-yt/internal/server/handler"
 	"github.com/cufee/feedlr-yt/internal/templates/components/settings"
+	"github.com/cufee/feedlr-yt/internal/types"
 	"github.com/cufee/tpot/brewed"
 )
 
-var ToggleSponsorBlockCategory brewed.Partial[*handler.Context] = func(ctx *handler.Context) (templ.Component, error) {
+var UpdateSponsorBlockCategory brewed.Partial[*handler.Context] = func(ctx *handler.Context) (templ.Component, error) {
 	userID, ok := ctx.UserID()
 	if !ok {
-		metrics.IncUserAction("toggle_sponsorblock_category", "unauthorized")
+		metrics.IncUserAction("update_sponsorblock_category", "unauthorized")
 		return nil, ctx.SendStatus(http.StatusUnauthorized)
 	}
 
 	category := ctx.Query("category")
+	action := types.SponsorBlockAction(ctx.Query("action"))
 
-	updated, err := logic.ToggleSponsorBlockCategory(ctx.Context(), ctx.Database(), userID, category)
+	updated, err := logic.SetSponsorBlockCategoryAction(ctx.Context(), ctx.Database(), userID, category, action)
 	if err != nil {
-		metrics.IncUserAction("toggle_sponsorblock_category", "error")
+		metrics.IncUserAction("update_sponsorblock_category", "error")
 		return nil, err
 	}
 
-	enabled := slices.Contains(updated.SponsorBlock.SelectedSponsorBlockCategories, category)
-	metrics.IncUserAction("toggle_sponsorblock_category", "success")
-	return settings.CategoryToggleButton(category, enabled, false), nil
+	metrics.IncUserAction("update_sponsorblock_category", "success")
+	return settings.CategoryActionTabs(category, updated.SponsorBlock.CategoryAction(category), false), nil
 }
 
 var ToggleSponsorBlock brewed.Partial[*handler.Context] = func(ctx *handler.Context) (templ.Component, error) {
@@ -47,3 +48,47 @@ var ToggleSponsorBlock brewed.Partial[*handler.Context] = func(ctx *handler.Cont
 	metrics.IncUserAction("toggle_sponsorblock", "success")
 	return settings.SponsorBlockSettings(updated.SponsorBlock), nil
 }
+
+var UpdateVideoTitleMode brewed.Partial[*handler.Context] = func(ctx *handler.Context) (templ.Component, error) {
+	userID, ok := ctx.UserID()
+	if !ok {
+		metrics.IncUserAction("update_video_title_mode", "unauthorized")
+		return nil, ctx.SendStatus(http.StatusUnauthorized)
+	}
+
+	updated, err := logic.SetVideoTitleMode(ctx.Context(), ctx.Database(), userID, types.VideoTitleMode(ctx.Query("mode")))
+	if err != nil {
+		metrics.IncUserAction("update_video_title_mode", "error")
+		return nil, err
+	}
+
+	metrics.IncUserAction("update_video_title_mode", "success")
+	return settings.TitleSettings(updated.DisplayTitleMode(), logic.DeArrowAvailable()), nil
+}
+
+var UpdateWatchLaterTTL brewed.Partial[*handler.Context] = func(ctx *handler.Context) (templ.Component, error) {
+	userID, ok := ctx.UserID()
+	if !ok {
+		metrics.IncUserAction("update_watch_later_ttl", "unauthorized")
+		return nil, ctx.SendStatus(http.StatusUnauthorized)
+	}
+
+	value, _ := ctx.FormValue("ttl_days")
+	days, err := strconv.ParseInt(value, 10, 64)
+	if err == nil {
+		err = logic.SetWatchLaterTTL(ctx.Context(), ctx.Database(), userID, days)
+	}
+
+	current, terr := logic.GetWatchLaterTTL(ctx.Context(), ctx.Database(), userID)
+	if terr != nil {
+		metrics.IncUserAction("update_watch_later_ttl", "error")
+		return nil, terr
+	}
+	if err != nil {
+		metrics.IncUserAction("update_watch_later_ttl", "error")
+		return settings.WatchLaterSettings(current, "Failed to save the setting"), nil
+	}
+
+	metrics.IncUserAction("update_watch_later_ttl", "success")
+	return settings.WatchLaterSettings(current, ""), nil
+}
@@ -0,0 +1,121 @@
+package api
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/a-h/templ"
+	"github.com/cufee/feedlr-yt/internal/logic"
+	"github.com/cufee/feedlr-yt/internal/server/handler"
+	"github.com/cufee/feedlr-yt/internal/templates/components/playlist"
+	"github.com/cufee/feedlr-yt/internal/types"
+	"github.com/cufee/tpot/brewed"
+)
+
+// parseSmartPlaylistRulesForm reads the fields of the rule editor, empty and invalid numbers do not filter
+func parseSmartPlaylistRulesForm(ctx *handler.Context) types.SmartPlaylistRules {
+	number := func(key string) int {
+		value, _ := ctx.FormValue(key)
+		n, _ := strconv.Atoi(value)
+		return n
+	}
+
+	filter, _ := ctx.FormValue("filter")
+	progress, _ := ctx.FormValue("progress")
+	sort, _ := ctx.FormValue("sort")
+	favorites, _ := ctx.FormValue("favorites")
+	channels, _ := ctx.FormValues("channel")
+
+	return types.SmartPlaylistRules{
+		Channels:            channels,
+		FavoritesOnly:       favorites == "true",
+		Filter:              types.VideoFilter(filter),
+		Progress:            types.SmartPlaylistProgress(progress),
+		MinDurationMinutes:  number("min_duration"),
+		MaxDurationMinutes:  number("max_duration"),
+		PublishedWithinDays: number("within_days"),
+		Sort:                types.PlaylistSort(sort),
+		Limit:               number("limit"),
+	}
+}
+
+var CreateSmartPlaylist brewed.Partial[*handler.Context] = func(ctx *handler.Context) (templ.Component, error) {
+	userID, ok := ctx.UserID()
+	if !ok {
+		return nil, ctx.SendStatus(http.StatusUnauthorized)
+	}
+
+	channels, _ := logic.GetUserSubscribedChannels(ctx.Context(), ctx.Database(), userID)
+	rules := parseSmartPlaylistRulesForm(ctx)
+
+	name, err := ctx.FormValue("name")
+	if err != nil || name == "" {
+		return playlist.CreateSmartPlaylistForm(rules, channels, "Playlist name is required"), nil
+	}
+	description, _ := ctx.FormValue("description")
+
+	if _, err := rules.Normalize(); err != nil {
+		return playlist.CreateSmartPlaylistForm(rules, channels, fmt.Sprintf("Invalid rules: %s", err)), nil
+	}
+
+	p, err := logic.CreateSmartPlaylist(ctx.Context(), ctx.Database(), userID, name, description, rules)
+	if err != nil {
+		return playlist.CreateSmartPlaylistForm(rules, channels, "Failed to create playlist"), nil
+	}
+
+	return nil, ctx.Redirect(fmt.Sprintf("/app/playlist/%s", p.ID), http.StatusSeeOther)
+}
+
+var UpdateSmartPlaylistRules brewed.Partial[*handler.Context] = func(ctx *handler.Context) (templ.Component, error) {
+	userID, ok := ctx.UserID()
+	if !ok {
+		return nil, ctx.SendStatus(http.StatusUnauthorized)
+	}
+
+	playlistID := ctx.Params("id")
+	if playlistID == "" {
+		return nil, ctx.SendStatus(http.StatusBadRequest)
+	}
+
+	p, err := ctx.Database().GetPlaylistByID(ctx.Context(), playlistID)
+	if err != nil || p.UserID != userID || !p.Rules.Valid {
+		return nil, ctx.SendStatus(http.StatusNotFound)
+	}
+
+	rules := parseSmartPlaylistRulesForm(ctx)
+	if _, err := rules.Normalize(); err != nil {
+		props := types.PlaylistModelToProps(p, 0, 0, "")
+		props.Rules = rules
+		channels, _ := logic.GetUserSubscribedChannels(ctx.Context(), ctx.Database(), userID)
+		return playlist.SmartPlaylistRulesEditor(props, channels, fmt.Sprintf("Invalid rules: %s", err)), nil
+	}
+
+	err = logic.UpdateSmartPlaylistRules(ctx.Context(), ctx.Database(), userID, playlistID, rules)
+	if err != nil {
+		props := types.PlaylistModelToProps(p, 0, 0, "")
+		props.Rules = rules
+		channels, _ := logic.GetUserSubscribedChannels(ctx.Context(), ctx.Database(), userID)
+		return playlist.SmartPlaylistRulesEditor(props, channels, "Failed to save rules"), nil
+	}
+
+	return nil, ctx.Redirect(fmt.Sprintf("/app/playlist/%s", playlistID), http.StatusSeeOther)
+}
+
+var PreviewSmartPlaylist brewed.Partial[*handler.Context] = func(ctx *handler.Context) (templ.Component, error) {
+	userID, ok := ctx.UserID()
+	if !ok {
+		return nil, ctx.SendStatus(http.StatusUnauthorized)
+	}
+
+	rules, err := parseSmartPlaylistRulesForm(ctx).Normalize()
+	if err != nil {
+		return playlist.SmartPlaylistPreview(nil, fmt.Sprintf("Invalid rules: %s", err)), nil
+	}
+
+	videos, err := logic.GetSmartPlaylistVideos(ctx.Context(), ctx.Database(), userID, rules)
+	if err != nil {
+		return playlist.SmartPlaylistPreview(nil, "Failed to preview this playlist"), nil
+	}
+	return playlist.SmartPlaylistPreview(videos, ""), nil
+}
@@ -0,0 +1,88 @@
+package api
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/a-h/templ"
+	"github.com/cufee/feedlr-yt/internal/logic"
+	"github.com/cufee/feedlr-yt/internal/metrics"
+	"github.com/cufee/feedlr-yt/internal/server/handler"
+	"github.com/cufee/feedlr-yt/internal/templates/pages"
+	"github.com/cufee/feedlr-yt/internal/types"
+	"github.com/cufee/tpot/brewed"
+	"github.com/pkg/errors"
+)
+
+var SubmitVideoSegment brewed.Partial[*handler.Context] = func(ctx *handler.Context) (templ.Component, error) {
+	userID, ok := ctx.UserID()
+	if !ok {
+		metrics.IncUserAction("submit_video_segment", "unauthorized")
+		return nil, ctx.SendStatus(http.StatusUnauthorized)
+	}
+
+	videoID := ctx.Params("id")
+	video, err := logic.GetVideoByID(ctx.Context(), ctx.Database(), videoID)
+	if err != nil {
+		metrics.IncUserAction("submit_video_segment", "invalid_request")
+		return nil, ctx.SendStatus(http.StatusNotFound)
+	}
+
+	rawStart, _ := ctx.FormValue("start")
+	rawEnd, _ := ctx.FormValue("end")
+	category, _ := ctx.FormValue("category")
+	action, _ := ctx.FormValue("action")
+	start, startErr := strconv.ParseFloat(rawStart, 64)
+	end, endErr := strconv.ParseFloat(rawEnd, 64)
+	if startErr != nil || endErr != nil {
+		metrics.IncUserAction("submit_video_segment", "invalid_request")
+		return pages.VideoSegmentSubmissions(nil, "Start and end times are required", true), nil
+	}
+
+	err = logic.SubmitSponsorBlockSegment(ctx.Context(), ctx.Database(), userID, logic.SponsorBlockSegmentRequest{
+		VideoID:       video.ID,
+		VideoDuration: video.Duration,
+		Start:         start,
+		End:           end,
+		Category:      category,
+		Action:        types.SponsorBlockAction(action),
+	})
+	if errors.Is(err, logic.ErrInvalidSegment) || errors.Is(err, logic.ErrSponsorBlockUnavailable) {
+		metrics.IncUserAction("submit_video_segment", "invalid_request")
+		return pages.VideoSegmentSubmissions(nil, err.Error(), true), nil
+	}
+	if err != nil {
+		metrics.IncUserAction("submit_video_segment", "error")
+		return nil, err
+	}
+
+	submissions, err := logic.GetUserSponsorBlockSubmissions(ctx.Context(), ctx.Database(), userID, video.ID)
+	if err != nil {
+		metrics.IncUserAction("submit_video_segment", "error")
+		return nil, err
+	}
+
+	metrics.IncUserAction("submit_video_segment", "success")
+	return pages.VideoSegmentSubmissions(submissions, "Segment submitted, thank you!", false), nil
+}
+
+var VoteVideoSegment brewed.Partial[*handler.Context] = func(ctx *handler.Context) (templ.Component, error) {
+	userID, ok := ctx.UserID()
+	if !ok {
+		metrics.IncUserAction("vote_video_segment", "unauthorized")
+		return nil, ctx.SendStatus(http.StatusUnauthorized)
+	}
+
+	err := logic.VoteOnSponsorBlockSegment(ctx.Context(), ctx.Database(), userID, ctx.Params("id"), ctx.Params("uuid"), ctx.Query("upvote") == "true")
+	if errors.Is(err, logic.ErrInvalidSegment) || errors.Is(err, logic.ErrSponsorBlockUnavailable) {
+		metrics.IncUserAction("vote_video_segment", "invalid_request")
+		return nil, ctx.SendStatus(http.StatusBadRequest)
+	}
+	if err != nil {
+		metrics.IncUserAction("vote_video_segment", "error")
+		return nil, err
+	}
+
+	metrics.IncUserAction("vote_video_segment", "success")
+	return nil, ctx.SendStatus(http.StatusOK)
+}
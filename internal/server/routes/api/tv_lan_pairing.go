@@ -0,0 +1,117 @@
+package api
+
+import (
+	"net/http"
+	"os"
+	"strings"
+
+	"github.com/a-h/templ"
+	"github.com/cufee/feedlr-yt/internal/logic"
+	"github.com/cufee/feedlr-yt/internal/metrics"
+	"github.com/cufee/feedlr-yt/internal/server/handler"
+	"github.com/cufee/feedlr-yt/internal/templates/components/settings"
+	"github.com/cufee/feedlr-yt/internal/types"
+	"github.com/cufee/tpot/brewed"
+	"github.com/gofiber/fiber/v2"
+	"github.com/pkg/errors"
+)
+
+// publicServerURL is the address the LAN pairing helper should use, the request URL can be an internal address behind a proxy
+func publicServerURL(ctx *handler.Context) string {
+	host := strings.TrimSpace(os.Getenv("COOKIE_DOMAIN"))
+	if host == "" {
+		return ctx.BaseURL()
+	}
+	if strings.HasPrefix(host, "localhost:") {
+		return "http://" + host
+	}
+	return "https://" + host
+}
+
+var CreateYouTubeTVLANPairing brewed.Partial[*handler.Context] = func(ctx *handler.Context) (templ.Component, error) {
+	userID, ok := ctx.UserID()
+	if !ok {
+		metrics.IncUserAction("youtube_tv_lan_pairing_create", "unauthorized")
+		return nil, ctx.SendStatus(http.StatusUnauthorized)
+	}
+
+	service, err := youtubeTVSyncService()
+	if err != nil {
+		metrics.IncUserAction("youtube_tv_lan_pairing_create", "service_unavailable")
+		return nil, ctx.SendStatus(http.StatusServiceUnavailable)
+	}
+
+	pairing, err := service.CreateLANPairingToken(ctx.Context(), userID)
+	if err != nil {
+		metrics.IncUserAction("youtube_tv_lan_pairing_create", "error")
+		return nil, err
+	}
+	pairing.ServerURL = publicServerURL(ctx)
+
+	metrics.IncUserAction("youtube_tv_lan_pairing_create", "success")
+	return settings.TVLANPairing(pairing), nil
+}
+
+var RevokeYouTubeTVLANPairing brewed.Partial[*handler.Context] = func(ctx *handler.Context) (templ.Component, error) {
+	userID, ok := ctx.UserID()
+	if !ok {
+		metrics.IncUserAction("youtube_tv_lan_pairing_revoke", "unauthorized")
+		return nil, ctx.SendStatus(http.StatusUnauthorized)
+	}
+
+	service, err := youtubeTVSyncService()
+	if err != nil {
+		metrics.IncUserAction("youtube_tv_lan_pairing_revoke", "service_unavailable")
+		return nil, ctx.SendStatus(http.StatusServiceUnavailable)
+	}
+
+	err = service.RevokeLANPairingTokens(ctx.Context(), userID)
+	if err != nil {
+		metrics.IncUserAction("youtube_tv_lan_pairing_revoke", "error")
+		return nil, err
+	}
+
+	metrics.IncUserAction("youtube_tv_lan_pairing_revoke", "success")
+	return settings.TVLANPairing(types.TVLANPairingProps{}), nil
+}
+
+/*
+PairYouTubeTVFromLAN is called by the LAN pairing helper, it is authenticated with a pairing token instead of a session
+*/
+var PairYouTubeTVFromLAN brewed.Endpoint[*handler.Context] = func(ctx *handler.Context) error {
+	service, err := youtubeTVSyncService()
+	if err != nil {
+		metrics.IncUserAction("youtube_tv_lan_pair", "service_unavailable")
+		return ctx.SendStatus(http.StatusServiceUnavailable)
+	}
+
+	token, ok := strings.CutPrefix(ctx.Get(fiber.HeaderAuthorization), "Bearer ")
+	if !ok || strings.TrimSpace(token) == "" {
+		metrics.IncUserAction("youtube_tv_lan_pair", "unauthorized")
+		return ctx.SendStatus(http.StatusUnauthorized)
+	}
+
+	screenID, _ := ctx.FormValue("screen_id")
+	name, _ := ctx.FormValue("name")
+	if strings.TrimSpace(screenID) == "" {
+		metrics.IncUserAction("youtube_tv_lan_pair", "invalid_request")
+		return ctx.SendStatus(http.StatusBadRequest)
+	}
+
+	screen, err := service.PairFromLAN(ctx.Context(), token, screenID, name)
+	if errors.Is(err, logic.ErrTVPairingTokenInvalid) {
+		metrics.IncUserAction("youtube_tv_lan_pair", "unauthorized")
+		return ctx.SendStatus(http.StatusUnauthorized)
+	}
+	if errors.Is(err, logic.ErrTVLANPairingDisabled) {
+		metrics.IncUserAction("youtube_tv_lan_pair", "service_unavailable")
+		return ctx.SendStatus(http.StatusServiceUnavailable)
+	}
+	if err != nil {
+		metrics.IncUserAction("youtube_tv_lan_pair", "error")
+		return ctx.SendStatus(http.StatusBadGateway)
+	}
+
+	metrics.IncUserAction("youtube_tv_lan_pair", "success")
+	return ctx.JSON(fiber.Map{"id": screen.ID, "name": screen.Name})
+}
@@ -0,0 +1,189 @@
+package api
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+
+	"github.com/a-h/templ"
+	"github.com/cufee/feedlr-yt/internal/logic"
+	"github.com/cufee/feedlr-yt/internal/metrics"
+	"github.com/cufee/feedlr-yt/internal/server/handler"
+	"github.com/cufee/feedlr-yt/internal/templates/components/feed"
+	"github.com/cufee/tpot/brewed"
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog/log"
+)
+
+func tvButtonVariant(ctx *handler.Context) feed.TVButtonVariant {
+	switch variant := feed.TVButtonVariant(ctx.Query("style")); variant {
+	case feed.TVButtonVideo, feed.TVButtonHeader:
+		return variant
+	default:
+		return feed.TVButtonCard
+	}
+}
+
+// tvRemoteResult returns the button state and a message the user can act on
+func tvRemoteResult(err error) (feed.TVButtonState, string) {
+	switch {
+	case err == nil:
+		return feed.TVButtonSent, ""
+	case errors.Is(err, logic.ErrTVNotConnected):
+		return feed.TVButtonFailed, "Your TV is not connected"
+	case errors.Is(err, logic.ErrTVQueueEmpty):
+		return feed.TVButtonFailed, "Nothing left to play"
+	default:
+		return feed.TVButtonFailed, "Could not reach the TV"
+	}
+}
+
+// tvRemoteAction runs a remote action and renders a button with the result, errors are shown on the button instead of failing the request
+func tvRemoteAction(ctx *handler.Context, action string, render func(feed.TVButtonState, string) templ.Component, run func(context.Context, *logic.YouTubeTVSyncService, string) error) (templ.Component, error) {
+	userID, ok := ctx.UserID()
+	if !ok {
+		metrics.IncUserAction(action, "unauthorized")
+		return nil, ctx.SendStatus(http.StatusUnauthorized)
+	}
+
+	service, err := youtubeTVSyncService()
+	if err != nil {
+		metrics.IncUserAction(action, "service_unavailable")
+		return nil, ctx.SendStatus(http.StatusServiceUnavailable)
+	}
+
+	err = run(ctx.Context(), service, userID)
+	if err != nil {
+		metrics.IncUserAction(action, "error")
+		if !errors.Is(err, logic.ErrTVNotConnected) && !errors.Is(err, logic.ErrTVQueueEmpty) {
+			log.Err(err).Str("userID", userID).Str("action", action).Msg("tv remote action failed")
+		}
+	} else {
+		metrics.IncUserAction(action, "success")
+	}
+	return render(tvRemoteResult(err)), nil
+}
+
+var PlayVideoOnTV brewed.Partial[*handler.Context] = func(ctx *handler.Context) (templ.Component, error) {
+	videoID := ctx.Params("id")
+	variant := tvButtonVariant(ctx)
+	return tvRemoteAction(ctx, "tv_play_video",
+		func(state feed.TVButtonState, message string) templ.Component {
+			return feed.PlayOnTVButton(feed.PlayVideoOnTVURL(videoID), variant, state, message)
+		},
+		func(c context.Context, service *logic.YouTubeTVSyncService, userID string) error {
+			return service.PlayVideo(c, userID, ctx.Query("screen"), videoID)
+		},
+	)
+}
+
+var QueueVideoOnTV brewed.Partial[*handler.Context] = func(ctx *handler.Context) (templ.Component, error) {
+	videoID := ctx.Params("id")
+	variant := tvButtonVariant(ctx)
+	return tvRemoteAction(ctx, "tv_queue_video",
+		func(state feed.TVButtonState, message string) templ.Component {
+			return feed.QueueOnTVButton(videoID, variant, state, message)
+		},
+		func(c context.Context, service *logic.YouTubeTVSyncService, userID string) error {
+			return service.QueueVideo(c, userID, ctx.Query("screen"), videoID)
+		},
+	)
+}
+
+var PlayWatchLaterOnTV brewed.Partial[*handler.Context] = func(ctx *handler.Context) (templ.Component, error) {
+	variant := tvButtonVariant(ctx)
+	return tvRemoteAction(ctx, "tv_play_watch_later",
+		func(state feed.TVButtonState, message string) templ.Component {
+			return feed.PlayOnTVButton(feed.PlayWatchLaterOnTVURL, variant, state, message)
+		},
+		func(c context.Context, service *logic.YouTubeTVSyncService, userID string) error {
+			queue, err := logic.GetWatchLaterTVQueue(c, ctx.Database(), userID)
+			if err != nil {
+				return err
+			}
+			return service.PlayQueue(c, userID, ctx.Query("screen"), queue)
+		},
+	)
+}
+
+var PlayPlaylistOnTV brewed.Partial[*handler.Context] = func(ctx *handler.Context) (templ.Component, error) {
+	playlistID := ctx.Params("id")
+	variant := tvButtonVariant(ctx)
+	return tvRemoteAction(ctx, "tv_play_playlist",
+		func(state feed.TVButtonState, message string) templ.Component {
+			return feed.PlayOnTVButton(feed.PlayPlaylistOnTVURL(playlistID), variant, state, message)
+		},
+		func(c context.Context, service *logic.YouTubeTVSyncService, userID string) error {
+			queue, err := logic.GetPlaylistTVQueue(c, ctx.Database(), userID, playlistID)
+			if err != nil {
+				return err
+			}
+			return service.PlayQueue(c, userID, ctx.Query("screen"), queue)
+		},
+	)
+}
+
+var SendTVRemoteCommand brewed.Partial[*handler.Context] = func(ctx *handler.Context) (templ.Component, error) {
+	userID, ok := ctx.UserID()
+	if !ok {
+		metrics.IncUserAction("tv_remote_command", "unauthorized")
+		return nil, ctx.SendStatus(http.StatusUnauthorized)
+	}
+
+	service, err := youtubeTVSyncService()
+	if err != nil {
+		metrics.IncUserAction("tv_remote_command", "service_unavailable")
+		return nil, ctx.SendStatus(http.StatusServiceUnavailable)
+	}
+
+	err = service.SendRemoteCommand(ctx.Context(), userID, ctx.Query("screen"), logic.TVRemoteCommand(ctx.Params("command")))
+	if errors.Is(err, logic.ErrInvalidRemoteCommand) {
+		metrics.IncUserAction("tv_remote_command", "invalid_request")
+		return nil, ctx.SendStatus(http.StatusBadRequest)
+	}
+	if errors.Is(err, logic.ErrTVNotConnected) {
+		metrics.IncUserAction("tv_remote_command", "error")
+		return nil, ctx.SendStatus(http.StatusConflict)
+	}
+	if err != nil {
+		metrics.IncUserAction("tv_remote_command", "error")
+		return nil, err
+	}
+
+	metrics.IncUserAction("tv_remote_command", "success")
+	return nil, ctx.SendStatus(http.StatusOK)
+}
+
+var SetTVVolume brewed.Partial[*handler.Context] = func(ctx *handler.Context) (templ.Component, error) {
+	userID, ok := ctx.UserID()
+	if !ok {
+		metrics.IncUserAction("tv_set_volume", "unauthorized")
+		return nil, ctx.SendStatus(http.StatusUnauthorized)
+	}
+
+	service, err := youtubeTVSyncService()
+	if err != nil {
+		metrics.IncUserAction("tv_set_volume", "service_unavailable")
+		return nil, ctx.SendStatus(http.StatusServiceUnavailable)
+	}
+
+	rawVolume, _ := ctx.FormValue("volume")
+	volume, err := strconv.Atoi(rawVolume)
+	if err != nil || volume < 0 || volume > 100 {
+		metrics.IncUserAction("tv_set_volume", "invalid_request")
+		return nil, ctx.SendStatus(http.StatusBadRequest)
+	}
+
+	err = service.SetVolume(ctx.Context(), userID, ctx.Query("screen"), volume)
+	if errors.Is(err, logic.ErrTVNotConnected) {
+		metrics.IncUserAction("tv_set_volume", "error")
+		return nil, ctx.SendStatus(http.StatusConflict)
+	}
+	if err != nil {
+		metrics.IncUserAction("tv_set_volume", "error")
+		return nil, err
+	}
+
+	metrics.IncUserAction("tv_set_volume", "success")
+	return nil, ctx.SendStatus(http.StatusOK)
+}
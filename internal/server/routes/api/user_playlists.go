@@ -13,7 +13,9 @@ import (
 	"github.com/cufee/feedlr-yt/internal/templates/components/playlist"
 	"github.com/cufee/feedlr-yt/internal/templates/components/shared"
 	"github.com/cufee/feedlr-yt/internal/templates/pages/app"
+	"github.com/cufee/feedlr-yt/internal/types"
 	"github.com/cufee/tpot/brewed"
+	"github.com/pkg/errors"
 )
 
 var CreatePlaylist brewed.Partial[*handler.Context] = func(ctx *handler.Context) (templ.Component, error) {
@@ -27,8 +29,15 @@ var CreatePlaylist brewed.Partial[*handler.Context] = func(ctx *handler.Context)
 		return playlist.CreatePlaylistForm("Playlist name is required"), nil
 	}
 	description, _ := ctx.FormValue("description")
+	limits, _, err := playlistLimitsForm(ctx)
+	if err != nil {
+		return playlist.CreatePlaylistForm("Invalid video limits"), nil
+	}
 
-	p, err := logic.CreateUserPlaylist(ctx.Context(), ctx.Database(), userID, name, description)
+	p, err := logic.CreateUserPlaylist(ctx.Context(), ctx.Database(), userID, name, description, limits)
+	if errors.Is(err, logic.ErrInvalidPlaylistLimits) {
+		return playlist.CreatePlaylistForm("Invalid video limits"), nil
+	}
 	if err != nil {
 		return playlist.CreatePlaylistForm("Failed to create playlist"), nil
 	}
@@ -57,11 +66,21 @@ var UpdatePlaylist brewed.Partial[*handler.Context] = func(ctx *handler.Context)
 	}
 	description, _ := ctx.FormValue("description")
 
-	err = logic.UpdateUserPlaylist(ctx.Context(), ctx.Database(), userID, playlistID, name, description)
+	limits, withLimits, err := playlistLimitsForm(ctx)
+	if err == nil {
+		err = logic.UpdateUserPlaylist(ctx.Context(), ctx.Database(), userID, playlistID, name, description)
+	}
+	if err == nil && withLimits {
+		err = logic.UpdatePlaylistLimits(ctx.Context(), ctx.Database(), userID, playlistID, limits)
+	}
 	if err != nil {
+		message := "Failed to update playlist"
+		if errors.Is(err, logic.ErrInvalidPlaylistLimits) {
+			message = "Invalid video limits"
+		}
 		props, _ := logic.GetPlaylistPageProps(ctx.Context(), ctx.Database(), userID, playlistID)
 		if props != nil {
-			return playlist.EditPlaylistForm(props.Playlist, "Failed to update playlist"), nil
+			return playlist.EditPlaylistForm(props.Playlist, message), nil
 		}
 		return nil, ctx.SendStatus(http.StatusInternalServerError)
 	}
@@ -69,6 +88,29 @@ var UpdatePlaylist brewed.Partial[*handler.Context] = func(ctx *handler.Context)
 	return nil, ctx.Redirect(fmt.Sprintf("/app/playlist/%s", playlistID), http.StatusSeeOther)
 }
 
+// playlistLimitsForm reads the video limits of the create and edit forms, ok is false when the form has no limits
+func playlistLimitsForm(ctx *handler.Context) (limits types.PlaylistLimits, ok bool, err error) {
+	policy, _ := ctx.FormValue("overflow_policy")
+	if policy == "" {
+		return limits, false, nil
+	}
+	limits.OverflowPolicy = policy
+
+	if value, _ := ctx.FormValue("ttl_days"); value != "" {
+		limits.TTLDays, err = strconv.ParseInt(value, 10, 64)
+		if err != nil {
+			return limits, true, logic.ErrInvalidPlaylistLimits
+		}
+	}
+	if value, _ := ctx.FormValue("max_size"); value != "" {
+		limits.MaxSize, err = strconv.ParseInt(value, 10, 64)
+		if err != nil {
+			return limits, true, logic.ErrInvalidPlaylistLimits
+		}
+	}
+	return limits, true, nil
+}
+
 var DeletePlaylist brewed.Partial[*handler.Context] = func(ctx *handler.Context) (templ.Component, error) {
 	userID, ok := ctx.UserID()
 	if !ok {
@@ -104,7 +146,7 @@ var ImportPlaylist brewed.Partial[*handler.Context] = func(ctx *handler.Context)
 		return playlist.ImportPlaylistForm(link, false), nil
 	}
 
-	importCtx, cancel := context.WithTimeout(context.Background(), 60*time.Second)
+	importCtx, cancel := context.WithTimeout(context.Background(), 15*time.Second)
 	defer cancel()
 
 	newPlaylistID, err := logic.ImportYouTubePlaylist(importCtx, ctx.Database(), userID, ytPlaylistID)
@@ -135,12 +177,41 @@ var SyncPlaylist brewed.Partial[*handler.Context] = func(ctx *handler.Context) (
 		if findErr != nil || p.UserID != userID {
 			return nil, ctx.SendStatus(http.StatusInternalServerError)
 		}
-		return shared.RefreshButton(fmt.Sprintf("/api/playlists/%s/sync", playlistID), p.UpdatedAt), nil
+		return shared.RefreshButton(fmt.Sprintf("/api/playlists/%s/sync", playlistID), types.PlaylistModelToProps(p, 0, 0, "").SyncedAt()), nil
 	}
 
 	return nil, ctx.Redirect(fmt.Sprintf("/app/playlist/%s", playlistID), http.StatusSeeOther)
 }
 
+var UpdatePlaylistAutoSync brewed.Partial[*handler.Context] = func(ctx *handler.Context) (templ.Component, error) {
+	userID, ok := ctx.UserID()
+	if !ok {
+		return nil, ctx.SendStatus(http.StatusUnauthorized)
+	}
+
+	playlistID := ctx.Params("id")
+	if playlistID == "" {
+		return nil, ctx.SendStatus(http.StatusBadRequest)
+	}
+
+	interval, _ := ctx.FormValue("interval")
+	mode, _ := ctx.FormValue("mode")
+	hours, err := strconv.ParseInt(interval, 10, 64)
+	if err == nil {
+		err = logic.UpdatePlaylistAutoSync(ctx.Context(), ctx.Database(), userID, playlistID, hours, mode)
+	}
+
+	p, findErr := ctx.Database().GetPlaylistByID(ctx.Context(), playlistID)
+	if findErr != nil || p.UserID != userID {
+		return nil, ctx.SendStatus(http.StatusNotFound)
+	}
+	props := types.PlaylistModelToProps(p, 0, 0, "")
+	if err != nil {
+		return playlist.AutoSyncSettings(props, "Failed to save auto sync settings"), nil
+	}
+	return playlist.AutoSyncSettings(props, ""), nil
+}
+
 var AddVideoToPlaylist brewed.Partial[*handler.Context] = func(ctx *handler.Context) (templ.Component, error) {
 	userID, ok := ctx.UserID()
 	if !ok {
@@ -162,7 +233,7 @@ var AddVideoToPlaylist brewed.Partial[*handler.Context] = func(ctx *handler.Cont
 	}
 
 	// Return refreshed select
-	playlists, _ := logic.GetUserPlaylistsProps(ctx.Context(), ctx.Database(), userID)
+	playlists, _ := logic.GetEditablePlaylistsProps(ctx.Context(), ctx.Database(), userID)
 	membership, _ = logic.GetVideoPlaylistMembership(ctx.Context(), ctx.Database(), userID, videoID)
 	return shared.AddToPlaylistSelect(videoID, playlists, membership), nil
 }
@@ -223,11 +294,61 @@ var MovePlaylistItem brewed.Partial[*handler.Context] = func(ctx *handler.Contex
 	playlistID := ctx.Params("id")
 	videoID := ctx.Params("videoID")
 	direction := ctx.Query("direction")
-	if playlistID == "" || videoID == "" || direction == "" {
+	index, indexErr := strconv.Atoi(ctx.Query("index"))
+	if playlistID == "" || videoID == "" || (direction == "" && indexErr != nil) {
+		return nil, ctx.SendStatus(http.StatusBadRequest)
+	}
+
+	if indexErr == nil {
+		_ = logic.MovePlaylistItemToIndex(ctx.Context(), ctx.Database(), userID, playlistID, videoID, index)
+	} else {
+		_ = logic.MovePlaylistItem(ctx.Context(), ctx.Database(), userID, playlistID, videoID, direction)
+	}
+
+	props, err := logic.GetPlaylistPageProps(ctx.Context(), ctx.Database(), userID, playlistID)
+	if err != nil {
+		return nil, ctx.SendStatus(http.StatusInternalServerError)
+	}
+
+	return app.PlaylistVideoFeed(*props), nil
+}
+
+var SetPlaylistOrder brewed.Partial[*handler.Context] = func(ctx *handler.Context) (templ.Component, error) {
+	userID, ok := ctx.UserID()
+	if !ok {
+		return nil, ctx.SendStatus(http.StatusUnauthorized)
+	}
+
+	playlistID := ctx.Params("id")
+	videoIDs, err := ctx.FormValues("video")
+	if playlistID == "" || err != nil || len(videoIDs) == 0 {
+		return nil, ctx.SendStatus(http.StatusBadRequest)
+	}
+
+	_ = logic.SetPlaylistOrder(ctx.Context(), ctx.Database(), userID, playlistID, videoIDs)
+
+	props, err := logic.GetPlaylistPageProps(ctx.Context(), ctx.Database(), userID, playlistID)
+	if err != nil {
+		return nil, ctx.SendStatus(http.StatusInternalServerError)
+	}
+
+	return app.PlaylistVideoFeed(*props), nil
+}
+
+var SortPlaylist brewed.Partial[*handler.Context] = func(ctx *handler.Context) (templ.Component, error) {
+	userID, ok := ctx.UserID()
+	if !ok {
+		return nil, ctx.SendStatus(http.StatusUnauthorized)
+	}
+
+	playlistID := ctx.Params("id")
+	by, _ := ctx.FormValue("by")
+	sort, valid := types.ParsePlaylistSort(by)
+	if playlistID == "" || !valid {
 		return nil, ctx.SendStatus(http.StatusBadRequest)
 	}
 
-	_ = logic.MovePlaylistItem(ctx.Context(), ctx.Database(), userID, playlistID, videoID, direction)
+	_ = logic.SortPlaylist(ctx.Context(), ctx.Database(), userID, playlistID, sort)
 
 	props, err := logic.GetPlaylistPageProps(ctx.Context(), ctx.Database(), userID, playlistID)
 	if err != nil {
@@ -56,7 +56,7 @@ var SaveVideoProgress brewed.Partial[*handler.Context] = func(ctx *handler.Conte
 	}
 
 	metrics.IncUserAction("save_video_progress", "success")
-	return feed.VideoCard(props.Video, feed.WithProgressActions, feed.WithProgressBar, feed.WithProgressOverlay), nil
+	return feed.VideoCard(props.Video, feed.WithProgressActions, feed.WithProgressBar, feed.WithProgressOverlay, feed.WithPlayOnTV(props.PlayOnTV)), nil
 }
 
 var OpenVideo brewed.Partial[*handler.Context] = func(ctx *handler.Context) (templ.Component, error) {
@@ -0,0 +1,73 @@
+package api
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/a-h/templ"
+	"github.com/cufee/feedlr-yt/internal/logic"
+	"github.com/cufee/feedlr-yt/internal/metrics"
+	"github.com/cufee/feedlr-yt/internal/server/handler"
+	"github.com/cufee/feedlr-yt/internal/templates/components/settings"
+	"github.com/cufee/tpot/brewed"
+	"github.com/pkg/errors"
+)
+
+// WatchHistoryUploadLimit is the largest Takeout file accepted, the body limit of the import route leaves room for it
+const WatchHistoryUploadLimit = 64 << 20
+
+func watchHistorySettings(ctx *handler.Context, userID, message string) (templ.Component, error) {
+	props, err := logic.GetWatchHistoryImportProps(ctx.Context(), ctx.Database(), userID)
+	if err != nil {
+		return nil, err
+	}
+	return settings.WatchHistorySettings(props, message), nil
+}
+
+var WatchHistoryImportStatus brewed.Partial[*handler.Context] = func(ctx *handler.Context) (templ.Component, error) {
+	userID, ok := ctx.UserID()
+	if !ok {
+		return nil, ctx.SendStatus(http.StatusUnauthorized)
+	}
+	return watchHistorySettings(ctx, userID, "")
+}
+
+var ImportWatchHistory brewed.Partial[*handler.Context] = func(ctx *handler.Context) (templ.Component, error) {
+	userID, ok := ctx.UserID()
+	if !ok {
+		metrics.IncUserAction("import_watch_history", "unauthorized")
+		return nil, ctx.SendStatus(http.StatusUnauthorized)
+	}
+
+	file, _, err := ctx.Request().FormFile("history")
+	if err != nil {
+		metrics.IncUserAction("import_watch_history", "invalid_request")
+		return watchHistorySettings(ctx, userID, "Pick the watch-history.json or watch-history.html file from your Google Takeout export.")
+	}
+	defer file.Close()
+
+	data, err := io.ReadAll(io.LimitReader(file, WatchHistoryUploadLimit+1))
+	if err != nil {
+		metrics.IncUserAction("import_watch_history", "invalid_request")
+		return watchHistorySettings(ctx, userID, "Failed to read the uploaded file.")
+	}
+	if len(data) > WatchHistoryUploadLimit {
+		metrics.IncUserAction("import_watch_history", "invalid_request")
+		return watchHistorySettings(ctx, userID, fmt.Sprintf("The file is larger than %d MB, export the history as JSON to get a smaller file.", WatchHistoryUploadLimit>>20))
+	}
+
+	mode, _ := ctx.FormValue("mode")
+	err = logic.StartWatchHistoryImport(ctx.Context(), ctx.Database(), userID, data, mode == "hide")
+	if errors.Is(err, logic.ErrWatchHistoryImportRunning) {
+		metrics.IncUserAction("import_watch_history", "duplicate")
+		return watchHistorySettings(ctx, userID, err.Error())
+	}
+	if err != nil {
+		metrics.IncUserAction("import_watch_history", "error")
+		return watchHistorySettings(ctx, userID, err.Error())
+	}
+
+	metrics.IncUserAction("import_watch_history", "success")
+	return watchHistorySettings(ctx, userID, "")
+}
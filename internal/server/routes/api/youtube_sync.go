@@ -1,14 +1,17 @@
 package api
 
 import (
-	"context"
+	"fmt"
 	"net/http"
 	"strconv"
 	"time"
 
+	"github.com/a-h/templ"
 	"github.com/cufee/feedlr-yt/internal/logic"
 	"github.com/cufee/feedlr-yt/internal/metrics"
 	"github.com/cufee/feedlr-yt/internal/server/handler"
+	"github.com/cufee/feedlr-yt/internal/templates/components/settings"
+	"github.com/cufee/feedlr-yt/internal/types"
 	"github.com/cufee/tpot/brewed"
 	"github.com/gofiber/fiber/v2"
 	"github.com/google/uuid"
@@ -16,7 +19,15 @@ import (
 	"github.com/rs/zerolog/log"
 )
 
-const youtubeSyncStateCookie = "youtube_sync_state"
+const (
+	youtubeSyncStateCookie  = "youtube_sync_state"
+	youtubeSyncReturnCookie = "youtube_sync_return"
+)
+
+// youtubeSyncReturnPaths are the pages the connect flow can send the user back to, everything else returns to settings
+var youtubeSyncReturnPaths = map[string]string{
+	"subscriptions-import": "/app/subscriptions/import",
+}
 
 func youtubeSyncService() (*logic.YouTubeSyncService, error) {
 	if logic.DefaultYouTubeSync == nil {
@@ -32,6 +43,17 @@ func youtubeTVSyncService() (*logic.YouTubeTVSyncService, error) {
 	return logic.DefaultYouTubeTVSync, nil
 }
 
+func parseScreenForm(ctx *handler.Context) (string, error) {
+	accountID, err := ctx.FormValue("screen")
+	if err != nil {
+		return "", err
+	}
+	if accountID == "" {
+		return "", errors.New("missing screen")
+	}
+	return accountID, nil
+}
+
 func parseEnabledForm(ctx *handler.Context) (bool, error) {
 	rawEnabled, err := ctx.FormValue("enabled")
 	if err != nil {
@@ -67,6 +89,18 @@ var BeginYouTubeSyncConnect brewed.Endpoint[*handler.Context] = func(ctx *handle
 		Expires:  time.Now().Add(10 * time.Minute),
 	})
 
+	returnTo, _ := ctx.FormValue("return")
+	if _, ok := youtubeSyncReturnPaths[returnTo]; ok {
+		ctx.Cookie(&fiber.Cookie{
+			Name:     youtubeSyncReturnCookie,
+			Value:    returnTo,
+			Path:     "/",
+			HTTPOnly: true,
+			SameSite: "Lax",
+			Expires:  time.Now().Add(10 * time.Minute),
+		})
+	}
+
 	redirectURL := service.OAuthAuthURL(state + ":" + userID)
 	metrics.IncUserAction("youtube_sync_begin_connect", "success")
 	return ctx.Redirect(redirectURL, http.StatusTemporaryRedirect)
@@ -95,6 +129,18 @@ var FinishYouTubeSyncConnect brewed.Endpoint[*handler.Context] = func(ctx *handl
 		Expires:  time.Now().Add(-time.Hour),
 	})
 
+	returnPath, ok := youtubeSyncReturnPaths[ctx.Cookies(youtubeSyncReturnCookie)]
+	if !ok {
+		returnPath = "/app/settings"
+	}
+	ctx.Cookie(&fiber.Cookie{
+		Name:     youtubeSyncReturnCookie,
+		Value:    "",
+		Path:     "/",
+		HTTPOnly: true,
+		Expires:  time.Now().Add(-time.Hour),
+	})
+
 	if queryState == "" || cookieState == "" || queryState != cookieState+":"+userID {
 		metrics.IncUserAction("youtube_sync_finish_connect", "invalid_state")
 		return ctx.Err(errors.New("invalid oauth state"))
@@ -112,17 +158,13 @@ var FinishYouTubeSyncConnect brewed.Endpoint[*handler.Context] = func(ctx *handl
 		return ctx.Err(err)
 	}
 
-	go func(userID string) {
-		runCtx, cancel := context.WithTimeout(context.Background(), 2*time.Minute)
-		defer cancel()
-
-		if err := service.RunSyncForUser(runCtx, userID); err != nil {
-			log.Warn().Err(err).Str("userID", userID).Msg("initial youtube sync failed")
-		}
-	}(userID)
+	_, err = logic.JobYouTubeSync.EnqueueWithPriority(ctx.Context(), ctx.Database(), logic.YouTubeSyncJob{UserID: userID}, logic.JobPriorityHigh)
+	if err != nil {
+		log.Warn().Err(err).Str("userID", userID).Msg("failed to queue initial youtube sync")
+	}
 
 	metrics.IncUserAction("youtube_sync_finish_connect", "success")
-	return ctx.Redirect("/app/settings", http.StatusTemporaryRedirect)
+	return ctx.Redirect(returnPath, http.StatusTemporaryRedirect)
 }
 
 var DisconnectYouTubeSync brewed.Endpoint[*handler.Context] = func(ctx *handler.Context) error {
@@ -176,6 +218,129 @@ var ToggleYouTubeSync brewed.Endpoint[*handler.Context] = func(ctx *handler.Cont
 	return ctx.Redirect("/app/settings", http.StatusTemporaryRedirect)
 }
 
+func parseSyncTargetForm(ctx *handler.Context) (string, error) {
+	targetID, err := ctx.FormValue("target")
+	if err != nil {
+		return "", err
+	}
+	if targetID == "" {
+		return "", errors.New("missing target")
+	}
+	return targetID, nil
+}
+
+// parseSyncTargetOptionsForm reads the title, size and ordering of a target, a missing size falls back to the default
+func parseSyncTargetOptionsForm(ctx *handler.Context) (string, int, types.YouTubeSyncOrdering) {
+	title, _ := ctx.FormValue("title")
+	rawSize, _ := ctx.FormValue("size")
+	ordering, _ := ctx.FormValue("ordering")
+
+	size, err := strconv.Atoi(rawSize)
+	if err != nil {
+		size = 0
+	}
+	return title, size, types.YouTubeSyncOrdering(ordering)
+}
+
+var CreateYouTubeSyncTarget brewed.Endpoint[*handler.Context] = func(ctx *handler.Context) error {
+	userID, ok := ctx.UserID()
+	if !ok {
+		metrics.IncUserAction("youtube_sync_target_create", "unauthorized")
+		return ctx.SendStatus(http.StatusUnauthorized)
+	}
+
+	service, err := youtubeSyncService()
+	if err != nil {
+		metrics.IncUserAction("youtube_sync_target_create", "service_unavailable")
+		return ctx.Err(err)
+	}
+
+	source, _ := ctx.FormValue("source")
+	title, size, ordering := parseSyncTargetOptionsForm(ctx)
+
+	_, err = service.CreateTarget(ctx.Context(), userID, source, title, size, ordering)
+	if err != nil {
+		metrics.IncUserAction("youtube_sync_target_create", "error")
+		return ctx.Err(err)
+	}
+
+	_, err = logic.JobYouTubeSync.EnqueueWithPriority(ctx.Context(), ctx.Database(), logic.YouTubeSyncJob{UserID: userID}, logic.JobPriorityHigh)
+	if err != nil {
+		log.Warn().Err(err).Str("userID", userID).Msg("failed to queue youtube sync for a new target")
+	}
+
+	metrics.IncUserAction("youtube_sync_target_create", "success")
+	return ctx.Redirect("/app/settings", http.StatusTemporaryRedirect)
+}
+
+var UpdateYouTubeSyncTarget brewed.Endpoint[*handler.Context] = func(ctx *handler.Context) error {
+	userID, ok := ctx.UserID()
+	if !ok {
+		metrics.IncUserAction("youtube_sync_target_update", "unauthorized")
+		return ctx.SendStatus(http.StatusUnauthorized)
+	}
+
+	service, err := youtubeSyncService()
+	if err != nil {
+		metrics.IncUserAction("youtube_sync_target_update", "service_unavailable")
+		return ctx.Err(err)
+	}
+
+	targetID, err := parseSyncTargetForm(ctx)
+	if err != nil {
+		metrics.IncUserAction("youtube_sync_target_update", "invalid_request")
+		return ctx.Err(err)
+	}
+	source, _ := ctx.FormValue("source")
+	title, size, ordering := parseSyncTargetOptionsForm(ctx)
+
+	err = service.UpdateTarget(ctx.Context(), userID, targetID, source, title, size, ordering)
+	if err != nil {
+		metrics.IncUserAction("youtube_sync_target_update", "error")
+		return ctx.Err(err)
+	}
+
+	if source != "" {
+		// The source may have changed, sync right away so the playlist does not keep the old videos until the next scheduled sync
+		_, err = logic.JobYouTubeSync.EnqueueWithPriority(ctx.Context(), ctx.Database(), logic.YouTubeSyncJob{UserID: userID}, logic.JobPriorityHigh)
+		if err != nil {
+			log.Warn().Err(err).Str("userID", userID).Msg("failed to queue youtube sync for an updated target")
+		}
+	}
+
+	metrics.IncUserAction("youtube_sync_target_update", "success")
+	return ctx.Redirect("/app/settings", http.StatusTemporaryRedirect)
+}
+
+var DeleteYouTubeSyncTarget brewed.Endpoint[*handler.Context] = func(ctx *handler.Context) error {
+	userID, ok := ctx.UserID()
+	if !ok {
+		metrics.IncUserAction("youtube_sync_target_delete", "unauthorized")
+		return ctx.SendStatus(http.StatusUnauthorized)
+	}
+
+	service, err := youtubeSyncService()
+	if err != nil {
+		metrics.IncUserAction("youtube_sync_target_delete", "service_unavailable")
+		return ctx.Err(err)
+	}
+
+	targetID, err := parseSyncTargetForm(ctx)
+	if err != nil {
+		metrics.IncUserAction("youtube_sync_target_delete", "invalid_request")
+		return ctx.Err(err)
+	}
+
+	err = service.DeleteTarget(ctx.Context(), userID, targetID)
+	if err != nil {
+		metrics.IncUserAction("youtube_sync_target_delete", "error")
+		return ctx.Err(err)
+	}
+
+	metrics.IncUserAction("youtube_sync_target_delete", "success")
+	return ctx.Redirect("/app/settings", http.StatusTemporaryRedirect)
+}
+
 var ConnectYouTubeTVSync brewed.Endpoint[*handler.Context] = func(ctx *handler.Context) error {
 	userID, ok := ctx.UserID()
 	if !ok {
@@ -222,7 +387,13 @@ var DisconnectYouTubeTVSync brewed.Endpoint[*handler.Context] = func(ctx *handle
 		return ctx.Err(err)
 	}
 
-	err = service.Disconnect(ctx.Context(), userID)
+	accountID, err := parseScreenForm(ctx)
+	if err != nil {
+		metrics.IncUserAction("youtube_tv_sync_disconnect", "invalid_request")
+		return ctx.Err(err)
+	}
+
+	err = service.Disconnect(ctx.Context(), userID, accountID)
 	if err != nil {
 		metrics.IncUserAction("youtube_tv_sync_disconnect", "error")
 		return ctx.Err(err)
@@ -245,13 +416,18 @@ var ToggleYouTubeTVSync brewed.Endpoint[*handler.Context] = func(ctx *handler.Co
 		return ctx.Err(err)
 	}
 
+	accountID, err := parseScreenForm(ctx)
+	if err != nil {
+		metrics.IncUserAction("youtube_tv_sync_toggle", "invalid_request")
+		return ctx.Err(err)
+	}
 	enabled, err := parseEnabledForm(ctx)
 	if err != nil {
 		metrics.IncUserAction("youtube_tv_sync_toggle", "invalid_request")
 		return ctx.Err(err)
 	}
 
-	err = service.SetEnabled(ctx.Context(), userID, enabled)
+	err = service.SetEnabled(ctx.Context(), userID, accountID, enabled)
 	if err != nil {
 		metrics.IncUserAction("youtube_tv_sync_toggle", "error")
 		return ctx.Err(err)
@@ -259,3 +435,158 @@ var ToggleYouTubeTVSync brewed.Endpoint[*handler.Context] = func(ctx *handler.Co
 	metrics.IncUserAction("youtube_tv_sync_toggle", "success")
 	return ctx.Redirect("/app/settings", http.StatusTemporaryRedirect)
 }
+
+var UpdateYouTubeTVScreen brewed.Endpoint[*handler.Context] = func(ctx *handler.Context) error {
+	userID, ok := ctx.UserID()
+	if !ok {
+		metrics.IncUserAction("youtube_tv_screen_update", "unauthorized")
+		return ctx.SendStatus(http.StatusUnauthorized)
+	}
+
+	service, err := youtubeTVSyncService()
+	if err != nil {
+		metrics.IncUserAction("youtube_tv_screen_update", "service_unavailable")
+		return ctx.Err(err)
+	}
+
+	accountID, err := parseScreenForm(ctx)
+	if err != nil {
+		metrics.IncUserAction("youtube_tv_screen_update", "invalid_request")
+		return ctx.Err(err)
+	}
+	name, _ := ctx.FormValue("name")
+	mode, _ := ctx.FormValue("sponsorblock_mode")
+
+	err = service.UpdateScreen(ctx.Context(), userID, accountID, name, types.TVSponsorBlockMode(mode))
+	if err != nil {
+		metrics.IncUserAction("youtube_tv_screen_update", "error")
+		return ctx.Err(err)
+	}
+
+	metrics.IncUserAction("youtube_tv_screen_update", "success")
+	return ctx.Redirect("/app/settings", http.StatusTemporaryRedirect)
+}
+
+var YouTubeTVSyncJournal brewed.Partial[*handler.Context] = func(ctx *handler.Context) (templ.Component, error) {
+	userID, ok := ctx.UserID()
+	if !ok {
+		metrics.IncUserAction("youtube_tv_journal", "unauthorized")
+		return nil, ctx.SendStatus(http.StatusUnauthorized)
+	}
+
+	service, err := youtubeTVSyncService()
+	if err != nil {
+		metrics.IncUserAction("youtube_tv_journal", "service_unavailable")
+		return nil, ctx.SendStatus(http.StatusServiceUnavailable)
+	}
+
+	accountID, err := parseScreenForm(ctx)
+	if err != nil {
+		metrics.IncUserAction("youtube_tv_journal", "invalid_request")
+		return nil, ctx.SendStatus(http.StatusBadRequest)
+	}
+
+	events, err := service.Journal(ctx.Context(), userID, accountID)
+	if errors.Is(err, logic.ErrTVScreenNotFound) {
+		metrics.IncUserAction("youtube_tv_journal", "invalid_request")
+		return nil, ctx.SendStatus(http.StatusNotFound)
+	}
+	if err != nil {
+		metrics.IncUserAction("youtube_tv_journal", "error")
+		return nil, err
+	}
+
+	metrics.IncUserAction("youtube_tv_journal", "success")
+	return settings.TVSyncJournal(events, false), nil
+}
+
+var UpdateYouTubeTVAutoplay brewed.Endpoint[*handler.Context] = func(ctx *handler.Context) error {
+	userID, ok := ctx.UserID()
+	if !ok {
+		metrics.IncUserAction("youtube_tv_autoplay_update", "unauthorized")
+		return ctx.SendStatus(http.StatusUnauthorized)
+	}
+
+	mode, _ := ctx.FormValue("mode")
+	playlistID, _ := ctx.FormValue("playlist")
+
+	_, err := logic.SetTVAutoplay(ctx.Context(), ctx.Database(), userID, types.TVAutoplayMode(mode), playlistID)
+	if err != nil {
+		metrics.IncUserAction("youtube_tv_autoplay_update", "invalid_request")
+		return ctx.Err(err)
+	}
+
+	metrics.IncUserAction("youtube_tv_autoplay_update", "success")
+	return ctx.Redirect("/app/settings", http.StatusTemporaryRedirect)
+}
+
+var SkipYouTubeTVToNextChapter brewed.Partial[*handler.Context] = func(ctx *handler.Context) (templ.Component, error) {
+	userID, ok := ctx.UserID()
+	if !ok {
+		metrics.IncUserAction("youtube_tv_next_chapter", "unauthorized")
+		return nil, ctx.SendStatus(http.StatusUnauthorized)
+	}
+
+	service, err := youtubeTVSyncService()
+	if err != nil {
+		metrics.IncUserAction("youtube_tv_next_chapter", "service_unavailable")
+		return nil, ctx.SendStatus(http.StatusServiceUnavailable)
+	}
+
+	accountID, err := parseScreenForm(ctx)
+	if err != nil {
+		metrics.IncUserAction("youtube_tv_next_chapter", "invalid_request")
+		return nil, ctx.SendStatus(http.StatusBadRequest)
+	}
+
+	// Errors are shown next to the button, like the other TV remote actions
+	var message string
+	err = service.SkipToNextChapter(ctx.Context(), userID, accountID)
+	switch {
+	case err == nil:
+		message = "Skipped to the next chapter"
+	case errors.Is(err, logic.ErrTVNotConnected):
+		message = "Your TV is not connected"
+	case errors.Is(err, logic.ErrTVNotPlaying):
+		message = "Nothing is playing"
+	case errors.Is(err, logic.ErrNoNextChapter):
+		message = "This is the last chapter"
+	default:
+		log.Err(err).Str("userID", userID).Str("accountID", accountID).Msg("failed to skip tv to next chapter")
+		message = "Could not reach the TV"
+	}
+	if err != nil {
+		metrics.IncUserAction("youtube_tv_next_chapter", "error")
+	} else {
+		metrics.IncUserAction("youtube_tv_next_chapter", "success")
+	}
+	return settings.TVNextChapterButton(accountID, message), nil
+}
+
+var ImportYouTubeSubscriptions brewed.Endpoint[*handler.Context] = func(ctx *handler.Context) error {
+	userID, ok := ctx.UserID()
+	if !ok {
+		metrics.IncUserAction("import_youtube_subscriptions", "unauthorized")
+		return ctx.SendStatus(http.StatusUnauthorized)
+	}
+
+	if _, err := youtubeSyncService(); err != nil {
+		metrics.IncUserAction("import_youtube_subscriptions", "service_unavailable")
+		return ctx.Err(err)
+	}
+
+	channelIDs, err := ctx.FormValues("channel")
+	if err != nil {
+		metrics.IncUserAction("import_youtube_subscriptions", "invalid_request")
+		return ctx.Err(err)
+	}
+
+	queued, err := logic.EnqueueSubscriptionImport(ctx.Context(), ctx.Database(), userID, channelIDs)
+	if err != nil {
+		metrics.IncUserAction("import_youtube_subscriptions", "error")
+		return ctx.Err(err)
+	}
+
+	metrics.IncUserAction("import_youtube_subscriptions", "success")
+	return ctx.Redirect(fmt.Sprintf("/app/subscriptions/import?queued=%d", queued), http.StatusTemporaryRedirect)
+}
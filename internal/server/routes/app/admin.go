@@ -4,6 +4,7 @@ import (
 	"net/http"
 
 	"github.com/a-h/templ"
+	"github.com/cufee/feedlr-yt/internal/logic"
 	"github.com/cufee/feedlr-yt/internal/permissions"
 	"github.com/cufee/feedlr-yt/internal/server/handler"
 	"github.com/cufee/tpot/brewed"
@@ -30,5 +31,20 @@ var Admin brewed.Page[*handler.Context] = func(ctx *handler.Context) (brewed.Lay
 		return nil, nil, nil
 	}
 
-	return layouts.App, app.Admin(), nil
+	events, err := logic.GetRecentTVSyncEvents(ctx.Context(), ctx.Database())
+	if err != nil {
+		return nil, nil, ctx.Err(err)
+	}
+
+	leases, err := logic.GetLeases(ctx.Context(), ctx.Database())
+	if err != nil {
+		return nil, nil, ctx.Err(err)
+	}
+
+	jobs, err := logic.GetJobsOverview(ctx.Context(), ctx.Database())
+	if err != nil {
+		return nil, nil, ctx.Err(err)
+	}
+
+	return layouts.App, app.Admin(events, leases, jobs, logic.LeaseHolderID()), nil
 }
@@ -2,6 +2,7 @@ package app
 
 import (
 	"net/http"
+	"slices"
 
 	"github.com/a-h/templ"
 	"github.com/cufee/feedlr-yt/internal/logic"
@@ -35,6 +36,14 @@ var Home brewed.Page[*handler.Context] = func(ctx *handler.Context) (brewed.Layo
 		props.WatchLater = watchLater
 	}
 
+	settings, err := logic.GetUserSettings(ctx.Context(), ctx.Database(), userID)
+	if err == nil {
+		logic.AddSponsorBlockLabels(ctx.Context(), ctx.Database(), settings.SponsorBlock, props.New)
+		logic.AddSponsorBlockLabels(ctx.Context(), ctx.Database(), settings.SponsorBlock, props.Watched)
+		logic.AddSponsorBlockLabels(ctx.Context(), ctx.Database(), settings.SponsorBlock, props.WatchLater)
+		logic.PrefetchFeedSponsorBlockSegments(ctx.Database(), settings.SponsorBlock, slices.Concat(props.WatchLater, props.New)...)
+	}
+
 	return layouts.App, app.VideosFeed(*props), nil
 
 }
@@ -7,6 +7,7 @@ import (
 	"github.com/a-h/templ"
 	"github.com/cufee/feedlr-yt/internal/logic"
 	"github.com/cufee/feedlr-yt/internal/server/handler"
+	"github.com/cufee/feedlr-yt/internal/types"
 	"github.com/cufee/tpot/brewed"
 
 	"github.com/cufee/feedlr-yt/internal/templates/layouts"
@@ -26,8 +27,15 @@ var PlaylistsIndex brewed.Page[*handler.Context] = func(ctx *handler.Context) (b
 		return nil, nil, nil
 	}
 
+	channels, err := logic.GetUserSubscribedChannels(ctx.Context(), ctx.Database(), userID)
+	if err != nil {
+		ctx.Err(err)
+		return nil, nil, nil
+	}
+
 	props := app.PlaylistsPageProps{
 		Playlists: playlists,
+		Channels:  channels,
 	}
 
 	return layouts.App, app.PlaylistsIndex(props), nil
@@ -52,7 +60,22 @@ var PlaylistDetail brewed.Page[*handler.Context] = func(ctx *handler.Context) (b
 		return nil, nil, nil
 	}
 
-	return layouts.App, app.PlaylistDetail(*props), nil
+	var channels []types.ChannelProps
+	if props.Playlist.Smart {
+		channels, err = logic.GetUserSubscribedChannels(ctx.Context(), ctx.Database(), userID)
+		if err != nil {
+			ctx.Err(err)
+			return nil, nil, nil
+		}
+	}
+
+	shareLinks, err := logic.GetPlaylistShareLinks(ctx.Context(), ctx.Database(), userID, playlistID)
+	if err != nil {
+		ctx.Err(err)
+		return nil, nil, nil
+	}
+
+	return layouts.App, app.PlaylistDetail(*props, channels, shareLinks), nil
 }
 
 var PlaylistFeed brewed.Partial[*handler.Context] = func(ctx *handler.Context) (templ.Component, error) {
@@ -0,0 +1,39 @@
+package app
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/cufee/feedlr-yt/internal/logic"
+	"github.com/cufee/feedlr-yt/internal/server/handler"
+	"github.com/cufee/tpot/brewed"
+	"github.com/rs/zerolog/log"
+)
+
+var PlayQueue brewed.Endpoint[*handler.Context] = func(ctx *handler.Context) error {
+	userID, ok := ctx.UserID()
+	if !ok {
+		return ctx.Redirect("/login", http.StatusTemporaryRedirect)
+	}
+
+	returnURL := ctx.Query("return", "/app")
+	if !strings.HasPrefix(returnURL, "/") || strings.HasPrefix(returnURL, "//") {
+		returnURL = "/app"
+	}
+
+	var shuffle int64
+	if ctx.Query("shuffle") == "true" {
+		shuffle = logic.NewPlayerQueueShuffleSeed()
+	}
+
+	source := ctx.Query("source")
+	queue, err := logic.GetPlayerQueue(ctx.Context(), ctx.Database(), userID, source, "", returnURL, shuffle)
+	if err != nil {
+		log.Warn().Err(err).Str("userID", userID).Str("queue", source).Msg("failed to start player queue")
+		return ctx.Redirect(returnURL, http.StatusTemporaryRedirect)
+	}
+	if queue.Next == nil {
+		return ctx.Redirect(returnURL, http.StatusTemporaryRedirect)
+	}
+	return ctx.Redirect(queue.VideoURL(queue.Next.ID), http.StatusTemporaryRedirect)
+}
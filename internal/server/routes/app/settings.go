@@ -24,6 +24,7 @@ var Settings brewed.Page[*handler.Context] = func(ctx *handler.Context) (brewed.
 	if err != nil {
 		return nil, nil, ctx.Err(err)
 	}
+	props.DeArrowAvailable = logic.DeArrowAvailable()
 
 	if logic.DefaultYouTubeSync != nil {
 		props.YouTubeSync, err = logic.DefaultYouTubeSync.Status(ctx.Context(), userID)
@@ -39,6 +40,16 @@ var Settings brewed.Page[*handler.Context] = func(ctx *handler.Context) (brewed.
 		}
 	}
 
+	props.WatchHistoryImport, err = logic.GetWatchHistoryImportProps(ctx.Context(), ctx.Database(), userID)
+	if err != nil {
+		return nil, nil, ctx.Err(err)
+	}
+
+	props.WatchLaterTTLDays, err = logic.GetWatchLaterTTL(ctx.Context(), ctx.Database(), userID)
+	if err != nil {
+		return nil, nil, ctx.Err(err)
+	}
+
 	passkeys, err := ctx.Database().GetUserPasskeys(ctx.Context(), userID)
 	if err != nil && !database.IsErrNotFound(err) {
 		return nil, nil, ctx.Err(err)
@@ -2,6 +2,7 @@ package app
 
 import (
 	"net/http"
+	"strconv"
 
 	"github.com/a-h/templ"
 	"github.com/cufee/feedlr-yt/internal/logic"
@@ -23,5 +24,26 @@ var Subscriptions brewed.Page[*handler.Context] = func(ctx *handler.Context) (br
 		return nil, nil, ctx.Err(err)
 	}
 
-	return layouts.App, app.Subscriptions(subscriptions), nil
+	return layouts.App, app.Subscriptions(subscriptions, logic.DefaultYouTubeSync != nil), nil
+}
+
+var SubscriptionsImport brewed.Page[*handler.Context] = func(ctx *handler.Context) (brewed.Layout[*handler.Context], templ.Component, error) {
+	userID, ok := ctx.UserID()
+	if !ok {
+		ctx.Redirect("/login", http.StatusTemporaryRedirect)
+		return nil, nil, nil
+	}
+
+	if logic.DefaultYouTubeSync == nil {
+		ctx.Redirect("/app/subscriptions", http.StatusTemporaryRedirect)
+		return nil, nil, nil
+	}
+
+	props, err := logic.DefaultYouTubeSync.SubscriptionImportProps(ctx.Context(), userID)
+	if err != nil {
+		return nil, nil, ctx.Err(err)
+	}
+	queued, _ := strconv.Atoi(ctx.Query("queued"))
+
+	return layouts.App, app.SubscriptionsImport(props, queued), nil
 }
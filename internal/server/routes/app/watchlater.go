@@ -38,9 +38,10 @@ var WatchLater brewed.Page[*handler.Context] = func(ctx *handler.Context) (brewe
 	}
 
 	props := app.WatchLaterPageProps{
-		Videos:  videos,
-		Page:    page,
-		HasMore: hasMore,
+		Videos:   videos,
+		Page:     page,
+		HasMore:  hasMore,
+		PlayOnTV: logic.TVRemoteAvailable(userID),
 	}
 
 	return layouts.App, app.WatchLater(props), nil
@@ -0,0 +1,34 @@
+package root
+
+import (
+	"net/http"
+
+	"github.com/a-h/templ"
+	"github.com/cufee/feedlr-yt/internal/logic"
+	"github.com/cufee/feedlr-yt/internal/server/handler"
+	"github.com/cufee/feedlr-yt/internal/templates/layouts"
+	"github.com/cufee/feedlr-yt/internal/templates/pages"
+	"github.com/cufee/tpot/brewed"
+	"github.com/pkg/errors"
+)
+
+/*
+SharedPlaylist is the read-only page of a playlist share link, it does not require a login
+*/
+var SharedPlaylist brewed.Page[*handler.Context] = func(ctx *handler.Context) (brewed.Layout[*handler.Context], templ.Component, error) {
+	props, err := logic.GetSharedPlaylistProps(ctx.Context(), ctx.Database(), ctx.Params("token"))
+	if errors.Is(err, logic.ErrPlaylistShareLinkInvalid) {
+		// Unknown, revoked and expired links look the same, the page does not tell them apart
+		ctx.Status(http.StatusNotFound)
+		return layouts.Main, pages.Error("This share link does not exist or has expired."), nil
+	}
+	if err != nil {
+		return nil, nil, ctx.Err(err)
+	}
+
+	userID, _ := ctx.UserID()
+	props.Authenticated = userID != ""
+	props.Owned = userID != "" && userID == props.OwnerID
+
+	return layouts.App, pages.SharedPlaylist(props), nil
+}
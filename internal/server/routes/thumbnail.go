@@ -4,10 +4,12 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"strconv"
 	"strings"
 	"time"
 
 	"github.com/a-h/templ"
+	"github.com/cufee/feedlr-yt/internal/api/dearrow"
 	"github.com/cufee/feedlr-yt/internal/database"
 	"github.com/cufee/feedlr-yt/internal/server/handler"
 	"github.com/cufee/tpot/brewed"
@@ -71,8 +73,9 @@ var VideoThumbnail brewed.Partial[*handler.Context] = func(ctx *handler.Context)
 		return nil, ctx.SendStatus(http.StatusBadRequest)
 	}
 
-	file, ok := videoThumbnailFile(ctx.Params("variant"))
-	if !ok {
+	variant := ctx.Params("variant")
+	file, ok := videoThumbnailFile(variant)
+	if !ok && variant != "dearrow" {
 		return nil, ctx.SendStatus(http.StatusBadRequest)
 	}
 
@@ -83,6 +86,22 @@ var VideoThumbnail brewed.Partial[*handler.Context] = func(ctx *handler.Context)
 		return nil, ctx.SendStatus(http.StatusInternalServerError)
 	}
 
+	if variant == "dearrow" {
+		timestamp, err := strconv.ParseFloat(ctx.Query("t"), 64)
+		if err != nil || timestamp < 0 {
+			return nil, ctx.SendStatus(http.StatusBadRequest)
+		}
+		if dearrow.C == nil {
+			return nil, ctx.SendStatus(http.StatusNotFound)
+		}
+		// Thumbnails that are not rendered yet return an error, the template falls back to the original thumbnail
+		thumbnailURL, ok := dearrow.C.ThumbnailURL(videoID, timestamp)
+		if !ok {
+			return nil, ctx.SendStatus(http.StatusNotFound)
+		}
+		return nil, proxyImage(ctx, thumbnailURL)
+	}
+
 	return nil, proxyImage(ctx, fmt.Sprintf("https://i.ytimg.com/vi/%s/%s", videoID, file))
 }
 
@@ -4,14 +4,17 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"strconv"
 	"time"
 
 	"github.com/a-h/templ"
 	"github.com/cufee/feedlr-yt/internal/logic"
+	"github.com/cufee/feedlr-yt/internal/metrics"
 	"github.com/cufee/feedlr-yt/internal/server/handler"
 	"github.com/cufee/feedlr-yt/internal/templates/layouts"
 	"github.com/cufee/feedlr-yt/internal/templates/pages"
 	"github.com/cufee/tpot/brewed"
+	"github.com/rs/zerolog/log"
 )
 
 var Video brewed.Page[*handler.Context] = func(ctx *handler.Context) (brewed.Layout[*handler.Context], templ.Component, error) {
@@ -19,11 +22,9 @@ var Video brewed.Page[*handler.Context] = func(ctx *handler.Context) (brewed.Lay
 
 	video := ctx.Params("id")
 	// Refresh cache in the background if stale
-	go func(id string) {
-		c, cancel := context.WithTimeout(context.Background(), time.Second*15)
-		defer cancel()
-		logic.RefreshVideoCache(c, ctx.Database(), id)
-	}(video)
+	if err := logic.EnqueueVideoRefresh(ctx.Context(), ctx.Database(), video); err != nil {
+		log.Warn().Err(err).Str("videoID", video).Msg("failed to queue video refresh")
+	}
 
 	if uid, valid := session.UserID(); valid {
 		sctx, cancel := context.WithTimeout(context.Background(), time.Millisecond*250)
@@ -37,7 +38,7 @@ var Video brewed.Page[*handler.Context] = func(ctx *handler.Context) (brewed.Lay
 		pctx, cancel := context.WithTimeout(context.Background(), time.Millisecond*1500)
 		defer cancel()
 
-		props, err := logic.GetPlayerPropsWithOpts(pctx, ctx.Database(), uid, video, logic.GetPlayerOptions{WithProgress: true, WithSegments: settings.SponsorBlock.SponsorBlockEnabled})
+		props, err := logic.GetPlayerPropsWithOpts(pctx, ctx.Database(), uid, video, logic.GetPlayerOptions{WithProgress: true, WithSegments: settings.SponsorBlock.SponsorBlockEnabled, SponsorBlock: &settings.SponsorBlock, WithChapters: true, WithTranscript: true})
 		if err != nil {
 			return nil, nil, ctx.Redirect(fmt.Sprintf("https://www.youtube.com/watch?v=%s&from=feedler.app", video), http.StatusTemporaryRedirect)
 		}
@@ -46,13 +47,16 @@ var Video brewed.Page[*handler.Context] = func(ctx *handler.Context) (brewed.Lay
 		if props.Video.Duration > 0 && props.Video.Progress >= props.Video.Duration {
 			props.Video.Progress = 0
 		}
+		if start, ok := startTimeFromQuery(ctx, props.Video.Duration); ok {
+			props.Video.Progress = start
+		}
 
 		// Check if video is in watch later
 		inWatchLater, _ := logic.IsInWatchLater(pctx, ctx.Database(), uid, video)
 		props.Video.InWatchLater = inWatchLater
 
 		// Populate user playlists for "add to playlist" dropdown
-		userPlaylists, _ := logic.GetUserPlaylistsProps(pctx, ctx.Database(), uid)
+		userPlaylists, _ := logic.GetEditablePlaylistsProps(pctx, ctx.Database(), uid)
 		props.UserPlaylists = userPlaylists
 		if len(userPlaylists) > 0 {
 			videoInPlaylists, _ := logic.GetVideoPlaylistMembership(pctx, ctx.Database(), uid, video)
@@ -60,6 +64,18 @@ var Video brewed.Page[*handler.Context] = func(ctx *handler.Context) (brewed.Lay
 		}
 
 		props.ReturnURL = ctx.Query("return", "/app")
+		if source := ctx.Query("queue"); source != "" {
+			qctx, cancel := context.WithTimeout(context.Background(), time.Millisecond*1500)
+			defer cancel()
+
+			shuffle, _ := strconv.ParseInt(ctx.Query("shuffle"), 10, 64)
+			queue, err := logic.GetPlayerQueue(qctx, ctx.Database(), uid, source, video, props.ReturnURL, shuffle)
+			if err != nil {
+				log.Warn().Err(err).Str("videoID", video).Str("queue", source).Msg("failed to get player queue")
+			} else {
+				props.Queue = queue
+			}
+		}
 		return layouts.Video(props.Video), pages.Video(props), nil
 	}
 
@@ -67,11 +83,41 @@ var Video brewed.Page[*handler.Context] = func(ctx *handler.Context) (brewed.Lay
 	pctx, cancel := context.WithTimeout(context.Background(), time.Millisecond*1500)
 	defer cancel()
 
-	props, err := logic.GetPlayerPropsWithOpts(pctx, ctx.Database(), "", video, logic.GetPlayerOptions{WithProgress: false, WithSegments: true})
+	props, err := logic.GetPlayerPropsWithOpts(pctx, ctx.Database(), "", video, logic.GetPlayerOptions{WithProgress: false, WithSegments: true, WithChapters: true, WithTranscript: true})
 	if err != nil {
 		return nil, nil, ctx.Redirect(fmt.Sprintf("https://www.youtube.com/watch?v=%s&from=feedler.app", video), http.StatusTemporaryRedirect)
 	}
+	if start, ok := startTimeFromQuery(ctx, props.Video.Duration); ok {
+		props.Video.Progress = start
+	}
 
 	props.ReturnURL = ctx.Query("return", "/app")
 	return layouts.Video(props.Video), pages.Video(props), nil
 }
+
+// startTimeFromQuery reads the t query param used by transcript search results to open a video at a specific point
+func startTimeFromQuery(ctx *handler.Context, duration int) (int, bool) {
+	start, err := strconv.Atoi(ctx.Query("t"))
+	if err != nil || start < 0 || (duration > 0 && start >= duration) {
+		return 0, false
+	}
+	return start, true
+}
+
+var VideoTranscript brewed.Partial[*handler.Context] = func(ctx *handler.Context) (templ.Component, error) {
+	tctx, cancel := context.WithTimeout(ctx.Context(), time.Second*2)
+	defer cancel()
+
+	transcript, err := logic.GetVideoTranscriptProps(tctx, ctx.Database(), ctx.Params("id"), ctx.Query("language"))
+	if err != nil {
+		metrics.IncUserAction("get_video_transcript", "error")
+		return nil, ctx.Err(err)
+	}
+	if transcript == nil {
+		metrics.IncUserAction("get_video_transcript", "not_found")
+		return nil, ctx.SendStatus(http.StatusNotFound)
+	}
+
+	metrics.IncUserAction("get_video_transcript", "success")
+	return pages.VideoTranscriptPanel(*transcript), nil
+}
@@ -1,6 +1,7 @@
 package server
 
 import (
+	"context"
 	"errors"
 	"io/fs"
 	"net"
@@ -8,6 +9,7 @@ import (
 	"os"
 	"strconv"
 	"strings"
+	"sync"
 
 	"github.com/cufee/feedlr-yt/internal/database"
 	"github.com/cufee/feedlr-yt/internal/server/handler"
@@ -22,13 +24,20 @@ import (
 	"github.com/gofiber/fiber/v2"
 	"github.com/gofiber/fiber/v2/middleware/adaptor"
 	"github.com/gofiber/fiber/v2/middleware/favicon"
-	"github.com/gofiber/fiber/v2/middleware/logger"
 	"github.com/microcosm-cc/bluemonday"
+	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promhttp"
 	"github.com/rs/zerolog/log"
 )
 
-func New(db database.Client, ses *sessions.SessionClient, assets fs.FS, policy *bluemonday.Policy, wa *webauthn.WebAuthn, authMw func(c *fiber.Ctx) error, globalMw func(c *fiber.Ctx) error, port ...int) func() error {
+// watchHistoryImportPath takes Takeout uploads, it is the only route with a body limit above the default
+const watchHistoryImportPath = "/api/settings/watch-history/import"
+
+/*
+New returns functions that start the server and shut it down.
+Start blocks until the server is shut down, shutdown stops accepting connections and waits for open requests until the context is done.
+*/
+func New(db database.Client, ses *sessions.SessionClient, assets fs.FS, policy *bluemonday.Policy, wa *webauthn.WebAuthn, authMw func(c *fiber.Ctx) error, globalMw func(c *fiber.Ctx) error, port ...int) (func() error, func(context.Context) error) {
 	var portString string
 	if len(port) > 0 {
 		portString = strconv.Itoa(port[0])
@@ -47,14 +56,37 @@ func New(db database.Client, ses *sessions.SessionClient, assets fs.FS, policy *
 		}
 	}
 
-	return func() error {
-		if err := startMetricsServer(); err != nil {
+	var mu sync.Mutex
+	var running *fiber.App
+	var runningMetrics *http.Server
+
+	shutdown := func(ctx context.Context) error {
+		mu.Lock()
+		server, metricsServer := running, runningMetrics
+		mu.Unlock()
+
+		var errs []error
+		if server != nil {
+			errs = append(errs, server.ShutdownWithContext(ctx))
+		}
+		if metricsServer != nil {
+			errs = append(errs, metricsServer.Shutdown(ctx))
+		}
+		return errors.Join(errs...)
+	}
+
+	start := func() error {
+		metricsServer, err := startMetricsServer()
+		if err != nil {
 			return err
 		}
 
-		server := fiber.New()
+		// Bodies past the default limit are streamed instead of rejected, bodyLimitMiddleware holds routes to their own limit
+		server := fiber.New(fiber.Config{StreamRequestBody: true, DisablePreParseMultipartForm: true})
+		server.Use(tracingMiddleware)
+		server.Use(bodyLimitMiddleware(fiber.DefaultBodyLimit, watchHistoryImportPath))
 		server.Use(requestMetricsMiddleware)
-		server.Use(logger.New())
+		server.Use(accessLogMiddleware)
 		server.Get("/healthy", func(c *fiber.Ctx) error { return c.SendStatus(fiber.StatusOK) })
 
 		// Static files
@@ -92,9 +124,12 @@ func New(db database.Client, ses *sessions.SessionClient, assets fs.FS, policy *
 
 		// Routes with unique auth handlers
 		server.Get("/video/:id", toFiber(root.Video))
+		server.Get("/video/:id/transcript", toFiber(root.VideoTranscript))
 		server.Get("/channel/:id", toFiber(root.Channel))
+		server.Get("/shared/playlist/:token", limiterMiddleware, toFiber(root.SharedPlaylist))
 		server.Get("/thumb/video/:id/:variant", toFiber(root.VideoThumbnail))
 		server.Get("/thumb/channel/:id", toFiber(root.ChannelThumbnail))
+		server.Post("/tv/pair/lan", limiterMiddleware, toFiber(rapi.PairYouTubeTVFromLAN))
 
 		api := server.Group("/api").Use(limiterMiddleware).Use(authMw)
 		api.Post("/passkeys/add/begin", toFiber(login.AdditionalPasskeyBegin))
@@ -104,32 +139,70 @@ func New(db database.Client, ses *sessions.SessionClient, assets fs.FS, policy *
 		api.Post("/videos/:id/progress", toFiber(rapi.SaveVideoProgress))
 		api.Post("/videos/:id/watch-later", toFiber(rapi.ToggleWatchLater))
 		api.Post("/videos/open", toFiber(rapi.OpenVideo))
+		api.Post("/videos/:id/segments", toFiber(rapi.SubmitVideoSegment))
+		api.Post("/videos/:id/segments/:uuid/vote", toFiber(rapi.VoteVideoSegment))
 
 		api.Post("/playlists", toFiber(rapi.CreatePlaylist))
 		api.Post("/playlists/import", toFiber(rapi.ImportPlaylist))
+		api.Post("/playlists/smart", toFiber(rapi.CreateSmartPlaylist))
+		api.Post("/playlists/smart/preview", toFiber(rapi.PreviewSmartPlaylist))
 		api.Post("/playlists/add-video", toFiber(rapi.AddVideoToPlaylist))
+		api.Post("/playlists/shared/:token/copy", toFiber(rapi.CopySharedPlaylist))
 		api.Post("/playlists/:id", toFiber(rapi.UpdatePlaylist))
 		api.Delete("/playlists/:id", toFiber(rapi.DeletePlaylist))
 		api.Post("/playlists/:id/sync", toFiber(rapi.SyncPlaylist))
+		api.Post("/playlists/:id/auto-sync", toFiber(rapi.UpdatePlaylistAutoSync))
+		api.Post("/playlists/:id/rules", toFiber(rapi.UpdateSmartPlaylistRules))
+		api.Post("/playlists/:id/share", toFiber(rapi.CreatePlaylistShareLink))
+		api.Delete("/playlists/:id/share/:linkID", toFiber(rapi.RevokePlaylistShareLink))
+		api.Post("/playlists/:id/order", toFiber(rapi.SetPlaylistOrder))
+		api.Post("/playlists/:id/sort", toFiber(rapi.SortPlaylist))
 		api.Post("/playlists/:id/videos/:videoID/progress", toFiber(rapi.UpdatePlaylistVideoProgress))
 		api.Post("/playlists/:id/videos/:videoID/remove", toFiber(rapi.RemoveVideoFromPlaylist))
 		api.Post("/playlists/:id/videos/:videoID/move", toFiber(rapi.MovePlaylistItem))
 
 		api.Get("/channels/search", toFiber(rapi.SearchChannels))
+		api.Post("/subscriptions/import", toFiber(rapi.ImportYouTubeSubscriptions))
 		api.Post("/channels/:id/subscribe", toFiber(rapi.CreateSubscription))
 		api.Post("/channels/:id/unsubscribe", toFiber(rapi.RemoveSubscription))
 		api.Post("/channels/:id/filter", toFiber(rapi.UpdateVideoFilter))
 		api.Post("/channels/:id/refresh", toFiber(rapi.RefreshChannel))
+		api.Post("/channels/:id/transcripts", toFiber(rapi.ToggleChannelTranscripts))
+
+		api.Get("/transcripts/search", toFiber(rapi.SearchTranscripts))
 
 		api.Post("/settings/sponsorblock", toFiber(rapi.ToggleSponsorBlock))
-		api.Post("/settings/sponsorblock/category", toFiber(rapi.ToggleSponsorBlockCategory))
+		api.Post("/settings/sponsorblock/category", toFiber(rapi.UpdateSponsorBlockCategory))
+		api.Post("/settings/titles", toFiber(rapi.UpdateVideoTitleMode))
+		api.Post("/settings/watch-later", toFiber(rapi.UpdateWatchLaterTTL))
+		api.Get("/settings/watch-history", toFiber(rapi.WatchHistoryImportStatus))
+		// Watch history uploads are the largest request bodies, the limit leaves room for the multipart encoding
+		api.Post(strings.TrimPrefix(watchHistoryImportPath, "/api"), bodyLimitMiddleware(rapi.WatchHistoryUploadLimit+1<<20), toFiber(rapi.ImportWatchHistory))
 		api.Post("/settings/youtube-sync/connect/begin", toFiber(rapi.BeginYouTubeSyncConnect))
 		api.Get("/settings/youtube-sync/connect/callback", toFiber(rapi.FinishYouTubeSyncConnect))
 		api.Post("/settings/youtube-sync/disconnect", toFiber(rapi.DisconnectYouTubeSync))
 		api.Post("/settings/youtube-sync/toggle", toFiber(rapi.ToggleYouTubeSync))
+		api.Post("/settings/youtube-sync/targets", toFiber(rapi.CreateYouTubeSyncTarget))
+		api.Post("/settings/youtube-sync/targets/update", toFiber(rapi.UpdateYouTubeSyncTarget))
+		api.Post("/settings/youtube-sync/targets/delete", toFiber(rapi.DeleteYouTubeSyncTarget))
 		api.Post("/settings/youtube-sync/tv/connect", toFiber(rapi.ConnectYouTubeTVSync))
 		api.Post("/settings/youtube-sync/tv/disconnect", toFiber(rapi.DisconnectYouTubeTVSync))
 		api.Post("/settings/youtube-sync/tv/toggle", toFiber(rapi.ToggleYouTubeTVSync))
+		api.Post("/settings/youtube-sync/tv/screen", toFiber(rapi.UpdateYouTubeTVScreen))
+		api.Post("/settings/youtube-sync/tv/autoplay", toFiber(rapi.UpdateYouTubeTVAutoplay))
+		api.Post("/settings/youtube-sync/tv/journal", toFiber(rapi.YouTubeTVSyncJournal))
+		api.Post("/settings/youtube-sync/tv/next-chapter", toFiber(rapi.SkipYouTubeTVToNextChapter))
+		api.Post("/settings/youtube-sync/tv/lan-pairing", toFiber(rapi.CreateYouTubeTVLANPairing))
+		api.Post("/settings/youtube-sync/tv/lan-pairing/revoke", toFiber(rapi.RevokeYouTubeTVLANPairing))
+
+		api.Post("/tv/play/video/:id", toFiber(rapi.PlayVideoOnTV))
+		api.Post("/tv/queue/video/:id", toFiber(rapi.QueueVideoOnTV))
+		api.Post("/tv/play/watch-later", toFiber(rapi.PlayWatchLaterOnTV))
+		api.Post("/tv/play/playlist/:id", toFiber(rapi.PlayPlaylistOnTV))
+		api.Post("/tv/remote/volume", toFiber(rapi.SetTVVolume))
+		api.Post("/tv/remote/:command", toFiber(rapi.SendTVRemoteCommand))
+
+		api.Post("/admin/jobs/:id/retry", toFiber(rapi.RetryJob))
 
 		// All routes used by HTMX should have a POST handler
 		app := server.Group("/app").Use(limiterMiddleware).Use(authMw)
@@ -139,17 +212,25 @@ func New(db database.Client, ses *sessions.SessionClient, assets fs.FS, policy *
 		app.All("/settings", toFiber(rapp.Settings))
 		app.All("/onboarding", toFiber(rapp.Onboarding))
 		app.All("/subscriptions", toFiber(rapp.Subscriptions))
+		app.All("/subscriptions/import", toFiber(rapp.SubscriptionsImport))
 		app.All("/playlists", toFiber(rapp.PlaylistsIndex))
 		app.All("/playlist/:id", toFiber(rapp.PlaylistDetail))
 		app.Get("/playlist/:id/feed", toFiber(rapp.PlaylistFeed))
+		app.Get("/queue", toFiber(rapp.PlayQueue))
+		app.All("/admin", toFiber(rapp.Admin))
 
 		// This last handler is a catch-all for any routes that don't exist
 		server.Use(func(c *fiber.Ctx) error {
 			return c.Redirect("/error?code=404&from=" + c.Path())
 		})
 
+		mu.Lock()
+		running, runningMetrics = server, metricsServer
+		mu.Unlock()
+
 		return server.Listen(":" + portString)
 	}
+	return start, shutdown
 }
 
 func metricsPath() string {
@@ -175,25 +256,27 @@ func metricsAddress() string {
 	return ":" + port
 }
 
-func startMetricsServer() error {
+func startMetricsServer() (*http.Server, error) {
 	path := metricsPath()
 	addr := metricsAddress()
 
 	mux := http.NewServeMux()
-	mux.Handle(path, promhttp.Handler())
+	// OpenMetrics is required for exemplars, Prometheus negotiates it when exemplar storage is enabled
+	mux.Handle(path, promhttp.InstrumentMetricHandler(prometheus.DefaultRegisterer, promhttp.HandlerFor(prometheus.DefaultGatherer, promhttp.HandlerOpts{EnableOpenMetrics: true})))
 
 	listener, err := net.Listen("tcp", addr)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	log.Info().Str("addr", listener.Addr().String()).Str("path", path).Msg("metrics server started")
 
+	server := &http.Server{Handler: mux}
 	go func() {
-		if serveErr := http.Serve(listener, mux); serveErr != nil && !errors.Is(serveErr, http.ErrServerClosed) {
+		if serveErr := server.Serve(listener); serveErr != nil && !errors.Is(serveErr, http.ErrServerClosed) {
 			log.Error().Err(serveErr).Msg("metrics server stopped unexpectedly")
 		}
 	}()
 
-	return nil
+	return server, nil
 }
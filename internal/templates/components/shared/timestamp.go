@@ -0,0 +1,14 @@
+package shared
+
+import "fmt"
+
+// PlaybackTimestamp formats a position in a video the same way the YouTube player does
+func PlaybackTimestamp(seconds int) string {
+	hours := seconds / 3600
+	minutes := (seconds % 3600) / 60
+	seconds = seconds % 60
+	if hours == 0 {
+		return fmt.Sprintf("%d:%02d", minutes, seconds)
+	}
+	return fmt.Sprintf("%d:%02d:%02d", hours, minutes, seconds)
+}
@@ -0,0 +1,134 @@
+package tracing
+
+import (
+	"context"
+	"os"
+	"sync/atomic"
+
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog"
+	"github.com/rs/zerolog/log"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const instrumentationName = "github.com/cufee/feedlr-yt"
+
+var enabled atomic.Bool
+
+/*
+Enabled reports whether traces are exported. Spans are still created when tracing is off, they are not recorded.
+*/
+func Enabled() bool {
+	return enabled.Load()
+}
+
+/*
+Setup exports traces to an OTLP collector when TRACING_ENABLED is true, tracing is off by default.
+The exporter is configured with the standard OTEL_EXPORTER_OTLP_* variables and sampling with OTEL_TRACES_SAMPLER.
+It returns a function that flushes buffered spans and stops the exporter.
+*/
+func Setup(ctx context.Context) (func(context.Context) error, error) {
+	if os.Getenv("TRACING_ENABLED") != "true" {
+		return func(context.Context) error { return nil }, nil
+	}
+
+	exporter, err := otlptracehttp.New(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create trace exporter")
+	}
+
+	serviceName := os.Getenv("OTEL_SERVICE_NAME")
+	if serviceName == "" {
+		serviceName = "feedlr"
+	}
+	res, err := resource.Merge(resource.Default(), resource.NewSchemaless(attribute.String("service.name", serviceName)))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create trace resource")
+	}
+
+	provider := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithResource(res),
+	)
+	otel.SetTracerProvider(provider)
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(propagation.TraceContext{}, propagation.Baggage{}))
+
+	log.Logger = log.Logger.Hook(logHook{})
+	enabled.Store(true)
+
+	log.Info().Str("service", serviceName).Msg("tracing enabled")
+	return provider.Shutdown, nil
+}
+
+func Tracer() trace.Tracer {
+	return otel.Tracer(instrumentationName)
+}
+
+/*
+Start starts a span, End ends it
+*/
+func Start(ctx context.Context, name string, attrs ...attribute.KeyValue) (context.Context, trace.Span) {
+	return Tracer().Start(ctx, name, trace.WithAttributes(attrs...))
+}
+
+/*
+StartClient starts a span for a call to another service
+*/
+func StartClient(ctx context.Context, name string, attrs ...attribute.KeyValue) (context.Context, trace.Span) {
+	return Tracer().Start(ctx, name, trace.WithAttributes(attrs...), trace.WithSpanKind(trace.SpanKindClient))
+}
+
+/*
+End records the error on the span, if any, and ends it
+*/
+func End(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
+}
+
+/*
+TraceID returns the ID of the sampled trace in the context, or an empty string
+*/
+func TraceID(ctx context.Context) string {
+	if ctx == nil {
+		return ""
+	}
+	spanCtx := trace.SpanContextFromContext(ctx)
+	if !spanCtx.IsSampled() {
+		return ""
+	}
+	return spanCtx.TraceID().String()
+}
+
+func init() {
+	// zerolog.Ctx falls back to the global logger for contexts without one, instead of dropping the events
+	zerolog.DefaultContextLogger = &log.Logger
+}
+
+/*
+WithLogger attaches a logger to the context, events logged through zerolog.Ctx(ctx) get the trace and span IDs of the context
+*/
+func WithLogger(ctx context.Context) context.Context {
+	return log.Logger.With().Ctx(ctx).Logger().WithContext(ctx)
+}
+
+// logHook adds the trace and span IDs to log events created with a context, like log.Warn().Ctx(ctx) or zerolog.Ctx(ctx).Warn()
+type logHook struct{}
+
+func (logHook) Run(e *zerolog.Event, _ zerolog.Level, _ string) {
+	spanCtx := trace.SpanContextFromContext(e.GetCtx())
+	if !spanCtx.IsValid() {
+		return
+	}
+	e.Str("trace_id", spanCtx.TraceID().String()).Str("span_id", spanCtx.SpanID().String())
+}
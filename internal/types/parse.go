@@ -52,6 +52,11 @@ func SubscriptionChannelModelToProps(sub *models.Subscription) ChannelProps {
 }
 
 func PlaylistModelToProps(p *models.Playlist, count int, progress int, thumbnailVideoID string) PlaylistProps {
+	var rules SmartPlaylistRules
+	if p.Rules.Valid {
+		rules, _ = ParseSmartPlaylistRules(p.Rules.String)
+	}
+
 	return PlaylistProps{
 		ID:                p.ID,
 		Name:              p.Name,
@@ -62,6 +67,21 @@ func PlaylistModelToProps(p *models.Playlist, count int, progress int, thumbnail
 		ThumbnailVideoID:  thumbnailVideoID,
 		YouTubePlaylistID: p.YoutubePlaylistID.String,
 		UpdatedAt:         p.UpdatedAt,
+
+		AutoSyncIntervalHours: p.AutoSyncIntervalHours,
+		SyncMode:              p.SyncMode,
+		LastSyncedAt:          p.LastSyncedAt.Time,
+		LastSyncError:         p.LastSyncError,
+		NextSyncAt:            p.NextSyncAt.Time,
+
+		Smart: p.Rules.Valid,
+		Rules: rules,
+
+		Limits: PlaylistLimits{
+			TTLDays:        p.TTLDays.Int64,
+			MaxSize:        p.MaxSize.Int64,
+			OverflowPolicy: p.OverflowPolicy,
+		},
 	}
 }
 
@@ -0,0 +1,87 @@
+package types
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+)
+
+const (
+	// PlayerQueueFeed plays the unwatched videos of the home feed
+	PlayerQueueFeed = "feed"
+	// PlayerQueueWatchLater plays the Watch Later playlist
+	PlayerQueueWatchLater     = "watch-later"
+	playerQueuePlaylistPrefix = "playlist:"
+)
+
+/*
+PlayerQueuePlaylist is the queue source of a playlist
+*/
+func PlayerQueuePlaylist(playlistID string) string {
+	return playerQueuePlaylistPrefix + playlistID
+}
+
+/*
+ParsePlayerQueuePlaylist returns the playlist of a queue source, ok is false for other sources
+*/
+func ParsePlayerQueuePlaylist(source string) (string, bool) {
+	id, ok := strings.CutPrefix(source, playerQueuePlaylistPrefix)
+	return id, ok && id != ""
+}
+
+/*
+PlayerQueueStartURL opens the first unwatched video of a queue, returnURL is where the player goes back to
+*/
+func PlayerQueueStartURL(source, returnURL string, shuffle bool) string {
+	query := make(url.Values)
+	query.Set("source", source)
+	query.Set("return", returnURL)
+	if shuffle {
+		query.Set("shuffle", "true")
+	}
+	return "/app/queue?" + query.Encode()
+}
+
+/*
+PlayerQueueProps is the queue a video is played from, the queue is built again from its source on every video
+*/
+type PlayerQueueProps struct {
+	Source string
+	Title  string
+	// Shuffle is the seed of the shuffled order, 0 plays the queue in order
+	Shuffle int64
+	// ToggleShuffle is the seed used when shuffle is toggled
+	ToggleShuffle int64
+	ReturnURL     string
+
+	Videos []VideoProps
+	// Position of the current video in Videos, -1 when the video is not part of the queue
+	Position int
+	Next     *VideoProps
+}
+
+/*
+VideoURL opens a video in the same queue
+*/
+func (q PlayerQueueProps) VideoURL(videoID string) string {
+	return q.videoURL(videoID, q.Shuffle)
+}
+
+/*
+ShuffleURL reopens a video with shuffle toggled
+*/
+func (q PlayerQueueProps) ShuffleURL(videoID string) string {
+	return q.videoURL(videoID, q.ToggleShuffle)
+}
+
+func (q PlayerQueueProps) videoURL(videoID string, shuffle int64) string {
+	query := make(url.Values)
+	query.Set("queue", q.Source)
+	if q.ReturnURL != "" {
+		query.Set("return", q.ReturnURL)
+	}
+	if shuffle != 0 {
+		query.Set("shuffle", fmt.Sprint(shuffle))
+	}
+	return fmt.Sprintf("/video/%s?%s", videoID, query.Encode())
+}
@@ -0,0 +1,37 @@
+package types
+
+import "fmt"
+
+/*
+PlaylistLimits are the item limits of a playlist, 0 is no limit
+*/
+type PlaylistLimits struct {
+	TTLDays        int64
+	MaxSize        int64
+	OverflowPolicy string
+}
+
+// PlaylistTTLOptions are the ages in days after which playlist items can be removed, 0 keeps them forever
+var PlaylistTTLOptions = []int64{0, 7, 14, 30, 90, 365}
+
+// PlaylistMaxSizeLimit is the largest size limit a playlist can have
+const PlaylistMaxSizeLimit = 5000
+
+func PlaylistTTLLabel(days int64) string {
+	switch days {
+	case 0:
+		return "Never"
+	case 7:
+		return "After a week"
+	case 14:
+		return "After two weeks"
+	case 30:
+		return "After a month"
+	case 90:
+		return "After three months"
+	case 365:
+		return "After a year"
+	default:
+		return fmt.Sprintf("After %d days", days)
+	}
+}
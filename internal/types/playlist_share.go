@@ -0,0 +1,57 @@
+package types
+
+import (
+	"fmt"
+	"slices"
+	"time"
+)
+
+// PlaylistShareExpiryOptions are the lifetimes offered for new share links in days, 0 never expires
+var PlaylistShareExpiryOptions = []int{0, 1, 7, 30, 90}
+
+func PlaylistShareExpiryLabel(days int) string {
+	switch days {
+	case 0:
+		return "Never expires"
+	case 1:
+		return "Expires in a day"
+	default:
+		return fmt.Sprintf("Expires in %d days", days)
+	}
+}
+
+/*
+ValidPlaylistShareExpiry reports whether days is one of the offered link lifetimes
+*/
+func ValidPlaylistShareExpiry(days int) bool {
+	return slices.Contains(PlaylistShareExpiryOptions, days)
+}
+
+type PlaylistShareLinkProps struct {
+	ID        string
+	CreatedAt time.Time
+	// ExpiresAt is zero for links that stay valid until they are revoked
+	ExpiresAt time.Time
+	// URL is only known right after the link is created, only a hash of the token is stored
+	URL string
+}
+
+func (l PlaylistShareLinkProps) Expired() bool {
+	return !l.ExpiresAt.IsZero() && !l.ExpiresAt.After(time.Now())
+}
+
+/*
+SharedPlaylistPageProps is the read-only view of a playlist opened with a share link
+*/
+type SharedPlaylistPageProps struct {
+	Token    string
+	OwnerID  string
+	Playlist PlaylistProps
+	Videos   []VideoProps
+	// YouTubeURL opens the videos as an unsaved playlist on YouTube
+	YouTubeURL string
+
+	Authenticated bool
+	// Owned is set when the viewer is the owner of the playlist
+	Owned bool
+}
@@ -0,0 +1,44 @@
+package types
+
+// PlaylistSort is a one-off sort of the videos in a playlist, the new order is saved as the playlist order
+type PlaylistSort string
+
+const (
+	PlaylistSortNewest   PlaylistSort = "newest"
+	PlaylistSortOldest   PlaylistSort = "oldest"
+	PlaylistSortShortest PlaylistSort = "shortest"
+	PlaylistSortLongest  PlaylistSort = "longest"
+	PlaylistSortChannel  PlaylistSort = "channel"
+	PlaylistSortAdded    PlaylistSort = "added"
+)
+
+var PlaylistSorts = []PlaylistSort{PlaylistSortNewest, PlaylistSortOldest, PlaylistSortShortest, PlaylistSortLongest, PlaylistSortChannel, PlaylistSortAdded}
+
+func PlaylistSortLabel(sort PlaylistSort) string {
+	switch sort {
+	case PlaylistSortNewest:
+		return "Newest published"
+	case PlaylistSortOldest:
+		return "Oldest published"
+	case PlaylistSortShortest:
+		return "Shortest"
+	case PlaylistSortLongest:
+		return "Longest"
+	case PlaylistSortChannel:
+		return "Channel"
+	default:
+		return "Date added"
+	}
+}
+
+/*
+ParsePlaylistSort returns a valid sort, ok is false for unknown values
+*/
+func ParsePlaylistSort(value string) (PlaylistSort, bool) {
+	switch sort := PlaylistSort(value); sort {
+	case PlaylistSortNewest, PlaylistSortOldest, PlaylistSortShortest, PlaylistSortLongest, PlaylistSortChannel, PlaylistSortAdded:
+		return sort, true
+	default:
+		return "", false
+	}
+}
@@ -1,6 +1,7 @@
 package types
 
 import (
+	"fmt"
 	"time"
 
 	"github.com/cufee/feedlr-yt/internal/api/sponsorblock"
@@ -12,29 +13,56 @@ import (
 type SettingsPageProps struct {
 	FeedMode      string
 	PlayerVolume  int
+	TitleMode     VideoTitleMode
 	SponsorBlock  SponsorBlockSettingsProps
 	Passkeys      []PasskeyProps
 	YouTubeSync   YouTubeSyncStatusProps
 	YouTubeTVSync YouTubeTVSyncStatusProps
+	TVAutoplay    TVAutoplaySettingsProps
+
+	WatchHistoryImport WatchHistoryImportProps `json:"-"`
+	// WatchLaterTTLDays is stored on the Watch Later playlist, 0 keeps videos until they are watched
+	WatchLaterTTLDays int64 `json:"-"`
+
+	DeArrowAvailable bool `json:"-"`
 }
 
 type YouTubeSyncStatusProps struct {
 	Available    bool
 	Connected    bool
 	Enabled      bool
-	PlaylistID   string
 	LastError    string
 	LastSyncedAt time.Time
+	// CanImportSubscriptions is false for accounts that did not grant read access, they need to connect again
+	CanImportSubscriptions bool
+
+	Targets []YouTubeSyncTargetProps
+	// Sources lists what can be picked for a new target, sources that already have a target are left out
+	Sources []YouTubeSyncSourceOptionProps
+	// DefaultTargetSize and MaxTargetSize bound the number of videos in a synced playlist
+	DefaultTargetSize int
+	MaxTargetSize     int
 }
 
 type YouTubeTVSyncStatusProps struct {
 	Available bool
-	Connected bool
-	Enabled   bool
+	Screens   []YouTubeTVScreenProps
+
+	Autoplay          TVAutoplaySettingsProps
+	AutoplayPlaylists []TVAutoplayPlaylistProps
+}
+
+type YouTubeTVScreenProps struct {
+	ID         string
+	Name       string
+	ScreenName string
+	Connected  bool
+	Enabled    bool
+
+	SponsorBlockMode TVSponsorBlockMode
 
 	ConnectionState string
 	StateReason     string
-	ScreenName      string
 	LastError       string
 
 	LastConnectedAt    time.Time
@@ -59,7 +87,11 @@ type PasskeyProps struct {
 type SponsorBlockSettingsProps struct {
 	SponsorBlockEnabled             bool
 	SelectedSponsorBlockCategories  []string
+	CategoryActions                 map[string]SponsorBlockAction
 	AvailableSponsorBlockCategories []sponsorblock.Category
+
+	// SubmitterID is the private SponsorBlock user ID used for submissions and votes, it should never be rendered
+	SubmitterID string
 }
 
 type NavbarProps struct {
@@ -99,13 +131,17 @@ type UserVideoFeedProps struct {
 	New        []VideoProps
 	Watched    []VideoProps
 	WatchLater []VideoProps
+	// PlayOnTV is set when the user has a connected TV that accepts remote commands
+	PlayOnTV bool
 }
 
 type ChannelPageProps struct {
-	Authenticated bool
-	Subscribed    bool
-	VideoFilter   VideoFilter
-	Channel       ChannelWithVideosProps
+	Authenticated      bool
+	Subscribed         bool
+	TranscriptsEnabled bool
+	PlayOnTV           bool
+	VideoFilter        VideoFilter
+	Channel            ChannelWithVideosProps
 }
 
 type ChannelWithVideosProps struct {
@@ -121,11 +157,64 @@ type VideoProps struct {
 	Channel      ChannelProps
 	PublishedAt  time.Time
 	CreatedAt    time.Time
+
+	// SponsorBlockLabel is set when the whole video was labeled, for example as exclusive access
+	SponsorBlockLabel string
+
+	// OriginalTitle is set when the displayed title was replaced based on the user title mode
+	OriginalTitle string
+	// ThumbnailTime is set to the timestamp of a community thumbnail when one should be shown instead of the original
+	ThumbnailTime *float64
 }
 
 type SegmentProps struct {
-	Start int `json:"start"`
-	End   int `json:"end"`
+	UUID     string             `json:"uuid"`
+	Start    int                `json:"start"`
+	End      int                `json:"end"`
+	Category string             `json:"category"`
+	Action   SponsorBlockAction `json:"action"`
+}
+
+type SegmentSubmissionProps struct {
+	Kind      string
+	Start     int
+	End       int
+	Category  string
+	Status    string
+	LastError string
+	CreatedAt time.Time
+}
+
+type ChapterProps struct {
+	Title string `json:"title"`
+	Start int    `json:"start"`
+	End   int    `json:"end"`
+}
+
+type TranscriptCueProps struct {
+	Start int
+	Text  string
+}
+
+type TranscriptProps struct {
+	VideoID       string
+	Language      string
+	Name          string
+	AutoGenerated bool
+	Cues          []TranscriptCueProps
+	Languages     []TranscriptLanguageProps
+}
+
+type TranscriptLanguageProps struct {
+	Language      string
+	Name          string
+	AutoGenerated bool
+}
+
+type TranscriptSearchResultProps struct {
+	Video        VideoProps
+	StartSeconds int
+	Snippet      string
 }
 
 type PlaylistProps struct {
@@ -138,6 +227,45 @@ type PlaylistProps struct {
 	ThumbnailVideoID  string
 	YouTubePlaylistID string
 	UpdatedAt         time.Time
+
+	// Auto sync settings and status of playlists imported from YouTube
+	AutoSyncIntervalHours int64
+	SyncMode              string
+	LastSyncedAt          time.Time
+	LastSyncError         string
+	NextSyncAt            time.Time
+
+	// Smart playlists list the videos matching their rules instead of saved items
+	Smart bool
+	Rules SmartPlaylistRules
+
+	Limits PlaylistLimits
+}
+
+/*
+SyncedAt is when the playlist was last synced from YouTube, playlists synced before the sync status was stored fall back to the last update
+*/
+func (p PlaylistProps) SyncedAt() time.Time {
+	if !p.LastSyncedAt.IsZero() {
+		return p.LastSyncedAt
+	}
+	return p.UpdatedAt
+}
+
+// PlaylistAutoSyncIntervals are the auto sync intervals in hours offered for imported playlists, 0 turns auto sync off
+var PlaylistAutoSyncIntervals = []int64{0, 6, 24, 7 * 24}
+
+func PlaylistAutoSyncIntervalLabel(hours int64) string {
+	switch hours {
+	case 0:
+		return "Off"
+	case 24:
+		return "Daily"
+	case 7 * 24:
+		return "Weekly"
+	default:
+		return fmt.Sprintf("Every %d hours", hours)
+	}
 }
 
 type VideoPlayerProps struct {
@@ -148,30 +276,122 @@ type VideoPlayerProps struct {
 
 	PlayerVolumeLevel int `json:"playerVolumeLevel"`
 
-	SkipSegments     []SegmentProps `json:"skipSegments"`
-	SkipSegmentsJSON string         `json:"skipSegmentsJSON"`
+	Segments     []SegmentProps `json:"segments"`
+	SegmentsJSON string         `json:"segmentsJSON"`
+	Highlight    *SegmentProps  `json:"highlight"`
+	// SubmitSegments enables the segment submission dialog and reporting of skipped segments
+	SubmitSegments bool `json:"-"`
+	// PlayOnTV shows the buttons to send this video to the paired TV
+	PlayOnTV bool `json:"-"`
+
+	Chapters   []ChapterProps   `json:"chapters"`
+	Transcript *TranscriptProps `json:"-"`
 
 	ReturnURL string `json:"returnURL"`
+	// Queue is set when the video is played from a queue, the return URL stays the page the queue was started from
+	Queue *PlayerQueueProps `json:"-"`
 
 	UserPlaylists      []PlaylistProps `json:"-"`
 	VideoInPlaylistIDs map[string]bool `json:"-"`
 }
 
-func (v *VideoPlayerProps) AddSegments(segments ...sponsorblock.Segment) error {
+/*
+AddSegments adds segments the user did not ignore to the player, along with the video highlight and full video label
+*/
+func (v *VideoPlayerProps) AddSegments(settings SponsorBlockSettingsProps, segments ...sponsorblock.Segment) error {
 	for _, segment := range segments {
 		if len(segment.Segment) != 2 {
 			continue
 		}
-		v.SkipSegments = append(v.SkipSegments, SegmentProps{
-			Start: int(segment.Segment[0]),
-			End:   int(segment.Segment[1]),
-		})
+		action := settings.SegmentAction(segment)
+		if action == SponsorBlockActionIgnore {
+			continue
+		}
+
+		props := SegmentProps{
+			UUID:     segment.UUID,
+			Start:    int(segment.Segment[0]),
+			End:      int(segment.Segment[1]),
+			Category: segment.Category,
+			Action:   action,
+		}
+		if segment.ActionType == sponsorblock.ActionTypePOI {
+			if v.Highlight == nil {
+				v.Highlight = &props
+			}
+			continue
+		}
+		v.Segments = append(v.Segments, props)
 	}
+	v.Video.SponsorBlockLabel = settings.FullVideoLabel(segments)
 
-	encoded, err := json.Marshal(v.SkipSegments)
+	encoded, err := json.Marshal(v.Segments)
 	if err != nil {
 		return err
 	}
-	v.SkipSegmentsJSON = string(encoded)
+	v.SegmentsJSON = string(encoded)
 	return nil
 }
+
+type LeaseProps struct {
+	Name       string
+	Holder     string
+	AcquiredAt time.Time
+	ExpiresAt  time.Time
+	// Local is set for leases held by the process rendering the page
+	Local bool
+}
+
+type JobProps struct {
+	ID          string
+	Kind        string
+	Payload     string
+	Priority    int
+	Status      string
+	Attempts    int
+	MaxAttempts int
+	RunAt       time.Time
+	UpdatedAt   time.Time
+	LastError   string
+	Holder      string
+	// Local is set for jobs running on the process rendering the page
+	Local bool
+}
+
+type JobsOverviewProps struct {
+	Queued    int
+	Running   int
+	Succeeded int
+	Failed    int
+
+	RunningJobs []JobProps
+	QueuedJobs  []JobProps
+	FailedJobs  []JobProps
+}
+
+type WatchHistoryImportProps struct {
+	// Status is empty when the user never imported their watch history
+	Status     string
+	Hide       bool
+	Total      int
+	Processed  int
+	Imported   int
+	Skipped    int
+	Failed     int
+	LastError  string
+	StartedAt  time.Time
+	FinishedAt time.Time
+	// Stalled is set when a running import stopped making progress, uploading the file again picks up where it stopped
+	Stalled bool
+}
+
+func (p WatchHistoryImportProps) Running() bool {
+	return (p.Status == "queued" || p.Status == "running") && !p.Stalled
+}
+
+func (p WatchHistoryImportProps) Percent() int {
+	if p.Total == 0 {
+		return 0
+	}
+	return p.Processed * 100 / p.Total
+}
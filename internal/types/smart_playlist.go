@@ -0,0 +1,117 @@
+package types
+
+import (
+	"slices"
+
+	"github.com/goccy/go-json"
+	"github.com/pkg/errors"
+)
+
+const (
+	SmartPlaylistDefaultLimit = 50
+	SmartPlaylistMaxLimit     = 200
+)
+
+// SmartPlaylistProgress picks videos by how much of them the user watched
+type SmartPlaylistProgress string
+
+const (
+	SmartPlaylistProgressAny       SmartPlaylistProgress = ""
+	SmartPlaylistProgressUnwatched SmartPlaylistProgress = "unwatched"
+	SmartPlaylistProgressStarted   SmartPlaylistProgress = "started"
+	SmartPlaylistProgressWatched   SmartPlaylistProgress = "watched"
+)
+
+var SmartPlaylistProgressOptions = []SmartPlaylistProgress{SmartPlaylistProgressAny, SmartPlaylistProgressUnwatched, SmartPlaylistProgressStarted, SmartPlaylistProgressWatched}
+
+func SmartPlaylistProgressLabel(progress SmartPlaylistProgress) string {
+	switch progress {
+	case SmartPlaylistProgressUnwatched:
+		return "Unwatched"
+	case SmartPlaylistProgressStarted:
+		return "Started, not finished"
+	case SmartPlaylistProgressWatched:
+		return "Finished"
+	default:
+		return "Any"
+	}
+}
+
+// SmartPlaylistSorts are the orders a smart playlist can list its videos in
+var SmartPlaylistSorts = []PlaylistSort{PlaylistSortNewest, PlaylistSortOldest, PlaylistSortShortest, PlaylistSortLongest}
+
+/*
+SmartPlaylistRules is the saved query of a smart playlist, it is stored as JSON on the playlist.
+Zero values do not filter, a smart playlist without any rules lists the latest videos of all subscriptions.
+*/
+type SmartPlaylistRules struct {
+	// Channels limits the videos to some subscribed channels, empty for all subscriptions
+	Channels      []string `json:"channels,omitempty"`
+	FavoritesOnly bool     `json:"favoritesOnly,omitempty"`
+	// Filter works like the video filter of a subscription, shorts are never included
+	Filter              VideoFilter           `json:"filter,omitempty"`
+	Progress            SmartPlaylistProgress `json:"progress,omitempty"`
+	MinDurationMinutes  int                   `json:"minDurationMinutes,omitempty"`
+	MaxDurationMinutes  int                   `json:"maxDurationMinutes,omitempty"`
+	PublishedWithinDays int                   `json:"publishedWithinDays,omitempty"`
+	Sort                PlaylistSort          `json:"sort,omitempty"`
+	Limit               int                   `json:"limit,omitempty"`
+}
+
+/*
+Normalize fills in defaults and returns an error for rules that cannot be evaluated
+*/
+func (r SmartPlaylistRules) Normalize() (SmartPlaylistRules, error) {
+	if r.Filter == "" {
+		r.Filter = VideoFilterAll
+	}
+	if r.Filter != VideoFilterAll && r.Filter != VideoFilterVideos && r.Filter != VideoFilterStreams {
+		return r, errors.New("unknown video filter")
+	}
+	if !slices.Contains(SmartPlaylistProgressOptions, r.Progress) {
+		return r, errors.New("unknown progress rule")
+	}
+	if r.Sort == "" {
+		r.Sort = PlaylistSortNewest
+	}
+	if !slices.Contains(SmartPlaylistSorts, r.Sort) {
+		return r, errors.New("unknown sort")
+	}
+	if r.MinDurationMinutes < 0 || r.MaxDurationMinutes < 0 || r.PublishedWithinDays < 0 {
+		return r, errors.New("durations and days cannot be negative")
+	}
+	if r.MaxDurationMinutes > 0 && r.MaxDurationMinutes < r.MinDurationMinutes {
+		return r, errors.New("the maximum duration is shorter than the minimum")
+	}
+	if r.Limit <= 0 {
+		r.Limit = SmartPlaylistDefaultLimit
+	}
+	r.Limit = min(r.Limit, SmartPlaylistMaxLimit)
+
+	channels := slices.DeleteFunc(slices.Clone(r.Channels), func(id string) bool { return id == "" })
+	slices.Sort(channels)
+	r.Channels = slices.Compact(channels)
+	if len(r.Channels) == 0 {
+		r.Channels = nil
+	}
+	return r, nil
+}
+
+func (r SmartPlaylistRules) Encode() (string, error) {
+	data, err := json.Marshal(r)
+	if err != nil {
+		return "", err
+	}
+	return string(data), nil
+}
+
+/*
+ParseSmartPlaylistRules decodes and normalizes the rules saved on a smart playlist
+*/
+func ParseSmartPlaylistRules(data string) (SmartPlaylistRules, error) {
+	var rules SmartPlaylistRules
+	if err := json.Unmarshal([]byte(data), &rules); err != nil {
+		return rules, errors.Wrap(err, "failed to decode smart playlist rules")
+	}
+	return rules.Normalize()
+}
@@ -0,0 +1,114 @@
+package types
+
+import (
+	"slices"
+
+	"github.com/cufee/feedlr-yt/internal/api/sponsorblock"
+)
+
+type SponsorBlockAction string
+
+const (
+	SponsorBlockActionSkip   SponsorBlockAction = "skip"
+	SponsorBlockActionMute   SponsorBlockAction = "mute"
+	SponsorBlockActionButton SponsorBlockAction = "button"
+	SponsorBlockActionIgnore SponsorBlockAction = "ignore"
+)
+
+/*
+SponsorBlockCategoryActions returns the actions a user can pick for a category, the first action is used when a category is enabled without an explicit choice.
+Highlights can be jumped to automatically or with a button, full video labels and chapters can only be shown or ignored.
+*/
+func SponsorBlockCategoryActions(category string) []SponsorBlockAction {
+	switch category {
+	case sponsorblock.Highlight.Value:
+		return []SponsorBlockAction{SponsorBlockActionButton, SponsorBlockActionSkip, SponsorBlockActionIgnore}
+	case sponsorblock.ExclusiveAccess.Value, sponsorblock.Chapter.Value:
+		return []SponsorBlockAction{SponsorBlockActionButton, SponsorBlockActionIgnore}
+	default:
+		return []SponsorBlockAction{SponsorBlockActionSkip, SponsorBlockActionMute, SponsorBlockActionButton, SponsorBlockActionIgnore}
+	}
+}
+
+func SponsorBlockActionLabel(category string, action SponsorBlockAction) string {
+	switch action {
+	case SponsorBlockActionSkip:
+		if category == sponsorblock.Highlight.Value {
+			return "Jump to"
+		}
+		return "Auto skip"
+	case SponsorBlockActionMute:
+		return "Mute"
+	case SponsorBlockActionButton:
+		switch category {
+		case sponsorblock.Highlight.Value, sponsorblock.ExclusiveAccess.Value, sponsorblock.Chapter.Value:
+			return "Show"
+		}
+		return "Skip button"
+	default:
+		return "Ignore"
+	}
+}
+
+/*
+CategoryAction returns the action picked for a category.
+Settings saved before per-category actions existed only have a list of selected categories, those are skipped automatically.
+*/
+func (s SponsorBlockSettingsProps) CategoryAction(category string) SponsorBlockAction {
+	allowed := SponsorBlockCategoryActions(category)
+	if action, ok := s.CategoryActions[category]; ok && slices.Contains(allowed, action) {
+		return action
+	}
+
+	switch category {
+	case sponsorblock.Highlight.Value, sponsorblock.ExclusiveAccess.Value, sponsorblock.Chapter.Value:
+		// These categories were added later and are shown by default
+		return allowed[0]
+	}
+	if slices.Contains(s.SelectedSponsorBlockCategories, category) {
+		return allowed[0]
+	}
+	return SponsorBlockActionIgnore
+}
+
+/*
+SegmentAction returns what should happen when playback reaches a segment, segments submitted as mute-only are never skipped
+*/
+func (s SponsorBlockSettingsProps) SegmentAction(segment sponsorblock.Segment) SponsorBlockAction {
+	action := s.CategoryAction(segment.Category)
+	switch segment.ActionType {
+	case "", sponsorblock.ActionTypeSkip:
+		return action
+	case sponsorblock.ActionTypeMute:
+		if action == SponsorBlockActionSkip {
+			return SponsorBlockActionMute
+		}
+		return action
+	case sponsorblock.ActionTypePOI:
+		if action == SponsorBlockActionMute {
+			return SponsorBlockActionButton
+		}
+		return action
+	default:
+		return SponsorBlockActionIgnore
+	}
+}
+
+/*
+FullVideoLabel returns the name of the category a whole video was labeled with, or an empty string
+*/
+func (s SponsorBlockSettingsProps) FullVideoLabel(segments []sponsorblock.Segment) string {
+	for _, segment := range segments {
+		if segment.ActionType != sponsorblock.ActionTypeFull {
+			continue
+		}
+		if s.CategoryAction(segment.Category) == SponsorBlockActionIgnore {
+			continue
+		}
+		idx := slices.IndexFunc(sponsorblock.AvailableCategories, func(c sponsorblock.Category) bool { return c.Value == segment.Category })
+		if idx >= 0 {
+			return sponsorblock.AvailableCategories[idx].Name
+		}
+	}
+	return ""
+}
@@ -0,0 +1,131 @@
+package types
+
+import "time"
+
+// TVSponsorBlockMode controls SponsorBlock on a single paired TV, inherit follows the user settings
+type TVSponsorBlockMode string
+
+const (
+	TVSponsorBlockInherit  TVSponsorBlockMode = "inherit"
+	TVSponsorBlockEnabled  TVSponsorBlockMode = "enabled"
+	TVSponsorBlockDisabled TVSponsorBlockMode = "disabled"
+)
+
+var TVSponsorBlockModes = []TVSponsorBlockMode{TVSponsorBlockInherit, TVSponsorBlockEnabled, TVSponsorBlockDisabled}
+
+func TVSponsorBlockModeLabel(mode TVSponsorBlockMode) string {
+	switch mode {
+	case TVSponsorBlockEnabled:
+		return "Always"
+	case TVSponsorBlockDisabled:
+		return "Never"
+	default:
+		return "Use settings"
+	}
+}
+
+/*
+ParseTVSponsorBlockMode returns a valid mode, unknown values fall back to inherit
+*/
+func ParseTVSponsorBlockMode(value string) TVSponsorBlockMode {
+	switch mode := TVSponsorBlockMode(value); mode {
+	case TVSponsorBlockEnabled, TVSponsorBlockDisabled:
+		return mode
+	default:
+		return TVSponsorBlockInherit
+	}
+}
+
+// TVAutoplayMode picks what plays on a paired TV once a video ends, off leaves it to YouTube autoplay
+type TVAutoplayMode string
+
+const (
+	TVAutoplayOff        TVAutoplayMode = "off"
+	TVAutoplayStop       TVAutoplayMode = "stop"
+	TVAutoplayFeed       TVAutoplayMode = "feed"
+	TVAutoplayWatchLater TVAutoplayMode = "watch_later"
+	TVAutoplayPlaylist   TVAutoplayMode = "playlist"
+)
+
+var TVAutoplayModes = []TVAutoplayMode{TVAutoplayOff, TVAutoplayStop, TVAutoplayFeed, TVAutoplayWatchLater, TVAutoplayPlaylist}
+
+func TVAutoplayModeLabel(mode TVAutoplayMode) string {
+	switch mode {
+	case TVAutoplayStop:
+		return "Stop playback"
+	case TVAutoplayFeed:
+		return "Next from my feed"
+	case TVAutoplayWatchLater:
+		return "Next from Watch Later"
+	case TVAutoplayPlaylist:
+		return "Next from a playlist"
+	default:
+		return "YouTube autoplay"
+	}
+}
+
+/*
+ParseTVAutoplayMode returns a valid mode, unknown values fall back to off
+*/
+func ParseTVAutoplayMode(value string) TVAutoplayMode {
+	switch mode := TVAutoplayMode(value); mode {
+	case TVAutoplayStop, TVAutoplayFeed, TVAutoplayWatchLater, TVAutoplayPlaylist:
+		return mode
+	default:
+		return TVAutoplayOff
+	}
+}
+
+type TVAutoplaySettingsProps struct {
+	Mode TVAutoplayMode
+	// PlaylistID is only used by the playlist mode
+	PlaylistID string
+}
+
+type TVAutoplayPlaylistProps struct {
+	ID   string
+	Name string
+}
+
+type TVSyncEventProps struct {
+	CreatedAt  time.Time
+	AccountID  string
+	UserID     string
+	ScreenName string
+	Kind       string
+	VideoID    string
+	Message    string
+}
+
+// TVLANPairingProps describes a token issued for the LAN pairing helper, the token itself is only shown once
+type TVLANPairingProps struct {
+	Token     string
+	ExpiresAt time.Time
+	// ServerURL is the address the helper should send paired screens to
+	ServerURL string
+}
+
+func TVSyncEventKindLabel(kind string) string {
+	switch kind {
+	case "paired":
+		return "Paired"
+	case "connect":
+		return "Connected"
+	case "connect_failed":
+		return "Connection failed"
+	case "disconnect":
+		return "Disconnected"
+	case "resume_seek":
+		return "Resumed"
+	case "resume_suppressed":
+		return "Resume skipped"
+	case "sponsor_skip":
+		return "Segment skipped"
+	case "progress":
+		return "Progress saved"
+	case "autoplay":
+		return "Autoplay"
+	default:
+		return kind
+	}
+}
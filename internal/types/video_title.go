@@ -22,3 +22,36 @@ func NormalizeVideoTitle(title string, videoType youtube.VideoType, videoID stri
 		return "Untitled video"
 	}
 }
+
+type VideoTitleMode string
+
+const (
+	VideoTitleModeOriginal VideoTitleMode = "original"
+	VideoTitleModeDeArrow  VideoTitleMode = "dearrow"
+	VideoTitleModeCasing   VideoTitleMode = "casing"
+)
+
+var VideoTitleModes = []VideoTitleMode{VideoTitleModeOriginal, VideoTitleModeDeArrow, VideoTitleModeCasing}
+
+func VideoTitleModeLabel(mode VideoTitleMode) string {
+	switch mode {
+	case VideoTitleModeDeArrow:
+		return "DeArrow"
+	case VideoTitleModeCasing:
+		return "Fix casing"
+	default:
+		return "Original"
+	}
+}
+
+/*
+DisplayTitleMode returns the title mode picked by the user, settings saved before title modes existed show original titles
+*/
+func (s SettingsPageProps) DisplayTitleMode() VideoTitleMode {
+	switch s.TitleMode {
+	case VideoTitleModeDeArrow, VideoTitleModeCasing:
+		return s.TitleMode
+	default:
+		return VideoTitleModeOriginal
+	}
+}
@@ -0,0 +1,110 @@
+package types
+
+import (
+	"time"
+
+	"github.com/cufee/feedlr-yt/internal/api/youtube"
+)
+
+// YouTubeSyncSource is the Feedlr list a sync target mirrors to a YouTube playlist
+type YouTubeSyncSource string
+
+const (
+	YouTubeSyncSourceFeed       YouTubeSyncSource = "feed"
+	YouTubeSyncSourceWatchLater YouTubeSyncSource = "watch_later"
+	YouTubeSyncSourcePlaylist   YouTubeSyncSource = "playlist"
+	YouTubeSyncSourceChannel    YouTubeSyncSource = "channel"
+)
+
+func YouTubeSyncSourceLabel(source YouTubeSyncSource) string {
+	switch source {
+	case YouTubeSyncSourceFeed:
+		return "Home feed"
+	case YouTubeSyncSourceWatchLater:
+		return "Watch Later"
+	case YouTubeSyncSourcePlaylist:
+		return "Playlist"
+	case YouTubeSyncSourceChannel:
+		return "Channel"
+	default:
+		return string(source)
+	}
+}
+
+/*
+ParseYouTubeSyncSource returns false for unknown sources
+*/
+func ParseYouTubeSyncSource(value string) (YouTubeSyncSource, bool) {
+	switch source := YouTubeSyncSource(value); source {
+	case YouTubeSyncSourceFeed, YouTubeSyncSourceWatchLater, YouTubeSyncSourcePlaylist, YouTubeSyncSourceChannel:
+		return source, true
+	default:
+		return "", false
+	}
+}
+
+// YouTubeSyncOrdering controls the order of videos in a synced playlist, source keeps the order of the Feedlr list
+type YouTubeSyncOrdering string
+
+const (
+	YouTubeSyncOrderingSource YouTubeSyncOrdering = "source"
+	YouTubeSyncOrderingNewest YouTubeSyncOrdering = "newest"
+	YouTubeSyncOrderingOldest YouTubeSyncOrdering = "oldest"
+)
+
+var YouTubeSyncOrderings = []YouTubeSyncOrdering{YouTubeSyncOrderingSource, YouTubeSyncOrderingNewest, YouTubeSyncOrderingOldest}
+
+func YouTubeSyncOrderingLabel(ordering YouTubeSyncOrdering) string {
+	switch ordering {
+	case YouTubeSyncOrderingNewest:
+		return "Newest first"
+	case YouTubeSyncOrderingOldest:
+		return "Oldest first"
+	default:
+		return "Same as Feedlr"
+	}
+}
+
+/*
+ParseYouTubeSyncOrdering returns a valid ordering, unknown values fall back to source
+*/
+func ParseYouTubeSyncOrdering(value string) YouTubeSyncOrdering {
+	switch ordering := YouTubeSyncOrdering(value); ordering {
+	case YouTubeSyncOrderingNewest, YouTubeSyncOrderingOldest:
+		return ordering
+	default:
+		return YouTubeSyncOrderingSource
+	}
+}
+
+type YouTubeSyncTargetProps struct {
+	ID       string
+	Source   YouTubeSyncSource
+	SourceID string
+	// SourceName is the playlist or channel name, empty for the feed and Watch Later
+	SourceName   string
+	Title        string
+	PlaylistID   string
+	Size         int
+	Ordering     YouTubeSyncOrdering
+	LastError    string
+	LastSyncedAt time.Time
+}
+
+// YouTubeSyncSourceOptionProps is a source that can be picked for a new target, Value encodes the source and its id
+type YouTubeSyncSourceOptionProps struct {
+	Value string
+	Label string
+	Group string
+}
+
+type YouTubeSubscriptionImportProps struct {
+	Connected bool
+	// CanRead is false when the account needs to be connected again to grant read access
+	CanRead bool
+	// Channels are the YouTube subscriptions that are not in Feedlr yet
+	Channels          []youtube.Channel
+	AlreadySubscribed int
+	// Truncated is set when the account has more subscriptions than are listed
+	Truncated bool
+}
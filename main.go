@@ -18,6 +18,7 @@ import (
 	"github.com/cufee/feedlr-yt/internal/logic/background"
 	"github.com/cufee/feedlr-yt/internal/server"
 	"github.com/cufee/feedlr-yt/internal/sessions"
+	"github.com/cufee/feedlr-yt/internal/tracing"
 	"github.com/go-webauthn/webauthn/webauthn"
 	"github.com/rs/zerolog/log"
 
@@ -32,10 +33,22 @@ import (
 var assetsFs embed.FS
 
 func main() {
+	if len(os.Args) > 1 && os.Args[1] == "tv-pair" {
+		os.Exit(runTVPair(os.Args[2:]))
+	}
+
+	stopTracing, err := tracing.Setup(context.Background())
+	if err != nil {
+		panic(err)
+	}
+
 	db, err := database.NewSQLiteClient(os.Getenv("DATABASE_PATH"))
 	if err != nil {
 		panic(err)
 	}
+	if tracing.Enabled() {
+		db = database.WithTracing(db)
+	}
 
 	youtubeSync, err := logic.NewYouTubeSyncService(db)
 	if err != nil {
@@ -70,10 +83,20 @@ func main() {
 	}
 	youtube.DefaultClient = yt
 
-	_, err = background.StartCronTasks(db, youtubeSync, youtubeTVSync)
+	jobs := logic.NewJobQueue(db, logic.JobWorkers())
+	logic.RegisterJobHandlers(jobs, db, youtubeSync)
+	jobs.Start()
+	if err := logic.EnqueueTokenReencryption(context.Background(), db, youtubeSync); err != nil {
+		log.Warn().Err(err).Msg("failed to queue token re-encryption")
+	}
+
+	cron, err := background.StartCronTasks(db, youtubeSync, youtubeTVSync)
 	if err != nil {
 		panic(err)
 	}
+	shutdown := &gracefulShutdown{db: db, cron: cron, jobs: jobs, youtubeSync: youtubeSync, tvSync: youtubeTVSync, tracing: stopTracing}
+	shutdown.onSignal()
+
 	bootCtx, bootCancel := context.WithTimeout(context.Background(), 15*time.Second)
 	if err := youtubeTVSync.RunLifecycleTick(bootCtx); err != nil {
 		log.Warn().Err(err).Msg("initial tv sync lifecycle tick failed")
@@ -117,6 +140,10 @@ func main() {
 
 	authMiddleware := mw.Middleware(ses)
 
-	start := server.New(db, ses, assetsFs, bluemonday.StrictPolicy(), wa, authMiddleware, nil)
-	start()
+	start, stop := server.New(db, ses, assetsFs, bluemonday.StrictPolicy(), wa, authMiddleware, nil)
+	shutdown.setServer(stop)
+	if err := start(); err != nil {
+		panic(err)
+	}
+	shutdown.wait()
 }
@@ -14,18 +14,31 @@ import (
 	"github.com/cufee/feedlr-yt/internal/logic"
 	"github.com/cufee/feedlr-yt/internal/server"
 	"github.com/cufee/feedlr-yt/internal/sessions"
+	"github.com/cufee/feedlr-yt/internal/tracing"
 	"github.com/rs/zerolog/log"
 
 	"github.com/microcosm-cc/bluemonday"
 )
 
 func main() {
+	if len(os.Args) > 1 && os.Args[1] == "tv-pair" {
+		os.Exit(runTVPair(os.Args[2:]))
+	}
+
 	log.Info().Msg("Starting in DEVELOPMENT mode with mock auth")
 
+	stopTracing, err := tracing.Setup(context.Background())
+	if err != nil {
+		panic(err)
+	}
+
 	db, err := database.NewSQLiteClient(os.Getenv("DATABASE_PATH"))
 	if err != nil {
 		panic(err)
 	}
+	if tracing.Enabled() {
+		db = database.WithTracing(db)
+	}
 
 	youtubeSync, err := logic.NewYouTubeSyncService(db)
 	if err != nil {
@@ -60,6 +73,18 @@ func main() {
 		panic(err)
 	}
 	youtube.DefaultClient = yt
+
+	// Jobs run in development too, playlist imports and channel refreshes depend on them
+	jobs := logic.NewJobQueue(db, logic.JobWorkers())
+	logic.RegisterJobHandlers(jobs, db, youtubeSync)
+	jobs.Start()
+	if err := logic.EnqueueTokenReencryption(context.Background(), db, youtubeSync); err != nil {
+		log.Warn().Err(err).Msg("failed to queue token re-encryption")
+	}
+
+	shutdown := &gracefulShutdown{db: db, jobs: jobs, youtubeSync: youtubeSync, tvSync: youtubeTVSync, tracing: stopTracing}
+	shutdown.onSignal()
+
 	bootCtx, bootCancel := context.WithTimeout(context.Background(), 15*time.Second)
 	if err := youtubeTVSync.RunLifecycleTick(bootCtx); err != nil {
 		log.Warn().Err(err).Msg("initial tv sync lifecycle tick failed")
@@ -82,8 +107,10 @@ func main() {
 	// - os.DirFS(".") for live asset reloading
 	// - nil for webauthn (not needed in dev mode)
 	// - mockAuth as both auth middleware AND global middleware
-	start := server.New(db, ses, os.DirFS("."), bluemonday.StrictPolicy(), nil, mockAuth, mockAuth)
+	start, stop := server.New(db, ses, os.DirFS("."), bluemonday.StrictPolicy(), nil, mockAuth, mockAuth)
+	shutdown.setServer(stop)
 	if err := start(); err != nil {
 		panic(err)
 	}
+	shutdown.wait()
 }
@@ -71,6 +71,11 @@ table "youtube_sync_accounts" {
     type = boolean
     default = true
   }
+  column "granted_scopes" {
+    null = false
+    type = text
+    default = ""
+  }
   column "last_feed_video_published_at" {
     null = true
     type = date
@@ -104,6 +109,82 @@ table "youtube_sync_accounts" {
   }
 }
 
+table "youtube_sync_targets" {
+  schema = schema.main
+
+  column "id" {
+    null = false
+    type = text
+  }
+  column "created_at" {
+    null = false
+    type = date
+  }
+  column "updated_at" {
+    null = false
+    type = date
+  }
+  primary_key {
+    columns = [column.id]
+  }
+
+  column "user_id" {
+    null = false
+    type = text
+  }
+  column "source" {
+    null = false
+    type = text
+  }
+  column "source_id" {
+    null = false
+    type = text
+    default = ""
+  }
+  column "title" {
+    null = false
+    type = text
+  }
+  column "playlist_id" {
+    null = true
+    type = text
+  }
+  column "size" {
+    null = false
+    type = integer
+    default = 36
+  }
+  column "ordering" {
+    null = false
+    type = text
+    default = "source"
+  }
+  column "last_synced_at" {
+    null = true
+    type = date
+  }
+  column "last_sync_attempt_at" {
+    null = true
+    type = date
+  }
+  column "last_error" {
+    null = false
+    type = text
+    default = ""
+  }
+
+  foreign_key "youtube_sync_targets_user_id_fkey" {
+    columns = [ column.user_id ]
+    ref_columns = [ table.youtube_sync_accounts.column.user_id ]
+    on_delete   = CASCADE
+  }
+
+  index "idx_youtube_sync_targets_user_id_source_source_id_unique" {
+    columns = [ column.user_id, column.source, column.source_id ]
+    unique = true
+  }
+}
+
 table "youtube_tv_sync_accounts" {
   schema = schema.main
 
@@ -184,6 +265,16 @@ table "youtube_tv_sync_accounts" {
     type = text
     default = ""
   }
+  column "display_name" {
+    null = false
+    type = text
+    default = ""
+  }
+  column "sponsorblock_mode" {
+    null = false
+    type = text
+    default = "inherit"
+  }
 
   foreign_key "youtube_tv_sync_accounts_user_id_fkey" {
     columns = [ column.user_id ]
@@ -191,8 +282,8 @@ table "youtube_tv_sync_accounts" {
     on_delete   = CASCADE
   }
 
-  index "idx_youtube_tv_sync_accounts_user_id_unique" {
-    columns = [ column.user_id ]
+  index "idx_youtube_tv_sync_accounts_user_id_screen_id_unique" {
+    columns = [ column.user_id, column.screen_id ]
     unique = true
   }
   index "idx_youtube_tv_sync_accounts_sync_enabled_connection_state" {
@@ -203,7 +294,7 @@ table "youtube_tv_sync_accounts" {
   }
 }
 
-table "channels" {
+table "youtube_tv_sync_events" {
   schema = schema.main
 
   column "id" {
@@ -214,44 +305,55 @@ table "channels" {
     null = false
     type = date
   }
-  column "updated_at" {
+  column "account_id" {
     null = false
-    type = date
-  }
-  primary_key {
-    columns = [column.id]
+    type = text
   }
-
-  column "title" {
+  column "user_id" {
     null = false
     type = text
   }
-  column "description" {
+  column "kind" {
     null = false
     type = text
   }
-  column "thumbnail" {
+  column "video_id" {
     null = false
     type = text
     default = ""
   }
-
-  column "feed_updated_at" {
-    null = false
-    type = date
-    default = 0
-  }
-  column "uploads_playlist_id" {
+  column "message" {
     null = false
     type = text
     default = ""
   }
+  primary_key {
+    columns = [column.id]
+  }
+
+  foreign_key "youtube_tv_sync_events_account_id_fkey" {
+    columns = [ column.account_id ]
+    ref_columns = [ table.youtube_tv_sync_accounts.column.id ]
+    on_delete   = CASCADE
+  }
+  foreign_key "youtube_tv_sync_events_user_id_fkey" {
+    columns = [ column.user_id ]
+    ref_columns = [ table.users.column.id ]
+    on_delete   = CASCADE
+  }
+
+  index "idx_youtube_tv_sync_events_account_id_created_at" {
+    columns = [ column.account_id, column.created_at ]
+  }
+  index "idx_youtube_tv_sync_events_created_at" {
+    columns = [ column.created_at ]
+  }
 }
 
-table "videos" {
+table "youtube_tv_pairing_tokens" {
   schema = schema.main
 
-  column "id" {
+  column "token_hash" {
     null = false
     type = text
   }
@@ -259,61 +361,58 @@ table "videos" {
     null = false
     type = date
   }
-  column "updated_at" {
+  column "expires_at" {
     null = false
     type = date
   }
+  column "user_id" {
+    null = false
+    type = text
+  }
   primary_key {
-    columns = [column.id]
+    columns = [column.token_hash]
   }
 
-  column "title" {
-    null = false
-    type = text
+  foreign_key "youtube_tv_pairing_tokens_user_id_fkey" {
+    columns = [ column.user_id ]
+    ref_columns = [ table.users.column.id ]
+    on_delete   = CASCADE
   }
-  column "description" {
+
+  index "idx_youtube_tv_pairing_tokens_user_id" {
+    columns = [ column.user_id ]
+  }
+}
+
+table "leases" {
+  schema = schema.main
+
+  column "name" {
     null = false
     type = text
   }
-  column "duration" {
+  column "holder" {
     null = false
-    type = integer
+    type = text
   }
-  column "published_at" {
+  column "acquired_at" {
     null = false
     type = date
   }
-  column "private" {
-    null = false
-    type = boolean
-  }
-  column "type" {
-    null = false
-    type = text
-  }
-
-  column "channel_id" {
+  column "expires_at" {
     null = false
-    type = text
+    type = date
   }
-  foreign_key "videos_channel_id_fkey" {
-    columns = [ column.channel_id ]
-    ref_columns = [ table.channels.column.id ]
-    on_delete   = CASCADE
+  primary_key {
+    columns = [column.name]
   }
 
-  index "idx_videos_published_at" {
-    columns = [ column.published_at]
-  }
-  index "idx_videos_channel_id" {
-    columns = [ column.channel_id]
-  }
-  index "idx_videos_published_at_channel_id" {
-    columns = [ column.published_at, column.channel_id ]
+  index "idx_leases_holder" {
+    columns = [ column.holder ]
   }
 }
 
-table "views" {
+table "jobs" {
   schema = schema.main
 
   column "id" {
@@ -332,50 +431,74 @@ table "views" {
     columns = [column.id]
   }
 
-  column "user_id" {
+  column "kind" {
     null = false
     type = text
   }
-  column "video_id" {
+  column "payload" {
+    null    = false
+    type    = text
+    default = "{}"
+  }
+  column "dedup_key" {
+    null = true
+    type = text
+  }
+  column "priority" {
+    null    = false
+    type    = integer
+    default = 0
+  }
+  column "status" {
     null = false
     type = text
   }
-  column "progress" {
+  column "attempts" {
+    null    = false
+    type    = integer
+    default = 0
+  }
+  column "max_attempts" {
     null = false
     type = integer
   }
-  column "hidden" {
+  column "run_at" {
+    null = false
+    type = date
+  }
+  column "locked_by" {
     null = true
-    type = boolean
-    default = false
+    type = text
   }
-
-  foreign_key "views_user_id_fkey" {
-    columns = [ column.user_id ]
-    ref_columns = [ table.users.column.id ]
-    on_delete   = CASCADE
+  column "locked_until" {
+    null = true
+    type = date
   }
-  foreign_key "views_video_id_fkey" {
-    columns = [ column.video_id ]
-    ref_columns = [ table.videos.column.id ]
+  column "last_error" {
+    null    = false
+    type    = text
+    default = ""
   }
-
-  index "idx_views_user_id" {
-    columns = [ column.user_id]
+  column "finished_at" {
+    null = true
+    type = date
   }
-  index "idx_views_user_id_hidden" {
-    columns = [ column.user_id, column.hidden]
+
+  index "idx_jobs_status_priority_run_at" {
+    columns = [ column.status, column.priority, column.run_at ]
   }
-  index "idx_views_video_id_user_id" {
-    columns = [  column.video_id, column.user_id ]
-    unique = true
+  // A dedup key is only unique among jobs that did not finish yet
+  index "idx_jobs_dedup_key_active" {
+    columns = [ column.dedup_key ]
+    unique  = true
+    where   = "dedup_key IS NOT NULL AND status IN ('queued', 'running')"
   }
-  index "idx_views_video_id_user_id_hidden" {
-    columns = [  column.video_id, column.user_id, column.hidden ]
+  index "idx_jobs_kind" {
+    columns = [ column.kind ]
   }
 }
 
-table "users" {
+table "channels" {
   schema = schema.main
 
   column "id" {
@@ -394,60 +517,33 @@ table "users" {
     columns = [column.id]
   }
 
-  column "permissions" {
+  column "title" {
     null = false
     type = text
-    default = ""
   }
-  column "username" {
+  column "description" {
     null = false
     type = text
   }
-  index "idx_users_username" {
-    columns = [ column.username ]
-    unique = true
-  }
-}
-
-table "passkeys" {
-  schema = schema.main
-
-  column "id" {
+  column "thumbnail" {
     null = false
     type = text
+    default = ""
   }
-  column "created_at" {
-    null = false
-    type = date
-  }
-  column "updated_at" {
+
+  column "feed_updated_at" {
     null = false
     type = date
+    default = 0
   }
-  primary_key {
-    columns = [column.id]
-  }
-
-  column "label" {
+  column "uploads_playlist_id" {
     null = false
     type = text
     default = ""
   }
-  column "data" {
-    null = false
-    type = blob
-  }
-  column "user_id" {
-    null = false
-    type = text
-  }
-
-  index "idx_passkeys_user_id" {
-    columns = [ column.user_id ]
-  }
 }
 
-table "sessions" {
+table "videos" {
   schema = schema.main
 
   column "id" {
@@ -466,46 +562,53 @@ table "sessions" {
     columns = [column.id]
   }
 
-  column "user_id" {
-    null = true
+  column "title" {
+    null = false
     type = text
   }
-  column "connection_id" {
-    null = true
+  column "description" {
+    null = false
     type = text
   }
-  column "expires_at" {
+  column "duration" {
     null = false
-    type = date
+    type = integer
   }
-  column "last_used" {
+  column "published_at" {
     null = false
     type = date
   }
-  column "deleted" {
+  column "private" {
     null = false
     type = boolean
-    default = false
+  }
+  column "type" {
+    null = false
+    type = text
   }
 
-  column "meta" {
+  column "channel_id" {
     null = false
-    type = blob
-    default = ""
+    type = text
+  }
+  foreign_key "videos_channel_id_fkey" {
+    columns = [ column.channel_id ]
+    ref_columns = [ table.channels.column.id ]
+    on_delete   = CASCADE
   }
 
-  index "idx_sessions_id_expires_at_deleted" {
-    columns = [  column.id, column.expires_at, column.deleted]
+  index "idx_videos_published_at" {
+    columns = [ column.published_at]
   }
-  index "idx_sessions_user_id_expires_at_deleted" {
-    columns = [  column.user_id, column.expires_at, column.deleted]
+  index "idx_videos_channel_id" {
+    columns = [ column.channel_id]
   }
-  index "idx_sessions_user_id_last_used_deleted" {
-    columns = [  column.user_id, column.last_used, column.deleted ]
+  index "idx_videos_published_at_channel_id" {
+    columns = [ column.published_at, column.channel_id ]
   }
 }
 
-table "settings" {
+table "watch_history_imports" {
   schema = schema.main
 
   column "id" {
@@ -524,31 +627,71 @@ table "settings" {
     columns = [column.id]
   }
 
-  column "data" {
+  column "user_id" {
     null = false
-    type = blob
+    type = text
   }
-
-  column "user_id" {
+  column "hide" {
+    null = false
+    type = boolean
+    default = false
+  }
+  column "entries" {
     null = false
     type = text
+    default = "[]"
   }
-  foreign_key "settings_user_id_fkey" {
+  column "status" {
+    null = false
+    type = text
+  }
+  column "total" {
+    null = false
+    type = integer
+    default = 0
+  }
+  column "processed" {
+    null = false
+    type = integer
+    default = 0
+  }
+  column "imported" {
+    null = false
+    type = integer
+    default = 0
+  }
+  column "skipped" {
+    null = false
+    type = integer
+    default = 0
+  }
+  column "failed" {
+    null = false
+    type = integer
+    default = 0
+  }
+  column "last_error" {
+    null = false
+    type = text
+    default = ""
+  }
+  column "finished_at" {
+    null = true
+    type = date
+  }
+
+  foreign_key "watch_history_imports_user_id_fkey" {
     columns = [ column.user_id ]
     ref_columns = [ table.users.column.id ]
     on_delete   = CASCADE
   }
 
-  index "idx_settings_user_id" {
-    columns = [ column.user_id ]
-  }
-  index "idx_settings_id_user_id_unique" {
-    columns = [ column.id, column.user_id ]
-    unique = true
+  index "idx_watch_history_imports_user_id_created_at" {
+    columns = [ column.user_id, column.created_at ]
   }
 }
 
-table "subscriptions" {
+table "views" {
   schema = schema.main
 
   column "id" {
@@ -567,46 +710,50 @@ table "subscriptions" {
     columns = [column.id]
   }
 
-  column "favorite" {
+  column "user_id" {
     null = false
-    type = boolean
+    type = text
   }
-
-  column "channel_id" {
+  column "video_id" {
     null = false
     type = text
   }
-  column "user_id" {
+  column "progress" {
     null = false
-    type = text
+    type = integer
   }
-  foreign_key "subscriptions_channel_id_fkey" {
-    columns = [ column.channel_id ]
-    ref_columns = [ table.channels.column.id ]
-    on_delete   = CASCADE
+  column "hidden" {
+    null = true
+    type = boolean
+    default = false
   }
-  foreign_key "subscriptions_user_id_fkey" {
+
+  foreign_key "views_user_id_fkey" {
     columns = [ column.user_id ]
     ref_columns = [ table.users.column.id ]
     on_delete   = CASCADE
   }
+  foreign_key "views_video_id_fkey" {
+    columns = [ column.video_id ]
+    ref_columns = [ table.videos.column.id ]
+  }
 
-  index "idx_subscriptions_user_id" {
-    columns = [ column.user_id ]
+  index "idx_views_user_id" {
+    columns = [ column.user_id]
   }
-  index "idx_subscriptions_user_id_favorite" {
-    columns = [ column.user_id, column.favorite ]
+  index "idx_views_user_id_hidden" {
+    columns = [ column.user_id, column.hidden]
   }
-  index "idx_subscriptions_user_id_channel_id_unique" {
-    columns = [ column.user_id, column.channel_id ]
+  index "idx_views_video_id_user_id" {
+    columns = [  column.video_id, column.user_id ]
     unique = true
   }
-  index "idx_subscriptions_channel_id" {
-    columns = [ column.channel_id ]
+  index "idx_views_video_id_user_id_hidden" {
+    columns = [  column.video_id, column.user_id, column.hidden ]
   }
 }
 
-table "playlists" {
+table "users" {
   schema = schema.main
 
   column "id" {
@@ -625,48 +772,60 @@ table "playlists" {
     columns = [column.id]
   }
 
-  column "user_id" {
+  column "permissions" {
     null = false
     type = text
+    default = ""
   }
-  column "slug" {
+  column "username" {
     null = false
     type = text
   }
-  column "name" {
+  index "idx_users_username" {
+    columns = [ column.username ]
+    unique = true
+  }
+}
+
+table "passkeys" {
+  schema = schema.main
+
+  column "id" {
     null = false
     type = text
   }
-  column "system" {
+  column "created_at" {
     null = false
-    type = boolean
-    default = false
+    type = date
   }
-  column "ttl_days" {
-    null = true
-    type = integer
+  column "updated_at" {
+    null = false
+    type = date
   }
-  column "max_size" {
-    null = true
-    type = integer
+  primary_key {
+    columns = [column.id]
   }
 
-  foreign_key "playlists_user_id_fkey" {
-    columns = [ column.user_id ]
-    ref_columns = [ table.users.column.id ]
-    on_delete   = CASCADE
+  column "label" {
+    null = false
+    type = text
+    default = ""
+  }
+  column "data" {
+    null = false
+    type = blob
+  }
+  column "user_id" {
+    null = false
+    type = text
   }
 
-  index "idx_playlists_user_id" {
+  index "idx_passkeys_user_id" {
     columns = [ column.user_id ]
   }
-  index "idx_playlists_user_id_slug_unique" {
-    columns = [ column.user_id, column.slug ]
-    unique = true
-  }
 }
 
-table "playlist_items" {
+table "sessions" {
   schema = schema.main
 
   column "id" {
@@ -685,38 +844,668 @@ table "playlist_items" {
     columns = [column.id]
   }
 
-  column "playlist_id" {
-    null = false
+  column "user_id" {
+    null = true
     type = text
   }
-  column "video_id" {
-    null = false
+  column "connection_id" {
+    null = true
     type = text
   }
-  column "position" {
+  column "expires_at" {
     null = false
-    type = integer
-    default = 0
+    type = date
+  }
+  column "last_used" {
+    null = false
+    type = date
+  }
+  column "deleted" {
+    null = false
+    type = boolean
+    default = false
   }
 
-  foreign_key "playlist_items_playlist_id_fkey" {
-    columns = [ column.playlist_id ]
-    ref_columns = [ table.playlists.column.id ]
-    on_delete   = CASCADE
+  column "meta" {
+    null = false
+    type = blob
+    default = ""
   }
-  foreign_key "playlist_items_video_id_fkey" {
-    columns = [ column.video_id ]
-    ref_columns = [ table.videos.column.id ]
+
+  index "idx_sessions_id_expires_at_deleted" {
+    columns = [  column.id, column.expires_at, column.deleted]
+  }
+  index "idx_sessions_user_id_expires_at_deleted" {
+    columns = [  column.user_id, column.expires_at, column.deleted]
+  }
+  index "idx_sessions_user_id_last_used_deleted" {
+    columns = [  column.user_id, column.last_used, column.deleted ]
   }
+}
 
-  index "idx_playlist_items_playlist_id" {
-    columns = [ column.playlist_id ]
+table "settings" {
+  schema = schema.main
+
+  column "id" {
+    null = false
+    type = text
   }
-  index "idx_playlist_items_playlist_id_video_id_unique" {
-    columns = [ column.playlist_id, column.video_id ]
-    unique = true
+  column "created_at" {
+    null = false
+    type = date
   }
-  index "idx_playlist_items_playlist_id_created_at" {
-    columns = [ column.playlist_id, column.created_at ]
+  column "updated_at" {
+    null = false
+    type = date
+  }
+  primary_key {
+    columns = [column.id]
+  }
+
+  column "data" {
+    null = false
+    type = blob
+  }
+
+  column "user_id" {
+    null = false
+    type = text
+  }
+  foreign_key "settings_user_id_fkey" {
+    columns = [ column.user_id ]
+    ref_columns = [ table.users.column.id ]
+    on_delete   = CASCADE
+  }
+
+  index "idx_settings_user_id" {
+    columns = [ column.user_id ]
+  }
+  index "idx_settings_id_user_id_unique" {
+    columns = [ column.id, column.user_id ]
+    unique = true
+  }
+}
+
+table "subscriptions" {
+  schema = schema.main
+
+  column "id" {
+    null = false
+    type = text
+  }
+  column "created_at" {
+    null = false
+    type = date
+  }
+  column "updated_at" {
+    null = false
+    type = date
+  }
+  primary_key {
+    columns = [column.id]
+  }
+
+  column "favorite" {
+    null = false
+    type = boolean
+  }
+
+  column "channel_id" {
+    null = false
+    type = text
+  }
+  column "user_id" {
+    null = false
+    type = text
+  }
+  column "transcripts_enabled" {
+    null    = false
+    type    = boolean
+    default = false
+  }
+  foreign_key "subscriptions_channel_id_fkey" {
+    columns = [ column.channel_id ]
+    ref_columns = [ table.channels.column.id ]
+    on_delete   = CASCADE
+  }
+  foreign_key "subscriptions_user_id_fkey" {
+    columns = [ column.user_id ]
+    ref_columns = [ table.users.column.id ]
+    on_delete   = CASCADE
+  }
+
+  index "idx_subscriptions_user_id" {
+    columns = [ column.user_id ]
+  }
+  index "idx_subscriptions_user_id_favorite" {
+    columns = [ column.user_id, column.favorite ]
+  }
+  index "idx_subscriptions_user_id_channel_id_unique" {
+    columns = [ column.user_id, column.channel_id ]
+    unique = true
+  }
+  index "idx_subscriptions_channel_id" {
+    columns = [ column.channel_id ]
+  }
+}
+
+table "playlists" {
+  schema = schema.main
+
+  column "id" {
+    null = false
+    type = text
+  }
+  column "created_at" {
+    null = false
+    type = date
+  }
+  column "updated_at" {
+    null = false
+    type = date
+  }
+  primary_key {
+    columns = [column.id]
+  }
+
+  column "user_id" {
+    null = false
+    type = text
+  }
+  column "slug" {
+    null = false
+    type = text
+  }
+  column "name" {
+    null = false
+    type = text
+  }
+  column "system" {
+    null = false
+    type = boolean
+    default = false
+  }
+  column "ttl_days" {
+    null = true
+    type = integer
+  }
+  column "max_size" {
+    null = true
+    type = integer
+  }
+  column "overflow_policy" {
+    null = false
+    type = text
+    default = "drop_oldest"
+  }
+  column "description" {
+    null = false
+    type = text
+    default = ""
+  }
+  column "youtube_playlist_id" {
+    null = true
+    type = text
+  }
+  column "auto_sync_interval_hours" {
+    null = false
+    type = integer
+    default = 0
+  }
+  column "sync_mode" {
+    null = false
+    type = text
+    default = "append"
+  }
+  column "last_synced_at" {
+    null = true
+    type = date
+  }
+  column "last_sync_error" {
+    null = false
+    type = text
+    default = ""
+  }
+  column "next_sync_at" {
+    null = true
+    type = date
+  }
+  column "rules" {
+    null = true
+    type = text
+  }
+
+  foreign_key "playlists_user_id_fkey" {
+    columns = [ column.user_id ]
+    ref_columns = [ table.users.column.id ]
+    on_delete   = CASCADE
+  }
+
+  index "idx_playlists_user_id" {
+    columns = [ column.user_id ]
+  }
+  index "idx_playlists_user_id_slug_unique" {
+    columns = [ column.user_id, column.slug ]
+    unique = true
+  }
+  index "idx_playlists_youtube_playlist_id" {
+    columns = [ column.youtube_playlist_id ]
+  }
+  index "idx_playlists_next_sync_at" {
+    columns = [ column.next_sync_at ]
+  }
+}
+
+table "playlist_items" {
+  schema = schema.main
+
+  column "id" {
+    null = false
+    type = text
+  }
+  column "created_at" {
+    null = false
+    type = date
+  }
+  column "updated_at" {
+    null = false
+    type = date
+  }
+  primary_key {
+    columns = [column.id]
+  }
+
+  column "playlist_id" {
+    null = false
+    type = text
+  }
+  column "video_id" {
+    null = false
+    type = text
+  }
+  column "position" {
+    null = false
+    type = integer
+    default = 0
+  }
+
+  foreign_key "playlist_items_playlist_id_fkey" {
+    columns = [ column.playlist_id ]
+    ref_columns = [ table.playlists.column.id ]
+    on_delete   = CASCADE
+  }
+  foreign_key "playlist_items_video_id_fkey" {
+    columns = [ column.video_id ]
+    ref_columns = [ table.videos.column.id ]
+  }
+
+  index "idx_playlist_items_playlist_id" {
+    columns = [ column.playlist_id ]
+  }
+  index "idx_playlist_items_playlist_id_video_id_unique" {
+    columns = [ column.playlist_id, column.video_id ]
+    unique = true
+  }
+  index "idx_playlist_items_playlist_id_created_at" {
+    columns = [ column.playlist_id, column.created_at ]
+  }
+  index "idx_playlist_items_playlist_id_position" {
+    columns = [ column.playlist_id, column.position ]
+  }
+}
+
+table "playlist_share_links" {
+  schema = schema.main
+
+  column "id" {
+    null = false
+    type = text
+  }
+  column "created_at" {
+    null = false
+    type = date
+  }
+  column "updated_at" {
+    null = false
+    type = date
+  }
+  primary_key {
+    columns = [column.id]
+  }
+
+  column "playlist_id" {
+    null = false
+    type = text
+  }
+  column "token_hash" {
+    null = false
+    type = text
+  }
+  column "expires_at" {
+    null = true
+    type = date
+  }
+
+  foreign_key "playlist_share_links_playlist_id_fkey" {
+    columns = [ column.playlist_id ]
+    ref_columns = [ table.playlists.column.id ]
+    on_delete   = CASCADE
+  }
+
+  index "idx_playlist_share_links_playlist_id" {
+    columns = [ column.playlist_id ]
+  }
+  index "idx_playlist_share_links_token_hash_unique" {
+    columns = [ column.token_hash ]
+    unique = true
+  }
+}
+
+table "video_chapters" {
+  schema = schema.main
+
+  column "id" {
+    null = false
+    type = text
+  }
+  column "created_at" {
+    null = false
+    type = date
+  }
+  column "updated_at" {
+    null = false
+    type = date
+  }
+  primary_key {
+    columns = [column.id]
+  }
+
+  column "video_id" {
+    null = false
+    type = text
+  }
+  column "source" {
+    null = false
+    type = text
+  }
+  column "position" {
+    null = false
+    type = integer
+  }
+  column "start_seconds" {
+    null = false
+    type = integer
+  }
+  column "end_seconds" {
+    null = false
+    type = integer
+  }
+  column "title" {
+    null = false
+    type = text
+  }
+
+  foreign_key "video_chapters_video_id_fkey" {
+    columns = [ column.video_id ]
+    ref_columns = [ table.videos.column.id ]
+    on_delete   = CASCADE
+  }
+
+  index "idx_video_chapters_video_id_source_position_unique" {
+    columns = [ column.video_id, column.source, column.position ]
+    unique = true
+  }
+}
+
+table "video_transcripts" {
+  schema = schema.main
+
+  column "id" {
+    null = false
+    type = text
+  }
+  column "created_at" {
+    null = false
+    type = date
+  }
+  column "updated_at" {
+    null = false
+    type = date
+  }
+  primary_key {
+    columns = [column.id]
+  }
+
+  column "video_id" {
+    null = false
+    type = text
+  }
+  column "language" {
+    null = false
+    type = text
+  }
+  column "name" {
+    null = false
+    type = text
+  }
+  column "auto_generated" {
+    null = false
+    type = boolean
+  }
+  column "cue_count" {
+    null = false
+    type = integer
+  }
+  column "content" {
+    null = false
+    type = blob
+  }
+
+  foreign_key "video_transcripts_video_id_fkey" {
+    columns = [ column.video_id ]
+    ref_columns = [ table.videos.column.id ]
+    on_delete   = CASCADE
+  }
+
+  index "idx_video_transcripts_video_id_language_unique" {
+    columns = [ column.video_id, column.language ]
+    unique = true
+  }
+}
+
+table "video_transcript_terms" {
+  schema = schema.main
+
+  column "term" {
+    null = false
+    type = text
+  }
+  column "transcript_id" {
+    null = false
+    type = text
+  }
+  column "start_seconds" {
+    null = false
+    type = integer
+  }
+  primary_key {
+    columns = [column.term, column.transcript_id]
+  }
+
+  foreign_key "video_transcript_terms_transcript_id_fkey" {
+    columns = [ column.transcript_id ]
+    ref_columns = [ table.video_transcripts.column.id ]
+    on_delete   = CASCADE
+  }
+
+  index "idx_video_transcript_terms_transcript_id" {
+    columns = [ column.transcript_id ]
+  }
+}
+
+table "video_transcript_checks" {
+  schema = schema.main
+
+  column "video_id" {
+    null = false
+    type = text
+  }
+  column "checked_at" {
+    null = false
+    type = date
+  }
+  column "track_count" {
+    null = false
+    type = integer
+  }
+  primary_key {
+    columns = [column.video_id]
+  }
+
+  foreign_key "video_transcript_checks_video_id_fkey" {
+    columns = [ column.video_id ]
+    ref_columns = [ table.videos.column.id ]
+    on_delete   = CASCADE
+  }
+}
+
+table "sponsorblock_video_segments" {
+  schema = schema.main
+
+  column "video_id" {
+    null = false
+    type = text
+  }
+  column "created_at" {
+    null = false
+    type = date
+  }
+  column "updated_at" {
+    null = false
+    type = date
+  }
+  column "expires_at" {
+    null = false
+    type = date
+  }
+  column "segment_count" {
+    null = false
+    type = integer
+  }
+  column "segments" {
+    null = false
+    type = text
+  }
+  primary_key {
+    columns = [column.video_id]
+  }
+
+  index "idx_sponsorblock_video_segments_expires_at" {
+    columns = [ column.expires_at ]
+  }
+}
+
+table "sponsorblock_submissions" {
+  schema = schema.main
+
+  column "id" {
+    null = false
+    type = text
+  }
+  column "created_at" {
+    null = false
+    type = date
+  }
+  column "updated_at" {
+    null = false
+    type = date
+  }
+  column "user_id" {
+    null = false
+    type = text
+  }
+  column "video_id" {
+    null = false
+    type = text
+  }
+  column "kind" {
+    null = false
+    type = text
+  }
+  column "payload" {
+    null = false
+    type = text
+  }
+  column "status" {
+    null = false
+    type = text
+  }
+  column "attempts" {
+    null = false
+    type = integer
+  }
+  column "next_attempt_at" {
+    null = false
+    type = date
+  }
+  column "last_error" {
+    null = false
+    type = text
+  }
+  column "result" {
+    null = false
+    type = text
+  }
+  primary_key {
+    columns = [column.id]
+  }
+
+  foreign_key "sponsorblock_submissions_user_id_fkey" {
+    columns = [ column.user_id ]
+    ref_columns = [ table.users.column.id ]
+    on_delete   = CASCADE
+  }
+
+  index "idx_sponsorblock_submissions_status_next_attempt_at" {
+    columns = [ column.status, column.next_attempt_at ]
+  }
+  index "idx_sponsorblock_submissions_user_id_video_id" {
+    columns = [ column.user_id, column.video_id ]
+  }
+}
+
+table "dearrow_video_branding" {
+  schema = schema.main
+
+  column "video_id" {
+    null = false
+    type = text
+  }
+  column "created_at" {
+    null = false
+    type = date
+  }
+  column "updated_at" {
+    null = false
+    type = date
+  }
+  column "expires_at" {
+    null = false
+    type = date
+  }
+  column "title" {
+    null = false
+    type = text
+  }
+  column "thumbnail_time" {
+    null = true
+    type = real
+  }
+  primary_key {
+    columns = [column.video_id]
+  }
+
+  index "idx_dearrow_video_branding_expires_at" {
+    columns = [ column.expires_at ]
   }
 }
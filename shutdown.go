@@ -0,0 +1,154 @@
+package main
+
+import (
+	"context"
+	"os"
+	"os/signal"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/cufee/feedlr-yt/internal/database"
+	"github.com/cufee/feedlr-yt/internal/logic"
+	"github.com/cufee/feedlr-yt/internal/logic/background"
+	"github.com/rs/zerolog/log"
+)
+
+// Each step gets its own deadline, the sum plus the cancel grace of cron and jobs should stay below the stop timeout of the container runtime
+const (
+	serverDrainTimeout     = 10 * time.Second
+	cronStopTimeout        = 10 * time.Second
+	jobsStopTimeout        = 15 * time.Second
+	youtubeSyncStopTimeout = 5 * time.Second
+	tvSyncHandOffTimeout   = 5 * time.Second
+	prefetchStopTimeout    = 5 * time.Second
+	tracingFlushTimeout    = 5 * time.Second
+)
+
+/*
+gracefulShutdown stops everything main started, in order: open requests are drained first, background work is
+finished or handed over to other instances next, and the database is closed last.
+*/
+type gracefulShutdown struct {
+	db          database.Client
+	cron        *background.CronTasks
+	jobs        *logic.JobQueue
+	youtubeSync *logic.YouTubeSyncService
+	tvSync      *logic.YouTubeTVSyncService
+	tracing     func(ctx context.Context) error
+
+	// server is set once the server is created, a signal can arrive before that
+	mu     sync.Mutex
+	server func(ctx context.Context) error
+
+	done chan struct{}
+}
+
+/*
+onSignal runs the shutdown when the process is asked to stop and exits once it is done, a second signal exits right away.
+It is registered before the server starts so screens are handed over even when the process is stopped during boot.
+*/
+func (g *gracefulShutdown) onSignal() {
+	g.done = make(chan struct{})
+
+	signals := make(chan os.Signal, 2)
+	signal.Notify(signals, os.Interrupt, syscall.SIGTERM)
+
+	go func() {
+		sig := <-signals
+		log.Info().Str("signal", sig.String()).Msg("shutting down")
+
+		go func() {
+			sig := <-signals
+			log.Warn().Str("signal", sig.String()).Msg("received a second signal, exiting without finishing the shutdown")
+			os.Exit(1)
+		}()
+
+		g.run()
+		close(g.done)
+		os.Exit(0)
+	}()
+}
+
+func (g *gracefulShutdown) setServer(shutdown func(ctx context.Context) error) {
+	g.mu.Lock()
+	g.server = shutdown
+	g.mu.Unlock()
+}
+
+/*
+wait blocks until the shutdown started by a signal is done, main calls it once the server stops listening
+*/
+func (g *gracefulShutdown) wait() {
+	<-g.done
+}
+
+func (g *gracefulShutdown) run() {
+	g.mu.Lock()
+	server := g.server
+	g.mu.Unlock()
+
+	if server != nil {
+		ctx, cancel := context.WithTimeout(context.Background(), serverDrainTimeout)
+		if err := server(ctx); err != nil {
+			log.Warn().Err(err).Msg("server did not drain in time")
+		}
+		cancel()
+	}
+
+	if g.cron != nil {
+		ctx, cancel := context.WithTimeout(context.Background(), cronStopTimeout)
+		if err := g.cron.Stop(ctx); err != nil {
+			log.Warn().Err(err).Msg("failed to stop cron tasks")
+		}
+		cancel()
+	}
+
+	// Cron only queues jobs, jobs still running at the deadline are put back in the queue for the next process
+	if g.jobs != nil {
+		ctx, cancel := context.WithTimeout(context.Background(), jobsStopTimeout)
+		if err := g.jobs.Stop(ctx); err != nil {
+			log.Warn().Err(err).Msg("jobs did not finish in time")
+		}
+		cancel()
+	}
+
+	// Syncs started outside of cron, like the first sync after connecting an account, are not covered by the scheduler
+	if g.youtubeSync != nil {
+		ctx, cancel := context.WithTimeout(context.Background(), youtubeSyncStopTimeout)
+		if err := g.youtubeSync.Stop(ctx); err != nil {
+			log.Warn().Err(err).Msg("youtube sync did not finish in time")
+		}
+		cancel()
+	}
+
+	// Workers save their last observed progress and state, and release their leases so other instances take the screens over
+	if g.tvSync != nil {
+		ctx, cancel := context.WithTimeout(context.Background(), tvSyncHandOffTimeout)
+		g.tvSync.Stop(ctx)
+		cancel()
+	}
+
+	// Requests, jobs and syncs above start SponsorBlock and DeArrow lookups that write to the database in the background
+	prefetchCtx, cancelPrefetch := context.WithTimeout(context.Background(), prefetchStopTimeout)
+	if err := logic.StopPrefetches(prefetchCtx); err != nil {
+		log.Warn().Err(err).Msg("prefetches did not finish in time")
+	}
+	cancelPrefetch()
+
+	if g.db != nil {
+		if err := g.db.Close(); err != nil {
+			log.Warn().Err(err).Msg("failed to close database")
+		}
+	}
+
+	// Spans of everything above are still buffered in the exporter
+	if g.tracing != nil {
+		ctx, cancel := context.WithTimeout(context.Background(), tracingFlushTimeout)
+		if err := g.tracing(ctx); err != nil {
+			log.Warn().Err(err).Msg("failed to flush traces")
+		}
+		cancel()
+	}
+	log.Info().Msg("shutdown complete")
+}
@@ -0,0 +1,129 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"os/signal"
+	"strings"
+	"time"
+
+	"github.com/cufee/feedlr-yt/internal/api/youtube/dial"
+)
+
+/*
+runTVPair implements the tv-pair subcommand, a helper run on the same network as the TVs.
+It finds YouTube screens using DIAL and sends their screen IDs to the Feedlr server, authenticated with a pairing token from the settings page.
+*/
+func runTVPair(args []string) int {
+	flags := flag.NewFlagSet("tv-pair", flag.ContinueOnError)
+	serverURL := flags.String("server", os.Getenv("FEEDLR_SERVER_URL"), "Feedlr address, for example https://feedlr.example.com")
+	token := flags.String("token", os.Getenv("FEEDLR_PAIRING_TOKEN"), "pairing token from the settings page")
+	timeout := flags.Duration("timeout", 3*time.Second, "how long to wait for TVs to answer")
+	nameFilter := flags.String("name", "", "only pair TVs with a name containing this text")
+	launch := flags.Bool("launch", false, "open YouTube on TVs where it is not running")
+	listOnly := flags.Bool("list", false, "list TVs without pairing them")
+	ssdpAddress := flags.String("ssdp-address", dial.DefaultSSDPAddress, "address SSDP searches are sent to")
+	if err := flags.Parse(args); err != nil {
+		return 2
+	}
+
+	if !*listOnly && (*serverURL == "" || *token == "") {
+		fmt.Fprintln(os.Stderr, "-server and -token are required, create a token on the Feedlr settings page")
+		return 2
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+
+	fmt.Println("Looking for TVs on the local network...")
+	screens, err := dial.Discover(ctx, dial.Options{Address: *ssdpAddress, Timeout: *timeout, Launch: *launch})
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Discovery failed: %v\n", err)
+		return 1
+	}
+
+	var matched []dial.Screen
+	for _, screen := range screens {
+		if *nameFilter != "" && !strings.Contains(strings.ToLower(screen.Name), strings.ToLower(*nameFilter)) {
+			continue
+		}
+		matched = append(matched, screen)
+	}
+	if len(matched) == 0 {
+		fmt.Println("No TVs found. Make sure YouTube is open on the TV, or pass -launch to open it.")
+		return 1
+	}
+
+	failed := 0
+	client := &http.Client{Timeout: 30 * time.Second}
+	for _, screen := range matched {
+		if *listOnly {
+			fmt.Printf("Found %s (%s)\n", screenLabel(screen), screen.ScreenID)
+			continue
+		}
+
+		name, err := pairLANScreen(ctx, client, *serverURL, *token, screen)
+		if err != nil {
+			failed++
+			fmt.Fprintf(os.Stderr, "Failed to pair %s: %v\n", screenLabel(screen), err)
+			continue
+		}
+		fmt.Printf("Paired %s\n", name)
+	}
+	if failed > 0 {
+		return 1
+	}
+	return 0
+}
+
+func screenLabel(screen dial.Screen) string {
+	if screen.Name != "" {
+		return screen.Name
+	}
+	return screen.Location
+}
+
+func pairLANScreen(ctx context.Context, client *http.Client, serverURL, token string, screen dial.Screen) (string, error) {
+	form := url.Values{}
+	form.Set("screen_id", screen.ScreenID)
+	form.Set("name", screen.Name)
+
+	endpoint := strings.TrimRight(serverURL, "/") + "/tv/pair/lan"
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint, strings.NewReader(form.Encode()))
+	if err != nil {
+		return "", err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("Authorization", "Bearer "+token)
+
+	res, err := client.Do(req)
+	if err != nil {
+		return "", err
+	}
+	defer res.Body.Close()
+
+	switch res.StatusCode {
+	case http.StatusOK:
+	case http.StatusUnauthorized:
+		return "", fmt.Errorf("the pairing token is invalid or expired, create a new one on the settings page")
+	default:
+		return "", fmt.Errorf("server responded with status %d", res.StatusCode)
+	}
+
+	var payload struct {
+		Name string `json:"name"`
+	}
+	if err := json.NewDecoder(io.LimitReader(res.Body, 64*1024)).Decode(&payload); err != nil {
+		return "", fmt.Errorf("invalid server response: %w", err)
+	}
+	if payload.Name == "" {
+		payload.Name = screenLabel(screen)
+	}
+	return payload.Name, nil
+}